@@ -0,0 +1,116 @@
+// Command pdfium-replay replays a trace that was recorded with the recorder
+// package against the WebAssembly or the multi-threaded implementation, to
+// reproduce the sequence of calls that crashed a worker.
+//
+// Usage:
+//
+//	pdfium-replay -trace trace.jsonl -payloads payloads/
+//	pdfium-replay -trace trace.jsonl -payloads payloads/ -backend multi_threaded -worker ./worker
+//
+// The single-threaded implementation needs cgo and PDFium at build time, use
+// recorder.Replay with a single-threaded instance to replay against it.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/klippa-app/go-pdfium"
+	"github.com/klippa-app/go-pdfium/multi_threaded"
+	"github.com/klippa-app/go-pdfium/recorder"
+	"github.com/klippa-app/go-pdfium/webassembly"
+)
+
+func main() {
+	tracePath := flag.String("trace", "", "The path of the trace to replay.")
+	payloadDir := flag.String("payloads", "", "The directory with the payloads of the trace.")
+	backend := flag.String("backend", "webassembly", "The implementation to replay against: webassembly or multi_threaded.")
+	workerPath := flag.String("worker", "", "The path of the worker binary, for the multi_threaded backend.")
+	callTimeout := flag.Duration("call-timeout", time.Minute, "The maximum duration of a single call, 0 means no timeout.")
+	flag.Parse()
+
+	if *tracePath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	pool, err := initPool(*backend, *workerPath, *callTimeout)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer pool.Close()
+
+	instance, err := pool.GetInstance(time.Second * 30)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer instance.Close()
+
+	trace, err := os.Open(*tracePath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer trace.Close()
+
+	failed := 0
+	err = recorder.Replay(context.Background(), instance, trace, recorder.ReplayConfig{
+		PayloadDir: *payloadDir,
+		OnCall: func(result *recorder.ReplayResult) {
+			status := "ok"
+			if result.Error != nil {
+				status = "error: " + result.Error.Error()
+				failed++
+			}
+
+			recorded := "no result recorded"
+			if result.Recorded {
+				recorded = "recorded: ok"
+				if result.RecordedError != "" {
+					recorded = "recorded error: " + result.RecordedError
+				}
+			}
+
+			fmt.Printf("#%d %s (%s): %s, %s\n", result.Seq, result.Method, result.Duration, status, recorded)
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("Replayed trace, %d calls returned an error\n", failed)
+}
+
+func initPool(backend string, workerPath string, callTimeout time.Duration) (pdfium.Pool, error) {
+	switch backend {
+	case "webassembly":
+		return webassembly.Init(webassembly.Config{
+			MinIdle:     1,
+			MaxIdle:     1,
+			MaxTotal:    1,
+			CallTimeout: callTimeout,
+			// Traces can contain the paths of the files of the host.
+			MountHostFS: true,
+		})
+	case "multi_threaded":
+		if workerPath == "" {
+			return nil, errors.New("the multi_threaded backend needs the path of the worker binary")
+		}
+
+		return multi_threaded.Init(multi_threaded.Config{
+			MinIdle:  1,
+			MaxIdle:  1,
+			MaxTotal: 1,
+			Command: multi_threaded.Command{
+				BinPath: workerPath,
+			},
+			CallTimeout: callTimeout,
+		}), nil
+	}
+
+	return nil, fmt.Errorf("unknown backend %s", backend)
+}
@@ -3,14 +3,15 @@ package main
 // This tool is to generate the go-pdfium implementations.
 // The implementations follow a format for input/output which makes it easy to
 // generate the implementations, saving a lot of copy-pasting time.
+// Run it from the root of the repository with: go run ./code_generation
 
 import (
+	"bytes"
+	"go/format"
 	"io/ioutil"
 	"log"
-	"os"
 	"path"
 	"reflect"
-	"strings"
 	"text/template"
 
 	"github.com/klippa-app/go-pdfium"
@@ -29,13 +30,34 @@ func (m *GenerateDataMethod) BlockForMultiThreaded() bool {
 		m.Name == "FSDK_SetUnSpObjProcessHandler" ||
 		m.Name == "FSDK_SetTimeFunction" ||
 		m.Name == "FSDK_SetLocaltimeFunction" ||
-		m.Name == "FPDF_RenderPage" ||
+		m.Name == "FPDF_RenderPage" {
+		return true
+	}
+	return false
+}
+
+// HasCustomRPC returns whether the RPC client and server of this method are
+// implemented by hand in the commons package. This is needed for methods that
+// have callbacks, readers or writers in their request, as these can't be serialized
+// over net/rpc, for methods that need to clean up after them and for methods
+// that can transfer their payload through shared memory.
+func (m *GenerateDataMethod) HasCustomRPC() bool {
+	if m.Name == "FPDFDOC_InitFormFillEnvironment" ||
+		m.Name == "FPDFDOC_ExitFormFillEnvironment" ||
 		m.Name == "FPDF_RenderPageBitmapWithColorScheme_Start" ||
 		m.Name == "FPDF_RenderPageBitmap_Start" ||
 		m.Name == "FPDF_RenderPage_Continue" ||
-		m.Name == "FPDF_RenderPage_Close" ||
-		m.Name == "FPDFDOC_InitFormFillEnvironment" ||
-		strings.HasPrefix(m.Name, "FPDFAvail_") {
+		m.Name == "FPDFAvail_Create" ||
+		m.Name == "FPDFAvail_Destroy" ||
+		m.Name == "OpenDocument" ||
+		m.Name == "FPDF_CloseDocument" ||
+		m.Name == "FPDF_SaveAsCopy" ||
+		m.Name == "FPDF_SaveWithVersion" ||
+		m.Name == "RenderPageInDPI" ||
+		m.Name == "RenderPageInPixels" ||
+		m.Name == "RenderPagesInDPI" ||
+		m.Name == "RenderPagesInPixels" ||
+		m.Name == "RenderPageRegion" {
 		return true
 	}
 	return false
@@ -43,6 +65,7 @@ func (m *GenerateDataMethod) BlockForMultiThreaded() bool {
 
 type GenerateData struct {
 	Methods []GenerateDataMethod
+	Proto   *ProtoSchema
 }
 
 type Template struct {
@@ -79,7 +102,17 @@ func main() {
 		data.Methods = append(data.Methods, dataMethod)
 	}
 
+	proto, err := generateProtoSchema()
+	if err != nil {
+		log.Fatalf("Could not generate protobuf schema: %s", err.Error())
+	}
+	data.Proto = proto
+
 	templates := []Template{
+		{
+			Source: "code_generation/templates/pdfium.go.tmpl",
+			Target: "generated.go",
+		},
 		{
 			Source: "code_generation/templates/single_threaded.go.tmpl",
 			Target: "single_threaded/generated.go",
@@ -96,6 +129,18 @@ func main() {
 			Source: "code_generation/templates/webassembly.go.tmpl",
 			Target: "webassembly/generated.go",
 		},
+		{
+			Source: "code_generation/templates/pdfiumtest.go.tmpl",
+			Target: "pdfiumtest/generated.go",
+		},
+		{
+			Source: "code_generation/templates/protobuf.go.tmpl",
+			Target: "internal/commons/generated_protobuf.go",
+		},
+		{
+			Source: "code_generation/templates/protobuf.proto.tmpl",
+			Target: "internal/commons/pdfium.proto",
+		},
 	}
 	for i := range templates {
 		err := generateFromTemplate(templates[i], data)
@@ -115,17 +160,19 @@ func generateFromTemplate(codeTemplate Template, data GenerateData) error {
 		return err
 	}
 
-	f, err := os.Create(codeTemplate.Target)
+	var output bytes.Buffer
+	err = t.Execute(&output, data)
 	if err != nil {
 		return err
 	}
 
-	defer f.Close()
-
-	err = t.Execute(f, data)
-	if err != nil {
-		return err
+	content := output.Bytes()
+	if path.Ext(codeTemplate.Target) == ".go" {
+		content, err = format.Source(content)
+		if err != nil {
+			return err
+		}
 	}
 
-	return nil
+	return ioutil.WriteFile(codeTemplate.Target, content, 0644)
 }
@@ -0,0 +1,594 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/klippa-app/go-pdfium"
+	"github.com/klippa-app/go-pdfium/internal/commons"
+	"github.com/klippa-app/go-pdfium/responses"
+)
+
+// protoSchemaPath is the protobuf schema of the gRPC transport. The schema is
+// also read before generating, so that the field numbers of existing fields
+// never change. This allows workers and hosts of different versions to talk to
+// each other.
+const protoSchemaPath = "internal/commons/pdfium.proto"
+
+// interfaceImplementations are the concrete types that can be sent over gRPC
+// in fields with an interface type. Fields with other interface types are not
+// sent, like gob does with functions.
+var interfaceImplementations = map[reflect.Type][]reflect.Type{
+	reflect.TypeOf((*image.Image)(nil)).Elem(): {
+		reflect.TypeOf(&image.RGBA{}),
+		reflect.TypeOf(&image.Gray{}),
+		reflect.TypeOf(&commons.SharedImage{}),
+	},
+}
+
+// callbackServers are served by the host process to the worker.
+var callbackServers = []interface{}{
+	&commons.FormFillInfoRPCServer{},
+	&commons.PauseRPCServer{},
+	&commons.ReaderRPCServer{},
+	&commons.DataAvailRPCServer{},
+	&commons.WriterRPCServer{},
+}
+
+type ProtoField struct {
+	Name   string
+	Label  string // optional, repeated or empty.
+	Type   string
+	Number int
+	GoType string // The Go expression of the concrete type for fields of interface messages.
+}
+
+type ProtoMessage struct {
+	Name     string
+	GoType   string // The Go type of the message, empty for wrapper messages.
+	Oneof    bool   // Whether the message is an interface, of which only one field is set.
+	Fields   []*ProtoField
+	Reserved []int
+}
+
+type ProtoMethod struct {
+	Name            string
+	Input           string
+	Output          string
+	ServerStreaming bool
+}
+
+type ProtoService struct {
+	Name    string
+	Methods []ProtoMethod
+}
+
+type ProtoSchema struct {
+	Messages []*ProtoMessage
+	Services []ProtoService
+}
+
+type existingProtoField struct {
+	Label  string
+	Type   string
+	Number int
+}
+
+type existingProtoMessage struct {
+	Fields   map[string]existingProtoField
+	Reserved []int
+}
+
+type protoSchemaBuilder struct {
+	existing map[string]*existingProtoMessage
+	messages map[string]*ProtoMessage
+}
+
+var (
+	protoMessageRegex  = regexp.MustCompile(`^message (\w+) \{`)
+	protoFieldRegex    = regexp.MustCompile(`^\s+(?:(optional|repeated) )?([\w.]+) (\w+) = (\d+);`)
+	protoReservedRegex = regexp.MustCompile(`^\s+reserved ([\d, ]+);`)
+)
+
+// readExistingProtoSchema reads the fields of the messages in the current
+// schema.
+func readExistingProtoSchema(path string) (map[string]*existingProtoMessage, error) {
+	existing := map[string]*existingProtoMessage{}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return existing, nil
+		}
+		return nil, err
+	}
+
+	var current *existingProtoMessage
+	for _, line := range strings.Split(string(content), "\n") {
+		if match := protoMessageRegex.FindStringSubmatch(line); match != nil {
+			current = &existingProtoMessage{Fields: map[string]existingProtoField{}}
+			existing[match[1]] = current
+			continue
+		}
+
+		if current == nil {
+			continue
+		}
+
+		if line == "}" {
+			current = nil
+			continue
+		}
+
+		if match := protoFieldRegex.FindStringSubmatch(line); match != nil {
+			number, err := strconv.Atoi(match[4])
+			if err != nil {
+				return nil, err
+			}
+			current.Fields[match[3]] = existingProtoField{Label: match[1], Type: match[2], Number: number}
+			continue
+		}
+
+		if match := protoReservedRegex.FindStringSubmatch(line); match != nil {
+			for _, reserved := range strings.Split(match[1], ",") {
+				number, err := strconv.Atoi(strings.TrimSpace(reserved))
+				if err != nil {
+					return nil, err
+				}
+				current.Reserved = append(current.Reserved, number)
+			}
+		}
+	}
+
+	return existing, nil
+}
+
+// generateProtoSchema generates the protobuf schema of all the messages that
+// are sent between the host process and the worker.
+func generateProtoSchema() (*ProtoSchema, error) {
+	existing, err := readExistingProtoSchema(protoSchemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := &protoSchemaBuilder{
+		existing: existing,
+		messages: map[string]*ProtoMessage{},
+	}
+
+	pdfiumService := ProtoService{Name: "Pdfium"}
+	pdfiumServer := reflect.TypeOf(&commons.PdfiumRPCServer{})
+	serverMethods := rpcMethods(pdfiumServer)
+
+	// Use the methods of the interface, in case the server has not been
+	// generated for them yet.
+	pdfiumType := reflect.TypeOf((*pdfium.Pdfium)(nil)).Elem()
+	for i := 0; i < pdfiumType.NumMethod(); i++ {
+		method := pdfiumType.Method(i)
+		if method.Name == "Close" || method.Name == "Kill" || method.Name == "GetImplementation" {
+			continue
+		}
+
+		if _, ok := serverMethods[method.Name]; ok {
+			continue
+		}
+
+		serverMethods[method.Name] = [2]reflect.Type{method.Type.In(0), method.Type.Out(0)}
+	}
+
+	for _, name := range sortedKeys(serverMethods) {
+		method, err := builder.method(name, serverMethods[name])
+		if err != nil {
+			return nil, err
+		}
+		pdfiumService.Methods = append(pdfiumService.Methods, method)
+	}
+
+	renderPagesStream, err := builder.method("RenderPagesStream", [2]reflect.Type{reflect.TypeOf(&commons.RenderPagesStreamRequest{}), reflect.TypeOf(&responses.RenderPage{})})
+	if err != nil {
+		return nil, err
+	}
+	renderPagesStream.ServerStreaming = true
+	pdfiumService.Methods = append(pdfiumService.Methods, renderPagesStream)
+
+	callbacksService := ProtoService{Name: "Callbacks"}
+	callbackMethods := map[string][2]reflect.Type{}
+	for i := range callbackServers {
+		for name, method := range rpcMethods(reflect.TypeOf(callbackServers[i])) {
+			callbackMethods[name] = method
+		}
+	}
+
+	for _, name := range sortedKeys(callbackMethods) {
+		method, err := builder.method(name, callbackMethods[name])
+		if err != nil {
+			return nil, err
+		}
+		callbacksService.Methods = append(callbacksService.Methods, method)
+	}
+
+	schema := &ProtoSchema{
+		Services: []ProtoService{pdfiumService, callbacksService},
+	}
+
+	for _, name := range sortedKeys(builder.messages) {
+		schema.Messages = append(schema.Messages, builder.messages[name])
+	}
+
+	return schema, nil
+}
+
+// rpcMethods returns the argument and reply types of the methods of the given
+// type that can be served by net/rpc, these are also served over gRPC.
+func rpcMethods(serverType reflect.Type) map[string][2]reflect.Type {
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+	methods := map[string][2]reflect.Type{}
+	for i := 0; i < serverType.NumMethod(); i++ {
+		method := serverType.Method(i)
+		if !method.IsExported() || method.Type.NumIn() != 3 || method.Type.NumOut() != 1 {
+			continue
+		}
+
+		if method.Type.In(2).Kind() != reflect.Ptr || method.Type.Out(0) != errorType {
+			continue
+		}
+
+		methods[method.Name] = [2]reflect.Type{method.Type.In(1), method.Type.In(2)}
+	}
+
+	return methods
+}
+
+func sortedKeys[T any](values map[string]T) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// method returns the RPC method with the messages of the given argument and
+// reply type.
+func (b *protoSchemaBuilder) method(name string, types [2]reflect.Type) (ProtoMethod, error) {
+	input, err := b.topLevelMessage(types[0])
+	if err != nil {
+		return ProtoMethod{}, fmt.Errorf("could not generate input of %s: %w", name, err)
+	}
+
+	output, err := b.topLevelMessage(types[1])
+	if err != nil {
+		return ProtoMethod{}, fmt.Errorf("could not generate output of %s: %w", name, err)
+	}
+
+	return ProtoMethod{Name: name, Input: input, Output: output}, nil
+}
+
+// topLevelMessage returns the message for an argument or reply of an RPC
+// method. Values that aren't structs are sent as field 1 of a wrapper
+// message.
+func (b *protoSchemaBuilder) topLevelMessage(goType reflect.Type) (string, error) {
+	for goType.Kind() == reflect.Ptr {
+		goType = goType.Elem()
+	}
+
+	if goType.Kind() == reflect.Struct {
+		return b.structMessage(goType)
+	}
+
+	if goType.Kind() == reflect.Interface {
+		b.messages["Empty"] = &ProtoMessage{Name: "Empty"}
+		return "Empty", nil
+	}
+
+	fieldType, label, ok, err := b.fieldType(goType)
+	if err != nil {
+		return "", err
+	}
+
+	if !ok {
+		return "", fmt.Errorf("type %s can't be sent over gRPC", goType)
+	}
+
+	name := strings.ToUpper(fieldType[:1]) + fieldType[1:]
+	if wrapperName, ok := scalarWrapperNames[fieldType]; ok {
+		name = wrapperName
+	}
+
+	b.messages[name] = &ProtoMessage{
+		Name:   name,
+		Fields: []*ProtoField{{Name: "Value", Label: label, Type: fieldType, Number: 1}},
+	}
+
+	return name, nil
+}
+
+var scalarWrapperNames = map[string]string{
+	"bool":   "Bool",
+	"sint64": "Int",
+	"uint64": "Uint",
+	"float":  "Float",
+	"double": "Double",
+	"string": "String",
+	"bytes":  "Bytes",
+}
+
+// scalarType returns the protobuf type of a Go type that isn't a struct,
+// slice, pointer or interface.
+func scalarType(goType reflect.Type) (string, bool) {
+	switch goType.Kind() {
+	case reflect.Bool:
+		return "bool", true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "sint64", true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "uint64", true
+	case reflect.Float32:
+		return "float", true
+	case reflect.Float64:
+		return "double", true
+	case reflect.String:
+		return "string", true
+	}
+
+	return "", false
+}
+
+// messageName returns the name of the message for the given Go type, the
+// package name is added to prevent conflicts between the requests and the
+// responses.
+func messageName(goType reflect.Type) string {
+	packagePath := strings.Split(goType.PkgPath(), "/")
+	return packagePath[len(packagePath)-1] + "_" + goType.Name()
+}
+
+// fieldType returns the protobuf type and label for a field with the given
+// Go type. The returned bool is false when the field can't be sent.
+// To keep the difference between nil and empty, all slices are sent in a
+// wrapper message or as bytes with presence, and all pointers to scalars
+// have presence.
+func (b *protoSchemaBuilder) fieldType(goType reflect.Type) (string, string, bool, error) {
+	switch goType.Kind() {
+	case reflect.Ptr:
+		elem := goType.Elem()
+		if elem.Kind() == reflect.Ptr || elem.Kind() == reflect.Interface {
+			return "", "", false, fmt.Errorf("type %s can't be sent over gRPC", goType)
+		}
+
+		fieldType, label, ok, err := b.fieldType(elem)
+		if err != nil || !ok {
+			return "", "", ok, err
+		}
+
+		if _, isScalar := scalarType(elem); isScalar {
+			label = "optional"
+		}
+
+		return fieldType, label, true, nil
+	case reflect.Slice:
+		if goType.Elem().Kind() == reflect.Uint8 {
+			return "bytes", "optional", true, nil
+		}
+
+		name, err := b.listMessage(goType.Elem())
+		if err != nil {
+			return "", "", false, err
+		}
+
+		return name, "", true, nil
+	case reflect.Struct:
+		name, err := b.structMessage(goType)
+		if err != nil {
+			return "", "", false, err
+		}
+
+		return name, "", true, nil
+	case reflect.Interface:
+		if _, ok := interfaceImplementations[goType]; !ok {
+			return "", "", false, nil
+		}
+
+		name, err := b.interfaceMessage(goType)
+		if err != nil {
+			return "", "", false, err
+		}
+
+		return name, "", true, nil
+	case reflect.Func, reflect.Chan:
+		return "", "", false, nil
+	}
+
+	if fieldType, ok := scalarType(goType); ok {
+		return fieldType, "", true, nil
+	}
+
+	return "", "", false, fmt.Errorf("type %s can't be sent over gRPC", goType)
+}
+
+// listMessage returns the wrapper message of a slice with the given element
+// type, this allows an empty slice to be sent.
+func (b *protoSchemaBuilder) listMessage(elem reflect.Type) (string, error) {
+	if elem.Kind() == reflect.Ptr {
+		if elem.Elem().Kind() != reflect.Struct {
+			return "", fmt.Errorf("slice of %s can't be sent over gRPC", elem)
+		}
+		elem = elem.Elem()
+	}
+
+	var elemType string
+	var name string
+	if fieldType, ok := scalarType(elem); ok {
+		elemType = fieldType
+		name = scalarWrapperNames[fieldType] + "List"
+	} else if elem.Kind() == reflect.Struct {
+		messageName, err := b.structMessage(elem)
+		if err != nil {
+			return "", err
+		}
+		elemType = messageName
+		name = messageName + "List"
+	} else {
+		return "", fmt.Errorf("slice of %s can't be sent over gRPC", elem)
+	}
+
+	b.messages[name] = &ProtoMessage{
+		Name:   name,
+		Fields: []*ProtoField{{Name: "Values", Label: "repeated", Type: elemType, Number: 1}},
+	}
+
+	return name, nil
+}
+
+// structMessage returns the message for the given struct type.
+func (b *protoSchemaBuilder) structMessage(goType reflect.Type) (string, error) {
+	name := messageName(goType)
+	if _, ok := b.messages[name]; ok {
+		return name, nil
+	}
+
+	message := &ProtoMessage{
+		Name:   name,
+		GoType: goType.String(),
+	}
+
+	// Register the message before the fields to support recursive types.
+	b.messages[name] = message
+
+	var fields []*ProtoField
+	for i := 0; i < goType.NumField(); i++ {
+		field := goType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		fieldType, label, ok, err := b.fieldType(field.Type)
+		if err != nil {
+			return "", fmt.Errorf("could not generate field %s of %s: %w", field.Name, goType, err)
+		}
+
+		if !ok {
+			continue
+		}
+
+		fields = append(fields, &ProtoField{Name: field.Name, Label: label, Type: fieldType})
+	}
+
+	b.numberFields(message, fields)
+	return name, nil
+}
+
+// interfaceMessage returns the message for the given interface type, it has
+// a field for every concrete type that can be sent in it.
+func (b *protoSchemaBuilder) interfaceMessage(goType reflect.Type) (string, error) {
+	name := messageName(goType)
+	if _, ok := b.messages[name]; ok {
+		return name, nil
+	}
+
+	message := &ProtoMessage{
+		Name:   name,
+		GoType: goType.String(),
+		Oneof:  true,
+	}
+	b.messages[name] = message
+
+	var fields []*ProtoField
+	for _, implementation := range interfaceImplementations[goType] {
+		fieldType, err := b.structMessage(implementation.Elem())
+		if err != nil {
+			return "", err
+		}
+
+		goExpression := "&" + strings.TrimPrefix(implementation.Elem().String(), "commons.") + "{}"
+		fields = append(fields, &ProtoField{Name: implementation.Elem().Name(), Type: fieldType, GoType: goExpression})
+	}
+
+	b.numberFields(message, fields)
+	return name, nil
+}
+
+// numberFields gives the fields the same number as in the existing schema,
+// and gives new fields (or fields of which the type changed) a number that
+// has never been used. The numbers of removed fields are reserved.
+func (b *protoSchemaBuilder) numberFields(message *ProtoMessage, fields []*ProtoField) {
+	existing, ok := b.existing[message.Name]
+	if !ok {
+		existing = &existingProtoMessage{Fields: map[string]existingProtoField{}}
+	}
+
+	used := map[int]bool{}
+	maxNumber := 0
+	for _, reserved := range existing.Reserved {
+		used[reserved] = true
+		if reserved > maxNumber {
+			maxNumber = reserved
+		}
+	}
+
+	for _, field := range existing.Fields {
+		used[field.Number] = true
+		if field.Number > maxNumber {
+			maxNumber = field.Number
+		}
+	}
+
+	reused := map[int]bool{}
+	for _, field := range fields {
+		if existingField, ok := existing.Fields[field.Name]; ok && existingField.Type == field.Type && existingField.Label == field.Label {
+			field.Number = existingField.Number
+			reused[field.Number] = true
+		}
+	}
+
+	for _, field := range fields {
+		if field.Number == 0 {
+			maxNumber++
+			field.Number = maxNumber
+		}
+	}
+
+	message.Reserved = append(message.Reserved, existing.Reserved...)
+	for number := range used {
+		if !reused[number] && !contains(message.Reserved, number) {
+			message.Reserved = append(message.Reserved, number)
+		}
+	}
+	sort.Ints(message.Reserved)
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Number < fields[j].Number
+	})
+	message.Fields = fields
+}
+
+func contains(values []int, value int) bool {
+	for i := range values {
+		if values[i] == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ReservedList returns the reserved field numbers in protobuf syntax.
+func (m *ProtoMessage) ReservedList() string {
+	numbers := make([]string, len(m.Reserved))
+	for i := range m.Reserved {
+		numbers[i] = strconv.Itoa(m.Reserved[i])
+	}
+	return strings.Join(numbers, ", ")
+}
+
+// Declaration returns the field in protobuf syntax.
+func (f *ProtoField) Declaration() string {
+	if f.Label != "" {
+		return fmt.Sprintf("%s %s %s = %d;", f.Label, f.Type, f.Name, f.Number)
+	}
+	return fmt.Sprintf("%s %s = %d;", f.Type, f.Name, f.Number)
+}
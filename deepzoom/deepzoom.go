@@ -0,0 +1,421 @@
+// Package deepzoom generates deep zoom tile pyramids of pages, for viewers
+// like OpenSeadragon or Leaflet.
+//
+// A Pyramid describes the levels and tiles of a page, the tiles are rendered
+// lazily with RenderTile, so that a server can render the tiles that are
+// requested by a viewer, or all at once with WriteFiles. Every tile is
+// rendered with RenderPageRegion through RenderToFile, so only the pixels of
+// the tile are rendered, and it's encoded with the same JPEG and PNG encoding
+// as RenderToFile.
+//
+//	pyramid, err := deepzoom.New(instance, deepzoom.Config{
+//		Page: requests.Page{
+//			ByIndex: &requests.PageByIndex{
+//				Document: doc.Document,
+//				Index:    0,
+//			},
+//		},
+//		DPI: 300,
+//	})
+//
+//	tile, err := pyramid.RenderTile(instance, level, column, row)
+package deepzoom
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+
+	"github.com/klippa-app/go-pdfium"
+	"github.com/klippa-app/go-pdfium/enums"
+	"github.com/klippa-app/go-pdfium/references"
+	"github.com/klippa-app/go-pdfium/requests"
+)
+
+const (
+	DefaultDPI      = 150 // The DPI of the highest level when Config.DPI is not set.
+	DefaultTileSize = 256 // The tile size when Config.TileSize is not set.
+)
+
+// Layout is the way the levels and tiles are numbered.
+type Layout string
+
+const (
+	// LayoutDZI is the Deep Zoom Image layout. Level 0 is the page in 1x1
+	// pixel, every next level doubles the size, up to the highest level in
+	// which the page is rendered in the full DPI. The tiles are written to
+	// {name}_files/{level}/{column}_{row}.{format}, with a {name}.dzi
+	// manifest.
+	LayoutDZI Layout = "dzi"
+
+	// LayoutXYZ is the layout of XYZ tile servers. Level 0 is the highest
+	// level in which the page fits in a single tile, every next level doubles
+	// the size, up to the highest level in which the page is rendered in the
+	// full DPI. The tiles are written to {name}/{level}/{column}/{row}.{format},
+	// with a {name}/manifest.json manifest. XYZ viewers usually expect no
+	// overlap.
+	LayoutXYZ Layout = "xyz"
+)
+
+// Config configures a Pyramid.
+type Config struct {
+	Page          requests.Page                     // The page to generate the pyramid for.
+	Layout        Layout                            // The layout of the pyramid, LayoutDZI when not set.
+	DPI           int                               // The DPI of the highest level, DefaultDPI when not set.
+	TileSize      int                               // The width and height of the tiles without overlap, DefaultTileSize when not set.
+	Overlap       int                               // The amount of pixels that the tiles overlap with their neighbours on every side.
+	Format        requests.RenderToFileOutputFormat // The format of the tiles, RenderToFileOutputFormatJPG when not set.
+	OutputQuality int                               // Only used when Format is RenderToFileOutputFormatJPG. Ranges from 1 to 100 inclusive, higher is better. The default is 95.
+	Progressive   bool                              // Only used when Format is RenderToFileOutputFormatJPG. Will render progressive jpeg tiles.
+	RenderFlags   enums.FPDF_RENDER_FLAG            // The render flags of the tiles.
+	RenderForm    bool                              // Whether to render form elements.
+	Document      *references.FPDF_DOCUMENT         // The document to render if not passed through the page by index, required when RenderForm is true.
+}
+
+// Level is a level of the pyramid.
+type Level struct {
+	Level             int     `json:"level"`                // The number of the level.
+	Width             int     `json:"width"`                // The width of the page in pixels in this level.
+	Height            int     `json:"height"`               // The height of the page in pixels in this level.
+	Columns           int     `json:"columns"`              // The number of tile columns in this level.
+	Rows              int     `json:"rows"`                 // The number of tile rows in this level.
+	PointToPixelRatio float64 `json:"point_to_pixel_ratio"` // The point to pixel ratio of this level.
+}
+
+// Tile is a tile of the pyramid, the position and size are in pixels in the
+// level, including the overlap.
+type Tile struct {
+	Level  int
+	Column int
+	Row    int
+	X      int
+	Y      int
+	Width  int
+	Height int
+}
+
+// Manifest describes a pyramid, it's written as manifest.json for LayoutXYZ.
+type Manifest struct {
+	Layout   Layout  `json:"layout"`
+	Width    int     `json:"width"`     // The width of the page in pixels in the highest level.
+	Height   int     `json:"height"`    // The height of the page in pixels in the highest level.
+	TileSize int     `json:"tile_size"` // The width and height of the tiles without overlap.
+	Overlap  int     `json:"overlap"`   // The amount of pixels that the tiles overlap with their neighbours on every side.
+	Format   string  `json:"format"`    // The format and file extension of the tiles.
+	MinLevel int     `json:"min_level"`
+	MaxLevel int     `json:"max_level"`
+	Levels   []Level `json:"levels"`
+}
+
+// Pyramid is the tile pyramid of a page.
+type Pyramid struct {
+	config Config
+	levels []Level
+}
+
+// New creates the pyramid of the page in the config, the instance is used to
+// get the size of the page.
+func New(instance pdfium.Pdfium, config Config) (*Pyramid, error) {
+	if config.Layout == "" {
+		config.Layout = LayoutDZI
+	}
+
+	if config.Layout != LayoutDZI && config.Layout != LayoutXYZ {
+		return nil, errors.New("invalid Layout given")
+	}
+
+	if config.DPI == 0 {
+		config.DPI = DefaultDPI
+	}
+
+	if config.TileSize == 0 {
+		config.TileSize = DefaultTileSize
+	}
+
+	if config.DPI < 0 || config.TileSize < 0 || config.Overlap < 0 {
+		return nil, errors.New("DPI, TileSize and Overlap can't be negative")
+	}
+
+	if config.Format == "" {
+		config.Format = requests.RenderToFileOutputFormatJPG
+	}
+
+	if config.Format != requests.RenderToFileOutputFormatJPG && config.Format != requests.RenderToFileOutputFormatPNG {
+		return nil, errors.New("invalid Format given")
+	}
+
+	pageSize, err := instance.GetPageSize(&requests.GetPageSize{
+		Page: config.Page,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pointToPixelRatio := float64(config.DPI) / 72.0
+	width := int(math.Ceil(pageSize.Width * pointToPixelRatio))
+	height := int(math.Ceil(pageSize.Height * pointToPixelRatio))
+	if width <= 0 || height <= 0 {
+		return nil, errors.New("page has no size")
+	}
+
+	return &Pyramid{
+		config: config,
+		levels: calculateLevels(config.Layout, width, height, config.TileSize, pointToPixelRatio),
+	}, nil
+}
+
+// calculateLevels returns the levels of a pyramid with the given size in the
+// highest level, from the lowest to the highest level.
+func calculateLevels(layout Layout, width, height, tileSize int, pointToPixelRatio float64) []Level {
+	// The number of times the highest level has to be halved to get to the
+	// lowest level.
+	maxSize := width
+	if height > maxSize {
+		maxSize = height
+	}
+
+	steps := 0
+	for size := maxSize; ; size = (size + 1) / 2 {
+		if layout == LayoutDZI && size <= 1 {
+			break
+		}
+		if layout == LayoutXYZ && size <= tileSize {
+			break
+		}
+		steps++
+	}
+
+	levels := make([]Level, steps+1)
+	for i := range levels {
+		factor := 1 << (steps - i)
+		levelWidth := (width + factor - 1) / factor
+		levelHeight := (height + factor - 1) / factor
+
+		levels[i] = Level{
+			Level:             i,
+			Width:             levelWidth,
+			Height:            levelHeight,
+			Columns:           (levelWidth + tileSize - 1) / tileSize,
+			Rows:              (levelHeight + tileSize - 1) / tileSize,
+			PointToPixelRatio: pointToPixelRatio / float64(factor),
+		}
+	}
+
+	return levels
+}
+
+// Levels returns the levels of the pyramid, from the lowest to the highest
+// level.
+func (p *Pyramid) Levels() []Level {
+	levels := make([]Level, len(p.levels))
+	copy(levels, p.levels)
+	return levels
+}
+
+// Tile returns the tile at the given level, column and row.
+func (p *Pyramid) Tile(level, column, row int) (*Tile, error) {
+	if level < 0 || level >= len(p.levels) {
+		return nil, errors.New("invalid level given")
+	}
+
+	pyramidLevel := p.levels[level]
+	if column < 0 || column >= pyramidLevel.Columns || row < 0 || row >= pyramidLevel.Rows {
+		return nil, errors.New("invalid column or row given")
+	}
+
+	x, width := p.tileSpan(column, pyramidLevel.Width)
+	y, height := p.tileSpan(row, pyramidLevel.Height)
+
+	return &Tile{
+		Level:  level,
+		Column: column,
+		Row:    row,
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	}, nil
+}
+
+// tileSpan returns the start and size of a tile in a single dimension, with
+// the overlap on both sides, except for the sides of the page.
+func (p *Pyramid) tileSpan(index, size int) (int, int) {
+	start := index*p.config.TileSize - p.config.Overlap
+	if start < 0 {
+		start = 0
+	}
+
+	end := (index+1)*p.config.TileSize + p.config.Overlap
+	if end > size {
+		end = size
+	}
+
+	return start, end - start
+}
+
+// Tiles returns all the tiles of the pyramid, from the lowest to the highest
+// level, per level by row and column.
+func (p *Pyramid) Tiles() []Tile {
+	tiles := []Tile{}
+	for _, level := range p.levels {
+		for row := 0; row < level.Rows; row++ {
+			for column := 0; column < level.Columns; column++ {
+				tile, _ := p.Tile(level.Level, column, row)
+				tiles = append(tiles, *tile)
+			}
+		}
+	}
+	return tiles
+}
+
+// TilePath returns the path of a tile relative to the tile directory of the
+// layout, like 12/3_4.jpg for LayoutDZI and 12/3/4.jpg for LayoutXYZ.
+func (p *Pyramid) TilePath(tile Tile) string {
+	if p.config.Layout == LayoutXYZ {
+		return fmt.Sprintf("%d/%d/%d.%s", tile.Level, tile.Column, tile.Row, p.config.Format)
+	}
+	return fmt.Sprintf("%d/%d_%d.%s", tile.Level, tile.Column, tile.Row, p.config.Format)
+}
+
+// RenderTile renders the tile at the given level, column and row, the result
+// is the encoded image. The instance must be able to render the page of the
+// pyramid, so it must have the document opened.
+func (p *Pyramid) RenderTile(instance pdfium.Pdfium, level, column, row int) ([]byte, error) {
+	tile, err := p.Tile(level, column, row)
+	if err != nil {
+		return nil, err
+	}
+
+	pointToPixelRatio := p.levels[level].PointToPixelRatio
+
+	// The size is given in pixels instead of a DPI, so that the tile is
+	// rendered in its exact size.
+	resp, err := instance.RenderToFile(&requests.RenderToFile{
+		RenderPageRegion: &requests.RenderPageRegion{
+			Page: p.config.Page,
+			Region: requests.RenderRegion{
+				X:      float64(tile.X) / pointToPixelRatio,
+				Y:      float64(tile.Y) / pointToPixelRatio,
+				Width:  float64(tile.Width) / pointToPixelRatio,
+				Height: float64(tile.Height) / pointToPixelRatio,
+			},
+			Width:       tile.Width,
+			Height:      tile.Height,
+			RenderFlags: p.config.RenderFlags,
+			RenderForm:  p.config.RenderForm,
+			Document:    p.config.Document,
+		},
+		OutputFormat:  p.config.Format,
+		OutputTarget:  requests.RenderToFileOutputTargetBytes,
+		OutputQuality: p.config.OutputQuality,
+		Progressive:   p.config.Progressive,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.ImageBytes == nil {
+		return nil, errors.New("no image returned")
+	}
+
+	return *resp.ImageBytes, nil
+}
+
+// Manifest returns the manifest of the pyramid.
+func (p *Pyramid) Manifest() Manifest {
+	return Manifest{
+		Layout:   p.config.Layout,
+		Width:    p.levels[len(p.levels)-1].Width,
+		Height:   p.levels[len(p.levels)-1].Height,
+		TileSize: p.config.TileSize,
+		Overlap:  p.config.Overlap,
+		Format:   string(p.config.Format),
+		MinLevel: 0,
+		MaxLevel: len(p.levels) - 1,
+		Levels:   p.Levels(),
+	}
+}
+
+type dziImage struct {
+	XMLName  xml.Name `xml:"http://schemas.microsoft.com/deepzoom/2008 Image"`
+	TileSize int      `xml:"TileSize,attr"`
+	Overlap  int      `xml:"Overlap,attr"`
+	Format   string   `xml:"Format,attr"`
+	Size     dziSize  `xml:"Size"`
+}
+
+type dziSize struct {
+	Width  int `xml:"Width,attr"`
+	Height int `xml:"Height,attr"`
+}
+
+// DZI returns the Deep Zoom Image XML manifest of the pyramid. Only valid for
+// LayoutDZI, the level numbers of LayoutXYZ don't match it.
+func (p *Pyramid) DZI() ([]byte, error) {
+	if p.config.Layout != LayoutDZI {
+		return nil, errors.New("DZI is only available for LayoutDZI")
+	}
+
+	manifest := p.Manifest()
+	data, err := xml.MarshalIndent(dziImage{
+		TileSize: manifest.TileSize,
+		Overlap:  manifest.Overlap,
+		Format:   manifest.Format,
+		Size: dziSize{
+			Width:  manifest.Width,
+			Height: manifest.Height,
+		},
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), data...), nil
+}
+
+// WriteFiles renders all tiles into the given directory and writes the
+// manifest, with the file layout of the Layout of the pyramid.
+func (p *Pyramid) WriteFiles(instance pdfium.Pdfium, dir, name string) error {
+	tileDir := filepath.Join(dir, name)
+	manifestPath := filepath.Join(tileDir, "manifest.json")
+	var manifest []byte
+	var err error
+	if p.config.Layout == LayoutDZI {
+		tileDir = filepath.Join(dir, name+"_files")
+		manifestPath = filepath.Join(dir, name+".dzi")
+		manifest, err = p.DZI()
+	} else {
+		manifest, err = json.MarshalIndent(p.Manifest(), "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, tile := range p.Tiles() {
+		tileData, err := p.RenderTile(instance, tile.Level, tile.Column, tile.Row)
+		if err != nil {
+			return fmt.Errorf("could not render tile %s: %w", p.TilePath(tile), err)
+		}
+
+		tilePath := filepath.Join(tileDir, filepath.FromSlash(p.TilePath(tile)))
+		if err := os.MkdirAll(filepath.Dir(tilePath), 0755); err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(tilePath, tileData, 0644); err != nil {
+			return err
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(manifestPath), 0755); err != nil {
+		return err
+	}
+
+	// The manifest is written last, so that a viewer doesn't load the
+	// pyramid before all tiles exist.
+	return os.WriteFile(manifestPath, manifest, 0644)
+}
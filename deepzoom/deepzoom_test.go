@@ -0,0 +1,178 @@
+package deepzoom_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klippa-app/go-pdfium/deepzoom"
+	"github.com/klippa-app/go-pdfium/pdfiumtest"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var page = requests.Page{
+	ByIndex: &requests.PageByIndex{
+		Document: "document",
+		Index:    0,
+	},
+}
+
+// newInstance returns an instance with a page of 144x72 points, which is
+// 288x144 pixels in 144 DPI.
+func newInstance() *pdfiumtest.Instance {
+	instance := pdfiumtest.NewInstance()
+	instance.Stubs.GetPageSize = func(request *requests.GetPageSize) (*responses.GetPageSize, error) {
+		return &responses.GetPageSize{Width: 144, Height: 72}, nil
+	}
+	instance.Stubs.RenderToFile = func(request *requests.RenderToFile) (*responses.RenderToFile, error) {
+		data := []byte(string(request.OutputFormat))
+		return &responses.RenderToFile{
+			Width:      request.RenderPageRegion.Width,
+			Height:     request.RenderPageRegion.Height,
+			ImageBytes: &data,
+		}, nil
+	}
+	return instance
+}
+
+func TestPyramidDZI(t *testing.T) {
+	instance := newInstance()
+	pyramid, err := deepzoom.New(instance, deepzoom.Config{
+		Page:     page,
+		DPI:      144,
+		TileSize: 100,
+		Overlap:  1,
+	})
+	require.NoError(t, err)
+
+	levels := pyramid.Levels()
+	require.Len(t, levels, 10)
+	assert.Equal(t, deepzoom.Level{Level: 0, Width: 1, Height: 1, Columns: 1, Rows: 1, PointToPixelRatio: 2.0 / 512}, levels[0])
+	assert.Equal(t, deepzoom.Level{Level: 8, Width: 144, Height: 72, Columns: 2, Rows: 1, PointToPixelRatio: 1}, levels[8])
+	assert.Equal(t, deepzoom.Level{Level: 9, Width: 288, Height: 144, Columns: 3, Rows: 2, PointToPixelRatio: 2}, levels[9])
+
+	tile, err := pyramid.Tile(9, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, &deepzoom.Tile{Level: 9, Column: 0, Row: 0, X: 0, Y: 0, Width: 101, Height: 101}, tile)
+
+	tile, err = pyramid.Tile(9, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &deepzoom.Tile{Level: 9, Column: 1, Row: 1, X: 99, Y: 99, Width: 102, Height: 45}, tile)
+	assert.Equal(t, "9/1_1.jpg", pyramid.TilePath(*tile))
+
+	_, err = pyramid.Tile(10, 0, 0)
+	assert.EqualError(t, err, "invalid level given")
+
+	_, err = pyramid.Tile(9, 3, 0)
+	assert.EqualError(t, err, "invalid column or row given")
+
+	data, err := pyramid.RenderTile(instance, 9, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("jpg"), data)
+	instance.AssertCalledWith(t, "RenderToFile", &requests.RenderToFile{
+		RenderPageRegion: &requests.RenderPageRegion{
+			Page: page,
+			Region: requests.RenderRegion{
+				X:      49.5,
+				Y:      49.5,
+				Width:  51,
+				Height: 22.5,
+			},
+			Width:  102,
+			Height: 45,
+		},
+		OutputFormat: requests.RenderToFileOutputFormatJPG,
+		OutputTarget: requests.RenderToFileOutputTargetBytes,
+	})
+
+	dzi, err := pyramid.DZI()
+	require.NoError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<Image xmlns="http://schemas.microsoft.com/deepzoom/2008" TileSize="100" Overlap="1" Format="jpg">
+  <Size Width="288" Height="144"></Size>
+</Image>`, string(dzi))
+}
+
+func TestPyramidXYZ(t *testing.T) {
+	instance := newInstance()
+	pyramid, err := deepzoom.New(instance, deepzoom.Config{
+		Page:     page,
+		Layout:   deepzoom.LayoutXYZ,
+		DPI:      144,
+		TileSize: 100,
+		Format:   requests.RenderToFileOutputFormatPNG,
+	})
+	require.NoError(t, err)
+
+	levels := pyramid.Levels()
+	require.Len(t, levels, 3)
+	assert.Equal(t, deepzoom.Level{Level: 0, Width: 72, Height: 36, Columns: 1, Rows: 1, PointToPixelRatio: 0.5}, levels[0])
+	assert.Equal(t, deepzoom.Level{Level: 2, Width: 288, Height: 144, Columns: 3, Rows: 2, PointToPixelRatio: 2}, levels[2])
+	assert.Len(t, pyramid.Tiles(), 1+2+6)
+
+	tile, err := pyramid.Tile(2, 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &deepzoom.Tile{Level: 2, Column: 2, Row: 1, X: 200, Y: 100, Width: 88, Height: 44}, tile)
+	assert.Equal(t, "2/2/1.png", pyramid.TilePath(*tile))
+
+	_, err = pyramid.DZI()
+	assert.EqualError(t, err, "DZI is only available for LayoutDZI")
+
+	dir := t.TempDir()
+	require.NoError(t, pyramid.WriteFiles(instance, dir, "page"))
+	instance.AssertNumberOfCalls(t, "RenderToFile", 9)
+
+	tileData, err := os.ReadFile(filepath.Join(dir, "page", "2", "2", "1.png"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("png"), tileData)
+
+	manifestData, err := os.ReadFile(filepath.Join(dir, "page", "manifest.json"))
+	require.NoError(t, err)
+
+	manifest := deepzoom.Manifest{}
+	require.NoError(t, json.Unmarshal(manifestData, &manifest))
+	assert.Equal(t, pyramid.Manifest(), manifest)
+	assert.Equal(t, 2, manifest.MaxLevel)
+}
+
+func TestPyramidWriteFilesDZI(t *testing.T) {
+	instance := newInstance()
+	pyramid, err := deepzoom.New(instance, deepzoom.Config{
+		Page:     page,
+		DPI:      72,
+		TileSize: 254,
+		Overlap:  1,
+	})
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, pyramid.WriteFiles(instance, dir, "page"))
+
+	_, err = os.Stat(filepath.Join(dir, "page.dzi"))
+	assert.NoError(t, err)
+
+	_, err = os.Stat(filepath.Join(dir, "page_files", "8", "0_0.jpg"))
+	assert.NoError(t, err)
+}
+
+func TestNewErrors(t *testing.T) {
+	instance := newInstance()
+
+	_, err := deepzoom.New(instance, deepzoom.Config{Page: page, Layout: "invalid"})
+	assert.EqualError(t, err, "invalid Layout given")
+
+	_, err = deepzoom.New(instance, deepzoom.Config{Page: page, Format: "gif"})
+	assert.EqualError(t, err, "invalid Format given")
+
+	_, err = deepzoom.New(instance, deepzoom.Config{Page: page, Overlap: -1})
+	assert.EqualError(t, err, "DPI, TileSize and Overlap can't be negative")
+
+	instance.Stubs.GetPageSize = nil
+	_, err = deepzoom.New(instance, deepzoom.Config{Page: page})
+	assert.ErrorIs(t, err, pdfiumtest.ErrNotStubbed)
+}
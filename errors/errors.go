@@ -1,6 +1,12 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/klippa-app/go-pdfium/references"
+)
 
 var (
 	ErrSuccess                  = errors.New("0: success")
@@ -14,4 +20,170 @@ var (
 	ErrExperimentalUnsupported  = errors.New("this functionality is only supported when using the pdfium_experimental build flag, see https://github.com/klippa-app/go-pdfium#experimental for more information")
 	ErrWindowsUnsupported       = errors.New("this functionality is Windows only")
 	ErrUnsupportedOnWebassembly = errors.New("this functionality is not supported on Webassembly")
+	ErrTimeout                  = errors.New("call timed out")
+	ErrWorkerCrashed            = errors.New("worker crashed")
+	ErrOutOfMemory              = errors.New("out of memory")
+)
+
+// Category is the kind of problem that caused an error.
+type Category string
+
+const (
+	CategoryUnknown       Category = "unknown"        // PDFium returned an unknown or unexpected error.
+	CategoryFile          Category = "file"           // The file could not be read.
+	CategoryFormat        Category = "format"         // The file is not a PDF or is corrupted.
+	CategoryPassword      Category = "password"       // The document needs a password, or the given password is invalid.
+	CategorySecurity      Category = "security"       // The encryption of the document is not supported.
+	CategoryPage          Category = "page"           // The page could not be loaded.
+	CategoryTimeout       Category = "timeout"        // The call took longer than the call timeout, see TimeoutError.
+	CategoryWorkerCrashed Category = "worker_crashed" // The worker process exited during the call.
+	CategoryOutOfMemory   Category = "out_of_memory"  // The worker reached its memory limit during the call.
+)
+
+// The FPDF_ERR codes of PDFium.
+const (
+	CodeSuccess  = 0 // No error.
+	CodeUnknown  = 1 // Unknown error.
+	CodeFile     = 2 // File not found or could not be opened.
+	CodeFormat   = 3 // File not in PDF format or corrupted.
+	CodePassword = 4 // Password required or incorrect password.
+	CodeSecurity = 5 // Unsupported security scheme.
+	CodePage     = 6 // Page not found or content error.
+
+	// NoCode is the Code of an Error that didn't come from PDFium.
+	NoCode = -1
 )
+
+// Error is an error with the context in which it happened. It's returned by
+// all implementations and survives the RPC between the host process and the
+// worker on multi-threaded usage, use errors.As to get it. It matches the
+// error variables of this package with errors.Is, like ErrPassword for the
+// FPDF_ERR_PASSWORD code.
+type Error struct {
+	Category Category                 // The kind of problem.
+	Code     int                      // The FPDF_ERR code that PDFium returned, NoCode when the error didn't come from PDFium.
+	Method   string                   // The method that returned the error.
+	Document references.FPDF_DOCUMENT // The document of the call, if known.
+	Page     references.FPDF_PAGE     // The page of the call, if known.
+	Message  string                   // The message of the error.
+
+	// cause is the error that caused this error, it's not sent between the
+	// host process and the worker.
+	cause error
+}
+
+// NewCodeError creates the error for an FPDF_ERR code of PDFium. Its message
+// is the message of the matching error variable, like ErrPassword.
+func NewCodeError(code int) *Error {
+	err := &Error{
+		Category: CategoryUnknown,
+		Code:     code,
+		Message:  ErrUnexpected.Error(),
+	}
+
+	if sentinel, ok := codeErrors[code]; ok {
+		err.Category = sentinel.category
+		err.Message = sentinel.err.Error()
+	}
+
+	return err
+}
+
+// NewWorkerCrashedError creates the error for a call during which the worker
+// crashed.
+func NewWorkerCrashedError(method string, cause error) *Error {
+	return &Error{
+		Category: CategoryWorkerCrashed,
+		Code:     NoCode,
+		Method:   method,
+		Message:  fmt.Sprintf("worker crashed during call to %s: %v", method, cause),
+		cause:    cause,
+	}
+}
+
+// NewOutOfMemoryError creates the error for a call during which the worker
+// reached its memory limit.
+func NewOutOfMemoryError(method string, cause error) *Error {
+	return &Error{
+		Category: CategoryOutOfMemory,
+		Code:     NoCode,
+		Method:   method,
+		Message:  fmt.Sprintf("out of memory: %v", cause),
+		cause:    cause,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Is makes the error match the error variable of its code or category with
+// errors.Is.
+func (e *Error) Is(target error) bool {
+	if sentinel, ok := codeErrors[e.Code]; ok {
+		return target == sentinel.err
+	}
+
+	if e.Category == CategoryWorkerCrashed {
+		return target == ErrWorkerCrashed
+	}
+
+	if e.Category == CategoryOutOfMemory {
+		return target == ErrOutOfMemory
+	}
+
+	return target == ErrUnexpected
+}
+
+type codeError struct {
+	err      error
+	category Category
+}
+
+// codeErrors are the error variables and categories of the FPDF_ERR codes.
+var codeErrors = map[int]codeError{
+	CodeSuccess:  {err: ErrSuccess, category: CategoryUnknown},
+	CodeUnknown:  {err: ErrUnknown, category: CategoryUnknown},
+	CodeFile:     {err: ErrFile, category: CategoryFile},
+	CodeFormat:   {err: ErrFormat, category: CategoryFormat},
+	CodePassword: {err: ErrPassword, category: CategoryPassword},
+	CodeSecurity: {err: ErrSecurity, category: CategorySecurity},
+	CodePage:     {err: ErrPage, category: CategoryPage},
+}
+
+// GetCategory returns the category of the error, or an empty category when
+// the error is not an Error or a TimeoutError.
+func GetCategory(err error) Category {
+	var timeoutError *TimeoutError
+	if errors.As(err, &timeoutError) {
+		return CategoryTimeout
+	}
+
+	var pdfiumError *Error
+	if errors.As(err, &pdfiumError) {
+		return pdfiumError.Category
+	}
+
+	return ""
+}
+
+// TimeoutError is returned when a call took longer than the configured call
+// timeout. The worker that handled the call has been killed, the instance
+// can't be used anymore and should be closed.
+type TimeoutError struct {
+	Method  string        // The method that timed out.
+	Timeout time.Duration // The configured call timeout.
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("call to %s timed out after %s, the worker has been killed", e.Method, e.Timeout)
+}
+
+// Is makes the error match ErrTimeout with errors.Is.
+func (e *TimeoutError) Is(target error) bool {
+	return target == ErrTimeout
+}
@@ -0,0 +1,52 @@
+package errors_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	pdfium_errors "github.com/klippa-app/go-pdfium/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodeError(t *testing.T) {
+	err := pdfium_errors.NewCodeError(pdfium_errors.CodePassword)
+	assert.EqualError(t, err, pdfium_errors.ErrPassword.Error())
+	assert.True(t, errors.Is(err, pdfium_errors.ErrPassword))
+	assert.False(t, errors.Is(err, pdfium_errors.ErrFormat))
+	assert.Equal(t, pdfium_errors.CategoryPassword, pdfium_errors.GetCategory(fmt.Errorf("wrapped: %w", err)))
+
+	err = pdfium_errors.NewCodeError(42)
+	assert.EqualError(t, err, pdfium_errors.ErrUnexpected.Error())
+	assert.True(t, errors.Is(err, pdfium_errors.ErrUnexpected))
+	assert.Equal(t, pdfium_errors.CategoryUnknown, pdfium_errors.GetCategory(err))
+}
+
+func TestWorkerCrashedError(t *testing.T) {
+	err := pdfium_errors.NewWorkerCrashedError("FPDF_LoadPage", io.ErrUnexpectedEOF)
+	assert.EqualError(t, err, "worker crashed during call to FPDF_LoadPage: unexpected EOF")
+	assert.True(t, errors.Is(err, pdfium_errors.ErrWorkerCrashed))
+	assert.True(t, errors.Is(err, io.ErrUnexpectedEOF))
+	assert.Equal(t, pdfium_errors.CategoryWorkerCrashed, pdfium_errors.GetCategory(err))
+}
+
+func TestOutOfMemoryError(t *testing.T) {
+	cause := errors.New("could not allocate memory")
+	err := pdfium_errors.NewOutOfMemoryError("RenderPageInDPI", cause)
+	assert.EqualError(t, err, "out of memory: could not allocate memory")
+	assert.Equal(t, "RenderPageInDPI", err.Method)
+	assert.True(t, errors.Is(err, pdfium_errors.ErrOutOfMemory))
+	assert.True(t, errors.Is(err, cause))
+	assert.False(t, errors.Is(err, pdfium_errors.ErrWorkerCrashed))
+	assert.Equal(t, pdfium_errors.CategoryOutOfMemory, pdfium_errors.GetCategory(fmt.Errorf("wrapped: %w", err)))
+}
+
+func TestTimeoutError(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", &pdfium_errors.TimeoutError{Method: "FPDF_LoadPage", Timeout: time.Second})
+	assert.True(t, errors.Is(err, pdfium_errors.ErrTimeout))
+	assert.Equal(t, pdfium_errors.CategoryTimeout, pdfium_errors.GetCategory(err))
+	assert.Equal(t, pdfium_errors.Category(""), pdfium_errors.GetCategory(errors.New("other")))
+}
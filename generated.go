@@ -0,0 +1,498 @@
+// Code generated by tool. DO NOT EDIT.
+// See the code_generation package.
+
+package pdfium
+
+import (
+	goctx "context"
+
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+)
+
+// PdfiumWithContext is the context-aware variant of Pdfium, the instances of
+// all implementations implement it. Every method of Pdfium has a variant
+// with the suffix WithContext that takes a context. When the context is done
+// before the instance is available, the call returns the error of the
+// context without calling PDFium. When the context is done during the call:
+// on single-threaded the call finishes, on multi-threaded the call returns
+// right away and the worker is killed, and on WebAssembly the module is
+// closed. On multi-threaded and WebAssembly the instance can't be used
+// anymore after that.
+// On multi-threaded usage with TransportGRPC, the OpenTelemetry trace
+// context is propagated to the worker.
+type PdfiumWithContext interface {
+	Pdfium
+	FORM_CanRedoWithContext(ctx goctx.Context, request *requests.FORM_CanRedo) (*responses.FORM_CanRedo, error)
+	FORM_CanUndoWithContext(ctx goctx.Context, request *requests.FORM_CanUndo) (*responses.FORM_CanUndo, error)
+	FORM_DoDocumentAActionWithContext(ctx goctx.Context, request *requests.FORM_DoDocumentAAction) (*responses.FORM_DoDocumentAAction, error)
+	FORM_DoDocumentJSActionWithContext(ctx goctx.Context, request *requests.FORM_DoDocumentJSAction) (*responses.FORM_DoDocumentJSAction, error)
+	FORM_DoDocumentOpenActionWithContext(ctx goctx.Context, request *requests.FORM_DoDocumentOpenAction) (*responses.FORM_DoDocumentOpenAction, error)
+	FORM_DoPageAActionWithContext(ctx goctx.Context, request *requests.FORM_DoPageAAction) (*responses.FORM_DoPageAAction, error)
+	FORM_ForceToKillFocusWithContext(ctx goctx.Context, request *requests.FORM_ForceToKillFocus) (*responses.FORM_ForceToKillFocus, error)
+	FORM_GetFocusedAnnotWithContext(ctx goctx.Context, request *requests.FORM_GetFocusedAnnot) (*responses.FORM_GetFocusedAnnot, error)
+	FORM_GetFocusedTextWithContext(ctx goctx.Context, request *requests.FORM_GetFocusedText) (*responses.FORM_GetFocusedText, error)
+	FORM_GetSelectedTextWithContext(ctx goctx.Context, request *requests.FORM_GetSelectedText) (*responses.FORM_GetSelectedText, error)
+	FORM_IsIndexSelectedWithContext(ctx goctx.Context, request *requests.FORM_IsIndexSelected) (*responses.FORM_IsIndexSelected, error)
+	FORM_OnAfterLoadPageWithContext(ctx goctx.Context, request *requests.FORM_OnAfterLoadPage) (*responses.FORM_OnAfterLoadPage, error)
+	FORM_OnBeforeClosePageWithContext(ctx goctx.Context, request *requests.FORM_OnBeforeClosePage) (*responses.FORM_OnBeforeClosePage, error)
+	FORM_OnCharWithContext(ctx goctx.Context, request *requests.FORM_OnChar) (*responses.FORM_OnChar, error)
+	FORM_OnFocusWithContext(ctx goctx.Context, request *requests.FORM_OnFocus) (*responses.FORM_OnFocus, error)
+	FORM_OnKeyDownWithContext(ctx goctx.Context, request *requests.FORM_OnKeyDown) (*responses.FORM_OnKeyDown, error)
+	FORM_OnKeyUpWithContext(ctx goctx.Context, request *requests.FORM_OnKeyUp) (*responses.FORM_OnKeyUp, error)
+	FORM_OnLButtonDoubleClickWithContext(ctx goctx.Context, request *requests.FORM_OnLButtonDoubleClick) (*responses.FORM_OnLButtonDoubleClick, error)
+	FORM_OnLButtonDownWithContext(ctx goctx.Context, request *requests.FORM_OnLButtonDown) (*responses.FORM_OnLButtonDown, error)
+	FORM_OnLButtonUpWithContext(ctx goctx.Context, request *requests.FORM_OnLButtonUp) (*responses.FORM_OnLButtonUp, error)
+	FORM_OnMouseMoveWithContext(ctx goctx.Context, request *requests.FORM_OnMouseMove) (*responses.FORM_OnMouseMove, error)
+	FORM_OnMouseWheelWithContext(ctx goctx.Context, request *requests.FORM_OnMouseWheel) (*responses.FORM_OnMouseWheel, error)
+	FORM_OnRButtonDownWithContext(ctx goctx.Context, request *requests.FORM_OnRButtonDown) (*responses.FORM_OnRButtonDown, error)
+	FORM_OnRButtonUpWithContext(ctx goctx.Context, request *requests.FORM_OnRButtonUp) (*responses.FORM_OnRButtonUp, error)
+	FORM_RedoWithContext(ctx goctx.Context, request *requests.FORM_Redo) (*responses.FORM_Redo, error)
+	FORM_ReplaceAndKeepSelectionWithContext(ctx goctx.Context, request *requests.FORM_ReplaceAndKeepSelection) (*responses.FORM_ReplaceAndKeepSelection, error)
+	FORM_ReplaceSelectionWithContext(ctx goctx.Context, request *requests.FORM_ReplaceSelection) (*responses.FORM_ReplaceSelection, error)
+	FORM_SelectAllTextWithContext(ctx goctx.Context, request *requests.FORM_SelectAllText) (*responses.FORM_SelectAllText, error)
+	FORM_SetFocusedAnnotWithContext(ctx goctx.Context, request *requests.FORM_SetFocusedAnnot) (*responses.FORM_SetFocusedAnnot, error)
+	FORM_SetIndexSelectedWithContext(ctx goctx.Context, request *requests.FORM_SetIndexSelected) (*responses.FORM_SetIndexSelected, error)
+	FORM_UndoWithContext(ctx goctx.Context, request *requests.FORM_Undo) (*responses.FORM_Undo, error)
+	FPDFAction_GetDestWithContext(ctx goctx.Context, request *requests.FPDFAction_GetDest) (*responses.FPDFAction_GetDest, error)
+	FPDFAction_GetFilePathWithContext(ctx goctx.Context, request *requests.FPDFAction_GetFilePath) (*responses.FPDFAction_GetFilePath, error)
+	FPDFAction_GetTypeWithContext(ctx goctx.Context, request *requests.FPDFAction_GetType) (*responses.FPDFAction_GetType, error)
+	FPDFAction_GetURIPathWithContext(ctx goctx.Context, request *requests.FPDFAction_GetURIPath) (*responses.FPDFAction_GetURIPath, error)
+	FPDFAnnot_AddFileAttachmentWithContext(ctx goctx.Context, request *requests.FPDFAnnot_AddFileAttachment) (*responses.FPDFAnnot_AddFileAttachment, error)
+	FPDFAnnot_AddInkStrokeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_AddInkStroke) (*responses.FPDFAnnot_AddInkStroke, error)
+	FPDFAnnot_AppendAttachmentPointsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_AppendAttachmentPoints) (*responses.FPDFAnnot_AppendAttachmentPoints, error)
+	FPDFAnnot_AppendObjectWithContext(ctx goctx.Context, request *requests.FPDFAnnot_AppendObject) (*responses.FPDFAnnot_AppendObject, error)
+	FPDFAnnot_CountAttachmentPointsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_CountAttachmentPoints) (*responses.FPDFAnnot_CountAttachmentPoints, error)
+	FPDFAnnot_GetAPWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetAP) (*responses.FPDFAnnot_GetAP, error)
+	FPDFAnnot_GetAttachmentPointsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetAttachmentPoints) (*responses.FPDFAnnot_GetAttachmentPoints, error)
+	FPDFAnnot_GetBorderWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetBorder) (*responses.FPDFAnnot_GetBorder, error)
+	FPDFAnnot_GetColorWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetColor) (*responses.FPDFAnnot_GetColor, error)
+	FPDFAnnot_GetFileAttachmentWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFileAttachment) (*responses.FPDFAnnot_GetFileAttachment, error)
+	FPDFAnnot_GetFlagsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFlags) (*responses.FPDFAnnot_GetFlags, error)
+	FPDFAnnot_GetFocusableSubtypesWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFocusableSubtypes) (*responses.FPDFAnnot_GetFocusableSubtypes, error)
+	FPDFAnnot_GetFocusableSubtypesCountWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFocusableSubtypesCount) (*responses.FPDFAnnot_GetFocusableSubtypesCount, error)
+	FPDFAnnot_GetFontColorWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFontColor) (*responses.FPDFAnnot_GetFontColor, error)
+	FPDFAnnot_GetFontSizeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFontSize) (*responses.FPDFAnnot_GetFontSize, error)
+	FPDFAnnot_GetFormAdditionalActionJavaScriptWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormAdditionalActionJavaScript) (*responses.FPDFAnnot_GetFormAdditionalActionJavaScript, error)
+	FPDFAnnot_GetFormControlCountWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormControlCount) (*responses.FPDFAnnot_GetFormControlCount, error)
+	FPDFAnnot_GetFormControlIndexWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormControlIndex) (*responses.FPDFAnnot_GetFormControlIndex, error)
+	FPDFAnnot_GetFormFieldAlternateNameWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldAlternateName) (*responses.FPDFAnnot_GetFormFieldAlternateName, error)
+	FPDFAnnot_GetFormFieldAtPointWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldAtPoint) (*responses.FPDFAnnot_GetFormFieldAtPoint, error)
+	FPDFAnnot_GetFormFieldExportValueWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldExportValue) (*responses.FPDFAnnot_GetFormFieldExportValue, error)
+	FPDFAnnot_GetFormFieldFlagsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldFlags) (*responses.FPDFAnnot_GetFormFieldFlags, error)
+	FPDFAnnot_GetFormFieldNameWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldName) (*responses.FPDFAnnot_GetFormFieldName, error)
+	FPDFAnnot_GetFormFieldTypeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldType) (*responses.FPDFAnnot_GetFormFieldType, error)
+	FPDFAnnot_GetFormFieldValueWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldValue) (*responses.FPDFAnnot_GetFormFieldValue, error)
+	FPDFAnnot_GetInkListCountWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetInkListCount) (*responses.FPDFAnnot_GetInkListCount, error)
+	FPDFAnnot_GetInkListPathWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetInkListPath) (*responses.FPDFAnnot_GetInkListPath, error)
+	FPDFAnnot_GetLineWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetLine) (*responses.FPDFAnnot_GetLine, error)
+	FPDFAnnot_GetLinkWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetLink) (*responses.FPDFAnnot_GetLink, error)
+	FPDFAnnot_GetLinkedAnnotWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetLinkedAnnot) (*responses.FPDFAnnot_GetLinkedAnnot, error)
+	FPDFAnnot_GetNumberValueWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetNumberValue) (*responses.FPDFAnnot_GetNumberValue, error)
+	FPDFAnnot_GetObjectWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetObject) (*responses.FPDFAnnot_GetObject, error)
+	FPDFAnnot_GetObjectCountWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetObjectCount) (*responses.FPDFAnnot_GetObjectCount, error)
+	FPDFAnnot_GetOptionCountWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetOptionCount) (*responses.FPDFAnnot_GetOptionCount, error)
+	FPDFAnnot_GetOptionLabelWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetOptionLabel) (*responses.FPDFAnnot_GetOptionLabel, error)
+	FPDFAnnot_GetRectWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetRect) (*responses.FPDFAnnot_GetRect, error)
+	FPDFAnnot_GetStringValueWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetStringValue) (*responses.FPDFAnnot_GetStringValue, error)
+	FPDFAnnot_GetSubtypeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetSubtype) (*responses.FPDFAnnot_GetSubtype, error)
+	FPDFAnnot_GetValueTypeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetValueType) (*responses.FPDFAnnot_GetValueType, error)
+	FPDFAnnot_GetVerticesWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetVertices) (*responses.FPDFAnnot_GetVertices, error)
+	FPDFAnnot_HasAttachmentPointsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_HasAttachmentPoints) (*responses.FPDFAnnot_HasAttachmentPoints, error)
+	FPDFAnnot_HasKeyWithContext(ctx goctx.Context, request *requests.FPDFAnnot_HasKey) (*responses.FPDFAnnot_HasKey, error)
+	FPDFAnnot_IsCheckedWithContext(ctx goctx.Context, request *requests.FPDFAnnot_IsChecked) (*responses.FPDFAnnot_IsChecked, error)
+	FPDFAnnot_IsObjectSupportedSubtypeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_IsObjectSupportedSubtype) (*responses.FPDFAnnot_IsObjectSupportedSubtype, error)
+	FPDFAnnot_IsOptionSelectedWithContext(ctx goctx.Context, request *requests.FPDFAnnot_IsOptionSelected) (*responses.FPDFAnnot_IsOptionSelected, error)
+	FPDFAnnot_IsSupportedSubtypeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_IsSupportedSubtype) (*responses.FPDFAnnot_IsSupportedSubtype, error)
+	FPDFAnnot_RemoveInkListWithContext(ctx goctx.Context, request *requests.FPDFAnnot_RemoveInkList) (*responses.FPDFAnnot_RemoveInkList, error)
+	FPDFAnnot_RemoveObjectWithContext(ctx goctx.Context, request *requests.FPDFAnnot_RemoveObject) (*responses.FPDFAnnot_RemoveObject, error)
+	FPDFAnnot_SetAPWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetAP) (*responses.FPDFAnnot_SetAP, error)
+	FPDFAnnot_SetAttachmentPointsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetAttachmentPoints) (*responses.FPDFAnnot_SetAttachmentPoints, error)
+	FPDFAnnot_SetBorderWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetBorder) (*responses.FPDFAnnot_SetBorder, error)
+	FPDFAnnot_SetColorWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetColor) (*responses.FPDFAnnot_SetColor, error)
+	FPDFAnnot_SetFlagsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetFlags) (*responses.FPDFAnnot_SetFlags, error)
+	FPDFAnnot_SetFocusableSubtypesWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetFocusableSubtypes) (*responses.FPDFAnnot_SetFocusableSubtypes, error)
+	FPDFAnnot_SetFontColorWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetFontColor) (*responses.FPDFAnnot_SetFontColor, error)
+	FPDFAnnot_SetFormFieldFlagsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetFormFieldFlags) (*responses.FPDFAnnot_SetFormFieldFlags, error)
+	FPDFAnnot_SetRectWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetRect) (*responses.FPDFAnnot_SetRect, error)
+	FPDFAnnot_SetStringValueWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetStringValue) (*responses.FPDFAnnot_SetStringValue, error)
+	FPDFAnnot_SetURIWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetURI) (*responses.FPDFAnnot_SetURI, error)
+	FPDFAnnot_UpdateObjectWithContext(ctx goctx.Context, request *requests.FPDFAnnot_UpdateObject) (*responses.FPDFAnnot_UpdateObject, error)
+	FPDFAttachment_GetDescriptionWithContext(ctx goctx.Context, request *requests.FPDFAttachment_GetDescription) (*responses.FPDFAttachment_GetDescription, error)
+	FPDFAttachment_GetFileWithContext(ctx goctx.Context, request *requests.FPDFAttachment_GetFile) (*responses.FPDFAttachment_GetFile, error)
+	FPDFAttachment_GetNameWithContext(ctx goctx.Context, request *requests.FPDFAttachment_GetName) (*responses.FPDFAttachment_GetName, error)
+	FPDFAttachment_GetStringValueWithContext(ctx goctx.Context, request *requests.FPDFAttachment_GetStringValue) (*responses.FPDFAttachment_GetStringValue, error)
+	FPDFAttachment_GetSubtypeWithContext(ctx goctx.Context, request *requests.FPDFAttachment_GetSubtype) (*responses.FPDFAttachment_GetSubtype, error)
+	FPDFAttachment_GetValueTypeWithContext(ctx goctx.Context, request *requests.FPDFAttachment_GetValueType) (*responses.FPDFAttachment_GetValueType, error)
+	FPDFAttachment_HasKeyWithContext(ctx goctx.Context, request *requests.FPDFAttachment_HasKey) (*responses.FPDFAttachment_HasKey, error)
+	FPDFAttachment_SetDescriptionWithContext(ctx goctx.Context, request *requests.FPDFAttachment_SetDescription) (*responses.FPDFAttachment_SetDescription, error)
+	FPDFAttachment_SetFileWithContext(ctx goctx.Context, request *requests.FPDFAttachment_SetFile) (*responses.FPDFAttachment_SetFile, error)
+	FPDFAttachment_SetStringValueWithContext(ctx goctx.Context, request *requests.FPDFAttachment_SetStringValue) (*responses.FPDFAttachment_SetStringValue, error)
+	FPDFAvail_CreateWithContext(ctx goctx.Context, request *requests.FPDFAvail_Create) (*responses.FPDFAvail_Create, error)
+	FPDFAvail_DestroyWithContext(ctx goctx.Context, request *requests.FPDFAvail_Destroy) (*responses.FPDFAvail_Destroy, error)
+	FPDFAvail_GetDocumentWithContext(ctx goctx.Context, request *requests.FPDFAvail_GetDocument) (*responses.FPDFAvail_GetDocument, error)
+	FPDFAvail_GetFirstPageNumWithContext(ctx goctx.Context, request *requests.FPDFAvail_GetFirstPageNum) (*responses.FPDFAvail_GetFirstPageNum, error)
+	FPDFAvail_IsDocAvailWithContext(ctx goctx.Context, request *requests.FPDFAvail_IsDocAvail) (*responses.FPDFAvail_IsDocAvail, error)
+	FPDFAvail_IsFormAvailWithContext(ctx goctx.Context, request *requests.FPDFAvail_IsFormAvail) (*responses.FPDFAvail_IsFormAvail, error)
+	FPDFAvail_IsLinearizedWithContext(ctx goctx.Context, request *requests.FPDFAvail_IsLinearized) (*responses.FPDFAvail_IsLinearized, error)
+	FPDFAvail_IsPageAvailWithContext(ctx goctx.Context, request *requests.FPDFAvail_IsPageAvail) (*responses.FPDFAvail_IsPageAvail, error)
+	FPDFBitmap_CreateWithContext(ctx goctx.Context, request *requests.FPDFBitmap_Create) (*responses.FPDFBitmap_Create, error)
+	FPDFBitmap_CreateExWithContext(ctx goctx.Context, request *requests.FPDFBitmap_CreateEx) (*responses.FPDFBitmap_CreateEx, error)
+	FPDFBitmap_DestroyWithContext(ctx goctx.Context, request *requests.FPDFBitmap_Destroy) (*responses.FPDFBitmap_Destroy, error)
+	FPDFBitmap_FillRectWithContext(ctx goctx.Context, request *requests.FPDFBitmap_FillRect) (*responses.FPDFBitmap_FillRect, error)
+	FPDFBitmap_GetBufferWithContext(ctx goctx.Context, request *requests.FPDFBitmap_GetBuffer) (*responses.FPDFBitmap_GetBuffer, error)
+	FPDFBitmap_GetFormatWithContext(ctx goctx.Context, request *requests.FPDFBitmap_GetFormat) (*responses.FPDFBitmap_GetFormat, error)
+	FPDFBitmap_GetHeightWithContext(ctx goctx.Context, request *requests.FPDFBitmap_GetHeight) (*responses.FPDFBitmap_GetHeight, error)
+	FPDFBitmap_GetStrideWithContext(ctx goctx.Context, request *requests.FPDFBitmap_GetStride) (*responses.FPDFBitmap_GetStride, error)
+	FPDFBitmap_GetWidthWithContext(ctx goctx.Context, request *requests.FPDFBitmap_GetWidth) (*responses.FPDFBitmap_GetWidth, error)
+	FPDFBookmark_FindWithContext(ctx goctx.Context, request *requests.FPDFBookmark_Find) (*responses.FPDFBookmark_Find, error)
+	FPDFBookmark_GetActionWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetAction) (*responses.FPDFBookmark_GetAction, error)
+	FPDFBookmark_GetColorWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetColor) (*responses.FPDFBookmark_GetColor, error)
+	FPDFBookmark_GetCountWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetCount) (*responses.FPDFBookmark_GetCount, error)
+	FPDFBookmark_GetDestWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetDest) (*responses.FPDFBookmark_GetDest, error)
+	FPDFBookmark_GetFirstChildWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetFirstChild) (*responses.FPDFBookmark_GetFirstChild, error)
+	FPDFBookmark_GetNextSiblingWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetNextSibling) (*responses.FPDFBookmark_GetNextSibling, error)
+	FPDFBookmark_GetTitleWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetTitle) (*responses.FPDFBookmark_GetTitle, error)
+	FPDFCatalog_GetLanguageWithContext(ctx goctx.Context, request *requests.FPDFCatalog_GetLanguage) (*responses.FPDFCatalog_GetLanguage, error)
+	FPDFCatalog_IsTaggedWithContext(ctx goctx.Context, request *requests.FPDFCatalog_IsTagged) (*responses.FPDFCatalog_IsTagged, error)
+	FPDFCatalog_SetLanguageWithContext(ctx goctx.Context, request *requests.FPDFCatalog_SetLanguage) (*responses.FPDFCatalog_SetLanguage, error)
+	FPDFClipPath_CountPathSegmentsWithContext(ctx goctx.Context, request *requests.FPDFClipPath_CountPathSegments) (*responses.FPDFClipPath_CountPathSegments, error)
+	FPDFClipPath_CountPathsWithContext(ctx goctx.Context, request *requests.FPDFClipPath_CountPaths) (*responses.FPDFClipPath_CountPaths, error)
+	FPDFClipPath_GetPathSegmentWithContext(ctx goctx.Context, request *requests.FPDFClipPath_GetPathSegment) (*responses.FPDFClipPath_GetPathSegment, error)
+	FPDFDOC_ExitFormFillEnvironmentWithContext(ctx goctx.Context, request *requests.FPDFDOC_ExitFormFillEnvironment) (*responses.FPDFDOC_ExitFormFillEnvironment, error)
+	FPDFDOC_InitFormFillEnvironmentWithContext(ctx goctx.Context, request *requests.FPDFDOC_InitFormFillEnvironment) (*responses.FPDFDOC_InitFormFillEnvironment, error)
+	FPDFDest_GetDestPageIndexWithContext(ctx goctx.Context, request *requests.FPDFDest_GetDestPageIndex) (*responses.FPDFDest_GetDestPageIndex, error)
+	FPDFDest_GetLocationInPageWithContext(ctx goctx.Context, request *requests.FPDFDest_GetLocationInPage) (*responses.FPDFDest_GetLocationInPage, error)
+	FPDFDest_GetViewWithContext(ctx goctx.Context, request *requests.FPDFDest_GetView) (*responses.FPDFDest_GetView, error)
+	FPDFDoc_AddAttachmentWithContext(ctx goctx.Context, request *requests.FPDFDoc_AddAttachment) (*responses.FPDFDoc_AddAttachment, error)
+	FPDFDoc_CloseJavaScriptActionWithContext(ctx goctx.Context, request *requests.FPDFDoc_CloseJavaScriptAction) (*responses.FPDFDoc_CloseJavaScriptAction, error)
+	FPDFDoc_DeleteAttachmentWithContext(ctx goctx.Context, request *requests.FPDFDoc_DeleteAttachment) (*responses.FPDFDoc_DeleteAttachment, error)
+	FPDFDoc_GetAttachmentWithContext(ctx goctx.Context, request *requests.FPDFDoc_GetAttachment) (*responses.FPDFDoc_GetAttachment, error)
+	FPDFDoc_GetAttachmentCountWithContext(ctx goctx.Context, request *requests.FPDFDoc_GetAttachmentCount) (*responses.FPDFDoc_GetAttachmentCount, error)
+	FPDFDoc_GetJavaScriptActionWithContext(ctx goctx.Context, request *requests.FPDFDoc_GetJavaScriptAction) (*responses.FPDFDoc_GetJavaScriptAction, error)
+	FPDFDoc_GetJavaScriptActionCountWithContext(ctx goctx.Context, request *requests.FPDFDoc_GetJavaScriptActionCount) (*responses.FPDFDoc_GetJavaScriptActionCount, error)
+	FPDFDoc_GetPageModeWithContext(ctx goctx.Context, request *requests.FPDFDoc_GetPageMode) (*responses.FPDFDoc_GetPageMode, error)
+	FPDFFont_CloseWithContext(ctx goctx.Context, request *requests.FPDFFont_Close) (*responses.FPDFFont_Close, error)
+	FPDFFont_GetAscentWithContext(ctx goctx.Context, request *requests.FPDFFont_GetAscent) (*responses.FPDFFont_GetAscent, error)
+	FPDFFont_GetBaseFontNameWithContext(ctx goctx.Context, request *requests.FPDFFont_GetBaseFontName) (*responses.FPDFFont_GetBaseFontName, error)
+	FPDFFont_GetDescentWithContext(ctx goctx.Context, request *requests.FPDFFont_GetDescent) (*responses.FPDFFont_GetDescent, error)
+	FPDFFont_GetFamilyNameWithContext(ctx goctx.Context, request *requests.FPDFFont_GetFamilyName) (*responses.FPDFFont_GetFamilyName, error)
+	FPDFFont_GetFlagsWithContext(ctx goctx.Context, request *requests.FPDFFont_GetFlags) (*responses.FPDFFont_GetFlags, error)
+	FPDFFont_GetFontDataWithContext(ctx goctx.Context, request *requests.FPDFFont_GetFontData) (*responses.FPDFFont_GetFontData, error)
+	FPDFFont_GetGlyphPathWithContext(ctx goctx.Context, request *requests.FPDFFont_GetGlyphPath) (*responses.FPDFFont_GetGlyphPath, error)
+	FPDFFont_GetGlyphWidthWithContext(ctx goctx.Context, request *requests.FPDFFont_GetGlyphWidth) (*responses.FPDFFont_GetGlyphWidth, error)
+	FPDFFont_GetIsEmbeddedWithContext(ctx goctx.Context, request *requests.FPDFFont_GetIsEmbedded) (*responses.FPDFFont_GetIsEmbedded, error)
+	FPDFFont_GetItalicAngleWithContext(ctx goctx.Context, request *requests.FPDFFont_GetItalicAngle) (*responses.FPDFFont_GetItalicAngle, error)
+	FPDFFont_GetWeightWithContext(ctx goctx.Context, request *requests.FPDFFont_GetWeight) (*responses.FPDFFont_GetWeight, error)
+	FPDFFormObj_CountObjectsWithContext(ctx goctx.Context, request *requests.FPDFFormObj_CountObjects) (*responses.FPDFFormObj_CountObjects, error)
+	FPDFFormObj_GetObjectWithContext(ctx goctx.Context, request *requests.FPDFFormObj_GetObject) (*responses.FPDFFormObj_GetObject, error)
+	FPDFFormObj_RemoveObjectWithContext(ctx goctx.Context, request *requests.FPDFFormObj_RemoveObject) (*responses.FPDFFormObj_RemoveObject, error)
+	FPDFGlyphPath_CountGlyphSegmentsWithContext(ctx goctx.Context, request *requests.FPDFGlyphPath_CountGlyphSegments) (*responses.FPDFGlyphPath_CountGlyphSegments, error)
+	FPDFGlyphPath_GetGlyphPathSegmentWithContext(ctx goctx.Context, request *requests.FPDFGlyphPath_GetGlyphPathSegment) (*responses.FPDFGlyphPath_GetGlyphPathSegment, error)
+	FPDFImageObj_GetBitmapWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetBitmap) (*responses.FPDFImageObj_GetBitmap, error)
+	FPDFImageObj_GetIccProfileDataDecodedWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetIccProfileDataDecoded) (*responses.FPDFImageObj_GetIccProfileDataDecoded, error)
+	FPDFImageObj_GetImageDataDecodedWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetImageDataDecoded) (*responses.FPDFImageObj_GetImageDataDecoded, error)
+	FPDFImageObj_GetImageDataRawWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetImageDataRaw) (*responses.FPDFImageObj_GetImageDataRaw, error)
+	FPDFImageObj_GetImageFilterWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetImageFilter) (*responses.FPDFImageObj_GetImageFilter, error)
+	FPDFImageObj_GetImageFilterCountWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetImageFilterCount) (*responses.FPDFImageObj_GetImageFilterCount, error)
+	FPDFImageObj_GetImageMetadataWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetImageMetadata) (*responses.FPDFImageObj_GetImageMetadata, error)
+	FPDFImageObj_GetImagePixelSizeWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetImagePixelSize) (*responses.FPDFImageObj_GetImagePixelSize, error)
+	FPDFImageObj_GetRenderedBitmapWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetRenderedBitmap) (*responses.FPDFImageObj_GetRenderedBitmap, error)
+	FPDFImageObj_LoadJpegFileWithContext(ctx goctx.Context, request *requests.FPDFImageObj_LoadJpegFile) (*responses.FPDFImageObj_LoadJpegFile, error)
+	FPDFImageObj_LoadJpegFileInlineWithContext(ctx goctx.Context, request *requests.FPDFImageObj_LoadJpegFileInline) (*responses.FPDFImageObj_LoadJpegFileInline, error)
+	FPDFImageObj_SetBitmapWithContext(ctx goctx.Context, request *requests.FPDFImageObj_SetBitmap) (*responses.FPDFImageObj_SetBitmap, error)
+	FPDFImageObj_SetMatrixWithContext(ctx goctx.Context, request *requests.FPDFImageObj_SetMatrix) (*responses.FPDFImageObj_SetMatrix, error)
+	FPDFJavaScriptAction_GetNameWithContext(ctx goctx.Context, request *requests.FPDFJavaScriptAction_GetName) (*responses.FPDFJavaScriptAction_GetName, error)
+	FPDFJavaScriptAction_GetScriptWithContext(ctx goctx.Context, request *requests.FPDFJavaScriptAction_GetScript) (*responses.FPDFJavaScriptAction_GetScript, error)
+	FPDFLink_CloseWebLinksWithContext(ctx goctx.Context, request *requests.FPDFLink_CloseWebLinks) (*responses.FPDFLink_CloseWebLinks, error)
+	FPDFLink_CountQuadPointsWithContext(ctx goctx.Context, request *requests.FPDFLink_CountQuadPoints) (*responses.FPDFLink_CountQuadPoints, error)
+	FPDFLink_CountRectsWithContext(ctx goctx.Context, request *requests.FPDFLink_CountRects) (*responses.FPDFLink_CountRects, error)
+	FPDFLink_CountWebLinksWithContext(ctx goctx.Context, request *requests.FPDFLink_CountWebLinks) (*responses.FPDFLink_CountWebLinks, error)
+	FPDFLink_EnumerateWithContext(ctx goctx.Context, request *requests.FPDFLink_Enumerate) (*responses.FPDFLink_Enumerate, error)
+	FPDFLink_GetActionWithContext(ctx goctx.Context, request *requests.FPDFLink_GetAction) (*responses.FPDFLink_GetAction, error)
+	FPDFLink_GetAnnotWithContext(ctx goctx.Context, request *requests.FPDFLink_GetAnnot) (*responses.FPDFLink_GetAnnot, error)
+	FPDFLink_GetAnnotRectWithContext(ctx goctx.Context, request *requests.FPDFLink_GetAnnotRect) (*responses.FPDFLink_GetAnnotRect, error)
+	FPDFLink_GetDestWithContext(ctx goctx.Context, request *requests.FPDFLink_GetDest) (*responses.FPDFLink_GetDest, error)
+	FPDFLink_GetLinkAtPointWithContext(ctx goctx.Context, request *requests.FPDFLink_GetLinkAtPoint) (*responses.FPDFLink_GetLinkAtPoint, error)
+	FPDFLink_GetLinkZOrderAtPointWithContext(ctx goctx.Context, request *requests.FPDFLink_GetLinkZOrderAtPoint) (*responses.FPDFLink_GetLinkZOrderAtPoint, error)
+	FPDFLink_GetQuadPointsWithContext(ctx goctx.Context, request *requests.FPDFLink_GetQuadPoints) (*responses.FPDFLink_GetQuadPoints, error)
+	FPDFLink_GetRectWithContext(ctx goctx.Context, request *requests.FPDFLink_GetRect) (*responses.FPDFLink_GetRect, error)
+	FPDFLink_GetTextRangeWithContext(ctx goctx.Context, request *requests.FPDFLink_GetTextRange) (*responses.FPDFLink_GetTextRange, error)
+	FPDFLink_GetURLWithContext(ctx goctx.Context, request *requests.FPDFLink_GetURL) (*responses.FPDFLink_GetURL, error)
+	FPDFLink_LoadWebLinksWithContext(ctx goctx.Context, request *requests.FPDFLink_LoadWebLinks) (*responses.FPDFLink_LoadWebLinks, error)
+	FPDFPageObjMark_CountParamsWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_CountParams) (*responses.FPDFPageObjMark_CountParams, error)
+	FPDFPageObjMark_GetNameWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetName) (*responses.FPDFPageObjMark_GetName, error)
+	FPDFPageObjMark_GetParamBlobValueWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetParamBlobValue) (*responses.FPDFPageObjMark_GetParamBlobValue, error)
+	FPDFPageObjMark_GetParamFloatValueWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetParamFloatValue) (*responses.FPDFPageObjMark_GetParamFloatValue, error)
+	FPDFPageObjMark_GetParamIntValueWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetParamIntValue) (*responses.FPDFPageObjMark_GetParamIntValue, error)
+	FPDFPageObjMark_GetParamKeyWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetParamKey) (*responses.FPDFPageObjMark_GetParamKey, error)
+	FPDFPageObjMark_GetParamStringValueWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetParamStringValue) (*responses.FPDFPageObjMark_GetParamStringValue, error)
+	FPDFPageObjMark_GetParamValueTypeWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetParamValueType) (*responses.FPDFPageObjMark_GetParamValueType, error)
+	FPDFPageObjMark_RemoveParamWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_RemoveParam) (*responses.FPDFPageObjMark_RemoveParam, error)
+	FPDFPageObjMark_SetBlobParamWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_SetBlobParam) (*responses.FPDFPageObjMark_SetBlobParam, error)
+	FPDFPageObjMark_SetFloatParamWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_SetFloatParam) (*responses.FPDFPageObjMark_SetFloatParam, error)
+	FPDFPageObjMark_SetIntParamWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_SetIntParam) (*responses.FPDFPageObjMark_SetIntParam, error)
+	FPDFPageObjMark_SetStringParamWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_SetStringParam) (*responses.FPDFPageObjMark_SetStringParam, error)
+	FPDFPageObj_AddExistingMarkWithContext(ctx goctx.Context, request *requests.FPDFPageObj_AddExistingMark) (*responses.FPDFPageObj_AddExistingMark, error)
+	FPDFPageObj_AddMarkWithContext(ctx goctx.Context, request *requests.FPDFPageObj_AddMark) (*responses.FPDFPageObj_AddMark, error)
+	FPDFPageObj_CountMarksWithContext(ctx goctx.Context, request *requests.FPDFPageObj_CountMarks) (*responses.FPDFPageObj_CountMarks, error)
+	FPDFPageObj_CreateNewPathWithContext(ctx goctx.Context, request *requests.FPDFPageObj_CreateNewPath) (*responses.FPDFPageObj_CreateNewPath, error)
+	FPDFPageObj_CreateNewRectWithContext(ctx goctx.Context, request *requests.FPDFPageObj_CreateNewRect) (*responses.FPDFPageObj_CreateNewRect, error)
+	FPDFPageObj_CreateTextObjWithContext(ctx goctx.Context, request *requests.FPDFPageObj_CreateTextObj) (*responses.FPDFPageObj_CreateTextObj, error)
+	FPDFPageObj_DestroyWithContext(ctx goctx.Context, request *requests.FPDFPageObj_Destroy) (*responses.FPDFPageObj_Destroy, error)
+	FPDFPageObj_GetBoundsWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetBounds) (*responses.FPDFPageObj_GetBounds, error)
+	FPDFPageObj_GetClipPathWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetClipPath) (*responses.FPDFPageObj_GetClipPath, error)
+	FPDFPageObj_GetDashArrayWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetDashArray) (*responses.FPDFPageObj_GetDashArray, error)
+	FPDFPageObj_GetDashCountWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetDashCount) (*responses.FPDFPageObj_GetDashCount, error)
+	FPDFPageObj_GetDashPhaseWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetDashPhase) (*responses.FPDFPageObj_GetDashPhase, error)
+	FPDFPageObj_GetFillColorWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetFillColor) (*responses.FPDFPageObj_GetFillColor, error)
+	FPDFPageObj_GetIsActiveWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetIsActive) (*responses.FPDFPageObj_GetIsActive, error)
+	FPDFPageObj_GetLineCapWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetLineCap) (*responses.FPDFPageObj_GetLineCap, error)
+	FPDFPageObj_GetLineJoinWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetLineJoin) (*responses.FPDFPageObj_GetLineJoin, error)
+	FPDFPageObj_GetMarkWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetMark) (*responses.FPDFPageObj_GetMark, error)
+	FPDFPageObj_GetMarkedContentIDWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetMarkedContentID) (*responses.FPDFPageObj_GetMarkedContentID, error)
+	FPDFPageObj_GetMatrixWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetMatrix) (*responses.FPDFPageObj_GetMatrix, error)
+	FPDFPageObj_GetRotatedBoundsWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetRotatedBounds) (*responses.FPDFPageObj_GetRotatedBounds, error)
+	FPDFPageObj_GetStrokeColorWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetStrokeColor) (*responses.FPDFPageObj_GetStrokeColor, error)
+	FPDFPageObj_GetStrokeWidthWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetStrokeWidth) (*responses.FPDFPageObj_GetStrokeWidth, error)
+	FPDFPageObj_GetTypeWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetType) (*responses.FPDFPageObj_GetType, error)
+	FPDFPageObj_HasTransparencyWithContext(ctx goctx.Context, request *requests.FPDFPageObj_HasTransparency) (*responses.FPDFPageObj_HasTransparency, error)
+	FPDFPageObj_NewImageObjWithContext(ctx goctx.Context, request *requests.FPDFPageObj_NewImageObj) (*responses.FPDFPageObj_NewImageObj, error)
+	FPDFPageObj_NewTextObjWithContext(ctx goctx.Context, request *requests.FPDFPageObj_NewTextObj) (*responses.FPDFPageObj_NewTextObj, error)
+	FPDFPageObj_RemoveMarkWithContext(ctx goctx.Context, request *requests.FPDFPageObj_RemoveMark) (*responses.FPDFPageObj_RemoveMark, error)
+	FPDFPageObj_SetBlendModeWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetBlendMode) (*responses.FPDFPageObj_SetBlendMode, error)
+	FPDFPageObj_SetDashArrayWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetDashArray) (*responses.FPDFPageObj_SetDashArray, error)
+	FPDFPageObj_SetDashPhaseWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetDashPhase) (*responses.FPDFPageObj_SetDashPhase, error)
+	FPDFPageObj_SetFillColorWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetFillColor) (*responses.FPDFPageObj_SetFillColor, error)
+	FPDFPageObj_SetIsActiveWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetIsActive) (*responses.FPDFPageObj_SetIsActive, error)
+	FPDFPageObj_SetLineCapWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetLineCap) (*responses.FPDFPageObj_SetLineCap, error)
+	FPDFPageObj_SetLineJoinWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetLineJoin) (*responses.FPDFPageObj_SetLineJoin, error)
+	FPDFPageObj_SetMatrixWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetMatrix) (*responses.FPDFPageObj_SetMatrix, error)
+	FPDFPageObj_SetStrokeColorWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetStrokeColor) (*responses.FPDFPageObj_SetStrokeColor, error)
+	FPDFPageObj_SetStrokeWidthWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetStrokeWidth) (*responses.FPDFPageObj_SetStrokeWidth, error)
+	FPDFPageObj_TransformWithContext(ctx goctx.Context, request *requests.FPDFPageObj_Transform) (*responses.FPDFPageObj_Transform, error)
+	FPDFPageObj_TransformClipPathWithContext(ctx goctx.Context, request *requests.FPDFPageObj_TransformClipPath) (*responses.FPDFPageObj_TransformClipPath, error)
+	FPDFPageObj_TransformFWithContext(ctx goctx.Context, request *requests.FPDFPageObj_TransformF) (*responses.FPDFPageObj_TransformF, error)
+	FPDFPage_CloseAnnotWithContext(ctx goctx.Context, request *requests.FPDFPage_CloseAnnot) (*responses.FPDFPage_CloseAnnot, error)
+	FPDFPage_CountObjectsWithContext(ctx goctx.Context, request *requests.FPDFPage_CountObjects) (*responses.FPDFPage_CountObjects, error)
+	FPDFPage_CreateAnnotWithContext(ctx goctx.Context, request *requests.FPDFPage_CreateAnnot) (*responses.FPDFPage_CreateAnnot, error)
+	FPDFPage_DeleteWithContext(ctx goctx.Context, request *requests.FPDFPage_Delete) (*responses.FPDFPage_Delete, error)
+	FPDFPage_FlattenWithContext(ctx goctx.Context, request *requests.FPDFPage_Flatten) (*responses.FPDFPage_Flatten, error)
+	FPDFPage_FormFieldZOrderAtPointWithContext(ctx goctx.Context, request *requests.FPDFPage_FormFieldZOrderAtPoint) (*responses.FPDFPage_FormFieldZOrderAtPoint, error)
+	FPDFPage_GenerateContentWithContext(ctx goctx.Context, request *requests.FPDFPage_GenerateContent) (*responses.FPDFPage_GenerateContent, error)
+	FPDFPage_GetAnnotWithContext(ctx goctx.Context, request *requests.FPDFPage_GetAnnot) (*responses.FPDFPage_GetAnnot, error)
+	FPDFPage_GetAnnotCountWithContext(ctx goctx.Context, request *requests.FPDFPage_GetAnnotCount) (*responses.FPDFPage_GetAnnotCount, error)
+	FPDFPage_GetAnnotIndexWithContext(ctx goctx.Context, request *requests.FPDFPage_GetAnnotIndex) (*responses.FPDFPage_GetAnnotIndex, error)
+	FPDFPage_GetArtBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_GetArtBox) (*responses.FPDFPage_GetArtBox, error)
+	FPDFPage_GetBleedBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_GetBleedBox) (*responses.FPDFPage_GetBleedBox, error)
+	FPDFPage_GetCropBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_GetCropBox) (*responses.FPDFPage_GetCropBox, error)
+	FPDFPage_GetDecodedThumbnailDataWithContext(ctx goctx.Context, request *requests.FPDFPage_GetDecodedThumbnailData) (*responses.FPDFPage_GetDecodedThumbnailData, error)
+	FPDFPage_GetMediaBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_GetMediaBox) (*responses.FPDFPage_GetMediaBox, error)
+	FPDFPage_GetObjectWithContext(ctx goctx.Context, request *requests.FPDFPage_GetObject) (*responses.FPDFPage_GetObject, error)
+	FPDFPage_GetRawThumbnailDataWithContext(ctx goctx.Context, request *requests.FPDFPage_GetRawThumbnailData) (*responses.FPDFPage_GetRawThumbnailData, error)
+	FPDFPage_GetRotationWithContext(ctx goctx.Context, request *requests.FPDFPage_GetRotation) (*responses.FPDFPage_GetRotation, error)
+	FPDFPage_GetThumbnailAsBitmapWithContext(ctx goctx.Context, request *requests.FPDFPage_GetThumbnailAsBitmap) (*responses.FPDFPage_GetThumbnailAsBitmap, error)
+	FPDFPage_GetTrimBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_GetTrimBox) (*responses.FPDFPage_GetTrimBox, error)
+	FPDFPage_HasFormFieldAtPointWithContext(ctx goctx.Context, request *requests.FPDFPage_HasFormFieldAtPoint) (*responses.FPDFPage_HasFormFieldAtPoint, error)
+	FPDFPage_HasTransparencyWithContext(ctx goctx.Context, request *requests.FPDFPage_HasTransparency) (*responses.FPDFPage_HasTransparency, error)
+	FPDFPage_InsertClipPathWithContext(ctx goctx.Context, request *requests.FPDFPage_InsertClipPath) (*responses.FPDFPage_InsertClipPath, error)
+	FPDFPage_InsertObjectWithContext(ctx goctx.Context, request *requests.FPDFPage_InsertObject) (*responses.FPDFPage_InsertObject, error)
+	FPDFPage_InsertObjectAtIndexWithContext(ctx goctx.Context, request *requests.FPDFPage_InsertObjectAtIndex) (*responses.FPDFPage_InsertObjectAtIndex, error)
+	FPDFPage_NewWithContext(ctx goctx.Context, request *requests.FPDFPage_New) (*responses.FPDFPage_New, error)
+	FPDFPage_RemoveAnnotWithContext(ctx goctx.Context, request *requests.FPDFPage_RemoveAnnot) (*responses.FPDFPage_RemoveAnnot, error)
+	FPDFPage_RemoveObjectWithContext(ctx goctx.Context, request *requests.FPDFPage_RemoveObject) (*responses.FPDFPage_RemoveObject, error)
+	FPDFPage_SetArtBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_SetArtBox) (*responses.FPDFPage_SetArtBox, error)
+	FPDFPage_SetBleedBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_SetBleedBox) (*responses.FPDFPage_SetBleedBox, error)
+	FPDFPage_SetCropBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_SetCropBox) (*responses.FPDFPage_SetCropBox, error)
+	FPDFPage_SetMediaBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_SetMediaBox) (*responses.FPDFPage_SetMediaBox, error)
+	FPDFPage_SetRotationWithContext(ctx goctx.Context, request *requests.FPDFPage_SetRotation) (*responses.FPDFPage_SetRotation, error)
+	FPDFPage_SetTrimBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_SetTrimBox) (*responses.FPDFPage_SetTrimBox, error)
+	FPDFPage_TransFormWithClipWithContext(ctx goctx.Context, request *requests.FPDFPage_TransFormWithClip) (*responses.FPDFPage_TransFormWithClip, error)
+	FPDFPage_TransformAnnotsWithContext(ctx goctx.Context, request *requests.FPDFPage_TransformAnnots) (*responses.FPDFPage_TransformAnnots, error)
+	FPDFPathSegment_GetCloseWithContext(ctx goctx.Context, request *requests.FPDFPathSegment_GetClose) (*responses.FPDFPathSegment_GetClose, error)
+	FPDFPathSegment_GetPointWithContext(ctx goctx.Context, request *requests.FPDFPathSegment_GetPoint) (*responses.FPDFPathSegment_GetPoint, error)
+	FPDFPathSegment_GetTypeWithContext(ctx goctx.Context, request *requests.FPDFPathSegment_GetType) (*responses.FPDFPathSegment_GetType, error)
+	FPDFPath_BezierToWithContext(ctx goctx.Context, request *requests.FPDFPath_BezierTo) (*responses.FPDFPath_BezierTo, error)
+	FPDFPath_CloseWithContext(ctx goctx.Context, request *requests.FPDFPath_Close) (*responses.FPDFPath_Close, error)
+	FPDFPath_CountSegmentsWithContext(ctx goctx.Context, request *requests.FPDFPath_CountSegments) (*responses.FPDFPath_CountSegments, error)
+	FPDFPath_GetDrawModeWithContext(ctx goctx.Context, request *requests.FPDFPath_GetDrawMode) (*responses.FPDFPath_GetDrawMode, error)
+	FPDFPath_GetPathSegmentWithContext(ctx goctx.Context, request *requests.FPDFPath_GetPathSegment) (*responses.FPDFPath_GetPathSegment, error)
+	FPDFPath_LineToWithContext(ctx goctx.Context, request *requests.FPDFPath_LineTo) (*responses.FPDFPath_LineTo, error)
+	FPDFPath_MoveToWithContext(ctx goctx.Context, request *requests.FPDFPath_MoveTo) (*responses.FPDFPath_MoveTo, error)
+	FPDFPath_SetDrawModeWithContext(ctx goctx.Context, request *requests.FPDFPath_SetDrawMode) (*responses.FPDFPath_SetDrawMode, error)
+	FPDFSignatureObj_GetByteRangeWithContext(ctx goctx.Context, request *requests.FPDFSignatureObj_GetByteRange) (*responses.FPDFSignatureObj_GetByteRange, error)
+	FPDFSignatureObj_GetContentsWithContext(ctx goctx.Context, request *requests.FPDFSignatureObj_GetContents) (*responses.FPDFSignatureObj_GetContents, error)
+	FPDFSignatureObj_GetDocMDPPermissionWithContext(ctx goctx.Context, request *requests.FPDFSignatureObj_GetDocMDPPermission) (*responses.FPDFSignatureObj_GetDocMDPPermission, error)
+	FPDFSignatureObj_GetReasonWithContext(ctx goctx.Context, request *requests.FPDFSignatureObj_GetReason) (*responses.FPDFSignatureObj_GetReason, error)
+	FPDFSignatureObj_GetSubFilterWithContext(ctx goctx.Context, request *requests.FPDFSignatureObj_GetSubFilter) (*responses.FPDFSignatureObj_GetSubFilter, error)
+	FPDFSignatureObj_GetTimeWithContext(ctx goctx.Context, request *requests.FPDFSignatureObj_GetTime) (*responses.FPDFSignatureObj_GetTime, error)
+	FPDFTextObj_GetFontWithContext(ctx goctx.Context, request *requests.FPDFTextObj_GetFont) (*responses.FPDFTextObj_GetFont, error)
+	FPDFTextObj_GetFontSizeWithContext(ctx goctx.Context, request *requests.FPDFTextObj_GetFontSize) (*responses.FPDFTextObj_GetFontSize, error)
+	FPDFTextObj_GetRenderedBitmapWithContext(ctx goctx.Context, request *requests.FPDFTextObj_GetRenderedBitmap) (*responses.FPDFTextObj_GetRenderedBitmap, error)
+	FPDFTextObj_GetTextWithContext(ctx goctx.Context, request *requests.FPDFTextObj_GetText) (*responses.FPDFTextObj_GetText, error)
+	FPDFTextObj_GetTextRenderModeWithContext(ctx goctx.Context, request *requests.FPDFTextObj_GetTextRenderMode) (*responses.FPDFTextObj_GetTextRenderMode, error)
+	FPDFTextObj_SetFontSizeWithContext(ctx goctx.Context, request *requests.FPDFTextObj_SetFontSize) (*responses.FPDFTextObj_SetFontSize, error)
+	FPDFTextObj_SetTextRenderModeWithContext(ctx goctx.Context, request *requests.FPDFTextObj_SetTextRenderMode) (*responses.FPDFTextObj_SetTextRenderMode, error)
+	FPDFText_ClosePageWithContext(ctx goctx.Context, request *requests.FPDFText_ClosePage) (*responses.FPDFText_ClosePage, error)
+	FPDFText_CountCharsWithContext(ctx goctx.Context, request *requests.FPDFText_CountChars) (*responses.FPDFText_CountChars, error)
+	FPDFText_CountRectsWithContext(ctx goctx.Context, request *requests.FPDFText_CountRects) (*responses.FPDFText_CountRects, error)
+	FPDFText_FindCloseWithContext(ctx goctx.Context, request *requests.FPDFText_FindClose) (*responses.FPDFText_FindClose, error)
+	FPDFText_FindNextWithContext(ctx goctx.Context, request *requests.FPDFText_FindNext) (*responses.FPDFText_FindNext, error)
+	FPDFText_FindPrevWithContext(ctx goctx.Context, request *requests.FPDFText_FindPrev) (*responses.FPDFText_FindPrev, error)
+	FPDFText_FindStartWithContext(ctx goctx.Context, request *requests.FPDFText_FindStart) (*responses.FPDFText_FindStart, error)
+	FPDFText_GetBoundedTextWithContext(ctx goctx.Context, request *requests.FPDFText_GetBoundedText) (*responses.FPDFText_GetBoundedText, error)
+	FPDFText_GetCharAngleWithContext(ctx goctx.Context, request *requests.FPDFText_GetCharAngle) (*responses.FPDFText_GetCharAngle, error)
+	FPDFText_GetCharBoxWithContext(ctx goctx.Context, request *requests.FPDFText_GetCharBox) (*responses.FPDFText_GetCharBox, error)
+	FPDFText_GetCharIndexAtPosWithContext(ctx goctx.Context, request *requests.FPDFText_GetCharIndexAtPos) (*responses.FPDFText_GetCharIndexAtPos, error)
+	FPDFText_GetCharIndexFromTextIndexWithContext(ctx goctx.Context, request *requests.FPDFText_GetCharIndexFromTextIndex) (*responses.FPDFText_GetCharIndexFromTextIndex, error)
+	FPDFText_GetCharOriginWithContext(ctx goctx.Context, request *requests.FPDFText_GetCharOrigin) (*responses.FPDFText_GetCharOrigin, error)
+	FPDFText_GetFillColorWithContext(ctx goctx.Context, request *requests.FPDFText_GetFillColor) (*responses.FPDFText_GetFillColor, error)
+	FPDFText_GetFontInfoWithContext(ctx goctx.Context, request *requests.FPDFText_GetFontInfo) (*responses.FPDFText_GetFontInfo, error)
+	FPDFText_GetFontSizeWithContext(ctx goctx.Context, request *requests.FPDFText_GetFontSize) (*responses.FPDFText_GetFontSize, error)
+	FPDFText_GetFontWeightWithContext(ctx goctx.Context, request *requests.FPDFText_GetFontWeight) (*responses.FPDFText_GetFontWeight, error)
+	FPDFText_GetLooseCharBoxWithContext(ctx goctx.Context, request *requests.FPDFText_GetLooseCharBox) (*responses.FPDFText_GetLooseCharBox, error)
+	FPDFText_GetMatrixWithContext(ctx goctx.Context, request *requests.FPDFText_GetMatrix) (*responses.FPDFText_GetMatrix, error)
+	FPDFText_GetRectWithContext(ctx goctx.Context, request *requests.FPDFText_GetRect) (*responses.FPDFText_GetRect, error)
+	FPDFText_GetSchCountWithContext(ctx goctx.Context, request *requests.FPDFText_GetSchCount) (*responses.FPDFText_GetSchCount, error)
+	FPDFText_GetSchResultIndexWithContext(ctx goctx.Context, request *requests.FPDFText_GetSchResultIndex) (*responses.FPDFText_GetSchResultIndex, error)
+	FPDFText_GetStrokeColorWithContext(ctx goctx.Context, request *requests.FPDFText_GetStrokeColor) (*responses.FPDFText_GetStrokeColor, error)
+	FPDFText_GetTextWithContext(ctx goctx.Context, request *requests.FPDFText_GetText) (*responses.FPDFText_GetText, error)
+	FPDFText_GetTextIndexFromCharIndexWithContext(ctx goctx.Context, request *requests.FPDFText_GetTextIndexFromCharIndex) (*responses.FPDFText_GetTextIndexFromCharIndex, error)
+	FPDFText_GetTextObjectWithContext(ctx goctx.Context, request *requests.FPDFText_GetTextObject) (*responses.FPDFText_GetTextObject, error)
+	FPDFText_GetUnicodeWithContext(ctx goctx.Context, request *requests.FPDFText_GetUnicode) (*responses.FPDFText_GetUnicode, error)
+	FPDFText_HasUnicodeMapErrorWithContext(ctx goctx.Context, request *requests.FPDFText_HasUnicodeMapError) (*responses.FPDFText_HasUnicodeMapError, error)
+	FPDFText_IsGeneratedWithContext(ctx goctx.Context, request *requests.FPDFText_IsGenerated) (*responses.FPDFText_IsGenerated, error)
+	FPDFText_IsHyphenWithContext(ctx goctx.Context, request *requests.FPDFText_IsHyphen) (*responses.FPDFText_IsHyphen, error)
+	FPDFText_LoadCidType2FontWithContext(ctx goctx.Context, request *requests.FPDFText_LoadCidType2Font) (*responses.FPDFText_LoadCidType2Font, error)
+	FPDFText_LoadFontWithContext(ctx goctx.Context, request *requests.FPDFText_LoadFont) (*responses.FPDFText_LoadFont, error)
+	FPDFText_LoadPageWithContext(ctx goctx.Context, request *requests.FPDFText_LoadPage) (*responses.FPDFText_LoadPage, error)
+	FPDFText_LoadStandardFontWithContext(ctx goctx.Context, request *requests.FPDFText_LoadStandardFont) (*responses.FPDFText_LoadStandardFont, error)
+	FPDFText_SetCharcodesWithContext(ctx goctx.Context, request *requests.FPDFText_SetCharcodes) (*responses.FPDFText_SetCharcodes, error)
+	FPDFText_SetPositionsWithContext(ctx goctx.Context, request *requests.FPDFText_SetPositions) (*responses.FPDFText_SetPositions, error)
+	FPDFText_SetTextWithContext(ctx goctx.Context, request *requests.FPDFText_SetText) (*responses.FPDFText_SetText, error)
+	FPDF_CloseDocumentWithContext(ctx goctx.Context, request *requests.FPDF_CloseDocument) (*responses.FPDF_CloseDocument, error)
+	FPDF_ClosePageWithContext(ctx goctx.Context, request *requests.FPDF_ClosePage) (*responses.FPDF_ClosePage, error)
+	FPDF_CloseXObjectWithContext(ctx goctx.Context, request *requests.FPDF_CloseXObject) (*responses.FPDF_CloseXObject, error)
+	FPDF_CopyViewerPreferencesWithContext(ctx goctx.Context, request *requests.FPDF_CopyViewerPreferences) (*responses.FPDF_CopyViewerPreferences, error)
+	FPDF_CountNamedDestsWithContext(ctx goctx.Context, request *requests.FPDF_CountNamedDests) (*responses.FPDF_CountNamedDests, error)
+	FPDF_CreateClipPathWithContext(ctx goctx.Context, request *requests.FPDF_CreateClipPath) (*responses.FPDF_CreateClipPath, error)
+	FPDF_CreateNewDocumentWithContext(ctx goctx.Context, request *requests.FPDF_CreateNewDocument) (*responses.FPDF_CreateNewDocument, error)
+	FPDF_DestroyClipPathWithContext(ctx goctx.Context, request *requests.FPDF_DestroyClipPath) (*responses.FPDF_DestroyClipPath, error)
+	FPDF_DeviceToPageWithContext(ctx goctx.Context, request *requests.FPDF_DeviceToPage) (*responses.FPDF_DeviceToPage, error)
+	FPDF_DocumentHasValidCrossReferenceTableWithContext(ctx goctx.Context, request *requests.FPDF_DocumentHasValidCrossReferenceTable) (*responses.FPDF_DocumentHasValidCrossReferenceTable, error)
+	FPDF_FFLDrawWithContext(ctx goctx.Context, request *requests.FPDF_FFLDraw) (*responses.FPDF_FFLDraw, error)
+	FPDF_GetDocPermissionsWithContext(ctx goctx.Context, request *requests.FPDF_GetDocPermissions) (*responses.FPDF_GetDocPermissions, error)
+	FPDF_GetDocUserPermissionsWithContext(ctx goctx.Context, request *requests.FPDF_GetDocUserPermissions) (*responses.FPDF_GetDocUserPermissions, error)
+	FPDF_GetFileIdentifierWithContext(ctx goctx.Context, request *requests.FPDF_GetFileIdentifier) (*responses.FPDF_GetFileIdentifier, error)
+	FPDF_GetFileVersionWithContext(ctx goctx.Context, request *requests.FPDF_GetFileVersion) (*responses.FPDF_GetFileVersion, error)
+	FPDF_GetFormTypeWithContext(ctx goctx.Context, request *requests.FPDF_GetFormType) (*responses.FPDF_GetFormType, error)
+	FPDF_GetLastErrorWithContext(ctx goctx.Context, request *requests.FPDF_GetLastError) (*responses.FPDF_GetLastError, error)
+	FPDF_GetMetaTextWithContext(ctx goctx.Context, request *requests.FPDF_GetMetaText) (*responses.FPDF_GetMetaText, error)
+	FPDF_GetNamedDestWithContext(ctx goctx.Context, request *requests.FPDF_GetNamedDest) (*responses.FPDF_GetNamedDest, error)
+	FPDF_GetNamedDestByNameWithContext(ctx goctx.Context, request *requests.FPDF_GetNamedDestByName) (*responses.FPDF_GetNamedDestByName, error)
+	FPDF_GetPageAActionWithContext(ctx goctx.Context, request *requests.FPDF_GetPageAAction) (*responses.FPDF_GetPageAAction, error)
+	FPDF_GetPageBoundingBoxWithContext(ctx goctx.Context, request *requests.FPDF_GetPageBoundingBox) (*responses.FPDF_GetPageBoundingBox, error)
+	FPDF_GetPageCountWithContext(ctx goctx.Context, request *requests.FPDF_GetPageCount) (*responses.FPDF_GetPageCount, error)
+	FPDF_GetPageHeightWithContext(ctx goctx.Context, request *requests.FPDF_GetPageHeight) (*responses.FPDF_GetPageHeight, error)
+	FPDF_GetPageHeightFWithContext(ctx goctx.Context, request *requests.FPDF_GetPageHeightF) (*responses.FPDF_GetPageHeightF, error)
+	FPDF_GetPageLabelWithContext(ctx goctx.Context, request *requests.FPDF_GetPageLabel) (*responses.FPDF_GetPageLabel, error)
+	FPDF_GetPageSizeByIndexWithContext(ctx goctx.Context, request *requests.FPDF_GetPageSizeByIndex) (*responses.FPDF_GetPageSizeByIndex, error)
+	FPDF_GetPageSizeByIndexFWithContext(ctx goctx.Context, request *requests.FPDF_GetPageSizeByIndexF) (*responses.FPDF_GetPageSizeByIndexF, error)
+	FPDF_GetPageWidthWithContext(ctx goctx.Context, request *requests.FPDF_GetPageWidth) (*responses.FPDF_GetPageWidth, error)
+	FPDF_GetPageWidthFWithContext(ctx goctx.Context, request *requests.FPDF_GetPageWidthF) (*responses.FPDF_GetPageWidthF, error)
+	FPDF_GetSecurityHandlerRevisionWithContext(ctx goctx.Context, request *requests.FPDF_GetSecurityHandlerRevision) (*responses.FPDF_GetSecurityHandlerRevision, error)
+	FPDF_GetSignatureCountWithContext(ctx goctx.Context, request *requests.FPDF_GetSignatureCount) (*responses.FPDF_GetSignatureCount, error)
+	FPDF_GetSignatureObjectWithContext(ctx goctx.Context, request *requests.FPDF_GetSignatureObject) (*responses.FPDF_GetSignatureObject, error)
+	FPDF_GetTrailerEndsWithContext(ctx goctx.Context, request *requests.FPDF_GetTrailerEnds) (*responses.FPDF_GetTrailerEnds, error)
+	FPDF_GetXFAPacketContentWithContext(ctx goctx.Context, request *requests.FPDF_GetXFAPacketContent) (*responses.FPDF_GetXFAPacketContent, error)
+	FPDF_GetXFAPacketCountWithContext(ctx goctx.Context, request *requests.FPDF_GetXFAPacketCount) (*responses.FPDF_GetXFAPacketCount, error)
+	FPDF_GetXFAPacketNameWithContext(ctx goctx.Context, request *requests.FPDF_GetXFAPacketName) (*responses.FPDF_GetXFAPacketName, error)
+	FPDF_ImportNPagesToOneWithContext(ctx goctx.Context, request *requests.FPDF_ImportNPagesToOne) (*responses.FPDF_ImportNPagesToOne, error)
+	FPDF_ImportPagesWithContext(ctx goctx.Context, request *requests.FPDF_ImportPages) (*responses.FPDF_ImportPages, error)
+	FPDF_ImportPagesByIndexWithContext(ctx goctx.Context, request *requests.FPDF_ImportPagesByIndex) (*responses.FPDF_ImportPagesByIndex, error)
+	FPDF_LoadCustomDocumentWithContext(ctx goctx.Context, request *requests.FPDF_LoadCustomDocument) (*responses.FPDF_LoadCustomDocument, error)
+	FPDF_LoadDocumentWithContext(ctx goctx.Context, request *requests.FPDF_LoadDocument) (*responses.FPDF_LoadDocument, error)
+	FPDF_LoadMemDocumentWithContext(ctx goctx.Context, request *requests.FPDF_LoadMemDocument) (*responses.FPDF_LoadMemDocument, error)
+	FPDF_LoadMemDocument64WithContext(ctx goctx.Context, request *requests.FPDF_LoadMemDocument64) (*responses.FPDF_LoadMemDocument64, error)
+	FPDF_LoadPageWithContext(ctx goctx.Context, request *requests.FPDF_LoadPage) (*responses.FPDF_LoadPage, error)
+	FPDF_LoadXFAWithContext(ctx goctx.Context, request *requests.FPDF_LoadXFA) (*responses.FPDF_LoadXFA, error)
+	FPDF_MovePagesWithContext(ctx goctx.Context, request *requests.FPDF_MovePages) (*responses.FPDF_MovePages, error)
+	FPDF_NewFormObjectFromXObjectWithContext(ctx goctx.Context, request *requests.FPDF_NewFormObjectFromXObject) (*responses.FPDF_NewFormObjectFromXObject, error)
+	FPDF_NewXObjectFromPageWithContext(ctx goctx.Context, request *requests.FPDF_NewXObjectFromPage) (*responses.FPDF_NewXObjectFromPage, error)
+	FPDF_PageToDeviceWithContext(ctx goctx.Context, request *requests.FPDF_PageToDevice) (*responses.FPDF_PageToDevice, error)
+	FPDF_RemoveFormFieldHighlightWithContext(ctx goctx.Context, request *requests.FPDF_RemoveFormFieldHighlight) (*responses.FPDF_RemoveFormFieldHighlight, error)
+	FPDF_RenderPageWithContext(ctx goctx.Context, request *requests.FPDF_RenderPage) (*responses.FPDF_RenderPage, error)
+	FPDF_RenderPageBitmapWithContext(ctx goctx.Context, request *requests.FPDF_RenderPageBitmap) (*responses.FPDF_RenderPageBitmap, error)
+	FPDF_RenderPageBitmapWithColorScheme_StartWithContext(ctx goctx.Context, request *requests.FPDF_RenderPageBitmapWithColorScheme_Start) (*responses.FPDF_RenderPageBitmapWithColorScheme_Start, error)
+	FPDF_RenderPageBitmapWithMatrixWithContext(ctx goctx.Context, request *requests.FPDF_RenderPageBitmapWithMatrix) (*responses.FPDF_RenderPageBitmapWithMatrix, error)
+	FPDF_RenderPageBitmap_StartWithContext(ctx goctx.Context, request *requests.FPDF_RenderPageBitmap_Start) (*responses.FPDF_RenderPageBitmap_Start, error)
+	FPDF_RenderPage_CloseWithContext(ctx goctx.Context, request *requests.FPDF_RenderPage_Close) (*responses.FPDF_RenderPage_Close, error)
+	FPDF_RenderPage_ContinueWithContext(ctx goctx.Context, request *requests.FPDF_RenderPage_Continue) (*responses.FPDF_RenderPage_Continue, error)
+	FPDF_SaveAsCopyWithContext(ctx goctx.Context, request *requests.FPDF_SaveAsCopy) (*responses.FPDF_SaveAsCopy, error)
+	FPDF_SaveWithVersionWithContext(ctx goctx.Context, request *requests.FPDF_SaveWithVersion) (*responses.FPDF_SaveWithVersion, error)
+	FPDF_SetFormFieldHighlightAlphaWithContext(ctx goctx.Context, request *requests.FPDF_SetFormFieldHighlightAlpha) (*responses.FPDF_SetFormFieldHighlightAlpha, error)
+	FPDF_SetFormFieldHighlightColorWithContext(ctx goctx.Context, request *requests.FPDF_SetFormFieldHighlightColor) (*responses.FPDF_SetFormFieldHighlightColor, error)
+	FPDF_SetPrintModeWithContext(ctx goctx.Context, request *requests.FPDF_SetPrintMode) (*responses.FPDF_SetPrintMode, error)
+	FPDF_SetSandBoxPolicyWithContext(ctx goctx.Context, request *requests.FPDF_SetSandBoxPolicy) (*responses.FPDF_SetSandBoxPolicy, error)
+	FPDF_StructElement_Attr_CountChildrenWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_CountChildren) (*responses.FPDF_StructElement_Attr_CountChildren, error)
+	FPDF_StructElement_Attr_GetBlobValueWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetBlobValue) (*responses.FPDF_StructElement_Attr_GetBlobValue, error)
+	FPDF_StructElement_Attr_GetBooleanValueWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetBooleanValue) (*responses.FPDF_StructElement_Attr_GetBooleanValue, error)
+	FPDF_StructElement_Attr_GetChildAtIndexWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetChildAtIndex) (*responses.FPDF_StructElement_Attr_GetChildAtIndex, error)
+	FPDF_StructElement_Attr_GetCountWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetCount) (*responses.FPDF_StructElement_Attr_GetCount, error)
+	FPDF_StructElement_Attr_GetNameWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetName) (*responses.FPDF_StructElement_Attr_GetName, error)
+	FPDF_StructElement_Attr_GetNumberValueWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetNumberValue) (*responses.FPDF_StructElement_Attr_GetNumberValue, error)
+	FPDF_StructElement_Attr_GetStringValueWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetStringValue) (*responses.FPDF_StructElement_Attr_GetStringValue, error)
+	FPDF_StructElement_Attr_GetTypeWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetType) (*responses.FPDF_StructElement_Attr_GetType, error)
+	FPDF_StructElement_Attr_GetValueWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetValue) (*responses.FPDF_StructElement_Attr_GetValue, error)
+	FPDF_StructElement_CountChildrenWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_CountChildren) (*responses.FPDF_StructElement_CountChildren, error)
+	FPDF_StructElement_GetActualTextWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetActualText) (*responses.FPDF_StructElement_GetActualText, error)
+	FPDF_StructElement_GetAltTextWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetAltText) (*responses.FPDF_StructElement_GetAltText, error)
+	FPDF_StructElement_GetAttributeAtIndexWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetAttributeAtIndex) (*responses.FPDF_StructElement_GetAttributeAtIndex, error)
+	FPDF_StructElement_GetAttributeCountWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetAttributeCount) (*responses.FPDF_StructElement_GetAttributeCount, error)
+	FPDF_StructElement_GetChildAtIndexWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetChildAtIndex) (*responses.FPDF_StructElement_GetChildAtIndex, error)
+	FPDF_StructElement_GetChildMarkedContentIDWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetChildMarkedContentID) (*responses.FPDF_StructElement_GetChildMarkedContentID, error)
+	FPDF_StructElement_GetExpansionWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetExpansion) (*responses.FPDF_StructElement_GetExpansion, error)
+	FPDF_StructElement_GetIDWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetID) (*responses.FPDF_StructElement_GetID, error)
+	FPDF_StructElement_GetLangWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetLang) (*responses.FPDF_StructElement_GetLang, error)
+	FPDF_StructElement_GetMarkedContentIDWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetMarkedContentID) (*responses.FPDF_StructElement_GetMarkedContentID, error)
+	FPDF_StructElement_GetMarkedContentIdAtIndexWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetMarkedContentIdAtIndex) (*responses.FPDF_StructElement_GetMarkedContentIdAtIndex, error)
+	FPDF_StructElement_GetMarkedContentIdCountWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetMarkedContentIdCount) (*responses.FPDF_StructElement_GetMarkedContentIdCount, error)
+	FPDF_StructElement_GetObjTypeWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetObjType) (*responses.FPDF_StructElement_GetObjType, error)
+	FPDF_StructElement_GetParentWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetParent) (*responses.FPDF_StructElement_GetParent, error)
+	FPDF_StructElement_GetStringAttributeWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetStringAttribute) (*responses.FPDF_StructElement_GetStringAttribute, error)
+	FPDF_StructElement_GetTitleWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetTitle) (*responses.FPDF_StructElement_GetTitle, error)
+	FPDF_StructElement_GetTypeWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetType) (*responses.FPDF_StructElement_GetType, error)
+	FPDF_StructTree_CloseWithContext(ctx goctx.Context, request *requests.FPDF_StructTree_Close) (*responses.FPDF_StructTree_Close, error)
+	FPDF_StructTree_CountChildrenWithContext(ctx goctx.Context, request *requests.FPDF_StructTree_CountChildren) (*responses.FPDF_StructTree_CountChildren, error)
+	FPDF_StructTree_GetChildAtIndexWithContext(ctx goctx.Context, request *requests.FPDF_StructTree_GetChildAtIndex) (*responses.FPDF_StructTree_GetChildAtIndex, error)
+	FPDF_StructTree_GetForPageWithContext(ctx goctx.Context, request *requests.FPDF_StructTree_GetForPage) (*responses.FPDF_StructTree_GetForPage, error)
+	FPDF_VIEWERREF_GetDuplexWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetDuplex) (*responses.FPDF_VIEWERREF_GetDuplex, error)
+	FPDF_VIEWERREF_GetNameWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetName) (*responses.FPDF_VIEWERREF_GetName, error)
+	FPDF_VIEWERREF_GetNumCopiesWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetNumCopies) (*responses.FPDF_VIEWERREF_GetNumCopies, error)
+	FPDF_VIEWERREF_GetPrintPageRangeWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetPrintPageRange) (*responses.FPDF_VIEWERREF_GetPrintPageRange, error)
+	FPDF_VIEWERREF_GetPrintPageRangeCountWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetPrintPageRangeCount) (*responses.FPDF_VIEWERREF_GetPrintPageRangeCount, error)
+	FPDF_VIEWERREF_GetPrintPageRangeElementWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetPrintPageRangeElement) (*responses.FPDF_VIEWERREF_GetPrintPageRangeElement, error)
+	FPDF_VIEWERREF_GetPrintScalingWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetPrintScaling) (*responses.FPDF_VIEWERREF_GetPrintScaling, error)
+	FSDK_SetLocaltimeFunctionWithContext(ctx goctx.Context, request *requests.FSDK_SetLocaltimeFunction) (*responses.FSDK_SetLocaltimeFunction, error)
+	FSDK_SetTimeFunctionWithContext(ctx goctx.Context, request *requests.FSDK_SetTimeFunction) (*responses.FSDK_SetTimeFunction, error)
+	FSDK_SetUnSpObjProcessHandlerWithContext(ctx goctx.Context, request *requests.FSDK_SetUnSpObjProcessHandler) (*responses.FSDK_SetUnSpObjProcessHandler, error)
+	GetActionInfoWithContext(ctx goctx.Context, request *requests.GetActionInfo) (*responses.GetActionInfo, error)
+	GetAttachmentsWithContext(ctx goctx.Context, request *requests.GetAttachments) (*responses.GetAttachments, error)
+	GetBookmarksWithContext(ctx goctx.Context, request *requests.GetBookmarks) (*responses.GetBookmarks, error)
+	GetDestInfoWithContext(ctx goctx.Context, request *requests.GetDestInfo) (*responses.GetDestInfo, error)
+	GetFormWithContext(ctx goctx.Context, request *requests.GetForm) (*responses.GetForm, error)
+	GetJavaScriptActionsWithContext(ctx goctx.Context, request *requests.GetJavaScriptActions) (*responses.GetJavaScriptActions, error)
+	GetMetaDataWithContext(ctx goctx.Context, request *requests.GetMetaData) (*responses.GetMetaData, error)
+	GetPageSizeWithContext(ctx goctx.Context, request *requests.GetPageSize) (*responses.GetPageSize, error)
+	GetPageSizeInPixelsWithContext(ctx goctx.Context, request *requests.GetPageSizeInPixels) (*responses.GetPageSizeInPixels, error)
+	GetPageTextWithContext(ctx goctx.Context, request *requests.GetPageText) (*responses.GetPageText, error)
+	GetPageTextStructuredWithContext(ctx goctx.Context, request *requests.GetPageTextStructured) (*responses.GetPageTextStructured, error)
+	ListOpenHandlesWithContext(ctx goctx.Context, request *requests.ListOpenHandles) (*responses.ListOpenHandles, error)
+	OpenDocumentWithContext(ctx goctx.Context, request *requests.OpenDocument) (*responses.OpenDocument, error)
+	RenderPageInDPIWithContext(ctx goctx.Context, request *requests.RenderPageInDPI) (*responses.RenderPageInDPI, error)
+	RenderPageInPixelsWithContext(ctx goctx.Context, request *requests.RenderPageInPixels) (*responses.RenderPageInPixels, error)
+	RenderPageRegionWithContext(ctx goctx.Context, request *requests.RenderPageRegion) (*responses.RenderPageRegion, error)
+	RenderPagesInDPIWithContext(ctx goctx.Context, request *requests.RenderPagesInDPI) (*responses.RenderPagesInDPI, error)
+	RenderPagesInPixelsWithContext(ctx goctx.Context, request *requests.RenderPagesInPixels) (*responses.RenderPagesInPixels, error)
+	RenderToFileWithContext(ctx goctx.Context, request *requests.RenderToFile) (*responses.RenderToFile, error)
+}
@@ -0,0 +1,33 @@
+package pdfium
+
+import (
+	"github.com/klippa-app/go-pdfium/references"
+)
+
+// HandleDebugConfig enables the handle debug mode of a pool. In this mode the
+// stack of the call that returned a handle is recorded for every handle, and
+// the handles that are still open when their document is closed with
+// FPDF_CloseDocument, or when the instance is closed, are reported as a leak.
+// The checks call ListOpenHandles on the instance, so they are visible to the
+// interceptors and in the statistics. This mode is meant for development, it
+// makes every call slower.
+type HandleDebugConfig struct {
+	// OnLeak is called for every document that has open handles when it's
+	// closed. When nil, the leaks are logged with the log package.
+	OnLeak func(leak HandleLeak)
+}
+
+// HandleLeak describes the handles that were still open when a document or an
+// instance was closed.
+type HandleLeak struct {
+	Method   string                   // The method that closed the handles, FPDF_CloseDocument or Close.
+	Document references.FPDF_DOCUMENT // The document of the handles, empty for the handles that don't belong to a document.
+	Handles  []LeakedHandle           // The handles that were still open, sorted by type and reference. On Close, an unclosed document is included as FPDF_DOCUMENT handle.
+}
+
+// LeakedHandle is a handle that was not closed.
+type LeakedHandle struct {
+	Type      string // The type of the handle, like FPDF_PAGE.
+	Reference string // The reference of the handle.
+	Stack     string // The stack of the call that returned the handle, empty when it was not seen.
+}
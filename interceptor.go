@@ -0,0 +1,37 @@
+package pdfium
+
+import (
+	goctx "context"
+)
+
+// Interceptor is called around every method call of an instance, on all
+// implementations. The method is the name of the method in the Pdfium
+// interface and the request is the request of the call, like
+// *requests.FPDF_GetPageCount. Calling next makes the call, or calls the next
+// interceptor, and returns the response, like *responses.FPDF_GetPageCount.
+// An interceptor can return a different response or error, but the response
+// must be of the type of the method, otherwise the call returns nil.
+// The context is the context that was given to the WithContext variant of
+// the method, or context.Background for the other methods.
+type Interceptor func(ctx goctx.Context, method string, request interface{}, next func() (interface{}, error)) (interface{}, error)
+
+// ChainInterceptors combines the given interceptors into one interceptor, the
+// first interceptor is the outermost one. It returns an interceptor that only
+// makes the call when no interceptors are given.
+func ChainInterceptors(interceptors ...Interceptor) Interceptor {
+	if len(interceptors) == 0 {
+		return func(ctx goctx.Context, method string, request interface{}, next func() (interface{}, error)) (interface{}, error) {
+			return next()
+		}
+	}
+
+	if len(interceptors) == 1 {
+		return interceptors[0]
+	}
+
+	return func(ctx goctx.Context, method string, request interface{}, next func() (interface{}, error)) (interface{}, error) {
+		return interceptors[0](ctx, method, request, func() (interface{}, error) {
+			return ChainInterceptors(interceptors[1:]...)(ctx, method, request, next)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package pdfium_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/klippa-app/go-pdfium"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChainInterceptors(t *testing.T) {
+	var calls []string
+	interceptor := func(name string) pdfium.Interceptor {
+		return func(ctx context.Context, method string, request interface{}, next func() (interface{}, error)) (interface{}, error) {
+			calls = append(calls, name+" before "+method)
+			resp, err := next()
+			calls = append(calls, name+" after "+method)
+			return resp, err
+		}
+	}
+
+	resp, err := pdfium.ChainInterceptors(interceptor("first"), interceptor("second"), interceptor("third"))(context.Background(), "FPDF_GetPageCount", nil, func() (interface{}, error) {
+		calls = append(calls, "call")
+		return "response", nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "response", resp)
+	assert.Equal(t, []string{
+		"first before FPDF_GetPageCount",
+		"second before FPDF_GetPageCount",
+		"third before FPDF_GetPageCount",
+		"call",
+		"third after FPDF_GetPageCount",
+		"second after FPDF_GetPageCount",
+		"first after FPDF_GetPageCount",
+	}, calls)
+}
+
+func TestChainInterceptorsEmpty(t *testing.T) {
+	resp, err := pdfium.ChainInterceptors()(context.Background(), "FPDF_GetPageCount", nil, func() (interface{}, error) {
+		return nil, errors.New("document not found")
+	})
+	assert.Nil(t, resp)
+	assert.EqualError(t, err, "document not found")
+}
+
+func TestChainInterceptorsShortCircuit(t *testing.T) {
+	called := false
+	deny := func(ctx context.Context, method string, request interface{}, next func() (interface{}, error)) (interface{}, error) {
+		return nil, errors.New("method not allowed")
+	}
+
+	_, err := pdfium.ChainInterceptors(deny)(context.Background(), "FPDF_GetPageCount", nil, func() (interface{}, error) {
+		called = true
+		return nil, nil
+	})
+	assert.EqualError(t, err, "method not allowed")
+	assert.False(t, called)
+}
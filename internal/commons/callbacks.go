@@ -0,0 +1,143 @@
+package commons
+
+import (
+	"context"
+	"net/rpc"
+
+	"github.com/hashicorp/go-plugin"
+)
+
+// rpcClient is a connection between the host process and the worker, over
+// net/rpc or over gRPC.
+type rpcClient interface {
+	Call(serviceMethod string, args interface{}, reply interface{}) error
+	Close() error
+
+	// withContext returns a client on the same connection that stops
+	// waiting for the calls when the given context is done.
+	withContext(ctx context.Context) rpcClient
+}
+
+// netRPCClient is an rpcClient over net/rpc.
+type netRPCClient struct {
+	client *rpc.Client
+	ctx    context.Context
+}
+
+func (c *netRPCClient) Call(serviceMethod string, args interface{}, reply interface{}) error {
+	if c.ctx == nil {
+		return decodeError(c.client.Call(serviceMethod, args, reply))
+	}
+
+	if err := c.ctx.Err(); err != nil {
+		return err
+	}
+
+	// net/rpc can't cancel a call, so we stop waiting for it. The worker
+	// keeps handling the call, the multi-threaded pool kills the worker when
+	// this happens.
+	call := c.client.Go(serviceMethod, args, reply, make(chan *rpc.Call, 1))
+	select {
+	case <-call.Done:
+		return decodeError(call.Error)
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	}
+}
+
+func (c *netRPCClient) Close() error {
+	return c.client.Close()
+}
+
+func (c *netRPCClient) withContext(ctx context.Context) rpcClient {
+	return &netRPCClient{client: c.client, ctx: ctx}
+}
+
+// callbackBroker creates the connections that the worker uses to call back
+// into the host process, over net/rpc or over gRPC.
+type callbackBroker interface {
+	serve(callbacks interface{}) uint32
+	dial(id uint32) (rpcClient, error)
+}
+
+// serveCallbacks starts an RPC server for the given callbacks on a new
+// stream of the broker, and returns the ID of that stream so that the worker
+// can connect to it using dialCallbacks. This allows the worker to call back
+// into the host process while it is handling a request.
+// The server stops when the worker closes the connection, or when the worker
+// did not connect to it in time.
+func serveCallbacks(broker callbackBroker, callbacks interface{}) uint32 {
+	return broker.serve(callbacks)
+}
+
+// dialCallbacks connects to the callbacks that the host process is serving
+// on the stream with the given ID.
+func dialCallbacks(broker callbackBroker, id uint32) (rpcClient, error) {
+	return broker.dial(id)
+}
+
+// netRPCBroker serves the callbacks over net/rpc.
+type netRPCBroker struct {
+	broker *plugin.MuxBroker
+}
+
+func (b *netRPCBroker) serve(callbacks interface{}) uint32 {
+	id := b.broker.NextId()
+
+	go func() {
+		conn, err := b.broker.Accept(id)
+		if err != nil {
+			return
+		}
+
+		server := rpc.NewServer()
+		if err := server.RegisterName("Plugin", callbacks); err != nil {
+			conn.Close()
+			return
+		}
+
+		server.ServeConn(conn)
+	}()
+
+	return id
+}
+
+func (b *netRPCBroker) dial(id uint32) (rpcClient, error) {
+	conn, err := b.broker.Dial(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &netRPCClient{client: rpc.NewClient(conn)}, nil
+}
+
+// closeCallbacks closes all the connections to callbacks in the host process
+// and releases the shared memory of the documents.
+func (s *PdfiumRPCServer) closeCallbacks() {
+	s.callbacksLock.Lock()
+	defer s.callbacksLock.Unlock()
+
+	for formHandle := range s.formFillInfos {
+		s.formFillInfos[formHandle].client.Close()
+		delete(s.formFillInfos, formHandle)
+	}
+
+	for timerRef := range s.formFillTimers {
+		delete(s.formFillTimers, timerRef)
+	}
+
+	for dataAvail := range s.dataAvails {
+		s.dataAvails[dataAvail].Close()
+		delete(s.dataAvails, dataAvail)
+	}
+
+	for document := range s.documentReaders {
+		s.documentReaders[document].Close()
+		delete(s.documentReaders, document)
+	}
+
+	for document := range s.documentMemory {
+		unmapSharedMemory(s.documentMemory[document])
+		delete(s.documentMemory, document)
+	}
+}
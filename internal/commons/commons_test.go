@@ -1,11 +1,49 @@
 package commons_test
 
 import (
+	"image"
 	"testing"
 
+	"github.com/klippa-app/go-pdfium/internal/commons"
+
+	"github.com/hashicorp/go-plugin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestStub(t *testing.T) {
 	assert.True(t, true, "This is good. Canary test passing")
 }
+
+// newTestClient returns an RPC client that is connected to a plugin server
+// with the given implementation, like a multi-threaded worker would be.
+func newTestClient(t *testing.T, impl commons.Pdfium) *commons.PdfiumRPC {
+	client, _ := plugin.TestPluginRPCConn(t, map[string]plugin.Plugin{
+		"pdfium": &commons.PdfiumPlugin{Impl: impl},
+	}, nil)
+	t.Cleanup(func() {
+		client.Close()
+	})
+
+	raw, err := client.Dispense("pdfium")
+	require.NoError(t, err)
+
+	return raw.(*commons.PdfiumRPC)
+}
+
+func testImage(gray bool) image.Image {
+	rect := image.Rect(0, 0, 64, 32)
+	if gray {
+		img := image.NewGray(rect)
+		for i := range img.Pix {
+			img.Pix[i] = byte(i)
+		}
+		return img
+	}
+
+	img := image.NewRGBA(rect)
+	for i := range img.Pix {
+		img.Pix[i] = byte(i)
+	}
+	return img
+}
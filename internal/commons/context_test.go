@@ -0,0 +1,55 @@
+package commons_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/klippa-app/go-pdfium/internal/commons"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingImpl is a fake worker implementation of which the calls block
+// until they are released.
+type blockingImpl struct {
+	commons.Pdfium
+	release chan struct{}
+}
+
+func (b *blockingImpl) FPDF_GetPageCount(request *requests.FPDF_GetPageCount) (*responses.FPDF_GetPageCount, error) {
+	<-b.release
+	return &responses.FPDF_GetPageCount{PageCount: 1}, nil
+}
+
+func (b *blockingImpl) Close() error {
+	return nil
+}
+
+func TestWithContext(t *testing.T) {
+	for _, newClient := range []func(*testing.T, commons.Pdfium) *commons.PdfiumRPC{newTestClient, newTestGRPCClient} {
+		impl := &blockingImpl{release: make(chan struct{})}
+		pdfium := newClient(t, impl)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		resp, err := pdfium.FPDF_GetPageCountWithContext(ctx, &requests.FPDF_GetPageCount{})
+		cancel()
+		assert.Nil(t, resp)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded), "unexpected error: %v", err)
+
+		// The call that was stopped is still handled by the worker.
+		impl.release <- struct{}{}
+
+		go func() {
+			impl.release <- struct{}{}
+		}()
+
+		resp, err = pdfium.FPDF_GetPageCountWithContext(context.Background(), &requests.FPDF_GetPageCount{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, resp.PageCount)
+	}
+}
@@ -0,0 +1,174 @@
+package commons
+
+import (
+	"fmt"
+
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+)
+
+// FPDFAvail_CreateRequest is the version of requests.FPDFAvail_Create that is
+// sent to the worker. The reader and the callbacks are replaced by a
+// reference to the callback server in the host process.
+type FPDFAvail_CreateRequest struct {
+	Size                       int64
+	HasReader                  bool
+	HasIsDataAvailableCallback bool
+	HasAddSegmentCallback      bool
+	CallbackServer             uint32
+}
+
+type DataAvailSegment struct {
+	Offset uint64
+	Size   uint64
+}
+
+func (g *PdfiumRPC) FPDFAvail_Create(request *requests.FPDFAvail_Create) (*responses.FPDFAvail_Create, error) {
+	callbackServer := serveCallbacks(g.broker, &DataAvailRPCServer{
+		ReaderRPCServer: ReaderRPCServer{
+			Reader: request.Reader,
+		},
+		IsDataAvailableCallback: request.IsDataAvailableCallback,
+		AddSegmentCallback:      request.AddSegmentCallback,
+	})
+
+	resp := &responses.FPDFAvail_Create{}
+	err := g.client.Call("Plugin.FPDFAvail_Create", &FPDFAvail_CreateRequest{
+		Size:                       request.Size,
+		HasReader:                  request.Reader != nil,
+		HasIsDataAvailableCallback: request.IsDataAvailableCallback != nil,
+		HasAddSegmentCallback:      request.AddSegmentCallback != nil,
+		CallbackServer:             callbackServer,
+	}, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (g *PdfiumRPC) FPDFAvail_Destroy(request *requests.FPDFAvail_Destroy) (*responses.FPDFAvail_Destroy, error) {
+	resp := &responses.FPDFAvail_Destroy{}
+	err := g.client.Call("Plugin.FPDFAvail_Destroy", request, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *PdfiumRPCServer) FPDFAvail_Create(request *FPDFAvail_CreateRequest, resp *responses.FPDFAvail_Create) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_Create", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	// Always connect to the callback server, also when the request turns out
+	// to be invalid, otherwise the host process keeps waiting for us.
+	client, err := dialCallbacks(s.broker, request.CallbackServer)
+	if err != nil {
+		return fmt.Errorf("could not connect to data availability callbacks: %w", err)
+	}
+
+	implRequest := &requests.FPDFAvail_Create{
+		Size: request.Size,
+	}
+
+	if request.HasReader {
+		implRequest.Reader = &remoteReader{
+			client: client,
+			size:   request.Size,
+		}
+	}
+
+	if request.HasIsDataAvailableCallback {
+		implRequest.IsDataAvailableCallback = func(offset, size uint64) bool {
+			isDataAvailable := false
+			client.Call("Plugin.IsDataAvail", &DataAvailSegment{Offset: offset, Size: size}, &isDataAvailable)
+			return isDataAvailable
+		}
+	}
+
+	if request.HasAddSegmentCallback {
+		implRequest.AddSegmentCallback = func(offset, size uint64) {
+			client.Call("Plugin.AddSegment", &DataAvailSegment{Offset: offset, Size: size}, new(interface{}))
+		}
+	}
+
+	implResp, err := s.Impl.FPDFAvail_Create(implRequest)
+	if err != nil {
+		client.Close()
+		return err
+	}
+
+	s.callbacksLock.Lock()
+	s.dataAvails[implResp.AvailabilityProvider] = client
+	s.callbacksLock.Unlock()
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+func (s *PdfiumRPCServer) FPDFAvail_Destroy(request *requests.FPDFAvail_Destroy, resp *responses.FPDFAvail_Destroy) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_Destroy", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	implResp, err := s.Impl.FPDFAvail_Destroy(request)
+	if err != nil {
+		return err
+	}
+
+	// PDFium is done with the reader and the callbacks, so we can close the
+	// connection.
+	s.callbacksLock.Lock()
+	if client, ok := s.dataAvails[request.AvailabilityProvider]; ok {
+		client.Close()
+		delete(s.dataAvails, request.AvailabilityProvider)
+	}
+	s.callbacksLock.Unlock()
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+// DataAvailRPCServer serves the reader and the callbacks of
+// requests.FPDFAvail_Create of the host process to the worker.
+type DataAvailRPCServer struct {
+	ReaderRPCServer
+	IsDataAvailableCallback func(offset, size uint64) bool
+	AddSegmentCallback      func(offset, size uint64)
+}
+
+func (s *DataAvailRPCServer) IsDataAvail(args *DataAvailSegment, resp *bool) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "IsDataAvail", panicError)
+		}
+	}()
+
+	*resp = s.IsDataAvailableCallback(args.Offset, args.Size)
+	return nil
+}
+
+func (s *DataAvailRPCServer) AddSegment(args *DataAvailSegment, resp *interface{}) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "AddSegment", panicError)
+		}
+	}()
+
+	s.AddSegmentCallback(args.Offset, args.Size)
+	return nil
+}
@@ -0,0 +1,118 @@
+package commons_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/klippa-app/go-pdfium/enums"
+	"github.com/klippa-app/go-pdfium/internal/commons"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dataAvailImpl is a fake worker implementation that reads from the
+// availability provider like PDFium would.
+type dataAvailImpl struct {
+	commons.Pdfium
+	request *requests.FPDFAvail_Create
+}
+
+func (d *dataAvailImpl) FPDFAvail_Create(request *requests.FPDFAvail_Create) (*responses.FPDFAvail_Create, error) {
+	if request.IsDataAvailableCallback == nil {
+		return nil, errors.New("IsDataAvailableCallback can't be nil")
+	}
+
+	if request.Reader == nil {
+		return nil, errors.New("Reader can't be nil")
+	}
+
+	d.request = request
+	return &responses.FPDFAvail_Create{
+		AvailabilityProvider: "avail",
+	}, nil
+}
+
+func (d *dataAvailImpl) FPDFAvail_IsDocAvail(request *requests.FPDFAvail_IsDocAvail) (*responses.FPDFAvail_IsDocAvail, error) {
+	if !d.request.IsDataAvailableCallback(2, 4) {
+		if d.request.AddSegmentCallback != nil {
+			d.request.AddSegmentCallback(2, 4)
+		}
+		return &responses.FPDFAvail_IsDocAvail{
+			IsDocAvail: enums.PDF_FILEAVAIL_DATA_NOTAVAIL,
+		}, nil
+	}
+
+	block := make([]byte, 4)
+	if _, err := d.request.Reader.Seek(2, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if _, err := io.ReadFull(d.request.Reader, block); err != nil {
+		return nil, err
+	}
+	if string(block) != "data" {
+		return nil, errors.New("unexpected data")
+	}
+
+	return &responses.FPDFAvail_IsDocAvail{
+		IsDocAvail: enums.PDF_FILEAVAIL_DATA_AVAIL,
+	}, nil
+}
+
+func (d *dataAvailImpl) FPDFAvail_Destroy(request *requests.FPDFAvail_Destroy) (*responses.FPDFAvail_Destroy, error) {
+	return &responses.FPDFAvail_Destroy{}, nil
+}
+
+func (d *dataAvailImpl) Close() error {
+	return nil
+}
+
+func TestDataAvailCallbacks(t *testing.T) {
+	impl := &dataAvailImpl{}
+	pdfium := newTestClient(t, impl)
+
+	_, err := pdfium.FPDFAvail_Create(&requests.FPDFAvail_Create{
+		IsDataAvailableCallback: func(offset, size uint64) bool {
+			return true
+		},
+		Size: 8,
+	})
+	assert.EqualError(t, err, "Reader can't be nil")
+
+	available := false
+	segments := [][2]uint64{}
+	create, err := pdfium.FPDFAvail_Create(&requests.FPDFAvail_Create{
+		Reader: bytes.NewReader([]byte("--data--")),
+		Size:   8,
+		IsDataAvailableCallback: func(offset, size uint64) bool {
+			return available
+		},
+		AddSegmentCallback: func(offset, size uint64) {
+			segments = append(segments, [2]uint64{offset, size})
+		},
+	})
+	require.NoError(t, err)
+
+	isDocAvail, err := pdfium.FPDFAvail_IsDocAvail(&requests.FPDFAvail_IsDocAvail{
+		AvailabilityProvider: create.AvailabilityProvider,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, enums.PDF_FILEAVAIL_DATA_NOTAVAIL, isDocAvail.IsDocAvail)
+	assert.Equal(t, [][2]uint64{{2, 4}}, segments)
+
+	available = true
+	isDocAvail, err = pdfium.FPDFAvail_IsDocAvail(&requests.FPDFAvail_IsDocAvail{
+		AvailabilityProvider: create.AvailabilityProvider,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, enums.PDF_FILEAVAIL_DATA_AVAIL, isDocAvail.IsDocAvail)
+
+	_, err = pdfium.FPDFAvail_Destroy(&requests.FPDFAvail_Destroy{
+		AvailabilityProvider: create.AvailabilityProvider,
+	})
+	require.NoError(t, err)
+}
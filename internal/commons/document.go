@@ -0,0 +1,233 @@
+package commons
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/klippa-app/go-pdfium/references"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+)
+
+// OpenDocumentRequest is the version of requests.OpenDocument that is sent to
+// the worker. The FileReader is not sent, but served by the host process.
+type OpenDocumentRequest struct {
+	Request      *requests.OpenDocument
+	ReaderServer uint32            // 0 when no FileReader was given.
+	SharedFile   *SharedMemoryFile // Set when File was written to shared memory.
+
+	// Document is the reference to open the document under, set when the
+	// document of a crashed worker is re-opened.
+	Document references.FPDF_DOCUMENT
+}
+
+func (g *PdfiumRPC) OpenDocument(request *requests.OpenDocument) (*responses.OpenDocument, error) {
+	return g.openDocument(request, "")
+}
+
+// ReopenDocument opens the document under the reference that it had on a
+// worker that crashed, so that the references of the host process stay valid.
+func (g *PdfiumRPC) ReopenDocument(document references.FPDF_DOCUMENT, request *requests.OpenDocument) error {
+	_, err := g.openDocument(request, document)
+	return err
+}
+
+func (g *PdfiumRPC) openDocument(request *requests.OpenDocument, document references.FPDF_DOCUMENT) (*responses.OpenDocument, error) {
+	rpcRequest := &OpenDocumentRequest{
+		Request:  request,
+		Document: document,
+	}
+
+	if request.FileReader != nil {
+		// The reader is only used when no file data or path has been given.
+		if request.File == nil && request.FilePath == nil {
+			rpcRequest.ReaderServer = serveCallbacks(g.broker, &ReaderRPCServer{
+				Reader: request.FileReader,
+			})
+		}
+
+		// The reader can't be sent over RPC. Copy the request so that we
+		// don't modify the request of the user.
+		requestWithoutReader := *request
+		requestWithoutReader.FileReader = nil
+		rpcRequest.Request = &requestWithoutReader
+	}
+
+	if g.sharedMemory != nil && request.File != nil && len(*request.File) > 0 && len(*request.File) >= g.sharedMemory.Threshold {
+		sharedFile, err := writeSharedMemory(g.sharedMemory, *request.File)
+		if err != nil {
+			// The file is sent over RPC instead, like when shared memory
+			// isn't enabled.
+			if g.sharedMemory.LogCallback != nil {
+				g.sharedMemory.LogCallback(fmt.Sprintf("Could not write file to shared memory, sending it over RPC: %s", err.Error()))
+			}
+		} else {
+			// The worker removes the file after mapping it, this is to make
+			// sure it's also removed when the worker didn't get to that.
+			defer os.Remove(sharedFile.Path)
+
+			requestWithoutFile := *rpcRequest.Request
+			requestWithoutFile.File = nil
+			rpcRequest.Request = &requestWithoutFile
+			rpcRequest.SharedFile = sharedFile
+		}
+	}
+
+	resp := &responses.OpenDocument{}
+	err := g.client.Call("Plugin.OpenDocument", rpcRequest, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (g *PdfiumRPC) FPDF_CloseDocument(request *requests.FPDF_CloseDocument) (*responses.FPDF_CloseDocument, error) {
+	resp := &responses.FPDF_CloseDocument{}
+	err := g.client.Call("Plugin.FPDF_CloseDocument", request, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *PdfiumRPCServer) OpenDocument(request *OpenDocumentRequest, resp *responses.OpenDocument) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "OpenDocument", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	if request.SharedFile != nil {
+		fileData, err := readSharedMemory(request.SharedFile)
+		if err != nil {
+			return fmt.Errorf("could not read file from shared memory: %w", err)
+		}
+
+		request.Request.File = &fileData
+		implResp, err := s.openDocument(request)
+		if err != nil {
+			unmapSharedMemory(fileData)
+			return err
+		}
+
+		// PDFium keeps using the file data while the document is open.
+		s.callbacksLock.Lock()
+		s.documentMemory[implResp.Document] = fileData
+		s.callbacksLock.Unlock()
+
+		// Overwrite the target address of resp to the target address of implResp.
+		*resp = *implResp
+
+		return nil
+	}
+
+	if request.ReaderServer == 0 {
+		implResp, err := s.openDocument(request)
+		if err != nil {
+			return err
+		}
+
+		// Overwrite the target address of resp to the target address of implResp.
+		*resp = *implResp
+
+		return nil
+	}
+
+	// Always connect to the reader, also when the request turns out to be
+	// invalid, otherwise the host process keeps waiting for us.
+	client, err := dialCallbacks(s.broker, request.ReaderServer)
+	if err != nil {
+		return fmt.Errorf("could not connect to file reader: %w", err)
+	}
+
+	request.Request.FileReader = &remoteReader{
+		client: client,
+		size:   request.Request.FileReaderSize,
+	}
+
+	implResp, err := s.openDocument(request)
+	if err != nil {
+		client.Close()
+		return err
+	}
+
+	// PDFium keeps reading from the reader while the document is open.
+	s.callbacksLock.Lock()
+	s.documentReaders[implResp.Document] = client
+	s.callbacksLock.Unlock()
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+// documentReferenceSetter is implemented by the implementations that can
+// change the reference of an open document.
+type documentReferenceSetter interface {
+	SetDocumentReference(document references.FPDF_DOCUMENT, reference references.FPDF_DOCUMENT) error
+}
+
+// openDocument opens the document of the request, under the reference of the
+// request when it's given.
+func (s *PdfiumRPCServer) openDocument(request *OpenDocumentRequest) (*responses.OpenDocument, error) {
+	var setter documentReferenceSetter
+	if request.Document != "" {
+		var ok bool
+		setter, ok = s.Impl.(documentReferenceSetter)
+		if !ok {
+			return nil, errors.New("re-opening documents is not supported by the worker")
+		}
+	}
+
+	implResp, err := s.Impl.OpenDocument(request.Request)
+	if err != nil || setter == nil {
+		return implResp, err
+	}
+
+	err = setter.SetDocumentReference(implResp.Document, request.Document)
+	if err != nil {
+		s.Impl.FPDF_CloseDocument(&requests.FPDF_CloseDocument{Document: implResp.Document})
+		return nil, err
+	}
+
+	return &responses.OpenDocument{Document: request.Document}, nil
+}
+
+func (s *PdfiumRPCServer) FPDF_CloseDocument(request *requests.FPDF_CloseDocument, resp *responses.FPDF_CloseDocument) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_CloseDocument", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	implResp, err := s.Impl.FPDF_CloseDocument(request)
+	if err != nil {
+		return err
+	}
+
+	// PDFium is done with the reader and the file data, so we can close the
+	// connection and release the memory.
+	s.callbacksLock.Lock()
+	if client, ok := s.documentReaders[request.Document]; ok {
+		client.Close()
+		delete(s.documentReaders, request.Document)
+	}
+	if fileData, ok := s.documentMemory[request.Document]; ok {
+		unmapSharedMemory(fileData)
+		delete(s.documentMemory, request.Document)
+	}
+	s.callbacksLock.Unlock()
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package commons_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/klippa-app/go-pdfium/internal/commons"
+	"github.com/klippa-app/go-pdfium/references"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// documentImpl is a fake worker implementation that keeps reading from the
+// file reader while the document is open, like PDFium would.
+type documentImpl struct {
+	commons.Pdfium
+	reader io.ReadSeeker
+}
+
+func (d *documentImpl) OpenDocument(request *requests.OpenDocument) (*responses.OpenDocument, error) {
+	if request.File != nil {
+		return &responses.OpenDocument{Document: "file"}, nil
+	}
+
+	if request.FileReader == nil {
+		return nil, errors.New("No file given")
+	}
+
+	if request.FileReaderSize == 0 {
+		return nil, errors.New("FileReaderSize should be given when FileReader is set")
+	}
+
+	d.reader = request.FileReader
+	return &responses.OpenDocument{Document: "reader"}, nil
+}
+
+// readBlock reads a block like PDFium's m_GetBlock would.
+func (d *documentImpl) readBlock(position int64, size int) ([]byte, error) {
+	if _, err := d.reader.Seek(position, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	block := make([]byte, size)
+	if _, err := io.ReadFull(d.reader, block); err != nil {
+		return nil, err
+	}
+
+	return block, nil
+}
+
+func (d *documentImpl) FPDF_CloseDocument(request *requests.FPDF_CloseDocument) (*responses.FPDF_CloseDocument, error) {
+	return &responses.FPDF_CloseDocument{}, nil
+}
+
+func (d *documentImpl) Close() error {
+	return nil
+}
+
+// countingReader counts the bytes that are read from it.
+type countingReader struct {
+	*bytes.Reader
+	readBytes int
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.Reader.Read(p)
+	c.readBytes += n
+	return n, err
+}
+
+func TestOpenDocumentFileReader(t *testing.T) {
+	impl := &documentImpl{}
+	pdfium := newTestClient(t, impl)
+
+	fileData := bytes.Repeat([]byte("0123456789"), 1000)
+	reader := &countingReader{Reader: bytes.NewReader(fileData)}
+
+	_, err := pdfium.OpenDocument(&requests.OpenDocument{
+		FileReader: reader,
+	})
+	assert.EqualError(t, err, "FileReaderSize should be given when FileReader is set")
+
+	doc, err := pdfium.OpenDocument(&requests.OpenDocument{
+		FileReader:     reader,
+		FileReaderSize: int64(len(fileData)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, reader.readBytes, "the reader should not be read before PDFium needs the data")
+
+	block, err := impl.readBlock(5000, 15)
+	require.NoError(t, err)
+	assert.Equal(t, fileData[5000:5015], block)
+	assert.Equal(t, 15, reader.readBytes)
+
+	// Reading past the end of the file can't fill the whole block.
+	_, err = impl.readBlock(int64(len(fileData)-5), 10)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	_, err = pdfium.FPDF_CloseDocument(&requests.FPDF_CloseDocument{
+		Document: doc.Document,
+	})
+	require.NoError(t, err)
+
+	// The reader is not served anymore after the document has been closed.
+	_, err = impl.readBlock(0, 10)
+	assert.Error(t, err)
+
+	// The reader is ignored when the file data is given.
+	fileDoc, err := pdfium.OpenDocument(&requests.OpenDocument{
+		File:       &fileData,
+		FileReader: reader,
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, "file", fileDoc.Document)
+}
+
+// reopenImpl is a fake worker implementation that can change the reference of
+// a document, like the implementation of the worker.
+type reopenImpl struct {
+	documentImpl
+	references map[references.FPDF_DOCUMENT]references.FPDF_DOCUMENT
+}
+
+func (r *reopenImpl) SetDocumentReference(document references.FPDF_DOCUMENT, reference references.FPDF_DOCUMENT) error {
+	r.references[document] = reference
+	return nil
+}
+
+func TestReopenDocument(t *testing.T) {
+	impl := &reopenImpl{references: map[references.FPDF_DOCUMENT]references.FPDF_DOCUMENT{}}
+	pdfium := newTestClient(t, impl)
+
+	fileData := []byte("%PDF-1.7")
+	err := pdfium.ReopenDocument("crashed", &requests.OpenDocument{File: &fileData})
+	require.NoError(t, err)
+	assert.Equal(t, references.FPDF_DOCUMENT("crashed"), impl.references["file"])
+
+	// Workers that can't change references can't re-open documents.
+	pdfium = newTestClient(t, &documentImpl{})
+	err = pdfium.ReopenDocument("crashed", &requests.OpenDocument{File: &fileData})
+	assert.EqualError(t, err, "re-opening documents is not supported by the worker")
+}
@@ -0,0 +1,140 @@
+package commons
+
+import (
+	"encoding/json"
+	"errors"
+	"net/rpc"
+	"strings"
+
+	pdfium_errors "github.com/klippa-app/go-pdfium/errors"
+)
+
+// errorPrefix marks the errors that contain an encoded error. Both net/rpc
+// and gRPC only send the message of an error, so the error is encoded in the
+// message.
+const errorPrefix = "go-pdfium error: "
+
+// sentinelErrors are the error variables of the errors package by the ID that
+// is sent between the worker and the host process.
+var sentinelErrors = map[string]error{
+	"success":                    pdfium_errors.ErrSuccess,
+	"unknown":                    pdfium_errors.ErrUnknown,
+	"file":                       pdfium_errors.ErrFile,
+	"format":                     pdfium_errors.ErrFormat,
+	"password":                   pdfium_errors.ErrPassword,
+	"security":                   pdfium_errors.ErrSecurity,
+	"page":                       pdfium_errors.ErrPage,
+	"unexpected":                 pdfium_errors.ErrUnexpected,
+	"experimental_unsupported":   pdfium_errors.ErrExperimentalUnsupported,
+	"windows_unsupported":        pdfium_errors.ErrWindowsUnsupported,
+	"unsupported_on_webassembly": pdfium_errors.ErrUnsupportedOnWebassembly,
+	"timeout":                    pdfium_errors.ErrTimeout,
+	"worker_crashed":             pdfium_errors.ErrWorkerCrashed,
+	"out_of_memory":              pdfium_errors.ErrOutOfMemory,
+}
+
+// encodedError is the error as it's sent between the worker and the host
+// process. Either Error is set, or Sentinel is the ID of the error variable
+// that the error matches.
+type encodedError struct {
+	Error    *pdfium_errors.Error `json:",omitempty"`
+	Sentinel string               `json:",omitempty"`
+	Message  string               `json:",omitempty"`
+}
+
+// sentinelError is a decoded error that matches an error variable of the
+// errors package, but has a different message, for example because the error
+// variable was wrapped.
+type sentinelError struct {
+	message  string
+	sentinel error
+}
+
+func (e *sentinelError) Error() string {
+	return e.message
+}
+
+func (e *sentinelError) Unwrap() error {
+	return e.sentinel
+}
+
+// encodeError encodes a *pdfium_errors.Error or an error that matches an
+// error variable of the errors package in the message of the returned error,
+// so that decodeError can restore it in the host process.
+func encodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	encoded := encodedError{}
+
+	var pdfiumError *pdfium_errors.Error
+	if errors.As(err, &pdfiumError) {
+		// Keep the message of the errors that wrap the error.
+		encoded.Error = &pdfium_errors.Error{}
+		*encoded.Error = *pdfiumError
+		encoded.Error.Message = err.Error()
+	} else {
+		for id, sentinel := range sentinelErrors {
+			if errors.Is(err, sentinel) {
+				encoded.Sentinel = id
+				encoded.Message = err.Error()
+				break
+			}
+		}
+
+		if encoded.Sentinel == "" {
+			return err
+		}
+	}
+
+	data, jsonErr := json.Marshal(&encoded)
+	if jsonErr != nil {
+		return err
+	}
+
+	return errors.New(errorPrefix + string(data))
+}
+
+// decodeError restores the error that was encoded by encodeError, other
+// errors are returned as they are.
+func decodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	message := err.Error()
+	var serverError rpc.ServerError
+	if errors.As(err, &serverError) {
+		message = string(serverError)
+	}
+
+	if !strings.HasPrefix(message, errorPrefix) {
+		return err
+	}
+
+	encoded := encodedError{}
+	if jsonErr := json.Unmarshal([]byte(strings.TrimPrefix(message, errorPrefix)), &encoded); jsonErr != nil {
+		return err
+	}
+
+	if encoded.Error != nil {
+		return encoded.Error
+	}
+
+	sentinel, ok := sentinelErrors[encoded.Sentinel]
+	if !ok {
+		return err
+	}
+
+	// Return the error variable itself when it wasn't wrapped, so that it can
+	// also be compared with ==.
+	if encoded.Message == sentinel.Error() {
+		return sentinel
+	}
+
+	return &sentinelError{
+		message:  encoded.Message,
+		sentinel: sentinel,
+	}
+}
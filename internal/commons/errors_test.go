@@ -0,0 +1,80 @@
+package commons_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	pdfium_errors "github.com/klippa-app/go-pdfium/errors"
+	"github.com/klippa-app/go-pdfium/internal/commons"
+	"github.com/klippa-app/go-pdfium/references"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// errorsImpl is a fake worker implementation that returns errors like the
+// implementations do.
+type errorsImpl struct {
+	commons.Pdfium
+}
+
+func (e *errorsImpl) FPDF_LoadPage(request *requests.FPDF_LoadPage) (*responses.FPDF_LoadPage, error) {
+	pageError := pdfium_errors.NewCodeError(pdfium_errors.CodePage)
+	pageError.Document = request.Document
+	return nil, fmt.Errorf("could not load page %d: %w", request.Index, pageError)
+}
+
+func (e *errorsImpl) FPDF_GetPageCount(request *requests.FPDF_GetPageCount) (*responses.FPDF_GetPageCount, error) {
+	return nil, errors.New("document not found")
+}
+
+func (e *errorsImpl) FPDF_GetPageLabel(request *requests.FPDF_GetPageLabel) (*responses.FPDF_GetPageLabel, error) {
+	return nil, pdfium_errors.ErrExperimentalUnsupported
+}
+
+func (e *errorsImpl) FPDF_GetFileVersion(request *requests.FPDF_GetFileVersion) (*responses.FPDF_GetFileVersion, error) {
+	return nil, fmt.Errorf("could not get file version: %w", pdfium_errors.ErrUnsupportedOnWebassembly)
+}
+
+func (e *errorsImpl) Close() error {
+	return nil
+}
+
+func testErrors(t *testing.T, pdfium *commons.PdfiumRPC) {
+	_, err := pdfium.FPDF_LoadPage(&requests.FPDF_LoadPage{Document: "document", Index: 3})
+	require.Error(t, err)
+	assert.EqualError(t, err, "could not load page 3: 6: incorrect page")
+	assert.True(t, errors.Is(err, pdfium_errors.ErrPage))
+	assert.False(t, errors.Is(err, pdfium_errors.ErrPassword))
+	assert.Equal(t, pdfium_errors.CategoryPage, pdfium_errors.GetCategory(err))
+
+	var pdfiumError *pdfium_errors.Error
+	require.True(t, errors.As(err, &pdfiumError))
+	assert.Equal(t, pdfium_errors.CodePage, pdfiumError.Code)
+	assert.Equal(t, references.FPDF_DOCUMENT("document"), pdfiumError.Document)
+
+	// Other errors are returned as they are.
+	_, err = pdfium.FPDF_GetPageCount(&requests.FPDF_GetPageCount{})
+	assert.EqualError(t, err, "document not found")
+	assert.False(t, errors.As(err, &pdfiumError))
+
+	// The error variables of the errors package are restored.
+	_, err = pdfium.FPDF_GetPageLabel(&requests.FPDF_GetPageLabel{})
+	assert.Equal(t, pdfium_errors.ErrExperimentalUnsupported, err)
+
+	_, err = pdfium.FPDF_GetFileVersion(&requests.FPDF_GetFileVersion{})
+	assert.EqualError(t, err, "could not get file version: "+pdfium_errors.ErrUnsupportedOnWebassembly.Error())
+	assert.True(t, errors.Is(err, pdfium_errors.ErrUnsupportedOnWebassembly))
+	assert.False(t, errors.Is(err, pdfium_errors.ErrExperimentalUnsupported))
+}
+
+func TestErrors(t *testing.T) {
+	testErrors(t, newTestClient(t, &errorsImpl{}))
+}
+
+func TestGRPCErrors(t *testing.T) {
+	testErrors(t, newTestGRPCClient(t, &errorsImpl{}))
+}
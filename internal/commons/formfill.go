@@ -0,0 +1,547 @@
+package commons
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/klippa-app/go-pdfium/enums"
+	"github.com/klippa-app/go-pdfium/references"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+	"github.com/klippa-app/go-pdfium/structs"
+
+	"github.com/google/uuid"
+)
+
+// FormFillInfoCallbacks tells the worker which callbacks of the
+// FPDF_FORMFILLINFO have been set in the host process. The worker only sets
+// the same callbacks, so that PDFium sees the same FPDF_FORMFILLINFO as the
+// host process gave.
+type FormFillInfoCallbacks struct {
+	Release                bool
+	FFI_Invalidate         bool
+	FFI_OutputSelectedRect bool
+	FFI_SetCursor          bool
+	FFI_SetTimer           bool
+	FFI_KillTimer          bool
+	FFI_GetLocalTime       bool
+	FFI_OnChange           bool
+	FFI_GetPage            bool
+	FFI_GetCurrentPage     bool
+	FFI_GetRotation        bool
+	FFI_ExecuteNamedAction bool
+	FFI_SetTextFieldFocus  bool
+	FFI_DoURIAction        bool
+	FFI_DoGoToAction       bool
+}
+
+func newFormFillInfoCallbacks(formFillInfo structs.FPDF_FORMFILLINFO) FormFillInfoCallbacks {
+	return FormFillInfoCallbacks{
+		Release:                formFillInfo.Release != nil,
+		FFI_Invalidate:         formFillInfo.FFI_Invalidate != nil,
+		FFI_OutputSelectedRect: formFillInfo.FFI_OutputSelectedRect != nil,
+		FFI_SetCursor:          formFillInfo.FFI_SetCursor != nil,
+		FFI_SetTimer:           formFillInfo.FFI_SetTimer != nil,
+		FFI_KillTimer:          formFillInfo.FFI_KillTimer != nil,
+		FFI_GetLocalTime:       formFillInfo.FFI_GetLocalTime != nil,
+		FFI_OnChange:           formFillInfo.FFI_OnChange != nil,
+		FFI_GetPage:            formFillInfo.FFI_GetPage != nil,
+		FFI_GetCurrentPage:     formFillInfo.FFI_GetCurrentPage != nil,
+		FFI_GetRotation:        formFillInfo.FFI_GetRotation != nil,
+		FFI_ExecuteNamedAction: formFillInfo.FFI_ExecuteNamedAction != nil,
+		FFI_SetTextFieldFocus:  formFillInfo.FFI_SetTextFieldFocus != nil,
+		FFI_DoURIAction:        formFillInfo.FFI_DoURIAction != nil,
+		FFI_DoGoToAction:       formFillInfo.FFI_DoGoToAction != nil,
+	}
+}
+
+// FPDFDOC_InitFormFillEnvironmentRequest is the version of
+// requests.FPDFDOC_InitFormFillEnvironment that is sent to the worker.
+// The callbacks are replaced by a reference to the callback server in the
+// host process.
+type FPDFDOC_InitFormFillEnvironmentRequest struct {
+	Document       references.FPDF_DOCUMENT
+	Callbacks      FormFillInfoCallbacks
+	CallbackServer uint32
+}
+
+type FormFillInfoRect struct {
+	Page   references.FPDF_PAGE
+	Left   float64
+	Top    float64
+	Right  float64
+	Bottom float64
+}
+
+type FormFillInfoSetTimer struct {
+	Elapse   int
+	TimerRef string // The reference of the timer callback in the worker.
+}
+
+type FormFillInfoTimer struct {
+	TimerRef string
+	IDEvent  int
+}
+
+type FormFillInfoGetPage struct {
+	Document references.FPDF_DOCUMENT
+	Index    int
+}
+
+type FormFillInfoPage struct {
+	Page *references.FPDF_PAGE
+}
+
+type FormFillInfoSetTextFieldFocus struct {
+	Value   string
+	IsFocus bool
+}
+
+type FormFillInfoDoGoToAction struct {
+	PageIndex int
+	ZoomMode  enums.FPDF_ZOOM_MODE
+	Pos       []float32
+}
+
+func (g *PdfiumRPC) FPDFDOC_InitFormFillEnvironment(request *requests.FPDFDOC_InitFormFillEnvironment) (*responses.FPDFDOC_InitFormFillEnvironment, error) {
+	callbackServer := serveCallbacks(g.broker, &FormFillInfoRPCServer{
+		Impl:   request.FormFillInfo,
+		plugin: g.client,
+	})
+
+	resp := &responses.FPDFDOC_InitFormFillEnvironment{}
+	err := g.client.Call("Plugin.FPDFDOC_InitFormFillEnvironment", &FPDFDOC_InitFormFillEnvironmentRequest{
+		Document:       request.Document,
+		Callbacks:      newFormFillInfoCallbacks(request.FormFillInfo),
+		CallbackServer: callbackServer,
+	}, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (g *PdfiumRPC) FPDFDOC_ExitFormFillEnvironment(request *requests.FPDFDOC_ExitFormFillEnvironment) (*responses.FPDFDOC_ExitFormFillEnvironment, error) {
+	resp := &responses.FPDFDOC_ExitFormFillEnvironment{}
+	err := g.client.Call("Plugin.FPDFDOC_ExitFormFillEnvironment", request, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *PdfiumRPCServer) FPDFDOC_InitFormFillEnvironment(request *FPDFDOC_InitFormFillEnvironmentRequest, resp *responses.FPDFDOC_InitFormFillEnvironment) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDOC_InitFormFillEnvironment", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	// Always connect to the callback server, also when the request turns out
+	// to be invalid, otherwise the host process keeps waiting for us.
+	client, err := dialCallbacks(s.broker, request.CallbackServer)
+	if err != nil {
+		return fmt.Errorf("could not connect to form fill callbacks: %w", err)
+	}
+
+	formFillInfo := &formFillInfoRPC{
+		client: client,
+		server: s,
+		timers: map[int]string{},
+	}
+
+	implResp, err := s.Impl.FPDFDOC_InitFormFillEnvironment(&requests.FPDFDOC_InitFormFillEnvironment{
+		Document:     request.Document,
+		FormFillInfo: formFillInfo.formFillInfo(request.Callbacks),
+	})
+	if err != nil {
+		client.Close()
+		return err
+	}
+
+	s.callbacksLock.Lock()
+	s.formFillInfos[implResp.FormHandle] = formFillInfo
+	s.callbacksLock.Unlock()
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+func (s *PdfiumRPCServer) FPDFDOC_ExitFormFillEnvironment(request *requests.FPDFDOC_ExitFormFillEnvironment, resp *responses.FPDFDOC_ExitFormFillEnvironment) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDOC_ExitFormFillEnvironment", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	implResp, err := s.Impl.FPDFDOC_ExitFormFillEnvironment(request)
+	if err != nil {
+		return err
+	}
+
+	// PDFium is done with the callbacks, so we can close the connection.
+	s.callbacksLock.Lock()
+	if formFillInfo, ok := s.formFillInfos[request.FormHandle]; ok {
+		for timerID := range formFillInfo.timers {
+			delete(s.formFillTimers, formFillInfo.timers[timerID])
+		}
+		formFillInfo.client.Close()
+		delete(s.formFillInfos, request.FormHandle)
+	}
+	s.callbacksLock.Unlock()
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+// FormFillInfoTimer is called by the host process when a timer that was
+// installed by FFI_SetTimer has elapsed.
+func (s *PdfiumRPCServer) FormFillInfoTimer(request *FormFillInfoTimer, resp *interface{}) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FormFillInfoTimer", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	s.callbacksLock.Lock()
+	timerFunc, ok := s.formFillTimers[request.TimerRef]
+	s.callbacksLock.Unlock()
+
+	if !ok {
+		return errors.New("could not find timer, perhaps the timer was already killed")
+	}
+
+	timerFunc(request.IDEvent)
+
+	return nil
+}
+
+// formFillInfoRPC creates the FPDF_FORMFILLINFO callbacks in the worker that
+// call the callbacks in the host process.
+type formFillInfoRPC struct {
+	client rpcClient
+	server *PdfiumRPCServer
+	timers map[int]string // Maps the timer IDs to the timer references.
+}
+
+// formFillInfo returns the FPDF_FORMFILLINFO to give to PDFium. Errors while
+// calling into the host process can't be returned to PDFium, so the
+// callbacks return their zero value in that case.
+func (f *formFillInfoRPC) formFillInfo(callbacks FormFillInfoCallbacks) structs.FPDF_FORMFILLINFO {
+	formFillInfo := structs.FPDF_FORMFILLINFO{}
+
+	if callbacks.Release {
+		formFillInfo.Release = func() {
+			f.client.Call("Plugin.Release", new(interface{}), new(interface{}))
+		}
+	}
+
+	if callbacks.FFI_Invalidate {
+		formFillInfo.FFI_Invalidate = func(page references.FPDF_PAGE, left, top, right, bottom float64) {
+			f.client.Call("Plugin.FFI_Invalidate", &FormFillInfoRect{Page: page, Left: left, Top: top, Right: right, Bottom: bottom}, new(interface{}))
+		}
+	}
+
+	if callbacks.FFI_OutputSelectedRect {
+		formFillInfo.FFI_OutputSelectedRect = func(page references.FPDF_PAGE, left, top, right, bottom float64) {
+			f.client.Call("Plugin.FFI_OutputSelectedRect", &FormFillInfoRect{Page: page, Left: left, Top: top, Right: right, Bottom: bottom}, new(interface{}))
+		}
+	}
+
+	if callbacks.FFI_SetCursor {
+		formFillInfo.FFI_SetCursor = func(cursorType enums.FXCT) {
+			f.client.Call("Plugin.FFI_SetCursor", &cursorType, new(interface{}))
+		}
+	}
+
+	if callbacks.FFI_SetTimer {
+		formFillInfo.FFI_SetTimer = func(elapse int, timerFunc func(idEvent int)) int {
+			timerRef := uuid.New().String()
+
+			f.server.callbacksLock.Lock()
+			f.server.formFillTimers[timerRef] = timerFunc
+			f.server.callbacksLock.Unlock()
+
+			var timerID int
+			err := f.client.Call("Plugin.FFI_SetTimer", &FormFillInfoSetTimer{Elapse: elapse, TimerRef: timerRef}, &timerID)
+
+			f.server.callbacksLock.Lock()
+			defer f.server.callbacksLock.Unlock()
+
+			if err != nil || timerID == 0 {
+				delete(f.server.formFillTimers, timerRef)
+				return 0
+			}
+
+			f.timers[timerID] = timerRef
+			return timerID
+		}
+	}
+
+	if callbacks.FFI_KillTimer {
+		formFillInfo.FFI_KillTimer = func(timerID int) {
+			f.client.Call("Plugin.FFI_KillTimer", &timerID, new(interface{}))
+
+			f.server.callbacksLock.Lock()
+			defer f.server.callbacksLock.Unlock()
+
+			if timerRef, ok := f.timers[timerID]; ok {
+				delete(f.server.formFillTimers, timerRef)
+				delete(f.timers, timerID)
+			}
+		}
+	}
+
+	if callbacks.FFI_GetLocalTime {
+		formFillInfo.FFI_GetLocalTime = func() structs.FPDF_SYSTEMTIME {
+			localTime := structs.FPDF_SYSTEMTIME{}
+			f.client.Call("Plugin.FFI_GetLocalTime", new(interface{}), &localTime)
+			return localTime
+		}
+	}
+
+	if callbacks.FFI_OnChange {
+		formFillInfo.FFI_OnChange = func() {
+			f.client.Call("Plugin.FFI_OnChange", new(interface{}), new(interface{}))
+		}
+	}
+
+	if callbacks.FFI_GetPage {
+		formFillInfo.FFI_GetPage = func(document references.FPDF_DOCUMENT, index int) *references.FPDF_PAGE {
+			page := FormFillInfoPage{}
+			f.client.Call("Plugin.FFI_GetPage", &FormFillInfoGetPage{Document: document, Index: index}, &page)
+			return page.Page
+		}
+	}
+
+	if callbacks.FFI_GetCurrentPage {
+		formFillInfo.FFI_GetCurrentPage = func(document references.FPDF_DOCUMENT) *references.FPDF_PAGE {
+			page := FormFillInfoPage{}
+			f.client.Call("Plugin.FFI_GetCurrentPage", &document, &page)
+			return page.Page
+		}
+	}
+
+	if callbacks.FFI_GetRotation {
+		formFillInfo.FFI_GetRotation = func(page references.FPDF_PAGE) enums.FPDF_PAGE_ROTATION {
+			rotation := enums.FPDF_PAGE_ROTATION_NONE
+			f.client.Call("Plugin.FFI_GetRotation", &page, &rotation)
+			return rotation
+		}
+	}
+
+	if callbacks.FFI_ExecuteNamedAction {
+		formFillInfo.FFI_ExecuteNamedAction = func(namedAction string) {
+			f.client.Call("Plugin.FFI_ExecuteNamedAction", &namedAction, new(interface{}))
+		}
+	}
+
+	if callbacks.FFI_SetTextFieldFocus {
+		formFillInfo.FFI_SetTextFieldFocus = func(value string, isFocus bool) {
+			f.client.Call("Plugin.FFI_SetTextFieldFocus", &FormFillInfoSetTextFieldFocus{Value: value, IsFocus: isFocus}, new(interface{}))
+		}
+	}
+
+	if callbacks.FFI_DoURIAction {
+		formFillInfo.FFI_DoURIAction = func(bsURI string) {
+			f.client.Call("Plugin.FFI_DoURIAction", &bsURI, new(interface{}))
+		}
+	}
+
+	if callbacks.FFI_DoGoToAction {
+		formFillInfo.FFI_DoGoToAction = func(pageIndex int, zoomMode enums.FPDF_ZOOM_MODE, pos []float32) {
+			f.client.Call("Plugin.FFI_DoGoToAction", &FormFillInfoDoGoToAction{PageIndex: pageIndex, ZoomMode: zoomMode, Pos: pos}, new(interface{}))
+		}
+	}
+
+	return formFillInfo
+}
+
+// FormFillInfoRPCServer serves the FPDF_FORMFILLINFO callbacks of the host
+// process to the worker.
+type FormFillInfoRPCServer struct {
+	Impl   structs.FPDF_FORMFILLINFO
+	plugin rpcClient
+}
+
+func (s *FormFillInfoRPCServer) Release(args interface{}, resp *interface{}) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "Release", panicError)
+		}
+	}()
+
+	s.Impl.Release()
+	return nil
+}
+
+func (s *FormFillInfoRPCServer) FFI_Invalidate(args *FormFillInfoRect, resp *interface{}) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FFI_Invalidate", panicError)
+		}
+	}()
+
+	s.Impl.FFI_Invalidate(args.Page, args.Left, args.Top, args.Right, args.Bottom)
+	return nil
+}
+
+func (s *FormFillInfoRPCServer) FFI_OutputSelectedRect(args *FormFillInfoRect, resp *interface{}) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FFI_OutputSelectedRect", panicError)
+		}
+	}()
+
+	s.Impl.FFI_OutputSelectedRect(args.Page, args.Left, args.Top, args.Right, args.Bottom)
+	return nil
+}
+
+func (s *FormFillInfoRPCServer) FFI_SetCursor(args *enums.FXCT, resp *interface{}) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FFI_SetCursor", panicError)
+		}
+	}()
+
+	s.Impl.FFI_SetCursor(*args)
+	return nil
+}
+
+func (s *FormFillInfoRPCServer) FFI_SetTimer(args *FormFillInfoSetTimer, resp *int) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FFI_SetTimer", panicError)
+		}
+	}()
+
+	timerRef := args.TimerRef
+	*resp = s.Impl.FFI_SetTimer(args.Elapse, func(idEvent int) {
+		// The timer callback lives in the worker, trigger it over there.
+		s.plugin.Call("Plugin.FormFillInfoTimer", &FormFillInfoTimer{TimerRef: timerRef, IDEvent: idEvent}, new(interface{}))
+	})
+
+	return nil
+}
+
+func (s *FormFillInfoRPCServer) FFI_KillTimer(args *int, resp *interface{}) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FFI_KillTimer", panicError)
+		}
+	}()
+
+	s.Impl.FFI_KillTimer(*args)
+	return nil
+}
+
+func (s *FormFillInfoRPCServer) FFI_GetLocalTime(args interface{}, resp *structs.FPDF_SYSTEMTIME) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FFI_GetLocalTime", panicError)
+		}
+	}()
+
+	*resp = s.Impl.FFI_GetLocalTime()
+	return nil
+}
+
+func (s *FormFillInfoRPCServer) FFI_OnChange(args interface{}, resp *interface{}) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FFI_OnChange", panicError)
+		}
+	}()
+
+	s.Impl.FFI_OnChange()
+	return nil
+}
+
+func (s *FormFillInfoRPCServer) FFI_GetPage(args *FormFillInfoGetPage, resp *FormFillInfoPage) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FFI_GetPage", panicError)
+		}
+	}()
+
+	resp.Page = s.Impl.FFI_GetPage(args.Document, args.Index)
+	return nil
+}
+
+func (s *FormFillInfoRPCServer) FFI_GetCurrentPage(args *references.FPDF_DOCUMENT, resp *FormFillInfoPage) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FFI_GetCurrentPage", panicError)
+		}
+	}()
+
+	resp.Page = s.Impl.FFI_GetCurrentPage(*args)
+	return nil
+}
+
+func (s *FormFillInfoRPCServer) FFI_GetRotation(args *references.FPDF_PAGE, resp *enums.FPDF_PAGE_ROTATION) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FFI_GetRotation", panicError)
+		}
+	}()
+
+	*resp = s.Impl.FFI_GetRotation(*args)
+	return nil
+}
+
+func (s *FormFillInfoRPCServer) FFI_ExecuteNamedAction(args *string, resp *interface{}) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FFI_ExecuteNamedAction", panicError)
+		}
+	}()
+
+	s.Impl.FFI_ExecuteNamedAction(*args)
+	return nil
+}
+
+func (s *FormFillInfoRPCServer) FFI_SetTextFieldFocus(args *FormFillInfoSetTextFieldFocus, resp *interface{}) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FFI_SetTextFieldFocus", panicError)
+		}
+	}()
+
+	s.Impl.FFI_SetTextFieldFocus(args.Value, args.IsFocus)
+	return nil
+}
+
+func (s *FormFillInfoRPCServer) FFI_DoURIAction(args *string, resp *interface{}) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FFI_DoURIAction", panicError)
+		}
+	}()
+
+	s.Impl.FFI_DoURIAction(*args)
+	return nil
+}
+
+func (s *FormFillInfoRPCServer) FFI_DoGoToAction(args *FormFillInfoDoGoToAction, resp *interface{}) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FFI_DoGoToAction", panicError)
+		}
+	}()
+
+	s.Impl.FFI_DoGoToAction(args.PageIndex, args.ZoomMode, args.Pos)
+	return nil
+}
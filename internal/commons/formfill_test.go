@@ -0,0 +1,127 @@
+package commons_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/klippa-app/go-pdfium/enums"
+	"github.com/klippa-app/go-pdfium/internal/commons"
+	"github.com/klippa-app/go-pdfium/references"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+	"github.com/klippa-app/go-pdfium/structs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// formFillImpl is a fake worker implementation that calls the form fill
+// callbacks like PDFium would.
+type formFillImpl struct {
+	commons.Pdfium
+	formFillInfo structs.FPDF_FORMFILLINFO
+	timerEvents  []int
+}
+
+func (f *formFillImpl) FPDFDOC_InitFormFillEnvironment(request *requests.FPDFDOC_InitFormFillEnvironment) (*responses.FPDFDOC_InitFormFillEnvironment, error) {
+	if request.FormFillInfo.FFI_Invalidate == nil {
+		return nil, errors.New("FormFillInfo callback FFI_Invalidate is required")
+	}
+
+	f.formFillInfo = request.FormFillInfo
+	f.formFillInfo.FFI_Invalidate("page", 1, 2, 3, 4)
+
+	return &responses.FPDFDOC_InitFormFillEnvironment{
+		FormHandle: "form",
+	}, nil
+}
+
+func (f *formFillImpl) FPDFDOC_ExitFormFillEnvironment(request *requests.FPDFDOC_ExitFormFillEnvironment) (*responses.FPDFDOC_ExitFormFillEnvironment, error) {
+	return &responses.FPDFDOC_ExitFormFillEnvironment{}, nil
+}
+
+func (f *formFillImpl) Close() error {
+	return nil
+}
+
+func TestFormFillEnvironmentCallbacks(t *testing.T) {
+	impl := &formFillImpl{}
+	pdfium := newTestClient(t, impl)
+
+	invalidated := []float64{}
+	var timerFunc func(idEvent int)
+	killedTimers := []int{}
+	page := references.FPDF_PAGE("page")
+
+	resp, err := pdfium.FPDFDOC_InitFormFillEnvironment(&requests.FPDFDOC_InitFormFillEnvironment{
+		Document: "document",
+		FormFillInfo: structs.FPDF_FORMFILLINFO{
+			FFI_Invalidate: func(page references.FPDF_PAGE, left, top, right, bottom float64) {
+				assert.Equal(t, references.FPDF_PAGE("page"), page)
+				invalidated = append(invalidated, left, top, right, bottom)
+			},
+			FFI_SetTimer: func(elapse int, callback func(idEvent int)) int {
+				assert.Equal(t, 100, elapse)
+				timerFunc = callback
+				return 1
+			},
+			FFI_KillTimer: func(timerID int) {
+				killedTimers = append(killedTimers, timerID)
+			},
+			FFI_GetPage: func(document references.FPDF_DOCUMENT, index int) *references.FPDF_PAGE {
+				assert.Equal(t, references.FPDF_DOCUMENT("document"), document)
+				if index != 0 {
+					return nil
+				}
+				return &page
+			},
+			FFI_GetRotation: func(page references.FPDF_PAGE) enums.FPDF_PAGE_ROTATION {
+				return enums.FPDF_PAGE_ROTATION_90_CW
+			},
+			FFI_DoGoToAction: func(pageIndex int, zoomMode enums.FPDF_ZOOM_MODE, pos []float32) {
+				assert.Equal(t, 2, pageIndex)
+				assert.Equal(t, []float32{1, 2}, pos)
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, references.FPDF_FORMHANDLE("form"), resp.FormHandle)
+	assert.Equal(t, []float64{1, 2, 3, 4}, invalidated)
+
+	// Only the callbacks that were set in the host should be set in the worker.
+	assert.Nil(t, impl.formFillInfo.FFI_SetCursor)
+	assert.Nil(t, impl.formFillInfo.FFI_OnChange)
+
+	assert.Equal(t, &page, impl.formFillInfo.FFI_GetPage("document", 0))
+	assert.Nil(t, impl.formFillInfo.FFI_GetPage("document", 1))
+	assert.Equal(t, enums.FPDF_PAGE_ROTATION_90_CW, impl.formFillInfo.FFI_GetRotation("page"))
+	impl.formFillInfo.FFI_DoGoToAction(2, enums.FPDF_ZOOM_MODE_XYZ, []float32{1, 2})
+
+	// Timers are started in the host, but the timer callback lives in the worker.
+	timerID := impl.formFillInfo.FFI_SetTimer(100, func(idEvent int) {
+		impl.timerEvents = append(impl.timerEvents, idEvent)
+	})
+	assert.Equal(t, 1, timerID)
+	require.NotNil(t, timerFunc)
+	timerFunc(timerID)
+	assert.Equal(t, []int{1}, impl.timerEvents)
+
+	impl.formFillInfo.FFI_KillTimer(timerID)
+	assert.Equal(t, []int{1}, killedTimers)
+
+	_, err = pdfium.FPDFDOC_ExitFormFillEnvironment(&requests.FPDFDOC_ExitFormFillEnvironment{
+		FormHandle: resp.FormHandle,
+	})
+	require.NoError(t, err)
+}
+
+func TestFormFillEnvironmentMissingCallbacks(t *testing.T) {
+	pdfium := newTestClient(t, &formFillImpl{})
+
+	resp, err := pdfium.FPDFDOC_InitFormFillEnvironment(&requests.FPDFDOC_InitFormFillEnvironment{
+		Document:     "document",
+		FormFillInfo: structs.FPDF_FORMFILLINFO{},
+	})
+	assert.Nil(t, resp)
+	assert.EqualError(t, err, "FormFillInfo callback FFI_Invalidate is required")
+}
@@ -4,6 +4,7 @@
 package commons
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/klippa-app/go-pdfium/requests"
@@ -476,9 +477,11 @@ type Pdfium interface {
 	GetPageSizeInPixels(*requests.GetPageSizeInPixels) (*responses.GetPageSizeInPixels, error)
 	GetPageText(*requests.GetPageText) (*responses.GetPageText, error)
 	GetPageTextStructured(*requests.GetPageTextStructured) (*responses.GetPageTextStructured, error)
+	ListOpenHandles(*requests.ListOpenHandles) (*responses.ListOpenHandles, error)
 	OpenDocument(*requests.OpenDocument) (*responses.OpenDocument, error)
 	RenderPageInDPI(*requests.RenderPageInDPI) (*responses.RenderPageInDPI, error)
 	RenderPageInPixels(*requests.RenderPageInPixels) (*responses.RenderPageInPixels, error)
+	RenderPageRegion(*requests.RenderPageRegion) (*responses.RenderPageRegion, error)
 	RenderPagesInDPI(*requests.RenderPagesInDPI) (*responses.RenderPagesInDPI, error)
 	RenderPagesInPixels(*requests.RenderPagesInPixels) (*responses.RenderPagesInPixels, error)
 	RenderToFile(*requests.RenderToFile) (*responses.RenderToFile, error)
@@ -1535,26 +1538,6 @@ func (g *PdfiumRPC) FPDFAttachment_SetStringValue(request *requests.FPDFAttachme
 	return resp, nil
 }
 
-func (g *PdfiumRPC) FPDFAvail_Create(request *requests.FPDFAvail_Create) (*responses.FPDFAvail_Create, error) {
-	resp := &responses.FPDFAvail_Create{}
-	err := g.client.Call("Plugin.FPDFAvail_Create", request, resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
-}
-
-func (g *PdfiumRPC) FPDFAvail_Destroy(request *requests.FPDFAvail_Destroy) (*responses.FPDFAvail_Destroy, error) {
-	resp := &responses.FPDFAvail_Destroy{}
-	err := g.client.Call("Plugin.FPDFAvail_Destroy", request, resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
-}
-
 func (g *PdfiumRPC) FPDFAvail_GetDocument(request *requests.FPDFAvail_GetDocument) (*responses.FPDFAvail_GetDocument, error) {
 	resp := &responses.FPDFAvail_GetDocument{}
 	err := g.client.Call("Plugin.FPDFAvail_GetDocument", request, resp)
@@ -1845,26 +1828,6 @@ func (g *PdfiumRPC) FPDFClipPath_GetPathSegment(request *requests.FPDFClipPath_G
 	return resp, nil
 }
 
-func (g *PdfiumRPC) FPDFDOC_ExitFormFillEnvironment(request *requests.FPDFDOC_ExitFormFillEnvironment) (*responses.FPDFDOC_ExitFormFillEnvironment, error) {
-	resp := &responses.FPDFDOC_ExitFormFillEnvironment{}
-	err := g.client.Call("Plugin.FPDFDOC_ExitFormFillEnvironment", request, resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
-}
-
-func (g *PdfiumRPC) FPDFDOC_InitFormFillEnvironment(request *requests.FPDFDOC_InitFormFillEnvironment) (*responses.FPDFDOC_InitFormFillEnvironment, error) {
-	resp := &responses.FPDFDOC_InitFormFillEnvironment{}
-	err := g.client.Call("Plugin.FPDFDOC_InitFormFillEnvironment", request, resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
-}
-
 func (g *PdfiumRPC) FPDFDest_GetDestPageIndex(request *requests.FPDFDest_GetDestPageIndex) (*responses.FPDFDest_GetDestPageIndex, error) {
 	resp := &responses.FPDFDest_GetDestPageIndex{}
 	err := g.client.Call("Plugin.FPDFDest_GetDestPageIndex", request, resp)
@@ -3955,16 +3918,6 @@ func (g *PdfiumRPC) FPDFText_SetText(request *requests.FPDFText_SetText) (*respo
 	return resp, nil
 }
 
-func (g *PdfiumRPC) FPDF_CloseDocument(request *requests.FPDF_CloseDocument) (*responses.FPDF_CloseDocument, error) {
-	resp := &responses.FPDF_CloseDocument{}
-	err := g.client.Call("Plugin.FPDF_CloseDocument", request, resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
-}
-
 func (g *PdfiumRPC) FPDF_ClosePage(request *requests.FPDF_ClosePage) (*responses.FPDF_ClosePage, error) {
 	resp := &responses.FPDF_ClosePage{}
 	err := g.client.Call("Plugin.FPDF_ClosePage", request, resp)
@@ -4485,16 +4438,6 @@ func (g *PdfiumRPC) FPDF_RenderPageBitmap(request *requests.FPDF_RenderPageBitma
 	return resp, nil
 }
 
-func (g *PdfiumRPC) FPDF_RenderPageBitmapWithColorScheme_Start(request *requests.FPDF_RenderPageBitmapWithColorScheme_Start) (*responses.FPDF_RenderPageBitmapWithColorScheme_Start, error) {
-	resp := &responses.FPDF_RenderPageBitmapWithColorScheme_Start{}
-	err := g.client.Call("Plugin.FPDF_RenderPageBitmapWithColorScheme_Start", request, resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
-}
-
 func (g *PdfiumRPC) FPDF_RenderPageBitmapWithMatrix(request *requests.FPDF_RenderPageBitmapWithMatrix) (*responses.FPDF_RenderPageBitmapWithMatrix, error) {
 	resp := &responses.FPDF_RenderPageBitmapWithMatrix{}
 	err := g.client.Call("Plugin.FPDF_RenderPageBitmapWithMatrix", request, resp)
@@ -4505,16 +4448,6 @@ func (g *PdfiumRPC) FPDF_RenderPageBitmapWithMatrix(request *requests.FPDF_Rende
 	return resp, nil
 }
 
-func (g *PdfiumRPC) FPDF_RenderPageBitmap_Start(request *requests.FPDF_RenderPageBitmap_Start) (*responses.FPDF_RenderPageBitmap_Start, error) {
-	resp := &responses.FPDF_RenderPageBitmap_Start{}
-	err := g.client.Call("Plugin.FPDF_RenderPageBitmap_Start", request, resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
-}
-
 func (g *PdfiumRPC) FPDF_RenderPage_Close(request *requests.FPDF_RenderPage_Close) (*responses.FPDF_RenderPage_Close, error) {
 	resp := &responses.FPDF_RenderPage_Close{}
 	err := g.client.Call("Plugin.FPDF_RenderPage_Close", request, resp)
@@ -4525,36 +4458,6 @@ func (g *PdfiumRPC) FPDF_RenderPage_Close(request *requests.FPDF_RenderPage_Clos
 	return resp, nil
 }
 
-func (g *PdfiumRPC) FPDF_RenderPage_Continue(request *requests.FPDF_RenderPage_Continue) (*responses.FPDF_RenderPage_Continue, error) {
-	resp := &responses.FPDF_RenderPage_Continue{}
-	err := g.client.Call("Plugin.FPDF_RenderPage_Continue", request, resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
-}
-
-func (g *PdfiumRPC) FPDF_SaveAsCopy(request *requests.FPDF_SaveAsCopy) (*responses.FPDF_SaveAsCopy, error) {
-	resp := &responses.FPDF_SaveAsCopy{}
-	err := g.client.Call("Plugin.FPDF_SaveAsCopy", request, resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
-}
-
-func (g *PdfiumRPC) FPDF_SaveWithVersion(request *requests.FPDF_SaveWithVersion) (*responses.FPDF_SaveWithVersion, error) {
-	resp := &responses.FPDF_SaveWithVersion{}
-	err := g.client.Call("Plugin.FPDF_SaveWithVersion", request, resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
-}
-
 func (g *PdfiumRPC) FPDF_SetFormFieldHighlightAlpha(request *requests.FPDF_SetFormFieldHighlightAlpha) (*responses.FPDF_SetFormFieldHighlightAlpha, error) {
 	resp := &responses.FPDF_SetFormFieldHighlightAlpha{}
 	err := g.client.Call("Plugin.FPDF_SetFormFieldHighlightAlpha", request, resp)
@@ -5062,7 +4965,9 @@ func (g *PdfiumRPC) GetForm(request *requests.GetForm) (*responses.GetForm, erro
 		return nil, err
 	}
 
-	any(resp).(responses.AfterUnmarshaler).AfterUnmarshal()
+	if g.usesGob() {
+		any(resp).(responses.AfterUnmarshaler).AfterUnmarshal()
+	}
 
 	return resp, nil
 }
@@ -5127,9 +5032,9 @@ func (g *PdfiumRPC) GetPageTextStructured(request *requests.GetPageTextStructure
 	return resp, nil
 }
 
-func (g *PdfiumRPC) OpenDocument(request *requests.OpenDocument) (*responses.OpenDocument, error) {
-	resp := &responses.OpenDocument{}
-	err := g.client.Call("Plugin.OpenDocument", request, resp)
+func (g *PdfiumRPC) ListOpenHandles(request *requests.ListOpenHandles) (*responses.ListOpenHandles, error) {
+	resp := &responses.ListOpenHandles{}
+	err := g.client.Call("Plugin.ListOpenHandles", request, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -5137,9 +5042,9 @@ func (g *PdfiumRPC) OpenDocument(request *requests.OpenDocument) (*responses.Ope
 	return resp, nil
 }
 
-func (g *PdfiumRPC) RenderPageInDPI(request *requests.RenderPageInDPI) (*responses.RenderPageInDPI, error) {
-	resp := &responses.RenderPageInDPI{}
-	err := g.client.Call("Plugin.RenderPageInDPI", request, resp)
+func (g *PdfiumRPC) RenderToFile(request *requests.RenderToFile) (*responses.RenderToFile, error) {
+	resp := &responses.RenderToFile{}
+	err := g.client.Call("Plugin.RenderToFile", request, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -5147,222 +5052,2090 @@ func (g *PdfiumRPC) RenderPageInDPI(request *requests.RenderPageInDPI) (*respons
 	return resp, nil
 }
 
-func (g *PdfiumRPC) RenderPageInPixels(request *requests.RenderPageInPixels) (*responses.RenderPageInPixels, error) {
-	resp := &responses.RenderPageInPixels{}
-	err := g.client.Call("Plugin.RenderPageInPixels", request, resp)
-	if err != nil {
-		return nil, err
-	}
+func (g *PdfiumRPC) FORM_CanRedoWithContext(ctx context.Context, request *requests.FORM_CanRedo) (*responses.FORM_CanRedo, error) {
+	return g.withContext(ctx).FORM_CanRedo(request)
+}
 
-	return resp, nil
+func (g *PdfiumRPC) FORM_CanUndoWithContext(ctx context.Context, request *requests.FORM_CanUndo) (*responses.FORM_CanUndo, error) {
+	return g.withContext(ctx).FORM_CanUndo(request)
 }
 
-func (g *PdfiumRPC) RenderPagesInDPI(request *requests.RenderPagesInDPI) (*responses.RenderPagesInDPI, error) {
-	resp := &responses.RenderPagesInDPI{}
-	err := g.client.Call("Plugin.RenderPagesInDPI", request, resp)
-	if err != nil {
-		return nil, err
-	}
+func (g *PdfiumRPC) FORM_DoDocumentAActionWithContext(ctx context.Context, request *requests.FORM_DoDocumentAAction) (*responses.FORM_DoDocumentAAction, error) {
+	return g.withContext(ctx).FORM_DoDocumentAAction(request)
+}
 
-	return resp, nil
+func (g *PdfiumRPC) FORM_DoDocumentJSActionWithContext(ctx context.Context, request *requests.FORM_DoDocumentJSAction) (*responses.FORM_DoDocumentJSAction, error) {
+	return g.withContext(ctx).FORM_DoDocumentJSAction(request)
 }
 
-func (g *PdfiumRPC) RenderPagesInPixels(request *requests.RenderPagesInPixels) (*responses.RenderPagesInPixels, error) {
-	resp := &responses.RenderPagesInPixels{}
-	err := g.client.Call("Plugin.RenderPagesInPixels", request, resp)
-	if err != nil {
-		return nil, err
-	}
+func (g *PdfiumRPC) FORM_DoDocumentOpenActionWithContext(ctx context.Context, request *requests.FORM_DoDocumentOpenAction) (*responses.FORM_DoDocumentOpenAction, error) {
+	return g.withContext(ctx).FORM_DoDocumentOpenAction(request)
+}
 
-	return resp, nil
+func (g *PdfiumRPC) FORM_DoPageAActionWithContext(ctx context.Context, request *requests.FORM_DoPageAAction) (*responses.FORM_DoPageAAction, error) {
+	return g.withContext(ctx).FORM_DoPageAAction(request)
 }
 
-func (g *PdfiumRPC) RenderToFile(request *requests.RenderToFile) (*responses.RenderToFile, error) {
-	resp := &responses.RenderToFile{}
-	err := g.client.Call("Plugin.RenderToFile", request, resp)
-	if err != nil {
-		return nil, err
-	}
+func (g *PdfiumRPC) FORM_ForceToKillFocusWithContext(ctx context.Context, request *requests.FORM_ForceToKillFocus) (*responses.FORM_ForceToKillFocus, error) {
+	return g.withContext(ctx).FORM_ForceToKillFocus(request)
+}
 
-	return resp, nil
+func (g *PdfiumRPC) FORM_GetFocusedAnnotWithContext(ctx context.Context, request *requests.FORM_GetFocusedAnnot) (*responses.FORM_GetFocusedAnnot, error) {
+	return g.withContext(ctx).FORM_GetFocusedAnnot(request)
 }
 
-func (s *PdfiumRPCServer) FORM_CanRedo(request *requests.FORM_CanRedo, resp *responses.FORM_CanRedo) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_CanRedo", panicError)
-		}
-	}()
+func (g *PdfiumRPC) FORM_GetFocusedTextWithContext(ctx context.Context, request *requests.FORM_GetFocusedText) (*responses.FORM_GetFocusedText, error) {
+	return g.withContext(ctx).FORM_GetFocusedText(request)
+}
 
-	implResp, err := s.Impl.FORM_CanRedo(request)
-	if err != nil {
-		return err
-	}
+func (g *PdfiumRPC) FORM_GetSelectedTextWithContext(ctx context.Context, request *requests.FORM_GetSelectedText) (*responses.FORM_GetSelectedText, error) {
+	return g.withContext(ctx).FORM_GetSelectedText(request)
+}
 
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
+func (g *PdfiumRPC) FORM_IsIndexSelectedWithContext(ctx context.Context, request *requests.FORM_IsIndexSelected) (*responses.FORM_IsIndexSelected, error) {
+	return g.withContext(ctx).FORM_IsIndexSelected(request)
+}
 
-	return nil
+func (g *PdfiumRPC) FORM_OnAfterLoadPageWithContext(ctx context.Context, request *requests.FORM_OnAfterLoadPage) (*responses.FORM_OnAfterLoadPage, error) {
+	return g.withContext(ctx).FORM_OnAfterLoadPage(request)
 }
 
-func (s *PdfiumRPCServer) FORM_CanUndo(request *requests.FORM_CanUndo, resp *responses.FORM_CanUndo) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_CanUndo", panicError)
-		}
-	}()
+func (g *PdfiumRPC) FORM_OnBeforeClosePageWithContext(ctx context.Context, request *requests.FORM_OnBeforeClosePage) (*responses.FORM_OnBeforeClosePage, error) {
+	return g.withContext(ctx).FORM_OnBeforeClosePage(request)
+}
 
-	implResp, err := s.Impl.FORM_CanUndo(request)
-	if err != nil {
-		return err
-	}
+func (g *PdfiumRPC) FORM_OnCharWithContext(ctx context.Context, request *requests.FORM_OnChar) (*responses.FORM_OnChar, error) {
+	return g.withContext(ctx).FORM_OnChar(request)
+}
 
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
+func (g *PdfiumRPC) FORM_OnFocusWithContext(ctx context.Context, request *requests.FORM_OnFocus) (*responses.FORM_OnFocus, error) {
+	return g.withContext(ctx).FORM_OnFocus(request)
+}
 
-	return nil
+func (g *PdfiumRPC) FORM_OnKeyDownWithContext(ctx context.Context, request *requests.FORM_OnKeyDown) (*responses.FORM_OnKeyDown, error) {
+	return g.withContext(ctx).FORM_OnKeyDown(request)
 }
 
-func (s *PdfiumRPCServer) FORM_DoDocumentAAction(request *requests.FORM_DoDocumentAAction, resp *responses.FORM_DoDocumentAAction) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_DoDocumentAAction", panicError)
-		}
-	}()
+func (g *PdfiumRPC) FORM_OnKeyUpWithContext(ctx context.Context, request *requests.FORM_OnKeyUp) (*responses.FORM_OnKeyUp, error) {
+	return g.withContext(ctx).FORM_OnKeyUp(request)
+}
 
-	implResp, err := s.Impl.FORM_DoDocumentAAction(request)
-	if err != nil {
-		return err
-	}
+func (g *PdfiumRPC) FORM_OnLButtonDoubleClickWithContext(ctx context.Context, request *requests.FORM_OnLButtonDoubleClick) (*responses.FORM_OnLButtonDoubleClick, error) {
+	return g.withContext(ctx).FORM_OnLButtonDoubleClick(request)
+}
 
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
+func (g *PdfiumRPC) FORM_OnLButtonDownWithContext(ctx context.Context, request *requests.FORM_OnLButtonDown) (*responses.FORM_OnLButtonDown, error) {
+	return g.withContext(ctx).FORM_OnLButtonDown(request)
+}
 
-	return nil
+func (g *PdfiumRPC) FORM_OnLButtonUpWithContext(ctx context.Context, request *requests.FORM_OnLButtonUp) (*responses.FORM_OnLButtonUp, error) {
+	return g.withContext(ctx).FORM_OnLButtonUp(request)
 }
 
-func (s *PdfiumRPCServer) FORM_DoDocumentJSAction(request *requests.FORM_DoDocumentJSAction, resp *responses.FORM_DoDocumentJSAction) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_DoDocumentJSAction", panicError)
-		}
-	}()
+func (g *PdfiumRPC) FORM_OnMouseMoveWithContext(ctx context.Context, request *requests.FORM_OnMouseMove) (*responses.FORM_OnMouseMove, error) {
+	return g.withContext(ctx).FORM_OnMouseMove(request)
+}
 
-	implResp, err := s.Impl.FORM_DoDocumentJSAction(request)
-	if err != nil {
-		return err
-	}
+func (g *PdfiumRPC) FORM_OnMouseWheelWithContext(ctx context.Context, request *requests.FORM_OnMouseWheel) (*responses.FORM_OnMouseWheel, error) {
+	return g.withContext(ctx).FORM_OnMouseWheel(request)
+}
 
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
+func (g *PdfiumRPC) FORM_OnRButtonDownWithContext(ctx context.Context, request *requests.FORM_OnRButtonDown) (*responses.FORM_OnRButtonDown, error) {
+	return g.withContext(ctx).FORM_OnRButtonDown(request)
+}
 
-	return nil
+func (g *PdfiumRPC) FORM_OnRButtonUpWithContext(ctx context.Context, request *requests.FORM_OnRButtonUp) (*responses.FORM_OnRButtonUp, error) {
+	return g.withContext(ctx).FORM_OnRButtonUp(request)
 }
 
-func (s *PdfiumRPCServer) FORM_DoDocumentOpenAction(request *requests.FORM_DoDocumentOpenAction, resp *responses.FORM_DoDocumentOpenAction) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_DoDocumentOpenAction", panicError)
-		}
-	}()
+func (g *PdfiumRPC) FORM_RedoWithContext(ctx context.Context, request *requests.FORM_Redo) (*responses.FORM_Redo, error) {
+	return g.withContext(ctx).FORM_Redo(request)
+}
 
-	implResp, err := s.Impl.FORM_DoDocumentOpenAction(request)
-	if err != nil {
-		return err
-	}
+func (g *PdfiumRPC) FORM_ReplaceAndKeepSelectionWithContext(ctx context.Context, request *requests.FORM_ReplaceAndKeepSelection) (*responses.FORM_ReplaceAndKeepSelection, error) {
+	return g.withContext(ctx).FORM_ReplaceAndKeepSelection(request)
+}
 
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
+func (g *PdfiumRPC) FORM_ReplaceSelectionWithContext(ctx context.Context, request *requests.FORM_ReplaceSelection) (*responses.FORM_ReplaceSelection, error) {
+	return g.withContext(ctx).FORM_ReplaceSelection(request)
+}
 
-	return nil
+func (g *PdfiumRPC) FORM_SelectAllTextWithContext(ctx context.Context, request *requests.FORM_SelectAllText) (*responses.FORM_SelectAllText, error) {
+	return g.withContext(ctx).FORM_SelectAllText(request)
 }
 
-func (s *PdfiumRPCServer) FORM_DoPageAAction(request *requests.FORM_DoPageAAction, resp *responses.FORM_DoPageAAction) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_DoPageAAction", panicError)
-		}
-	}()
+func (g *PdfiumRPC) FORM_SetFocusedAnnotWithContext(ctx context.Context, request *requests.FORM_SetFocusedAnnot) (*responses.FORM_SetFocusedAnnot, error) {
+	return g.withContext(ctx).FORM_SetFocusedAnnot(request)
+}
 
-	implResp, err := s.Impl.FORM_DoPageAAction(request)
-	if err != nil {
-		return err
-	}
+func (g *PdfiumRPC) FORM_SetIndexSelectedWithContext(ctx context.Context, request *requests.FORM_SetIndexSelected) (*responses.FORM_SetIndexSelected, error) {
+	return g.withContext(ctx).FORM_SetIndexSelected(request)
+}
 
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
+func (g *PdfiumRPC) FORM_UndoWithContext(ctx context.Context, request *requests.FORM_Undo) (*responses.FORM_Undo, error) {
+	return g.withContext(ctx).FORM_Undo(request)
+}
 
-	return nil
+func (g *PdfiumRPC) FPDFAction_GetDestWithContext(ctx context.Context, request *requests.FPDFAction_GetDest) (*responses.FPDFAction_GetDest, error) {
+	return g.withContext(ctx).FPDFAction_GetDest(request)
 }
 
-func (s *PdfiumRPCServer) FORM_ForceToKillFocus(request *requests.FORM_ForceToKillFocus, resp *responses.FORM_ForceToKillFocus) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_ForceToKillFocus", panicError)
-		}
-	}()
+func (g *PdfiumRPC) FPDFAction_GetFilePathWithContext(ctx context.Context, request *requests.FPDFAction_GetFilePath) (*responses.FPDFAction_GetFilePath, error) {
+	return g.withContext(ctx).FPDFAction_GetFilePath(request)
+}
 
-	implResp, err := s.Impl.FORM_ForceToKillFocus(request)
-	if err != nil {
-		return err
-	}
+func (g *PdfiumRPC) FPDFAction_GetTypeWithContext(ctx context.Context, request *requests.FPDFAction_GetType) (*responses.FPDFAction_GetType, error) {
+	return g.withContext(ctx).FPDFAction_GetType(request)
+}
 
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
+func (g *PdfiumRPC) FPDFAction_GetURIPathWithContext(ctx context.Context, request *requests.FPDFAction_GetURIPath) (*responses.FPDFAction_GetURIPath, error) {
+	return g.withContext(ctx).FPDFAction_GetURIPath(request)
+}
 
-	return nil
+func (g *PdfiumRPC) FPDFAnnot_AddFileAttachmentWithContext(ctx context.Context, request *requests.FPDFAnnot_AddFileAttachment) (*responses.FPDFAnnot_AddFileAttachment, error) {
+	return g.withContext(ctx).FPDFAnnot_AddFileAttachment(request)
 }
 
-func (s *PdfiumRPCServer) FORM_GetFocusedAnnot(request *requests.FORM_GetFocusedAnnot, resp *responses.FORM_GetFocusedAnnot) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_GetFocusedAnnot", panicError)
-		}
-	}()
+func (g *PdfiumRPC) FPDFAnnot_AddInkStrokeWithContext(ctx context.Context, request *requests.FPDFAnnot_AddInkStroke) (*responses.FPDFAnnot_AddInkStroke, error) {
+	return g.withContext(ctx).FPDFAnnot_AddInkStroke(request)
+}
 
-	implResp, err := s.Impl.FORM_GetFocusedAnnot(request)
-	if err != nil {
-		return err
-	}
+func (g *PdfiumRPC) FPDFAnnot_AppendAttachmentPointsWithContext(ctx context.Context, request *requests.FPDFAnnot_AppendAttachmentPoints) (*responses.FPDFAnnot_AppendAttachmentPoints, error) {
+	return g.withContext(ctx).FPDFAnnot_AppendAttachmentPoints(request)
+}
 
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
+func (g *PdfiumRPC) FPDFAnnot_AppendObjectWithContext(ctx context.Context, request *requests.FPDFAnnot_AppendObject) (*responses.FPDFAnnot_AppendObject, error) {
+	return g.withContext(ctx).FPDFAnnot_AppendObject(request)
+}
 
-	return nil
+func (g *PdfiumRPC) FPDFAnnot_CountAttachmentPointsWithContext(ctx context.Context, request *requests.FPDFAnnot_CountAttachmentPoints) (*responses.FPDFAnnot_CountAttachmentPoints, error) {
+	return g.withContext(ctx).FPDFAnnot_CountAttachmentPoints(request)
 }
 
-func (s *PdfiumRPCServer) FORM_GetFocusedText(request *requests.FORM_GetFocusedText, resp *responses.FORM_GetFocusedText) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_GetFocusedText", panicError)
-		}
-	}()
+func (g *PdfiumRPC) FPDFAnnot_GetAPWithContext(ctx context.Context, request *requests.FPDFAnnot_GetAP) (*responses.FPDFAnnot_GetAP, error) {
+	return g.withContext(ctx).FPDFAnnot_GetAP(request)
+}
 
-	implResp, err := s.Impl.FORM_GetFocusedText(request)
-	if err != nil {
-		return err
-	}
+func (g *PdfiumRPC) FPDFAnnot_GetAttachmentPointsWithContext(ctx context.Context, request *requests.FPDFAnnot_GetAttachmentPoints) (*responses.FPDFAnnot_GetAttachmentPoints, error) {
+	return g.withContext(ctx).FPDFAnnot_GetAttachmentPoints(request)
+}
 
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
+func (g *PdfiumRPC) FPDFAnnot_GetBorderWithContext(ctx context.Context, request *requests.FPDFAnnot_GetBorder) (*responses.FPDFAnnot_GetBorder, error) {
+	return g.withContext(ctx).FPDFAnnot_GetBorder(request)
+}
 
-	return nil
+func (g *PdfiumRPC) FPDFAnnot_GetColorWithContext(ctx context.Context, request *requests.FPDFAnnot_GetColor) (*responses.FPDFAnnot_GetColor, error) {
+	return g.withContext(ctx).FPDFAnnot_GetColor(request)
 }
 
-func (s *PdfiumRPCServer) FORM_GetSelectedText(request *requests.FORM_GetSelectedText, resp *responses.FORM_GetSelectedText) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_GetSelectedText", panicError)
-		}
-	}()
+func (g *PdfiumRPC) FPDFAnnot_GetFileAttachmentWithContext(ctx context.Context, request *requests.FPDFAnnot_GetFileAttachment) (*responses.FPDFAnnot_GetFileAttachment, error) {
+	return g.withContext(ctx).FPDFAnnot_GetFileAttachment(request)
+}
 
-	implResp, err := s.Impl.FORM_GetSelectedText(request)
-	if err != nil {
-		return err
-	}
+func (g *PdfiumRPC) FPDFAnnot_GetFlagsWithContext(ctx context.Context, request *requests.FPDFAnnot_GetFlags) (*responses.FPDFAnnot_GetFlags, error) {
+	return g.withContext(ctx).FPDFAnnot_GetFlags(request)
+}
 
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
+func (g *PdfiumRPC) FPDFAnnot_GetFocusableSubtypesWithContext(ctx context.Context, request *requests.FPDFAnnot_GetFocusableSubtypes) (*responses.FPDFAnnot_GetFocusableSubtypes, error) {
+	return g.withContext(ctx).FPDFAnnot_GetFocusableSubtypes(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetFocusableSubtypesCountWithContext(ctx context.Context, request *requests.FPDFAnnot_GetFocusableSubtypesCount) (*responses.FPDFAnnot_GetFocusableSubtypesCount, error) {
+	return g.withContext(ctx).FPDFAnnot_GetFocusableSubtypesCount(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetFontColorWithContext(ctx context.Context, request *requests.FPDFAnnot_GetFontColor) (*responses.FPDFAnnot_GetFontColor, error) {
+	return g.withContext(ctx).FPDFAnnot_GetFontColor(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetFontSizeWithContext(ctx context.Context, request *requests.FPDFAnnot_GetFontSize) (*responses.FPDFAnnot_GetFontSize, error) {
+	return g.withContext(ctx).FPDFAnnot_GetFontSize(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetFormAdditionalActionJavaScriptWithContext(ctx context.Context, request *requests.FPDFAnnot_GetFormAdditionalActionJavaScript) (*responses.FPDFAnnot_GetFormAdditionalActionJavaScript, error) {
+	return g.withContext(ctx).FPDFAnnot_GetFormAdditionalActionJavaScript(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetFormControlCountWithContext(ctx context.Context, request *requests.FPDFAnnot_GetFormControlCount) (*responses.FPDFAnnot_GetFormControlCount, error) {
+	return g.withContext(ctx).FPDFAnnot_GetFormControlCount(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetFormControlIndexWithContext(ctx context.Context, request *requests.FPDFAnnot_GetFormControlIndex) (*responses.FPDFAnnot_GetFormControlIndex, error) {
+	return g.withContext(ctx).FPDFAnnot_GetFormControlIndex(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetFormFieldAlternateNameWithContext(ctx context.Context, request *requests.FPDFAnnot_GetFormFieldAlternateName) (*responses.FPDFAnnot_GetFormFieldAlternateName, error) {
+	return g.withContext(ctx).FPDFAnnot_GetFormFieldAlternateName(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetFormFieldAtPointWithContext(ctx context.Context, request *requests.FPDFAnnot_GetFormFieldAtPoint) (*responses.FPDFAnnot_GetFormFieldAtPoint, error) {
+	return g.withContext(ctx).FPDFAnnot_GetFormFieldAtPoint(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetFormFieldExportValueWithContext(ctx context.Context, request *requests.FPDFAnnot_GetFormFieldExportValue) (*responses.FPDFAnnot_GetFormFieldExportValue, error) {
+	return g.withContext(ctx).FPDFAnnot_GetFormFieldExportValue(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetFormFieldFlagsWithContext(ctx context.Context, request *requests.FPDFAnnot_GetFormFieldFlags) (*responses.FPDFAnnot_GetFormFieldFlags, error) {
+	return g.withContext(ctx).FPDFAnnot_GetFormFieldFlags(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetFormFieldNameWithContext(ctx context.Context, request *requests.FPDFAnnot_GetFormFieldName) (*responses.FPDFAnnot_GetFormFieldName, error) {
+	return g.withContext(ctx).FPDFAnnot_GetFormFieldName(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetFormFieldTypeWithContext(ctx context.Context, request *requests.FPDFAnnot_GetFormFieldType) (*responses.FPDFAnnot_GetFormFieldType, error) {
+	return g.withContext(ctx).FPDFAnnot_GetFormFieldType(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetFormFieldValueWithContext(ctx context.Context, request *requests.FPDFAnnot_GetFormFieldValue) (*responses.FPDFAnnot_GetFormFieldValue, error) {
+	return g.withContext(ctx).FPDFAnnot_GetFormFieldValue(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetInkListCountWithContext(ctx context.Context, request *requests.FPDFAnnot_GetInkListCount) (*responses.FPDFAnnot_GetInkListCount, error) {
+	return g.withContext(ctx).FPDFAnnot_GetInkListCount(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetInkListPathWithContext(ctx context.Context, request *requests.FPDFAnnot_GetInkListPath) (*responses.FPDFAnnot_GetInkListPath, error) {
+	return g.withContext(ctx).FPDFAnnot_GetInkListPath(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetLineWithContext(ctx context.Context, request *requests.FPDFAnnot_GetLine) (*responses.FPDFAnnot_GetLine, error) {
+	return g.withContext(ctx).FPDFAnnot_GetLine(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetLinkWithContext(ctx context.Context, request *requests.FPDFAnnot_GetLink) (*responses.FPDFAnnot_GetLink, error) {
+	return g.withContext(ctx).FPDFAnnot_GetLink(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetLinkedAnnotWithContext(ctx context.Context, request *requests.FPDFAnnot_GetLinkedAnnot) (*responses.FPDFAnnot_GetLinkedAnnot, error) {
+	return g.withContext(ctx).FPDFAnnot_GetLinkedAnnot(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetNumberValueWithContext(ctx context.Context, request *requests.FPDFAnnot_GetNumberValue) (*responses.FPDFAnnot_GetNumberValue, error) {
+	return g.withContext(ctx).FPDFAnnot_GetNumberValue(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetObjectWithContext(ctx context.Context, request *requests.FPDFAnnot_GetObject) (*responses.FPDFAnnot_GetObject, error) {
+	return g.withContext(ctx).FPDFAnnot_GetObject(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetObjectCountWithContext(ctx context.Context, request *requests.FPDFAnnot_GetObjectCount) (*responses.FPDFAnnot_GetObjectCount, error) {
+	return g.withContext(ctx).FPDFAnnot_GetObjectCount(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetOptionCountWithContext(ctx context.Context, request *requests.FPDFAnnot_GetOptionCount) (*responses.FPDFAnnot_GetOptionCount, error) {
+	return g.withContext(ctx).FPDFAnnot_GetOptionCount(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetOptionLabelWithContext(ctx context.Context, request *requests.FPDFAnnot_GetOptionLabel) (*responses.FPDFAnnot_GetOptionLabel, error) {
+	return g.withContext(ctx).FPDFAnnot_GetOptionLabel(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetRectWithContext(ctx context.Context, request *requests.FPDFAnnot_GetRect) (*responses.FPDFAnnot_GetRect, error) {
+	return g.withContext(ctx).FPDFAnnot_GetRect(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetStringValueWithContext(ctx context.Context, request *requests.FPDFAnnot_GetStringValue) (*responses.FPDFAnnot_GetStringValue, error) {
+	return g.withContext(ctx).FPDFAnnot_GetStringValue(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetSubtypeWithContext(ctx context.Context, request *requests.FPDFAnnot_GetSubtype) (*responses.FPDFAnnot_GetSubtype, error) {
+	return g.withContext(ctx).FPDFAnnot_GetSubtype(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetValueTypeWithContext(ctx context.Context, request *requests.FPDFAnnot_GetValueType) (*responses.FPDFAnnot_GetValueType, error) {
+	return g.withContext(ctx).FPDFAnnot_GetValueType(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_GetVerticesWithContext(ctx context.Context, request *requests.FPDFAnnot_GetVertices) (*responses.FPDFAnnot_GetVertices, error) {
+	return g.withContext(ctx).FPDFAnnot_GetVertices(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_HasAttachmentPointsWithContext(ctx context.Context, request *requests.FPDFAnnot_HasAttachmentPoints) (*responses.FPDFAnnot_HasAttachmentPoints, error) {
+	return g.withContext(ctx).FPDFAnnot_HasAttachmentPoints(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_HasKeyWithContext(ctx context.Context, request *requests.FPDFAnnot_HasKey) (*responses.FPDFAnnot_HasKey, error) {
+	return g.withContext(ctx).FPDFAnnot_HasKey(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_IsCheckedWithContext(ctx context.Context, request *requests.FPDFAnnot_IsChecked) (*responses.FPDFAnnot_IsChecked, error) {
+	return g.withContext(ctx).FPDFAnnot_IsChecked(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_IsObjectSupportedSubtypeWithContext(ctx context.Context, request *requests.FPDFAnnot_IsObjectSupportedSubtype) (*responses.FPDFAnnot_IsObjectSupportedSubtype, error) {
+	return g.withContext(ctx).FPDFAnnot_IsObjectSupportedSubtype(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_IsOptionSelectedWithContext(ctx context.Context, request *requests.FPDFAnnot_IsOptionSelected) (*responses.FPDFAnnot_IsOptionSelected, error) {
+	return g.withContext(ctx).FPDFAnnot_IsOptionSelected(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_IsSupportedSubtypeWithContext(ctx context.Context, request *requests.FPDFAnnot_IsSupportedSubtype) (*responses.FPDFAnnot_IsSupportedSubtype, error) {
+	return g.withContext(ctx).FPDFAnnot_IsSupportedSubtype(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_RemoveInkListWithContext(ctx context.Context, request *requests.FPDFAnnot_RemoveInkList) (*responses.FPDFAnnot_RemoveInkList, error) {
+	return g.withContext(ctx).FPDFAnnot_RemoveInkList(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_RemoveObjectWithContext(ctx context.Context, request *requests.FPDFAnnot_RemoveObject) (*responses.FPDFAnnot_RemoveObject, error) {
+	return g.withContext(ctx).FPDFAnnot_RemoveObject(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_SetAPWithContext(ctx context.Context, request *requests.FPDFAnnot_SetAP) (*responses.FPDFAnnot_SetAP, error) {
+	return g.withContext(ctx).FPDFAnnot_SetAP(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_SetAttachmentPointsWithContext(ctx context.Context, request *requests.FPDFAnnot_SetAttachmentPoints) (*responses.FPDFAnnot_SetAttachmentPoints, error) {
+	return g.withContext(ctx).FPDFAnnot_SetAttachmentPoints(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_SetBorderWithContext(ctx context.Context, request *requests.FPDFAnnot_SetBorder) (*responses.FPDFAnnot_SetBorder, error) {
+	return g.withContext(ctx).FPDFAnnot_SetBorder(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_SetColorWithContext(ctx context.Context, request *requests.FPDFAnnot_SetColor) (*responses.FPDFAnnot_SetColor, error) {
+	return g.withContext(ctx).FPDFAnnot_SetColor(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_SetFlagsWithContext(ctx context.Context, request *requests.FPDFAnnot_SetFlags) (*responses.FPDFAnnot_SetFlags, error) {
+	return g.withContext(ctx).FPDFAnnot_SetFlags(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_SetFocusableSubtypesWithContext(ctx context.Context, request *requests.FPDFAnnot_SetFocusableSubtypes) (*responses.FPDFAnnot_SetFocusableSubtypes, error) {
+	return g.withContext(ctx).FPDFAnnot_SetFocusableSubtypes(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_SetFontColorWithContext(ctx context.Context, request *requests.FPDFAnnot_SetFontColor) (*responses.FPDFAnnot_SetFontColor, error) {
+	return g.withContext(ctx).FPDFAnnot_SetFontColor(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_SetFormFieldFlagsWithContext(ctx context.Context, request *requests.FPDFAnnot_SetFormFieldFlags) (*responses.FPDFAnnot_SetFormFieldFlags, error) {
+	return g.withContext(ctx).FPDFAnnot_SetFormFieldFlags(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_SetRectWithContext(ctx context.Context, request *requests.FPDFAnnot_SetRect) (*responses.FPDFAnnot_SetRect, error) {
+	return g.withContext(ctx).FPDFAnnot_SetRect(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_SetStringValueWithContext(ctx context.Context, request *requests.FPDFAnnot_SetStringValue) (*responses.FPDFAnnot_SetStringValue, error) {
+	return g.withContext(ctx).FPDFAnnot_SetStringValue(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_SetURIWithContext(ctx context.Context, request *requests.FPDFAnnot_SetURI) (*responses.FPDFAnnot_SetURI, error) {
+	return g.withContext(ctx).FPDFAnnot_SetURI(request)
+}
+
+func (g *PdfiumRPC) FPDFAnnot_UpdateObjectWithContext(ctx context.Context, request *requests.FPDFAnnot_UpdateObject) (*responses.FPDFAnnot_UpdateObject, error) {
+	return g.withContext(ctx).FPDFAnnot_UpdateObject(request)
+}
+
+func (g *PdfiumRPC) FPDFAttachment_GetDescriptionWithContext(ctx context.Context, request *requests.FPDFAttachment_GetDescription) (*responses.FPDFAttachment_GetDescription, error) {
+	return g.withContext(ctx).FPDFAttachment_GetDescription(request)
+}
+
+func (g *PdfiumRPC) FPDFAttachment_GetFileWithContext(ctx context.Context, request *requests.FPDFAttachment_GetFile) (*responses.FPDFAttachment_GetFile, error) {
+	return g.withContext(ctx).FPDFAttachment_GetFile(request)
+}
+
+func (g *PdfiumRPC) FPDFAttachment_GetNameWithContext(ctx context.Context, request *requests.FPDFAttachment_GetName) (*responses.FPDFAttachment_GetName, error) {
+	return g.withContext(ctx).FPDFAttachment_GetName(request)
+}
+
+func (g *PdfiumRPC) FPDFAttachment_GetStringValueWithContext(ctx context.Context, request *requests.FPDFAttachment_GetStringValue) (*responses.FPDFAttachment_GetStringValue, error) {
+	return g.withContext(ctx).FPDFAttachment_GetStringValue(request)
+}
+
+func (g *PdfiumRPC) FPDFAttachment_GetSubtypeWithContext(ctx context.Context, request *requests.FPDFAttachment_GetSubtype) (*responses.FPDFAttachment_GetSubtype, error) {
+	return g.withContext(ctx).FPDFAttachment_GetSubtype(request)
+}
+
+func (g *PdfiumRPC) FPDFAttachment_GetValueTypeWithContext(ctx context.Context, request *requests.FPDFAttachment_GetValueType) (*responses.FPDFAttachment_GetValueType, error) {
+	return g.withContext(ctx).FPDFAttachment_GetValueType(request)
+}
+
+func (g *PdfiumRPC) FPDFAttachment_HasKeyWithContext(ctx context.Context, request *requests.FPDFAttachment_HasKey) (*responses.FPDFAttachment_HasKey, error) {
+	return g.withContext(ctx).FPDFAttachment_HasKey(request)
+}
+
+func (g *PdfiumRPC) FPDFAttachment_SetDescriptionWithContext(ctx context.Context, request *requests.FPDFAttachment_SetDescription) (*responses.FPDFAttachment_SetDescription, error) {
+	return g.withContext(ctx).FPDFAttachment_SetDescription(request)
+}
+
+func (g *PdfiumRPC) FPDFAttachment_SetFileWithContext(ctx context.Context, request *requests.FPDFAttachment_SetFile) (*responses.FPDFAttachment_SetFile, error) {
+	return g.withContext(ctx).FPDFAttachment_SetFile(request)
+}
+
+func (g *PdfiumRPC) FPDFAttachment_SetStringValueWithContext(ctx context.Context, request *requests.FPDFAttachment_SetStringValue) (*responses.FPDFAttachment_SetStringValue, error) {
+	return g.withContext(ctx).FPDFAttachment_SetStringValue(request)
+}
+
+func (g *PdfiumRPC) FPDFAvail_CreateWithContext(ctx context.Context, request *requests.FPDFAvail_Create) (*responses.FPDFAvail_Create, error) {
+	return g.withContext(ctx).FPDFAvail_Create(request)
+}
+
+func (g *PdfiumRPC) FPDFAvail_DestroyWithContext(ctx context.Context, request *requests.FPDFAvail_Destroy) (*responses.FPDFAvail_Destroy, error) {
+	return g.withContext(ctx).FPDFAvail_Destroy(request)
+}
+
+func (g *PdfiumRPC) FPDFAvail_GetDocumentWithContext(ctx context.Context, request *requests.FPDFAvail_GetDocument) (*responses.FPDFAvail_GetDocument, error) {
+	return g.withContext(ctx).FPDFAvail_GetDocument(request)
+}
+
+func (g *PdfiumRPC) FPDFAvail_GetFirstPageNumWithContext(ctx context.Context, request *requests.FPDFAvail_GetFirstPageNum) (*responses.FPDFAvail_GetFirstPageNum, error) {
+	return g.withContext(ctx).FPDFAvail_GetFirstPageNum(request)
+}
+
+func (g *PdfiumRPC) FPDFAvail_IsDocAvailWithContext(ctx context.Context, request *requests.FPDFAvail_IsDocAvail) (*responses.FPDFAvail_IsDocAvail, error) {
+	return g.withContext(ctx).FPDFAvail_IsDocAvail(request)
+}
+
+func (g *PdfiumRPC) FPDFAvail_IsFormAvailWithContext(ctx context.Context, request *requests.FPDFAvail_IsFormAvail) (*responses.FPDFAvail_IsFormAvail, error) {
+	return g.withContext(ctx).FPDFAvail_IsFormAvail(request)
+}
+
+func (g *PdfiumRPC) FPDFAvail_IsLinearizedWithContext(ctx context.Context, request *requests.FPDFAvail_IsLinearized) (*responses.FPDFAvail_IsLinearized, error) {
+	return g.withContext(ctx).FPDFAvail_IsLinearized(request)
+}
+
+func (g *PdfiumRPC) FPDFAvail_IsPageAvailWithContext(ctx context.Context, request *requests.FPDFAvail_IsPageAvail) (*responses.FPDFAvail_IsPageAvail, error) {
+	return g.withContext(ctx).FPDFAvail_IsPageAvail(request)
+}
+
+func (g *PdfiumRPC) FPDFBitmap_CreateWithContext(ctx context.Context, request *requests.FPDFBitmap_Create) (*responses.FPDFBitmap_Create, error) {
+	return g.withContext(ctx).FPDFBitmap_Create(request)
+}
+
+func (g *PdfiumRPC) FPDFBitmap_CreateExWithContext(ctx context.Context, request *requests.FPDFBitmap_CreateEx) (*responses.FPDFBitmap_CreateEx, error) {
+	return g.withContext(ctx).FPDFBitmap_CreateEx(request)
+}
+
+func (g *PdfiumRPC) FPDFBitmap_DestroyWithContext(ctx context.Context, request *requests.FPDFBitmap_Destroy) (*responses.FPDFBitmap_Destroy, error) {
+	return g.withContext(ctx).FPDFBitmap_Destroy(request)
+}
+
+func (g *PdfiumRPC) FPDFBitmap_FillRectWithContext(ctx context.Context, request *requests.FPDFBitmap_FillRect) (*responses.FPDFBitmap_FillRect, error) {
+	return g.withContext(ctx).FPDFBitmap_FillRect(request)
+}
+
+func (g *PdfiumRPC) FPDFBitmap_GetBufferWithContext(ctx context.Context, request *requests.FPDFBitmap_GetBuffer) (*responses.FPDFBitmap_GetBuffer, error) {
+	return g.withContext(ctx).FPDFBitmap_GetBuffer(request)
+}
+
+func (g *PdfiumRPC) FPDFBitmap_GetFormatWithContext(ctx context.Context, request *requests.FPDFBitmap_GetFormat) (*responses.FPDFBitmap_GetFormat, error) {
+	return g.withContext(ctx).FPDFBitmap_GetFormat(request)
+}
+
+func (g *PdfiumRPC) FPDFBitmap_GetHeightWithContext(ctx context.Context, request *requests.FPDFBitmap_GetHeight) (*responses.FPDFBitmap_GetHeight, error) {
+	return g.withContext(ctx).FPDFBitmap_GetHeight(request)
+}
+
+func (g *PdfiumRPC) FPDFBitmap_GetStrideWithContext(ctx context.Context, request *requests.FPDFBitmap_GetStride) (*responses.FPDFBitmap_GetStride, error) {
+	return g.withContext(ctx).FPDFBitmap_GetStride(request)
+}
+
+func (g *PdfiumRPC) FPDFBitmap_GetWidthWithContext(ctx context.Context, request *requests.FPDFBitmap_GetWidth) (*responses.FPDFBitmap_GetWidth, error) {
+	return g.withContext(ctx).FPDFBitmap_GetWidth(request)
+}
+
+func (g *PdfiumRPC) FPDFBookmark_FindWithContext(ctx context.Context, request *requests.FPDFBookmark_Find) (*responses.FPDFBookmark_Find, error) {
+	return g.withContext(ctx).FPDFBookmark_Find(request)
+}
+
+func (g *PdfiumRPC) FPDFBookmark_GetActionWithContext(ctx context.Context, request *requests.FPDFBookmark_GetAction) (*responses.FPDFBookmark_GetAction, error) {
+	return g.withContext(ctx).FPDFBookmark_GetAction(request)
+}
+
+func (g *PdfiumRPC) FPDFBookmark_GetColorWithContext(ctx context.Context, request *requests.FPDFBookmark_GetColor) (*responses.FPDFBookmark_GetColor, error) {
+	return g.withContext(ctx).FPDFBookmark_GetColor(request)
+}
+
+func (g *PdfiumRPC) FPDFBookmark_GetCountWithContext(ctx context.Context, request *requests.FPDFBookmark_GetCount) (*responses.FPDFBookmark_GetCount, error) {
+	return g.withContext(ctx).FPDFBookmark_GetCount(request)
+}
+
+func (g *PdfiumRPC) FPDFBookmark_GetDestWithContext(ctx context.Context, request *requests.FPDFBookmark_GetDest) (*responses.FPDFBookmark_GetDest, error) {
+	return g.withContext(ctx).FPDFBookmark_GetDest(request)
+}
+
+func (g *PdfiumRPC) FPDFBookmark_GetFirstChildWithContext(ctx context.Context, request *requests.FPDFBookmark_GetFirstChild) (*responses.FPDFBookmark_GetFirstChild, error) {
+	return g.withContext(ctx).FPDFBookmark_GetFirstChild(request)
+}
+
+func (g *PdfiumRPC) FPDFBookmark_GetNextSiblingWithContext(ctx context.Context, request *requests.FPDFBookmark_GetNextSibling) (*responses.FPDFBookmark_GetNextSibling, error) {
+	return g.withContext(ctx).FPDFBookmark_GetNextSibling(request)
+}
+
+func (g *PdfiumRPC) FPDFBookmark_GetTitleWithContext(ctx context.Context, request *requests.FPDFBookmark_GetTitle) (*responses.FPDFBookmark_GetTitle, error) {
+	return g.withContext(ctx).FPDFBookmark_GetTitle(request)
+}
+
+func (g *PdfiumRPC) FPDFCatalog_GetLanguageWithContext(ctx context.Context, request *requests.FPDFCatalog_GetLanguage) (*responses.FPDFCatalog_GetLanguage, error) {
+	return g.withContext(ctx).FPDFCatalog_GetLanguage(request)
+}
+
+func (g *PdfiumRPC) FPDFCatalog_IsTaggedWithContext(ctx context.Context, request *requests.FPDFCatalog_IsTagged) (*responses.FPDFCatalog_IsTagged, error) {
+	return g.withContext(ctx).FPDFCatalog_IsTagged(request)
+}
+
+func (g *PdfiumRPC) FPDFCatalog_SetLanguageWithContext(ctx context.Context, request *requests.FPDFCatalog_SetLanguage) (*responses.FPDFCatalog_SetLanguage, error) {
+	return g.withContext(ctx).FPDFCatalog_SetLanguage(request)
+}
+
+func (g *PdfiumRPC) FPDFClipPath_CountPathSegmentsWithContext(ctx context.Context, request *requests.FPDFClipPath_CountPathSegments) (*responses.FPDFClipPath_CountPathSegments, error) {
+	return g.withContext(ctx).FPDFClipPath_CountPathSegments(request)
+}
+
+func (g *PdfiumRPC) FPDFClipPath_CountPathsWithContext(ctx context.Context, request *requests.FPDFClipPath_CountPaths) (*responses.FPDFClipPath_CountPaths, error) {
+	return g.withContext(ctx).FPDFClipPath_CountPaths(request)
+}
+
+func (g *PdfiumRPC) FPDFClipPath_GetPathSegmentWithContext(ctx context.Context, request *requests.FPDFClipPath_GetPathSegment) (*responses.FPDFClipPath_GetPathSegment, error) {
+	return g.withContext(ctx).FPDFClipPath_GetPathSegment(request)
+}
+
+func (g *PdfiumRPC) FPDFDOC_ExitFormFillEnvironmentWithContext(ctx context.Context, request *requests.FPDFDOC_ExitFormFillEnvironment) (*responses.FPDFDOC_ExitFormFillEnvironment, error) {
+	return g.withContext(ctx).FPDFDOC_ExitFormFillEnvironment(request)
+}
+
+func (g *PdfiumRPC) FPDFDOC_InitFormFillEnvironmentWithContext(ctx context.Context, request *requests.FPDFDOC_InitFormFillEnvironment) (*responses.FPDFDOC_InitFormFillEnvironment, error) {
+	return g.withContext(ctx).FPDFDOC_InitFormFillEnvironment(request)
+}
+
+func (g *PdfiumRPC) FPDFDest_GetDestPageIndexWithContext(ctx context.Context, request *requests.FPDFDest_GetDestPageIndex) (*responses.FPDFDest_GetDestPageIndex, error) {
+	return g.withContext(ctx).FPDFDest_GetDestPageIndex(request)
+}
+
+func (g *PdfiumRPC) FPDFDest_GetLocationInPageWithContext(ctx context.Context, request *requests.FPDFDest_GetLocationInPage) (*responses.FPDFDest_GetLocationInPage, error) {
+	return g.withContext(ctx).FPDFDest_GetLocationInPage(request)
+}
+
+func (g *PdfiumRPC) FPDFDest_GetViewWithContext(ctx context.Context, request *requests.FPDFDest_GetView) (*responses.FPDFDest_GetView, error) {
+	return g.withContext(ctx).FPDFDest_GetView(request)
+}
+
+func (g *PdfiumRPC) FPDFDoc_AddAttachmentWithContext(ctx context.Context, request *requests.FPDFDoc_AddAttachment) (*responses.FPDFDoc_AddAttachment, error) {
+	return g.withContext(ctx).FPDFDoc_AddAttachment(request)
+}
+
+func (g *PdfiumRPC) FPDFDoc_CloseJavaScriptActionWithContext(ctx context.Context, request *requests.FPDFDoc_CloseJavaScriptAction) (*responses.FPDFDoc_CloseJavaScriptAction, error) {
+	return g.withContext(ctx).FPDFDoc_CloseJavaScriptAction(request)
+}
+
+func (g *PdfiumRPC) FPDFDoc_DeleteAttachmentWithContext(ctx context.Context, request *requests.FPDFDoc_DeleteAttachment) (*responses.FPDFDoc_DeleteAttachment, error) {
+	return g.withContext(ctx).FPDFDoc_DeleteAttachment(request)
+}
+
+func (g *PdfiumRPC) FPDFDoc_GetAttachmentWithContext(ctx context.Context, request *requests.FPDFDoc_GetAttachment) (*responses.FPDFDoc_GetAttachment, error) {
+	return g.withContext(ctx).FPDFDoc_GetAttachment(request)
+}
+
+func (g *PdfiumRPC) FPDFDoc_GetAttachmentCountWithContext(ctx context.Context, request *requests.FPDFDoc_GetAttachmentCount) (*responses.FPDFDoc_GetAttachmentCount, error) {
+	return g.withContext(ctx).FPDFDoc_GetAttachmentCount(request)
+}
+
+func (g *PdfiumRPC) FPDFDoc_GetJavaScriptActionWithContext(ctx context.Context, request *requests.FPDFDoc_GetJavaScriptAction) (*responses.FPDFDoc_GetJavaScriptAction, error) {
+	return g.withContext(ctx).FPDFDoc_GetJavaScriptAction(request)
+}
+
+func (g *PdfiumRPC) FPDFDoc_GetJavaScriptActionCountWithContext(ctx context.Context, request *requests.FPDFDoc_GetJavaScriptActionCount) (*responses.FPDFDoc_GetJavaScriptActionCount, error) {
+	return g.withContext(ctx).FPDFDoc_GetJavaScriptActionCount(request)
+}
+
+func (g *PdfiumRPC) FPDFDoc_GetPageModeWithContext(ctx context.Context, request *requests.FPDFDoc_GetPageMode) (*responses.FPDFDoc_GetPageMode, error) {
+	return g.withContext(ctx).FPDFDoc_GetPageMode(request)
+}
+
+func (g *PdfiumRPC) FPDFFont_CloseWithContext(ctx context.Context, request *requests.FPDFFont_Close) (*responses.FPDFFont_Close, error) {
+	return g.withContext(ctx).FPDFFont_Close(request)
+}
+
+func (g *PdfiumRPC) FPDFFont_GetAscentWithContext(ctx context.Context, request *requests.FPDFFont_GetAscent) (*responses.FPDFFont_GetAscent, error) {
+	return g.withContext(ctx).FPDFFont_GetAscent(request)
+}
+
+func (g *PdfiumRPC) FPDFFont_GetBaseFontNameWithContext(ctx context.Context, request *requests.FPDFFont_GetBaseFontName) (*responses.FPDFFont_GetBaseFontName, error) {
+	return g.withContext(ctx).FPDFFont_GetBaseFontName(request)
+}
+
+func (g *PdfiumRPC) FPDFFont_GetDescentWithContext(ctx context.Context, request *requests.FPDFFont_GetDescent) (*responses.FPDFFont_GetDescent, error) {
+	return g.withContext(ctx).FPDFFont_GetDescent(request)
+}
+
+func (g *PdfiumRPC) FPDFFont_GetFamilyNameWithContext(ctx context.Context, request *requests.FPDFFont_GetFamilyName) (*responses.FPDFFont_GetFamilyName, error) {
+	return g.withContext(ctx).FPDFFont_GetFamilyName(request)
+}
+
+func (g *PdfiumRPC) FPDFFont_GetFlagsWithContext(ctx context.Context, request *requests.FPDFFont_GetFlags) (*responses.FPDFFont_GetFlags, error) {
+	return g.withContext(ctx).FPDFFont_GetFlags(request)
+}
+
+func (g *PdfiumRPC) FPDFFont_GetFontDataWithContext(ctx context.Context, request *requests.FPDFFont_GetFontData) (*responses.FPDFFont_GetFontData, error) {
+	return g.withContext(ctx).FPDFFont_GetFontData(request)
+}
+
+func (g *PdfiumRPC) FPDFFont_GetGlyphPathWithContext(ctx context.Context, request *requests.FPDFFont_GetGlyphPath) (*responses.FPDFFont_GetGlyphPath, error) {
+	return g.withContext(ctx).FPDFFont_GetGlyphPath(request)
+}
+
+func (g *PdfiumRPC) FPDFFont_GetGlyphWidthWithContext(ctx context.Context, request *requests.FPDFFont_GetGlyphWidth) (*responses.FPDFFont_GetGlyphWidth, error) {
+	return g.withContext(ctx).FPDFFont_GetGlyphWidth(request)
+}
+
+func (g *PdfiumRPC) FPDFFont_GetIsEmbeddedWithContext(ctx context.Context, request *requests.FPDFFont_GetIsEmbedded) (*responses.FPDFFont_GetIsEmbedded, error) {
+	return g.withContext(ctx).FPDFFont_GetIsEmbedded(request)
+}
+
+func (g *PdfiumRPC) FPDFFont_GetItalicAngleWithContext(ctx context.Context, request *requests.FPDFFont_GetItalicAngle) (*responses.FPDFFont_GetItalicAngle, error) {
+	return g.withContext(ctx).FPDFFont_GetItalicAngle(request)
+}
+
+func (g *PdfiumRPC) FPDFFont_GetWeightWithContext(ctx context.Context, request *requests.FPDFFont_GetWeight) (*responses.FPDFFont_GetWeight, error) {
+	return g.withContext(ctx).FPDFFont_GetWeight(request)
+}
+
+func (g *PdfiumRPC) FPDFFormObj_CountObjectsWithContext(ctx context.Context, request *requests.FPDFFormObj_CountObjects) (*responses.FPDFFormObj_CountObjects, error) {
+	return g.withContext(ctx).FPDFFormObj_CountObjects(request)
+}
+
+func (g *PdfiumRPC) FPDFFormObj_GetObjectWithContext(ctx context.Context, request *requests.FPDFFormObj_GetObject) (*responses.FPDFFormObj_GetObject, error) {
+	return g.withContext(ctx).FPDFFormObj_GetObject(request)
+}
+
+func (g *PdfiumRPC) FPDFFormObj_RemoveObjectWithContext(ctx context.Context, request *requests.FPDFFormObj_RemoveObject) (*responses.FPDFFormObj_RemoveObject, error) {
+	return g.withContext(ctx).FPDFFormObj_RemoveObject(request)
+}
+
+func (g *PdfiumRPC) FPDFGlyphPath_CountGlyphSegmentsWithContext(ctx context.Context, request *requests.FPDFGlyphPath_CountGlyphSegments) (*responses.FPDFGlyphPath_CountGlyphSegments, error) {
+	return g.withContext(ctx).FPDFGlyphPath_CountGlyphSegments(request)
+}
+
+func (g *PdfiumRPC) FPDFGlyphPath_GetGlyphPathSegmentWithContext(ctx context.Context, request *requests.FPDFGlyphPath_GetGlyphPathSegment) (*responses.FPDFGlyphPath_GetGlyphPathSegment, error) {
+	return g.withContext(ctx).FPDFGlyphPath_GetGlyphPathSegment(request)
+}
+
+func (g *PdfiumRPC) FPDFImageObj_GetBitmapWithContext(ctx context.Context, request *requests.FPDFImageObj_GetBitmap) (*responses.FPDFImageObj_GetBitmap, error) {
+	return g.withContext(ctx).FPDFImageObj_GetBitmap(request)
+}
+
+func (g *PdfiumRPC) FPDFImageObj_GetIccProfileDataDecodedWithContext(ctx context.Context, request *requests.FPDFImageObj_GetIccProfileDataDecoded) (*responses.FPDFImageObj_GetIccProfileDataDecoded, error) {
+	return g.withContext(ctx).FPDFImageObj_GetIccProfileDataDecoded(request)
+}
+
+func (g *PdfiumRPC) FPDFImageObj_GetImageDataDecodedWithContext(ctx context.Context, request *requests.FPDFImageObj_GetImageDataDecoded) (*responses.FPDFImageObj_GetImageDataDecoded, error) {
+	return g.withContext(ctx).FPDFImageObj_GetImageDataDecoded(request)
+}
+
+func (g *PdfiumRPC) FPDFImageObj_GetImageDataRawWithContext(ctx context.Context, request *requests.FPDFImageObj_GetImageDataRaw) (*responses.FPDFImageObj_GetImageDataRaw, error) {
+	return g.withContext(ctx).FPDFImageObj_GetImageDataRaw(request)
+}
+
+func (g *PdfiumRPC) FPDFImageObj_GetImageFilterWithContext(ctx context.Context, request *requests.FPDFImageObj_GetImageFilter) (*responses.FPDFImageObj_GetImageFilter, error) {
+	return g.withContext(ctx).FPDFImageObj_GetImageFilter(request)
+}
+
+func (g *PdfiumRPC) FPDFImageObj_GetImageFilterCountWithContext(ctx context.Context, request *requests.FPDFImageObj_GetImageFilterCount) (*responses.FPDFImageObj_GetImageFilterCount, error) {
+	return g.withContext(ctx).FPDFImageObj_GetImageFilterCount(request)
+}
+
+func (g *PdfiumRPC) FPDFImageObj_GetImageMetadataWithContext(ctx context.Context, request *requests.FPDFImageObj_GetImageMetadata) (*responses.FPDFImageObj_GetImageMetadata, error) {
+	return g.withContext(ctx).FPDFImageObj_GetImageMetadata(request)
+}
+
+func (g *PdfiumRPC) FPDFImageObj_GetImagePixelSizeWithContext(ctx context.Context, request *requests.FPDFImageObj_GetImagePixelSize) (*responses.FPDFImageObj_GetImagePixelSize, error) {
+	return g.withContext(ctx).FPDFImageObj_GetImagePixelSize(request)
+}
+
+func (g *PdfiumRPC) FPDFImageObj_GetRenderedBitmapWithContext(ctx context.Context, request *requests.FPDFImageObj_GetRenderedBitmap) (*responses.FPDFImageObj_GetRenderedBitmap, error) {
+	return g.withContext(ctx).FPDFImageObj_GetRenderedBitmap(request)
+}
+
+func (g *PdfiumRPC) FPDFImageObj_LoadJpegFileWithContext(ctx context.Context, request *requests.FPDFImageObj_LoadJpegFile) (*responses.FPDFImageObj_LoadJpegFile, error) {
+	return g.withContext(ctx).FPDFImageObj_LoadJpegFile(request)
+}
+
+func (g *PdfiumRPC) FPDFImageObj_LoadJpegFileInlineWithContext(ctx context.Context, request *requests.FPDFImageObj_LoadJpegFileInline) (*responses.FPDFImageObj_LoadJpegFileInline, error) {
+	return g.withContext(ctx).FPDFImageObj_LoadJpegFileInline(request)
+}
+
+func (g *PdfiumRPC) FPDFImageObj_SetBitmapWithContext(ctx context.Context, request *requests.FPDFImageObj_SetBitmap) (*responses.FPDFImageObj_SetBitmap, error) {
+	return g.withContext(ctx).FPDFImageObj_SetBitmap(request)
+}
+
+func (g *PdfiumRPC) FPDFImageObj_SetMatrixWithContext(ctx context.Context, request *requests.FPDFImageObj_SetMatrix) (*responses.FPDFImageObj_SetMatrix, error) {
+	return g.withContext(ctx).FPDFImageObj_SetMatrix(request)
+}
+
+func (g *PdfiumRPC) FPDFJavaScriptAction_GetNameWithContext(ctx context.Context, request *requests.FPDFJavaScriptAction_GetName) (*responses.FPDFJavaScriptAction_GetName, error) {
+	return g.withContext(ctx).FPDFJavaScriptAction_GetName(request)
+}
+
+func (g *PdfiumRPC) FPDFJavaScriptAction_GetScriptWithContext(ctx context.Context, request *requests.FPDFJavaScriptAction_GetScript) (*responses.FPDFJavaScriptAction_GetScript, error) {
+	return g.withContext(ctx).FPDFJavaScriptAction_GetScript(request)
+}
+
+func (g *PdfiumRPC) FPDFLink_CloseWebLinksWithContext(ctx context.Context, request *requests.FPDFLink_CloseWebLinks) (*responses.FPDFLink_CloseWebLinks, error) {
+	return g.withContext(ctx).FPDFLink_CloseWebLinks(request)
+}
+
+func (g *PdfiumRPC) FPDFLink_CountQuadPointsWithContext(ctx context.Context, request *requests.FPDFLink_CountQuadPoints) (*responses.FPDFLink_CountQuadPoints, error) {
+	return g.withContext(ctx).FPDFLink_CountQuadPoints(request)
+}
+
+func (g *PdfiumRPC) FPDFLink_CountRectsWithContext(ctx context.Context, request *requests.FPDFLink_CountRects) (*responses.FPDFLink_CountRects, error) {
+	return g.withContext(ctx).FPDFLink_CountRects(request)
+}
+
+func (g *PdfiumRPC) FPDFLink_CountWebLinksWithContext(ctx context.Context, request *requests.FPDFLink_CountWebLinks) (*responses.FPDFLink_CountWebLinks, error) {
+	return g.withContext(ctx).FPDFLink_CountWebLinks(request)
+}
+
+func (g *PdfiumRPC) FPDFLink_EnumerateWithContext(ctx context.Context, request *requests.FPDFLink_Enumerate) (*responses.FPDFLink_Enumerate, error) {
+	return g.withContext(ctx).FPDFLink_Enumerate(request)
+}
+
+func (g *PdfiumRPC) FPDFLink_GetActionWithContext(ctx context.Context, request *requests.FPDFLink_GetAction) (*responses.FPDFLink_GetAction, error) {
+	return g.withContext(ctx).FPDFLink_GetAction(request)
+}
+
+func (g *PdfiumRPC) FPDFLink_GetAnnotWithContext(ctx context.Context, request *requests.FPDFLink_GetAnnot) (*responses.FPDFLink_GetAnnot, error) {
+	return g.withContext(ctx).FPDFLink_GetAnnot(request)
+}
+
+func (g *PdfiumRPC) FPDFLink_GetAnnotRectWithContext(ctx context.Context, request *requests.FPDFLink_GetAnnotRect) (*responses.FPDFLink_GetAnnotRect, error) {
+	return g.withContext(ctx).FPDFLink_GetAnnotRect(request)
+}
+
+func (g *PdfiumRPC) FPDFLink_GetDestWithContext(ctx context.Context, request *requests.FPDFLink_GetDest) (*responses.FPDFLink_GetDest, error) {
+	return g.withContext(ctx).FPDFLink_GetDest(request)
+}
+
+func (g *PdfiumRPC) FPDFLink_GetLinkAtPointWithContext(ctx context.Context, request *requests.FPDFLink_GetLinkAtPoint) (*responses.FPDFLink_GetLinkAtPoint, error) {
+	return g.withContext(ctx).FPDFLink_GetLinkAtPoint(request)
+}
+
+func (g *PdfiumRPC) FPDFLink_GetLinkZOrderAtPointWithContext(ctx context.Context, request *requests.FPDFLink_GetLinkZOrderAtPoint) (*responses.FPDFLink_GetLinkZOrderAtPoint, error) {
+	return g.withContext(ctx).FPDFLink_GetLinkZOrderAtPoint(request)
+}
+
+func (g *PdfiumRPC) FPDFLink_GetQuadPointsWithContext(ctx context.Context, request *requests.FPDFLink_GetQuadPoints) (*responses.FPDFLink_GetQuadPoints, error) {
+	return g.withContext(ctx).FPDFLink_GetQuadPoints(request)
+}
+
+func (g *PdfiumRPC) FPDFLink_GetRectWithContext(ctx context.Context, request *requests.FPDFLink_GetRect) (*responses.FPDFLink_GetRect, error) {
+	return g.withContext(ctx).FPDFLink_GetRect(request)
+}
+
+func (g *PdfiumRPC) FPDFLink_GetTextRangeWithContext(ctx context.Context, request *requests.FPDFLink_GetTextRange) (*responses.FPDFLink_GetTextRange, error) {
+	return g.withContext(ctx).FPDFLink_GetTextRange(request)
+}
+
+func (g *PdfiumRPC) FPDFLink_GetURLWithContext(ctx context.Context, request *requests.FPDFLink_GetURL) (*responses.FPDFLink_GetURL, error) {
+	return g.withContext(ctx).FPDFLink_GetURL(request)
+}
+
+func (g *PdfiumRPC) FPDFLink_LoadWebLinksWithContext(ctx context.Context, request *requests.FPDFLink_LoadWebLinks) (*responses.FPDFLink_LoadWebLinks, error) {
+	return g.withContext(ctx).FPDFLink_LoadWebLinks(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObjMark_CountParamsWithContext(ctx context.Context, request *requests.FPDFPageObjMark_CountParams) (*responses.FPDFPageObjMark_CountParams, error) {
+	return g.withContext(ctx).FPDFPageObjMark_CountParams(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObjMark_GetNameWithContext(ctx context.Context, request *requests.FPDFPageObjMark_GetName) (*responses.FPDFPageObjMark_GetName, error) {
+	return g.withContext(ctx).FPDFPageObjMark_GetName(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObjMark_GetParamBlobValueWithContext(ctx context.Context, request *requests.FPDFPageObjMark_GetParamBlobValue) (*responses.FPDFPageObjMark_GetParamBlobValue, error) {
+	return g.withContext(ctx).FPDFPageObjMark_GetParamBlobValue(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObjMark_GetParamFloatValueWithContext(ctx context.Context, request *requests.FPDFPageObjMark_GetParamFloatValue) (*responses.FPDFPageObjMark_GetParamFloatValue, error) {
+	return g.withContext(ctx).FPDFPageObjMark_GetParamFloatValue(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObjMark_GetParamIntValueWithContext(ctx context.Context, request *requests.FPDFPageObjMark_GetParamIntValue) (*responses.FPDFPageObjMark_GetParamIntValue, error) {
+	return g.withContext(ctx).FPDFPageObjMark_GetParamIntValue(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObjMark_GetParamKeyWithContext(ctx context.Context, request *requests.FPDFPageObjMark_GetParamKey) (*responses.FPDFPageObjMark_GetParamKey, error) {
+	return g.withContext(ctx).FPDFPageObjMark_GetParamKey(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObjMark_GetParamStringValueWithContext(ctx context.Context, request *requests.FPDFPageObjMark_GetParamStringValue) (*responses.FPDFPageObjMark_GetParamStringValue, error) {
+	return g.withContext(ctx).FPDFPageObjMark_GetParamStringValue(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObjMark_GetParamValueTypeWithContext(ctx context.Context, request *requests.FPDFPageObjMark_GetParamValueType) (*responses.FPDFPageObjMark_GetParamValueType, error) {
+	return g.withContext(ctx).FPDFPageObjMark_GetParamValueType(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObjMark_RemoveParamWithContext(ctx context.Context, request *requests.FPDFPageObjMark_RemoveParam) (*responses.FPDFPageObjMark_RemoveParam, error) {
+	return g.withContext(ctx).FPDFPageObjMark_RemoveParam(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObjMark_SetBlobParamWithContext(ctx context.Context, request *requests.FPDFPageObjMark_SetBlobParam) (*responses.FPDFPageObjMark_SetBlobParam, error) {
+	return g.withContext(ctx).FPDFPageObjMark_SetBlobParam(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObjMark_SetFloatParamWithContext(ctx context.Context, request *requests.FPDFPageObjMark_SetFloatParam) (*responses.FPDFPageObjMark_SetFloatParam, error) {
+	return g.withContext(ctx).FPDFPageObjMark_SetFloatParam(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObjMark_SetIntParamWithContext(ctx context.Context, request *requests.FPDFPageObjMark_SetIntParam) (*responses.FPDFPageObjMark_SetIntParam, error) {
+	return g.withContext(ctx).FPDFPageObjMark_SetIntParam(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObjMark_SetStringParamWithContext(ctx context.Context, request *requests.FPDFPageObjMark_SetStringParam) (*responses.FPDFPageObjMark_SetStringParam, error) {
+	return g.withContext(ctx).FPDFPageObjMark_SetStringParam(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_AddExistingMarkWithContext(ctx context.Context, request *requests.FPDFPageObj_AddExistingMark) (*responses.FPDFPageObj_AddExistingMark, error) {
+	return g.withContext(ctx).FPDFPageObj_AddExistingMark(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_AddMarkWithContext(ctx context.Context, request *requests.FPDFPageObj_AddMark) (*responses.FPDFPageObj_AddMark, error) {
+	return g.withContext(ctx).FPDFPageObj_AddMark(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_CountMarksWithContext(ctx context.Context, request *requests.FPDFPageObj_CountMarks) (*responses.FPDFPageObj_CountMarks, error) {
+	return g.withContext(ctx).FPDFPageObj_CountMarks(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_CreateNewPathWithContext(ctx context.Context, request *requests.FPDFPageObj_CreateNewPath) (*responses.FPDFPageObj_CreateNewPath, error) {
+	return g.withContext(ctx).FPDFPageObj_CreateNewPath(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_CreateNewRectWithContext(ctx context.Context, request *requests.FPDFPageObj_CreateNewRect) (*responses.FPDFPageObj_CreateNewRect, error) {
+	return g.withContext(ctx).FPDFPageObj_CreateNewRect(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_CreateTextObjWithContext(ctx context.Context, request *requests.FPDFPageObj_CreateTextObj) (*responses.FPDFPageObj_CreateTextObj, error) {
+	return g.withContext(ctx).FPDFPageObj_CreateTextObj(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_DestroyWithContext(ctx context.Context, request *requests.FPDFPageObj_Destroy) (*responses.FPDFPageObj_Destroy, error) {
+	return g.withContext(ctx).FPDFPageObj_Destroy(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_GetBoundsWithContext(ctx context.Context, request *requests.FPDFPageObj_GetBounds) (*responses.FPDFPageObj_GetBounds, error) {
+	return g.withContext(ctx).FPDFPageObj_GetBounds(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_GetClipPathWithContext(ctx context.Context, request *requests.FPDFPageObj_GetClipPath) (*responses.FPDFPageObj_GetClipPath, error) {
+	return g.withContext(ctx).FPDFPageObj_GetClipPath(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_GetDashArrayWithContext(ctx context.Context, request *requests.FPDFPageObj_GetDashArray) (*responses.FPDFPageObj_GetDashArray, error) {
+	return g.withContext(ctx).FPDFPageObj_GetDashArray(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_GetDashCountWithContext(ctx context.Context, request *requests.FPDFPageObj_GetDashCount) (*responses.FPDFPageObj_GetDashCount, error) {
+	return g.withContext(ctx).FPDFPageObj_GetDashCount(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_GetDashPhaseWithContext(ctx context.Context, request *requests.FPDFPageObj_GetDashPhase) (*responses.FPDFPageObj_GetDashPhase, error) {
+	return g.withContext(ctx).FPDFPageObj_GetDashPhase(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_GetFillColorWithContext(ctx context.Context, request *requests.FPDFPageObj_GetFillColor) (*responses.FPDFPageObj_GetFillColor, error) {
+	return g.withContext(ctx).FPDFPageObj_GetFillColor(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_GetIsActiveWithContext(ctx context.Context, request *requests.FPDFPageObj_GetIsActive) (*responses.FPDFPageObj_GetIsActive, error) {
+	return g.withContext(ctx).FPDFPageObj_GetIsActive(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_GetLineCapWithContext(ctx context.Context, request *requests.FPDFPageObj_GetLineCap) (*responses.FPDFPageObj_GetLineCap, error) {
+	return g.withContext(ctx).FPDFPageObj_GetLineCap(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_GetLineJoinWithContext(ctx context.Context, request *requests.FPDFPageObj_GetLineJoin) (*responses.FPDFPageObj_GetLineJoin, error) {
+	return g.withContext(ctx).FPDFPageObj_GetLineJoin(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_GetMarkWithContext(ctx context.Context, request *requests.FPDFPageObj_GetMark) (*responses.FPDFPageObj_GetMark, error) {
+	return g.withContext(ctx).FPDFPageObj_GetMark(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_GetMarkedContentIDWithContext(ctx context.Context, request *requests.FPDFPageObj_GetMarkedContentID) (*responses.FPDFPageObj_GetMarkedContentID, error) {
+	return g.withContext(ctx).FPDFPageObj_GetMarkedContentID(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_GetMatrixWithContext(ctx context.Context, request *requests.FPDFPageObj_GetMatrix) (*responses.FPDFPageObj_GetMatrix, error) {
+	return g.withContext(ctx).FPDFPageObj_GetMatrix(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_GetRotatedBoundsWithContext(ctx context.Context, request *requests.FPDFPageObj_GetRotatedBounds) (*responses.FPDFPageObj_GetRotatedBounds, error) {
+	return g.withContext(ctx).FPDFPageObj_GetRotatedBounds(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_GetStrokeColorWithContext(ctx context.Context, request *requests.FPDFPageObj_GetStrokeColor) (*responses.FPDFPageObj_GetStrokeColor, error) {
+	return g.withContext(ctx).FPDFPageObj_GetStrokeColor(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_GetStrokeWidthWithContext(ctx context.Context, request *requests.FPDFPageObj_GetStrokeWidth) (*responses.FPDFPageObj_GetStrokeWidth, error) {
+	return g.withContext(ctx).FPDFPageObj_GetStrokeWidth(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_GetTypeWithContext(ctx context.Context, request *requests.FPDFPageObj_GetType) (*responses.FPDFPageObj_GetType, error) {
+	return g.withContext(ctx).FPDFPageObj_GetType(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_HasTransparencyWithContext(ctx context.Context, request *requests.FPDFPageObj_HasTransparency) (*responses.FPDFPageObj_HasTransparency, error) {
+	return g.withContext(ctx).FPDFPageObj_HasTransparency(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_NewImageObjWithContext(ctx context.Context, request *requests.FPDFPageObj_NewImageObj) (*responses.FPDFPageObj_NewImageObj, error) {
+	return g.withContext(ctx).FPDFPageObj_NewImageObj(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_NewTextObjWithContext(ctx context.Context, request *requests.FPDFPageObj_NewTextObj) (*responses.FPDFPageObj_NewTextObj, error) {
+	return g.withContext(ctx).FPDFPageObj_NewTextObj(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_RemoveMarkWithContext(ctx context.Context, request *requests.FPDFPageObj_RemoveMark) (*responses.FPDFPageObj_RemoveMark, error) {
+	return g.withContext(ctx).FPDFPageObj_RemoveMark(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_SetBlendModeWithContext(ctx context.Context, request *requests.FPDFPageObj_SetBlendMode) (*responses.FPDFPageObj_SetBlendMode, error) {
+	return g.withContext(ctx).FPDFPageObj_SetBlendMode(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_SetDashArrayWithContext(ctx context.Context, request *requests.FPDFPageObj_SetDashArray) (*responses.FPDFPageObj_SetDashArray, error) {
+	return g.withContext(ctx).FPDFPageObj_SetDashArray(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_SetDashPhaseWithContext(ctx context.Context, request *requests.FPDFPageObj_SetDashPhase) (*responses.FPDFPageObj_SetDashPhase, error) {
+	return g.withContext(ctx).FPDFPageObj_SetDashPhase(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_SetFillColorWithContext(ctx context.Context, request *requests.FPDFPageObj_SetFillColor) (*responses.FPDFPageObj_SetFillColor, error) {
+	return g.withContext(ctx).FPDFPageObj_SetFillColor(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_SetIsActiveWithContext(ctx context.Context, request *requests.FPDFPageObj_SetIsActive) (*responses.FPDFPageObj_SetIsActive, error) {
+	return g.withContext(ctx).FPDFPageObj_SetIsActive(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_SetLineCapWithContext(ctx context.Context, request *requests.FPDFPageObj_SetLineCap) (*responses.FPDFPageObj_SetLineCap, error) {
+	return g.withContext(ctx).FPDFPageObj_SetLineCap(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_SetLineJoinWithContext(ctx context.Context, request *requests.FPDFPageObj_SetLineJoin) (*responses.FPDFPageObj_SetLineJoin, error) {
+	return g.withContext(ctx).FPDFPageObj_SetLineJoin(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_SetMatrixWithContext(ctx context.Context, request *requests.FPDFPageObj_SetMatrix) (*responses.FPDFPageObj_SetMatrix, error) {
+	return g.withContext(ctx).FPDFPageObj_SetMatrix(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_SetStrokeColorWithContext(ctx context.Context, request *requests.FPDFPageObj_SetStrokeColor) (*responses.FPDFPageObj_SetStrokeColor, error) {
+	return g.withContext(ctx).FPDFPageObj_SetStrokeColor(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_SetStrokeWidthWithContext(ctx context.Context, request *requests.FPDFPageObj_SetStrokeWidth) (*responses.FPDFPageObj_SetStrokeWidth, error) {
+	return g.withContext(ctx).FPDFPageObj_SetStrokeWidth(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_TransformWithContext(ctx context.Context, request *requests.FPDFPageObj_Transform) (*responses.FPDFPageObj_Transform, error) {
+	return g.withContext(ctx).FPDFPageObj_Transform(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_TransformClipPathWithContext(ctx context.Context, request *requests.FPDFPageObj_TransformClipPath) (*responses.FPDFPageObj_TransformClipPath, error) {
+	return g.withContext(ctx).FPDFPageObj_TransformClipPath(request)
+}
+
+func (g *PdfiumRPC) FPDFPageObj_TransformFWithContext(ctx context.Context, request *requests.FPDFPageObj_TransformF) (*responses.FPDFPageObj_TransformF, error) {
+	return g.withContext(ctx).FPDFPageObj_TransformF(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_CloseAnnotWithContext(ctx context.Context, request *requests.FPDFPage_CloseAnnot) (*responses.FPDFPage_CloseAnnot, error) {
+	return g.withContext(ctx).FPDFPage_CloseAnnot(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_CountObjectsWithContext(ctx context.Context, request *requests.FPDFPage_CountObjects) (*responses.FPDFPage_CountObjects, error) {
+	return g.withContext(ctx).FPDFPage_CountObjects(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_CreateAnnotWithContext(ctx context.Context, request *requests.FPDFPage_CreateAnnot) (*responses.FPDFPage_CreateAnnot, error) {
+	return g.withContext(ctx).FPDFPage_CreateAnnot(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_DeleteWithContext(ctx context.Context, request *requests.FPDFPage_Delete) (*responses.FPDFPage_Delete, error) {
+	return g.withContext(ctx).FPDFPage_Delete(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_FlattenWithContext(ctx context.Context, request *requests.FPDFPage_Flatten) (*responses.FPDFPage_Flatten, error) {
+	return g.withContext(ctx).FPDFPage_Flatten(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_FormFieldZOrderAtPointWithContext(ctx context.Context, request *requests.FPDFPage_FormFieldZOrderAtPoint) (*responses.FPDFPage_FormFieldZOrderAtPoint, error) {
+	return g.withContext(ctx).FPDFPage_FormFieldZOrderAtPoint(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_GenerateContentWithContext(ctx context.Context, request *requests.FPDFPage_GenerateContent) (*responses.FPDFPage_GenerateContent, error) {
+	return g.withContext(ctx).FPDFPage_GenerateContent(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_GetAnnotWithContext(ctx context.Context, request *requests.FPDFPage_GetAnnot) (*responses.FPDFPage_GetAnnot, error) {
+	return g.withContext(ctx).FPDFPage_GetAnnot(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_GetAnnotCountWithContext(ctx context.Context, request *requests.FPDFPage_GetAnnotCount) (*responses.FPDFPage_GetAnnotCount, error) {
+	return g.withContext(ctx).FPDFPage_GetAnnotCount(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_GetAnnotIndexWithContext(ctx context.Context, request *requests.FPDFPage_GetAnnotIndex) (*responses.FPDFPage_GetAnnotIndex, error) {
+	return g.withContext(ctx).FPDFPage_GetAnnotIndex(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_GetArtBoxWithContext(ctx context.Context, request *requests.FPDFPage_GetArtBox) (*responses.FPDFPage_GetArtBox, error) {
+	return g.withContext(ctx).FPDFPage_GetArtBox(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_GetBleedBoxWithContext(ctx context.Context, request *requests.FPDFPage_GetBleedBox) (*responses.FPDFPage_GetBleedBox, error) {
+	return g.withContext(ctx).FPDFPage_GetBleedBox(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_GetCropBoxWithContext(ctx context.Context, request *requests.FPDFPage_GetCropBox) (*responses.FPDFPage_GetCropBox, error) {
+	return g.withContext(ctx).FPDFPage_GetCropBox(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_GetDecodedThumbnailDataWithContext(ctx context.Context, request *requests.FPDFPage_GetDecodedThumbnailData) (*responses.FPDFPage_GetDecodedThumbnailData, error) {
+	return g.withContext(ctx).FPDFPage_GetDecodedThumbnailData(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_GetMediaBoxWithContext(ctx context.Context, request *requests.FPDFPage_GetMediaBox) (*responses.FPDFPage_GetMediaBox, error) {
+	return g.withContext(ctx).FPDFPage_GetMediaBox(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_GetObjectWithContext(ctx context.Context, request *requests.FPDFPage_GetObject) (*responses.FPDFPage_GetObject, error) {
+	return g.withContext(ctx).FPDFPage_GetObject(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_GetRawThumbnailDataWithContext(ctx context.Context, request *requests.FPDFPage_GetRawThumbnailData) (*responses.FPDFPage_GetRawThumbnailData, error) {
+	return g.withContext(ctx).FPDFPage_GetRawThumbnailData(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_GetRotationWithContext(ctx context.Context, request *requests.FPDFPage_GetRotation) (*responses.FPDFPage_GetRotation, error) {
+	return g.withContext(ctx).FPDFPage_GetRotation(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_GetThumbnailAsBitmapWithContext(ctx context.Context, request *requests.FPDFPage_GetThumbnailAsBitmap) (*responses.FPDFPage_GetThumbnailAsBitmap, error) {
+	return g.withContext(ctx).FPDFPage_GetThumbnailAsBitmap(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_GetTrimBoxWithContext(ctx context.Context, request *requests.FPDFPage_GetTrimBox) (*responses.FPDFPage_GetTrimBox, error) {
+	return g.withContext(ctx).FPDFPage_GetTrimBox(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_HasFormFieldAtPointWithContext(ctx context.Context, request *requests.FPDFPage_HasFormFieldAtPoint) (*responses.FPDFPage_HasFormFieldAtPoint, error) {
+	return g.withContext(ctx).FPDFPage_HasFormFieldAtPoint(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_HasTransparencyWithContext(ctx context.Context, request *requests.FPDFPage_HasTransparency) (*responses.FPDFPage_HasTransparency, error) {
+	return g.withContext(ctx).FPDFPage_HasTransparency(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_InsertClipPathWithContext(ctx context.Context, request *requests.FPDFPage_InsertClipPath) (*responses.FPDFPage_InsertClipPath, error) {
+	return g.withContext(ctx).FPDFPage_InsertClipPath(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_InsertObjectWithContext(ctx context.Context, request *requests.FPDFPage_InsertObject) (*responses.FPDFPage_InsertObject, error) {
+	return g.withContext(ctx).FPDFPage_InsertObject(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_InsertObjectAtIndexWithContext(ctx context.Context, request *requests.FPDFPage_InsertObjectAtIndex) (*responses.FPDFPage_InsertObjectAtIndex, error) {
+	return g.withContext(ctx).FPDFPage_InsertObjectAtIndex(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_NewWithContext(ctx context.Context, request *requests.FPDFPage_New) (*responses.FPDFPage_New, error) {
+	return g.withContext(ctx).FPDFPage_New(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_RemoveAnnotWithContext(ctx context.Context, request *requests.FPDFPage_RemoveAnnot) (*responses.FPDFPage_RemoveAnnot, error) {
+	return g.withContext(ctx).FPDFPage_RemoveAnnot(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_RemoveObjectWithContext(ctx context.Context, request *requests.FPDFPage_RemoveObject) (*responses.FPDFPage_RemoveObject, error) {
+	return g.withContext(ctx).FPDFPage_RemoveObject(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_SetArtBoxWithContext(ctx context.Context, request *requests.FPDFPage_SetArtBox) (*responses.FPDFPage_SetArtBox, error) {
+	return g.withContext(ctx).FPDFPage_SetArtBox(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_SetBleedBoxWithContext(ctx context.Context, request *requests.FPDFPage_SetBleedBox) (*responses.FPDFPage_SetBleedBox, error) {
+	return g.withContext(ctx).FPDFPage_SetBleedBox(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_SetCropBoxWithContext(ctx context.Context, request *requests.FPDFPage_SetCropBox) (*responses.FPDFPage_SetCropBox, error) {
+	return g.withContext(ctx).FPDFPage_SetCropBox(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_SetMediaBoxWithContext(ctx context.Context, request *requests.FPDFPage_SetMediaBox) (*responses.FPDFPage_SetMediaBox, error) {
+	return g.withContext(ctx).FPDFPage_SetMediaBox(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_SetRotationWithContext(ctx context.Context, request *requests.FPDFPage_SetRotation) (*responses.FPDFPage_SetRotation, error) {
+	return g.withContext(ctx).FPDFPage_SetRotation(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_SetTrimBoxWithContext(ctx context.Context, request *requests.FPDFPage_SetTrimBox) (*responses.FPDFPage_SetTrimBox, error) {
+	return g.withContext(ctx).FPDFPage_SetTrimBox(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_TransFormWithClipWithContext(ctx context.Context, request *requests.FPDFPage_TransFormWithClip) (*responses.FPDFPage_TransFormWithClip, error) {
+	return g.withContext(ctx).FPDFPage_TransFormWithClip(request)
+}
+
+func (g *PdfiumRPC) FPDFPage_TransformAnnotsWithContext(ctx context.Context, request *requests.FPDFPage_TransformAnnots) (*responses.FPDFPage_TransformAnnots, error) {
+	return g.withContext(ctx).FPDFPage_TransformAnnots(request)
+}
+
+func (g *PdfiumRPC) FPDFPathSegment_GetCloseWithContext(ctx context.Context, request *requests.FPDFPathSegment_GetClose) (*responses.FPDFPathSegment_GetClose, error) {
+	return g.withContext(ctx).FPDFPathSegment_GetClose(request)
+}
+
+func (g *PdfiumRPC) FPDFPathSegment_GetPointWithContext(ctx context.Context, request *requests.FPDFPathSegment_GetPoint) (*responses.FPDFPathSegment_GetPoint, error) {
+	return g.withContext(ctx).FPDFPathSegment_GetPoint(request)
+}
+
+func (g *PdfiumRPC) FPDFPathSegment_GetTypeWithContext(ctx context.Context, request *requests.FPDFPathSegment_GetType) (*responses.FPDFPathSegment_GetType, error) {
+	return g.withContext(ctx).FPDFPathSegment_GetType(request)
+}
+
+func (g *PdfiumRPC) FPDFPath_BezierToWithContext(ctx context.Context, request *requests.FPDFPath_BezierTo) (*responses.FPDFPath_BezierTo, error) {
+	return g.withContext(ctx).FPDFPath_BezierTo(request)
+}
+
+func (g *PdfiumRPC) FPDFPath_CloseWithContext(ctx context.Context, request *requests.FPDFPath_Close) (*responses.FPDFPath_Close, error) {
+	return g.withContext(ctx).FPDFPath_Close(request)
+}
+
+func (g *PdfiumRPC) FPDFPath_CountSegmentsWithContext(ctx context.Context, request *requests.FPDFPath_CountSegments) (*responses.FPDFPath_CountSegments, error) {
+	return g.withContext(ctx).FPDFPath_CountSegments(request)
+}
+
+func (g *PdfiumRPC) FPDFPath_GetDrawModeWithContext(ctx context.Context, request *requests.FPDFPath_GetDrawMode) (*responses.FPDFPath_GetDrawMode, error) {
+	return g.withContext(ctx).FPDFPath_GetDrawMode(request)
+}
+
+func (g *PdfiumRPC) FPDFPath_GetPathSegmentWithContext(ctx context.Context, request *requests.FPDFPath_GetPathSegment) (*responses.FPDFPath_GetPathSegment, error) {
+	return g.withContext(ctx).FPDFPath_GetPathSegment(request)
+}
+
+func (g *PdfiumRPC) FPDFPath_LineToWithContext(ctx context.Context, request *requests.FPDFPath_LineTo) (*responses.FPDFPath_LineTo, error) {
+	return g.withContext(ctx).FPDFPath_LineTo(request)
+}
+
+func (g *PdfiumRPC) FPDFPath_MoveToWithContext(ctx context.Context, request *requests.FPDFPath_MoveTo) (*responses.FPDFPath_MoveTo, error) {
+	return g.withContext(ctx).FPDFPath_MoveTo(request)
+}
+
+func (g *PdfiumRPC) FPDFPath_SetDrawModeWithContext(ctx context.Context, request *requests.FPDFPath_SetDrawMode) (*responses.FPDFPath_SetDrawMode, error) {
+	return g.withContext(ctx).FPDFPath_SetDrawMode(request)
+}
+
+func (g *PdfiumRPC) FPDFSignatureObj_GetByteRangeWithContext(ctx context.Context, request *requests.FPDFSignatureObj_GetByteRange) (*responses.FPDFSignatureObj_GetByteRange, error) {
+	return g.withContext(ctx).FPDFSignatureObj_GetByteRange(request)
+}
+
+func (g *PdfiumRPC) FPDFSignatureObj_GetContentsWithContext(ctx context.Context, request *requests.FPDFSignatureObj_GetContents) (*responses.FPDFSignatureObj_GetContents, error) {
+	return g.withContext(ctx).FPDFSignatureObj_GetContents(request)
+}
+
+func (g *PdfiumRPC) FPDFSignatureObj_GetDocMDPPermissionWithContext(ctx context.Context, request *requests.FPDFSignatureObj_GetDocMDPPermission) (*responses.FPDFSignatureObj_GetDocMDPPermission, error) {
+	return g.withContext(ctx).FPDFSignatureObj_GetDocMDPPermission(request)
+}
+
+func (g *PdfiumRPC) FPDFSignatureObj_GetReasonWithContext(ctx context.Context, request *requests.FPDFSignatureObj_GetReason) (*responses.FPDFSignatureObj_GetReason, error) {
+	return g.withContext(ctx).FPDFSignatureObj_GetReason(request)
+}
+
+func (g *PdfiumRPC) FPDFSignatureObj_GetSubFilterWithContext(ctx context.Context, request *requests.FPDFSignatureObj_GetSubFilter) (*responses.FPDFSignatureObj_GetSubFilter, error) {
+	return g.withContext(ctx).FPDFSignatureObj_GetSubFilter(request)
+}
+
+func (g *PdfiumRPC) FPDFSignatureObj_GetTimeWithContext(ctx context.Context, request *requests.FPDFSignatureObj_GetTime) (*responses.FPDFSignatureObj_GetTime, error) {
+	return g.withContext(ctx).FPDFSignatureObj_GetTime(request)
+}
+
+func (g *PdfiumRPC) FPDFTextObj_GetFontWithContext(ctx context.Context, request *requests.FPDFTextObj_GetFont) (*responses.FPDFTextObj_GetFont, error) {
+	return g.withContext(ctx).FPDFTextObj_GetFont(request)
+}
+
+func (g *PdfiumRPC) FPDFTextObj_GetFontSizeWithContext(ctx context.Context, request *requests.FPDFTextObj_GetFontSize) (*responses.FPDFTextObj_GetFontSize, error) {
+	return g.withContext(ctx).FPDFTextObj_GetFontSize(request)
+}
+
+func (g *PdfiumRPC) FPDFTextObj_GetRenderedBitmapWithContext(ctx context.Context, request *requests.FPDFTextObj_GetRenderedBitmap) (*responses.FPDFTextObj_GetRenderedBitmap, error) {
+	return g.withContext(ctx).FPDFTextObj_GetRenderedBitmap(request)
+}
+
+func (g *PdfiumRPC) FPDFTextObj_GetTextWithContext(ctx context.Context, request *requests.FPDFTextObj_GetText) (*responses.FPDFTextObj_GetText, error) {
+	return g.withContext(ctx).FPDFTextObj_GetText(request)
+}
+
+func (g *PdfiumRPC) FPDFTextObj_GetTextRenderModeWithContext(ctx context.Context, request *requests.FPDFTextObj_GetTextRenderMode) (*responses.FPDFTextObj_GetTextRenderMode, error) {
+	return g.withContext(ctx).FPDFTextObj_GetTextRenderMode(request)
+}
+
+func (g *PdfiumRPC) FPDFTextObj_SetFontSizeWithContext(ctx context.Context, request *requests.FPDFTextObj_SetFontSize) (*responses.FPDFTextObj_SetFontSize, error) {
+	return g.withContext(ctx).FPDFTextObj_SetFontSize(request)
+}
+
+func (g *PdfiumRPC) FPDFTextObj_SetTextRenderModeWithContext(ctx context.Context, request *requests.FPDFTextObj_SetTextRenderMode) (*responses.FPDFTextObj_SetTextRenderMode, error) {
+	return g.withContext(ctx).FPDFTextObj_SetTextRenderMode(request)
+}
+
+func (g *PdfiumRPC) FPDFText_ClosePageWithContext(ctx context.Context, request *requests.FPDFText_ClosePage) (*responses.FPDFText_ClosePage, error) {
+	return g.withContext(ctx).FPDFText_ClosePage(request)
+}
+
+func (g *PdfiumRPC) FPDFText_CountCharsWithContext(ctx context.Context, request *requests.FPDFText_CountChars) (*responses.FPDFText_CountChars, error) {
+	return g.withContext(ctx).FPDFText_CountChars(request)
+}
+
+func (g *PdfiumRPC) FPDFText_CountRectsWithContext(ctx context.Context, request *requests.FPDFText_CountRects) (*responses.FPDFText_CountRects, error) {
+	return g.withContext(ctx).FPDFText_CountRects(request)
+}
+
+func (g *PdfiumRPC) FPDFText_FindCloseWithContext(ctx context.Context, request *requests.FPDFText_FindClose) (*responses.FPDFText_FindClose, error) {
+	return g.withContext(ctx).FPDFText_FindClose(request)
+}
+
+func (g *PdfiumRPC) FPDFText_FindNextWithContext(ctx context.Context, request *requests.FPDFText_FindNext) (*responses.FPDFText_FindNext, error) {
+	return g.withContext(ctx).FPDFText_FindNext(request)
+}
+
+func (g *PdfiumRPC) FPDFText_FindPrevWithContext(ctx context.Context, request *requests.FPDFText_FindPrev) (*responses.FPDFText_FindPrev, error) {
+	return g.withContext(ctx).FPDFText_FindPrev(request)
+}
+
+func (g *PdfiumRPC) FPDFText_FindStartWithContext(ctx context.Context, request *requests.FPDFText_FindStart) (*responses.FPDFText_FindStart, error) {
+	return g.withContext(ctx).FPDFText_FindStart(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetBoundedTextWithContext(ctx context.Context, request *requests.FPDFText_GetBoundedText) (*responses.FPDFText_GetBoundedText, error) {
+	return g.withContext(ctx).FPDFText_GetBoundedText(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetCharAngleWithContext(ctx context.Context, request *requests.FPDFText_GetCharAngle) (*responses.FPDFText_GetCharAngle, error) {
+	return g.withContext(ctx).FPDFText_GetCharAngle(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetCharBoxWithContext(ctx context.Context, request *requests.FPDFText_GetCharBox) (*responses.FPDFText_GetCharBox, error) {
+	return g.withContext(ctx).FPDFText_GetCharBox(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetCharIndexAtPosWithContext(ctx context.Context, request *requests.FPDFText_GetCharIndexAtPos) (*responses.FPDFText_GetCharIndexAtPos, error) {
+	return g.withContext(ctx).FPDFText_GetCharIndexAtPos(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetCharIndexFromTextIndexWithContext(ctx context.Context, request *requests.FPDFText_GetCharIndexFromTextIndex) (*responses.FPDFText_GetCharIndexFromTextIndex, error) {
+	return g.withContext(ctx).FPDFText_GetCharIndexFromTextIndex(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetCharOriginWithContext(ctx context.Context, request *requests.FPDFText_GetCharOrigin) (*responses.FPDFText_GetCharOrigin, error) {
+	return g.withContext(ctx).FPDFText_GetCharOrigin(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetFillColorWithContext(ctx context.Context, request *requests.FPDFText_GetFillColor) (*responses.FPDFText_GetFillColor, error) {
+	return g.withContext(ctx).FPDFText_GetFillColor(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetFontInfoWithContext(ctx context.Context, request *requests.FPDFText_GetFontInfo) (*responses.FPDFText_GetFontInfo, error) {
+	return g.withContext(ctx).FPDFText_GetFontInfo(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetFontSizeWithContext(ctx context.Context, request *requests.FPDFText_GetFontSize) (*responses.FPDFText_GetFontSize, error) {
+	return g.withContext(ctx).FPDFText_GetFontSize(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetFontWeightWithContext(ctx context.Context, request *requests.FPDFText_GetFontWeight) (*responses.FPDFText_GetFontWeight, error) {
+	return g.withContext(ctx).FPDFText_GetFontWeight(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetLooseCharBoxWithContext(ctx context.Context, request *requests.FPDFText_GetLooseCharBox) (*responses.FPDFText_GetLooseCharBox, error) {
+	return g.withContext(ctx).FPDFText_GetLooseCharBox(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetMatrixWithContext(ctx context.Context, request *requests.FPDFText_GetMatrix) (*responses.FPDFText_GetMatrix, error) {
+	return g.withContext(ctx).FPDFText_GetMatrix(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetRectWithContext(ctx context.Context, request *requests.FPDFText_GetRect) (*responses.FPDFText_GetRect, error) {
+	return g.withContext(ctx).FPDFText_GetRect(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetSchCountWithContext(ctx context.Context, request *requests.FPDFText_GetSchCount) (*responses.FPDFText_GetSchCount, error) {
+	return g.withContext(ctx).FPDFText_GetSchCount(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetSchResultIndexWithContext(ctx context.Context, request *requests.FPDFText_GetSchResultIndex) (*responses.FPDFText_GetSchResultIndex, error) {
+	return g.withContext(ctx).FPDFText_GetSchResultIndex(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetStrokeColorWithContext(ctx context.Context, request *requests.FPDFText_GetStrokeColor) (*responses.FPDFText_GetStrokeColor, error) {
+	return g.withContext(ctx).FPDFText_GetStrokeColor(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetTextWithContext(ctx context.Context, request *requests.FPDFText_GetText) (*responses.FPDFText_GetText, error) {
+	return g.withContext(ctx).FPDFText_GetText(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetTextIndexFromCharIndexWithContext(ctx context.Context, request *requests.FPDFText_GetTextIndexFromCharIndex) (*responses.FPDFText_GetTextIndexFromCharIndex, error) {
+	return g.withContext(ctx).FPDFText_GetTextIndexFromCharIndex(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetTextObjectWithContext(ctx context.Context, request *requests.FPDFText_GetTextObject) (*responses.FPDFText_GetTextObject, error) {
+	return g.withContext(ctx).FPDFText_GetTextObject(request)
+}
+
+func (g *PdfiumRPC) FPDFText_GetUnicodeWithContext(ctx context.Context, request *requests.FPDFText_GetUnicode) (*responses.FPDFText_GetUnicode, error) {
+	return g.withContext(ctx).FPDFText_GetUnicode(request)
+}
+
+func (g *PdfiumRPC) FPDFText_HasUnicodeMapErrorWithContext(ctx context.Context, request *requests.FPDFText_HasUnicodeMapError) (*responses.FPDFText_HasUnicodeMapError, error) {
+	return g.withContext(ctx).FPDFText_HasUnicodeMapError(request)
+}
+
+func (g *PdfiumRPC) FPDFText_IsGeneratedWithContext(ctx context.Context, request *requests.FPDFText_IsGenerated) (*responses.FPDFText_IsGenerated, error) {
+	return g.withContext(ctx).FPDFText_IsGenerated(request)
+}
+
+func (g *PdfiumRPC) FPDFText_IsHyphenWithContext(ctx context.Context, request *requests.FPDFText_IsHyphen) (*responses.FPDFText_IsHyphen, error) {
+	return g.withContext(ctx).FPDFText_IsHyphen(request)
+}
+
+func (g *PdfiumRPC) FPDFText_LoadCidType2FontWithContext(ctx context.Context, request *requests.FPDFText_LoadCidType2Font) (*responses.FPDFText_LoadCidType2Font, error) {
+	return g.withContext(ctx).FPDFText_LoadCidType2Font(request)
+}
+
+func (g *PdfiumRPC) FPDFText_LoadFontWithContext(ctx context.Context, request *requests.FPDFText_LoadFont) (*responses.FPDFText_LoadFont, error) {
+	return g.withContext(ctx).FPDFText_LoadFont(request)
+}
+
+func (g *PdfiumRPC) FPDFText_LoadPageWithContext(ctx context.Context, request *requests.FPDFText_LoadPage) (*responses.FPDFText_LoadPage, error) {
+	return g.withContext(ctx).FPDFText_LoadPage(request)
+}
+
+func (g *PdfiumRPC) FPDFText_LoadStandardFontWithContext(ctx context.Context, request *requests.FPDFText_LoadStandardFont) (*responses.FPDFText_LoadStandardFont, error) {
+	return g.withContext(ctx).FPDFText_LoadStandardFont(request)
+}
+
+func (g *PdfiumRPC) FPDFText_SetCharcodesWithContext(ctx context.Context, request *requests.FPDFText_SetCharcodes) (*responses.FPDFText_SetCharcodes, error) {
+	return g.withContext(ctx).FPDFText_SetCharcodes(request)
+}
+
+func (g *PdfiumRPC) FPDFText_SetPositionsWithContext(ctx context.Context, request *requests.FPDFText_SetPositions) (*responses.FPDFText_SetPositions, error) {
+	return g.withContext(ctx).FPDFText_SetPositions(request)
+}
+
+func (g *PdfiumRPC) FPDFText_SetTextWithContext(ctx context.Context, request *requests.FPDFText_SetText) (*responses.FPDFText_SetText, error) {
+	return g.withContext(ctx).FPDFText_SetText(request)
+}
+
+func (g *PdfiumRPC) FPDF_CloseDocumentWithContext(ctx context.Context, request *requests.FPDF_CloseDocument) (*responses.FPDF_CloseDocument, error) {
+	return g.withContext(ctx).FPDF_CloseDocument(request)
+}
+
+func (g *PdfiumRPC) FPDF_ClosePageWithContext(ctx context.Context, request *requests.FPDF_ClosePage) (*responses.FPDF_ClosePage, error) {
+	return g.withContext(ctx).FPDF_ClosePage(request)
+}
+
+func (g *PdfiumRPC) FPDF_CloseXObjectWithContext(ctx context.Context, request *requests.FPDF_CloseXObject) (*responses.FPDF_CloseXObject, error) {
+	return g.withContext(ctx).FPDF_CloseXObject(request)
+}
+
+func (g *PdfiumRPC) FPDF_CopyViewerPreferencesWithContext(ctx context.Context, request *requests.FPDF_CopyViewerPreferences) (*responses.FPDF_CopyViewerPreferences, error) {
+	return g.withContext(ctx).FPDF_CopyViewerPreferences(request)
+}
+
+func (g *PdfiumRPC) FPDF_CountNamedDestsWithContext(ctx context.Context, request *requests.FPDF_CountNamedDests) (*responses.FPDF_CountNamedDests, error) {
+	return g.withContext(ctx).FPDF_CountNamedDests(request)
+}
+
+func (g *PdfiumRPC) FPDF_CreateClipPathWithContext(ctx context.Context, request *requests.FPDF_CreateClipPath) (*responses.FPDF_CreateClipPath, error) {
+	return g.withContext(ctx).FPDF_CreateClipPath(request)
+}
+
+func (g *PdfiumRPC) FPDF_CreateNewDocumentWithContext(ctx context.Context, request *requests.FPDF_CreateNewDocument) (*responses.FPDF_CreateNewDocument, error) {
+	return g.withContext(ctx).FPDF_CreateNewDocument(request)
+}
+
+func (g *PdfiumRPC) FPDF_DestroyClipPathWithContext(ctx context.Context, request *requests.FPDF_DestroyClipPath) (*responses.FPDF_DestroyClipPath, error) {
+	return g.withContext(ctx).FPDF_DestroyClipPath(request)
+}
+
+func (g *PdfiumRPC) FPDF_DeviceToPageWithContext(ctx context.Context, request *requests.FPDF_DeviceToPage) (*responses.FPDF_DeviceToPage, error) {
+	return g.withContext(ctx).FPDF_DeviceToPage(request)
+}
+
+func (g *PdfiumRPC) FPDF_DocumentHasValidCrossReferenceTableWithContext(ctx context.Context, request *requests.FPDF_DocumentHasValidCrossReferenceTable) (*responses.FPDF_DocumentHasValidCrossReferenceTable, error) {
+	return g.withContext(ctx).FPDF_DocumentHasValidCrossReferenceTable(request)
+}
+
+func (g *PdfiumRPC) FPDF_FFLDrawWithContext(ctx context.Context, request *requests.FPDF_FFLDraw) (*responses.FPDF_FFLDraw, error) {
+	return g.withContext(ctx).FPDF_FFLDraw(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetDocPermissionsWithContext(ctx context.Context, request *requests.FPDF_GetDocPermissions) (*responses.FPDF_GetDocPermissions, error) {
+	return g.withContext(ctx).FPDF_GetDocPermissions(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetDocUserPermissionsWithContext(ctx context.Context, request *requests.FPDF_GetDocUserPermissions) (*responses.FPDF_GetDocUserPermissions, error) {
+	return g.withContext(ctx).FPDF_GetDocUserPermissions(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetFileIdentifierWithContext(ctx context.Context, request *requests.FPDF_GetFileIdentifier) (*responses.FPDF_GetFileIdentifier, error) {
+	return g.withContext(ctx).FPDF_GetFileIdentifier(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetFileVersionWithContext(ctx context.Context, request *requests.FPDF_GetFileVersion) (*responses.FPDF_GetFileVersion, error) {
+	return g.withContext(ctx).FPDF_GetFileVersion(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetFormTypeWithContext(ctx context.Context, request *requests.FPDF_GetFormType) (*responses.FPDF_GetFormType, error) {
+	return g.withContext(ctx).FPDF_GetFormType(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetLastErrorWithContext(ctx context.Context, request *requests.FPDF_GetLastError) (*responses.FPDF_GetLastError, error) {
+	return g.withContext(ctx).FPDF_GetLastError(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetMetaTextWithContext(ctx context.Context, request *requests.FPDF_GetMetaText) (*responses.FPDF_GetMetaText, error) {
+	return g.withContext(ctx).FPDF_GetMetaText(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetNamedDestWithContext(ctx context.Context, request *requests.FPDF_GetNamedDest) (*responses.FPDF_GetNamedDest, error) {
+	return g.withContext(ctx).FPDF_GetNamedDest(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetNamedDestByNameWithContext(ctx context.Context, request *requests.FPDF_GetNamedDestByName) (*responses.FPDF_GetNamedDestByName, error) {
+	return g.withContext(ctx).FPDF_GetNamedDestByName(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetPageAActionWithContext(ctx context.Context, request *requests.FPDF_GetPageAAction) (*responses.FPDF_GetPageAAction, error) {
+	return g.withContext(ctx).FPDF_GetPageAAction(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetPageBoundingBoxWithContext(ctx context.Context, request *requests.FPDF_GetPageBoundingBox) (*responses.FPDF_GetPageBoundingBox, error) {
+	return g.withContext(ctx).FPDF_GetPageBoundingBox(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetPageCountWithContext(ctx context.Context, request *requests.FPDF_GetPageCount) (*responses.FPDF_GetPageCount, error) {
+	return g.withContext(ctx).FPDF_GetPageCount(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetPageHeightWithContext(ctx context.Context, request *requests.FPDF_GetPageHeight) (*responses.FPDF_GetPageHeight, error) {
+	return g.withContext(ctx).FPDF_GetPageHeight(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetPageHeightFWithContext(ctx context.Context, request *requests.FPDF_GetPageHeightF) (*responses.FPDF_GetPageHeightF, error) {
+	return g.withContext(ctx).FPDF_GetPageHeightF(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetPageLabelWithContext(ctx context.Context, request *requests.FPDF_GetPageLabel) (*responses.FPDF_GetPageLabel, error) {
+	return g.withContext(ctx).FPDF_GetPageLabel(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetPageSizeByIndexWithContext(ctx context.Context, request *requests.FPDF_GetPageSizeByIndex) (*responses.FPDF_GetPageSizeByIndex, error) {
+	return g.withContext(ctx).FPDF_GetPageSizeByIndex(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetPageSizeByIndexFWithContext(ctx context.Context, request *requests.FPDF_GetPageSizeByIndexF) (*responses.FPDF_GetPageSizeByIndexF, error) {
+	return g.withContext(ctx).FPDF_GetPageSizeByIndexF(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetPageWidthWithContext(ctx context.Context, request *requests.FPDF_GetPageWidth) (*responses.FPDF_GetPageWidth, error) {
+	return g.withContext(ctx).FPDF_GetPageWidth(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetPageWidthFWithContext(ctx context.Context, request *requests.FPDF_GetPageWidthF) (*responses.FPDF_GetPageWidthF, error) {
+	return g.withContext(ctx).FPDF_GetPageWidthF(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetSecurityHandlerRevisionWithContext(ctx context.Context, request *requests.FPDF_GetSecurityHandlerRevision) (*responses.FPDF_GetSecurityHandlerRevision, error) {
+	return g.withContext(ctx).FPDF_GetSecurityHandlerRevision(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetSignatureCountWithContext(ctx context.Context, request *requests.FPDF_GetSignatureCount) (*responses.FPDF_GetSignatureCount, error) {
+	return g.withContext(ctx).FPDF_GetSignatureCount(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetSignatureObjectWithContext(ctx context.Context, request *requests.FPDF_GetSignatureObject) (*responses.FPDF_GetSignatureObject, error) {
+	return g.withContext(ctx).FPDF_GetSignatureObject(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetTrailerEndsWithContext(ctx context.Context, request *requests.FPDF_GetTrailerEnds) (*responses.FPDF_GetTrailerEnds, error) {
+	return g.withContext(ctx).FPDF_GetTrailerEnds(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetXFAPacketContentWithContext(ctx context.Context, request *requests.FPDF_GetXFAPacketContent) (*responses.FPDF_GetXFAPacketContent, error) {
+	return g.withContext(ctx).FPDF_GetXFAPacketContent(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetXFAPacketCountWithContext(ctx context.Context, request *requests.FPDF_GetXFAPacketCount) (*responses.FPDF_GetXFAPacketCount, error) {
+	return g.withContext(ctx).FPDF_GetXFAPacketCount(request)
+}
+
+func (g *PdfiumRPC) FPDF_GetXFAPacketNameWithContext(ctx context.Context, request *requests.FPDF_GetXFAPacketName) (*responses.FPDF_GetXFAPacketName, error) {
+	return g.withContext(ctx).FPDF_GetXFAPacketName(request)
+}
+
+func (g *PdfiumRPC) FPDF_ImportNPagesToOneWithContext(ctx context.Context, request *requests.FPDF_ImportNPagesToOne) (*responses.FPDF_ImportNPagesToOne, error) {
+	return g.withContext(ctx).FPDF_ImportNPagesToOne(request)
+}
+
+func (g *PdfiumRPC) FPDF_ImportPagesWithContext(ctx context.Context, request *requests.FPDF_ImportPages) (*responses.FPDF_ImportPages, error) {
+	return g.withContext(ctx).FPDF_ImportPages(request)
+}
+
+func (g *PdfiumRPC) FPDF_ImportPagesByIndexWithContext(ctx context.Context, request *requests.FPDF_ImportPagesByIndex) (*responses.FPDF_ImportPagesByIndex, error) {
+	return g.withContext(ctx).FPDF_ImportPagesByIndex(request)
+}
+
+func (g *PdfiumRPC) FPDF_LoadCustomDocumentWithContext(ctx context.Context, request *requests.FPDF_LoadCustomDocument) (*responses.FPDF_LoadCustomDocument, error) {
+	return g.withContext(ctx).FPDF_LoadCustomDocument(request)
+}
+
+func (g *PdfiumRPC) FPDF_LoadDocumentWithContext(ctx context.Context, request *requests.FPDF_LoadDocument) (*responses.FPDF_LoadDocument, error) {
+	return g.withContext(ctx).FPDF_LoadDocument(request)
+}
+
+func (g *PdfiumRPC) FPDF_LoadMemDocumentWithContext(ctx context.Context, request *requests.FPDF_LoadMemDocument) (*responses.FPDF_LoadMemDocument, error) {
+	return g.withContext(ctx).FPDF_LoadMemDocument(request)
+}
+
+func (g *PdfiumRPC) FPDF_LoadMemDocument64WithContext(ctx context.Context, request *requests.FPDF_LoadMemDocument64) (*responses.FPDF_LoadMemDocument64, error) {
+	return g.withContext(ctx).FPDF_LoadMemDocument64(request)
+}
+
+func (g *PdfiumRPC) FPDF_LoadPageWithContext(ctx context.Context, request *requests.FPDF_LoadPage) (*responses.FPDF_LoadPage, error) {
+	return g.withContext(ctx).FPDF_LoadPage(request)
+}
+
+func (g *PdfiumRPC) FPDF_LoadXFAWithContext(ctx context.Context, request *requests.FPDF_LoadXFA) (*responses.FPDF_LoadXFA, error) {
+	return g.withContext(ctx).FPDF_LoadXFA(request)
+}
+
+func (g *PdfiumRPC) FPDF_MovePagesWithContext(ctx context.Context, request *requests.FPDF_MovePages) (*responses.FPDF_MovePages, error) {
+	return g.withContext(ctx).FPDF_MovePages(request)
+}
+
+func (g *PdfiumRPC) FPDF_NewFormObjectFromXObjectWithContext(ctx context.Context, request *requests.FPDF_NewFormObjectFromXObject) (*responses.FPDF_NewFormObjectFromXObject, error) {
+	return g.withContext(ctx).FPDF_NewFormObjectFromXObject(request)
+}
+
+func (g *PdfiumRPC) FPDF_NewXObjectFromPageWithContext(ctx context.Context, request *requests.FPDF_NewXObjectFromPage) (*responses.FPDF_NewXObjectFromPage, error) {
+	return g.withContext(ctx).FPDF_NewXObjectFromPage(request)
+}
+
+func (g *PdfiumRPC) FPDF_PageToDeviceWithContext(ctx context.Context, request *requests.FPDF_PageToDevice) (*responses.FPDF_PageToDevice, error) {
+	return g.withContext(ctx).FPDF_PageToDevice(request)
+}
+
+func (g *PdfiumRPC) FPDF_RemoveFormFieldHighlightWithContext(ctx context.Context, request *requests.FPDF_RemoveFormFieldHighlight) (*responses.FPDF_RemoveFormFieldHighlight, error) {
+	return g.withContext(ctx).FPDF_RemoveFormFieldHighlight(request)
+}
+
+func (g *PdfiumRPC) FPDF_RenderPageWithContext(ctx context.Context, request *requests.FPDF_RenderPage) (*responses.FPDF_RenderPage, error) {
+	return g.withContext(ctx).FPDF_RenderPage(request)
+}
+
+func (g *PdfiumRPC) FPDF_RenderPageBitmapWithContext(ctx context.Context, request *requests.FPDF_RenderPageBitmap) (*responses.FPDF_RenderPageBitmap, error) {
+	return g.withContext(ctx).FPDF_RenderPageBitmap(request)
+}
+
+func (g *PdfiumRPC) FPDF_RenderPageBitmapWithColorScheme_StartWithContext(ctx context.Context, request *requests.FPDF_RenderPageBitmapWithColorScheme_Start) (*responses.FPDF_RenderPageBitmapWithColorScheme_Start, error) {
+	return g.withContext(ctx).FPDF_RenderPageBitmapWithColorScheme_Start(request)
+}
+
+func (g *PdfiumRPC) FPDF_RenderPageBitmapWithMatrixWithContext(ctx context.Context, request *requests.FPDF_RenderPageBitmapWithMatrix) (*responses.FPDF_RenderPageBitmapWithMatrix, error) {
+	return g.withContext(ctx).FPDF_RenderPageBitmapWithMatrix(request)
+}
+
+func (g *PdfiumRPC) FPDF_RenderPageBitmap_StartWithContext(ctx context.Context, request *requests.FPDF_RenderPageBitmap_Start) (*responses.FPDF_RenderPageBitmap_Start, error) {
+	return g.withContext(ctx).FPDF_RenderPageBitmap_Start(request)
+}
+
+func (g *PdfiumRPC) FPDF_RenderPage_CloseWithContext(ctx context.Context, request *requests.FPDF_RenderPage_Close) (*responses.FPDF_RenderPage_Close, error) {
+	return g.withContext(ctx).FPDF_RenderPage_Close(request)
+}
+
+func (g *PdfiumRPC) FPDF_RenderPage_ContinueWithContext(ctx context.Context, request *requests.FPDF_RenderPage_Continue) (*responses.FPDF_RenderPage_Continue, error) {
+	return g.withContext(ctx).FPDF_RenderPage_Continue(request)
+}
+
+func (g *PdfiumRPC) FPDF_SaveAsCopyWithContext(ctx context.Context, request *requests.FPDF_SaveAsCopy) (*responses.FPDF_SaveAsCopy, error) {
+	return g.withContext(ctx).FPDF_SaveAsCopy(request)
+}
+
+func (g *PdfiumRPC) FPDF_SaveWithVersionWithContext(ctx context.Context, request *requests.FPDF_SaveWithVersion) (*responses.FPDF_SaveWithVersion, error) {
+	return g.withContext(ctx).FPDF_SaveWithVersion(request)
+}
+
+func (g *PdfiumRPC) FPDF_SetFormFieldHighlightAlphaWithContext(ctx context.Context, request *requests.FPDF_SetFormFieldHighlightAlpha) (*responses.FPDF_SetFormFieldHighlightAlpha, error) {
+	return g.withContext(ctx).FPDF_SetFormFieldHighlightAlpha(request)
+}
+
+func (g *PdfiumRPC) FPDF_SetFormFieldHighlightColorWithContext(ctx context.Context, request *requests.FPDF_SetFormFieldHighlightColor) (*responses.FPDF_SetFormFieldHighlightColor, error) {
+	return g.withContext(ctx).FPDF_SetFormFieldHighlightColor(request)
+}
+
+func (g *PdfiumRPC) FPDF_SetPrintModeWithContext(ctx context.Context, request *requests.FPDF_SetPrintMode) (*responses.FPDF_SetPrintMode, error) {
+	return g.withContext(ctx).FPDF_SetPrintMode(request)
+}
+
+func (g *PdfiumRPC) FPDF_SetSandBoxPolicyWithContext(ctx context.Context, request *requests.FPDF_SetSandBoxPolicy) (*responses.FPDF_SetSandBoxPolicy, error) {
+	return g.withContext(ctx).FPDF_SetSandBoxPolicy(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_Attr_CountChildrenWithContext(ctx context.Context, request *requests.FPDF_StructElement_Attr_CountChildren) (*responses.FPDF_StructElement_Attr_CountChildren, error) {
+	return g.withContext(ctx).FPDF_StructElement_Attr_CountChildren(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_Attr_GetBlobValueWithContext(ctx context.Context, request *requests.FPDF_StructElement_Attr_GetBlobValue) (*responses.FPDF_StructElement_Attr_GetBlobValue, error) {
+	return g.withContext(ctx).FPDF_StructElement_Attr_GetBlobValue(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_Attr_GetBooleanValueWithContext(ctx context.Context, request *requests.FPDF_StructElement_Attr_GetBooleanValue) (*responses.FPDF_StructElement_Attr_GetBooleanValue, error) {
+	return g.withContext(ctx).FPDF_StructElement_Attr_GetBooleanValue(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_Attr_GetChildAtIndexWithContext(ctx context.Context, request *requests.FPDF_StructElement_Attr_GetChildAtIndex) (*responses.FPDF_StructElement_Attr_GetChildAtIndex, error) {
+	return g.withContext(ctx).FPDF_StructElement_Attr_GetChildAtIndex(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_Attr_GetCountWithContext(ctx context.Context, request *requests.FPDF_StructElement_Attr_GetCount) (*responses.FPDF_StructElement_Attr_GetCount, error) {
+	return g.withContext(ctx).FPDF_StructElement_Attr_GetCount(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_Attr_GetNameWithContext(ctx context.Context, request *requests.FPDF_StructElement_Attr_GetName) (*responses.FPDF_StructElement_Attr_GetName, error) {
+	return g.withContext(ctx).FPDF_StructElement_Attr_GetName(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_Attr_GetNumberValueWithContext(ctx context.Context, request *requests.FPDF_StructElement_Attr_GetNumberValue) (*responses.FPDF_StructElement_Attr_GetNumberValue, error) {
+	return g.withContext(ctx).FPDF_StructElement_Attr_GetNumberValue(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_Attr_GetStringValueWithContext(ctx context.Context, request *requests.FPDF_StructElement_Attr_GetStringValue) (*responses.FPDF_StructElement_Attr_GetStringValue, error) {
+	return g.withContext(ctx).FPDF_StructElement_Attr_GetStringValue(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_Attr_GetTypeWithContext(ctx context.Context, request *requests.FPDF_StructElement_Attr_GetType) (*responses.FPDF_StructElement_Attr_GetType, error) {
+	return g.withContext(ctx).FPDF_StructElement_Attr_GetType(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_Attr_GetValueWithContext(ctx context.Context, request *requests.FPDF_StructElement_Attr_GetValue) (*responses.FPDF_StructElement_Attr_GetValue, error) {
+	return g.withContext(ctx).FPDF_StructElement_Attr_GetValue(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_CountChildrenWithContext(ctx context.Context, request *requests.FPDF_StructElement_CountChildren) (*responses.FPDF_StructElement_CountChildren, error) {
+	return g.withContext(ctx).FPDF_StructElement_CountChildren(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_GetActualTextWithContext(ctx context.Context, request *requests.FPDF_StructElement_GetActualText) (*responses.FPDF_StructElement_GetActualText, error) {
+	return g.withContext(ctx).FPDF_StructElement_GetActualText(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_GetAltTextWithContext(ctx context.Context, request *requests.FPDF_StructElement_GetAltText) (*responses.FPDF_StructElement_GetAltText, error) {
+	return g.withContext(ctx).FPDF_StructElement_GetAltText(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_GetAttributeAtIndexWithContext(ctx context.Context, request *requests.FPDF_StructElement_GetAttributeAtIndex) (*responses.FPDF_StructElement_GetAttributeAtIndex, error) {
+	return g.withContext(ctx).FPDF_StructElement_GetAttributeAtIndex(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_GetAttributeCountWithContext(ctx context.Context, request *requests.FPDF_StructElement_GetAttributeCount) (*responses.FPDF_StructElement_GetAttributeCount, error) {
+	return g.withContext(ctx).FPDF_StructElement_GetAttributeCount(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_GetChildAtIndexWithContext(ctx context.Context, request *requests.FPDF_StructElement_GetChildAtIndex) (*responses.FPDF_StructElement_GetChildAtIndex, error) {
+	return g.withContext(ctx).FPDF_StructElement_GetChildAtIndex(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_GetChildMarkedContentIDWithContext(ctx context.Context, request *requests.FPDF_StructElement_GetChildMarkedContentID) (*responses.FPDF_StructElement_GetChildMarkedContentID, error) {
+	return g.withContext(ctx).FPDF_StructElement_GetChildMarkedContentID(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_GetExpansionWithContext(ctx context.Context, request *requests.FPDF_StructElement_GetExpansion) (*responses.FPDF_StructElement_GetExpansion, error) {
+	return g.withContext(ctx).FPDF_StructElement_GetExpansion(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_GetIDWithContext(ctx context.Context, request *requests.FPDF_StructElement_GetID) (*responses.FPDF_StructElement_GetID, error) {
+	return g.withContext(ctx).FPDF_StructElement_GetID(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_GetLangWithContext(ctx context.Context, request *requests.FPDF_StructElement_GetLang) (*responses.FPDF_StructElement_GetLang, error) {
+	return g.withContext(ctx).FPDF_StructElement_GetLang(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_GetMarkedContentIDWithContext(ctx context.Context, request *requests.FPDF_StructElement_GetMarkedContentID) (*responses.FPDF_StructElement_GetMarkedContentID, error) {
+	return g.withContext(ctx).FPDF_StructElement_GetMarkedContentID(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_GetMarkedContentIdAtIndexWithContext(ctx context.Context, request *requests.FPDF_StructElement_GetMarkedContentIdAtIndex) (*responses.FPDF_StructElement_GetMarkedContentIdAtIndex, error) {
+	return g.withContext(ctx).FPDF_StructElement_GetMarkedContentIdAtIndex(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_GetMarkedContentIdCountWithContext(ctx context.Context, request *requests.FPDF_StructElement_GetMarkedContentIdCount) (*responses.FPDF_StructElement_GetMarkedContentIdCount, error) {
+	return g.withContext(ctx).FPDF_StructElement_GetMarkedContentIdCount(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_GetObjTypeWithContext(ctx context.Context, request *requests.FPDF_StructElement_GetObjType) (*responses.FPDF_StructElement_GetObjType, error) {
+	return g.withContext(ctx).FPDF_StructElement_GetObjType(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_GetParentWithContext(ctx context.Context, request *requests.FPDF_StructElement_GetParent) (*responses.FPDF_StructElement_GetParent, error) {
+	return g.withContext(ctx).FPDF_StructElement_GetParent(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_GetStringAttributeWithContext(ctx context.Context, request *requests.FPDF_StructElement_GetStringAttribute) (*responses.FPDF_StructElement_GetStringAttribute, error) {
+	return g.withContext(ctx).FPDF_StructElement_GetStringAttribute(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_GetTitleWithContext(ctx context.Context, request *requests.FPDF_StructElement_GetTitle) (*responses.FPDF_StructElement_GetTitle, error) {
+	return g.withContext(ctx).FPDF_StructElement_GetTitle(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructElement_GetTypeWithContext(ctx context.Context, request *requests.FPDF_StructElement_GetType) (*responses.FPDF_StructElement_GetType, error) {
+	return g.withContext(ctx).FPDF_StructElement_GetType(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructTree_CloseWithContext(ctx context.Context, request *requests.FPDF_StructTree_Close) (*responses.FPDF_StructTree_Close, error) {
+	return g.withContext(ctx).FPDF_StructTree_Close(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructTree_CountChildrenWithContext(ctx context.Context, request *requests.FPDF_StructTree_CountChildren) (*responses.FPDF_StructTree_CountChildren, error) {
+	return g.withContext(ctx).FPDF_StructTree_CountChildren(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructTree_GetChildAtIndexWithContext(ctx context.Context, request *requests.FPDF_StructTree_GetChildAtIndex) (*responses.FPDF_StructTree_GetChildAtIndex, error) {
+	return g.withContext(ctx).FPDF_StructTree_GetChildAtIndex(request)
+}
+
+func (g *PdfiumRPC) FPDF_StructTree_GetForPageWithContext(ctx context.Context, request *requests.FPDF_StructTree_GetForPage) (*responses.FPDF_StructTree_GetForPage, error) {
+	return g.withContext(ctx).FPDF_StructTree_GetForPage(request)
+}
+
+func (g *PdfiumRPC) FPDF_VIEWERREF_GetDuplexWithContext(ctx context.Context, request *requests.FPDF_VIEWERREF_GetDuplex) (*responses.FPDF_VIEWERREF_GetDuplex, error) {
+	return g.withContext(ctx).FPDF_VIEWERREF_GetDuplex(request)
+}
+
+func (g *PdfiumRPC) FPDF_VIEWERREF_GetNameWithContext(ctx context.Context, request *requests.FPDF_VIEWERREF_GetName) (*responses.FPDF_VIEWERREF_GetName, error) {
+	return g.withContext(ctx).FPDF_VIEWERREF_GetName(request)
+}
+
+func (g *PdfiumRPC) FPDF_VIEWERREF_GetNumCopiesWithContext(ctx context.Context, request *requests.FPDF_VIEWERREF_GetNumCopies) (*responses.FPDF_VIEWERREF_GetNumCopies, error) {
+	return g.withContext(ctx).FPDF_VIEWERREF_GetNumCopies(request)
+}
+
+func (g *PdfiumRPC) FPDF_VIEWERREF_GetPrintPageRangeWithContext(ctx context.Context, request *requests.FPDF_VIEWERREF_GetPrintPageRange) (*responses.FPDF_VIEWERREF_GetPrintPageRange, error) {
+	return g.withContext(ctx).FPDF_VIEWERREF_GetPrintPageRange(request)
+}
+
+func (g *PdfiumRPC) FPDF_VIEWERREF_GetPrintPageRangeCountWithContext(ctx context.Context, request *requests.FPDF_VIEWERREF_GetPrintPageRangeCount) (*responses.FPDF_VIEWERREF_GetPrintPageRangeCount, error) {
+	return g.withContext(ctx).FPDF_VIEWERREF_GetPrintPageRangeCount(request)
+}
+
+func (g *PdfiumRPC) FPDF_VIEWERREF_GetPrintPageRangeElementWithContext(ctx context.Context, request *requests.FPDF_VIEWERREF_GetPrintPageRangeElement) (*responses.FPDF_VIEWERREF_GetPrintPageRangeElement, error) {
+	return g.withContext(ctx).FPDF_VIEWERREF_GetPrintPageRangeElement(request)
+}
+
+func (g *PdfiumRPC) FPDF_VIEWERREF_GetPrintScalingWithContext(ctx context.Context, request *requests.FPDF_VIEWERREF_GetPrintScaling) (*responses.FPDF_VIEWERREF_GetPrintScaling, error) {
+	return g.withContext(ctx).FPDF_VIEWERREF_GetPrintScaling(request)
+}
+
+func (g *PdfiumRPC) FSDK_SetLocaltimeFunctionWithContext(ctx context.Context, request *requests.FSDK_SetLocaltimeFunction) (*responses.FSDK_SetLocaltimeFunction, error) {
+	return g.withContext(ctx).FSDK_SetLocaltimeFunction(request)
+}
+
+func (g *PdfiumRPC) FSDK_SetTimeFunctionWithContext(ctx context.Context, request *requests.FSDK_SetTimeFunction) (*responses.FSDK_SetTimeFunction, error) {
+	return g.withContext(ctx).FSDK_SetTimeFunction(request)
+}
+
+func (g *PdfiumRPC) FSDK_SetUnSpObjProcessHandlerWithContext(ctx context.Context, request *requests.FSDK_SetUnSpObjProcessHandler) (*responses.FSDK_SetUnSpObjProcessHandler, error) {
+	return g.withContext(ctx).FSDK_SetUnSpObjProcessHandler(request)
+}
+
+func (g *PdfiumRPC) GetActionInfoWithContext(ctx context.Context, request *requests.GetActionInfo) (*responses.GetActionInfo, error) {
+	return g.withContext(ctx).GetActionInfo(request)
+}
+
+func (g *PdfiumRPC) GetAttachmentsWithContext(ctx context.Context, request *requests.GetAttachments) (*responses.GetAttachments, error) {
+	return g.withContext(ctx).GetAttachments(request)
+}
+
+func (g *PdfiumRPC) GetBookmarksWithContext(ctx context.Context, request *requests.GetBookmarks) (*responses.GetBookmarks, error) {
+	return g.withContext(ctx).GetBookmarks(request)
+}
+
+func (g *PdfiumRPC) GetDestInfoWithContext(ctx context.Context, request *requests.GetDestInfo) (*responses.GetDestInfo, error) {
+	return g.withContext(ctx).GetDestInfo(request)
+}
+
+func (g *PdfiumRPC) GetFormWithContext(ctx context.Context, request *requests.GetForm) (*responses.GetForm, error) {
+	return g.withContext(ctx).GetForm(request)
+}
+
+func (g *PdfiumRPC) GetJavaScriptActionsWithContext(ctx context.Context, request *requests.GetJavaScriptActions) (*responses.GetJavaScriptActions, error) {
+	return g.withContext(ctx).GetJavaScriptActions(request)
+}
+
+func (g *PdfiumRPC) GetMetaDataWithContext(ctx context.Context, request *requests.GetMetaData) (*responses.GetMetaData, error) {
+	return g.withContext(ctx).GetMetaData(request)
+}
+
+func (g *PdfiumRPC) GetPageSizeWithContext(ctx context.Context, request *requests.GetPageSize) (*responses.GetPageSize, error) {
+	return g.withContext(ctx).GetPageSize(request)
+}
+
+func (g *PdfiumRPC) GetPageSizeInPixelsWithContext(ctx context.Context, request *requests.GetPageSizeInPixels) (*responses.GetPageSizeInPixels, error) {
+	return g.withContext(ctx).GetPageSizeInPixels(request)
+}
+
+func (g *PdfiumRPC) GetPageTextWithContext(ctx context.Context, request *requests.GetPageText) (*responses.GetPageText, error) {
+	return g.withContext(ctx).GetPageText(request)
+}
+
+func (g *PdfiumRPC) GetPageTextStructuredWithContext(ctx context.Context, request *requests.GetPageTextStructured) (*responses.GetPageTextStructured, error) {
+	return g.withContext(ctx).GetPageTextStructured(request)
+}
+
+func (g *PdfiumRPC) ListOpenHandlesWithContext(ctx context.Context, request *requests.ListOpenHandles) (*responses.ListOpenHandles, error) {
+	return g.withContext(ctx).ListOpenHandles(request)
+}
+
+func (g *PdfiumRPC) OpenDocumentWithContext(ctx context.Context, request *requests.OpenDocument) (*responses.OpenDocument, error) {
+	return g.withContext(ctx).OpenDocument(request)
+}
+
+func (g *PdfiumRPC) RenderPageInDPIWithContext(ctx context.Context, request *requests.RenderPageInDPI) (*responses.RenderPageInDPI, error) {
+	return g.withContext(ctx).RenderPageInDPI(request)
+}
+
+func (g *PdfiumRPC) RenderPageInPixelsWithContext(ctx context.Context, request *requests.RenderPageInPixels) (*responses.RenderPageInPixels, error) {
+	return g.withContext(ctx).RenderPageInPixels(request)
+}
+
+func (g *PdfiumRPC) RenderPageRegionWithContext(ctx context.Context, request *requests.RenderPageRegion) (*responses.RenderPageRegion, error) {
+	return g.withContext(ctx).RenderPageRegion(request)
+}
+
+func (g *PdfiumRPC) RenderPagesInDPIWithContext(ctx context.Context, request *requests.RenderPagesInDPI) (*responses.RenderPagesInDPI, error) {
+	return g.withContext(ctx).RenderPagesInDPI(request)
+}
+
+func (g *PdfiumRPC) RenderPagesInPixelsWithContext(ctx context.Context, request *requests.RenderPagesInPixels) (*responses.RenderPagesInPixels, error) {
+	return g.withContext(ctx).RenderPagesInPixels(request)
+}
+
+func (g *PdfiumRPC) RenderToFileWithContext(ctx context.Context, request *requests.RenderToFile) (*responses.RenderToFile, error) {
+	return g.withContext(ctx).RenderToFile(request)
+}
+
+func (s *PdfiumRPCServer) FORM_CanRedo(request *requests.FORM_CanRedo, resp *responses.FORM_CanRedo) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FORM_CanRedo", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	implResp, err := s.Impl.FORM_CanRedo(request)
+	if err != nil {
+		return err
+	}
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+func (s *PdfiumRPCServer) FORM_CanUndo(request *requests.FORM_CanUndo, resp *responses.FORM_CanUndo) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FORM_CanUndo", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	implResp, err := s.Impl.FORM_CanUndo(request)
+	if err != nil {
+		return err
+	}
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+func (s *PdfiumRPCServer) FORM_DoDocumentAAction(request *requests.FORM_DoDocumentAAction, resp *responses.FORM_DoDocumentAAction) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FORM_DoDocumentAAction", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	implResp, err := s.Impl.FORM_DoDocumentAAction(request)
+	if err != nil {
+		return err
+	}
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+func (s *PdfiumRPCServer) FORM_DoDocumentJSAction(request *requests.FORM_DoDocumentJSAction, resp *responses.FORM_DoDocumentJSAction) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FORM_DoDocumentJSAction", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	implResp, err := s.Impl.FORM_DoDocumentJSAction(request)
+	if err != nil {
+		return err
+	}
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+func (s *PdfiumRPCServer) FORM_DoDocumentOpenAction(request *requests.FORM_DoDocumentOpenAction, resp *responses.FORM_DoDocumentOpenAction) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FORM_DoDocumentOpenAction", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	implResp, err := s.Impl.FORM_DoDocumentOpenAction(request)
+	if err != nil {
+		return err
+	}
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+func (s *PdfiumRPCServer) FORM_DoPageAAction(request *requests.FORM_DoPageAAction, resp *responses.FORM_DoPageAAction) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FORM_DoPageAAction", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	implResp, err := s.Impl.FORM_DoPageAAction(request)
+	if err != nil {
+		return err
+	}
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+func (s *PdfiumRPCServer) FORM_ForceToKillFocus(request *requests.FORM_ForceToKillFocus, resp *responses.FORM_ForceToKillFocus) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FORM_ForceToKillFocus", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	implResp, err := s.Impl.FORM_ForceToKillFocus(request)
+	if err != nil {
+		return err
+	}
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+func (s *PdfiumRPCServer) FORM_GetFocusedAnnot(request *requests.FORM_GetFocusedAnnot, resp *responses.FORM_GetFocusedAnnot) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FORM_GetFocusedAnnot", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	implResp, err := s.Impl.FORM_GetFocusedAnnot(request)
+	if err != nil {
+		return err
+	}
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+func (s *PdfiumRPCServer) FORM_GetFocusedText(request *requests.FORM_GetFocusedText, resp *responses.FORM_GetFocusedText) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FORM_GetFocusedText", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	implResp, err := s.Impl.FORM_GetFocusedText(request)
+	if err != nil {
+		return err
+	}
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+func (s *PdfiumRPCServer) FORM_GetSelectedText(request *requests.FORM_GetSelectedText, resp *responses.FORM_GetSelectedText) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FORM_GetSelectedText", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	implResp, err := s.Impl.FORM_GetSelectedText(request)
+	if err != nil {
+		return err
+	}
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
 
 	return nil
 }
@@ -5372,6 +7145,8 @@ func (s *PdfiumRPCServer) FORM_IsIndexSelected(request *requests.FORM_IsIndexSel
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_IsIndexSelected", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_IsIndexSelected(request)
@@ -5390,6 +7165,8 @@ func (s *PdfiumRPCServer) FORM_OnAfterLoadPage(request *requests.FORM_OnAfterLoa
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnAfterLoadPage", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_OnAfterLoadPage(request)
@@ -5408,6 +7185,8 @@ func (s *PdfiumRPCServer) FORM_OnBeforeClosePage(request *requests.FORM_OnBefore
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnBeforeClosePage", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_OnBeforeClosePage(request)
@@ -5426,6 +7205,8 @@ func (s *PdfiumRPCServer) FORM_OnChar(request *requests.FORM_OnChar, resp *respo
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnChar", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_OnChar(request)
@@ -5444,6 +7225,8 @@ func (s *PdfiumRPCServer) FORM_OnFocus(request *requests.FORM_OnFocus, resp *res
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnFocus", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_OnFocus(request)
@@ -5462,6 +7245,8 @@ func (s *PdfiumRPCServer) FORM_OnKeyDown(request *requests.FORM_OnKeyDown, resp
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnKeyDown", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_OnKeyDown(request)
@@ -5480,6 +7265,8 @@ func (s *PdfiumRPCServer) FORM_OnKeyUp(request *requests.FORM_OnKeyUp, resp *res
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnKeyUp", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_OnKeyUp(request)
@@ -5498,6 +7285,8 @@ func (s *PdfiumRPCServer) FORM_OnLButtonDoubleClick(request *requests.FORM_OnLBu
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnLButtonDoubleClick", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_OnLButtonDoubleClick(request)
@@ -5516,6 +7305,8 @@ func (s *PdfiumRPCServer) FORM_OnLButtonDown(request *requests.FORM_OnLButtonDow
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnLButtonDown", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_OnLButtonDown(request)
@@ -5534,6 +7325,8 @@ func (s *PdfiumRPCServer) FORM_OnLButtonUp(request *requests.FORM_OnLButtonUp, r
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnLButtonUp", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_OnLButtonUp(request)
@@ -5552,6 +7345,8 @@ func (s *PdfiumRPCServer) FORM_OnMouseMove(request *requests.FORM_OnMouseMove, r
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnMouseMove", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_OnMouseMove(request)
@@ -5570,6 +7365,8 @@ func (s *PdfiumRPCServer) FORM_OnMouseWheel(request *requests.FORM_OnMouseWheel,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnMouseWheel", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_OnMouseWheel(request)
@@ -5588,6 +7385,8 @@ func (s *PdfiumRPCServer) FORM_OnRButtonDown(request *requests.FORM_OnRButtonDow
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnRButtonDown", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_OnRButtonDown(request)
@@ -5606,6 +7405,8 @@ func (s *PdfiumRPCServer) FORM_OnRButtonUp(request *requests.FORM_OnRButtonUp, r
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnRButtonUp", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_OnRButtonUp(request)
@@ -5624,6 +7425,8 @@ func (s *PdfiumRPCServer) FORM_Redo(request *requests.FORM_Redo, resp *responses
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_Redo", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_Redo(request)
@@ -5642,6 +7445,8 @@ func (s *PdfiumRPCServer) FORM_ReplaceAndKeepSelection(request *requests.FORM_Re
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_ReplaceAndKeepSelection", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_ReplaceAndKeepSelection(request)
@@ -5660,6 +7465,8 @@ func (s *PdfiumRPCServer) FORM_ReplaceSelection(request *requests.FORM_ReplaceSe
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_ReplaceSelection", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_ReplaceSelection(request)
@@ -5678,6 +7485,8 @@ func (s *PdfiumRPCServer) FORM_SelectAllText(request *requests.FORM_SelectAllTex
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_SelectAllText", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_SelectAllText(request)
@@ -5696,6 +7505,8 @@ func (s *PdfiumRPCServer) FORM_SetFocusedAnnot(request *requests.FORM_SetFocused
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_SetFocusedAnnot", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_SetFocusedAnnot(request)
@@ -5714,6 +7525,8 @@ func (s *PdfiumRPCServer) FORM_SetIndexSelected(request *requests.FORM_SetIndexS
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_SetIndexSelected", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_SetIndexSelected(request)
@@ -5732,6 +7545,8 @@ func (s *PdfiumRPCServer) FORM_Undo(request *requests.FORM_Undo, resp *responses
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FORM_Undo", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FORM_Undo(request)
@@ -5750,6 +7565,8 @@ func (s *PdfiumRPCServer) FPDFAction_GetDest(request *requests.FPDFAction_GetDes
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAction_GetDest", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAction_GetDest(request)
@@ -5768,6 +7585,8 @@ func (s *PdfiumRPCServer) FPDFAction_GetFilePath(request *requests.FPDFAction_Ge
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAction_GetFilePath", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAction_GetFilePath(request)
@@ -5786,6 +7605,8 @@ func (s *PdfiumRPCServer) FPDFAction_GetType(request *requests.FPDFAction_GetTyp
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAction_GetType", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAction_GetType(request)
@@ -5804,6 +7625,8 @@ func (s *PdfiumRPCServer) FPDFAction_GetURIPath(request *requests.FPDFAction_Get
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAction_GetURIPath", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAction_GetURIPath(request)
@@ -5822,6 +7645,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_AddFileAttachment(request *requests.FPDFAnno
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_AddFileAttachment", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_AddFileAttachment(request)
@@ -5840,6 +7665,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_AddInkStroke(request *requests.FPDFAnnot_Add
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_AddInkStroke", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_AddInkStroke(request)
@@ -5858,6 +7685,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_AppendAttachmentPoints(request *requests.FPD
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_AppendAttachmentPoints", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_AppendAttachmentPoints(request)
@@ -5876,6 +7705,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_AppendObject(request *requests.FPDFAnnot_App
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_AppendObject", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_AppendObject(request)
@@ -5894,6 +7725,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_CountAttachmentPoints(request *requests.FPDF
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_CountAttachmentPoints", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_CountAttachmentPoints(request)
@@ -5912,6 +7745,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetAP(request *requests.FPDFAnnot_GetAP, res
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetAP", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetAP(request)
@@ -5930,6 +7765,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetAttachmentPoints(request *requests.FPDFAn
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetAttachmentPoints", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetAttachmentPoints(request)
@@ -5948,6 +7785,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetBorder(request *requests.FPDFAnnot_GetBor
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetBorder", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetBorder(request)
@@ -5966,6 +7805,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetColor(request *requests.FPDFAnnot_GetColo
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetColor", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetColor(request)
@@ -5984,6 +7825,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetFileAttachment(request *requests.FPDFAnno
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFileAttachment", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetFileAttachment(request)
@@ -6002,6 +7845,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetFlags(request *requests.FPDFAnnot_GetFlag
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFlags", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetFlags(request)
@@ -6020,6 +7865,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetFocusableSubtypes(request *requests.FPDFA
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFocusableSubtypes", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetFocusableSubtypes(request)
@@ -6038,6 +7885,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetFocusableSubtypesCount(request *requests.
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFocusableSubtypesCount", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetFocusableSubtypesCount(request)
@@ -6056,6 +7905,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetFontColor(request *requests.FPDFAnnot_Get
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFontColor", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetFontColor(request)
@@ -6074,6 +7925,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetFontSize(request *requests.FPDFAnnot_GetF
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFontSize", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetFontSize(request)
@@ -6092,6 +7945,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetFormAdditionalActionJavaScript(request *r
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormAdditionalActionJavaScript", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetFormAdditionalActionJavaScript(request)
@@ -6110,6 +7965,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetFormControlCount(request *requests.FPDFAn
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormControlCount", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetFormControlCount(request)
@@ -6128,6 +7985,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetFormControlIndex(request *requests.FPDFAn
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormControlIndex", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetFormControlIndex(request)
@@ -6146,6 +8005,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetFormFieldAlternateName(request *requests.
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldAlternateName", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetFormFieldAlternateName(request)
@@ -6164,6 +8025,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetFormFieldAtPoint(request *requests.FPDFAn
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldAtPoint", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetFormFieldAtPoint(request)
@@ -6182,6 +8045,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetFormFieldExportValue(request *requests.FP
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldExportValue", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetFormFieldExportValue(request)
@@ -6200,6 +8065,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetFormFieldFlags(request *requests.FPDFAnno
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldFlags", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetFormFieldFlags(request)
@@ -6218,6 +8085,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetFormFieldName(request *requests.FPDFAnnot
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldName", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetFormFieldName(request)
@@ -6236,6 +8105,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetFormFieldType(request *requests.FPDFAnnot
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldType", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetFormFieldType(request)
@@ -6254,6 +8125,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetFormFieldValue(request *requests.FPDFAnno
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldValue", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetFormFieldValue(request)
@@ -6272,6 +8145,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetInkListCount(request *requests.FPDFAnnot_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetInkListCount", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetInkListCount(request)
@@ -6290,6 +8165,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetInkListPath(request *requests.FPDFAnnot_G
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetInkListPath", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetInkListPath(request)
@@ -6308,6 +8185,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetLine(request *requests.FPDFAnnot_GetLine,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetLine", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetLine(request)
@@ -6326,6 +8205,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetLink(request *requests.FPDFAnnot_GetLink,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetLink", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetLink(request)
@@ -6344,6 +8225,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetLinkedAnnot(request *requests.FPDFAnnot_G
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetLinkedAnnot", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetLinkedAnnot(request)
@@ -6362,6 +8245,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetNumberValue(request *requests.FPDFAnnot_G
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetNumberValue", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetNumberValue(request)
@@ -6380,6 +8265,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetObject(request *requests.FPDFAnnot_GetObj
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetObject", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetObject(request)
@@ -6398,6 +8285,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetObjectCount(request *requests.FPDFAnnot_G
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetObjectCount", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetObjectCount(request)
@@ -6416,6 +8305,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetOptionCount(request *requests.FPDFAnnot_G
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetOptionCount", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetOptionCount(request)
@@ -6434,6 +8325,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetOptionLabel(request *requests.FPDFAnnot_G
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetOptionLabel", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetOptionLabel(request)
@@ -6452,6 +8345,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetRect(request *requests.FPDFAnnot_GetRect,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetRect", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetRect(request)
@@ -6470,6 +8365,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetStringValue(request *requests.FPDFAnnot_G
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetStringValue", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetStringValue(request)
@@ -6488,6 +8385,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetSubtype(request *requests.FPDFAnnot_GetSu
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetSubtype", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetSubtype(request)
@@ -6506,6 +8405,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetValueType(request *requests.FPDFAnnot_Get
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetValueType", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetValueType(request)
@@ -6524,6 +8425,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_GetVertices(request *requests.FPDFAnnot_GetV
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetVertices", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_GetVertices(request)
@@ -6542,6 +8445,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_HasAttachmentPoints(request *requests.FPDFAn
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_HasAttachmentPoints", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_HasAttachmentPoints(request)
@@ -6560,6 +8465,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_HasKey(request *requests.FPDFAnnot_HasKey, r
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_HasKey", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_HasKey(request)
@@ -6578,6 +8485,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_IsChecked(request *requests.FPDFAnnot_IsChec
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_IsChecked", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_IsChecked(request)
@@ -6596,6 +8505,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_IsObjectSupportedSubtype(request *requests.F
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_IsObjectSupportedSubtype", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_IsObjectSupportedSubtype(request)
@@ -6614,6 +8525,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_IsOptionSelected(request *requests.FPDFAnnot
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_IsOptionSelected", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_IsOptionSelected(request)
@@ -6632,6 +8545,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_IsSupportedSubtype(request *requests.FPDFAnn
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_IsSupportedSubtype", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_IsSupportedSubtype(request)
@@ -6650,6 +8565,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_RemoveInkList(request *requests.FPDFAnnot_Re
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_RemoveInkList", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_RemoveInkList(request)
@@ -6668,6 +8585,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_RemoveObject(request *requests.FPDFAnnot_Rem
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_RemoveObject", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_RemoveObject(request)
@@ -6686,6 +8605,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_SetAP(request *requests.FPDFAnnot_SetAP, res
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetAP", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_SetAP(request)
@@ -6704,6 +8625,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_SetAttachmentPoints(request *requests.FPDFAn
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetAttachmentPoints", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_SetAttachmentPoints(request)
@@ -6722,6 +8645,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_SetBorder(request *requests.FPDFAnnot_SetBor
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetBorder", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_SetBorder(request)
@@ -6740,6 +8665,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_SetColor(request *requests.FPDFAnnot_SetColo
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetColor", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_SetColor(request)
@@ -6758,6 +8685,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_SetFlags(request *requests.FPDFAnnot_SetFlag
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetFlags", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_SetFlags(request)
@@ -6776,6 +8705,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_SetFocusableSubtypes(request *requests.FPDFA
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetFocusableSubtypes", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_SetFocusableSubtypes(request)
@@ -6794,6 +8725,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_SetFontColor(request *requests.FPDFAnnot_Set
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetFontColor", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_SetFontColor(request)
@@ -6812,6 +8745,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_SetFormFieldFlags(request *requests.FPDFAnno
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetFormFieldFlags", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_SetFormFieldFlags(request)
@@ -6830,6 +8765,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_SetRect(request *requests.FPDFAnnot_SetRect,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetRect", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_SetRect(request)
@@ -6848,6 +8785,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_SetStringValue(request *requests.FPDFAnnot_S
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetStringValue", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_SetStringValue(request)
@@ -6866,6 +8805,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_SetURI(request *requests.FPDFAnnot_SetURI, r
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetURI", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_SetURI(request)
@@ -6884,6 +8825,8 @@ func (s *PdfiumRPCServer) FPDFAnnot_UpdateObject(request *requests.FPDFAnnot_Upd
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_UpdateObject", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAnnot_UpdateObject(request)
@@ -6902,6 +8845,8 @@ func (s *PdfiumRPCServer) FPDFAttachment_GetDescription(request *requests.FPDFAt
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_GetDescription", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAttachment_GetDescription(request)
@@ -6920,6 +8865,8 @@ func (s *PdfiumRPCServer) FPDFAttachment_GetFile(request *requests.FPDFAttachmen
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_GetFile", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAttachment_GetFile(request)
@@ -6938,6 +8885,8 @@ func (s *PdfiumRPCServer) FPDFAttachment_GetName(request *requests.FPDFAttachmen
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_GetName", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAttachment_GetName(request)
@@ -6956,6 +8905,8 @@ func (s *PdfiumRPCServer) FPDFAttachment_GetStringValue(request *requests.FPDFAt
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_GetStringValue", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAttachment_GetStringValue(request)
@@ -6974,6 +8925,8 @@ func (s *PdfiumRPCServer) FPDFAttachment_GetSubtype(request *requests.FPDFAttach
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_GetSubtype", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAttachment_GetSubtype(request)
@@ -6992,6 +8945,8 @@ func (s *PdfiumRPCServer) FPDFAttachment_GetValueType(request *requests.FPDFAtta
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_GetValueType", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAttachment_GetValueType(request)
@@ -7010,6 +8965,8 @@ func (s *PdfiumRPCServer) FPDFAttachment_HasKey(request *requests.FPDFAttachment
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_HasKey", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAttachment_HasKey(request)
@@ -7028,6 +8985,8 @@ func (s *PdfiumRPCServer) FPDFAttachment_SetDescription(request *requests.FPDFAt
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_SetDescription", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAttachment_SetDescription(request)
@@ -7046,6 +9005,8 @@ func (s *PdfiumRPCServer) FPDFAttachment_SetFile(request *requests.FPDFAttachmen
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_SetFile", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAttachment_SetFile(request)
@@ -7064,45 +9025,11 @@ func (s *PdfiumRPCServer) FPDFAttachment_SetStringValue(request *requests.FPDFAt
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_SetStringValue", panicError)
 		}
-	}()
-
-	implResp, err := s.Impl.FPDFAttachment_SetStringValue(request)
-	if err != nil {
-		return err
-	}
-
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
-
-	return nil
-}
-
-func (s *PdfiumRPCServer) FPDFAvail_Create(request *requests.FPDFAvail_Create, resp *responses.FPDFAvail_Create) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_Create", panicError)
-		}
-	}()
-
-	implResp, err := s.Impl.FPDFAvail_Create(request)
-	if err != nil {
-		return err
-	}
-
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
-
-	return nil
-}
 
-func (s *PdfiumRPCServer) FPDFAvail_Destroy(request *requests.FPDFAvail_Destroy, resp *responses.FPDFAvail_Destroy) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_Destroy", panicError)
-		}
+		err = encodeError(err)
 	}()
 
-	implResp, err := s.Impl.FPDFAvail_Destroy(request)
+	implResp, err := s.Impl.FPDFAttachment_SetStringValue(request)
 	if err != nil {
 		return err
 	}
@@ -7118,6 +9045,8 @@ func (s *PdfiumRPCServer) FPDFAvail_GetDocument(request *requests.FPDFAvail_GetD
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_GetDocument", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAvail_GetDocument(request)
@@ -7136,6 +9065,8 @@ func (s *PdfiumRPCServer) FPDFAvail_GetFirstPageNum(request *requests.FPDFAvail_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_GetFirstPageNum", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAvail_GetFirstPageNum(request)
@@ -7154,6 +9085,8 @@ func (s *PdfiumRPCServer) FPDFAvail_IsDocAvail(request *requests.FPDFAvail_IsDoc
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_IsDocAvail", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAvail_IsDocAvail(request)
@@ -7172,6 +9105,8 @@ func (s *PdfiumRPCServer) FPDFAvail_IsFormAvail(request *requests.FPDFAvail_IsFo
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_IsFormAvail", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAvail_IsFormAvail(request)
@@ -7190,6 +9125,8 @@ func (s *PdfiumRPCServer) FPDFAvail_IsLinearized(request *requests.FPDFAvail_IsL
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_IsLinearized", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAvail_IsLinearized(request)
@@ -7208,6 +9145,8 @@ func (s *PdfiumRPCServer) FPDFAvail_IsPageAvail(request *requests.FPDFAvail_IsPa
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_IsPageAvail", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFAvail_IsPageAvail(request)
@@ -7226,6 +9165,8 @@ func (s *PdfiumRPCServer) FPDFBitmap_Create(request *requests.FPDFBitmap_Create,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_Create", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFBitmap_Create(request)
@@ -7244,6 +9185,8 @@ func (s *PdfiumRPCServer) FPDFBitmap_CreateEx(request *requests.FPDFBitmap_Creat
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_CreateEx", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFBitmap_CreateEx(request)
@@ -7262,6 +9205,8 @@ func (s *PdfiumRPCServer) FPDFBitmap_Destroy(request *requests.FPDFBitmap_Destro
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_Destroy", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFBitmap_Destroy(request)
@@ -7280,6 +9225,8 @@ func (s *PdfiumRPCServer) FPDFBitmap_FillRect(request *requests.FPDFBitmap_FillR
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_FillRect", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFBitmap_FillRect(request)
@@ -7298,6 +9245,8 @@ func (s *PdfiumRPCServer) FPDFBitmap_GetBuffer(request *requests.FPDFBitmap_GetB
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_GetBuffer", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFBitmap_GetBuffer(request)
@@ -7316,6 +9265,8 @@ func (s *PdfiumRPCServer) FPDFBitmap_GetFormat(request *requests.FPDFBitmap_GetF
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_GetFormat", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFBitmap_GetFormat(request)
@@ -7334,6 +9285,8 @@ func (s *PdfiumRPCServer) FPDFBitmap_GetHeight(request *requests.FPDFBitmap_GetH
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_GetHeight", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFBitmap_GetHeight(request)
@@ -7352,6 +9305,8 @@ func (s *PdfiumRPCServer) FPDFBitmap_GetStride(request *requests.FPDFBitmap_GetS
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_GetStride", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFBitmap_GetStride(request)
@@ -7370,6 +9325,8 @@ func (s *PdfiumRPCServer) FPDFBitmap_GetWidth(request *requests.FPDFBitmap_GetWi
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_GetWidth", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFBitmap_GetWidth(request)
@@ -7388,6 +9345,8 @@ func (s *PdfiumRPCServer) FPDFBookmark_Find(request *requests.FPDFBookmark_Find,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_Find", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFBookmark_Find(request)
@@ -7406,6 +9365,8 @@ func (s *PdfiumRPCServer) FPDFBookmark_GetAction(request *requests.FPDFBookmark_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetAction", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFBookmark_GetAction(request)
@@ -7424,6 +9385,8 @@ func (s *PdfiumRPCServer) FPDFBookmark_GetColor(request *requests.FPDFBookmark_G
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetColor", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFBookmark_GetColor(request)
@@ -7442,6 +9405,8 @@ func (s *PdfiumRPCServer) FPDFBookmark_GetCount(request *requests.FPDFBookmark_G
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetCount", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFBookmark_GetCount(request)
@@ -7460,6 +9425,8 @@ func (s *PdfiumRPCServer) FPDFBookmark_GetDest(request *requests.FPDFBookmark_Ge
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetDest", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFBookmark_GetDest(request)
@@ -7478,6 +9445,8 @@ func (s *PdfiumRPCServer) FPDFBookmark_GetFirstChild(request *requests.FPDFBookm
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetFirstChild", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFBookmark_GetFirstChild(request)
@@ -7496,6 +9465,8 @@ func (s *PdfiumRPCServer) FPDFBookmark_GetNextSibling(request *requests.FPDFBook
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetNextSibling", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFBookmark_GetNextSibling(request)
@@ -7514,6 +9485,8 @@ func (s *PdfiumRPCServer) FPDFBookmark_GetTitle(request *requests.FPDFBookmark_G
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetTitle", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFBookmark_GetTitle(request)
@@ -7532,45 +9505,11 @@ func (s *PdfiumRPCServer) FPDFCatalog_GetLanguage(request *requests.FPDFCatalog_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFCatalog_GetLanguage", panicError)
 		}
-	}()
-
-	implResp, err := s.Impl.FPDFCatalog_GetLanguage(request)
-	if err != nil {
-		return err
-	}
-
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
-
-	return nil
-}
-
-func (s *PdfiumRPCServer) FPDFCatalog_IsTagged(request *requests.FPDFCatalog_IsTagged, resp *responses.FPDFCatalog_IsTagged) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFCatalog_IsTagged", panicError)
-		}
-	}()
-
-	implResp, err := s.Impl.FPDFCatalog_IsTagged(request)
-	if err != nil {
-		return err
-	}
-
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
-
-	return nil
-}
 
-func (s *PdfiumRPCServer) FPDFCatalog_SetLanguage(request *requests.FPDFCatalog_SetLanguage, resp *responses.FPDFCatalog_SetLanguage) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFCatalog_SetLanguage", panicError)
-		}
+		err = encodeError(err)
 	}()
 
-	implResp, err := s.Impl.FPDFCatalog_SetLanguage(request)
+	implResp, err := s.Impl.FPDFCatalog_GetLanguage(request)
 	if err != nil {
 		return err
 	}
@@ -7581,14 +9520,16 @@ func (s *PdfiumRPCServer) FPDFCatalog_SetLanguage(request *requests.FPDFCatalog_
 	return nil
 }
 
-func (s *PdfiumRPCServer) FPDFClipPath_CountPathSegments(request *requests.FPDFClipPath_CountPathSegments, resp *responses.FPDFClipPath_CountPathSegments) (err error) {
+func (s *PdfiumRPCServer) FPDFCatalog_IsTagged(request *requests.FPDFCatalog_IsTagged, resp *responses.FPDFCatalog_IsTagged) (err error) {
 	defer func() {
 		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFClipPath_CountPathSegments", panicError)
+			err = fmt.Errorf("panic occurred in %s: %v", "FPDFCatalog_IsTagged", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
-	implResp, err := s.Impl.FPDFClipPath_CountPathSegments(request)
+	implResp, err := s.Impl.FPDFCatalog_IsTagged(request)
 	if err != nil {
 		return err
 	}
@@ -7599,14 +9540,16 @@ func (s *PdfiumRPCServer) FPDFClipPath_CountPathSegments(request *requests.FPDFC
 	return nil
 }
 
-func (s *PdfiumRPCServer) FPDFClipPath_CountPaths(request *requests.FPDFClipPath_CountPaths, resp *responses.FPDFClipPath_CountPaths) (err error) {
+func (s *PdfiumRPCServer) FPDFCatalog_SetLanguage(request *requests.FPDFCatalog_SetLanguage, resp *responses.FPDFCatalog_SetLanguage) (err error) {
 	defer func() {
 		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFClipPath_CountPaths", panicError)
+			err = fmt.Errorf("panic occurred in %s: %v", "FPDFCatalog_SetLanguage", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
-	implResp, err := s.Impl.FPDFClipPath_CountPaths(request)
+	implResp, err := s.Impl.FPDFCatalog_SetLanguage(request)
 	if err != nil {
 		return err
 	}
@@ -7617,14 +9560,16 @@ func (s *PdfiumRPCServer) FPDFClipPath_CountPaths(request *requests.FPDFClipPath
 	return nil
 }
 
-func (s *PdfiumRPCServer) FPDFClipPath_GetPathSegment(request *requests.FPDFClipPath_GetPathSegment, resp *responses.FPDFClipPath_GetPathSegment) (err error) {
+func (s *PdfiumRPCServer) FPDFClipPath_CountPathSegments(request *requests.FPDFClipPath_CountPathSegments, resp *responses.FPDFClipPath_CountPathSegments) (err error) {
 	defer func() {
 		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFClipPath_GetPathSegment", panicError)
+			err = fmt.Errorf("panic occurred in %s: %v", "FPDFClipPath_CountPathSegments", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
-	implResp, err := s.Impl.FPDFClipPath_GetPathSegment(request)
+	implResp, err := s.Impl.FPDFClipPath_CountPathSegments(request)
 	if err != nil {
 		return err
 	}
@@ -7635,14 +9580,16 @@ func (s *PdfiumRPCServer) FPDFClipPath_GetPathSegment(request *requests.FPDFClip
 	return nil
 }
 
-func (s *PdfiumRPCServer) FPDFDOC_ExitFormFillEnvironment(request *requests.FPDFDOC_ExitFormFillEnvironment, resp *responses.FPDFDOC_ExitFormFillEnvironment) (err error) {
+func (s *PdfiumRPCServer) FPDFClipPath_CountPaths(request *requests.FPDFClipPath_CountPaths, resp *responses.FPDFClipPath_CountPaths) (err error) {
 	defer func() {
 		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDOC_ExitFormFillEnvironment", panicError)
+			err = fmt.Errorf("panic occurred in %s: %v", "FPDFClipPath_CountPaths", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
-	implResp, err := s.Impl.FPDFDOC_ExitFormFillEnvironment(request)
+	implResp, err := s.Impl.FPDFClipPath_CountPaths(request)
 	if err != nil {
 		return err
 	}
@@ -7653,14 +9600,16 @@ func (s *PdfiumRPCServer) FPDFDOC_ExitFormFillEnvironment(request *requests.FPDF
 	return nil
 }
 
-func (s *PdfiumRPCServer) FPDFDOC_InitFormFillEnvironment(request *requests.FPDFDOC_InitFormFillEnvironment, resp *responses.FPDFDOC_InitFormFillEnvironment) (err error) {
+func (s *PdfiumRPCServer) FPDFClipPath_GetPathSegment(request *requests.FPDFClipPath_GetPathSegment, resp *responses.FPDFClipPath_GetPathSegment) (err error) {
 	defer func() {
 		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDOC_InitFormFillEnvironment", panicError)
+			err = fmt.Errorf("panic occurred in %s: %v", "FPDFClipPath_GetPathSegment", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
-	implResp, err := s.Impl.FPDFDOC_InitFormFillEnvironment(request)
+	implResp, err := s.Impl.FPDFClipPath_GetPathSegment(request)
 	if err != nil {
 		return err
 	}
@@ -7676,6 +9625,8 @@ func (s *PdfiumRPCServer) FPDFDest_GetDestPageIndex(request *requests.FPDFDest_G
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDest_GetDestPageIndex", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFDest_GetDestPageIndex(request)
@@ -7694,6 +9645,8 @@ func (s *PdfiumRPCServer) FPDFDest_GetLocationInPage(request *requests.FPDFDest_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDest_GetLocationInPage", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFDest_GetLocationInPage(request)
@@ -7712,6 +9665,8 @@ func (s *PdfiumRPCServer) FPDFDest_GetView(request *requests.FPDFDest_GetView, r
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDest_GetView", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFDest_GetView(request)
@@ -7730,6 +9685,8 @@ func (s *PdfiumRPCServer) FPDFDoc_AddAttachment(request *requests.FPDFDoc_AddAtt
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_AddAttachment", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFDoc_AddAttachment(request)
@@ -7748,6 +9705,8 @@ func (s *PdfiumRPCServer) FPDFDoc_CloseJavaScriptAction(request *requests.FPDFDo
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_CloseJavaScriptAction", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFDoc_CloseJavaScriptAction(request)
@@ -7766,6 +9725,8 @@ func (s *PdfiumRPCServer) FPDFDoc_DeleteAttachment(request *requests.FPDFDoc_Del
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_DeleteAttachment", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFDoc_DeleteAttachment(request)
@@ -7784,6 +9745,8 @@ func (s *PdfiumRPCServer) FPDFDoc_GetAttachment(request *requests.FPDFDoc_GetAtt
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_GetAttachment", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFDoc_GetAttachment(request)
@@ -7802,6 +9765,8 @@ func (s *PdfiumRPCServer) FPDFDoc_GetAttachmentCount(request *requests.FPDFDoc_G
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_GetAttachmentCount", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFDoc_GetAttachmentCount(request)
@@ -7820,6 +9785,8 @@ func (s *PdfiumRPCServer) FPDFDoc_GetJavaScriptAction(request *requests.FPDFDoc_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_GetJavaScriptAction", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFDoc_GetJavaScriptAction(request)
@@ -7838,6 +9805,8 @@ func (s *PdfiumRPCServer) FPDFDoc_GetJavaScriptActionCount(request *requests.FPD
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_GetJavaScriptActionCount", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFDoc_GetJavaScriptActionCount(request)
@@ -7856,6 +9825,8 @@ func (s *PdfiumRPCServer) FPDFDoc_GetPageMode(request *requests.FPDFDoc_GetPageM
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_GetPageMode", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFDoc_GetPageMode(request)
@@ -7874,6 +9845,8 @@ func (s *PdfiumRPCServer) FPDFFont_Close(request *requests.FPDFFont_Close, resp
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_Close", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFFont_Close(request)
@@ -7892,6 +9865,8 @@ func (s *PdfiumRPCServer) FPDFFont_GetAscent(request *requests.FPDFFont_GetAscen
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetAscent", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFFont_GetAscent(request)
@@ -7910,6 +9885,8 @@ func (s *PdfiumRPCServer) FPDFFont_GetBaseFontName(request *requests.FPDFFont_Ge
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetBaseFontName", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFFont_GetBaseFontName(request)
@@ -7928,6 +9905,8 @@ func (s *PdfiumRPCServer) FPDFFont_GetDescent(request *requests.FPDFFont_GetDesc
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetDescent", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFFont_GetDescent(request)
@@ -7946,6 +9925,8 @@ func (s *PdfiumRPCServer) FPDFFont_GetFamilyName(request *requests.FPDFFont_GetF
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetFamilyName", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFFont_GetFamilyName(request)
@@ -7964,6 +9945,8 @@ func (s *PdfiumRPCServer) FPDFFont_GetFlags(request *requests.FPDFFont_GetFlags,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetFlags", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFFont_GetFlags(request)
@@ -7982,6 +9965,8 @@ func (s *PdfiumRPCServer) FPDFFont_GetFontData(request *requests.FPDFFont_GetFon
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetFontData", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFFont_GetFontData(request)
@@ -8000,6 +9985,8 @@ func (s *PdfiumRPCServer) FPDFFont_GetGlyphPath(request *requests.FPDFFont_GetGl
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetGlyphPath", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFFont_GetGlyphPath(request)
@@ -8018,6 +10005,8 @@ func (s *PdfiumRPCServer) FPDFFont_GetGlyphWidth(request *requests.FPDFFont_GetG
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetGlyphWidth", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFFont_GetGlyphWidth(request)
@@ -8036,6 +10025,8 @@ func (s *PdfiumRPCServer) FPDFFont_GetIsEmbedded(request *requests.FPDFFont_GetI
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetIsEmbedded", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFFont_GetIsEmbedded(request)
@@ -8054,6 +10045,8 @@ func (s *PdfiumRPCServer) FPDFFont_GetItalicAngle(request *requests.FPDFFont_Get
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetItalicAngle", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFFont_GetItalicAngle(request)
@@ -8072,6 +10065,8 @@ func (s *PdfiumRPCServer) FPDFFont_GetWeight(request *requests.FPDFFont_GetWeigh
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetWeight", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFFont_GetWeight(request)
@@ -8090,6 +10085,8 @@ func (s *PdfiumRPCServer) FPDFFormObj_CountObjects(request *requests.FPDFFormObj
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFormObj_CountObjects", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFFormObj_CountObjects(request)
@@ -8108,6 +10105,8 @@ func (s *PdfiumRPCServer) FPDFFormObj_GetObject(request *requests.FPDFFormObj_Ge
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFormObj_GetObject", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFFormObj_GetObject(request)
@@ -8126,6 +10125,8 @@ func (s *PdfiumRPCServer) FPDFFormObj_RemoveObject(request *requests.FPDFFormObj
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFormObj_RemoveObject", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFFormObj_RemoveObject(request)
@@ -8144,6 +10145,8 @@ func (s *PdfiumRPCServer) FPDFGlyphPath_CountGlyphSegments(request *requests.FPD
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFGlyphPath_CountGlyphSegments", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFGlyphPath_CountGlyphSegments(request)
@@ -8162,6 +10165,8 @@ func (s *PdfiumRPCServer) FPDFGlyphPath_GetGlyphPathSegment(request *requests.FP
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFGlyphPath_GetGlyphPathSegment", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFGlyphPath_GetGlyphPathSegment(request)
@@ -8180,6 +10185,8 @@ func (s *PdfiumRPCServer) FPDFImageObj_GetBitmap(request *requests.FPDFImageObj_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetBitmap", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFImageObj_GetBitmap(request)
@@ -8198,6 +10205,8 @@ func (s *PdfiumRPCServer) FPDFImageObj_GetIccProfileDataDecoded(request *request
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetIccProfileDataDecoded", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFImageObj_GetIccProfileDataDecoded(request)
@@ -8216,6 +10225,8 @@ func (s *PdfiumRPCServer) FPDFImageObj_GetImageDataDecoded(request *requests.FPD
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetImageDataDecoded", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFImageObj_GetImageDataDecoded(request)
@@ -8234,6 +10245,8 @@ func (s *PdfiumRPCServer) FPDFImageObj_GetImageDataRaw(request *requests.FPDFIma
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetImageDataRaw", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFImageObj_GetImageDataRaw(request)
@@ -8252,6 +10265,8 @@ func (s *PdfiumRPCServer) FPDFImageObj_GetImageFilter(request *requests.FPDFImag
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetImageFilter", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFImageObj_GetImageFilter(request)
@@ -8270,6 +10285,8 @@ func (s *PdfiumRPCServer) FPDFImageObj_GetImageFilterCount(request *requests.FPD
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetImageFilterCount", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFImageObj_GetImageFilterCount(request)
@@ -8288,6 +10305,8 @@ func (s *PdfiumRPCServer) FPDFImageObj_GetImageMetadata(request *requests.FPDFIm
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetImageMetadata", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFImageObj_GetImageMetadata(request)
@@ -8306,6 +10325,8 @@ func (s *PdfiumRPCServer) FPDFImageObj_GetImagePixelSize(request *requests.FPDFI
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetImagePixelSize", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFImageObj_GetImagePixelSize(request)
@@ -8324,6 +10345,8 @@ func (s *PdfiumRPCServer) FPDFImageObj_GetRenderedBitmap(request *requests.FPDFI
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetRenderedBitmap", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFImageObj_GetRenderedBitmap(request)
@@ -8342,6 +10365,8 @@ func (s *PdfiumRPCServer) FPDFImageObj_LoadJpegFile(request *requests.FPDFImageO
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_LoadJpegFile", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFImageObj_LoadJpegFile(request)
@@ -8360,6 +10385,8 @@ func (s *PdfiumRPCServer) FPDFImageObj_LoadJpegFileInline(request *requests.FPDF
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_LoadJpegFileInline", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFImageObj_LoadJpegFileInline(request)
@@ -8378,6 +10405,8 @@ func (s *PdfiumRPCServer) FPDFImageObj_SetBitmap(request *requests.FPDFImageObj_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_SetBitmap", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFImageObj_SetBitmap(request)
@@ -8396,6 +10425,8 @@ func (s *PdfiumRPCServer) FPDFImageObj_SetMatrix(request *requests.FPDFImageObj_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_SetMatrix", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFImageObj_SetMatrix(request)
@@ -8414,6 +10445,8 @@ func (s *PdfiumRPCServer) FPDFJavaScriptAction_GetName(request *requests.FPDFJav
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFJavaScriptAction_GetName", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFJavaScriptAction_GetName(request)
@@ -8432,6 +10465,8 @@ func (s *PdfiumRPCServer) FPDFJavaScriptAction_GetScript(request *requests.FPDFJ
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFJavaScriptAction_GetScript", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFJavaScriptAction_GetScript(request)
@@ -8450,6 +10485,8 @@ func (s *PdfiumRPCServer) FPDFLink_CloseWebLinks(request *requests.FPDFLink_Clos
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_CloseWebLinks", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFLink_CloseWebLinks(request)
@@ -8468,6 +10505,8 @@ func (s *PdfiumRPCServer) FPDFLink_CountQuadPoints(request *requests.FPDFLink_Co
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_CountQuadPoints", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFLink_CountQuadPoints(request)
@@ -8486,6 +10525,8 @@ func (s *PdfiumRPCServer) FPDFLink_CountRects(request *requests.FPDFLink_CountRe
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_CountRects", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFLink_CountRects(request)
@@ -8504,6 +10545,8 @@ func (s *PdfiumRPCServer) FPDFLink_CountWebLinks(request *requests.FPDFLink_Coun
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_CountWebLinks", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFLink_CountWebLinks(request)
@@ -8522,6 +10565,8 @@ func (s *PdfiumRPCServer) FPDFLink_Enumerate(request *requests.FPDFLink_Enumerat
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_Enumerate", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFLink_Enumerate(request)
@@ -8540,6 +10585,8 @@ func (s *PdfiumRPCServer) FPDFLink_GetAction(request *requests.FPDFLink_GetActio
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetAction", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFLink_GetAction(request)
@@ -8558,6 +10605,8 @@ func (s *PdfiumRPCServer) FPDFLink_GetAnnot(request *requests.FPDFLink_GetAnnot,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetAnnot", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFLink_GetAnnot(request)
@@ -8576,6 +10625,8 @@ func (s *PdfiumRPCServer) FPDFLink_GetAnnotRect(request *requests.FPDFLink_GetAn
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetAnnotRect", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFLink_GetAnnotRect(request)
@@ -8594,6 +10645,8 @@ func (s *PdfiumRPCServer) FPDFLink_GetDest(request *requests.FPDFLink_GetDest, r
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetDest", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFLink_GetDest(request)
@@ -8612,6 +10665,8 @@ func (s *PdfiumRPCServer) FPDFLink_GetLinkAtPoint(request *requests.FPDFLink_Get
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetLinkAtPoint", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFLink_GetLinkAtPoint(request)
@@ -8630,6 +10685,8 @@ func (s *PdfiumRPCServer) FPDFLink_GetLinkZOrderAtPoint(request *requests.FPDFLi
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetLinkZOrderAtPoint", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFLink_GetLinkZOrderAtPoint(request)
@@ -8648,6 +10705,8 @@ func (s *PdfiumRPCServer) FPDFLink_GetQuadPoints(request *requests.FPDFLink_GetQ
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetQuadPoints", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFLink_GetQuadPoints(request)
@@ -8666,6 +10725,8 @@ func (s *PdfiumRPCServer) FPDFLink_GetRect(request *requests.FPDFLink_GetRect, r
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetRect", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFLink_GetRect(request)
@@ -8684,6 +10745,8 @@ func (s *PdfiumRPCServer) FPDFLink_GetTextRange(request *requests.FPDFLink_GetTe
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetTextRange", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFLink_GetTextRange(request)
@@ -8702,6 +10765,8 @@ func (s *PdfiumRPCServer) FPDFLink_GetURL(request *requests.FPDFLink_GetURL, res
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetURL", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFLink_GetURL(request)
@@ -8720,6 +10785,8 @@ func (s *PdfiumRPCServer) FPDFLink_LoadWebLinks(request *requests.FPDFLink_LoadW
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_LoadWebLinks", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFLink_LoadWebLinks(request)
@@ -8738,6 +10805,8 @@ func (s *PdfiumRPCServer) FPDFPageObjMark_CountParams(request *requests.FPDFPage
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_CountParams", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObjMark_CountParams(request)
@@ -8756,6 +10825,8 @@ func (s *PdfiumRPCServer) FPDFPageObjMark_GetName(request *requests.FPDFPageObjM
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetName", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObjMark_GetName(request)
@@ -8774,6 +10845,8 @@ func (s *PdfiumRPCServer) FPDFPageObjMark_GetParamBlobValue(request *requests.FP
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetParamBlobValue", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObjMark_GetParamBlobValue(request)
@@ -8792,6 +10865,8 @@ func (s *PdfiumRPCServer) FPDFPageObjMark_GetParamFloatValue(request *requests.F
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetParamFloatValue", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObjMark_GetParamFloatValue(request)
@@ -8810,6 +10885,8 @@ func (s *PdfiumRPCServer) FPDFPageObjMark_GetParamIntValue(request *requests.FPD
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetParamIntValue", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObjMark_GetParamIntValue(request)
@@ -8828,6 +10905,8 @@ func (s *PdfiumRPCServer) FPDFPageObjMark_GetParamKey(request *requests.FPDFPage
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetParamKey", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObjMark_GetParamKey(request)
@@ -8846,6 +10925,8 @@ func (s *PdfiumRPCServer) FPDFPageObjMark_GetParamStringValue(request *requests.
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetParamStringValue", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObjMark_GetParamStringValue(request)
@@ -8864,6 +10945,8 @@ func (s *PdfiumRPCServer) FPDFPageObjMark_GetParamValueType(request *requests.FP
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetParamValueType", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObjMark_GetParamValueType(request)
@@ -8882,6 +10965,8 @@ func (s *PdfiumRPCServer) FPDFPageObjMark_RemoveParam(request *requests.FPDFPage
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_RemoveParam", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObjMark_RemoveParam(request)
@@ -8900,6 +10985,8 @@ func (s *PdfiumRPCServer) FPDFPageObjMark_SetBlobParam(request *requests.FPDFPag
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_SetBlobParam", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObjMark_SetBlobParam(request)
@@ -8918,6 +11005,8 @@ func (s *PdfiumRPCServer) FPDFPageObjMark_SetFloatParam(request *requests.FPDFPa
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_SetFloatParam", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObjMark_SetFloatParam(request)
@@ -8936,6 +11025,8 @@ func (s *PdfiumRPCServer) FPDFPageObjMark_SetIntParam(request *requests.FPDFPage
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_SetIntParam", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObjMark_SetIntParam(request)
@@ -8954,6 +11045,8 @@ func (s *PdfiumRPCServer) FPDFPageObjMark_SetStringParam(request *requests.FPDFP
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_SetStringParam", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObjMark_SetStringParam(request)
@@ -8972,6 +11065,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_AddExistingMark(request *requests.FPDFPage
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_AddExistingMark", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_AddExistingMark(request)
@@ -8990,6 +11085,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_AddMark(request *requests.FPDFPageObj_AddM
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_AddMark", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_AddMark(request)
@@ -9008,6 +11105,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_CountMarks(request *requests.FPDFPageObj_C
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_CountMarks", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_CountMarks(request)
@@ -9026,6 +11125,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_CreateNewPath(request *requests.FPDFPageOb
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_CreateNewPath", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_CreateNewPath(request)
@@ -9044,6 +11145,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_CreateNewRect(request *requests.FPDFPageOb
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_CreateNewRect", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_CreateNewRect(request)
@@ -9062,6 +11165,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_CreateTextObj(request *requests.FPDFPageOb
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_CreateTextObj", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_CreateTextObj(request)
@@ -9080,6 +11185,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_Destroy(request *requests.FPDFPageObj_Dest
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_Destroy", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_Destroy(request)
@@ -9098,6 +11205,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_GetBounds(request *requests.FPDFPageObj_Ge
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetBounds", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_GetBounds(request)
@@ -9116,6 +11225,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_GetClipPath(request *requests.FPDFPageObj_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetClipPath", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_GetClipPath(request)
@@ -9134,6 +11245,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_GetDashArray(request *requests.FPDFPageObj
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetDashArray", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_GetDashArray(request)
@@ -9152,6 +11265,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_GetDashCount(request *requests.FPDFPageObj
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetDashCount", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_GetDashCount(request)
@@ -9170,6 +11285,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_GetDashPhase(request *requests.FPDFPageObj
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetDashPhase", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_GetDashPhase(request)
@@ -9188,6 +11305,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_GetFillColor(request *requests.FPDFPageObj
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetFillColor", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_GetFillColor(request)
@@ -9206,6 +11325,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_GetIsActive(request *requests.FPDFPageObj_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetIsActive", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_GetIsActive(request)
@@ -9224,6 +11345,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_GetLineCap(request *requests.FPDFPageObj_G
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetLineCap", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_GetLineCap(request)
@@ -9242,6 +11365,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_GetLineJoin(request *requests.FPDFPageObj_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetLineJoin", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_GetLineJoin(request)
@@ -9260,6 +11385,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_GetMark(request *requests.FPDFPageObj_GetM
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetMark", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_GetMark(request)
@@ -9278,6 +11405,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_GetMarkedContentID(request *requests.FPDFP
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetMarkedContentID", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_GetMarkedContentID(request)
@@ -9296,6 +11425,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_GetMatrix(request *requests.FPDFPageObj_Ge
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetMatrix", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_GetMatrix(request)
@@ -9314,6 +11445,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_GetRotatedBounds(request *requests.FPDFPag
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetRotatedBounds", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_GetRotatedBounds(request)
@@ -9332,6 +11465,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_GetStrokeColor(request *requests.FPDFPageO
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetStrokeColor", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_GetStrokeColor(request)
@@ -9350,6 +11485,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_GetStrokeWidth(request *requests.FPDFPageO
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetStrokeWidth", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_GetStrokeWidth(request)
@@ -9368,6 +11505,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_GetType(request *requests.FPDFPageObj_GetT
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetType", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_GetType(request)
@@ -9386,6 +11525,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_HasTransparency(request *requests.FPDFPage
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_HasTransparency", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_HasTransparency(request)
@@ -9404,6 +11545,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_NewImageObj(request *requests.FPDFPageObj_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_NewImageObj", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_NewImageObj(request)
@@ -9422,6 +11565,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_NewTextObj(request *requests.FPDFPageObj_N
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_NewTextObj", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_NewTextObj(request)
@@ -9440,6 +11585,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_RemoveMark(request *requests.FPDFPageObj_R
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_RemoveMark", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_RemoveMark(request)
@@ -9458,6 +11605,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_SetBlendMode(request *requests.FPDFPageObj
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetBlendMode", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_SetBlendMode(request)
@@ -9476,6 +11625,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_SetDashArray(request *requests.FPDFPageObj
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetDashArray", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_SetDashArray(request)
@@ -9494,6 +11645,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_SetDashPhase(request *requests.FPDFPageObj
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetDashPhase", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_SetDashPhase(request)
@@ -9512,6 +11665,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_SetFillColor(request *requests.FPDFPageObj
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetFillColor", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_SetFillColor(request)
@@ -9530,6 +11685,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_SetIsActive(request *requests.FPDFPageObj_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetIsActive", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_SetIsActive(request)
@@ -9548,6 +11705,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_SetLineCap(request *requests.FPDFPageObj_S
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetLineCap", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_SetLineCap(request)
@@ -9566,6 +11725,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_SetLineJoin(request *requests.FPDFPageObj_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetLineJoin", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_SetLineJoin(request)
@@ -9584,6 +11745,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_SetMatrix(request *requests.FPDFPageObj_Se
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetMatrix", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_SetMatrix(request)
@@ -9602,6 +11765,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_SetStrokeColor(request *requests.FPDFPageO
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetStrokeColor", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_SetStrokeColor(request)
@@ -9620,6 +11785,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_SetStrokeWidth(request *requests.FPDFPageO
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetStrokeWidth", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_SetStrokeWidth(request)
@@ -9638,6 +11805,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_Transform(request *requests.FPDFPageObj_Tr
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_Transform", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_Transform(request)
@@ -9656,6 +11825,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_TransformClipPath(request *requests.FPDFPa
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_TransformClipPath", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_TransformClipPath(request)
@@ -9674,6 +11845,8 @@ func (s *PdfiumRPCServer) FPDFPageObj_TransformF(request *requests.FPDFPageObj_T
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_TransformF", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPageObj_TransformF(request)
@@ -9692,6 +11865,8 @@ func (s *PdfiumRPCServer) FPDFPage_CloseAnnot(request *requests.FPDFPage_CloseAn
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_CloseAnnot", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_CloseAnnot(request)
@@ -9710,6 +11885,8 @@ func (s *PdfiumRPCServer) FPDFPage_CountObjects(request *requests.FPDFPage_Count
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_CountObjects", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_CountObjects(request)
@@ -9728,6 +11905,8 @@ func (s *PdfiumRPCServer) FPDFPage_CreateAnnot(request *requests.FPDFPage_Create
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_CreateAnnot", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_CreateAnnot(request)
@@ -9746,6 +11925,8 @@ func (s *PdfiumRPCServer) FPDFPage_Delete(request *requests.FPDFPage_Delete, res
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_Delete", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_Delete(request)
@@ -9764,6 +11945,8 @@ func (s *PdfiumRPCServer) FPDFPage_Flatten(request *requests.FPDFPage_Flatten, r
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_Flatten", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_Flatten(request)
@@ -9782,6 +11965,8 @@ func (s *PdfiumRPCServer) FPDFPage_FormFieldZOrderAtPoint(request *requests.FPDF
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_FormFieldZOrderAtPoint", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_FormFieldZOrderAtPoint(request)
@@ -9800,6 +11985,8 @@ func (s *PdfiumRPCServer) FPDFPage_GenerateContent(request *requests.FPDFPage_Ge
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GenerateContent", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_GenerateContent(request)
@@ -9818,6 +12005,8 @@ func (s *PdfiumRPCServer) FPDFPage_GetAnnot(request *requests.FPDFPage_GetAnnot,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetAnnot", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_GetAnnot(request)
@@ -9836,6 +12025,8 @@ func (s *PdfiumRPCServer) FPDFPage_GetAnnotCount(request *requests.FPDFPage_GetA
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetAnnotCount", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_GetAnnotCount(request)
@@ -9854,6 +12045,8 @@ func (s *PdfiumRPCServer) FPDFPage_GetAnnotIndex(request *requests.FPDFPage_GetA
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetAnnotIndex", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_GetAnnotIndex(request)
@@ -9872,6 +12065,8 @@ func (s *PdfiumRPCServer) FPDFPage_GetArtBox(request *requests.FPDFPage_GetArtBo
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetArtBox", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_GetArtBox(request)
@@ -9890,6 +12085,8 @@ func (s *PdfiumRPCServer) FPDFPage_GetBleedBox(request *requests.FPDFPage_GetBle
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetBleedBox", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_GetBleedBox(request)
@@ -9908,6 +12105,8 @@ func (s *PdfiumRPCServer) FPDFPage_GetCropBox(request *requests.FPDFPage_GetCrop
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetCropBox", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_GetCropBox(request)
@@ -9926,6 +12125,8 @@ func (s *PdfiumRPCServer) FPDFPage_GetDecodedThumbnailData(request *requests.FPD
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetDecodedThumbnailData", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_GetDecodedThumbnailData(request)
@@ -9944,6 +12145,8 @@ func (s *PdfiumRPCServer) FPDFPage_GetMediaBox(request *requests.FPDFPage_GetMed
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetMediaBox", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_GetMediaBox(request)
@@ -9962,6 +12165,8 @@ func (s *PdfiumRPCServer) FPDFPage_GetObject(request *requests.FPDFPage_GetObjec
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetObject", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_GetObject(request)
@@ -9980,6 +12185,8 @@ func (s *PdfiumRPCServer) FPDFPage_GetRawThumbnailData(request *requests.FPDFPag
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetRawThumbnailData", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_GetRawThumbnailData(request)
@@ -9998,6 +12205,8 @@ func (s *PdfiumRPCServer) FPDFPage_GetRotation(request *requests.FPDFPage_GetRot
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetRotation", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_GetRotation(request)
@@ -10016,6 +12225,8 @@ func (s *PdfiumRPCServer) FPDFPage_GetThumbnailAsBitmap(request *requests.FPDFPa
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetThumbnailAsBitmap", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_GetThumbnailAsBitmap(request)
@@ -10034,6 +12245,8 @@ func (s *PdfiumRPCServer) FPDFPage_GetTrimBox(request *requests.FPDFPage_GetTrim
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetTrimBox", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_GetTrimBox(request)
@@ -10052,6 +12265,8 @@ func (s *PdfiumRPCServer) FPDFPage_HasFormFieldAtPoint(request *requests.FPDFPag
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_HasFormFieldAtPoint", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_HasFormFieldAtPoint(request)
@@ -10070,6 +12285,8 @@ func (s *PdfiumRPCServer) FPDFPage_HasTransparency(request *requests.FPDFPage_Ha
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_HasTransparency", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_HasTransparency(request)
@@ -10088,6 +12305,8 @@ func (s *PdfiumRPCServer) FPDFPage_InsertClipPath(request *requests.FPDFPage_Ins
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_InsertClipPath", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_InsertClipPath(request)
@@ -10106,6 +12325,8 @@ func (s *PdfiumRPCServer) FPDFPage_InsertObject(request *requests.FPDFPage_Inser
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_InsertObject", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_InsertObject(request)
@@ -10124,6 +12345,8 @@ func (s *PdfiumRPCServer) FPDFPage_InsertObjectAtIndex(request *requests.FPDFPag
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_InsertObjectAtIndex", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_InsertObjectAtIndex(request)
@@ -10142,6 +12365,8 @@ func (s *PdfiumRPCServer) FPDFPage_New(request *requests.FPDFPage_New, resp *res
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_New", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_New(request)
@@ -10160,6 +12385,8 @@ func (s *PdfiumRPCServer) FPDFPage_RemoveAnnot(request *requests.FPDFPage_Remove
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_RemoveAnnot", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_RemoveAnnot(request)
@@ -10178,6 +12405,8 @@ func (s *PdfiumRPCServer) FPDFPage_RemoveObject(request *requests.FPDFPage_Remov
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_RemoveObject", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_RemoveObject(request)
@@ -10196,6 +12425,8 @@ func (s *PdfiumRPCServer) FPDFPage_SetArtBox(request *requests.FPDFPage_SetArtBo
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_SetArtBox", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_SetArtBox(request)
@@ -10214,6 +12445,8 @@ func (s *PdfiumRPCServer) FPDFPage_SetBleedBox(request *requests.FPDFPage_SetBle
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_SetBleedBox", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_SetBleedBox(request)
@@ -10232,6 +12465,8 @@ func (s *PdfiumRPCServer) FPDFPage_SetCropBox(request *requests.FPDFPage_SetCrop
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_SetCropBox", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_SetCropBox(request)
@@ -10250,6 +12485,8 @@ func (s *PdfiumRPCServer) FPDFPage_SetMediaBox(request *requests.FPDFPage_SetMed
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_SetMediaBox", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_SetMediaBox(request)
@@ -10268,6 +12505,8 @@ func (s *PdfiumRPCServer) FPDFPage_SetRotation(request *requests.FPDFPage_SetRot
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_SetRotation", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_SetRotation(request)
@@ -10286,6 +12525,8 @@ func (s *PdfiumRPCServer) FPDFPage_SetTrimBox(request *requests.FPDFPage_SetTrim
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_SetTrimBox", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_SetTrimBox(request)
@@ -10304,6 +12545,8 @@ func (s *PdfiumRPCServer) FPDFPage_TransFormWithClip(request *requests.FPDFPage_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_TransFormWithClip", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_TransFormWithClip(request)
@@ -10322,6 +12565,8 @@ func (s *PdfiumRPCServer) FPDFPage_TransformAnnots(request *requests.FPDFPage_Tr
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_TransformAnnots", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPage_TransformAnnots(request)
@@ -10340,6 +12585,8 @@ func (s *PdfiumRPCServer) FPDFPathSegment_GetClose(request *requests.FPDFPathSeg
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPathSegment_GetClose", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPathSegment_GetClose(request)
@@ -10358,6 +12605,8 @@ func (s *PdfiumRPCServer) FPDFPathSegment_GetPoint(request *requests.FPDFPathSeg
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPathSegment_GetPoint", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPathSegment_GetPoint(request)
@@ -10376,6 +12625,8 @@ func (s *PdfiumRPCServer) FPDFPathSegment_GetType(request *requests.FPDFPathSegm
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPathSegment_GetType", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPathSegment_GetType(request)
@@ -10394,6 +12645,8 @@ func (s *PdfiumRPCServer) FPDFPath_BezierTo(request *requests.FPDFPath_BezierTo,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_BezierTo", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPath_BezierTo(request)
@@ -10412,6 +12665,8 @@ func (s *PdfiumRPCServer) FPDFPath_Close(request *requests.FPDFPath_Close, resp
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_Close", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPath_Close(request)
@@ -10430,6 +12685,8 @@ func (s *PdfiumRPCServer) FPDFPath_CountSegments(request *requests.FPDFPath_Coun
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_CountSegments", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPath_CountSegments(request)
@@ -10448,6 +12705,8 @@ func (s *PdfiumRPCServer) FPDFPath_GetDrawMode(request *requests.FPDFPath_GetDra
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_GetDrawMode", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPath_GetDrawMode(request)
@@ -10466,6 +12725,8 @@ func (s *PdfiumRPCServer) FPDFPath_GetPathSegment(request *requests.FPDFPath_Get
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_GetPathSegment", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPath_GetPathSegment(request)
@@ -10484,6 +12745,8 @@ func (s *PdfiumRPCServer) FPDFPath_LineTo(request *requests.FPDFPath_LineTo, res
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_LineTo", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPath_LineTo(request)
@@ -10502,6 +12765,8 @@ func (s *PdfiumRPCServer) FPDFPath_MoveTo(request *requests.FPDFPath_MoveTo, res
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_MoveTo", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPath_MoveTo(request)
@@ -10520,6 +12785,8 @@ func (s *PdfiumRPCServer) FPDFPath_SetDrawMode(request *requests.FPDFPath_SetDra
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_SetDrawMode", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFPath_SetDrawMode(request)
@@ -10538,6 +12805,8 @@ func (s *PdfiumRPCServer) FPDFSignatureObj_GetByteRange(request *requests.FPDFSi
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFSignatureObj_GetByteRange", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFSignatureObj_GetByteRange(request)
@@ -10556,6 +12825,8 @@ func (s *PdfiumRPCServer) FPDFSignatureObj_GetContents(request *requests.FPDFSig
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFSignatureObj_GetContents", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFSignatureObj_GetContents(request)
@@ -10574,6 +12845,8 @@ func (s *PdfiumRPCServer) FPDFSignatureObj_GetDocMDPPermission(request *requests
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFSignatureObj_GetDocMDPPermission", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFSignatureObj_GetDocMDPPermission(request)
@@ -10592,6 +12865,8 @@ func (s *PdfiumRPCServer) FPDFSignatureObj_GetReason(request *requests.FPDFSigna
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFSignatureObj_GetReason", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFSignatureObj_GetReason(request)
@@ -10610,6 +12885,8 @@ func (s *PdfiumRPCServer) FPDFSignatureObj_GetSubFilter(request *requests.FPDFSi
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFSignatureObj_GetSubFilter", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFSignatureObj_GetSubFilter(request)
@@ -10628,6 +12905,8 @@ func (s *PdfiumRPCServer) FPDFSignatureObj_GetTime(request *requests.FPDFSignatu
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFSignatureObj_GetTime", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFSignatureObj_GetTime(request)
@@ -10646,6 +12925,8 @@ func (s *PdfiumRPCServer) FPDFTextObj_GetFont(request *requests.FPDFTextObj_GetF
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_GetFont", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFTextObj_GetFont(request)
@@ -10664,6 +12945,8 @@ func (s *PdfiumRPCServer) FPDFTextObj_GetFontSize(request *requests.FPDFTextObj_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_GetFontSize", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFTextObj_GetFontSize(request)
@@ -10682,6 +12965,8 @@ func (s *PdfiumRPCServer) FPDFTextObj_GetRenderedBitmap(request *requests.FPDFTe
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_GetRenderedBitmap", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFTextObj_GetRenderedBitmap(request)
@@ -10700,6 +12985,8 @@ func (s *PdfiumRPCServer) FPDFTextObj_GetText(request *requests.FPDFTextObj_GetT
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_GetText", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFTextObj_GetText(request)
@@ -10718,6 +13005,8 @@ func (s *PdfiumRPCServer) FPDFTextObj_GetTextRenderMode(request *requests.FPDFTe
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_GetTextRenderMode", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFTextObj_GetTextRenderMode(request)
@@ -10736,6 +13025,8 @@ func (s *PdfiumRPCServer) FPDFTextObj_SetFontSize(request *requests.FPDFTextObj_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_SetFontSize", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFTextObj_SetFontSize(request)
@@ -10754,6 +13045,8 @@ func (s *PdfiumRPCServer) FPDFTextObj_SetTextRenderMode(request *requests.FPDFTe
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_SetTextRenderMode", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFTextObj_SetTextRenderMode(request)
@@ -10772,6 +13065,8 @@ func (s *PdfiumRPCServer) FPDFText_ClosePage(request *requests.FPDFText_ClosePag
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_ClosePage", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_ClosePage(request)
@@ -10790,6 +13085,8 @@ func (s *PdfiumRPCServer) FPDFText_CountChars(request *requests.FPDFText_CountCh
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_CountChars", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_CountChars(request)
@@ -10808,6 +13105,8 @@ func (s *PdfiumRPCServer) FPDFText_CountRects(request *requests.FPDFText_CountRe
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_CountRects", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_CountRects(request)
@@ -10826,6 +13125,8 @@ func (s *PdfiumRPCServer) FPDFText_FindClose(request *requests.FPDFText_FindClos
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_FindClose", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_FindClose(request)
@@ -10844,6 +13145,8 @@ func (s *PdfiumRPCServer) FPDFText_FindNext(request *requests.FPDFText_FindNext,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_FindNext", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_FindNext(request)
@@ -10862,6 +13165,8 @@ func (s *PdfiumRPCServer) FPDFText_FindPrev(request *requests.FPDFText_FindPrev,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_FindPrev", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_FindPrev(request)
@@ -10880,6 +13185,8 @@ func (s *PdfiumRPCServer) FPDFText_FindStart(request *requests.FPDFText_FindStar
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_FindStart", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_FindStart(request)
@@ -10898,6 +13205,8 @@ func (s *PdfiumRPCServer) FPDFText_GetBoundedText(request *requests.FPDFText_Get
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetBoundedText", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetBoundedText(request)
@@ -10916,6 +13225,8 @@ func (s *PdfiumRPCServer) FPDFText_GetCharAngle(request *requests.FPDFText_GetCh
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetCharAngle", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetCharAngle(request)
@@ -10934,6 +13245,8 @@ func (s *PdfiumRPCServer) FPDFText_GetCharBox(request *requests.FPDFText_GetChar
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetCharBox", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetCharBox(request)
@@ -10952,6 +13265,8 @@ func (s *PdfiumRPCServer) FPDFText_GetCharIndexAtPos(request *requests.FPDFText_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetCharIndexAtPos", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetCharIndexAtPos(request)
@@ -10970,6 +13285,8 @@ func (s *PdfiumRPCServer) FPDFText_GetCharIndexFromTextIndex(request *requests.F
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetCharIndexFromTextIndex", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetCharIndexFromTextIndex(request)
@@ -10988,6 +13305,8 @@ func (s *PdfiumRPCServer) FPDFText_GetCharOrigin(request *requests.FPDFText_GetC
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetCharOrigin", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetCharOrigin(request)
@@ -11006,6 +13325,8 @@ func (s *PdfiumRPCServer) FPDFText_GetFillColor(request *requests.FPDFText_GetFi
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetFillColor", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetFillColor(request)
@@ -11024,6 +13345,8 @@ func (s *PdfiumRPCServer) FPDFText_GetFontInfo(request *requests.FPDFText_GetFon
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetFontInfo", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetFontInfo(request)
@@ -11042,6 +13365,8 @@ func (s *PdfiumRPCServer) FPDFText_GetFontSize(request *requests.FPDFText_GetFon
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetFontSize", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetFontSize(request)
@@ -11060,6 +13385,8 @@ func (s *PdfiumRPCServer) FPDFText_GetFontWeight(request *requests.FPDFText_GetF
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetFontWeight", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetFontWeight(request)
@@ -11078,6 +13405,8 @@ func (s *PdfiumRPCServer) FPDFText_GetLooseCharBox(request *requests.FPDFText_Ge
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetLooseCharBox", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetLooseCharBox(request)
@@ -11096,6 +13425,8 @@ func (s *PdfiumRPCServer) FPDFText_GetMatrix(request *requests.FPDFText_GetMatri
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetMatrix", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetMatrix(request)
@@ -11114,6 +13445,8 @@ func (s *PdfiumRPCServer) FPDFText_GetRect(request *requests.FPDFText_GetRect, r
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetRect", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetRect(request)
@@ -11132,6 +13465,8 @@ func (s *PdfiumRPCServer) FPDFText_GetSchCount(request *requests.FPDFText_GetSch
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetSchCount", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetSchCount(request)
@@ -11150,6 +13485,8 @@ func (s *PdfiumRPCServer) FPDFText_GetSchResultIndex(request *requests.FPDFText_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetSchResultIndex", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetSchResultIndex(request)
@@ -11168,6 +13505,8 @@ func (s *PdfiumRPCServer) FPDFText_GetStrokeColor(request *requests.FPDFText_Get
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetStrokeColor", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetStrokeColor(request)
@@ -11186,6 +13525,8 @@ func (s *PdfiumRPCServer) FPDFText_GetText(request *requests.FPDFText_GetText, r
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetText", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetText(request)
@@ -11204,6 +13545,8 @@ func (s *PdfiumRPCServer) FPDFText_GetTextIndexFromCharIndex(request *requests.F
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetTextIndexFromCharIndex", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetTextIndexFromCharIndex(request)
@@ -11222,6 +13565,8 @@ func (s *PdfiumRPCServer) FPDFText_GetTextObject(request *requests.FPDFText_GetT
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetTextObject", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetTextObject(request)
@@ -11240,6 +13585,8 @@ func (s *PdfiumRPCServer) FPDFText_GetUnicode(request *requests.FPDFText_GetUnic
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetUnicode", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_GetUnicode(request)
@@ -11258,6 +13605,8 @@ func (s *PdfiumRPCServer) FPDFText_HasUnicodeMapError(request *requests.FPDFText
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_HasUnicodeMapError", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_HasUnicodeMapError(request)
@@ -11276,6 +13625,8 @@ func (s *PdfiumRPCServer) FPDFText_IsGenerated(request *requests.FPDFText_IsGene
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_IsGenerated", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_IsGenerated(request)
@@ -11294,6 +13645,8 @@ func (s *PdfiumRPCServer) FPDFText_IsHyphen(request *requests.FPDFText_IsHyphen,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_IsHyphen", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_IsHyphen(request)
@@ -11312,6 +13665,8 @@ func (s *PdfiumRPCServer) FPDFText_LoadCidType2Font(request *requests.FPDFText_L
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_LoadCidType2Font", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_LoadCidType2Font(request)
@@ -11330,6 +13685,8 @@ func (s *PdfiumRPCServer) FPDFText_LoadFont(request *requests.FPDFText_LoadFont,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_LoadFont", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_LoadFont(request)
@@ -11348,6 +13705,8 @@ func (s *PdfiumRPCServer) FPDFText_LoadPage(request *requests.FPDFText_LoadPage,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_LoadPage", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_LoadPage(request)
@@ -11366,6 +13725,8 @@ func (s *PdfiumRPCServer) FPDFText_LoadStandardFont(request *requests.FPDFText_L
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_LoadStandardFont", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDFText_LoadStandardFont(request)
@@ -11384,27 +13745,11 @@ func (s *PdfiumRPCServer) FPDFText_SetCharcodes(request *requests.FPDFText_SetCh
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_SetCharcodes", panicError)
 		}
-	}()
-
-	implResp, err := s.Impl.FPDFText_SetCharcodes(request)
-	if err != nil {
-		return err
-	}
-
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
-
-	return nil
-}
 
-func (s *PdfiumRPCServer) FPDFText_SetPositions(request *requests.FPDFText_SetPositions, resp *responses.FPDFText_SetPositions) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_SetPositions", panicError)
-		}
+		err = encodeError(err)
 	}()
 
-	implResp, err := s.Impl.FPDFText_SetPositions(request)
+	implResp, err := s.Impl.FPDFText_SetCharcodes(request)
 	if err != nil {
 		return err
 	}
@@ -11415,14 +13760,16 @@ func (s *PdfiumRPCServer) FPDFText_SetPositions(request *requests.FPDFText_SetPo
 	return nil
 }
 
-func (s *PdfiumRPCServer) FPDFText_SetText(request *requests.FPDFText_SetText, resp *responses.FPDFText_SetText) (err error) {
+func (s *PdfiumRPCServer) FPDFText_SetPositions(request *requests.FPDFText_SetPositions, resp *responses.FPDFText_SetPositions) (err error) {
 	defer func() {
 		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_SetText", panicError)
+			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_SetPositions", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
-	implResp, err := s.Impl.FPDFText_SetText(request)
+	implResp, err := s.Impl.FPDFText_SetPositions(request)
 	if err != nil {
 		return err
 	}
@@ -11433,14 +13780,16 @@ func (s *PdfiumRPCServer) FPDFText_SetText(request *requests.FPDFText_SetText, r
 	return nil
 }
 
-func (s *PdfiumRPCServer) FPDF_CloseDocument(request *requests.FPDF_CloseDocument, resp *responses.FPDF_CloseDocument) (err error) {
+func (s *PdfiumRPCServer) FPDFText_SetText(request *requests.FPDFText_SetText, resp *responses.FPDFText_SetText) (err error) {
 	defer func() {
 		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_CloseDocument", panicError)
+			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_SetText", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
-	implResp, err := s.Impl.FPDF_CloseDocument(request)
+	implResp, err := s.Impl.FPDFText_SetText(request)
 	if err != nil {
 		return err
 	}
@@ -11456,6 +13805,8 @@ func (s *PdfiumRPCServer) FPDF_ClosePage(request *requests.FPDF_ClosePage, resp
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_ClosePage", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_ClosePage(request)
@@ -11474,6 +13825,8 @@ func (s *PdfiumRPCServer) FPDF_CloseXObject(request *requests.FPDF_CloseXObject,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_CloseXObject", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_CloseXObject(request)
@@ -11492,6 +13845,8 @@ func (s *PdfiumRPCServer) FPDF_CopyViewerPreferences(request *requests.FPDF_Copy
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_CopyViewerPreferences", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_CopyViewerPreferences(request)
@@ -11510,6 +13865,8 @@ func (s *PdfiumRPCServer) FPDF_CountNamedDests(request *requests.FPDF_CountNamed
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_CountNamedDests", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_CountNamedDests(request)
@@ -11528,6 +13885,8 @@ func (s *PdfiumRPCServer) FPDF_CreateClipPath(request *requests.FPDF_CreateClipP
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_CreateClipPath", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_CreateClipPath(request)
@@ -11546,6 +13905,8 @@ func (s *PdfiumRPCServer) FPDF_CreateNewDocument(request *requests.FPDF_CreateNe
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_CreateNewDocument", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_CreateNewDocument(request)
@@ -11564,6 +13925,8 @@ func (s *PdfiumRPCServer) FPDF_DestroyClipPath(request *requests.FPDF_DestroyCli
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_DestroyClipPath", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_DestroyClipPath(request)
@@ -11582,6 +13945,8 @@ func (s *PdfiumRPCServer) FPDF_DeviceToPage(request *requests.FPDF_DeviceToPage,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_DeviceToPage", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_DeviceToPage(request)
@@ -11600,6 +13965,8 @@ func (s *PdfiumRPCServer) FPDF_DocumentHasValidCrossReferenceTable(request *requ
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_DocumentHasValidCrossReferenceTable", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_DocumentHasValidCrossReferenceTable(request)
@@ -11618,6 +13985,8 @@ func (s *PdfiumRPCServer) FPDF_FFLDraw(request *requests.FPDF_FFLDraw, resp *res
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_FFLDraw", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_FFLDraw(request)
@@ -11636,6 +14005,8 @@ func (s *PdfiumRPCServer) FPDF_GetDocPermissions(request *requests.FPDF_GetDocPe
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetDocPermissions", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetDocPermissions(request)
@@ -11654,6 +14025,8 @@ func (s *PdfiumRPCServer) FPDF_GetDocUserPermissions(request *requests.FPDF_GetD
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetDocUserPermissions", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetDocUserPermissions(request)
@@ -11672,6 +14045,8 @@ func (s *PdfiumRPCServer) FPDF_GetFileIdentifier(request *requests.FPDF_GetFileI
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetFileIdentifier", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetFileIdentifier(request)
@@ -11690,6 +14065,8 @@ func (s *PdfiumRPCServer) FPDF_GetFileVersion(request *requests.FPDF_GetFileVers
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetFileVersion", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetFileVersion(request)
@@ -11708,6 +14085,8 @@ func (s *PdfiumRPCServer) FPDF_GetFormType(request *requests.FPDF_GetFormType, r
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetFormType", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetFormType(request)
@@ -11726,6 +14105,8 @@ func (s *PdfiumRPCServer) FPDF_GetLastError(request *requests.FPDF_GetLastError,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetLastError", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetLastError(request)
@@ -11744,6 +14125,8 @@ func (s *PdfiumRPCServer) FPDF_GetMetaText(request *requests.FPDF_GetMetaText, r
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetMetaText", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetMetaText(request)
@@ -11762,6 +14145,8 @@ func (s *PdfiumRPCServer) FPDF_GetNamedDest(request *requests.FPDF_GetNamedDest,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetNamedDest", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetNamedDest(request)
@@ -11780,6 +14165,8 @@ func (s *PdfiumRPCServer) FPDF_GetNamedDestByName(request *requests.FPDF_GetName
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetNamedDestByName", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetNamedDestByName(request)
@@ -11798,6 +14185,8 @@ func (s *PdfiumRPCServer) FPDF_GetPageAAction(request *requests.FPDF_GetPageAAct
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageAAction", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetPageAAction(request)
@@ -11816,6 +14205,8 @@ func (s *PdfiumRPCServer) FPDF_GetPageBoundingBox(request *requests.FPDF_GetPage
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageBoundingBox", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetPageBoundingBox(request)
@@ -11834,6 +14225,8 @@ func (s *PdfiumRPCServer) FPDF_GetPageCount(request *requests.FPDF_GetPageCount,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageCount", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetPageCount(request)
@@ -11852,6 +14245,8 @@ func (s *PdfiumRPCServer) FPDF_GetPageHeight(request *requests.FPDF_GetPageHeigh
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageHeight", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetPageHeight(request)
@@ -11870,6 +14265,8 @@ func (s *PdfiumRPCServer) FPDF_GetPageHeightF(request *requests.FPDF_GetPageHeig
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageHeightF", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetPageHeightF(request)
@@ -11888,6 +14285,8 @@ func (s *PdfiumRPCServer) FPDF_GetPageLabel(request *requests.FPDF_GetPageLabel,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageLabel", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetPageLabel(request)
@@ -11906,6 +14305,8 @@ func (s *PdfiumRPCServer) FPDF_GetPageSizeByIndex(request *requests.FPDF_GetPage
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageSizeByIndex", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetPageSizeByIndex(request)
@@ -11924,6 +14325,8 @@ func (s *PdfiumRPCServer) FPDF_GetPageSizeByIndexF(request *requests.FPDF_GetPag
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageSizeByIndexF", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetPageSizeByIndexF(request)
@@ -11942,6 +14345,8 @@ func (s *PdfiumRPCServer) FPDF_GetPageWidth(request *requests.FPDF_GetPageWidth,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageWidth", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetPageWidth(request)
@@ -11960,6 +14365,8 @@ func (s *PdfiumRPCServer) FPDF_GetPageWidthF(request *requests.FPDF_GetPageWidth
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageWidthF", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetPageWidthF(request)
@@ -11978,6 +14385,8 @@ func (s *PdfiumRPCServer) FPDF_GetSecurityHandlerRevision(request *requests.FPDF
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetSecurityHandlerRevision", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetSecurityHandlerRevision(request)
@@ -11996,6 +14405,8 @@ func (s *PdfiumRPCServer) FPDF_GetSignatureCount(request *requests.FPDF_GetSigna
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetSignatureCount", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetSignatureCount(request)
@@ -12014,6 +14425,8 @@ func (s *PdfiumRPCServer) FPDF_GetSignatureObject(request *requests.FPDF_GetSign
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetSignatureObject", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetSignatureObject(request)
@@ -12032,6 +14445,8 @@ func (s *PdfiumRPCServer) FPDF_GetTrailerEnds(request *requests.FPDF_GetTrailerE
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetTrailerEnds", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetTrailerEnds(request)
@@ -12050,6 +14465,8 @@ func (s *PdfiumRPCServer) FPDF_GetXFAPacketContent(request *requests.FPDF_GetXFA
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetXFAPacketContent", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetXFAPacketContent(request)
@@ -12068,6 +14485,8 @@ func (s *PdfiumRPCServer) FPDF_GetXFAPacketCount(request *requests.FPDF_GetXFAPa
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetXFAPacketCount", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetXFAPacketCount(request)
@@ -12086,6 +14505,8 @@ func (s *PdfiumRPCServer) FPDF_GetXFAPacketName(request *requests.FPDF_GetXFAPac
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetXFAPacketName", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_GetXFAPacketName(request)
@@ -12104,6 +14525,8 @@ func (s *PdfiumRPCServer) FPDF_ImportNPagesToOne(request *requests.FPDF_ImportNP
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_ImportNPagesToOne", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_ImportNPagesToOne(request)
@@ -12122,6 +14545,8 @@ func (s *PdfiumRPCServer) FPDF_ImportPages(request *requests.FPDF_ImportPages, r
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_ImportPages", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_ImportPages(request)
@@ -12140,6 +14565,8 @@ func (s *PdfiumRPCServer) FPDF_ImportPagesByIndex(request *requests.FPDF_ImportP
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_ImportPagesByIndex", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_ImportPagesByIndex(request)
@@ -12158,6 +14585,8 @@ func (s *PdfiumRPCServer) FPDF_LoadCustomDocument(request *requests.FPDF_LoadCus
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_LoadCustomDocument", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_LoadCustomDocument(request)
@@ -12176,6 +14605,8 @@ func (s *PdfiumRPCServer) FPDF_LoadDocument(request *requests.FPDF_LoadDocument,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_LoadDocument", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_LoadDocument(request)
@@ -12194,6 +14625,8 @@ func (s *PdfiumRPCServer) FPDF_LoadMemDocument(request *requests.FPDF_LoadMemDoc
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_LoadMemDocument", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_LoadMemDocument(request)
@@ -12212,6 +14645,8 @@ func (s *PdfiumRPCServer) FPDF_LoadMemDocument64(request *requests.FPDF_LoadMemD
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_LoadMemDocument64", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_LoadMemDocument64(request)
@@ -12230,6 +14665,8 @@ func (s *PdfiumRPCServer) FPDF_LoadPage(request *requests.FPDF_LoadPage, resp *r
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_LoadPage", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_LoadPage(request)
@@ -12248,6 +14685,8 @@ func (s *PdfiumRPCServer) FPDF_LoadXFA(request *requests.FPDF_LoadXFA, resp *res
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_LoadXFA", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_LoadXFA(request)
@@ -12266,6 +14705,8 @@ func (s *PdfiumRPCServer) FPDF_MovePages(request *requests.FPDF_MovePages, resp
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_MovePages", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_MovePages(request)
@@ -12284,6 +14725,8 @@ func (s *PdfiumRPCServer) FPDF_NewFormObjectFromXObject(request *requests.FPDF_N
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_NewFormObjectFromXObject", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_NewFormObjectFromXObject(request)
@@ -12302,6 +14745,8 @@ func (s *PdfiumRPCServer) FPDF_NewXObjectFromPage(request *requests.FPDF_NewXObj
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_NewXObjectFromPage", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_NewXObjectFromPage(request)
@@ -12320,6 +14765,8 @@ func (s *PdfiumRPCServer) FPDF_PageToDevice(request *requests.FPDF_PageToDevice,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_PageToDevice", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_PageToDevice(request)
@@ -12338,6 +14785,8 @@ func (s *PdfiumRPCServer) FPDF_RemoveFormFieldHighlight(request *requests.FPDF_R
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RemoveFormFieldHighlight", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_RemoveFormFieldHighlight(request)
@@ -12356,6 +14805,8 @@ func (s *PdfiumRPCServer) FPDF_RenderPage(request *requests.FPDF_RenderPage, res
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPage", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_RenderPage(request)
@@ -12374,27 +14825,11 @@ func (s *PdfiumRPCServer) FPDF_RenderPageBitmap(request *requests.FPDF_RenderPag
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPageBitmap", panicError)
 		}
-	}()
-
-	implResp, err := s.Impl.FPDF_RenderPageBitmap(request)
-	if err != nil {
-		return err
-	}
-
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
-
-	return nil
-}
 
-func (s *PdfiumRPCServer) FPDF_RenderPageBitmapWithColorScheme_Start(request *requests.FPDF_RenderPageBitmapWithColorScheme_Start, resp *responses.FPDF_RenderPageBitmapWithColorScheme_Start) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPageBitmapWithColorScheme_Start", panicError)
-		}
+		err = encodeError(err)
 	}()
 
-	implResp, err := s.Impl.FPDF_RenderPageBitmapWithColorScheme_Start(request)
+	implResp, err := s.Impl.FPDF_RenderPageBitmap(request)
 	if err != nil {
 		return err
 	}
@@ -12410,27 +14845,11 @@ func (s *PdfiumRPCServer) FPDF_RenderPageBitmapWithMatrix(request *requests.FPDF
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPageBitmapWithMatrix", panicError)
 		}
-	}()
-
-	implResp, err := s.Impl.FPDF_RenderPageBitmapWithMatrix(request)
-	if err != nil {
-		return err
-	}
-
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
-
-	return nil
-}
 
-func (s *PdfiumRPCServer) FPDF_RenderPageBitmap_Start(request *requests.FPDF_RenderPageBitmap_Start, resp *responses.FPDF_RenderPageBitmap_Start) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPageBitmap_Start", panicError)
-		}
+		err = encodeError(err)
 	}()
 
-	implResp, err := s.Impl.FPDF_RenderPageBitmap_Start(request)
+	implResp, err := s.Impl.FPDF_RenderPageBitmapWithMatrix(request)
 	if err != nil {
 		return err
 	}
@@ -12446,63 +14865,11 @@ func (s *PdfiumRPCServer) FPDF_RenderPage_Close(request *requests.FPDF_RenderPag
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPage_Close", panicError)
 		}
-	}()
-
-	implResp, err := s.Impl.FPDF_RenderPage_Close(request)
-	if err != nil {
-		return err
-	}
-
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
-
-	return nil
-}
-
-func (s *PdfiumRPCServer) FPDF_RenderPage_Continue(request *requests.FPDF_RenderPage_Continue, resp *responses.FPDF_RenderPage_Continue) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPage_Continue", panicError)
-		}
-	}()
-
-	implResp, err := s.Impl.FPDF_RenderPage_Continue(request)
-	if err != nil {
-		return err
-	}
-
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
-
-	return nil
-}
-
-func (s *PdfiumRPCServer) FPDF_SaveAsCopy(request *requests.FPDF_SaveAsCopy, resp *responses.FPDF_SaveAsCopy) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SaveAsCopy", panicError)
-		}
-	}()
-
-	implResp, err := s.Impl.FPDF_SaveAsCopy(request)
-	if err != nil {
-		return err
-	}
-
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
 
-	return nil
-}
-
-func (s *PdfiumRPCServer) FPDF_SaveWithVersion(request *requests.FPDF_SaveWithVersion, resp *responses.FPDF_SaveWithVersion) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SaveWithVersion", panicError)
-		}
+		err = encodeError(err)
 	}()
 
-	implResp, err := s.Impl.FPDF_SaveWithVersion(request)
+	implResp, err := s.Impl.FPDF_RenderPage_Close(request)
 	if err != nil {
 		return err
 	}
@@ -12518,6 +14885,8 @@ func (s *PdfiumRPCServer) FPDF_SetFormFieldHighlightAlpha(request *requests.FPDF
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SetFormFieldHighlightAlpha", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_SetFormFieldHighlightAlpha(request)
@@ -12536,6 +14905,8 @@ func (s *PdfiumRPCServer) FPDF_SetFormFieldHighlightColor(request *requests.FPDF
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SetFormFieldHighlightColor", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_SetFormFieldHighlightColor(request)
@@ -12554,6 +14925,8 @@ func (s *PdfiumRPCServer) FPDF_SetPrintMode(request *requests.FPDF_SetPrintMode,
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SetPrintMode", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_SetPrintMode(request)
@@ -12572,6 +14945,8 @@ func (s *PdfiumRPCServer) FPDF_SetSandBoxPolicy(request *requests.FPDF_SetSandBo
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SetSandBoxPolicy", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_SetSandBoxPolicy(request)
@@ -12590,6 +14965,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_Attr_CountChildren(request *request
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_CountChildren", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_Attr_CountChildren(request)
@@ -12608,6 +14985,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_Attr_GetBlobValue(request *requests
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetBlobValue", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_Attr_GetBlobValue(request)
@@ -12626,6 +15005,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_Attr_GetBooleanValue(request *reque
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetBooleanValue", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_Attr_GetBooleanValue(request)
@@ -12644,6 +15025,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_Attr_GetChildAtIndex(request *reque
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetChildAtIndex", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_Attr_GetChildAtIndex(request)
@@ -12662,6 +15045,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_Attr_GetCount(request *requests.FPD
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetCount", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_Attr_GetCount(request)
@@ -12680,6 +15065,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_Attr_GetName(request *requests.FPDF
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetName", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_Attr_GetName(request)
@@ -12698,6 +15085,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_Attr_GetNumberValue(request *reques
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetNumberValue", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_Attr_GetNumberValue(request)
@@ -12716,6 +15105,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_Attr_GetStringValue(request *reques
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetStringValue", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_Attr_GetStringValue(request)
@@ -12734,6 +15125,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_Attr_GetType(request *requests.FPDF
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetType", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_Attr_GetType(request)
@@ -12752,6 +15145,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_Attr_GetValue(request *requests.FPD
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetValue", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_Attr_GetValue(request)
@@ -12770,6 +15165,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_CountChildren(request *requests.FPD
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_CountChildren", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_CountChildren(request)
@@ -12788,6 +15185,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_GetActualText(request *requests.FPD
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetActualText", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_GetActualText(request)
@@ -12806,6 +15205,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_GetAltText(request *requests.FPDF_S
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetAltText", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_GetAltText(request)
@@ -12824,6 +15225,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_GetAttributeAtIndex(request *reques
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetAttributeAtIndex", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_GetAttributeAtIndex(request)
@@ -12842,6 +15245,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_GetAttributeCount(request *requests
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetAttributeCount", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_GetAttributeCount(request)
@@ -12860,6 +15265,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_GetChildAtIndex(request *requests.F
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetChildAtIndex", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_GetChildAtIndex(request)
@@ -12878,6 +15285,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_GetChildMarkedContentID(request *re
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetChildMarkedContentID", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_GetChildMarkedContentID(request)
@@ -12896,6 +15305,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_GetExpansion(request *requests.FPDF
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetExpansion", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_GetExpansion(request)
@@ -12914,6 +15325,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_GetID(request *requests.FPDF_Struct
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetID", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_GetID(request)
@@ -12932,6 +15345,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_GetLang(request *requests.FPDF_Stru
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetLang", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_GetLang(request)
@@ -12950,6 +15365,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_GetMarkedContentID(request *request
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetMarkedContentID", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_GetMarkedContentID(request)
@@ -12968,6 +15385,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_GetMarkedContentIdAtIndex(request *
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetMarkedContentIdAtIndex", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_GetMarkedContentIdAtIndex(request)
@@ -12986,6 +15405,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_GetMarkedContentIdCount(request *re
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetMarkedContentIdCount", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_GetMarkedContentIdCount(request)
@@ -13004,6 +15425,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_GetObjType(request *requests.FPDF_S
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetObjType", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_GetObjType(request)
@@ -13022,6 +15445,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_GetParent(request *requests.FPDF_St
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetParent", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_GetParent(request)
@@ -13040,6 +15465,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_GetStringAttribute(request *request
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetStringAttribute", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_GetStringAttribute(request)
@@ -13058,6 +15485,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_GetTitle(request *requests.FPDF_Str
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetTitle", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_GetTitle(request)
@@ -13076,6 +15505,8 @@ func (s *PdfiumRPCServer) FPDF_StructElement_GetType(request *requests.FPDF_Stru
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetType", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructElement_GetType(request)
@@ -13094,6 +15525,8 @@ func (s *PdfiumRPCServer) FPDF_StructTree_Close(request *requests.FPDF_StructTre
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructTree_Close", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructTree_Close(request)
@@ -13112,6 +15545,8 @@ func (s *PdfiumRPCServer) FPDF_StructTree_CountChildren(request *requests.FPDF_S
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructTree_CountChildren", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructTree_CountChildren(request)
@@ -13130,6 +15565,8 @@ func (s *PdfiumRPCServer) FPDF_StructTree_GetChildAtIndex(request *requests.FPDF
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructTree_GetChildAtIndex", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructTree_GetChildAtIndex(request)
@@ -13148,6 +15585,8 @@ func (s *PdfiumRPCServer) FPDF_StructTree_GetForPage(request *requests.FPDF_Stru
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructTree_GetForPage", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_StructTree_GetForPage(request)
@@ -13166,6 +15605,8 @@ func (s *PdfiumRPCServer) FPDF_VIEWERREF_GetDuplex(request *requests.FPDF_VIEWER
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetDuplex", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_VIEWERREF_GetDuplex(request)
@@ -13184,6 +15625,8 @@ func (s *PdfiumRPCServer) FPDF_VIEWERREF_GetName(request *requests.FPDF_VIEWERRE
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetName", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_VIEWERREF_GetName(request)
@@ -13202,6 +15645,8 @@ func (s *PdfiumRPCServer) FPDF_VIEWERREF_GetNumCopies(request *requests.FPDF_VIE
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetNumCopies", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_VIEWERREF_GetNumCopies(request)
@@ -13220,6 +15665,8 @@ func (s *PdfiumRPCServer) FPDF_VIEWERREF_GetPrintPageRange(request *requests.FPD
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetPrintPageRange", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_VIEWERREF_GetPrintPageRange(request)
@@ -13238,6 +15685,8 @@ func (s *PdfiumRPCServer) FPDF_VIEWERREF_GetPrintPageRangeCount(request *request
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetPrintPageRangeCount", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_VIEWERREF_GetPrintPageRangeCount(request)
@@ -13256,6 +15705,8 @@ func (s *PdfiumRPCServer) FPDF_VIEWERREF_GetPrintPageRangeElement(request *reque
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetPrintPageRangeElement", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_VIEWERREF_GetPrintPageRangeElement(request)
@@ -13274,6 +15725,8 @@ func (s *PdfiumRPCServer) FPDF_VIEWERREF_GetPrintScaling(request *requests.FPDF_
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetPrintScaling", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FPDF_VIEWERREF_GetPrintScaling(request)
@@ -13292,6 +15745,8 @@ func (s *PdfiumRPCServer) FSDK_SetLocaltimeFunction(request *requests.FSDK_SetLo
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FSDK_SetLocaltimeFunction", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FSDK_SetLocaltimeFunction(request)
@@ -13310,6 +15765,8 @@ func (s *PdfiumRPCServer) FSDK_SetTimeFunction(request *requests.FSDK_SetTimeFun
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FSDK_SetTimeFunction", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FSDK_SetTimeFunction(request)
@@ -13328,6 +15785,8 @@ func (s *PdfiumRPCServer) FSDK_SetUnSpObjProcessHandler(request *requests.FSDK_S
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "FSDK_SetUnSpObjProcessHandler", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.FSDK_SetUnSpObjProcessHandler(request)
@@ -13346,6 +15805,8 @@ func (s *PdfiumRPCServer) GetActionInfo(request *requests.GetActionInfo, resp *r
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "GetActionInfo", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.GetActionInfo(request)
@@ -13364,6 +15825,8 @@ func (s *PdfiumRPCServer) GetAttachments(request *requests.GetAttachments, resp
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "GetAttachments", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.GetAttachments(request)
@@ -13382,6 +15845,8 @@ func (s *PdfiumRPCServer) GetBookmarks(request *requests.GetBookmarks, resp *res
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "GetBookmarks", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.GetBookmarks(request)
@@ -13400,6 +15865,8 @@ func (s *PdfiumRPCServer) GetDestInfo(request *requests.GetDestInfo, resp *respo
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "GetDestInfo", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.GetDestInfo(request)
@@ -13418,6 +15885,8 @@ func (s *PdfiumRPCServer) GetForm(request *requests.GetForm, resp *responses.Get
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "GetForm", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.GetForm(request)
@@ -13436,6 +15905,8 @@ func (s *PdfiumRPCServer) GetJavaScriptActions(request *requests.GetJavaScriptAc
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "GetJavaScriptActions", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.GetJavaScriptActions(request)
@@ -13454,6 +15925,8 @@ func (s *PdfiumRPCServer) GetMetaData(request *requests.GetMetaData, resp *respo
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "GetMetaData", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.GetMetaData(request)
@@ -13472,6 +15945,8 @@ func (s *PdfiumRPCServer) GetPageSize(request *requests.GetPageSize, resp *respo
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "GetPageSize", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.GetPageSize(request)
@@ -13490,6 +15965,8 @@ func (s *PdfiumRPCServer) GetPageSizeInPixels(request *requests.GetPageSizeInPix
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "GetPageSizeInPixels", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.GetPageSizeInPixels(request)
@@ -13508,6 +15985,8 @@ func (s *PdfiumRPCServer) GetPageText(request *requests.GetPageText, resp *respo
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "GetPageText", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.GetPageText(request)
@@ -13526,63 +16005,11 @@ func (s *PdfiumRPCServer) GetPageTextStructured(request *requests.GetPageTextStr
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "GetPageTextStructured", panicError)
 		}
-	}()
-
-	implResp, err := s.Impl.GetPageTextStructured(request)
-	if err != nil {
-		return err
-	}
-
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
-
-	return nil
-}
-
-func (s *PdfiumRPCServer) OpenDocument(request *requests.OpenDocument, resp *responses.OpenDocument) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "OpenDocument", panicError)
-		}
-	}()
-
-	implResp, err := s.Impl.OpenDocument(request)
-	if err != nil {
-		return err
-	}
-
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
-
-	return nil
-}
-
-func (s *PdfiumRPCServer) RenderPageInDPI(request *requests.RenderPageInDPI, resp *responses.RenderPageInDPI) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "RenderPageInDPI", panicError)
-		}
-	}()
-
-	implResp, err := s.Impl.RenderPageInDPI(request)
-	if err != nil {
-		return err
-	}
-
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
-
-	return nil
-}
 
-func (s *PdfiumRPCServer) RenderPageInPixels(request *requests.RenderPageInPixels, resp *responses.RenderPageInPixels) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "RenderPageInPixels", panicError)
-		}
+		err = encodeError(err)
 	}()
 
-	implResp, err := s.Impl.RenderPageInPixels(request)
+	implResp, err := s.Impl.GetPageTextStructured(request)
 	if err != nil {
 		return err
 	}
@@ -13593,32 +16020,16 @@ func (s *PdfiumRPCServer) RenderPageInPixels(request *requests.RenderPageInPixel
 	return nil
 }
 
-func (s *PdfiumRPCServer) RenderPagesInDPI(request *requests.RenderPagesInDPI, resp *responses.RenderPagesInDPI) (err error) {
+func (s *PdfiumRPCServer) ListOpenHandles(request *requests.ListOpenHandles, resp *responses.ListOpenHandles) (err error) {
 	defer func() {
 		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "RenderPagesInDPI", panicError)
+			err = fmt.Errorf("panic occurred in %s: %v", "ListOpenHandles", panicError)
 		}
-	}()
-
-	implResp, err := s.Impl.RenderPagesInDPI(request)
-	if err != nil {
-		return err
-	}
-
-	// Overwrite the target address of resp to the target address of implResp.
-	*resp = *implResp
-
-	return nil
-}
 
-func (s *PdfiumRPCServer) RenderPagesInPixels(request *requests.RenderPagesInPixels, resp *responses.RenderPagesInPixels) (err error) {
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "RenderPagesInPixels", panicError)
-		}
+		err = encodeError(err)
 	}()
 
-	implResp, err := s.Impl.RenderPagesInPixels(request)
+	implResp, err := s.Impl.ListOpenHandles(request)
 	if err != nil {
 		return err
 	}
@@ -13634,6 +16045,8 @@ func (s *PdfiumRPCServer) RenderToFile(request *requests.RenderToFile, resp *res
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "RenderToFile", panicError)
 		}
+
+		err = encodeError(err)
 	}()
 
 	implResp, err := s.Impl.RenderToFile(request)
@@ -0,0 +1,3586 @@
+// Code generated by tool. DO NOT EDIT.
+// See the code_generation package.
+
+package commons
+
+import (
+	"image"
+	"reflect"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// protobufFields contains the field numbers of the structs that are sent over
+// gRPC, by Go type. See pdfium.proto.
+var protobufFields = map[string]map[string]protowire.Number{
+	"commons.DataAvailSegment": {
+		"Offset": 1,
+		"Size":   2,
+	},
+	"commons.FPDFAvail_CreateRequest": {
+		"Size":                       1,
+		"HasReader":                  2,
+		"HasIsDataAvailableCallback": 3,
+		"HasAddSegmentCallback":      4,
+		"CallbackServer":             5,
+	},
+	"commons.FPDFDOC_InitFormFillEnvironmentRequest": {
+		"Document":       1,
+		"Callbacks":      2,
+		"CallbackServer": 3,
+	},
+	"commons.FPDF_RenderPageBitmapWithColorScheme_StartRequest": {
+		"Request":        1,
+		"CallbackServer": 2,
+	},
+	"commons.FPDF_RenderPageBitmap_StartRequest": {
+		"Request":        1,
+		"CallbackServer": 2,
+	},
+	"commons.FPDF_RenderPage_ContinueRequest": {
+		"Request":        1,
+		"CallbackServer": 2,
+	},
+	"commons.FPDF_SaveAsCopyRequest": {
+		"Request":      1,
+		"WriterServer": 2,
+	},
+	"commons.FPDF_SaveWithVersionRequest": {
+		"Request":      1,
+		"WriterServer": 2,
+	},
+	"commons.FormFillInfoCallbacks": {
+		"Release":                1,
+		"FFI_Invalidate":         2,
+		"FFI_OutputSelectedRect": 3,
+		"FFI_SetCursor":          4,
+		"FFI_SetTimer":           5,
+		"FFI_KillTimer":          6,
+		"FFI_GetLocalTime":       7,
+		"FFI_OnChange":           8,
+		"FFI_GetPage":            9,
+		"FFI_GetCurrentPage":     10,
+		"FFI_GetRotation":        11,
+		"FFI_ExecuteNamedAction": 12,
+		"FFI_SetTextFieldFocus":  13,
+		"FFI_DoURIAction":        14,
+		"FFI_DoGoToAction":       15,
+	},
+	"commons.FormFillInfoDoGoToAction": {
+		"PageIndex": 1,
+		"ZoomMode":  2,
+		"Pos":       3,
+	},
+	"commons.FormFillInfoGetPage": {
+		"Document": 1,
+		"Index":    2,
+	},
+	"commons.FormFillInfoPage": {
+		"Page": 1,
+	},
+	"commons.FormFillInfoRect": {
+		"Page":   1,
+		"Left":   2,
+		"Top":    3,
+		"Right":  4,
+		"Bottom": 5,
+	},
+	"commons.FormFillInfoSetTextFieldFocus": {
+		"Value":   1,
+		"IsFocus": 2,
+	},
+	"commons.FormFillInfoSetTimer": {
+		"Elapse":   1,
+		"TimerRef": 2,
+	},
+	"commons.FormFillInfoTimer": {
+		"TimerRef": 1,
+		"IDEvent":  2,
+	},
+	"commons.OpenDocumentRequest": {
+		"Request":      1,
+		"ReaderServer": 2,
+		"SharedFile":   3,
+		"Document":     4,
+	},
+	"commons.ReadBlockRequest": {
+		"Position": 1,
+		"Size":     2,
+	},
+	"commons.RenderPagesStreamRequest": {
+		"DPI":    1,
+		"Pixels": 2,
+	},
+	"commons.SharedImage": {
+		"File":   1,
+		"Rect":   2,
+		"Stride": 3,
+		"Gray":   4,
+	},
+	"commons.SharedMemoryConfig": {
+		"Dir":       1,
+		"Threshold": 2,
+	},
+	"commons.SharedMemoryFile": {
+		"Path": 1,
+		"Size": 2,
+	},
+	"image.Gray": {
+		"Pix":    1,
+		"Stride": 2,
+		"Rect":   3,
+	},
+	"image.Point": {
+		"X": 1,
+		"Y": 2,
+	},
+	"image.RGBA": {
+		"Pix":    1,
+		"Stride": 2,
+		"Rect":   3,
+	},
+	"image.Rectangle": {
+		"Min": 1,
+		"Max": 2,
+	},
+	"requests.FORM_CanRedo": {
+		"FormHandle": 1,
+		"Page":       2,
+	},
+	"requests.FORM_CanUndo": {
+		"FormHandle": 1,
+		"Page":       2,
+	},
+	"requests.FORM_DoDocumentAAction": {
+		"FormHandle": 1,
+		"AAType":     2,
+	},
+	"requests.FORM_DoDocumentJSAction": {
+		"FormHandle": 1,
+	},
+	"requests.FORM_DoDocumentOpenAction": {
+		"FormHandle": 1,
+	},
+	"requests.FORM_DoPageAAction": {
+		"Page":       1,
+		"FormHandle": 2,
+		"AAType":     3,
+	},
+	"requests.FORM_ForceToKillFocus": {
+		"FormHandle": 1,
+	},
+	"requests.FORM_GetFocusedAnnot": {
+		"FormHandle": 1,
+	},
+	"requests.FORM_GetFocusedText": {
+		"FormHandle": 1,
+		"Page":       2,
+	},
+	"requests.FORM_GetSelectedText": {
+		"FormHandle": 1,
+		"Page":       2,
+	},
+	"requests.FORM_IsIndexSelected": {
+		"FormHandle": 1,
+		"Page":       2,
+		"Index":      3,
+	},
+	"requests.FORM_OnAfterLoadPage": {
+		"Page":       1,
+		"FormHandle": 2,
+	},
+	"requests.FORM_OnBeforeClosePage": {
+		"Page":       1,
+		"FormHandle": 2,
+	},
+	"requests.FORM_OnChar": {
+		"FormHandle": 1,
+		"Page":       2,
+		"NChar":      3,
+		"Modifier":   4,
+	},
+	"requests.FORM_OnFocus": {
+		"FormHandle": 1,
+		"Page":       2,
+		"Modifier":   3,
+		"PageX":      4,
+		"PageY":      5,
+	},
+	"requests.FORM_OnKeyDown": {
+		"FormHandle": 1,
+		"Page":       2,
+		"NKeyCode":   3,
+		"Modifier":   4,
+	},
+	"requests.FORM_OnKeyUp": {
+		"FormHandle": 1,
+		"Page":       2,
+		"NKeyCode":   3,
+		"Modifier":   4,
+	},
+	"requests.FORM_OnLButtonDoubleClick": {
+		"FormHandle": 1,
+		"Page":       2,
+		"Modifier":   3,
+		"PageX":      4,
+		"PageY":      5,
+	},
+	"requests.FORM_OnLButtonDown": {
+		"FormHandle": 1,
+		"Page":       2,
+		"Modifier":   3,
+		"PageX":      4,
+		"PageY":      5,
+	},
+	"requests.FORM_OnLButtonUp": {
+		"FormHandle": 1,
+		"Page":       2,
+		"Modifier":   3,
+		"PageX":      4,
+		"PageY":      5,
+	},
+	"requests.FORM_OnMouseMove": {
+		"FormHandle": 1,
+		"Page":       2,
+		"Modifier":   3,
+		"PageX":      4,
+		"PageY":      5,
+	},
+	"requests.FORM_OnMouseWheel": {
+		"FormHandle": 1,
+		"Page":       2,
+		"Modifier":   3,
+		"PageCoord":  4,
+		"DeltaX":     5,
+		"DeltaY":     6,
+	},
+	"requests.FORM_OnRButtonDown": {
+		"FormHandle": 1,
+		"Page":       2,
+		"Modifier":   3,
+		"PageX":      4,
+		"PageY":      5,
+	},
+	"requests.FORM_OnRButtonUp": {
+		"FormHandle": 1,
+		"Page":       2,
+		"Modifier":   3,
+		"PageX":      4,
+		"PageY":      5,
+	},
+	"requests.FORM_Redo": {
+		"FormHandle": 1,
+		"Page":       2,
+	},
+	"requests.FORM_ReplaceAndKeepSelection": {
+		"FormHandle": 1,
+		"Page":       2,
+		"Text":       3,
+	},
+	"requests.FORM_ReplaceSelection": {
+		"FormHandle": 1,
+		"Page":       2,
+		"Text":       3,
+	},
+	"requests.FORM_SelectAllText": {
+		"FormHandle": 1,
+		"Page":       2,
+	},
+	"requests.FORM_SetFocusedAnnot": {
+		"FormHandle": 1,
+		"Annotation": 2,
+	},
+	"requests.FORM_SetIndexSelected": {
+		"FormHandle": 1,
+		"Page":       2,
+		"Index":      3,
+		"Selected":   4,
+	},
+	"requests.FORM_Undo": {
+		"FormHandle": 1,
+		"Page":       2,
+	},
+	"requests.FPDFAction_GetDest": {
+		"Document": 1,
+		"Action":   2,
+	},
+	"requests.FPDFAction_GetFilePath": {
+		"Action": 1,
+	},
+	"requests.FPDFAction_GetType": {
+		"Action": 1,
+	},
+	"requests.FPDFAction_GetURIPath": {
+		"Document": 1,
+		"Action":   2,
+	},
+	"requests.FPDFAnnot_AddFileAttachment": {
+		"Document":   1,
+		"Annotation": 2,
+		"Name":       3,
+	},
+	"requests.FPDFAnnot_AddInkStroke": {
+		"Annotation": 1,
+		"Points":     2,
+	},
+	"requests.FPDFAnnot_AppendAttachmentPoints": {
+		"Annotation":       1,
+		"AttachmentPoints": 2,
+	},
+	"requests.FPDFAnnot_AppendObject": {
+		"Annotation": 1,
+		"PageObject": 2,
+	},
+	"requests.FPDFAnnot_CountAttachmentPoints": {
+		"Annotation": 1,
+		"Count":      2,
+	},
+	"requests.FPDFAnnot_GetAP": {
+		"Annotation":     1,
+		"AppearanceMode": 2,
+	},
+	"requests.FPDFAnnot_GetAttachmentPoints": {
+		"Annotation": 1,
+		"Index":      2,
+	},
+	"requests.FPDFAnnot_GetBorder": {
+		"Annotation": 1,
+	},
+	"requests.FPDFAnnot_GetColor": {
+		"Annotation": 1,
+		"ColorType":  2,
+	},
+	"requests.FPDFAnnot_GetFileAttachment": {
+		"Document":   1,
+		"Annotation": 2,
+	},
+	"requests.FPDFAnnot_GetFlags": {
+		"Annotation": 1,
+	},
+	"requests.FPDFAnnot_GetFocusableSubtypes": {
+		"FormHandle": 1,
+	},
+	"requests.FPDFAnnot_GetFocusableSubtypesCount": {
+		"FormHandle": 1,
+	},
+	"requests.FPDFAnnot_GetFontColor": {
+		"FormHandle": 1,
+		"Annotation": 2,
+	},
+	"requests.FPDFAnnot_GetFontSize": {
+		"FormHandle": 1,
+		"Annotation": 2,
+	},
+	"requests.FPDFAnnot_GetFormAdditionalActionJavaScript": {
+		"FormHandle": 1,
+		"Annotation": 2,
+		"Event":      3,
+	},
+	"requests.FPDFAnnot_GetFormControlCount": {
+		"FormHandle": 1,
+		"Annotation": 2,
+	},
+	"requests.FPDFAnnot_GetFormControlIndex": {
+		"FormHandle": 1,
+		"Annotation": 2,
+	},
+	"requests.FPDFAnnot_GetFormFieldAlternateName": {
+		"FormHandle": 1,
+		"Annotation": 2,
+	},
+	"requests.FPDFAnnot_GetFormFieldAtPoint": {
+		"FormHandle": 1,
+		"Page":       2,
+		"Point":      3,
+	},
+	"requests.FPDFAnnot_GetFormFieldExportValue": {
+		"FormHandle": 1,
+		"Annotation": 2,
+	},
+	"requests.FPDFAnnot_GetFormFieldFlags": {
+		"FormHandle": 1,
+		"Annotation": 2,
+	},
+	"requests.FPDFAnnot_GetFormFieldName": {
+		"FormHandle": 1,
+		"Annotation": 2,
+	},
+	"requests.FPDFAnnot_GetFormFieldType": {
+		"FormHandle": 1,
+		"Annotation": 2,
+	},
+	"requests.FPDFAnnot_GetFormFieldValue": {
+		"FormHandle": 1,
+		"Annotation": 2,
+	},
+	"requests.FPDFAnnot_GetInkListCount": {
+		"Annotation": 1,
+	},
+	"requests.FPDFAnnot_GetInkListPath": {
+		"Annotation": 1,
+		"Index":      2,
+	},
+	"requests.FPDFAnnot_GetLine": {
+		"Annotation": 1,
+	},
+	"requests.FPDFAnnot_GetLink": {
+		"Annotation": 1,
+	},
+	"requests.FPDFAnnot_GetLinkedAnnot": {
+		"Annotation": 1,
+		"Key":        2,
+	},
+	"requests.FPDFAnnot_GetNumberValue": {
+		"Annotation": 1,
+		"Key":        2,
+	},
+	"requests.FPDFAnnot_GetObject": {
+		"Annotation": 1,
+		"Index":      2,
+	},
+	"requests.FPDFAnnot_GetObjectCount": {
+		"Annotation": 1,
+	},
+	"requests.FPDFAnnot_GetOptionCount": {
+		"FormHandle": 1,
+		"Annotation": 2,
+	},
+	"requests.FPDFAnnot_GetOptionLabel": {
+		"FormHandle": 1,
+		"Annotation": 2,
+		"Index":      3,
+	},
+	"requests.FPDFAnnot_GetRect": {
+		"Annotation": 1,
+	},
+	"requests.FPDFAnnot_GetStringValue": {
+		"Annotation": 1,
+		"Key":        2,
+	},
+	"requests.FPDFAnnot_GetSubtype": {
+		"Annotation": 1,
+	},
+	"requests.FPDFAnnot_GetValueType": {
+		"Annotation": 1,
+		"Key":        2,
+	},
+	"requests.FPDFAnnot_GetVertices": {
+		"Annotation": 1,
+	},
+	"requests.FPDFAnnot_HasAttachmentPoints": {
+		"Annotation": 1,
+	},
+	"requests.FPDFAnnot_HasKey": {
+		"Annotation": 1,
+		"Key":        2,
+	},
+	"requests.FPDFAnnot_IsChecked": {
+		"FormHandle": 1,
+		"Annotation": 2,
+	},
+	"requests.FPDFAnnot_IsObjectSupportedSubtype": {
+		"Subtype": 1,
+	},
+	"requests.FPDFAnnot_IsOptionSelected": {
+		"FormHandle": 1,
+		"Annotation": 2,
+		"Index":      3,
+	},
+	"requests.FPDFAnnot_IsSupportedSubtype": {
+		"Subtype": 1,
+	},
+	"requests.FPDFAnnot_RemoveInkList": {
+		"Annotation": 1,
+	},
+	"requests.FPDFAnnot_RemoveObject": {
+		"Annotation": 1,
+		"Index":      2,
+	},
+	"requests.FPDFAnnot_SetAP": {
+		"Annotation":     1,
+		"AppearanceMode": 2,
+		"Value":          3,
+	},
+	"requests.FPDFAnnot_SetAttachmentPoints": {
+		"Annotation":       1,
+		"Index":            2,
+		"AttachmentPoints": 3,
+	},
+	"requests.FPDFAnnot_SetBorder": {
+		"Annotation":       1,
+		"HorizontalRadius": 2,
+		"VerticalRadius":   3,
+		"BorderWidth":      4,
+	},
+	"requests.FPDFAnnot_SetColor": {
+		"Annotation": 1,
+		"ColorType":  2,
+		"R":          3,
+		"G":          4,
+		"B":          5,
+		"A":          6,
+	},
+	"requests.FPDFAnnot_SetFlags": {
+		"Annotation": 1,
+		"Flags":      2,
+	},
+	"requests.FPDFAnnot_SetFocusableSubtypes": {
+		"FormHandle": 1,
+		"Subtypes":   2,
+	},
+	"requests.FPDFAnnot_SetFontColor": {
+		"FormHandle": 1,
+		"Annotation": 2,
+		"R":          3,
+		"G":          4,
+		"B":          5,
+	},
+	"requests.FPDFAnnot_SetFormFieldFlags": {
+		"FormHandle": 1,
+		"Annotation": 2,
+		"Flags":      3,
+	},
+	"requests.FPDFAnnot_SetRect": {
+		"Annotation": 1,
+		"Rect":       2,
+	},
+	"requests.FPDFAnnot_SetStringValue": {
+		"Annotation": 1,
+		"Key":        2,
+		"Value":      3,
+	},
+	"requests.FPDFAnnot_SetURI": {
+		"Annotation": 1,
+		"URI":        2,
+	},
+	"requests.FPDFAnnot_UpdateObject": {
+		"Annotation": 1,
+		"PageObject": 2,
+	},
+	"requests.FPDFAttachment_GetDescription": {
+		"Attachment": 1,
+	},
+	"requests.FPDFAttachment_GetFile": {
+		"Attachment": 1,
+	},
+	"requests.FPDFAttachment_GetName": {
+		"Attachment": 1,
+	},
+	"requests.FPDFAttachment_GetStringValue": {
+		"Attachment": 1,
+		"Key":        2,
+	},
+	"requests.FPDFAttachment_GetSubtype": {
+		"Attachment": 1,
+	},
+	"requests.FPDFAttachment_GetValueType": {
+		"Attachment": 1,
+		"Key":        2,
+	},
+	"requests.FPDFAttachment_HasKey": {
+		"Attachment": 1,
+		"Key":        2,
+	},
+	"requests.FPDFAttachment_SetDescription": {
+		"Attachment": 1,
+		"Value":      2,
+	},
+	"requests.FPDFAttachment_SetFile": {
+		"Attachment": 1,
+		"Contents":   2,
+	},
+	"requests.FPDFAttachment_SetStringValue": {
+		"Attachment": 1,
+		"Key":        2,
+		"Value":      3,
+	},
+	"requests.FPDFAvail_Destroy": {
+		"AvailabilityProvider": 1,
+	},
+	"requests.FPDFAvail_GetDocument": {
+		"AvailabilityProvider": 1,
+		"Password":             2,
+	},
+	"requests.FPDFAvail_GetFirstPageNum": {
+		"Document": 1,
+	},
+	"requests.FPDFAvail_IsDocAvail": {
+		"AvailabilityProvider": 1,
+	},
+	"requests.FPDFAvail_IsFormAvail": {
+		"AvailabilityProvider": 1,
+	},
+	"requests.FPDFAvail_IsLinearized": {
+		"AvailabilityProvider": 1,
+	},
+	"requests.FPDFAvail_IsPageAvail": {
+		"AvailabilityProvider": 1,
+		"PageIndex":            2,
+	},
+	"requests.FPDFBitmap_Create": {
+		"Width":  1,
+		"Height": 2,
+		"Alpha":  3,
+	},
+	"requests.FPDFBitmap_CreateEx": {
+		"Width":  1,
+		"Height": 2,
+		"Format": 3,
+		"Buffer": 4,
+		"Stride": 5,
+	},
+	"requests.FPDFBitmap_Destroy": {
+		"Bitmap": 1,
+	},
+	"requests.FPDFBitmap_FillRect": {
+		"Bitmap": 1,
+		"Left":   2,
+		"Top":    3,
+		"Width":  4,
+		"Height": 5,
+		"Color":  6,
+	},
+	"requests.FPDFBitmap_GetBuffer": {
+		"Bitmap": 1,
+	},
+	"requests.FPDFBitmap_GetFormat": {
+		"Bitmap": 1,
+	},
+	"requests.FPDFBitmap_GetHeight": {
+		"Bitmap": 1,
+	},
+	"requests.FPDFBitmap_GetStride": {
+		"Bitmap": 1,
+	},
+	"requests.FPDFBitmap_GetWidth": {
+		"Bitmap": 1,
+	},
+	"requests.FPDFBookmark_Find": {
+		"Document": 1,
+		"Title":    2,
+	},
+	"requests.FPDFBookmark_GetAction": {
+		"Bookmark": 1,
+	},
+	"requests.FPDFBookmark_GetColor": {
+		"Bookmark": 1,
+	},
+	"requests.FPDFBookmark_GetCount": {
+		"Bookmark": 1,
+	},
+	"requests.FPDFBookmark_GetDest": {
+		"Document": 1,
+		"Bookmark": 2,
+	},
+	"requests.FPDFBookmark_GetFirstChild": {
+		"Document": 1,
+		"Bookmark": 2,
+	},
+	"requests.FPDFBookmark_GetNextSibling": {
+		"Document": 1,
+		"Bookmark": 2,
+	},
+	"requests.FPDFBookmark_GetTitle": {
+		"Bookmark": 1,
+	},
+	"requests.FPDFCatalog_GetLanguage": {
+		"Document": 1,
+	},
+	"requests.FPDFCatalog_IsTagged": {
+		"Document": 1,
+	},
+	"requests.FPDFCatalog_SetLanguage": {
+		"Document": 1,
+		"Language": 2,
+	},
+	"requests.FPDFClipPath_CountPathSegments": {
+		"ClipPath":  1,
+		"PathIndex": 2,
+	},
+	"requests.FPDFClipPath_CountPaths": {
+		"ClipPath": 1,
+	},
+	"requests.FPDFClipPath_GetPathSegment": {
+		"ClipPath":     1,
+		"PathIndex":    2,
+		"SegmentIndex": 3,
+	},
+	"requests.FPDFDOC_ExitFormFillEnvironment": {
+		"FormHandle": 1,
+	},
+	"requests.FPDFDest_GetDestPageIndex": {
+		"Document": 1,
+		"Dest":     2,
+	},
+	"requests.FPDFDest_GetLocationInPage": {
+		"Dest": 1,
+	},
+	"requests.FPDFDest_GetView": {
+		"Dest": 1,
+	},
+	"requests.FPDFDoc_AddAttachment": {
+		"Document": 1,
+		"Name":     2,
+	},
+	"requests.FPDFDoc_CloseJavaScriptAction": {
+		"JavaScriptAction": 1,
+	},
+	"requests.FPDFDoc_DeleteAttachment": {
+		"Document": 1,
+		"Index":    2,
+	},
+	"requests.FPDFDoc_GetAttachment": {
+		"Document": 1,
+		"Index":    2,
+	},
+	"requests.FPDFDoc_GetAttachmentCount": {
+		"Document": 1,
+	},
+	"requests.FPDFDoc_GetJavaScriptAction": {
+		"Document": 1,
+		"Index":    2,
+	},
+	"requests.FPDFDoc_GetJavaScriptActionCount": {
+		"Document": 1,
+	},
+	"requests.FPDFDoc_GetPageMode": {
+		"Document": 1,
+	},
+	"requests.FPDFFont_Close": {
+		"Font": 1,
+	},
+	"requests.FPDFFont_GetAscent": {
+		"Font":     1,
+		"FontSize": 2,
+	},
+	"requests.FPDFFont_GetBaseFontName": {
+		"Font": 1,
+	},
+	"requests.FPDFFont_GetDescent": {
+		"Font":     1,
+		"FontSize": 2,
+	},
+	"requests.FPDFFont_GetFamilyName": {
+		"Font": 1,
+	},
+	"requests.FPDFFont_GetFlags": {
+		"Font": 1,
+	},
+	"requests.FPDFFont_GetFontData": {
+		"Font": 1,
+	},
+	"requests.FPDFFont_GetGlyphPath": {
+		"Font":     1,
+		"Glyph":    2,
+		"FontSize": 3,
+	},
+	"requests.FPDFFont_GetGlyphWidth": {
+		"Font":     1,
+		"Glyph":    2,
+		"FontSize": 3,
+	},
+	"requests.FPDFFont_GetIsEmbedded": {
+		"Font": 1,
+	},
+	"requests.FPDFFont_GetItalicAngle": {
+		"Font": 1,
+	},
+	"requests.FPDFFont_GetWeight": {
+		"Font": 1,
+	},
+	"requests.FPDFFormObj_CountObjects": {
+		"PageObject": 1,
+	},
+	"requests.FPDFFormObj_GetObject": {
+		"PageObject": 1,
+		"Index":      2,
+	},
+	"requests.FPDFFormObj_RemoveObject": {
+		"PageObject": 1,
+		"FormObject": 2,
+	},
+	"requests.FPDFGlyphPath_CountGlyphSegments": {
+		"GlyphPath": 1,
+	},
+	"requests.FPDFGlyphPath_GetGlyphPathSegment": {
+		"GlyphPath": 1,
+		"Index":     2,
+	},
+	"requests.FPDFImageObj_GetBitmap": {
+		"ImageObject": 1,
+	},
+	"requests.FPDFImageObj_GetIccProfileDataDecoded": {
+		"ImageObject": 1,
+		"Page":        2,
+	},
+	"requests.FPDFImageObj_GetImageDataDecoded": {
+		"ImageObject": 1,
+	},
+	"requests.FPDFImageObj_GetImageDataRaw": {
+		"ImageObject": 1,
+	},
+	"requests.FPDFImageObj_GetImageFilter": {
+		"ImageObject": 1,
+		"Index":       2,
+	},
+	"requests.FPDFImageObj_GetImageFilterCount": {
+		"ImageObject": 1,
+	},
+	"requests.FPDFImageObj_GetImageMetadata": {
+		"ImageObject": 1,
+		"Page":        2,
+	},
+	"requests.FPDFImageObj_GetImagePixelSize": {
+		"ImageObject": 1,
+	},
+	"requests.FPDFImageObj_GetRenderedBitmap": {
+		"Document":    1,
+		"Page":        2,
+		"ImageObject": 3,
+	},
+	"requests.FPDFImageObj_LoadJpegFile": {
+		"Page":           1,
+		"Count":          2,
+		"ImageObject":    3,
+		"FileData":       4,
+		"FileReaderSize": 5,
+		"FilePath":       6,
+	},
+	"requests.FPDFImageObj_LoadJpegFileInline": {
+		"Page":           1,
+		"Count":          2,
+		"ImageObject":    3,
+		"FileData":       4,
+		"FileReaderSize": 5,
+		"FilePath":       6,
+	},
+	"requests.FPDFImageObj_SetBitmap": {
+		"Page":        1,
+		"Count":       2,
+		"ImageObject": 3,
+		"Bitmap":      4,
+	},
+	"requests.FPDFImageObj_SetMatrix": {
+		"ImageObject": 1,
+		"Transform":   2,
+	},
+	"requests.FPDFJavaScriptAction_GetName": {
+		"JavaScriptAction": 1,
+	},
+	"requests.FPDFJavaScriptAction_GetScript": {
+		"JavaScriptAction": 1,
+	},
+	"requests.FPDFLink_CloseWebLinks": {
+		"PageLink": 1,
+	},
+	"requests.FPDFLink_CountQuadPoints": {
+		"Link": 1,
+	},
+	"requests.FPDFLink_CountRects": {
+		"PageLink": 1,
+		"Index":    2,
+	},
+	"requests.FPDFLink_CountWebLinks": {
+		"PageLink": 1,
+	},
+	"requests.FPDFLink_Enumerate": {
+		"Page":     1,
+		"StartPos": 2,
+	},
+	"requests.FPDFLink_GetAction": {
+		"Link": 1,
+	},
+	"requests.FPDFLink_GetAnnot": {
+		"Page": 1,
+		"Link": 2,
+	},
+	"requests.FPDFLink_GetAnnotRect": {
+		"Link": 1,
+	},
+	"requests.FPDFLink_GetDest": {
+		"Document": 1,
+		"Link":     2,
+	},
+	"requests.FPDFLink_GetLinkAtPoint": {
+		"Page": 1,
+		"X":    2,
+		"Y":    3,
+	},
+	"requests.FPDFLink_GetLinkZOrderAtPoint": {
+		"Page": 1,
+		"X":    2,
+		"Y":    3,
+	},
+	"requests.FPDFLink_GetQuadPoints": {
+		"Link":      1,
+		"QuadIndex": 2,
+	},
+	"requests.FPDFLink_GetRect": {
+		"PageLink":  1,
+		"Index":     2,
+		"RectIndex": 3,
+	},
+	"requests.FPDFLink_GetTextRange": {
+		"PageLink": 1,
+		"Index":    2,
+	},
+	"requests.FPDFLink_GetURL": {
+		"PageLink": 1,
+		"Index":    2,
+	},
+	"requests.FPDFLink_LoadWebLinks": {
+		"TextPage": 1,
+	},
+	"requests.FPDFPageObjMark_CountParams": {
+		"PageObjectMark": 1,
+	},
+	"requests.FPDFPageObjMark_GetName": {
+		"PageObjectMark": 1,
+	},
+	"requests.FPDFPageObjMark_GetParamBlobValue": {
+		"PageObjectMark": 1,
+		"Key":            2,
+	},
+	"requests.FPDFPageObjMark_GetParamFloatValue": {
+		"PageObjectMark": 1,
+		"Key":            2,
+	},
+	"requests.FPDFPageObjMark_GetParamIntValue": {
+		"PageObjectMark": 1,
+		"Key":            2,
+	},
+	"requests.FPDFPageObjMark_GetParamKey": {
+		"PageObjectMark": 1,
+		"Index":          2,
+	},
+	"requests.FPDFPageObjMark_GetParamStringValue": {
+		"PageObjectMark": 1,
+		"Key":            2,
+	},
+	"requests.FPDFPageObjMark_GetParamValueType": {
+		"PageObjectMark": 1,
+		"Key":            2,
+	},
+	"requests.FPDFPageObjMark_RemoveParam": {
+		"PageObject":     1,
+		"PageObjectMark": 2,
+		"Key":            3,
+	},
+	"requests.FPDFPageObjMark_SetBlobParam": {
+		"Document":       1,
+		"PageObject":     2,
+		"PageObjectMark": 3,
+		"Key":            4,
+		"Value":          5,
+	},
+	"requests.FPDFPageObjMark_SetFloatParam": {
+		"Document":       1,
+		"PageObject":     2,
+		"PageObjectMark": 3,
+		"Key":            4,
+		"Value":          5,
+	},
+	"requests.FPDFPageObjMark_SetIntParam": {
+		"Document":       1,
+		"PageObject":     2,
+		"PageObjectMark": 3,
+		"Key":            4,
+		"Value":          5,
+	},
+	"requests.FPDFPageObjMark_SetStringParam": {
+		"Document":       1,
+		"PageObject":     2,
+		"PageObjectMark": 3,
+		"Key":            4,
+		"Value":          5,
+	},
+	"requests.FPDFPageObj_AddExistingMark": {
+		"PageObject":     1,
+		"PageObjectMark": 2,
+	},
+	"requests.FPDFPageObj_AddMark": {
+		"PageObject": 1,
+		"Name":       2,
+	},
+	"requests.FPDFPageObj_CountMarks": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPageObj_CreateNewPath": {
+		"X": 1,
+		"Y": 2,
+	},
+	"requests.FPDFPageObj_CreateNewRect": {
+		"X": 1,
+		"Y": 2,
+		"W": 3,
+		"H": 4,
+	},
+	"requests.FPDFPageObj_CreateTextObj": {
+		"Document": 1,
+		"Font":     2,
+		"FontSize": 3,
+	},
+	"requests.FPDFPageObj_Destroy": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPageObj_GetBounds": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPageObj_GetClipPath": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPageObj_GetDashArray": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPageObj_GetDashCount": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPageObj_GetDashPhase": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPageObj_GetFillColor": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPageObj_GetIsActive": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPageObj_GetLineCap": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPageObj_GetLineJoin": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPageObj_GetMark": {
+		"PageObject": 1,
+		"Index":      2,
+	},
+	"requests.FPDFPageObj_GetMarkedContentID": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPageObj_GetMatrix": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPageObj_GetRotatedBounds": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPageObj_GetStrokeColor": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPageObj_GetStrokeWidth": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPageObj_GetType": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPageObj_HasTransparency": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPageObj_NewImageObj": {
+		"Document": 1,
+	},
+	"requests.FPDFPageObj_NewTextObj": {
+		"Document": 1,
+		"Font":     2,
+		"FontSize": 3,
+	},
+	"requests.FPDFPageObj_RemoveMark": {
+		"PageObject":     1,
+		"PageObjectMark": 2,
+	},
+	"requests.FPDFPageObj_SetBlendMode": {
+		"PageObject": 1,
+		"BlendMode":  2,
+	},
+	"requests.FPDFPageObj_SetDashArray": {
+		"PageObject": 1,
+		"DashArray":  2,
+		"DashPhase":  3,
+	},
+	"requests.FPDFPageObj_SetDashPhase": {
+		"PageObject": 1,
+		"DashPhase":  2,
+	},
+	"requests.FPDFPageObj_SetFillColor": {
+		"PageObject": 1,
+		"FillColor":  2,
+	},
+	"requests.FPDFPageObj_SetIsActive": {
+		"PageObject": 1,
+		"Active":     2,
+	},
+	"requests.FPDFPageObj_SetLineCap": {
+		"PageObject": 1,
+		"LineCap":    2,
+	},
+	"requests.FPDFPageObj_SetLineJoin": {
+		"PageObject": 1,
+		"LineJoin":   2,
+	},
+	"requests.FPDFPageObj_SetMatrix": {
+		"PageObject": 1,
+		"Transform":  2,
+	},
+	"requests.FPDFPageObj_SetStrokeColor": {
+		"PageObject":  1,
+		"StrokeColor": 2,
+	},
+	"requests.FPDFPageObj_SetStrokeWidth": {
+		"PageObject":  1,
+		"StrokeWidth": 2,
+	},
+	"requests.FPDFPageObj_Transform": {
+		"PageObject": 1,
+		"Transform":  2,
+	},
+	"requests.FPDFPageObj_TransformClipPath": {
+		"PageObject": 1,
+		"A":          2,
+		"B":          3,
+		"C":          4,
+		"D":          5,
+		"E":          6,
+		"F":          7,
+	},
+	"requests.FPDFPageObj_TransformF": {
+		"PageObject": 1,
+		"Transform":  2,
+	},
+	"requests.FPDFPage_CloseAnnot": {
+		"Annotation": 1,
+	},
+	"requests.FPDFPage_CountObjects": {
+		"Page": 1,
+	},
+	"requests.FPDFPage_CreateAnnot": {
+		"Page":    1,
+		"Subtype": 2,
+	},
+	"requests.FPDFPage_Delete": {
+		"Document":  1,
+		"PageIndex": 2,
+	},
+	"requests.FPDFPage_Flatten": {
+		"Page":  1,
+		"Usage": 2,
+	},
+	"requests.FPDFPage_FormFieldZOrderAtPoint": {
+		"FormHandle": 1,
+		"Page":       2,
+		"PageX":      3,
+		"PageY":      4,
+	},
+	"requests.FPDFPage_GenerateContent": {
+		"Page": 1,
+	},
+	"requests.FPDFPage_GetAnnot": {
+		"Page":  1,
+		"Index": 2,
+	},
+	"requests.FPDFPage_GetAnnotCount": {
+		"Page": 1,
+	},
+	"requests.FPDFPage_GetAnnotIndex": {
+		"Page":       1,
+		"Annotation": 2,
+	},
+	"requests.FPDFPage_GetArtBox": {
+		"Page": 1,
+	},
+	"requests.FPDFPage_GetBleedBox": {
+		"Page": 1,
+	},
+	"requests.FPDFPage_GetCropBox": {
+		"Page": 1,
+	},
+	"requests.FPDFPage_GetDecodedThumbnailData": {
+		"Page": 1,
+	},
+	"requests.FPDFPage_GetMediaBox": {
+		"Page": 1,
+	},
+	"requests.FPDFPage_GetObject": {
+		"Page":  1,
+		"Index": 2,
+	},
+	"requests.FPDFPage_GetRawThumbnailData": {
+		"Page": 1,
+	},
+	"requests.FPDFPage_GetRotation": {
+		"Page": 1,
+	},
+	"requests.FPDFPage_GetThumbnailAsBitmap": {
+		"Page": 1,
+	},
+	"requests.FPDFPage_GetTrimBox": {
+		"Page": 1,
+	},
+	"requests.FPDFPage_HasFormFieldAtPoint": {
+		"FormHandle": 1,
+		"Page":       2,
+		"PageX":      3,
+		"PageY":      4,
+	},
+	"requests.FPDFPage_HasTransparency": {
+		"Page": 1,
+	},
+	"requests.FPDFPage_InsertClipPath": {
+		"Page":     1,
+		"ClipPath": 2,
+	},
+	"requests.FPDFPage_InsertObject": {
+		"Page":       1,
+		"PageObject": 2,
+	},
+	"requests.FPDFPage_InsertObjectAtIndex": {
+		"Page":       1,
+		"PageObject": 2,
+		"Index":      3,
+	},
+	"requests.FPDFPage_New": {
+		"Document":  1,
+		"PageIndex": 2,
+		"Width":     3,
+		"Height":    4,
+	},
+	"requests.FPDFPage_RemoveAnnot": {
+		"Page":  1,
+		"Index": 2,
+	},
+	"requests.FPDFPage_RemoveObject": {
+		"Page":       1,
+		"PageObject": 2,
+	},
+	"requests.FPDFPage_SetArtBox": {
+		"Page":   1,
+		"Left":   2,
+		"Bottom": 3,
+		"Right":  4,
+		"Top":    5,
+	},
+	"requests.FPDFPage_SetBleedBox": {
+		"Page":   1,
+		"Left":   2,
+		"Bottom": 3,
+		"Right":  4,
+		"Top":    5,
+	},
+	"requests.FPDFPage_SetCropBox": {
+		"Page":   1,
+		"Left":   2,
+		"Bottom": 3,
+		"Right":  4,
+		"Top":    5,
+	},
+	"requests.FPDFPage_SetMediaBox": {
+		"Page":   1,
+		"Left":   2,
+		"Bottom": 3,
+		"Right":  4,
+		"Top":    5,
+	},
+	"requests.FPDFPage_SetRotation": {
+		"Page":   1,
+		"Rotate": 2,
+	},
+	"requests.FPDFPage_SetTrimBox": {
+		"Page":   1,
+		"Left":   2,
+		"Bottom": 3,
+		"Right":  4,
+		"Top":    5,
+	},
+	"requests.FPDFPage_TransFormWithClip": {
+		"Page":     1,
+		"Matrix":   2,
+		"ClipRect": 3,
+	},
+	"requests.FPDFPage_TransformAnnots": {
+		"Page":      1,
+		"Transform": 2,
+	},
+	"requests.FPDFPathSegment_GetClose": {
+		"PathSegment": 1,
+	},
+	"requests.FPDFPathSegment_GetPoint": {
+		"PathSegment": 1,
+	},
+	"requests.FPDFPathSegment_GetType": {
+		"PathSegment": 1,
+	},
+	"requests.FPDFPath_BezierTo": {
+		"PageObject": 1,
+		"X1":         2,
+		"Y1":         3,
+		"X2":         4,
+		"Y2":         5,
+		"X3":         6,
+		"Y3":         7,
+	},
+	"requests.FPDFPath_Close": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPath_CountSegments": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPath_GetDrawMode": {
+		"PageObject": 1,
+	},
+	"requests.FPDFPath_GetPathSegment": {
+		"PageObject": 1,
+		"Index":      2,
+	},
+	"requests.FPDFPath_LineTo": {
+		"PageObject": 1,
+		"X":          2,
+		"Y":          3,
+	},
+	"requests.FPDFPath_MoveTo": {
+		"PageObject": 1,
+		"X":          2,
+		"Y":          3,
+	},
+	"requests.FPDFPath_SetDrawMode": {
+		"PageObject": 1,
+		"FillMode":   2,
+		"Stroke":     3,
+	},
+	"requests.FPDFSignatureObj_GetByteRange": {
+		"Signature": 1,
+	},
+	"requests.FPDFSignatureObj_GetContents": {
+		"Signature": 1,
+	},
+	"requests.FPDFSignatureObj_GetDocMDPPermission": {
+		"Signature": 1,
+	},
+	"requests.FPDFSignatureObj_GetReason": {
+		"Signature": 1,
+	},
+	"requests.FPDFSignatureObj_GetSubFilter": {
+		"Signature": 1,
+	},
+	"requests.FPDFSignatureObj_GetTime": {
+		"Signature": 1,
+	},
+	"requests.FPDFTextObj_GetFont": {
+		"PageObject": 1,
+	},
+	"requests.FPDFTextObj_GetFontSize": {
+		"PageObject": 1,
+	},
+	"requests.FPDFTextObj_GetRenderedBitmap": {
+		"Document":   1,
+		"Page":       2,
+		"PageObject": 3,
+		"Scale":      4,
+	},
+	"requests.FPDFTextObj_GetText": {
+		"PageObject": 1,
+		"TextPage":   2,
+	},
+	"requests.FPDFTextObj_GetTextRenderMode": {
+		"PageObject": 1,
+	},
+	"requests.FPDFTextObj_SetFontSize": {
+		"PageObject": 1,
+		"FontSize":   2,
+	},
+	"requests.FPDFTextObj_SetTextRenderMode": {
+		"PageObject":     1,
+		"TextRenderMode": 2,
+	},
+	"requests.FPDFText_ClosePage": {
+		"TextPage": 1,
+	},
+	"requests.FPDFText_CountChars": {
+		"TextPage": 1,
+	},
+	"requests.FPDFText_CountRects": {
+		"TextPage":   1,
+		"StartIndex": 2,
+		"Count":      3,
+	},
+	"requests.FPDFText_FindClose": {
+		"Search": 1,
+	},
+	"requests.FPDFText_FindNext": {
+		"Search": 1,
+	},
+	"requests.FPDFText_FindPrev": {
+		"Search": 1,
+	},
+	"requests.FPDFText_FindStart": {
+		"TextPage":   1,
+		"Find":       2,
+		"Flags":      3,
+		"StartIndex": 4,
+	},
+	"requests.FPDFText_GetBoundedText": {
+		"TextPage": 1,
+		"Left":     2,
+		"Top":      3,
+		"Right":    4,
+		"Bottom":   5,
+	},
+	"requests.FPDFText_GetCharAngle": {
+		"TextPage": 1,
+		"Index":    2,
+	},
+	"requests.FPDFText_GetCharBox": {
+		"TextPage": 1,
+		"Index":    2,
+	},
+	"requests.FPDFText_GetCharIndexAtPos": {
+		"TextPage":   1,
+		"X":          2,
+		"Y":          3,
+		"XTolerance": 4,
+		"YTolerance": 5,
+	},
+	"requests.FPDFText_GetCharIndexFromTextIndex": {
+		"TextPage":   1,
+		"NTextIndex": 2,
+	},
+	"requests.FPDFText_GetCharOrigin": {
+		"TextPage": 1,
+		"Index":    2,
+	},
+	"requests.FPDFText_GetFillColor": {
+		"TextPage": 1,
+		"Index":    2,
+	},
+	"requests.FPDFText_GetFontInfo": {
+		"TextPage": 1,
+		"Index":    2,
+	},
+	"requests.FPDFText_GetFontSize": {
+		"TextPage": 1,
+		"Index":    2,
+	},
+	"requests.FPDFText_GetFontWeight": {
+		"TextPage": 1,
+		"Index":    2,
+	},
+	"requests.FPDFText_GetLooseCharBox": {
+		"TextPage": 1,
+		"Index":    2,
+	},
+	"requests.FPDFText_GetMatrix": {
+		"TextPage": 1,
+		"Index":    2,
+	},
+	"requests.FPDFText_GetRect": {
+		"TextPage": 1,
+		"Index":    2,
+	},
+	"requests.FPDFText_GetSchCount": {
+		"Search": 1,
+	},
+	"requests.FPDFText_GetSchResultIndex": {
+		"Search": 1,
+	},
+	"requests.FPDFText_GetStrokeColor": {
+		"TextPage": 1,
+		"Index":    2,
+	},
+	"requests.FPDFText_GetText": {
+		"TextPage":   1,
+		"StartIndex": 2,
+		"Count":      3,
+	},
+	"requests.FPDFText_GetTextIndexFromCharIndex": {
+		"TextPage":   1,
+		"NCharIndex": 2,
+	},
+	"requests.FPDFText_GetTextObject": {
+		"TextPage": 1,
+		"Index":    2,
+	},
+	"requests.FPDFText_GetUnicode": {
+		"TextPage": 1,
+		"Index":    2,
+	},
+	"requests.FPDFText_HasUnicodeMapError": {
+		"TextPage": 1,
+		"Index":    2,
+	},
+	"requests.FPDFText_IsGenerated": {
+		"TextPage": 1,
+		"Index":    2,
+	},
+	"requests.FPDFText_IsHyphen": {
+		"TextPage": 1,
+		"Index":    2,
+	},
+	"requests.FPDFText_LoadCidType2Font": {
+		"Document":        1,
+		"FontData":        2,
+		"ToUnicodeCmap":   3,
+		"CIDToGIDMapData": 4,
+	},
+	"requests.FPDFText_LoadFont": {
+		"Document": 1,
+		"Data":     2,
+		"FontType": 3,
+		"CID":      4,
+	},
+	"requests.FPDFText_LoadPage": {
+		"Page": 1,
+	},
+	"requests.FPDFText_LoadStandardFont": {
+		"Document": 1,
+		"Font":     2,
+	},
+	"requests.FPDFText_SetCharcodes": {
+		"PageObject": 1,
+		"CharCodes":  2,
+	},
+	"requests.FPDFText_SetPositions": {
+		"PageObject": 1,
+		"Positions":  2,
+	},
+	"requests.FPDFText_SetText": {
+		"PageObject": 1,
+		"Text":       2,
+	},
+	"requests.FPDF_CloseDocument": {
+		"Document": 1,
+	},
+	"requests.FPDF_ClosePage": {
+		"Page": 1,
+	},
+	"requests.FPDF_CloseXObject": {
+		"XObject": 1,
+	},
+	"requests.FPDF_CopyViewerPreferences": {
+		"Source":      1,
+		"Destination": 2,
+	},
+	"requests.FPDF_CountNamedDests": {
+		"Document": 1,
+	},
+	"requests.FPDF_CreateClipPath": {
+		"Left":   1,
+		"Bottom": 2,
+		"Right":  3,
+		"Top":    4,
+	},
+	"requests.FPDF_CreateNewDocument": {},
+	"requests.FPDF_DestroyClipPath": {
+		"ClipPath": 1,
+	},
+	"requests.FPDF_DeviceToPage": {
+		"Page":    1,
+		"StartX":  2,
+		"StartY":  3,
+		"SizeX":   4,
+		"SizeY":   5,
+		"Rotate":  6,
+		"DeviceX": 7,
+		"DeviceY": 8,
+	},
+	"requests.FPDF_DocumentHasValidCrossReferenceTable": {
+		"Document": 1,
+	},
+	"requests.FPDF_FFLDraw": {
+		"FormHandle": 1,
+		"Bitmap":     2,
+		"Page":       3,
+		"StartX":     4,
+		"StartY":     5,
+		"SizeX":      6,
+		"SizeY":      7,
+		"Rotate":     8,
+		"Flags":      9,
+	},
+	"requests.FPDF_GetDocPermissions": {
+		"Document": 1,
+	},
+	"requests.FPDF_GetDocUserPermissions": {
+		"Document": 1,
+	},
+	"requests.FPDF_GetFileIdentifier": {
+		"Document":   1,
+		"FileIdType": 2,
+	},
+	"requests.FPDF_GetFileVersion": {
+		"Document": 1,
+	},
+	"requests.FPDF_GetFormType": {
+		"Document": 1,
+	},
+	"requests.FPDF_GetLastError": {},
+	"requests.FPDF_GetMetaText": {
+		"Document": 1,
+		"Tag":      2,
+	},
+	"requests.FPDF_GetNamedDest": {
+		"Document": 1,
+		"Index":    2,
+	},
+	"requests.FPDF_GetNamedDestByName": {
+		"Document": 1,
+		"Name":     2,
+	},
+	"requests.FPDF_GetPageAAction": {
+		"Page":   1,
+		"AAType": 2,
+	},
+	"requests.FPDF_GetPageBoundingBox": {
+		"Page": 1,
+	},
+	"requests.FPDF_GetPageCount": {
+		"Document": 1,
+	},
+	"requests.FPDF_GetPageHeight": {
+		"Page": 1,
+	},
+	"requests.FPDF_GetPageHeightF": {
+		"Page": 1,
+	},
+	"requests.FPDF_GetPageLabel": {
+		"Document": 1,
+		"Page":     2,
+	},
+	"requests.FPDF_GetPageSizeByIndex": {
+		"Document": 1,
+		"Index":    2,
+	},
+	"requests.FPDF_GetPageSizeByIndexF": {
+		"Document": 1,
+		"Index":    2,
+	},
+	"requests.FPDF_GetPageWidth": {
+		"Page": 1,
+	},
+	"requests.FPDF_GetPageWidthF": {
+		"Page": 1,
+	},
+	"requests.FPDF_GetSecurityHandlerRevision": {
+		"Document": 1,
+	},
+	"requests.FPDF_GetSignatureCount": {
+		"Document": 1,
+	},
+	"requests.FPDF_GetSignatureObject": {
+		"Document": 1,
+		"Index":    2,
+	},
+	"requests.FPDF_GetTrailerEnds": {
+		"Document": 1,
+	},
+	"requests.FPDF_GetXFAPacketContent": {
+		"Document": 1,
+		"Index":    2,
+	},
+	"requests.FPDF_GetXFAPacketCount": {
+		"Document": 1,
+	},
+	"requests.FPDF_GetXFAPacketName": {
+		"Document": 1,
+		"Index":    2,
+	},
+	"requests.FPDF_ImportNPagesToOne": {
+		"Source":          1,
+		"OutputWidth":     2,
+		"OutputHeight":    3,
+		"NumPagesOnXAxis": 4,
+		"NumPagesOnYAxis": 5,
+	},
+	"requests.FPDF_ImportPages": {
+		"Source":      1,
+		"Destination": 2,
+		"PageRange":   3,
+		"Index":       4,
+	},
+	"requests.FPDF_ImportPagesByIndex": {
+		"Source":      1,
+		"Destination": 2,
+		"PageIndices": 3,
+		"Index":       4,
+	},
+	"requests.FPDF_LoadCustomDocument": {
+		"Size":     1,
+		"Password": 2,
+	},
+	"requests.FPDF_LoadDocument": {
+		"Path":     1,
+		"Password": 2,
+	},
+	"requests.FPDF_LoadMemDocument": {
+		"Data":     1,
+		"Password": 2,
+	},
+	"requests.FPDF_LoadMemDocument64": {
+		"Data":     1,
+		"Password": 2,
+	},
+	"requests.FPDF_LoadPage": {
+		"Document": 1,
+		"Index":    2,
+	},
+	"requests.FPDF_LoadXFA": {
+		"Document": 1,
+	},
+	"requests.FPDF_MovePages": {
+		"Document":      1,
+		"PageIndices":   2,
+		"DestPageIndex": 3,
+	},
+	"requests.FPDF_NewFormObjectFromXObject": {
+		"XObject": 1,
+	},
+	"requests.FPDF_NewXObjectFromPage": {
+		"Source":          1,
+		"Destination":     2,
+		"SourcePageIndex": 3,
+	},
+	"requests.FPDF_PageToDevice": {
+		"Page":   1,
+		"StartX": 2,
+		"StartY": 3,
+		"SizeX":  4,
+		"SizeY":  5,
+		"Rotate": 6,
+		"PageX":  7,
+		"PageY":  8,
+	},
+	"requests.FPDF_RemoveFormFieldHighlight": {
+		"FormHandle": 1,
+	},
+	"requests.FPDF_RenderPage": {
+		"Page":   1,
+		"StartX": 2,
+		"StartY": 3,
+		"SizeX":  4,
+		"SizeY":  5,
+		"Rotate": 6,
+		"Flags":  7,
+	},
+	"requests.FPDF_RenderPageBitmap": {
+		"Bitmap": 1,
+		"Page":   2,
+		"StartX": 3,
+		"StartY": 4,
+		"SizeX":  5,
+		"SizeY":  6,
+		"Rotate": 7,
+		"Flags":  8,
+	},
+	"requests.FPDF_RenderPageBitmapWithColorScheme_Start": {
+		"Bitmap":      1,
+		"Page":        2,
+		"StartX":      3,
+		"StartY":      4,
+		"SizeX":       5,
+		"SizeY":       6,
+		"Rotate":      7,
+		"Flags":       8,
+		"ColorScheme": 9,
+	},
+	"requests.FPDF_RenderPageBitmapWithMatrix": {
+		"Bitmap":   1,
+		"Page":     2,
+		"Matrix":   3,
+		"Clipping": 4,
+		"Flags":    5,
+	},
+	"requests.FPDF_RenderPageBitmap_Start": {
+		"Bitmap": 1,
+		"Page":   2,
+		"StartX": 3,
+		"StartY": 4,
+		"SizeX":  5,
+		"SizeY":  6,
+		"Rotate": 7,
+		"Flags":  8,
+	},
+	"requests.FPDF_RenderPage_Close": {
+		"Page": 1,
+	},
+	"requests.FPDF_RenderPage_Continue": {
+		"Page": 1,
+	},
+	"requests.FPDF_SaveAsCopy": {
+		"Flags":    1,
+		"Document": 2,
+		"FilePath": 3,
+	},
+	"requests.FPDF_SaveWithVersion": {
+		"Document":    1,
+		"Flags":       2,
+		"FileVersion": 3,
+		"FilePath":    4,
+	},
+	"requests.FPDF_SetFormFieldHighlightAlpha": {
+		"FormHandle": 1,
+		"Alpha":      2,
+	},
+	"requests.FPDF_SetFormFieldHighlightColor": {
+		"FormHandle": 1,
+		"FieldType":  2,
+		"Color":      3,
+	},
+	"requests.FPDF_SetPrintMode": {
+		"PrintMode": 1,
+	},
+	"requests.FPDF_SetSandBoxPolicy": {
+		"Policy": 1,
+		"Enable": 2,
+	},
+	"requests.FPDF_StructElement_Attr_CountChildren": {
+		"StructElementAttributeValue": 1,
+	},
+	"requests.FPDF_StructElement_Attr_GetBlobValue": {
+		"StructElementAttributeValue": 1,
+	},
+	"requests.FPDF_StructElement_Attr_GetBooleanValue": {
+		"StructElementAttributeValue": 1,
+	},
+	"requests.FPDF_StructElement_Attr_GetChildAtIndex": {
+		"StructElementAttributeValue": 1,
+		"Index":                       2,
+	},
+	"requests.FPDF_StructElement_Attr_GetCount": {
+		"StructElementAttribute": 1,
+	},
+	"requests.FPDF_StructElement_Attr_GetName": {
+		"StructElementAttribute": 1,
+		"Index":                  2,
+	},
+	"requests.FPDF_StructElement_Attr_GetNumberValue": {
+		"StructElementAttributeValue": 1,
+	},
+	"requests.FPDF_StructElement_Attr_GetStringValue": {
+		"StructElementAttributeValue": 1,
+	},
+	"requests.FPDF_StructElement_Attr_GetType": {
+		"StructElementAttributeValue": 1,
+	},
+	"requests.FPDF_StructElement_Attr_GetValue": {
+		"StructElementAttribute": 1,
+		"Name":                   2,
+	},
+	"requests.FPDF_StructElement_CountChildren": {
+		"StructElement": 1,
+	},
+	"requests.FPDF_StructElement_GetActualText": {
+		"StructElement": 1,
+	},
+	"requests.FPDF_StructElement_GetAltText": {
+		"StructElement": 1,
+	},
+	"requests.FPDF_StructElement_GetAttributeAtIndex": {
+		"StructElement": 1,
+		"Index":         2,
+	},
+	"requests.FPDF_StructElement_GetAttributeCount": {
+		"StructElement": 1,
+	},
+	"requests.FPDF_StructElement_GetChildAtIndex": {
+		"StructElement": 1,
+		"Index":         2,
+	},
+	"requests.FPDF_StructElement_GetChildMarkedContentID": {
+		"StructElement": 1,
+		"Index":         2,
+	},
+	"requests.FPDF_StructElement_GetExpansion": {
+		"StructElement": 1,
+	},
+	"requests.FPDF_StructElement_GetID": {
+		"StructElement": 1,
+	},
+	"requests.FPDF_StructElement_GetLang": {
+		"StructElement": 1,
+	},
+	"requests.FPDF_StructElement_GetMarkedContentID": {
+		"StructElement": 1,
+	},
+	"requests.FPDF_StructElement_GetMarkedContentIdAtIndex": {
+		"StructElement": 1,
+		"Index":         2,
+	},
+	"requests.FPDF_StructElement_GetMarkedContentIdCount": {
+		"StructElement": 1,
+	},
+	"requests.FPDF_StructElement_GetObjType": {
+		"StructElement": 1,
+	},
+	"requests.FPDF_StructElement_GetParent": {
+		"StructElement": 1,
+	},
+	"requests.FPDF_StructElement_GetStringAttribute": {
+		"StructElement": 1,
+		"AttributeName": 2,
+	},
+	"requests.FPDF_StructElement_GetTitle": {
+		"StructElement": 1,
+	},
+	"requests.FPDF_StructElement_GetType": {
+		"StructElement": 1,
+	},
+	"requests.FPDF_StructTree_Close": {
+		"StructTree": 1,
+	},
+	"requests.FPDF_StructTree_CountChildren": {
+		"StructTree": 1,
+	},
+	"requests.FPDF_StructTree_GetChildAtIndex": {
+		"StructTree": 1,
+		"Index":      2,
+	},
+	"requests.FPDF_StructTree_GetForPage": {
+		"Page": 1,
+	},
+	"requests.FPDF_VIEWERREF_GetDuplex": {
+		"Document": 1,
+	},
+	"requests.FPDF_VIEWERREF_GetName": {
+		"Document": 1,
+		"Key":      2,
+	},
+	"requests.FPDF_VIEWERREF_GetNumCopies": {
+		"Document": 1,
+	},
+	"requests.FPDF_VIEWERREF_GetPrintPageRange": {
+		"Document": 1,
+	},
+	"requests.FPDF_VIEWERREF_GetPrintPageRangeCount": {
+		"PageRange": 1,
+	},
+	"requests.FPDF_VIEWERREF_GetPrintPageRangeElement": {
+		"PageRange": 1,
+		"Index":     2,
+	},
+	"requests.FPDF_VIEWERREF_GetPrintScaling": {
+		"Document": 1,
+	},
+	"requests.FSDK_SetLocaltimeFunction":     {},
+	"requests.FSDK_SetTimeFunction":          {},
+	"requests.FSDK_SetUnSpObjProcessHandler": {},
+	"requests.GetActionInfo": {
+		"Document": 1,
+		"Action":   2,
+	},
+	"requests.GetAttachments": {
+		"Document": 1,
+	},
+	"requests.GetBookmarks": {
+		"Document": 1,
+	},
+	"requests.GetDestInfo": {
+		"Document": 1,
+		"Dest":     2,
+	},
+	"requests.GetForm": {
+		"Page": 1,
+	},
+	"requests.GetJavaScriptActions": {
+		"Document": 1,
+	},
+	"requests.GetMetaData": {
+		"Document": 1,
+		"Tags":     2,
+	},
+	"requests.GetPageSize": {
+		"Page": 1,
+	},
+	"requests.GetPageSizeInPixels": {
+		"Page": 1,
+		"DPI":  2,
+	},
+	"requests.GetPageText": {
+		"Page": 1,
+	},
+	"requests.GetPageTextStructured": {
+		"Page":                   1,
+		"Mode":                   2,
+		"CollectFontInformation": 3,
+		"PixelPositions":         4,
+	},
+	"requests.GetPageTextStructuredPixelPositions": {
+		"Document":  1,
+		"Calculate": 2,
+		"DPI":       3,
+		"Width":     4,
+		"Height":    5,
+	},
+	"requests.ListOpenHandles": {
+		"Document": 1,
+	},
+	"requests.OpenDocument": {
+		"File":           1,
+		"FilePath":       2,
+		"FileReaderSize": 3,
+		"Password":       4,
+	},
+	"requests.Page": {
+		"ByIndex":     1,
+		"ByReference": 2,
+	},
+	"requests.PageByIndex": {
+		"Document": 1,
+		"Index":    2,
+	},
+	"requests.RenderPageInDPI": {
+		"Page":            1,
+		"DPI":             2,
+		"RenderFlags":     3,
+		"RenderForm":      4,
+		"Document":        5,
+		"ImageFormat":     6,
+		"ColorScheme":     7,
+		"BackgroundColor": 8,
+	},
+	"requests.RenderPageInPixels": {
+		"Page":            1,
+		"Width":           2,
+		"Height":          3,
+		"RenderFlags":     4,
+		"RenderForm":      5,
+		"Document":        6,
+		"ImageFormat":     7,
+		"ColorScheme":     8,
+		"BackgroundColor": 9,
+	},
+	"requests.RenderPageRegion": {
+		"Page":            1,
+		"Region":          2,
+		"DPI":             3,
+		"Width":           4,
+		"Height":          5,
+		"RenderFlags":     6,
+		"RenderForm":      7,
+		"Document":        8,
+		"ImageFormat":     9,
+		"ColorScheme":     10,
+		"BackgroundColor": 11,
+	},
+	"requests.RenderPagesInDPI": {
+		"Pages":   1,
+		"Padding": 2,
+	},
+	"requests.RenderPagesInPixels": {
+		"Pages":   1,
+		"Padding": 2,
+	},
+	"requests.RenderRegion": {
+		"X":      1,
+		"Y":      2,
+		"Width":  3,
+		"Height": 4,
+	},
+	"requests.RenderToFile": {
+		"RenderPageInDPI":     1,
+		"RenderPagesInDPI":    2,
+		"RenderPageInPixels":  3,
+		"RenderPagesInPixels": 4,
+		"OutputFormat":        5,
+		"OutputTarget":        6,
+		"OutputQuality":       7,
+		"Progressive":         8,
+		"MaxFileSize":         9,
+		"TargetFilePath":      10,
+		"RenderPageRegion":    11,
+		"TIFFCompression":     12,
+		"TIFFPageCompression": 13,
+	},
+	"responses.ActionInfo": {
+		"Reference": 1,
+		"Type":      2,
+		"DestInfo":  3,
+		"FilePath":  4,
+		"URIPath":   5,
+	},
+	"responses.Attachment": {
+		"Name":    1,
+		"Content": 2,
+		"Values":  3,
+	},
+	"responses.AttachmentValue": {
+		"Key":         1,
+		"ValueType":   2,
+		"StringValue": 3,
+	},
+	"responses.CharPosition": {
+		"Left":   1,
+		"Top":    2,
+		"Right":  3,
+		"Bottom": 4,
+	},
+	"responses.DestInfo": {
+		"Reference": 1,
+		"PageIndex": 2,
+	},
+	"responses.DocumentOpenHandles": {
+		"Document": 1,
+		"Counts":   2,
+		"Handles":  3,
+	},
+	"responses.FORM_CanRedo": {
+		"CanRedo": 1,
+	},
+	"responses.FORM_CanUndo": {
+		"CanUndo": 1,
+	},
+	"responses.FORM_DoDocumentAAction":    {},
+	"responses.FORM_DoDocumentJSAction":   {},
+	"responses.FORM_DoDocumentOpenAction": {},
+	"responses.FORM_DoPageAAction":        {},
+	"responses.FORM_ForceToKillFocus":     {},
+	"responses.FORM_GetFocusedAnnot": {
+		"PageIndex":  1,
+		"Annotation": 2,
+	},
+	"responses.FORM_GetFocusedText": {
+		"FocusedText": 1,
+	},
+	"responses.FORM_GetSelectedText": {
+		"SelectedText": 1,
+	},
+	"responses.FORM_IsIndexSelected": {
+		"IsIndexSelected": 1,
+	},
+	"responses.FORM_OnAfterLoadPage":   {},
+	"responses.FORM_OnBeforeClosePage": {},
+	"responses.FORM_OnChar":            {},
+	"responses.FORM_OnFocus": {
+		"HasFocus": 1,
+	},
+	"responses.FORM_OnKeyDown":               {},
+	"responses.FORM_OnKeyUp":                 {},
+	"responses.FORM_OnLButtonDoubleClick":    {},
+	"responses.FORM_OnLButtonDown":           {},
+	"responses.FORM_OnLButtonUp":             {},
+	"responses.FORM_OnMouseMove":             {},
+	"responses.FORM_OnMouseWheel":            {},
+	"responses.FORM_OnRButtonDown":           {},
+	"responses.FORM_OnRButtonUp":             {},
+	"responses.FORM_Redo":                    {},
+	"responses.FORM_ReplaceAndKeepSelection": {},
+	"responses.FORM_ReplaceSelection":        {},
+	"responses.FORM_SelectAllText":           {},
+	"responses.FORM_SetFocusedAnnot":         {},
+	"responses.FORM_SetIndexSelected":        {},
+	"responses.FORM_Undo":                    {},
+	"responses.FPDFAction_GetDest": {
+		"Dest": 1,
+	},
+	"responses.FPDFAction_GetFilePath": {
+		"FilePath": 1,
+	},
+	"responses.FPDFAction_GetType": {
+		"Type": 1,
+	},
+	"responses.FPDFAction_GetURIPath": {
+		"URIPath": 1,
+	},
+	"responses.FPDFAnnot_AddFileAttachment": {
+		"Attachment": 1,
+	},
+	"responses.FPDFAnnot_AddInkStroke": {
+		"Index": 1,
+	},
+	"responses.FPDFAnnot_AppendAttachmentPoints": {},
+	"responses.FPDFAnnot_AppendObject":           {},
+	"responses.FPDFAnnot_CountAttachmentPoints": {
+		"Count": 1,
+	},
+	"responses.FPDFAnnot_GetAP": {
+		"Value": 1,
+	},
+	"responses.FPDFAnnot_GetAttachmentPoints": {
+		"QuadPoints": 1,
+	},
+	"responses.FPDFAnnot_GetBorder": {
+		"HorizontalRadius": 1,
+		"VerticalRadius":   2,
+		"BorderWidth":      3,
+	},
+	"responses.FPDFAnnot_GetColor": {
+		"R": 1,
+		"G": 2,
+		"B": 3,
+		"A": 4,
+	},
+	"responses.FPDFAnnot_GetFileAttachment": {
+		"Attachment": 1,
+	},
+	"responses.FPDFAnnot_GetFlags": {
+		"Flags": 1,
+	},
+	"responses.FPDFAnnot_GetFocusableSubtypes": {
+		"FocusableSubtypes": 1,
+	},
+	"responses.FPDFAnnot_GetFocusableSubtypesCount": {
+		"FocusableSubtypesCount": 1,
+	},
+	"responses.FPDFAnnot_GetFontColor": {
+		"R": 1,
+		"G": 2,
+		"B": 3,
+	},
+	"responses.FPDFAnnot_GetFontSize": {
+		"FontSize": 1,
+	},
+	"responses.FPDFAnnot_GetFormAdditionalActionJavaScript": {
+		"FormAdditionalActionJavaScript": 1,
+	},
+	"responses.FPDFAnnot_GetFormControlCount": {
+		"FormControlCount": 1,
+	},
+	"responses.FPDFAnnot_GetFormControlIndex": {
+		"FormControlIndex": 1,
+	},
+	"responses.FPDFAnnot_GetFormFieldAlternateName": {
+		"FormFieldAlternateName": 1,
+	},
+	"responses.FPDFAnnot_GetFormFieldAtPoint": {
+		"Annotation": 1,
+	},
+	"responses.FPDFAnnot_GetFormFieldExportValue": {
+		"Value": 1,
+	},
+	"responses.FPDFAnnot_GetFormFieldFlags": {
+		"Flags": 1,
+	},
+	"responses.FPDFAnnot_GetFormFieldName": {
+		"FormFieldName": 1,
+	},
+	"responses.FPDFAnnot_GetFormFieldType": {
+		"FormFieldType": 1,
+	},
+	"responses.FPDFAnnot_GetFormFieldValue": {
+		"FormFieldValue": 1,
+	},
+	"responses.FPDFAnnot_GetInkListCount": {
+		"Count": 1,
+	},
+	"responses.FPDFAnnot_GetInkListPath": {
+		"Path": 1,
+	},
+	"responses.FPDFAnnot_GetLine": {
+		"Start": 1,
+		"End":   2,
+	},
+	"responses.FPDFAnnot_GetLink": {
+		"Link": 1,
+	},
+	"responses.FPDFAnnot_GetLinkedAnnot": {
+		"LinkedAnnotation": 1,
+	},
+	"responses.FPDFAnnot_GetNumberValue": {
+		"Value": 1,
+	},
+	"responses.FPDFAnnot_GetObject": {
+		"PageObject": 1,
+	},
+	"responses.FPDFAnnot_GetObjectCount": {
+		"Count": 1,
+	},
+	"responses.FPDFAnnot_GetOptionCount": {
+		"OptionCount": 1,
+	},
+	"responses.FPDFAnnot_GetOptionLabel": {
+		"OptionLabel": 1,
+	},
+	"responses.FPDFAnnot_GetRect": {
+		"Rect": 1,
+	},
+	"responses.FPDFAnnot_GetStringValue": {
+		"Value": 1,
+	},
+	"responses.FPDFAnnot_GetSubtype": {
+		"Subtype": 1,
+	},
+	"responses.FPDFAnnot_GetValueType": {
+		"ValueType": 1,
+	},
+	"responses.FPDFAnnot_GetVertices": {
+		"Vertices": 1,
+	},
+	"responses.FPDFAnnot_HasAttachmentPoints": {
+		"HasAttachmentPoints": 1,
+	},
+	"responses.FPDFAnnot_HasKey": {
+		"HasKey": 1,
+	},
+	"responses.FPDFAnnot_IsChecked": {
+		"IsChecked": 1,
+	},
+	"responses.FPDFAnnot_IsObjectSupportedSubtype": {
+		"IsObjectSupportedSubtype": 1,
+	},
+	"responses.FPDFAnnot_IsOptionSelected": {
+		"IsOptionSelected": 1,
+	},
+	"responses.FPDFAnnot_IsSupportedSubtype": {
+		"IsSupported": 1,
+	},
+	"responses.FPDFAnnot_RemoveInkList":        {},
+	"responses.FPDFAnnot_RemoveObject":         {},
+	"responses.FPDFAnnot_SetAP":                {},
+	"responses.FPDFAnnot_SetAttachmentPoints":  {},
+	"responses.FPDFAnnot_SetBorder":            {},
+	"responses.FPDFAnnot_SetColor":             {},
+	"responses.FPDFAnnot_SetFlags":             {},
+	"responses.FPDFAnnot_SetFocusableSubtypes": {},
+	"responses.FPDFAnnot_SetFontColor":         {},
+	"responses.FPDFAnnot_SetFormFieldFlags":    {},
+	"responses.FPDFAnnot_SetRect":              {},
+	"responses.FPDFAnnot_SetStringValue":       {},
+	"responses.FPDFAnnot_SetURI":               {},
+	"responses.FPDFAnnot_UpdateObject":         {},
+	"responses.FPDFAttachment_GetDescription": {
+		"Value": 1,
+	},
+	"responses.FPDFAttachment_GetFile": {
+		"Contents": 1,
+	},
+	"responses.FPDFAttachment_GetName": {
+		"Name": 1,
+	},
+	"responses.FPDFAttachment_GetStringValue": {
+		"Key":   1,
+		"Value": 2,
+	},
+	"responses.FPDFAttachment_GetSubtype": {
+		"Subtype": 1,
+	},
+	"responses.FPDFAttachment_GetValueType": {
+		"Key":       1,
+		"ValueType": 2,
+	},
+	"responses.FPDFAttachment_HasKey": {
+		"Key":    1,
+		"HasKey": 2,
+	},
+	"responses.FPDFAttachment_SetDescription": {
+		"Value": 1,
+	},
+	"responses.FPDFAttachment_SetFile": {},
+	"responses.FPDFAttachment_SetStringValue": {
+		"Key":   1,
+		"Value": 2,
+	},
+	"responses.FPDFAvail_Create": {
+		"AvailabilityProvider": 1,
+	},
+	"responses.FPDFAvail_Destroy": {},
+	"responses.FPDFAvail_GetDocument": {
+		"Document": 1,
+	},
+	"responses.FPDFAvail_GetFirstPageNum": {
+		"FirstPageNum": 1,
+	},
+	"responses.FPDFAvail_IsDocAvail": {
+		"IsDocAvail": 1,
+	},
+	"responses.FPDFAvail_IsFormAvail": {
+		"IsFormAvail": 1,
+	},
+	"responses.FPDFAvail_IsLinearized": {
+		"IsLinearized": 1,
+	},
+	"responses.FPDFAvail_IsPageAvail": {
+		"IsPageAvail": 1,
+	},
+	"responses.FPDFBitmap_Create": {
+		"Bitmap": 1,
+	},
+	"responses.FPDFBitmap_CreateEx": {
+		"Bitmap": 1,
+	},
+	"responses.FPDFBitmap_Destroy":  {},
+	"responses.FPDFBitmap_FillRect": {},
+	"responses.FPDFBitmap_GetBuffer": {
+		"Buffer": 1,
+	},
+	"responses.FPDFBitmap_GetFormat": {
+		"Format": 1,
+	},
+	"responses.FPDFBitmap_GetHeight": {
+		"Height": 1,
+	},
+	"responses.FPDFBitmap_GetStride": {
+		"Stride": 1,
+	},
+	"responses.FPDFBitmap_GetWidth": {
+		"Width": 1,
+	},
+	"responses.FPDFBookmark_Find": {
+		"Bookmark": 1,
+	},
+	"responses.FPDFBookmark_GetAction": {
+		"Action": 1,
+	},
+	"responses.FPDFBookmark_GetColor": {
+		"R": 1,
+		"G": 2,
+		"B": 3,
+	},
+	"responses.FPDFBookmark_GetCount": {
+		"Count": 1,
+	},
+	"responses.FPDFBookmark_GetDest": {
+		"Dest": 1,
+	},
+	"responses.FPDFBookmark_GetFirstChild": {
+		"Bookmark": 1,
+	},
+	"responses.FPDFBookmark_GetNextSibling": {
+		"Bookmark": 1,
+	},
+	"responses.FPDFBookmark_GetTitle": {
+		"Title": 1,
+	},
+	"responses.FPDFCatalog_GetLanguage": {
+		"Language": 1,
+	},
+	"responses.FPDFCatalog_IsTagged": {
+		"IsTagged": 1,
+	},
+	"responses.FPDFCatalog_SetLanguage": {},
+	"responses.FPDFClipPath_CountPathSegments": {
+		"Count": 1,
+	},
+	"responses.FPDFClipPath_CountPaths": {
+		"Count": 1,
+	},
+	"responses.FPDFClipPath_GetPathSegment": {
+		"PathSegment": 1,
+	},
+	"responses.FPDFDOC_ExitFormFillEnvironment": {},
+	"responses.FPDFDOC_InitFormFillEnvironment": {
+		"FormHandle": 1,
+	},
+	"responses.FPDFDest_GetDestPageIndex": {
+		"Index": 1,
+	},
+	"responses.FPDFDest_GetLocationInPage": {
+		"X":    1,
+		"Y":    2,
+		"Zoom": 3,
+	},
+	"responses.FPDFDest_GetView": {
+		"DestView": 1,
+		"Params":   2,
+	},
+	"responses.FPDFDoc_AddAttachment": {
+		"Attachment": 1,
+	},
+	"responses.FPDFDoc_CloseJavaScriptAction": {},
+	"responses.FPDFDoc_DeleteAttachment": {
+		"Index": 1,
+	},
+	"responses.FPDFDoc_GetAttachment": {
+		"Index":      1,
+		"Attachment": 2,
+	},
+	"responses.FPDFDoc_GetAttachmentCount": {
+		"AttachmentCount": 1,
+	},
+	"responses.FPDFDoc_GetJavaScriptAction": {
+		"Index":            1,
+		"JavaScriptAction": 2,
+	},
+	"responses.FPDFDoc_GetJavaScriptActionCount": {
+		"JavaScriptActionCount": 1,
+	},
+	"responses.FPDFDoc_GetPageMode": {
+		"PageMode": 1,
+	},
+	"responses.FPDFFont_Close": {},
+	"responses.FPDFFont_GetAscent": {
+		"Ascent": 1,
+	},
+	"responses.FPDFFont_GetBaseFontName": {
+		"BaseFontName": 1,
+	},
+	"responses.FPDFFont_GetDescent": {
+		"Descent": 1,
+	},
+	"responses.FPDFFont_GetFamilyName": {
+		"FamilyName": 1,
+	},
+	"responses.FPDFFont_GetFlags": {
+		"Flags":       1,
+		"FixedPitch":  2,
+		"Serif":       3,
+		"Symbolic":    4,
+		"Script":      5,
+		"Nonsymbolic": 6,
+		"Italic":      7,
+		"AllCap":      8,
+		"SmallCap":    9,
+		"ForceBold":   10,
+	},
+	"responses.FPDFFont_GetFontData": {
+		"FontData": 1,
+	},
+	"responses.FPDFFont_GetGlyphPath": {
+		"GlyphPath": 1,
+	},
+	"responses.FPDFFont_GetGlyphWidth": {
+		"GlyphWidth": 1,
+	},
+	"responses.FPDFFont_GetIsEmbedded": {
+		"IsEmbedded": 1,
+	},
+	"responses.FPDFFont_GetItalicAngle": {
+		"ItalicAngle": 1,
+	},
+	"responses.FPDFFont_GetWeight": {
+		"Weight": 1,
+	},
+	"responses.FPDFFormObj_CountObjects": {
+		"Count": 1,
+	},
+	"responses.FPDFFormObj_GetObject": {
+		"PageObject": 1,
+	},
+	"responses.FPDFFormObj_RemoveObject": {},
+	"responses.FPDFGlyphPath_CountGlyphSegments": {
+		"Count": 1,
+	},
+	"responses.FPDFGlyphPath_GetGlyphPathSegment": {
+		"GlyphPathSegment": 1,
+	},
+	"responses.FPDFImageObj_GetBitmap": {
+		"Bitmap": 1,
+	},
+	"responses.FPDFImageObj_GetIccProfileDataDecoded": {
+		"Data": 1,
+	},
+	"responses.FPDFImageObj_GetImageDataDecoded": {
+		"Data": 1,
+	},
+	"responses.FPDFImageObj_GetImageDataRaw": {
+		"Data": 1,
+	},
+	"responses.FPDFImageObj_GetImageFilter": {
+		"ImageFilter": 1,
+	},
+	"responses.FPDFImageObj_GetImageFilterCount": {
+		"Count": 1,
+	},
+	"responses.FPDFImageObj_GetImageMetadata": {
+		"ImageMetadata": 1,
+	},
+	"responses.FPDFImageObj_GetImagePixelSize": {
+		"Width":  1,
+		"Height": 2,
+	},
+	"responses.FPDFImageObj_GetRenderedBitmap": {
+		"Bitmap": 1,
+	},
+	"responses.FPDFImageObj_LoadJpegFile":       {},
+	"responses.FPDFImageObj_LoadJpegFileInline": {},
+	"responses.FPDFImageObj_SetBitmap":          {},
+	"responses.FPDFImageObj_SetMatrix":          {},
+	"responses.FPDFJavaScriptAction_GetName": {
+		"Name": 1,
+	},
+	"responses.FPDFJavaScriptAction_GetScript": {
+		"Script": 1,
+	},
+	"responses.FPDFLink_CloseWebLinks": {},
+	"responses.FPDFLink_CountQuadPoints": {
+		"Count": 1,
+	},
+	"responses.FPDFLink_CountRects": {
+		"Index": 1,
+		"Count": 2,
+	},
+	"responses.FPDFLink_CountWebLinks": {
+		"Count": 1,
+	},
+	"responses.FPDFLink_Enumerate": {
+		"NextStartPos": 1,
+		"Link":         2,
+	},
+	"responses.FPDFLink_GetAction": {
+		"Action": 1,
+	},
+	"responses.FPDFLink_GetAnnot": {
+		"Annotation": 1,
+	},
+	"responses.FPDFLink_GetAnnotRect": {
+		"Rect": 1,
+	},
+	"responses.FPDFLink_GetDest": {
+		"Dest": 1,
+	},
+	"responses.FPDFLink_GetLinkAtPoint": {
+		"Link": 1,
+	},
+	"responses.FPDFLink_GetLinkZOrderAtPoint": {
+		"ZOrder": 1,
+	},
+	"responses.FPDFLink_GetQuadPoints": {
+		"Points": 1,
+	},
+	"responses.FPDFLink_GetRect": {
+		"Index":     1,
+		"RectIndex": 2,
+		"Left":      3,
+		"Top":       4,
+		"Right":     5,
+		"Bottom":    6,
+	},
+	"responses.FPDFLink_GetTextRange": {
+		"Index":          1,
+		"StartCharIndex": 2,
+		"CharCount":      3,
+	},
+	"responses.FPDFLink_GetURL": {
+		"Index": 1,
+		"URL":   2,
+	},
+	"responses.FPDFLink_LoadWebLinks": {
+		"PageLink": 1,
+	},
+	"responses.FPDFPageObjMark_CountParams": {
+		"Count": 1,
+	},
+	"responses.FPDFPageObjMark_GetName": {
+		"Name": 1,
+	},
+	"responses.FPDFPageObjMark_GetParamBlobValue": {
+		"Value": 1,
+	},
+	"responses.FPDFPageObjMark_GetParamFloatValue": {
+		"Value": 1,
+	},
+	"responses.FPDFPageObjMark_GetParamIntValue": {
+		"Value": 1,
+	},
+	"responses.FPDFPageObjMark_GetParamKey": {
+		"Key": 1,
+	},
+	"responses.FPDFPageObjMark_GetParamStringValue": {
+		"Value": 1,
+	},
+	"responses.FPDFPageObjMark_GetParamValueType": {
+		"ValueType": 1,
+	},
+	"responses.FPDFPageObjMark_RemoveParam":    {},
+	"responses.FPDFPageObjMark_SetBlobParam":   {},
+	"responses.FPDFPageObjMark_SetFloatParam":  {},
+	"responses.FPDFPageObjMark_SetIntParam":    {},
+	"responses.FPDFPageObjMark_SetStringParam": {},
+	"responses.FPDFPageObj_AddExistingMark":    {},
+	"responses.FPDFPageObj_AddMark": {
+		"Mark": 1,
+	},
+	"responses.FPDFPageObj_CountMarks": {
+		"Count": 1,
+	},
+	"responses.FPDFPageObj_CreateNewPath": {
+		"PageObject": 1,
+	},
+	"responses.FPDFPageObj_CreateNewRect": {
+		"PageObject": 1,
+	},
+	"responses.FPDFPageObj_CreateTextObj": {
+		"PageObject": 1,
+	},
+	"responses.FPDFPageObj_Destroy": {},
+	"responses.FPDFPageObj_GetBounds": {
+		"Left":   1,
+		"Bottom": 2,
+		"Right":  3,
+		"Top":    4,
+	},
+	"responses.FPDFPageObj_GetClipPath": {
+		"ClipPath": 1,
+	},
+	"responses.FPDFPageObj_GetDashArray": {
+		"DashArray": 1,
+	},
+	"responses.FPDFPageObj_GetDashCount": {
+		"DashCount": 1,
+	},
+	"responses.FPDFPageObj_GetDashPhase": {
+		"DashPhase": 1,
+	},
+	"responses.FPDFPageObj_GetFillColor": {
+		"FillColor": 1,
+	},
+	"responses.FPDFPageObj_GetIsActive": {
+		"Active": 1,
+	},
+	"responses.FPDFPageObj_GetLineCap": {
+		"LineCap": 1,
+	},
+	"responses.FPDFPageObj_GetLineJoin": {
+		"LineJoin": 1,
+	},
+	"responses.FPDFPageObj_GetMark": {
+		"Mark": 1,
+	},
+	"responses.FPDFPageObj_GetMarkedContentID": {
+		"MarkedContentID": 1,
+	},
+	"responses.FPDFPageObj_GetMatrix": {
+		"Matrix": 1,
+	},
+	"responses.FPDFPageObj_GetRotatedBounds": {
+		"QuadPoints": 1,
+	},
+	"responses.FPDFPageObj_GetStrokeColor": {
+		"StrokeColor": 1,
+	},
+	"responses.FPDFPageObj_GetStrokeWidth": {
+		"StrokeWidth": 1,
+	},
+	"responses.FPDFPageObj_GetType": {
+		"Type": 1,
+	},
+	"responses.FPDFPageObj_HasTransparency": {
+		"HasTransparency": 1,
+	},
+	"responses.FPDFPageObj_NewImageObj": {
+		"PageObject": 1,
+	},
+	"responses.FPDFPageObj_NewTextObj": {
+		"PageObject": 1,
+	},
+	"responses.FPDFPageObj_RemoveMark":        {},
+	"responses.FPDFPageObj_SetBlendMode":      {},
+	"responses.FPDFPageObj_SetDashArray":      {},
+	"responses.FPDFPageObj_SetDashPhase":      {},
+	"responses.FPDFPageObj_SetFillColor":      {},
+	"responses.FPDFPageObj_SetIsActive":       {},
+	"responses.FPDFPageObj_SetLineCap":        {},
+	"responses.FPDFPageObj_SetLineJoin":       {},
+	"responses.FPDFPageObj_SetMatrix":         {},
+	"responses.FPDFPageObj_SetStrokeColor":    {},
+	"responses.FPDFPageObj_SetStrokeWidth":    {},
+	"responses.FPDFPageObj_Transform":         {},
+	"responses.FPDFPageObj_TransformClipPath": {},
+	"responses.FPDFPageObj_TransformF":        {},
+	"responses.FPDFPage_CloseAnnot":           {},
+	"responses.FPDFPage_CountObjects": {
+		"Count": 1,
+	},
+	"responses.FPDFPage_CreateAnnot": {
+		"Annotation": 1,
+	},
+	"responses.FPDFPage_Delete": {},
+	"responses.FPDFPage_Flatten": {
+		"Page":   1,
+		"Result": 2,
+	},
+	"responses.FPDFPage_FormFieldZOrderAtPoint": {
+		"ZOrder": 1,
+	},
+	"responses.FPDFPage_GenerateContent": {},
+	"responses.FPDFPage_GetAnnot": {
+		"Annotation": 1,
+	},
+	"responses.FPDFPage_GetAnnotCount": {
+		"Count": 1,
+	},
+	"responses.FPDFPage_GetAnnotIndex": {
+		"Index": 1,
+	},
+	"responses.FPDFPage_GetArtBox": {
+		"Left":   1,
+		"Bottom": 2,
+		"Right":  3,
+		"Top":    4,
+	},
+	"responses.FPDFPage_GetBleedBox": {
+		"Left":   1,
+		"Bottom": 2,
+		"Right":  3,
+		"Top":    4,
+	},
+	"responses.FPDFPage_GetCropBox": {
+		"Left":   1,
+		"Bottom": 2,
+		"Right":  3,
+		"Top":    4,
+	},
+	"responses.FPDFPage_GetDecodedThumbnailData": {
+		"Thumbnail": 1,
+	},
+	"responses.FPDFPage_GetMediaBox": {
+		"Left":   1,
+		"Bottom": 2,
+		"Right":  3,
+		"Top":    4,
+	},
+	"responses.FPDFPage_GetObject": {
+		"PageObject": 1,
+	},
+	"responses.FPDFPage_GetRawThumbnailData": {
+		"RawThumbnail": 1,
+	},
+	"responses.FPDFPage_GetRotation": {
+		"Page":         1,
+		"PageRotation": 2,
+	},
+	"responses.FPDFPage_GetThumbnailAsBitmap": {
+		"Bitmap": 1,
+	},
+	"responses.FPDFPage_GetTrimBox": {
+		"Left":   1,
+		"Bottom": 2,
+		"Right":  3,
+		"Top":    4,
+	},
+	"responses.FPDFPage_HasFormFieldAtPoint": {
+		"FieldType": 1,
+	},
+	"responses.FPDFPage_HasTransparency": {
+		"Page":            1,
+		"HasTransparency": 2,
+	},
+	"responses.FPDFPage_InsertClipPath":      {},
+	"responses.FPDFPage_InsertObject":        {},
+	"responses.FPDFPage_InsertObjectAtIndex": {},
+	"responses.FPDFPage_New": {
+		"Page": 1,
+	},
+	"responses.FPDFPage_RemoveAnnot":       {},
+	"responses.FPDFPage_RemoveObject":      {},
+	"responses.FPDFPage_SetArtBox":         {},
+	"responses.FPDFPage_SetBleedBox":       {},
+	"responses.FPDFPage_SetCropBox":        {},
+	"responses.FPDFPage_SetMediaBox":       {},
+	"responses.FPDFPage_SetRotation":       {},
+	"responses.FPDFPage_SetTrimBox":        {},
+	"responses.FPDFPage_TransFormWithClip": {},
+	"responses.FPDFPage_TransformAnnots":   {},
+	"responses.FPDFPathSegment_GetClose": {
+		"IsClose": 1,
+	},
+	"responses.FPDFPathSegment_GetPoint": {
+		"X": 1,
+		"Y": 2,
+	},
+	"responses.FPDFPathSegment_GetType": {
+		"Type": 1,
+	},
+	"responses.FPDFPath_BezierTo": {},
+	"responses.FPDFPath_Close":    {},
+	"responses.FPDFPath_CountSegments": {
+		"Count": 1,
+	},
+	"responses.FPDFPath_GetDrawMode": {
+		"FillMode": 1,
+		"Stroke":   2,
+	},
+	"responses.FPDFPath_GetPathSegment": {
+		"PathSegment": 1,
+	},
+	"responses.FPDFPath_LineTo":      {},
+	"responses.FPDFPath_MoveTo":      {},
+	"responses.FPDFPath_SetDrawMode": {},
+	"responses.FPDFSignatureObj_GetByteRange": {
+		"ByteRange": 1,
+	},
+	"responses.FPDFSignatureObj_GetContents": {
+		"Contents": 1,
+	},
+	"responses.FPDFSignatureObj_GetDocMDPPermission": {
+		"DocMDPPermission": 1,
+	},
+	"responses.FPDFSignatureObj_GetReason": {
+		"Reason": 1,
+	},
+	"responses.FPDFSignatureObj_GetSubFilter": {
+		"SubFilter": 1,
+	},
+	"responses.FPDFSignatureObj_GetTime": {
+		"Time": 1,
+	},
+	"responses.FPDFTextObj_GetFont": {
+		"Font": 1,
+	},
+	"responses.FPDFTextObj_GetFontSize": {
+		"FontSize": 1,
+	},
+	"responses.FPDFTextObj_GetRenderedBitmap": {
+		"Bitmap": 1,
+	},
+	"responses.FPDFTextObj_GetText": {
+		"Text": 1,
+	},
+	"responses.FPDFTextObj_GetTextRenderMode": {
+		"TextRenderMode": 1,
+	},
+	"responses.FPDFTextObj_SetFontSize":       {},
+	"responses.FPDFTextObj_SetTextRenderMode": {},
+	"responses.FPDFText_ClosePage":            {},
+	"responses.FPDFText_CountChars": {
+		"Count": 1,
+	},
+	"responses.FPDFText_CountRects": {
+		"Count": 1,
+	},
+	"responses.FPDFText_FindClose": {},
+	"responses.FPDFText_FindNext": {
+		"GotMatch": 1,
+	},
+	"responses.FPDFText_FindPrev": {
+		"GotMatch": 1,
+	},
+	"responses.FPDFText_FindStart": {
+		"Search": 1,
+	},
+	"responses.FPDFText_GetBoundedText": {
+		"Text": 1,
+	},
+	"responses.FPDFText_GetCharAngle": {
+		"Index":     1,
+		"CharAngle": 2,
+	},
+	"responses.FPDFText_GetCharBox": {
+		"Index":  1,
+		"Left":   2,
+		"Right":  3,
+		"Bottom": 4,
+		"Top":    5,
+	},
+	"responses.FPDFText_GetCharIndexAtPos": {
+		"CharIndex": 1,
+	},
+	"responses.FPDFText_GetCharIndexFromTextIndex": {
+		"CharIndex": 1,
+	},
+	"responses.FPDFText_GetCharOrigin": {
+		"Index": 1,
+		"X":     2,
+		"Y":     3,
+	},
+	"responses.FPDFText_GetFillColor": {
+		"Index": 1,
+		"R":     2,
+		"G":     3,
+		"B":     4,
+		"A":     5,
+	},
+	"responses.FPDFText_GetFontInfo": {
+		"Index":    1,
+		"FontName": 2,
+		"Flags":    3,
+	},
+	"responses.FPDFText_GetFontSize": {
+		"Index":    1,
+		"FontSize": 2,
+	},
+	"responses.FPDFText_GetFontWeight": {
+		"Index":      1,
+		"FontWeight": 2,
+	},
+	"responses.FPDFText_GetLooseCharBox": {
+		"Index": 1,
+		"Rect":  2,
+	},
+	"responses.FPDFText_GetMatrix": {
+		"Index":  1,
+		"Matrix": 2,
+	},
+	"responses.FPDFText_GetRect": {
+		"Left":   1,
+		"Top":    2,
+		"Right":  3,
+		"Bottom": 4,
+	},
+	"responses.FPDFText_GetSchCount": {
+		"Count": 1,
+	},
+	"responses.FPDFText_GetSchResultIndex": {
+		"Index": 1,
+	},
+	"responses.FPDFText_GetStrokeColor": {
+		"Index": 1,
+		"R":     2,
+		"G":     3,
+		"B":     4,
+		"A":     5,
+	},
+	"responses.FPDFText_GetText": {
+		"Text": 1,
+	},
+	"responses.FPDFText_GetTextIndexFromCharIndex": {
+		"TextIndex": 1,
+	},
+	"responses.FPDFText_GetTextObject": {
+		"Index":      1,
+		"TextObject": 2,
+	},
+	"responses.FPDFText_GetUnicode": {
+		"Index":   1,
+		"Unicode": 2,
+	},
+	"responses.FPDFText_HasUnicodeMapError": {
+		"Index":              1,
+		"HasUnicodeMapError": 2,
+	},
+	"responses.FPDFText_IsGenerated": {
+		"Index":       1,
+		"IsGenerated": 2,
+	},
+	"responses.FPDFText_IsHyphen": {
+		"Index":    1,
+		"IsHyphen": 2,
+	},
+	"responses.FPDFText_LoadCidType2Font": {
+		"Font": 1,
+	},
+	"responses.FPDFText_LoadFont": {
+		"Font": 1,
+	},
+	"responses.FPDFText_LoadPage": {
+		"TextPage": 1,
+	},
+	"responses.FPDFText_LoadStandardFont": {
+		"Font": 1,
+	},
+	"responses.FPDFText_SetCharcodes":      {},
+	"responses.FPDFText_SetPositions":      {},
+	"responses.FPDFText_SetText":           {},
+	"responses.FPDF_CloseDocument":         {},
+	"responses.FPDF_ClosePage":             {},
+	"responses.FPDF_CloseXObject":          {},
+	"responses.FPDF_CopyViewerPreferences": {},
+	"responses.FPDF_CountNamedDests": {
+		"Count": 1,
+	},
+	"responses.FPDF_CreateClipPath": {
+		"ClipPath": 1,
+	},
+	"responses.FPDF_CreateNewDocument": {
+		"Document": 1,
+	},
+	"responses.FPDF_DestroyClipPath": {},
+	"responses.FPDF_DeviceToPage": {
+		"PageX": 1,
+		"PageY": 2,
+	},
+	"responses.FPDF_DocumentHasValidCrossReferenceTable": {
+		"DocumentHasValidCrossReferenceTable": 1,
+	},
+	"responses.FPDF_FFLDraw": {},
+	"responses.FPDF_GetDocPermissions": {
+		"DocPermissions":                      1,
+		"PrintDocument":                       2,
+		"ModifyContents":                      3,
+		"CopyOrExtractText":                   4,
+		"AddOrModifyTextAnnotations":          5,
+		"FillInInteractiveFormFields":         6,
+		"CreateOrModifyInteractiveFormFields": 7,
+		"FillInExistingInteractiveFormFields": 8,
+		"ExtractTextAndGraphics":              9,
+		"AssembleDocument":                    10,
+		"PrintDocumentAsFaithfulDigitalCopy":  11,
+	},
+	"responses.FPDF_GetDocUserPermissions": {
+		"DocUserPermissions":                  1,
+		"PrintDocument":                       2,
+		"ModifyContents":                      3,
+		"CopyOrExtractText":                   4,
+		"AddOrModifyTextAnnotations":          5,
+		"FillInInteractiveFormFields":         6,
+		"CreateOrModifyInteractiveFormFields": 7,
+		"FillInExistingInteractiveFormFields": 8,
+		"ExtractTextAndGraphics":              9,
+		"AssembleDocument":                    10,
+		"PrintDocumentAsFaithfulDigitalCopy":  11,
+	},
+	"responses.FPDF_GetFileIdentifier": {
+		"FileIdType": 1,
+		"Identifier": 2,
+	},
+	"responses.FPDF_GetFileVersion": {
+		"FileVersion": 1,
+	},
+	"responses.FPDF_GetFormType": {
+		"FormType": 1,
+	},
+	"responses.FPDF_GetLastError": {
+		"Error": 1,
+	},
+	"responses.FPDF_GetMetaText": {
+		"Tag":   1,
+		"Value": 2,
+	},
+	"responses.FPDF_GetNamedDest": {
+		"Dest": 1,
+		"Name": 2,
+	},
+	"responses.FPDF_GetNamedDestByName": {
+		"Dest": 1,
+	},
+	"responses.FPDF_GetPageAAction": {
+		"AAType": 1,
+		"Action": 2,
+	},
+	"responses.FPDF_GetPageBoundingBox": {
+		"Rect": 1,
+	},
+	"responses.FPDF_GetPageCount": {
+		"PageCount": 1,
+	},
+	"responses.FPDF_GetPageHeight": {
+		"Page":   1,
+		"Height": 2,
+	},
+	"responses.FPDF_GetPageHeightF": {
+		"PageHeight": 1,
+	},
+	"responses.FPDF_GetPageLabel": {
+		"Page":  1,
+		"Label": 2,
+	},
+	"responses.FPDF_GetPageSizeByIndex": {
+		"Page":   1,
+		"Width":  2,
+		"Height": 3,
+	},
+	"responses.FPDF_GetPageSizeByIndexF": {
+		"Size": 1,
+	},
+	"responses.FPDF_GetPageWidth": {
+		"Page":  1,
+		"Width": 2,
+	},
+	"responses.FPDF_GetPageWidthF": {
+		"PageWidth": 1,
+	},
+	"responses.FPDF_GetSecurityHandlerRevision": {
+		"SecurityHandlerRevision": 1,
+	},
+	"responses.FPDF_GetSignatureCount": {
+		"Count": 1,
+	},
+	"responses.FPDF_GetSignatureObject": {
+		"Index":     1,
+		"Signature": 2,
+	},
+	"responses.FPDF_GetTrailerEnds": {
+		"TrailerEnds": 1,
+	},
+	"responses.FPDF_GetXFAPacketContent": {
+		"Index":   1,
+		"Content": 2,
+	},
+	"responses.FPDF_GetXFAPacketCount": {
+		"Count": 1,
+	},
+	"responses.FPDF_GetXFAPacketName": {
+		"Index": 1,
+		"Name":  2,
+	},
+	"responses.FPDF_ImportNPagesToOne": {
+		"Document": 1,
+	},
+	"responses.FPDF_ImportPages":        {},
+	"responses.FPDF_ImportPagesByIndex": {},
+	"responses.FPDF_LoadCustomDocument": {
+		"Document": 1,
+	},
+	"responses.FPDF_LoadDocument": {
+		"Document": 1,
+	},
+	"responses.FPDF_LoadMemDocument": {
+		"Document": 1,
+	},
+	"responses.FPDF_LoadMemDocument64": {
+		"Document": 1,
+	},
+	"responses.FPDF_LoadPage": {
+		"Page": 1,
+	},
+	"responses.FPDF_LoadXFA":   {},
+	"responses.FPDF_MovePages": {},
+	"responses.FPDF_NewFormObjectFromXObject": {
+		"PageObject": 1,
+	},
+	"responses.FPDF_NewXObjectFromPage": {
+		"XObject": 1,
+	},
+	"responses.FPDF_PageToDevice": {
+		"DeviceX": 1,
+		"DeviceY": 2,
+	},
+	"responses.FPDF_RemoveFormFieldHighlight": {},
+	"responses.FPDF_RenderPage":               {},
+	"responses.FPDF_RenderPageBitmap":         {},
+	"responses.FPDF_RenderPageBitmapWithColorScheme_Start": {
+		"RenderStatus": 1,
+	},
+	"responses.FPDF_RenderPageBitmapWithMatrix": {},
+	"responses.FPDF_RenderPageBitmap_Start": {
+		"RenderStatus": 1,
+	},
+	"responses.FPDF_RenderPage_Close": {},
+	"responses.FPDF_RenderPage_Continue": {
+		"RenderStatus": 1,
+	},
+	"responses.FPDF_SaveAsCopy": {
+		"FileBytes": 1,
+		"FilePath":  2,
+	},
+	"responses.FPDF_SaveWithVersion": {
+		"FileBytes": 1,
+		"FilePath":  2,
+	},
+	"responses.FPDF_SetFormFieldHighlightAlpha": {},
+	"responses.FPDF_SetFormFieldHighlightColor": {},
+	"responses.FPDF_SetPrintMode":               {},
+	"responses.FPDF_SetSandBoxPolicy":           {},
+	"responses.FPDF_StructElement_Attr_CountChildren": {
+		"Count": 1,
+	},
+	"responses.FPDF_StructElement_Attr_GetBlobValue": {
+		"Value": 1,
+	},
+	"responses.FPDF_StructElement_Attr_GetBooleanValue": {
+		"Value": 1,
+	},
+	"responses.FPDF_StructElement_Attr_GetChildAtIndex": {
+		"StructElementAttributeValue": 1,
+	},
+	"responses.FPDF_StructElement_Attr_GetCount": {
+		"Count": 1,
+	},
+	"responses.FPDF_StructElement_Attr_GetName": {
+		"Name": 1,
+	},
+	"responses.FPDF_StructElement_Attr_GetNumberValue": {
+		"Value": 1,
+	},
+	"responses.FPDF_StructElement_Attr_GetStringValue": {
+		"Value": 1,
+	},
+	"responses.FPDF_StructElement_Attr_GetType": {
+		"ObjectType": 1,
+	},
+	"responses.FPDF_StructElement_Attr_GetValue": {
+		"StructElementAttributeValue": 1,
+	},
+	"responses.FPDF_StructElement_CountChildren": {
+		"Count": 1,
+	},
+	"responses.FPDF_StructElement_GetActualText": {
+		"Actualtext": 1,
+	},
+	"responses.FPDF_StructElement_GetAltText": {
+		"AltText": 1,
+	},
+	"responses.FPDF_StructElement_GetAttributeAtIndex": {
+		"StructElementAttribute": 1,
+	},
+	"responses.FPDF_StructElement_GetAttributeCount": {
+		"Count": 1,
+	},
+	"responses.FPDF_StructElement_GetChildAtIndex": {
+		"StructElement": 1,
+	},
+	"responses.FPDF_StructElement_GetChildMarkedContentID": {
+		"ChildMarkedContentID": 1,
+	},
+	"responses.FPDF_StructElement_GetExpansion": {
+		"Expansion": 1,
+	},
+	"responses.FPDF_StructElement_GetID": {
+		"ID": 1,
+	},
+	"responses.FPDF_StructElement_GetLang": {
+		"Lang": 1,
+	},
+	"responses.FPDF_StructElement_GetMarkedContentID": {
+		"MarkedContentID": 1,
+	},
+	"responses.FPDF_StructElement_GetMarkedContentIdAtIndex": {
+		"MarkedContentID": 1,
+	},
+	"responses.FPDF_StructElement_GetMarkedContentIdCount": {
+		"Count": 1,
+	},
+	"responses.FPDF_StructElement_GetObjType": {
+		"ObjType": 1,
+	},
+	"responses.FPDF_StructElement_GetParent": {
+		"StructElement": 1,
+	},
+	"responses.FPDF_StructElement_GetStringAttribute": {
+		"Attribute": 1,
+		"Value":     2,
+	},
+	"responses.FPDF_StructElement_GetTitle": {
+		"Title": 1,
+	},
+	"responses.FPDF_StructElement_GetType": {
+		"Type": 1,
+	},
+	"responses.FPDF_StructTree_Close": {},
+	"responses.FPDF_StructTree_CountChildren": {
+		"Count": 1,
+	},
+	"responses.FPDF_StructTree_GetChildAtIndex": {
+		"StructElement": 1,
+	},
+	"responses.FPDF_StructTree_GetForPage": {
+		"StructTree": 1,
+	},
+	"responses.FPDF_VIEWERREF_GetDuplex": {
+		"DuplexType": 1,
+	},
+	"responses.FPDF_VIEWERREF_GetName": {
+		"Value": 1,
+	},
+	"responses.FPDF_VIEWERREF_GetNumCopies": {
+		"NumCopies": 1,
+	},
+	"responses.FPDF_VIEWERREF_GetPrintPageRange": {
+		"PageRange": 1,
+	},
+	"responses.FPDF_VIEWERREF_GetPrintPageRangeCount": {
+		"Count": 1,
+	},
+	"responses.FPDF_VIEWERREF_GetPrintPageRangeElement": {
+		"Value": 1,
+	},
+	"responses.FPDF_VIEWERREF_GetPrintScaling": {
+		"PreferPrintScaling": 1,
+	},
+	"responses.FSDK_SetLocaltimeFunction":     {},
+	"responses.FSDK_SetTimeFunction":          {},
+	"responses.FSDK_SetUnSpObjProcessHandler": {},
+	"responses.FontInformation": {
+		"Size":         1,
+		"RenderedSize": 2,
+		"SizeInPixels": 3,
+		"Weight":       4,
+		"Name":         5,
+		"Flags":        6,
+	},
+	"responses.FormField": {
+		"Type":      1,
+		"Name":      2,
+		"Value":     3,
+		"Values":    4,
+		"IsChecked": 5,
+		"ToolTip":   6,
+		"Options":   7,
+		"Flags":     8,
+	},
+	"responses.FormFieldFlags": {
+		"ReadOnly": 1,
+		"Required": 2,
+		"NoExport": 3,
+	},
+	"responses.GetActionInfo": {
+		"ActionInfo": 1,
+	},
+	"responses.GetAttachments": {
+		"Attachments": 1,
+	},
+	"responses.GetBookmarks": {
+		"Bookmarks": 1,
+	},
+	"responses.GetBookmarksBookmark": {
+		"Title":      1,
+		"Reference":  2,
+		"ActionInfo": 3,
+		"DestInfo":   4,
+		"Children":   5,
+	},
+	"responses.GetDestInfo": {
+		"DestInfo": 1,
+	},
+	"responses.GetForm": {
+		"Page":   1,
+		"Fields": 2,
+	},
+	"responses.GetJavaScriptActions": {
+		"JavaScriptActions": 1,
+	},
+	"responses.GetMetaData": {
+		"Tags": 1,
+	},
+	"responses.GetMetaDataTag": {
+		"Tag":   1,
+		"Value": 2,
+	},
+	"responses.GetPageSize": {
+		"Page":   1,
+		"Width":  2,
+		"Height": 3,
+	},
+	"responses.GetPageSizeInPixels": {
+		"Page":              1,
+		"Width":             2,
+		"Height":            3,
+		"PointToPixelRatio": 4,
+	},
+	"responses.GetPageText": {
+		"Page": 1,
+		"Text": 2,
+	},
+	"responses.GetPageTextStructured": {
+		"Page":              1,
+		"Chars":             2,
+		"Rects":             3,
+		"PointToPixelRatio": 4,
+	},
+	"responses.GetPageTextStructuredChar": {
+		"Text":            1,
+		"Angle":           2,
+		"PointPosition":   3,
+		"PixelPosition":   4,
+		"FontInformation": 5,
+	},
+	"responses.GetPageTextStructuredRect": {
+		"Text":            1,
+		"PointPosition":   2,
+		"PixelPosition":   3,
+		"FontInformation": 4,
+	},
+	"responses.JavaScriptAction": {
+		"Name":   1,
+		"Script": 2,
+	},
+	"responses.ListOpenHandles": {
+		"Documents": 1,
+	},
+	"responses.OpenDocument": {
+		"Document": 1,
+	},
+	"responses.OpenHandle": {
+		"Type":      1,
+		"Reference": 2,
+	},
+	"responses.OpenHandleCount": {
+		"Type":  1,
+		"Count": 2,
+	},
+	"responses.RenderPage": {
+		"Page":              1,
+		"PointToPixelRatio": 2,
+		"Image":             3,
+		"RenderedImage":     4,
+		"Width":             5,
+		"Height":            6,
+		"HasTransparency":   7,
+	},
+	"responses.RenderPageInDPI": {
+		"Result": 1,
+	},
+	"responses.RenderPageInPixels": {
+		"Result": 1,
+	},
+	"responses.RenderPageRegion": {
+		"Result": 1,
+	},
+	"responses.RenderPages": {
+		"Pages":         1,
+		"Image":         2,
+		"RenderedImage": 3,
+		"Width":         4,
+		"Height":        5,
+	},
+	"responses.RenderPagesInDPI": {
+		"Result": 1,
+	},
+	"responses.RenderPagesInPixels": {
+		"Result": 1,
+	},
+	"responses.RenderPagesPage": {
+		"Page":              1,
+		"PointToPixelRatio": 2,
+		"Width":             3,
+		"Height":            4,
+		"X":                 5,
+		"Y":                 6,
+		"HasTransparency":   7,
+	},
+	"responses.RenderToFile": {
+		"Pages":             1,
+		"ImageBytes":        2,
+		"ImagePath":         3,
+		"Width":             4,
+		"Height":            5,
+		"PointToPixelRatio": 6,
+	},
+	"structs.FPDF_COLOR": {
+		"R": 1,
+		"G": 2,
+		"B": 3,
+		"A": 4,
+	},
+	"structs.FPDF_COLORSCHEME": {
+		"PathFillColor":   1,
+		"PathStrokeColor": 2,
+		"TextFillColor":   3,
+		"TextStrokeColor": 4,
+	},
+	"structs.FPDF_FS_MATRIX": {
+		"A": 1,
+		"B": 2,
+		"C": 3,
+		"D": 4,
+		"E": 5,
+		"F": 6,
+	},
+	"structs.FPDF_FS_POINTF": {
+		"X": 1,
+		"Y": 2,
+	},
+	"structs.FPDF_FS_QUADPOINTSF": {
+		"X1": 1,
+		"Y1": 2,
+		"X2": 3,
+		"Y2": 4,
+		"X3": 5,
+		"Y3": 6,
+		"X4": 7,
+		"Y4": 8,
+	},
+	"structs.FPDF_FS_RECTF": {
+		"Left":   1,
+		"Top":    2,
+		"Right":  3,
+		"Bottom": 4,
+	},
+	"structs.FPDF_FS_SIZEF": {
+		"Width":  1,
+		"Height": 2,
+	},
+	"structs.FPDF_IMAGEOBJ_METADATA": {
+		"Width":           1,
+		"Height":          2,
+		"HorizontalDPI":   3,
+		"VerticalDPI":     4,
+		"BitsPerPixel":    5,
+		"Colorspace":      6,
+		"MarkedContentID": 7,
+	},
+	"structs.FPDF_SYSTEMTIME": {
+		"Year":         1,
+		"Month":        2,
+		"DayOfWeek":    3,
+		"Day":          4,
+		"Hour":         5,
+		"Minute":       6,
+		"Second":       7,
+		"Milliseconds": 8,
+	},
+}
+
+// protobufInterfaces contains the field numbers of the concrete types that
+// can be sent in interface fields over gRPC, by interface type.
+var protobufInterfaces = map[string]map[reflect.Type]protowire.Number{
+	"image.Image": {
+		reflect.TypeOf(&image.RGBA{}):  1,
+		reflect.TypeOf(&image.Gray{}):  2,
+		reflect.TypeOf(&SharedImage{}): 3,
+	},
+}
@@ -0,0 +1,349 @@
+package commons
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// grpcPdfiumService is the name of the gRPC service of the worker.
+	grpcPdfiumService = "pdfium.Pdfium"
+
+	// grpcCallbacksService is the name of the gRPC service of the callbacks
+	// that the host process serves to the worker.
+	grpcCallbacksService = "pdfium.Callbacks"
+
+	// grpcAcceptTimeout is how long the host process waits for the worker to
+	// connect to the callbacks, like the MuxBroker of net/rpc does.
+	grpcAcceptTimeout = 5 * time.Second
+)
+
+// PdfiumGRPCPlugin serves the worker over gRPC, with the messages encoded as
+// described in pdfium.proto.
+type PdfiumGRPCPlugin struct {
+	plugin.NetRPCUnsupportedPlugin
+	Impl Pdfium
+}
+
+func (p *PdfiumGRPCPlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
+	server := newPdfiumRPCServer(p.Impl, &grpcBroker{broker: broker})
+	serviceDesc := grpcServiceDesc(grpcPdfiumService, server)
+	serviceDesc.Streams = append(serviceDesc.Streams, grpc.StreamDesc{
+		StreamName:    "RenderPagesStream",
+		Handler:       renderPagesStreamHandler,
+		ServerStreams: true,
+	})
+
+	s.RegisterService(serviceDesc, server)
+	return nil
+}
+
+func (p *PdfiumGRPCPlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, conn *grpc.ClientConn) (interface{}, error) {
+	return &PdfiumRPC{
+		client: &grpcClient{conn: conn, service: grpcPdfiumService},
+		broker: &grpcBroker{broker: broker},
+	}, nil
+}
+
+// NewGRPCServer creates the gRPC server of the worker. The messages between
+// the host process and the worker can be a lot bigger than the default limit
+// of gRPC.
+func NewGRPCServer(opts []grpc.ServerOption) *grpc.Server {
+	opts = append(opts, grpc.MaxRecvMsgSize(math.MaxInt32), grpc.MaxSendMsgSize(math.MaxInt32))
+	return grpc.NewServer(opts...)
+}
+
+// grpcClient calls the methods of a gRPC service that is created by
+// grpcServiceDesc, in the same way as a net/rpc client would.
+type grpcClient struct {
+	conn    *grpc.ClientConn
+	service string
+	ctx     context.Context
+}
+
+func (c *grpcClient) Call(serviceMethod string, args interface{}, reply interface{}) error {
+	method := "/" + c.service + "/" + strings.TrimPrefix(serviceMethod, "Plugin.")
+	ctx := c.context()
+	err := c.conn.Invoke(ctx, method, args, reply, grpc.CallContentSubtype(protobufCodecName))
+	if err != nil {
+		// Return the error of the context like net/rpc does.
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		return grpcError(err)
+	}
+
+	return nil
+}
+
+func (c *grpcClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *grpcClient) withContext(ctx context.Context) rpcClient {
+	return &grpcClient{conn: c.conn, service: c.service, ctx: ctx}
+}
+
+// context returns the context for a call, with the trace context of
+// OpenTelemetry in the metadata so that it's propagated to the worker.
+func (c *grpcClient) context() context.Context {
+	ctx := c.ctx
+	if ctx == nil {
+		return context.Background()
+	}
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// incomingContext returns the context of a call in the worker, with the trace
+// context of OpenTelemetry that was propagated by the host process.
+func incomingContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	return otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+}
+
+// metadataCarrier allows OpenTelemetry propagators to read and write gRPC
+// metadata.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key string, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// grpcError converts the errors that were returned by the methods of the
+// server back to plain errors, like net/rpc does.
+func grpcError(err error) error {
+	grpcStatus, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch grpcStatus.Code() {
+	case codes.Unknown:
+		return decodeError(errors.New(grpcStatus.Message()))
+	case codes.Unimplemented:
+		return fmt.Errorf("method is not supported by the worker, the worker might be of an older version: %w", err)
+	}
+
+	return err
+}
+
+// grpcServiceDesc describes a gRPC service that serves all the methods of
+// the given receiver that can be served by net/rpc. This allows the same
+// servers to be used for both transports.
+func grpcServiceDesc(serviceName string, receiver interface{}) *grpc.ServiceDesc {
+	serviceDesc := &grpc.ServiceDesc{
+		ServiceName: serviceName,
+		HandlerType: (*interface{})(nil),
+		Metadata:    "pdfium.proto",
+	}
+
+	receiverType := reflect.TypeOf(receiver)
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+	for i := 0; i < receiverType.NumMethod(); i++ {
+		method := receiverType.Method(i)
+		if !method.IsExported() || method.Type.NumIn() != 3 || method.Type.NumOut() != 1 {
+			continue
+		}
+
+		if method.Type.In(2).Kind() != reflect.Ptr || method.Type.Out(0) != errorType {
+			continue
+		}
+
+		serviceDesc.Methods = append(serviceDesc.Methods, grpc.MethodDesc{
+			MethodName: method.Name,
+			Handler:    grpcMethodHandler(serviceName, method),
+		})
+	}
+
+	return serviceDesc
+}
+
+func grpcMethodHandler(serviceName string, method reflect.Method) func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+	argsType := method.Type.In(1)
+	replyType := method.Type.In(2).Elem()
+
+	call := func(srv interface{}, args interface{}) (interface{}, error) {
+		argsValue := reflect.ValueOf(args)
+		if argsType.Kind() != reflect.Ptr {
+			argsValue = argsValue.Elem()
+		}
+
+		reply := reflect.New(replyType)
+		result := method.Func.Call([]reflect.Value{reflect.ValueOf(srv), argsValue, reply})
+		if err, _ := result[0].Interface().(error); err != nil {
+			return nil, err
+		}
+
+		return reply.Interface(), nil
+	}
+
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		var args interface{}
+		if argsType.Kind() == reflect.Ptr {
+			args = reflect.New(argsType.Elem()).Interface()
+		} else {
+			args = reflect.New(argsType).Interface()
+		}
+
+		if err := dec(args); err != nil {
+			return nil, err
+		}
+
+		ctx = incomingContext(ctx)
+
+		if interceptor == nil {
+			return call(srv, args)
+		}
+
+		info := &grpc.UnaryServerInfo{
+			Server:     srv,
+			FullMethod: "/" + serviceName + "/" + method.Name,
+		}
+
+		return interceptor(ctx, args, info, func(ctx context.Context, args interface{}) (interface{}, error) {
+			return call(srv, args)
+		})
+	}
+}
+
+// grpcBroker serves the callbacks over gRPC.
+type grpcBroker struct {
+	broker *plugin.GRPCBroker
+}
+
+func (b *grpcBroker) serve(callbacks interface{}) uint32 {
+	id := b.broker.NextId()
+
+	go func() {
+		listener, err := b.broker.Accept(id)
+		if err != nil {
+			return
+		}
+
+		// Only accept the connection of the worker, so that the server stops
+		// when the worker closes the connection.
+		acceptTimer := time.AfterFunc(grpcAcceptTimeout, func() {
+			listener.Close()
+		})
+		conn, err := listener.Accept()
+		acceptTimer.Stop()
+		listener.Close()
+		if err != nil {
+			return
+		}
+
+		server := NewGRPCServer(nil)
+		server.RegisterService(grpcServiceDesc(grpcCallbacksService, callbacks), callbacks)
+		server.Serve(newSingleConnListener(conn))
+		server.Stop()
+	}()
+
+	return id
+}
+
+func (b *grpcBroker) dial(id uint32) (rpcClient, error) {
+	conn, err := b.broker.Dial(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpcClient{conn: conn, service: grpcCallbacksService}, nil
+}
+
+// singleConnListener is a net.Listener that returns one connection, and
+// returns an error once that connection has been closed.
+type singleConnListener struct {
+	conn   net.Conn
+	conns  chan net.Conn
+	closed chan struct{}
+	once   *sync.Once
+}
+
+func newSingleConnListener(conn net.Conn) *singleConnListener {
+	listener := &singleConnListener{
+		conns:  make(chan net.Conn, 1),
+		closed: make(chan struct{}),
+		once:   &sync.Once{},
+	}
+
+	listener.conn = &notifyCloseConn{Conn: conn, close: listener.Close}
+	listener.conns <- listener.conn
+	return listener
+}
+
+func (l *singleConnListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *singleConnListener) Close() error {
+	l.once.Do(func() {
+		close(l.closed)
+	})
+	return nil
+}
+
+func (l *singleConnListener) Addr() net.Addr {
+	return l.conn.LocalAddr()
+}
+
+// notifyCloseConn closes the listener when the connection is closed.
+type notifyCloseConn struct {
+	net.Conn
+	close func() error
+}
+
+func (c *notifyCloseConn) Close() error {
+	c.close()
+	return c.Conn.Close()
+}
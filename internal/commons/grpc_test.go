@@ -0,0 +1,196 @@
+package commons_test
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"testing"
+
+	"github.com/klippa-app/go-pdfium/enums"
+	"github.com/klippa-app/go-pdfium/internal/commons"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGRPCClient(t *testing.T, impl commons.Pdfium) *commons.PdfiumRPC {
+	client, _ := plugin.TestPluginGRPCConn(t, false, map[string]plugin.Plugin{
+		"pdfium": &commons.PdfiumGRPCPlugin{Impl: impl},
+	})
+	t.Cleanup(func() {
+		client.Close()
+	})
+
+	raw, err := client.Dispense("pdfium")
+	require.NoError(t, err)
+
+	return raw.(*commons.PdfiumRPC)
+}
+
+// grpcImpl is a fake worker implementation that returns the given values, to
+// check whether they survive the transport.
+type grpcImpl struct {
+	commons.Pdfium
+	metaDataRequest *requests.GetMetaData
+	form            *responses.GetForm
+	image           image.Image
+}
+
+func (g *grpcImpl) GetMetaData(request *requests.GetMetaData) (*responses.GetMetaData, error) {
+	g.metaDataRequest = request
+	return &responses.GetMetaData{}, nil
+}
+
+func (g *grpcImpl) GetForm(request *requests.GetForm) (*responses.GetForm, error) {
+	return g.form, nil
+}
+
+func (g *grpcImpl) FPDF_GetPageCount(request *requests.FPDF_GetPageCount) (*responses.FPDF_GetPageCount, error) {
+	return nil, errors.New("document not found")
+}
+
+func (g *grpcImpl) RenderPageInDPI(request *requests.RenderPageInDPI) (*responses.RenderPageInDPI, error) {
+	if request.Page.ByIndex.Index == 2 {
+		return nil, errors.New("page not found")
+	}
+
+	return &responses.RenderPageInDPI{
+		Result: responses.RenderPage{
+			Page:          request.Page.ByIndex.Index,
+			Image:         g.image.(*image.RGBA),
+			RenderedImage: g.image,
+			Width:         g.image.Bounds().Dx(),
+			Height:        g.image.Bounds().Dy(),
+		},
+	}, nil
+}
+
+func (g *grpcImpl) Close() error {
+	return nil
+}
+
+func TestGRPCNilAndEmpty(t *testing.T) {
+	impl := &grpcImpl{}
+	pdfium := newTestGRPCClient(t, impl)
+
+	_, err := pdfium.GetMetaData(&requests.GetMetaData{})
+	require.NoError(t, err)
+	assert.Nil(t, impl.metaDataRequest.Tags)
+
+	_, err = pdfium.GetMetaData(&requests.GetMetaData{Tags: &[]string{}})
+	require.NoError(t, err)
+	require.NotNil(t, impl.metaDataRequest.Tags)
+	assert.Empty(t, *impl.metaDataRequest.Tags)
+
+	emptyString := ""
+	notChecked := false
+	impl.form = &responses.GetForm{
+		Page: 1,
+		Fields: []responses.FormField{
+			{Type: enums.FPDF_FORMFIELD_TYPE_TEXTFIELD, Name: "text", Value: &emptyString},
+			{Type: enums.FPDF_FORMFIELD_TYPE_PUSHBUTTON, Name: "button"},
+			{Type: enums.FPDF_FORMFIELD_TYPE_LISTBOX, Name: "list", Values: []string{}, Options: []string{"a", ""}},
+			{Type: enums.FPDF_FORMFIELD_TYPE_CHECKBOX, Name: "check", IsChecked: &notChecked},
+		},
+	}
+	form, err := pdfium.GetForm(&requests.GetForm{})
+	require.NoError(t, err)
+	assert.Equal(t, impl.form, form)
+
+	impl.form = &responses.GetForm{Fields: []responses.FormField{}}
+	form, err = pdfium.GetForm(&requests.GetForm{})
+	require.NoError(t, err)
+	assert.Equal(t, impl.form, form)
+
+	impl.form = &responses.GetForm{}
+	form, err = pdfium.GetForm(&requests.GetForm{})
+	require.NoError(t, err)
+	assert.Nil(t, form.Fields)
+}
+
+func TestGRPCError(t *testing.T) {
+	pdfium := newTestGRPCClient(t, &grpcImpl{})
+
+	resp, err := pdfium.FPDF_GetPageCount(&requests.FPDF_GetPageCount{})
+	assert.Nil(t, resp)
+	assert.EqualError(t, err, "document not found")
+}
+
+func TestGRPCOpenDocumentFileReader(t *testing.T) {
+	impl := &documentImpl{}
+	pdfium := newTestGRPCClient(t, impl)
+
+	fileData := bytes.Repeat([]byte("0123456789"), 1000)
+	doc, err := pdfium.OpenDocument(&requests.OpenDocument{
+		FileReader:     bytes.NewReader(fileData),
+		FileReaderSize: int64(len(fileData)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "reader", string(doc.Document))
+
+	block, err := impl.readBlock(5000, 100)
+	require.NoError(t, err)
+	assert.Equal(t, fileData[5000:5100], block)
+
+	_, err = pdfium.FPDF_CloseDocument(&requests.FPDF_CloseDocument{Document: doc.Document})
+	require.NoError(t, err)
+}
+
+func TestGRPCSaveAsCopyFileWriter(t *testing.T) {
+	pdfium := newTestGRPCClient(t, &saveImpl{})
+
+	writer := &recordingWriter{}
+	resp, err := pdfium.FPDF_SaveAsCopy(&requests.FPDF_SaveAsCopy{
+		Document:   "document",
+		FileWriter: writer,
+	})
+	require.NoError(t, err)
+	assert.Nil(t, resp.FileBytes)
+	assert.Equal(t, bytes.Repeat([]byte("0123456789"), 100000), writer.Bytes())
+}
+
+func TestGRPCRenderPagesStream(t *testing.T) {
+	for _, newClient := range []func(*testing.T, commons.Pdfium) *commons.PdfiumRPC{newTestClient, newTestGRPCClient} {
+		impl := &grpcImpl{image: testImage(false)}
+		pdfium := newClient(t, impl)
+
+		request := &requests.RenderPagesInDPI{
+			Pages: []requests.RenderPageInDPI{
+				{Page: requests.Page{ByIndex: &requests.PageByIndex{Index: 0}}, DPI: 72},
+				{Page: requests.Page{ByIndex: &requests.PageByIndex{Index: 1}}, DPI: 72},
+			},
+		}
+
+		var pages []int
+		err := pdfium.RenderPagesInDPIStream(request, func(page *responses.RenderPageInDPI) error {
+			pages = append(pages, page.Result.Page)
+			assert.Equal(t, impl.image, page.Result.RenderedImage)
+			assert.Equal(t, impl.image, page.Result.Image)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int{0, 1}, pages)
+
+		// Rendering stops at the first error of the callback.
+		pages = nil
+		err = pdfium.RenderPagesInDPIStream(request, func(page *responses.RenderPageInDPI) error {
+			pages = append(pages, page.Result.Page)
+			return errors.New("stop")
+		})
+		assert.EqualError(t, err, "stop")
+		assert.Equal(t, []int{0}, pages)
+
+		// Rendering stops at the first error of the worker.
+		request.Pages = append(request.Pages, requests.RenderPageInDPI{Page: requests.Page{ByIndex: &requests.PageByIndex{Index: 2}}, DPI: 72})
+		pages = nil
+		err = pdfium.RenderPagesInDPIStream(request, func(page *responses.RenderPageInDPI) error {
+			pages = append(pages, page.Result.Page)
+			return nil
+		})
+		assert.ErrorContains(t, err, "page not found")
+		assert.Equal(t, []int{0, 1}, pages)
+	}
+}
@@ -0,0 +1,23 @@
+package commons
+
+import (
+	"fmt"
+	"os"
+)
+
+// residentMemory returns the resident memory of the current process in
+// bytes.
+func residentMemory() (uint64, error) {
+	statm, err := os.ReadFile("/proc/self/statm")
+	if err != nil {
+		return 0, err
+	}
+
+	var size, resident uint64
+	_, err = fmt.Sscanf(string(statm), "%d %d", &size, &resident)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse /proc/self/statm: %w", err)
+	}
+
+	return resident * uint64(os.Getpagesize()), nil
+}
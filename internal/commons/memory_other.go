@@ -0,0 +1,13 @@
+//go:build !linux
+
+package commons
+
+import (
+	"errors"
+)
+
+// residentMemory returns the resident memory of the current process in
+// bytes.
+func residentMemory() (uint64, error) {
+	return 0, errors.New("resident memory is only supported on Linux")
+}
@@ -0,0 +1,22 @@
+//go:build linux
+
+package commons_test
+
+import (
+	"testing"
+
+	"github.com/klippa-app/go-pdfium/internal/commons"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResidentMemory(t *testing.T) {
+	for _, newClient := range []func(*testing.T, commons.Pdfium) *commons.PdfiumRPC{newTestClient, newTestGRPCClient} {
+		pdfium := newClient(t, &grpcImpl{})
+
+		residentMemory, err := pdfium.ResidentMemory()
+		require.NoError(t, err)
+		assert.Greater(t, residentMemory, uint64(1024*1024))
+	}
+}
@@ -1,12 +1,24 @@
 package commons
 
 import (
+	"context"
 	"net/rpc"
+	"os"
+	"sync"
+
+	"github.com/klippa-app/go-pdfium/references"
 
 	"github.com/hashicorp/go-plugin"
 )
 
-type PdfiumRPC struct{ client *rpc.Client }
+type PdfiumRPC struct {
+	client rpcClient
+	broker callbackBroker
+
+	// sharedMemory is set when large payloads are transferred through
+	// shared memory.
+	sharedMemory *SharedMemoryConfig
+}
 
 func (g *PdfiumRPC) Ping() (string, error) {
 	var resp string
@@ -27,8 +39,105 @@ func (g *PdfiumRPC) Close() error {
 	return nil
 }
 
+// usesGob returns whether the connection uses net/rpc, of which the gob
+// encoding loses the difference between nil and empty.
+func (g *PdfiumRPC) usesGob() bool {
+	_, ok := g.client.(*netRPCClient)
+	return ok
+}
+
+// withContext returns a copy of the client that makes its calls with the
+// given context.
+func (g *PdfiumRPC) withContext(ctx context.Context) *PdfiumRPC {
+	withContext := *g
+	withContext.client = g.client.withContext(ctx)
+	return &withContext
+}
+
+// EnableSharedMemory enables the transfer of large payloads through shared
+// memory for both sides of the connection.
+// Every connection gets its own directory in the configured directory, so
+// that the files that never reached the other side, like when the worker was
+// killed during a call, can be removed with RemoveSharedMemory.
+func (g *PdfiumRPC) EnableSharedMemory(config SharedMemoryConfig) error {
+	if err := checkSharedMemory(&config); err != nil {
+		return err
+	}
+
+	dir, err := os.MkdirTemp(config.Dir, "go-pdfium-worker-*")
+	if err != nil {
+		return err
+	}
+	config.Dir = dir
+
+	err = g.client.Call("Plugin.EnableSharedMemory", &config, new(interface{}))
+	if err != nil {
+		os.RemoveAll(dir)
+		return err
+	}
+
+	g.sharedMemory = &config
+	return nil
+}
+
+// RemoveSharedMemory removes the directory of the shared memory files of the
+// connection, with the files that are still in it. It must be called when the
+// worker has stopped.
+func (g *PdfiumRPC) RemoveSharedMemory() error {
+	if g.sharedMemory == nil {
+		return nil
+	}
+
+	return os.RemoveAll(g.sharedMemory.Dir)
+}
+
+// ResidentMemory returns the resident memory of the worker process in bytes.
+// Only supported by workers that run on Linux.
+func (g *PdfiumRPC) ResidentMemory() (uint64, error) {
+	var resp uint64
+	err := g.client.Call("Plugin.ResidentMemory", new(interface{}), &resp)
+	if err != nil {
+		return 0, err
+	}
+
+	return resp, nil
+}
+
 type PdfiumRPCServer struct {
-	Impl Pdfium
+	Impl   Pdfium
+	broker callbackBroker
+
+	// callbacksLock protects the state that is kept for callbacks into the
+	// host process.
+	callbacksLock *sync.Mutex
+
+	// formFillInfos keeps track of the form fill environments that call back
+	// into the host process, so that we can close the connection when the
+	// form fill environment exits.
+	formFillInfos map[references.FPDF_FORMHANDLE]*formFillInfoRPC
+
+	// formFillTimers keeps track of the PDFium timer callbacks that the host
+	// process can trigger.
+	formFillTimers map[string]func(idEvent int)
+
+	// dataAvails keeps track of the availability providers that read from and
+	// call back into the host process, so that we can close the connection
+	// when the availability provider is destroyed.
+	dataAvails map[references.FPDF_AVAIL]rpcClient
+
+	// documentReaders keeps track of the documents that read from a reader in
+	// the host process, so that we can close the connection when the document
+	// is closed.
+	documentReaders map[references.FPDF_DOCUMENT]rpcClient
+
+	// sharedMemory is set when large payloads are transferred through
+	// shared memory.
+	sharedMemory *SharedMemoryConfig
+
+	// documentMemory keeps track of the documents that were loaded from
+	// shared memory, so that we can release the memory when the document is
+	// closed.
+	documentMemory map[references.FPDF_DOCUMENT][]byte
 }
 
 func (s *PdfiumRPCServer) Ping(args interface{}, resp *string) error {
@@ -40,9 +149,32 @@ func (s *PdfiumRPCServer) Ping(args interface{}, resp *string) error {
 	return nil
 }
 
+func (s *PdfiumRPCServer) ResidentMemory(args interface{}, resp *uint64) error {
+	var err error
+	*resp, err = residentMemory()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *PdfiumRPCServer) EnableSharedMemory(config *SharedMemoryConfig, resp *interface{}) error {
+	if err := checkSharedMemory(config); err != nil {
+		return err
+	}
+
+	s.sharedMemory = config
+	return nil
+}
+
 func (s *PdfiumRPCServer) Close(args interface{}, resp *interface{}) error {
 	var err error
 	err = s.Impl.Close()
+
+	// Closing the instance also closes all form fill environments,
+	// availability providers and documents.
+	s.closeCallbacks()
+
 	if err != nil {
 		return err
 	}
@@ -53,10 +185,23 @@ type PdfiumPlugin struct {
 	Impl Pdfium
 }
 
-func (p *PdfiumPlugin) Server(*plugin.MuxBroker) (interface{}, error) {
-	return &PdfiumRPCServer{Impl: p.Impl}, nil
+func (p *PdfiumPlugin) Server(b *plugin.MuxBroker) (interface{}, error) {
+	return newPdfiumRPCServer(p.Impl, &netRPCBroker{broker: b}), nil
 }
 
 func (PdfiumPlugin) Client(b *plugin.MuxBroker, c *rpc.Client) (interface{}, error) {
-	return &PdfiumRPC{client: c}, nil
+	return &PdfiumRPC{client: &netRPCClient{client: c}, broker: &netRPCBroker{broker: b}}, nil
+}
+
+func newPdfiumRPCServer(impl Pdfium, broker callbackBroker) *PdfiumRPCServer {
+	return &PdfiumRPCServer{
+		Impl:            impl,
+		broker:          broker,
+		callbacksLock:   &sync.Mutex{},
+		formFillInfos:   map[references.FPDF_FORMHANDLE]*formFillInfoRPC{},
+		formFillTimers:  map[string]func(idEvent int){},
+		dataAvails:      map[references.FPDF_AVAIL]rpcClient{},
+		documentReaders: map[references.FPDF_DOCUMENT]rpcClient{},
+		documentMemory:  map[references.FPDF_DOCUMENT][]byte{},
+	}
 }
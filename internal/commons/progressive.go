@@ -0,0 +1,213 @@
+package commons
+
+import (
+	"fmt"
+
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+)
+
+// FPDF_RenderPageBitmapWithColorScheme_StartRequest is the version of
+// requests.FPDF_RenderPageBitmapWithColorScheme_Start that is sent to the
+// worker. The NeedToPauseNowCallback is not sent, but served by the host.
+type FPDF_RenderPageBitmapWithColorScheme_StartRequest struct {
+	Request        *requests.FPDF_RenderPageBitmapWithColorScheme_Start
+	CallbackServer uint32 // 0 when no NeedToPauseNowCallback was given.
+}
+
+// FPDF_RenderPageBitmap_StartRequest is the version of
+// requests.FPDF_RenderPageBitmap_Start that is sent to the worker.
+// The NeedToPauseNowCallback is not sent, but served by the host.
+type FPDF_RenderPageBitmap_StartRequest struct {
+	Request        *requests.FPDF_RenderPageBitmap_Start
+	CallbackServer uint32 // 0 when no NeedToPauseNowCallback was given.
+}
+
+// FPDF_RenderPage_ContinueRequest is the version of
+// requests.FPDF_RenderPage_Continue that is sent to the worker.
+// The NeedToPauseNowCallback is not sent, but served by the host.
+type FPDF_RenderPage_ContinueRequest struct {
+	Request        *requests.FPDF_RenderPage_Continue
+	CallbackServer uint32 // 0 when no NeedToPauseNowCallback was given.
+}
+
+// servePauseCallback serves the given NeedToPauseNowCallback to the worker
+// and returns the ID of the callback server, or 0 when there is no callback.
+func (g *PdfiumRPC) servePauseCallback(callback func() bool) uint32 {
+	if callback == nil {
+		return 0
+	}
+
+	return serveCallbacks(g.broker, &PauseRPCServer{Impl: callback})
+}
+
+func (g *PdfiumRPC) FPDF_RenderPageBitmapWithColorScheme_Start(request *requests.FPDF_RenderPageBitmapWithColorScheme_Start) (*responses.FPDF_RenderPageBitmapWithColorScheme_Start, error) {
+	resp := &responses.FPDF_RenderPageBitmapWithColorScheme_Start{}
+	err := g.client.Call("Plugin.FPDF_RenderPageBitmapWithColorScheme_Start", &FPDF_RenderPageBitmapWithColorScheme_StartRequest{
+		Request:        request,
+		CallbackServer: g.servePauseCallback(request.NeedToPauseNowCallback),
+	}, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (g *PdfiumRPC) FPDF_RenderPageBitmap_Start(request *requests.FPDF_RenderPageBitmap_Start) (*responses.FPDF_RenderPageBitmap_Start, error) {
+	resp := &responses.FPDF_RenderPageBitmap_Start{}
+	err := g.client.Call("Plugin.FPDF_RenderPageBitmap_Start", &FPDF_RenderPageBitmap_StartRequest{
+		Request:        request,
+		CallbackServer: g.servePauseCallback(request.NeedToPauseNowCallback),
+	}, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (g *PdfiumRPC) FPDF_RenderPage_Continue(request *requests.FPDF_RenderPage_Continue) (*responses.FPDF_RenderPage_Continue, error) {
+	resp := &responses.FPDF_RenderPage_Continue{}
+	err := g.client.Call("Plugin.FPDF_RenderPage_Continue", &FPDF_RenderPage_ContinueRequest{
+		Request:        request,
+		CallbackServer: g.servePauseCallback(request.NeedToPauseNowCallback),
+	}, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// pauseCallback connects to the NeedToPauseNowCallback that is served by the
+// host and returns a callback that calls it. The returned close function
+// must be called when PDFium is done with the callback.
+func (s *PdfiumRPCServer) pauseCallback(callbackServer uint32) (func() bool, func(), error) {
+	if callbackServer == 0 {
+		return nil, func() {}, nil
+	}
+
+	client, err := dialCallbacks(s.broker, callbackServer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not connect to pause callback: %w", err)
+	}
+
+	pause := &pauseRPC{client: client}
+	return pause.needToPauseNow, func() { client.Close() }, nil
+}
+
+func (s *PdfiumRPCServer) FPDF_RenderPageBitmapWithColorScheme_Start(request *FPDF_RenderPageBitmapWithColorScheme_StartRequest, resp *responses.FPDF_RenderPageBitmapWithColorScheme_Start) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPageBitmapWithColorScheme_Start", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	callback, closeCallback, err := s.pauseCallback(request.CallbackServer)
+	if err != nil {
+		return err
+	}
+	defer closeCallback()
+
+	request.Request.NeedToPauseNowCallback = callback
+	implResp, err := s.Impl.FPDF_RenderPageBitmapWithColorScheme_Start(request.Request)
+	if err != nil {
+		return err
+	}
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+func (s *PdfiumRPCServer) FPDF_RenderPageBitmap_Start(request *FPDF_RenderPageBitmap_StartRequest, resp *responses.FPDF_RenderPageBitmap_Start) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPageBitmap_Start", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	callback, closeCallback, err := s.pauseCallback(request.CallbackServer)
+	if err != nil {
+		return err
+	}
+	defer closeCallback()
+
+	request.Request.NeedToPauseNowCallback = callback
+	implResp, err := s.Impl.FPDF_RenderPageBitmap_Start(request.Request)
+	if err != nil {
+		return err
+	}
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+func (s *PdfiumRPCServer) FPDF_RenderPage_Continue(request *FPDF_RenderPage_ContinueRequest, resp *responses.FPDF_RenderPage_Continue) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPage_Continue", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	callback, closeCallback, err := s.pauseCallback(request.CallbackServer)
+	if err != nil {
+		return err
+	}
+	defer closeCallback()
+
+	request.Request.NeedToPauseNowCallback = callback
+	implResp, err := s.Impl.FPDF_RenderPage_Continue(request.Request)
+	if err != nil {
+		return err
+	}
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+// pauseRPC calls the NeedToPauseNowCallback in the host process.
+type pauseRPC struct {
+	client rpcClient
+}
+
+// needToPauseNow pauses the rendering when the host process can't be
+// reached, so that the worker never keeps rendering without the host being
+// able to stop it.
+func (p *pauseRPC) needToPauseNow() bool {
+	shouldPause := true
+	if err := p.client.Call("Plugin.NeedToPauseNow", new(interface{}), &shouldPause); err != nil {
+		return true
+	}
+
+	return shouldPause
+}
+
+// PauseRPCServer serves the NeedToPauseNowCallback of the host process to
+// the worker.
+type PauseRPCServer struct {
+	Impl func() bool
+}
+
+func (s *PauseRPCServer) NeedToPauseNow(args interface{}, resp *bool) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "NeedToPauseNow", panicError)
+		}
+	}()
+
+	*resp = s.Impl()
+	return nil
+}
@@ -0,0 +1,89 @@
+package commons_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/klippa-app/go-pdfium/enums"
+	"github.com/klippa-app/go-pdfium/internal/commons"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// progressiveImpl is a fake worker implementation that renders in steps and
+// asks the pause callback after every step, like PDFium would.
+type progressiveImpl struct {
+	commons.Pdfium
+	steps int
+}
+
+func (p *progressiveImpl) render(callback func() bool) enums.FPDF_RENDER_STATUS {
+	for p.steps < 3 {
+		p.steps++
+		if callback != nil && callback() {
+			return enums.FPDF_RENDER_STATUS_TOBECONTINUED
+		}
+	}
+
+	return enums.FPDF_RENDER_STATUS_DONE
+}
+
+func (p *progressiveImpl) FPDF_RenderPageBitmap_Start(request *requests.FPDF_RenderPageBitmap_Start) (*responses.FPDF_RenderPageBitmap_Start, error) {
+	if request.NeedToPauseNowCallback == nil {
+		return nil, errors.New("NeedToPauseNowCallback can't be nil")
+	}
+
+	return &responses.FPDF_RenderPageBitmap_Start{
+		RenderStatus: p.render(request.NeedToPauseNowCallback),
+	}, nil
+}
+
+func (p *progressiveImpl) FPDF_RenderPage_Continue(request *requests.FPDF_RenderPage_Continue) (*responses.FPDF_RenderPage_Continue, error) {
+	return &responses.FPDF_RenderPage_Continue{
+		RenderStatus: p.render(request.NeedToPauseNowCallback),
+	}, nil
+}
+
+func (p *progressiveImpl) Close() error {
+	return nil
+}
+
+func TestProgressiveRenderingPauseCallback(t *testing.T) {
+	impl := &progressiveImpl{}
+	pdfium := newTestClient(t, impl)
+
+	_, err := pdfium.FPDF_RenderPageBitmap_Start(&requests.FPDF_RenderPageBitmap_Start{})
+	assert.EqualError(t, err, "NeedToPauseNowCallback can't be nil")
+
+	pauseCalls := 0
+	start, err := pdfium.FPDF_RenderPageBitmap_Start(&requests.FPDF_RenderPageBitmap_Start{
+		Bitmap: "bitmap",
+		NeedToPauseNowCallback: func() bool {
+			pauseCalls++
+			return true
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, enums.FPDF_RENDER_STATUS_TOBECONTINUED, start.RenderStatus)
+	assert.Equal(t, 1, pauseCalls)
+	assert.Equal(t, 1, impl.steps)
+
+	continueResp, err := pdfium.FPDF_RenderPage_Continue(&requests.FPDF_RenderPage_Continue{
+		NeedToPauseNowCallback: func() bool {
+			pauseCalls++
+			return false
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, enums.FPDF_RENDER_STATUS_DONE, continueResp.RenderStatus)
+	assert.Equal(t, 3, pauseCalls)
+	assert.Equal(t, 3, impl.steps)
+
+	impl.steps = 0
+	continueResp, err = pdfium.FPDF_RenderPage_Continue(&requests.FPDF_RenderPage_Continue{})
+	require.NoError(t, err)
+	assert.Equal(t, enums.FPDF_RENDER_STATUS_DONE, continueResp.RenderStatus)
+}
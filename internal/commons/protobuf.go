@@ -0,0 +1,589 @@
+package commons
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sync"
+
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// protobufCodecName is the content subtype of the gRPC calls that use the
+// protobuf codec, both sides register the codec under this name.
+const protobufCodecName = "pdfium"
+
+func init() {
+	encoding.RegisterCodec(protobufCodec{})
+}
+
+// protobufCodec encodes the Go types of the requests and responses in the
+// protobuf wire format that is described in pdfium.proto. The field numbers
+// come from the generated protobufFields, fields that are not known are
+// skipped when decoding, so that workers and hosts of different versions can
+// talk to each other.
+//
+// The difference between nil and empty is kept: slices are sent in a wrapper
+// message or as bytes with presence, and pointers to scalars have presence.
+type protobufCodec struct{}
+
+func (protobufCodec) Name() string {
+	return protobufCodecName
+}
+
+func (protobufCodec) Marshal(v interface{}) ([]byte, error) {
+	return marshalProtobuf(v)
+}
+
+func (protobufCodec) Unmarshal(data []byte, v interface{}) error {
+	return unmarshalProtobuf(data, v)
+}
+
+// protobufStructField is a field of a struct that is sent over gRPC.
+type protobufStructField struct {
+	index  int
+	number protowire.Number
+}
+
+// protobufStruct contains the fields of a struct that are sent over gRPC.
+type protobufStruct struct {
+	fields   []protobufStructField
+	byNumber map[protowire.Number]int
+}
+
+var protobufStructs sync.Map
+
+// protobufStructInfo returns the fields of the given struct type that are
+// sent over gRPC.
+func protobufStructInfo(structType reflect.Type) (*protobufStruct, error) {
+	if info, ok := protobufStructs.Load(structType); ok {
+		return info.(*protobufStruct), nil
+	}
+
+	numbers, ok := protobufFields[structType.String()]
+	if !ok {
+		return nil, fmt.Errorf("type %s is not in the protobuf schema", structType)
+	}
+
+	info := &protobufStruct{
+		byNumber: map[protowire.Number]int{},
+	}
+
+	for i := 0; i < structType.NumField(); i++ {
+		number, ok := numbers[structType.Field(i).Name]
+		if !ok {
+			continue
+		}
+
+		info.fields = append(info.fields, protobufStructField{index: i, number: number})
+		info.byNumber[number] = i
+	}
+
+	protobufStructs.Store(structType, info)
+	return info, nil
+}
+
+// marshalProtobuf encodes the given value as protobuf message. Values that
+// aren't structs are encoded as field 1 of a wrapper message.
+func marshalProtobuf(v interface{}) ([]byte, error) {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return []byte{}, nil
+		}
+		value = value.Elem()
+	}
+
+	if value.Kind() == reflect.Struct {
+		return appendProtobufMessage([]byte{}, value)
+	}
+
+	return appendProtobufField([]byte{}, 1, value)
+}
+
+// unmarshalProtobuf decodes the given protobuf message into v, which must be
+// a pointer.
+func unmarshalProtobuf(data []byte, v interface{}) error {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return fmt.Errorf("can't unmarshal into %T", v)
+	}
+
+	value = value.Elem()
+	if value.Kind() == reflect.Interface {
+		// Used for empty arguments and replies.
+		return nil
+	}
+
+	if value.Kind() == reflect.Struct {
+		return decodeProtobufMessage(data, value)
+	}
+
+	for len(data) > 0 {
+		number, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		if number != 1 {
+			n = protowire.ConsumeFieldValue(number, wireType, data)
+		} else {
+			var err error
+			n, err = decodeProtobufField(data, wireType, value)
+			if err != nil {
+				return err
+			}
+		}
+
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+	}
+
+	return nil
+}
+
+func appendProtobufMessage(b []byte, value reflect.Value) ([]byte, error) {
+	info, err := protobufStructInfo(value.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range info.fields {
+		b, err = appendProtobufField(b, field.number, value.Field(field.index))
+		if err != nil {
+			return nil, fmt.Errorf("could not encode field %s of %s: %w", value.Type().Field(field.index).Name, value.Type(), err)
+		}
+	}
+
+	return b, nil
+}
+
+// appendProtobufField encodes the given value as field with the given
+// number. Zero scalars are skipped, like protobuf does.
+func appendProtobufField(b []byte, number protowire.Number, value reflect.Value) ([]byte, error) {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return b, nil
+		}
+
+		if value.Elem().Kind() == reflect.Struct {
+			return appendProtobufMessageField(b, number, value.Elem())
+		}
+
+		// Pointers to scalars have presence, so zero values are also sent.
+		return appendProtobufValue(b, number, value.Elem())
+	case reflect.Slice:
+		if value.IsNil() {
+			return b, nil
+		}
+	case reflect.Interface:
+		if value.IsNil() {
+			return b, nil
+		}
+
+		implementations, ok := protobufInterfaces[value.Type().String()]
+		if !ok {
+			return nil, fmt.Errorf("interface %s is not in the protobuf schema", value.Type())
+		}
+
+		implementationNumber, ok := implementations[value.Elem().Type()]
+		if !ok || value.Elem().Kind() != reflect.Ptr || value.Elem().IsNil() {
+			return nil, fmt.Errorf("type %s can't be sent as %s", value.Elem().Type(), value.Type())
+		}
+
+		message, err := appendProtobufMessageField([]byte{}, implementationNumber, value.Elem().Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		b = protowire.AppendTag(b, number, protowire.BytesType)
+		return protowire.AppendBytes(b, message), nil
+	case reflect.Struct:
+		return appendProtobufMessageField(b, number, value)
+	default:
+		if value.IsZero() {
+			return b, nil
+		}
+	}
+
+	return appendProtobufValue(b, number, value)
+}
+
+func appendProtobufMessageField(b []byte, number protowire.Number, value reflect.Value) ([]byte, error) {
+	message, err := appendProtobufMessage([]byte{}, value)
+	if err != nil {
+		return nil, err
+	}
+
+	b = protowire.AppendTag(b, number, protowire.BytesType)
+	return protowire.AppendBytes(b, message), nil
+}
+
+// appendProtobufValue encodes the given value, also when it's zero.
+func appendProtobufValue(b []byte, number protowire.Number, value reflect.Value) ([]byte, error) {
+	switch value.Kind() {
+	case reflect.Bool:
+		b = protowire.AppendTag(b, number, protowire.VarintType)
+		return protowire.AppendVarint(b, protowire.EncodeBool(value.Bool())), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		b = protowire.AppendTag(b, number, protowire.VarintType)
+		return protowire.AppendVarint(b, protowire.EncodeZigZag(value.Int())), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		b = protowire.AppendTag(b, number, protowire.VarintType)
+		return protowire.AppendVarint(b, value.Uint()), nil
+	case reflect.Float32:
+		b = protowire.AppendTag(b, number, protowire.Fixed32Type)
+		return protowire.AppendFixed32(b, math.Float32bits(float32(value.Float()))), nil
+	case reflect.Float64:
+		b = protowire.AppendTag(b, number, protowire.Fixed64Type)
+		return protowire.AppendFixed64(b, math.Float64bits(value.Float())), nil
+	case reflect.String:
+		b = protowire.AppendTag(b, number, protowire.BytesType)
+		return protowire.AppendString(b, value.String()), nil
+	case reflect.Struct:
+		return appendProtobufMessageField(b, number, value)
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			b = protowire.AppendTag(b, number, protowire.BytesType)
+			return protowire.AppendBytes(b, value.Bytes()), nil
+		}
+
+		list, err := appendProtobufList([]byte{}, value)
+		if err != nil {
+			return nil, err
+		}
+
+		b = protowire.AppendTag(b, number, protowire.BytesType)
+		return protowire.AppendBytes(b, list), nil
+	}
+
+	return nil, fmt.Errorf("type %s can't be sent over gRPC", value.Type())
+}
+
+// appendProtobufList encodes the elements of the given slice as field 1 of
+// the list wrapper message. Numbers are packed.
+func appendProtobufList(b []byte, value reflect.Value) ([]byte, error) {
+	elemType := value.Type().Elem()
+	if isPackedKind(elemType.Kind()) {
+		if value.Len() == 0 {
+			return b, nil
+		}
+
+		var packed []byte
+		for i := 0; i < value.Len(); i++ {
+			packed = appendPackedValue(packed, value.Index(i))
+		}
+
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		return protowire.AppendBytes(b, packed), nil
+	}
+
+	var err error
+	for i := 0; i < value.Len(); i++ {
+		elem := value.Index(i)
+		if elem.Kind() == reflect.Ptr {
+			// Nil elements can't be sent in a list, they are sent as empty
+			// messages.
+			if elem.IsNil() {
+				b = protowire.AppendTag(b, 1, protowire.BytesType)
+				b = protowire.AppendBytes(b, nil)
+				continue
+			}
+			elem = elem.Elem()
+		}
+
+		b, err = appendProtobufValue(b, 1, elem)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
+
+func isPackedKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func appendPackedValue(b []byte, value reflect.Value) []byte {
+	switch value.Kind() {
+	case reflect.Bool:
+		return protowire.AppendVarint(b, protowire.EncodeBool(value.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return protowire.AppendVarint(b, protowire.EncodeZigZag(value.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return protowire.AppendVarint(b, value.Uint())
+	case reflect.Float32:
+		return protowire.AppendFixed32(b, math.Float32bits(float32(value.Float())))
+	default:
+		return protowire.AppendFixed64(b, math.Float64bits(value.Float()))
+	}
+}
+
+func decodeProtobufMessage(data []byte, value reflect.Value) error {
+	info, err := protobufStructInfo(value.Type())
+	if err != nil {
+		return err
+	}
+
+	for len(data) > 0 {
+		number, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		fieldIndex, ok := info.byNumber[number]
+		if !ok {
+			// Skip fields that are unknown to this version.
+			n = protowire.ConsumeFieldValue(number, wireType, data)
+		} else {
+			n, err = decodeProtobufField(data, wireType, value.Field(fieldIndex))
+			if err != nil {
+				return fmt.Errorf("could not decode field %s of %s: %w", value.Type().Field(fieldIndex).Name, value.Type(), err)
+			}
+		}
+
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+	}
+
+	return nil
+}
+
+var errProtobufWireType = errors.New("unexpected wire type")
+
+// decodeProtobufField decodes a field value into the given value and returns
+// the amount of bytes that were consumed.
+func decodeProtobufField(data []byte, wireType protowire.Type, value reflect.Value) (int, error) {
+	switch value.Kind() {
+	case reflect.Ptr:
+		newValue := reflect.New(value.Type().Elem())
+		if !value.IsNil() {
+			newValue = value
+		}
+
+		n, err := decodeProtobufField(data, wireType, newValue.Elem())
+		if err != nil {
+			return 0, err
+		}
+
+		value.Set(newValue)
+		return n, nil
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return decodeProtobufNumber(data, wireType, value)
+	}
+
+	if wireType != protowire.BytesType {
+		return 0, errProtobufWireType
+	}
+
+	fieldData, n := protowire.ConsumeBytes(data)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(string(fieldData))
+	case reflect.Struct:
+		if err := decodeProtobufMessage(fieldData, value); err != nil {
+			return 0, err
+		}
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			// Copy the bytes, the buffer of the message can be reused.
+			value.SetBytes(append([]byte{}, fieldData...))
+			break
+		}
+
+		if err := decodeProtobufList(fieldData, value); err != nil {
+			return 0, err
+		}
+	case reflect.Interface:
+		if err := decodeProtobufInterface(fieldData, value); err != nil {
+			return 0, err
+		}
+	default:
+		return 0, fmt.Errorf("type %s can't be received over gRPC", value.Type())
+	}
+
+	return n, nil
+}
+
+func decodeProtobufNumber(data []byte, wireType protowire.Type, value reflect.Value) (int, error) {
+	switch value.Kind() {
+	case reflect.Float32:
+		if wireType != protowire.Fixed32Type {
+			return 0, errProtobufWireType
+		}
+
+		number, n := protowire.ConsumeFixed32(data)
+		if n < 0 {
+			return 0, protowire.ParseError(n)
+		}
+
+		value.SetFloat(float64(math.Float32frombits(number)))
+		return n, nil
+	case reflect.Float64:
+		if wireType != protowire.Fixed64Type {
+			return 0, errProtobufWireType
+		}
+
+		number, n := protowire.ConsumeFixed64(data)
+		if n < 0 {
+			return 0, protowire.ParseError(n)
+		}
+
+		value.SetFloat(math.Float64frombits(number))
+		return n, nil
+	}
+
+	if wireType != protowire.VarintType {
+		return 0, errProtobufWireType
+	}
+
+	number, n := protowire.ConsumeVarint(data)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+
+	switch value.Kind() {
+	case reflect.Bool:
+		value.SetBool(protowire.DecodeBool(number))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value.SetInt(protowire.DecodeZigZag(number))
+	default:
+		value.SetUint(number)
+	}
+
+	return n, nil
+}
+
+// decodeProtobufList decodes the list wrapper message into the given slice,
+// the slice is always set, also when the list is empty.
+func decodeProtobufList(data []byte, value reflect.Value) error {
+	if value.IsNil() {
+		value.Set(reflect.MakeSlice(value.Type(), 0, 0))
+	}
+
+	elemType := value.Type().Elem()
+	for len(data) > 0 {
+		number, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		if number != 1 {
+			n = protowire.ConsumeFieldValue(number, wireType, data)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			data = data[n:]
+			continue
+		}
+
+		if isPackedKind(elemType.Kind()) && wireType == protowire.BytesType {
+			packed, n := protowire.ConsumeBytes(data)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			data = data[n:]
+
+			packedWireType := protowire.VarintType
+			if elemType.Kind() == reflect.Float32 {
+				packedWireType = protowire.Fixed32Type
+			} else if elemType.Kind() == reflect.Float64 {
+				packedWireType = protowire.Fixed64Type
+			}
+
+			for len(packed) > 0 {
+				elem := reflect.New(elemType).Elem()
+				n, err := decodeProtobufNumber(packed, packedWireType, elem)
+				if err != nil {
+					return err
+				}
+				packed = packed[n:]
+				value.Set(reflect.Append(value, elem))
+			}
+			continue
+		}
+
+		elem := reflect.New(elemType).Elem()
+		n, err := decodeProtobufField(data, wireType, elem)
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+		value.Set(reflect.Append(value, elem))
+	}
+
+	return nil
+}
+
+// decodeProtobufInterface decodes the interface message, of which the field
+// number tells the concrete type.
+func decodeProtobufInterface(data []byte, value reflect.Value) error {
+	implementations, ok := protobufInterfaces[value.Type().String()]
+	if !ok {
+		return fmt.Errorf("interface %s is not in the protobuf schema", value.Type())
+	}
+
+	for len(data) > 0 {
+		number, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		var implementation reflect.Type
+		for implementationType, implementationNumber := range implementations {
+			if implementationNumber == number {
+				implementation = implementationType
+			}
+		}
+
+		// Skip types that are unknown to this version.
+		if implementation == nil || wireType != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(number, wireType, data)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			data = data[n:]
+			continue
+		}
+
+		message, n := protowire.ConsumeBytes(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		newValue := reflect.New(implementation.Elem())
+		if err := decodeProtobufMessage(message, newValue.Elem()); err != nil {
+			return err
+		}
+
+		value.Set(newValue)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package commons
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+type ReadBlockRequest struct {
+	Position int64
+	Size     int
+}
+
+// ReaderRPCServer serves an io.ReadSeeker of the host process to the worker,
+// so that the worker can read the blocks that PDFium needs, when it needs
+// them, instead of receiving the whole file.
+type ReaderRPCServer struct {
+	Reader io.ReadSeeker
+}
+
+// ReadBlock reads the requested block from the reader. When the reader ends
+// before the end of the block, only the available bytes are returned.
+func (s *ReaderRPCServer) ReadBlock(args *ReadBlockRequest, resp *[]byte) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "ReadBlock", panicError)
+		}
+	}()
+
+	if _, err := s.Reader.Seek(args.Position, io.SeekStart); err != nil {
+		return err
+	}
+
+	block := make([]byte, args.Size)
+	readBytes, err := io.ReadFull(s.Reader, block)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	*resp = block[:readBytes]
+	return nil
+}
+
+// Make sure remoteReader is an io.ReadSeeker.
+var _ io.ReadSeeker = &remoteReader{}
+
+// remoteReader is an io.ReadSeeker in the worker that reads from the reader
+// in the host process that is served by ReaderRPCServer.
+type remoteReader struct {
+	client rpcClient
+	size   int64
+	offset int64
+}
+
+func (r *remoteReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+
+	var block []byte
+	err := r.client.Call("Plugin.ReadBlock", &ReadBlockRequest{
+		Position: r.offset,
+		Size:     len(p),
+	}, &block)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(block) == 0 {
+		return 0, io.EOF
+	}
+
+	readBytes := copy(p, block)
+	r.offset += int64(readBytes)
+	return readBytes, nil
+}
+
+func (r *remoteReader) Seek(offset int64, whence int) (int64, error) {
+	var newOffset int64
+	switch whence {
+	case io.SeekStart:
+		newOffset = offset
+	case io.SeekCurrent:
+		newOffset = r.offset + offset
+	case io.SeekEnd:
+		newOffset = r.size + offset
+	default:
+		return 0, errors.New("invalid whence")
+	}
+
+	if newOffset < 0 {
+		return 0, errors.New("negative position")
+	}
+
+	r.offset = newOffset
+	return newOffset, nil
+}
@@ -0,0 +1,403 @@
+package commons
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+
+	"google.golang.org/grpc"
+)
+
+// The render methods are implemented by hand so that the rendered image can
+// be transferred through shared memory when that has been enabled.
+
+func (g *PdfiumRPC) RenderPageInDPI(request *requests.RenderPageInDPI) (*responses.RenderPageInDPI, error) {
+	resp := &responses.RenderPageInDPI{}
+	err := g.client.Call("Plugin.RenderPageInDPI", request, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.CleanupFunc, err = receiveRenderPage(&resp.Result)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (g *PdfiumRPC) RenderPageInPixels(request *requests.RenderPageInPixels) (*responses.RenderPageInPixels, error) {
+	resp := &responses.RenderPageInPixels{}
+	err := g.client.Call("Plugin.RenderPageInPixels", request, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.CleanupFunc, err = receiveRenderPage(&resp.Result)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (g *PdfiumRPC) RenderPagesInDPI(request *requests.RenderPagesInDPI) (*responses.RenderPagesInDPI, error) {
+	resp := &responses.RenderPagesInDPI{}
+	err := g.client.Call("Plugin.RenderPagesInDPI", request, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.CleanupFunc, err = receiveRenderPages(&resp.Result)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (g *PdfiumRPC) RenderPagesInPixels(request *requests.RenderPagesInPixels) (*responses.RenderPagesInPixels, error) {
+	resp := &responses.RenderPagesInPixels{}
+	err := g.client.Call("Plugin.RenderPagesInPixels", request, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.CleanupFunc, err = receiveRenderPages(&resp.Result)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (g *PdfiumRPC) RenderPageRegion(request *requests.RenderPageRegion) (*responses.RenderPageRegion, error) {
+	resp := &responses.RenderPageRegion{}
+	err := g.client.Call("Plugin.RenderPageRegion", request, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.CleanupFunc, err = receiveRenderPage(&resp.Result)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// receiveRenderPage maps the rendered image when it has been transferred
+// through shared memory. It returns the cleanup function of the image, nil
+// when the image was transferred over RPC.
+func receiveRenderPage(result *responses.RenderPage) (func(), error) {
+	renderedImage, cleanup, err := receiveImage(result.RenderedImage)
+	if err != nil {
+		return nil, err
+	}
+
+	result.RenderedImage = renderedImage
+	if rgbaImage, ok := renderedImage.(*image.RGBA); ok {
+		result.Image = rgbaImage
+	}
+
+	return cleanup, nil
+}
+
+// receiveRenderPages maps the rendered image when it has been transferred
+// through shared memory. It returns the cleanup function of the image, nil
+// when the image was transferred over RPC.
+func receiveRenderPages(result *responses.RenderPages) (func(), error) {
+	renderedImage, cleanup, err := receiveImage(result.RenderedImage)
+	if err != nil {
+		return nil, err
+	}
+
+	result.RenderedImage = renderedImage
+	if rgbaImage, ok := renderedImage.(*image.RGBA); ok {
+		result.Image = rgbaImage
+	}
+
+	return cleanup, nil
+}
+
+func (s *PdfiumRPCServer) RenderPageInDPI(request *requests.RenderPageInDPI, resp *responses.RenderPageInDPI) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "RenderPageInDPI", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	implResp, err := s.Impl.RenderPageInDPI(request)
+	if err != nil {
+		return err
+	}
+
+	s.shareRenderPage(&implResp.Result)
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+func (s *PdfiumRPCServer) RenderPageInPixels(request *requests.RenderPageInPixels, resp *responses.RenderPageInPixels) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "RenderPageInPixels", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	implResp, err := s.Impl.RenderPageInPixels(request)
+	if err != nil {
+		return err
+	}
+
+	s.shareRenderPage(&implResp.Result)
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+func (s *PdfiumRPCServer) RenderPagesInDPI(request *requests.RenderPagesInDPI, resp *responses.RenderPagesInDPI) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "RenderPagesInDPI", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	implResp, err := s.Impl.RenderPagesInDPI(request)
+	if err != nil {
+		return err
+	}
+
+	s.shareRenderPages(&implResp.Result)
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+func (s *PdfiumRPCServer) RenderPagesInPixels(request *requests.RenderPagesInPixels, resp *responses.RenderPagesInPixels) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "RenderPagesInPixels", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	implResp, err := s.Impl.RenderPagesInPixels(request)
+	if err != nil {
+		return err
+	}
+
+	s.shareRenderPages(&implResp.Result)
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+func (s *PdfiumRPCServer) RenderPageRegion(request *requests.RenderPageRegion, resp *responses.RenderPageRegion) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "RenderPageRegion", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	implResp, err := s.Impl.RenderPageRegion(request)
+	if err != nil {
+		return err
+	}
+
+	s.shareRenderPage(&implResp.Result)
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+// shareRenderPage moves the rendered image to shared memory when possible.
+// The deprecated Image field is always cleared, so that the image is not
+// sent twice, the host process restores it from RenderedImage.
+func (s *PdfiumRPCServer) shareRenderPage(result *responses.RenderPage) {
+	result.RenderedImage = s.shareImage(result.RenderedImage)
+	result.Image = nil
+}
+
+// shareRenderPages moves the rendered image to shared memory when possible.
+// The deprecated Image field is always cleared, so that the image is not
+// sent twice, the host process restores it from RenderedImage.
+func (s *PdfiumRPCServer) shareRenderPages(result *responses.RenderPages) {
+	result.RenderedImage = s.shareImage(result.RenderedImage)
+	result.Image = nil
+}
+
+// RenderPagesStreamRequest is the request of the RenderPagesStream method of
+// the gRPC transport. Only one of the fields is set.
+type RenderPagesStreamRequest struct {
+	DPI    *requests.RenderPagesInDPI
+	Pixels *requests.RenderPagesInPixels
+}
+
+// RenderPagesInDPIStream renders the given pages one by one, and calls the
+// callback for every page once it has been rendered. The padding of the
+// request is not used. Over gRPC the worker streams the pages while it's
+// rendering them, over net/rpc every page is a separate call.
+// Rendering stops when the callback returns an error.
+func (g *PdfiumRPC) RenderPagesInDPIStream(request *requests.RenderPagesInDPI, callback func(*responses.RenderPageInDPI) error) error {
+	client, ok := g.client.(*grpcClient)
+	if !ok {
+		for i := range request.Pages {
+			resp, err := g.RenderPageInDPI(&request.Pages[i])
+			if err != nil {
+				return err
+			}
+
+			if err := callback(resp); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return client.renderPagesStream(&RenderPagesStreamRequest{DPI: request}, func(result responses.RenderPage, cleanup func()) error {
+		return callback(&responses.RenderPageInDPI{Result: result, CleanupFunc: cleanup})
+	})
+}
+
+// RenderPagesInPixelsStream renders the given pages one by one, and calls
+// the callback for every page once it has been rendered. The padding of the
+// request is not used. Over gRPC the worker streams the pages while it's
+// rendering them, over net/rpc every page is a separate call.
+// Rendering stops when the callback returns an error.
+func (g *PdfiumRPC) RenderPagesInPixelsStream(request *requests.RenderPagesInPixels, callback func(*responses.RenderPageInPixels) error) error {
+	client, ok := g.client.(*grpcClient)
+	if !ok {
+		for i := range request.Pages {
+			resp, err := g.RenderPageInPixels(&request.Pages[i])
+			if err != nil {
+				return err
+			}
+
+			if err := callback(resp); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return client.renderPagesStream(&RenderPagesStreamRequest{Pixels: request}, func(result responses.RenderPage, cleanup func()) error {
+		return callback(&responses.RenderPageInPixels{Result: result, CleanupFunc: cleanup})
+	})
+}
+
+func (c *grpcClient) renderPagesStream(request *RenderPagesStreamRequest, callback func(result responses.RenderPage, cleanup func()) error) error {
+	// Cancelling the context stops the rendering in the worker.
+	ctx, cancel := context.WithCancel(c.context())
+	defer cancel()
+
+	stream, err := c.conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, "/"+c.service+"/RenderPagesStream", grpc.CallContentSubtype(protobufCodecName))
+	if err != nil {
+		return grpcError(err)
+	}
+
+	if err := stream.SendMsg(request); err != nil {
+		return grpcError(err)
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		return grpcError(err)
+	}
+
+	for {
+		result := responses.RenderPage{}
+		err := stream.RecvMsg(&result)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return grpcError(err)
+		}
+
+		cleanup, err := receiveRenderPage(&result)
+		if err != nil {
+			return err
+		}
+
+		if err := callback(result, cleanup); err != nil {
+			return err
+		}
+	}
+}
+
+func renderPagesStreamHandler(srv interface{}, stream grpc.ServerStream) error {
+	request := &RenderPagesStreamRequest{}
+	if err := stream.RecvMsg(request); err != nil {
+		return err
+	}
+
+	return srv.(*PdfiumRPCServer).renderPagesStream(incomingContext(stream.Context()), request, func(result *responses.RenderPage) error {
+		return stream.SendMsg(result)
+	})
+}
+
+// renderPagesStream renders the pages of the request one by one, and sends
+// every page once it has been rendered.
+func (s *PdfiumRPCServer) renderPagesStream(ctx context.Context, request *RenderPagesStreamRequest, send func(*responses.RenderPage) error) error {
+	if request.DPI != nil {
+		for i := range request.DPI.Pages {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			resp := &responses.RenderPageInDPI{}
+			if err := s.RenderPageInDPI(&request.DPI.Pages[i], resp); err != nil {
+				return err
+			}
+
+			if err := send(&resp.Result); err != nil {
+				return err
+			}
+		}
+	}
+
+	if request.Pixels != nil {
+		for i := range request.Pixels.Pages {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			resp := &responses.RenderPageInPixels{}
+			if err := s.RenderPageInPixels(&request.Pixels.Pages[i], resp); err != nil {
+				return err
+			}
+
+			if err := send(&resp.Result); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
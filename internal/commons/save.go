@@ -0,0 +1,175 @@
+package commons
+
+import (
+	"bufio"
+	"fmt"
+
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+)
+
+// FPDF_SaveAsCopyRequest is the version of requests.FPDF_SaveAsCopy that is
+// sent to the worker. The FileWriter is not sent, but served by the host
+// process.
+type FPDF_SaveAsCopyRequest struct {
+	Request      *requests.FPDF_SaveAsCopy
+	WriterServer uint32 // 0 when no FileWriter was given.
+}
+
+// FPDF_SaveWithVersionRequest is the version of
+// requests.FPDF_SaveWithVersion that is sent to the worker. The FileWriter
+// is not sent, but served by the host process.
+type FPDF_SaveWithVersionRequest struct {
+	Request      *requests.FPDF_SaveWithVersion
+	WriterServer uint32 // 0 when no FileWriter was given.
+}
+
+func (g *PdfiumRPC) FPDF_SaveAsCopy(request *requests.FPDF_SaveAsCopy) (*responses.FPDF_SaveAsCopy, error) {
+	rpcRequest := &FPDF_SaveAsCopyRequest{
+		Request: request,
+	}
+
+	if request.FileWriter != nil {
+		rpcRequest.WriterServer = serveCallbacks(g.broker, &WriterRPCServer{
+			Writer: request.FileWriter,
+		})
+
+		// The writer can't be sent over RPC. Copy the request so that we
+		// don't modify the request of the user.
+		requestWithoutWriter := *request
+		requestWithoutWriter.FileWriter = nil
+		rpcRequest.Request = &requestWithoutWriter
+	}
+
+	resp := &responses.FPDF_SaveAsCopy{}
+	err := g.client.Call("Plugin.FPDF_SaveAsCopy", rpcRequest, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (g *PdfiumRPC) FPDF_SaveWithVersion(request *requests.FPDF_SaveWithVersion) (*responses.FPDF_SaveWithVersion, error) {
+	rpcRequest := &FPDF_SaveWithVersionRequest{
+		Request: request,
+	}
+
+	if request.FileWriter != nil {
+		rpcRequest.WriterServer = serveCallbacks(g.broker, &WriterRPCServer{
+			Writer: request.FileWriter,
+		})
+
+		// The writer can't be sent over RPC. Copy the request so that we
+		// don't modify the request of the user.
+		requestWithoutWriter := *request
+		requestWithoutWriter.FileWriter = nil
+		rpcRequest.Request = &requestWithoutWriter
+	}
+
+	resp := &responses.FPDF_SaveWithVersion{}
+	err := g.client.Call("Plugin.FPDF_SaveWithVersion", rpcRequest, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// fileWriter connects to the writer that is served by the host process.
+// The writes of PDFium are collected in chunks before they are sent to the
+// host process, so the writer must be flushed when PDFium is done with it.
+func (s *PdfiumRPCServer) fileWriter(writerServer uint32) (*bufio.Writer, rpcClient, error) {
+	client, err := dialCallbacks(s.broker, writerServer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not connect to file writer: %w", err)
+	}
+
+	return bufio.NewWriterSize(&remoteWriter{client: client}, writerChunkSize), client, nil
+}
+
+func (s *PdfiumRPCServer) FPDF_SaveAsCopy(request *FPDF_SaveAsCopyRequest, resp *responses.FPDF_SaveAsCopy) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SaveAsCopy", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	if request.WriterServer == 0 {
+		implResp, err := s.Impl.FPDF_SaveAsCopy(request.Request)
+		if err != nil {
+			return err
+		}
+
+		// Overwrite the target address of resp to the target address of implResp.
+		*resp = *implResp
+
+		return nil
+	}
+
+	writer, client, err := s.fileWriter(request.WriterServer)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	request.Request.FileWriter = writer
+	implResp, err := s.Impl.FPDF_SaveAsCopy(request.Request)
+	if err != nil {
+		return err
+	}
+
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("could not write to file writer: %w", err)
+	}
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
+
+func (s *PdfiumRPCServer) FPDF_SaveWithVersion(request *FPDF_SaveWithVersionRequest, resp *responses.FPDF_SaveWithVersion) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SaveWithVersion", panicError)
+		}
+
+		err = encodeError(err)
+	}()
+
+	if request.WriterServer == 0 {
+		implResp, err := s.Impl.FPDF_SaveWithVersion(request.Request)
+		if err != nil {
+			return err
+		}
+
+		// Overwrite the target address of resp to the target address of implResp.
+		*resp = *implResp
+
+		return nil
+	}
+
+	writer, client, err := s.fileWriter(request.WriterServer)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	request.Request.FileWriter = writer
+	implResp, err := s.Impl.FPDF_SaveWithVersion(request.Request)
+	if err != nil {
+		return err
+	}
+
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("could not write to file writer: %w", err)
+	}
+
+	// Overwrite the target address of resp to the target address of implResp.
+	*resp = *implResp
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package commons_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/klippa-app/go-pdfium/internal/commons"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// saveImpl is a fake worker implementation that writes the document in a lot
+// of small blocks, like PDFium would.
+type saveImpl struct {
+	commons.Pdfium
+}
+
+func (s *saveImpl) FPDF_SaveAsCopy(request *requests.FPDF_SaveAsCopy) (*responses.FPDF_SaveAsCopy, error) {
+	if request.FileWriter == nil {
+		fileBytes := []byte("document")
+		return &responses.FPDF_SaveAsCopy{FileBytes: &fileBytes}, nil
+	}
+
+	for i := 0; i < 100000; i++ {
+		if _, err := request.FileWriter.Write([]byte("0123456789")); err != nil {
+			return nil, errors.New("save of document failed")
+		}
+	}
+
+	return &responses.FPDF_SaveAsCopy{}, nil
+}
+
+func (s *saveImpl) Close() error {
+	return nil
+}
+
+// recordingWriter records the writes that are done on it.
+type recordingWriter struct {
+	bytes.Buffer
+	writes int
+	err    error
+}
+
+func (r *recordingWriter) Write(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	r.writes++
+	return r.Buffer.Write(p)
+}
+
+func TestSaveAsCopyFileWriter(t *testing.T) {
+	pdfium := newTestClient(t, &saveImpl{})
+
+	writer := &recordingWriter{}
+	resp, err := pdfium.FPDF_SaveAsCopy(&requests.FPDF_SaveAsCopy{
+		Document:   "document",
+		FileWriter: writer,
+	})
+	require.NoError(t, err)
+	assert.Nil(t, resp.FileBytes)
+	assert.Equal(t, bytes.Repeat([]byte("0123456789"), 100000), writer.Bytes())
+	assert.Equal(t, 1, writer.writes, "the small blocks should be sent in chunks")
+
+	resp, err = pdfium.FPDF_SaveAsCopy(&requests.FPDF_SaveAsCopy{
+		Document: "document",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("document"), *resp.FileBytes)
+}
+
+func TestSaveAsCopyFileWriterError(t *testing.T) {
+	pdfium := newTestClient(t, &saveImpl{})
+
+	resp, err := pdfium.FPDF_SaveAsCopy(&requests.FPDF_SaveAsCopy{
+		Document:   "document",
+		FileWriter: &recordingWriter{err: errors.New("disk full")},
+	})
+	assert.Nil(t, resp)
+	assert.ErrorContains(t, err, "disk full")
+}
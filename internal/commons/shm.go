@@ -0,0 +1,160 @@
+package commons
+
+import (
+	"encoding/gob"
+	"image"
+	"image/color"
+	"os"
+)
+
+func init() {
+	// SharedImage is sent in the RenderedImage field of render responses.
+	gob.Register(&SharedImage{})
+}
+
+// SharedMemoryConfig configures the transfer of large payloads between the
+// host process and the worker through shared memory files.
+type SharedMemoryConfig struct {
+	Dir       string // The directory to create the shared memory files in, this should be a tmpfs like /dev/shm.
+	Threshold int    // Payloads smaller than this amount of bytes are sent over RPC.
+
+	// LogCallback is called by the host process when a payload is sent over
+	// RPC because it couldn't be written to shared memory. It's not sent to
+	// the worker.
+	LogCallback func(string)
+}
+
+// SharedMemoryFile refers to a payload that has been written to a shared
+// memory file. The receiving side maps the file and removes it.
+type SharedMemoryFile struct {
+	Path string
+	Size int
+}
+
+// SharedImage is sent instead of a rendered image when the pixels have been
+// written to shared memory. It only implements image.Image so that it can be
+// sent in the RenderedImage field, the host process replaces it with the
+// real image before the response is returned to the user.
+type SharedImage struct {
+	File   SharedMemoryFile
+	Rect   image.Rectangle
+	Stride int
+	Gray   bool
+}
+
+func (s *SharedImage) ColorModel() color.Model {
+	if s.Gray {
+		return color.GrayModel
+	}
+	return color.RGBAModel
+}
+
+func (s *SharedImage) Bounds() image.Rectangle {
+	return s.Rect
+}
+
+func (s *SharedImage) At(x, y int) color.Color {
+	return color.Transparent
+}
+
+// writeSharedMemory writes the given data to a new shared memory file.
+func writeSharedMemory(config *SharedMemoryConfig, data []byte) (*SharedMemoryFile, error) {
+	file, err := os.CreateTemp(config.Dir, "go-pdfium-*")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, err
+	}
+
+	if err := file.Close(); err != nil {
+		os.Remove(file.Name())
+		return nil, err
+	}
+
+	return &SharedMemoryFile{
+		Path: file.Name(),
+		Size: len(data),
+	}, nil
+}
+
+// readSharedMemory maps the given shared memory file and removes it, the
+// mapping stays valid until unmapSharedMemory is called. Changes to the
+// mapping are private to this process.
+func readSharedMemory(sharedFile *SharedMemoryFile) ([]byte, error) {
+	defer os.Remove(sharedFile.Path)
+	return mapSharedMemory(sharedFile)
+}
+
+// shareImage writes the pixels of the given image to shared memory when the
+// image is large enough. The image is returned unchanged when it's not
+// possible to share it, so that it will be sent over RPC.
+func (s *PdfiumRPCServer) shareImage(img image.Image) image.Image {
+	if s.sharedMemory == nil {
+		return img
+	}
+
+	sharedImage := &SharedImage{}
+	var pix []byte
+	switch typedImage := img.(type) {
+	case *image.RGBA:
+		pix = typedImage.Pix
+		sharedImage.Rect = typedImage.Rect
+		sharedImage.Stride = typedImage.Stride
+	case *image.Gray:
+		pix = typedImage.Pix
+		sharedImage.Rect = typedImage.Rect
+		sharedImage.Stride = typedImage.Stride
+		sharedImage.Gray = true
+	default:
+		return img
+	}
+
+	if len(pix) == 0 || len(pix) < s.sharedMemory.Threshold {
+		return img
+	}
+
+	sharedFile, err := writeSharedMemory(s.sharedMemory, pix)
+	if err != nil {
+		return img
+	}
+
+	sharedImage.File = *sharedFile
+	return sharedImage
+}
+
+// receiveImage replaces a SharedImage with an image that is backed by the
+// shared memory. The returned cleanup function releases the shared memory,
+// the image can't be used after that.
+func receiveImage(img image.Image) (image.Image, func(), error) {
+	sharedImage, ok := img.(*SharedImage)
+	if !ok {
+		return img, nil, nil
+	}
+
+	pix, err := readSharedMemory(&sharedImage.File)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cleanup := func() {
+		unmapSharedMemory(pix)
+	}
+
+	if sharedImage.Gray {
+		return &image.Gray{
+			Pix:    pix,
+			Stride: sharedImage.Stride,
+			Rect:   sharedImage.Rect,
+		}, cleanup, nil
+	}
+
+	return &image.RGBA{
+		Pix:    pix,
+		Stride: sharedImage.Stride,
+		Rect:   sharedImage.Rect,
+	}, cleanup, nil
+}
@@ -0,0 +1,32 @@
+//go:build linux
+
+package commons
+
+import (
+	"os"
+	"syscall"
+)
+
+// checkSharedMemory returns an error when shared memory can't be used.
+func checkSharedMemory(config *SharedMemoryConfig) error {
+	_, err := os.Stat(config.Dir)
+	return err
+}
+
+// mapSharedMemory maps the given shared memory file into memory.
+func mapSharedMemory(sharedFile *SharedMemoryFile) ([]byte, error) {
+	file, err := os.Open(sharedFile.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	// The mapping stays valid after closing the file.
+	defer file.Close()
+
+	return syscall.Mmap(int(file.Fd()), 0, sharedFile.Size, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_PRIVATE)
+}
+
+// unmapSharedMemory releases memory that was mapped by mapSharedMemory.
+func unmapSharedMemory(data []byte) error {
+	return syscall.Munmap(data)
+}
@@ -0,0 +1,22 @@
+//go:build !linux
+
+package commons
+
+import (
+	"errors"
+)
+
+// checkSharedMemory returns an error when shared memory can't be used.
+func checkSharedMemory(config *SharedMemoryConfig) error {
+	return errors.New("shared memory is only supported on Linux")
+}
+
+// mapSharedMemory maps the given shared memory file into memory.
+func mapSharedMemory(sharedFile *SharedMemoryFile) ([]byte, error) {
+	return nil, errors.New("shared memory is only supported on Linux")
+}
+
+// unmapSharedMemory releases memory that was mapped by mapSharedMemory.
+func unmapSharedMemory(data []byte) error {
+	return errors.New("shared memory is only supported on Linux")
+}
@@ -0,0 +1,181 @@
+//go:build linux
+
+package commons_test
+
+import (
+	"bytes"
+	"image"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klippa-app/go-pdfium/internal/commons"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sharedMemoryImpl is a fake worker implementation that renders a fixed
+// image and remembers the file data of the opened document.
+type sharedMemoryImpl struct {
+	commons.Pdfium
+	image    image.Image
+	fileData []byte
+}
+
+func (s *sharedMemoryImpl) OpenDocument(request *requests.OpenDocument) (*responses.OpenDocument, error) {
+	s.fileData = append([]byte{}, *request.File...)
+	return &responses.OpenDocument{Document: "file"}, nil
+}
+
+func (s *sharedMemoryImpl) FPDF_CloseDocument(request *requests.FPDF_CloseDocument) (*responses.FPDF_CloseDocument, error) {
+	return &responses.FPDF_CloseDocument{}, nil
+}
+
+func (s *sharedMemoryImpl) RenderPageInDPI(request *requests.RenderPageInDPI) (*responses.RenderPageInDPI, error) {
+	result := responses.RenderPage{
+		RenderedImage: s.image,
+		Width:         s.image.Bounds().Dx(),
+		Height:        s.image.Bounds().Dy(),
+	}
+	if rgbaImage, ok := s.image.(*image.RGBA); ok {
+		result.Image = rgbaImage
+	}
+
+	return &responses.RenderPageInDPI{Result: result}, nil
+}
+
+func (s *sharedMemoryImpl) Close() error {
+	return nil
+}
+
+func TestSharedMemoryRenderPage(t *testing.T) {
+	for _, gray := range []bool{false, true} {
+		impl := &sharedMemoryImpl{image: testImage(gray)}
+		pdfium := newTestClient(t, impl)
+
+		dir := t.TempDir()
+		err := pdfium.EnableSharedMemory(commons.SharedMemoryConfig{Dir: dir, Threshold: 1024})
+		require.NoError(t, err)
+
+		resp, err := pdfium.RenderPageInDPI(&requests.RenderPageInDPI{DPI: 72})
+		require.NoError(t, err)
+		require.NotNil(t, resp.CleanupFunc, "image should be transferred through shared memory")
+
+		assert.Equal(t, impl.image, resp.Result.RenderedImage)
+		if gray {
+			assert.Nil(t, resp.Result.Image)
+		} else {
+			assert.Equal(t, impl.image, resp.Result.Image)
+		}
+
+		// The shared memory file is removed once it has been mapped.
+		assert.Empty(t, sharedMemoryFiles(t, dir))
+
+		resp.Cleanup()
+	}
+}
+
+func TestSharedMemoryRenderPageBelowThreshold(t *testing.T) {
+	impl := &sharedMemoryImpl{image: testImage(false)}
+	pdfium := newTestClient(t, impl)
+
+	err := pdfium.EnableSharedMemory(commons.SharedMemoryConfig{Dir: t.TempDir(), Threshold: 1024 * 1024})
+	require.NoError(t, err)
+
+	resp, err := pdfium.RenderPageInDPI(&requests.RenderPageInDPI{DPI: 72})
+	require.NoError(t, err)
+	assert.Nil(t, resp.CleanupFunc, "image should be transferred over RPC")
+	assert.Equal(t, impl.image, resp.Result.RenderedImage)
+	assert.Equal(t, impl.image, resp.Result.Image)
+}
+
+func TestSharedMemoryOpenDocument(t *testing.T) {
+	impl := &sharedMemoryImpl{}
+	pdfium := newTestClient(t, impl)
+
+	dir := t.TempDir()
+	err := pdfium.EnableSharedMemory(commons.SharedMemoryConfig{Dir: dir, Threshold: 1024})
+	require.NoError(t, err)
+
+	fileData := bytes.Repeat([]byte("0123456789"), 1000)
+	request := &requests.OpenDocument{File: &fileData}
+	doc, err := pdfium.OpenDocument(request)
+	require.NoError(t, err)
+	assert.Equal(t, fileData, impl.fileData)
+	assert.Same(t, &fileData, request.File, "the request of the user should not be modified")
+
+	assert.Empty(t, sharedMemoryFiles(t, dir))
+
+	_, err = pdfium.FPDF_CloseDocument(&requests.FPDF_CloseDocument{Document: doc.Document})
+	require.NoError(t, err)
+}
+
+func TestSharedMemoryOpenDocumentFallback(t *testing.T) {
+	impl := &sharedMemoryImpl{}
+	pdfium := newTestClient(t, impl)
+
+	dir := filepath.Join(t.TempDir(), "shm")
+	require.NoError(t, os.Mkdir(dir, 0700))
+
+	var logs []string
+	err := pdfium.EnableSharedMemory(commons.SharedMemoryConfig{
+		Dir:         dir,
+		Threshold:   1024,
+		LogCallback: func(s string) { logs = append(logs, s) },
+	})
+	require.NoError(t, err)
+
+	// The file can't be written to shared memory anymore, it's sent over
+	// RPC instead.
+	require.NoError(t, os.RemoveAll(dir))
+
+	fileData := bytes.Repeat([]byte("0123456789"), 1000)
+	doc, err := pdfium.OpenDocument(&requests.OpenDocument{File: &fileData})
+	require.NoError(t, err)
+	assert.Equal(t, fileData, impl.fileData)
+	require.Len(t, logs, 1)
+	assert.Contains(t, logs[0], "Could not write file to shared memory")
+
+	_, err = pdfium.FPDF_CloseDocument(&requests.FPDF_CloseDocument{Document: doc.Document})
+	require.NoError(t, err)
+}
+
+func TestRemoveSharedMemory(t *testing.T) {
+	pdfium := newTestClient(t, &sharedMemoryImpl{})
+
+	dir := t.TempDir()
+	err := pdfium.EnableSharedMemory(commons.SharedMemoryConfig{Dir: dir, Threshold: 1024})
+	require.NoError(t, err)
+
+	// The connection writes its files in its own directory.
+	connectionDirs, err := filepath.Glob(filepath.Join(dir, "go-pdfium-worker-*"))
+	require.NoError(t, err)
+	require.Len(t, connectionDirs, 1)
+
+	// A file of which the response never reached the other side.
+	require.NoError(t, os.WriteFile(filepath.Join(connectionDirs[0], "go-pdfium-1"), []byte("pixels"), 0600))
+
+	require.NoError(t, pdfium.RemoveSharedMemory())
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestSharedMemoryInvalidDir(t *testing.T) {
+	pdfium := newTestClient(t, &sharedMemoryImpl{})
+
+	err := pdfium.EnableSharedMemory(commons.SharedMemoryConfig{Dir: "/does/not/exist"})
+	assert.Error(t, err)
+}
+
+// sharedMemoryFiles returns the shared memory files in the directories of the
+// connections in the given directory.
+func sharedMemoryFiles(t *testing.T, dir string) []string {
+	files, err := filepath.Glob(filepath.Join(dir, "*", "*"))
+	require.NoError(t, err)
+	return files
+}
@@ -0,0 +1,56 @@
+package commons
+
+import (
+	"fmt"
+	"io"
+)
+
+// writerChunkSize is the amount of bytes that the worker collects before it
+// sends them to the writer in the host process. PDFium writes in a lot of
+// small blocks, sending each of them separately would be very slow.
+const writerChunkSize = 1024 * 1024
+
+// WriterRPCServer serves an io.Writer of the host process to the worker, so
+// that the worker can write the output of PDFium to it while PDFium is
+// creating it.
+type WriterRPCServer struct {
+	Writer io.Writer
+}
+
+func (s *WriterRPCServer) WriteBlock(args *[]byte, resp *int) (err error) {
+	defer func() {
+		if panicError := recover(); panicError != nil {
+			err = fmt.Errorf("panic occurred in %s: %v", "WriteBlock", panicError)
+		}
+	}()
+
+	*resp, err = s.Writer.Write(*args)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Make sure remoteWriter is an io.Writer.
+var _ io.Writer = &remoteWriter{}
+
+// remoteWriter is an io.Writer in the worker that writes to the writer in
+// the host process that is served by WriterRPCServer.
+type remoteWriter struct {
+	client rpcClient
+}
+
+func (w *remoteWriter) Write(p []byte) (int, error) {
+	writtenBytes := 0
+	err := w.client.Call("Plugin.WriteBlock", &p, &writtenBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	if writtenBytes < len(p) {
+		return writtenBytes, io.ErrShortWrite
+	}
+
+	return writtenBytes, nil
+}
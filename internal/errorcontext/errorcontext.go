@@ -0,0 +1,67 @@
+// Package errorcontext adds the context of a call to the errors of the call,
+// it's used by the pools to fill in the fields of *errors.Error.
+package errorcontext
+
+import (
+	"errors"
+	"reflect"
+
+	pdfium_errors "github.com/klippa-app/go-pdfium/errors"
+	"github.com/klippa-app/go-pdfium/references"
+	"github.com/klippa-app/go-pdfium/requests"
+)
+
+// Set sets the method, document and page of the *errors.Error in err when
+// they are not set yet. The document and the page are taken from the
+// Document and Page fields of the request, when it has them.
+func Set(err error, method string, request interface{}) {
+	var pdfiumError *pdfium_errors.Error
+	if !errors.As(err, &pdfiumError) {
+		return
+	}
+
+	if pdfiumError.Method == "" {
+		pdfiumError.Method = method
+	}
+
+	value := reflect.ValueOf(request)
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return
+		}
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return
+	}
+
+	switch page := fieldValue(value, "Page").(type) {
+	case references.FPDF_PAGE:
+		if pdfiumError.Page == "" {
+			pdfiumError.Page = page
+		}
+	case requests.Page:
+		if page.ByReference != nil && pdfiumError.Page == "" {
+			pdfiumError.Page = *page.ByReference
+		}
+		if page.ByIndex != nil && pdfiumError.Document == "" {
+			pdfiumError.Document = page.ByIndex.Document
+		}
+	}
+
+	if document, ok := fieldValue(value, "Document").(references.FPDF_DOCUMENT); ok && pdfiumError.Document == "" {
+		pdfiumError.Document = document
+	}
+}
+
+// fieldValue returns the value of the exported field of a struct, or nil when
+// the struct doesn't have the field.
+func fieldValue(value reflect.Value, name string) interface{} {
+	field := value.FieldByName(name)
+	if !field.IsValid() || !field.CanInterface() {
+		return nil
+	}
+
+	return field.Interface()
+}
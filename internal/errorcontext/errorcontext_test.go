@@ -0,0 +1,67 @@
+package errorcontext_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	pdfium_errors "github.com/klippa-app/go-pdfium/errors"
+	"github.com/klippa-app/go-pdfium/internal/errorcontext"
+	"github.com/klippa-app/go-pdfium/references"
+	"github.com/klippa-app/go-pdfium/requests"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetPageByIndex(t *testing.T) {
+	pdfiumError := pdfium_errors.NewCodeError(pdfium_errors.CodePage)
+	errorcontext.Set(fmt.Errorf("could not render: %w", pdfiumError), "RenderPageInDPI", &requests.RenderPageInDPI{
+		Page: requests.Page{
+			ByIndex: &requests.PageByIndex{
+				Document: "document",
+				Index:    1,
+			},
+		},
+	})
+
+	assert.Equal(t, "RenderPageInDPI", pdfiumError.Method)
+	assert.Equal(t, references.FPDF_DOCUMENT("document"), pdfiumError.Document)
+	assert.Equal(t, references.FPDF_PAGE(""), pdfiumError.Page)
+}
+
+func TestSetPageByReference(t *testing.T) {
+	pdfiumError := pdfium_errors.NewCodeError(pdfium_errors.CodeUnknown)
+	page := references.FPDF_PAGE("page")
+	errorcontext.Set(pdfiumError, "FPDF_GetPageWidth", &requests.FPDF_GetPageWidth{
+		Page: requests.Page{
+			ByReference: &page,
+		},
+	})
+
+	assert.Equal(t, "FPDF_GetPageWidth", pdfiumError.Method)
+	assert.Equal(t, references.FPDF_PAGE("page"), pdfiumError.Page)
+}
+
+func TestSetDocument(t *testing.T) {
+	pdfiumError := pdfium_errors.NewCodeError(pdfium_errors.CodePage)
+	pdfiumError.Method = "FPDF_LoadPage"
+	errorcontext.Set(pdfiumError, "OtherMethod", &requests.FPDF_LoadPage{
+		Document: "document",
+	})
+
+	// Fields that are already set are kept.
+	assert.Equal(t, "FPDF_LoadPage", pdfiumError.Method)
+	assert.Equal(t, references.FPDF_DOCUMENT("document"), pdfiumError.Document)
+}
+
+func TestSetOtherErrors(t *testing.T) {
+	// Errors that are not an *errors.Error and requests without the fields
+	// are ignored.
+	errorcontext.Set(errors.New("error"), "FPDF_LoadPage", &requests.FPDF_LoadPage{})
+	errorcontext.Set(nil, "FPDF_LoadPage", nil)
+
+	pdfiumError := pdfium_errors.NewCodeError(pdfium_errors.CodeFile)
+	errorcontext.Set(pdfiumError, "FPDF_LoadMemDocument", &requests.FPDF_LoadMemDocument{})
+	assert.Equal(t, "FPDF_LoadMemDocument", pdfiumError.Method)
+	assert.Equal(t, references.FPDF_DOCUMENT(""), pdfiumError.Document)
+}
@@ -0,0 +1,259 @@
+// Package handledebug implements the handle debug mode of the pools, see
+// pdfium.HandleDebugConfig.
+package handledebug
+
+import (
+	goctx "context"
+	"fmt"
+	"log"
+	"reflect"
+	"runtime/debug"
+	"strings"
+	"sync"
+
+	"github.com/klippa-app/go-pdfium"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+)
+
+// referencesPackage is the package of the reference types.
+const referencesPackage = "github.com/klippa-app/go-pdfium/references"
+
+// trackedTypes are the handle types of which the stack is recorded, these
+// are the types that are returned by ListOpenHandles.
+var trackedTypes = map[string]bool{
+	"FPDF_DOCUMENT":          true,
+	"FPDF_AVAIL":             true,
+	"FPDF_ANNOTATION":        true,
+	"FPDF_BITMAP":            true,
+	"FPDF_CLIPPATH":          true,
+	"FPDF_FONT":              true,
+	"FPDF_FORMHANDLE":        true,
+	"FPDF_JAVASCRIPT_ACTION": true,
+	"FPDF_PAGE":              true,
+	"FPDF_PAGELINK":          true,
+	"FPDF_SCHHANDLE":         true,
+	"FPDF_STRUCTTREE":        true,
+	"FPDF_TEXTPAGE":          true,
+	"FPDF_XOBJECT":           true,
+}
+
+// ListOpenHandles lists the open handles of the instance, this should call
+// ListOpenHandles of the instance.
+type ListOpenHandles func(ctx goctx.Context, request *requests.ListOpenHandles) (*responses.ListOpenHandles, error)
+
+// Tracker records the stacks of the handles of one instance.
+type Tracker struct {
+	onLeak          func(leak pdfium.HandleLeak)
+	listOpenHandles ListOpenHandles
+
+	lock   *sync.Mutex
+	stacks map[string]string
+}
+
+// New creates a tracker for an instance, the given function is used to list
+// the open handles of the instance.
+func New(config *pdfium.HandleDebugConfig, listOpenHandles ListOpenHandles) *Tracker {
+	onLeak := config.OnLeak
+	if onLeak == nil {
+		onLeak = logLeak
+	}
+
+	return &Tracker{
+		onLeak:          onLeak,
+		listOpenHandles: listOpenHandles,
+		lock:            &sync.Mutex{},
+		stacks:          map[string]string{},
+	}
+}
+
+// Interceptor returns the interceptor that records the stacks of the returned
+// handles and checks for leaks on FPDF_CloseDocument. It should be the
+// innermost interceptor.
+func (t *Tracker) Interceptor() pdfium.Interceptor {
+	return func(ctx goctx.Context, method string, request interface{}, next func() (interface{}, error)) (interface{}, error) {
+		if method == "ListOpenHandles" {
+			return next()
+		}
+
+		var documentHandles *responses.DocumentOpenHandles
+		if closeDocument, ok := request.(*requests.FPDF_CloseDocument); ok && closeDocument.Document != "" {
+			openHandles, err := t.listOpenHandles(ctx, &requests.ListOpenHandles{Document: closeDocument.Document})
+			if err == nil && len(openHandles.Documents) == 1 {
+				documentHandles = &openHandles.Documents[0]
+			}
+		}
+
+		resp, err := next()
+		if err != nil {
+			return resp, err
+		}
+
+		if documentHandles != nil {
+			if len(documentHandles.Handles) > 0 {
+				t.onLeak(t.leak(method, *documentHandles, false))
+			}
+
+			t.forgetDocument(*documentHandles)
+		} else if isCloseMethod(method) {
+			t.forget(collectHandles(reflect.ValueOf(request)))
+		}
+
+		if resp != nil {
+			t.remember(collectHandles(reflect.ValueOf(resp)))
+		}
+
+		return resp, err
+	}
+}
+
+// Close reports the handles and documents that are still open. It must be
+// called before the instance is closed.
+func (t *Tracker) Close(ctx goctx.Context) {
+	openHandles, err := t.listOpenHandles(ctx, &requests.ListOpenHandles{})
+	if err != nil {
+		return
+	}
+
+	// Every listed document is still open, the handles that don't belong to a
+	// document are only listed when there are any.
+	for _, documentHandles := range openHandles.Documents {
+		t.onLeak(t.leak("Close", documentHandles, true))
+	}
+
+	t.lock.Lock()
+	t.stacks = map[string]string{}
+	t.lock.Unlock()
+}
+
+func (t *Tracker) leak(method string, documentHandles responses.DocumentOpenHandles, includeDocument bool) pdfium.HandleLeak {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	leak := pdfium.HandleLeak{
+		Method:   method,
+		Document: documentHandles.Document,
+	}
+
+	if includeDocument && documentHandles.Document != "" {
+		leak.Handles = append(leak.Handles, pdfium.LeakedHandle{
+			Type:      "FPDF_DOCUMENT",
+			Reference: string(documentHandles.Document),
+			Stack:     t.stacks[string(documentHandles.Document)],
+		})
+	}
+
+	for _, handle := range documentHandles.Handles {
+		leak.Handles = append(leak.Handles, pdfium.LeakedHandle{
+			Type:      handle.Type,
+			Reference: handle.Reference,
+			Stack:     t.stacks[handle.Reference],
+		})
+	}
+
+	return leak
+}
+
+func (t *Tracker) remember(handles []string) {
+	if len(handles) == 0 {
+		return
+	}
+
+	stack := string(debug.Stack())
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	// A handle can be returned by multiple calls, only the first call
+	// allocated it.
+	for _, handle := range handles {
+		if _, ok := t.stacks[handle]; !ok {
+			t.stacks[handle] = stack
+		}
+	}
+}
+
+func (t *Tracker) forget(handles []string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, handle := range handles {
+		delete(t.stacks, handle)
+	}
+}
+
+func (t *Tracker) forgetDocument(documentHandles responses.DocumentOpenHandles) {
+	handles := []string{string(documentHandles.Document)}
+	for _, handle := range documentHandles.Handles {
+		handles = append(handles, handle.Reference)
+	}
+
+	t.forget(handles)
+}
+
+// isCloseMethod returns whether the method closes the handles in its request,
+// like FPDF_ClosePage, FPDFBitmap_Destroy and FPDFDOC_ExitFormFillEnvironment.
+func isCloseMethod(method string) bool {
+	return strings.Contains(method, "Close") || strings.Contains(method, "Destroy") || strings.Contains(method, "Exit")
+}
+
+// collectHandles returns the references of the tracked handle types in the
+// value.
+func collectHandles(value reflect.Value) []string {
+	handles := []string{}
+	walkHandles(value, &handles)
+	return handles
+}
+
+func walkHandles(value reflect.Value, handles *[]string) {
+	if !value.IsValid() {
+		return
+	}
+
+	if value.Type().PkgPath() == referencesPackage {
+		if trackedTypes[value.Type().Name()] && value.String() != "" {
+			*handles = append(*handles, value.String())
+		}
+		return
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !value.IsNil() {
+			walkHandles(value.Elem(), handles)
+		}
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).IsExported() {
+				walkHandles(value.Field(i), handles)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		// Don't walk through file and image data.
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+
+		for i := 0; i < value.Len(); i++ {
+			walkHandles(value.Index(i), handles)
+		}
+	}
+}
+
+func logLeak(leak pdfium.HandleLeak) {
+	message := &strings.Builder{}
+	if leak.Document != "" {
+		fmt.Fprintf(message, "go-pdfium: %d handle(s) of document %s were not closed before %s", len(leak.Handles), leak.Document, leak.Method)
+	} else {
+		fmt.Fprintf(message, "go-pdfium: %d handle(s) were not closed before %s", len(leak.Handles), leak.Method)
+	}
+
+	for _, handle := range leak.Handles {
+		fmt.Fprintf(message, "\n%s %s", handle.Type, handle.Reference)
+		if handle.Stack != "" {
+			fmt.Fprintf(message, ", allocated at:\n%s", handle.Stack)
+		}
+	}
+
+	log.Print(message.String())
+}
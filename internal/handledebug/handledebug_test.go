@@ -0,0 +1,121 @@
+package handledebug_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/klippa-app/go-pdfium"
+	"github.com/klippa-app/go-pdfium/internal/handledebug"
+	"github.com/klippa-app/go-pdfium/references"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeHandles keeps the open pages by document, like the implementations do.
+type fakeHandles struct {
+	pages map[references.FPDF_DOCUMENT][]references.FPDF_PAGE
+}
+
+func (f *fakeHandles) listOpenHandles(ctx context.Context, request *requests.ListOpenHandles) (*responses.ListOpenHandles, error) {
+	openHandles := &responses.ListOpenHandles{}
+	for document, pages := range f.pages {
+		if request.Document != "" && request.Document != document {
+			continue
+		}
+
+		documentHandles := responses.DocumentOpenHandles{Document: document}
+		for _, page := range pages {
+			documentHandles.Handles = append(documentHandles.Handles, responses.OpenHandle{Type: "FPDF_PAGE", Reference: string(page)})
+		}
+		openHandles.Documents = append(openHandles.Documents, documentHandles)
+	}
+
+	return openHandles, nil
+}
+
+func call(interceptor pdfium.Interceptor, method string, request interface{}, resp interface{}) {
+	interceptor(context.Background(), method, request, func() (interface{}, error) {
+		return resp, nil
+	})
+}
+
+func TestLeakOnCloseDocument(t *testing.T) {
+	handles := &fakeHandles{pages: map[references.FPDF_DOCUMENT][]references.FPDF_PAGE{}}
+	leaks := []pdfium.HandleLeak{}
+	tracker := handledebug.New(&pdfium.HandleDebugConfig{
+		OnLeak: func(leak pdfium.HandleLeak) {
+			leaks = append(leaks, leak)
+		},
+	}, handles.listOpenHandles)
+	interceptor := tracker.Interceptor()
+
+	call(interceptor, "FPDF_LoadMemDocument", &requests.FPDF_LoadMemDocument{}, &responses.FPDF_LoadMemDocument{Document: "document"})
+	handles.pages["document"] = nil
+
+	call(interceptor, "FPDF_LoadPage", &requests.FPDF_LoadPage{Document: "document"}, &responses.FPDF_LoadPage{Page: "closed-page"})
+	call(interceptor, "FPDF_LoadPage", &requests.FPDF_LoadPage{Document: "document", Index: 1}, &responses.FPDF_LoadPage{Page: "leaked-page"})
+	call(interceptor, "FPDF_ClosePage", &requests.FPDF_ClosePage{Page: "closed-page"}, &responses.FPDF_ClosePage{})
+	handles.pages["document"] = []references.FPDF_PAGE{"leaked-page"}
+
+	call(interceptor, "FPDF_CloseDocument", &requests.FPDF_CloseDocument{Document: "document"}, &responses.FPDF_CloseDocument{})
+	delete(handles.pages, "document")
+
+	require.Len(t, leaks, 1)
+	assert.Equal(t, "FPDF_CloseDocument", leaks[0].Method)
+	assert.Equal(t, references.FPDF_DOCUMENT("document"), leaks[0].Document)
+	require.Len(t, leaks[0].Handles, 1)
+	assert.Equal(t, "FPDF_PAGE", leaks[0].Handles[0].Type)
+	assert.Equal(t, "leaked-page", leaks[0].Handles[0].Reference)
+	assert.Contains(t, leaks[0].Handles[0].Stack, "TestLeakOnCloseDocument")
+
+	// The document is closed, so closing the instance doesn't report it again.
+	tracker.Close(context.Background())
+	assert.Len(t, leaks, 1)
+}
+
+func TestLeakOnClose(t *testing.T) {
+	handles := &fakeHandles{pages: map[references.FPDF_DOCUMENT][]references.FPDF_PAGE{}}
+	leaks := []pdfium.HandleLeak{}
+	tracker := handledebug.New(&pdfium.HandleDebugConfig{
+		OnLeak: func(leak pdfium.HandleLeak) {
+			leaks = append(leaks, leak)
+		},
+	}, handles.listOpenHandles)
+	interceptor := tracker.Interceptor()
+
+	call(interceptor, "FPDF_LoadMemDocument", &requests.FPDF_LoadMemDocument{}, &responses.FPDF_LoadMemDocument{Document: "document"})
+	call(interceptor, "FPDF_LoadPage", &requests.FPDF_LoadPage{Document: "document"}, &responses.FPDF_LoadPage{Page: "page"})
+	handles.pages["document"] = []references.FPDF_PAGE{"page"}
+
+	tracker.Close(context.Background())
+
+	require.Len(t, leaks, 1)
+	assert.Equal(t, "Close", leaks[0].Method)
+	require.Len(t, leaks[0].Handles, 2)
+	assert.Equal(t, "FPDF_DOCUMENT", leaks[0].Handles[0].Type)
+	assert.Equal(t, "document", leaks[0].Handles[0].Reference)
+	assert.NotEmpty(t, leaks[0].Handles[0].Stack)
+	assert.Equal(t, "FPDF_PAGE", leaks[0].Handles[1].Type)
+	assert.NotEmpty(t, leaks[0].Handles[1].Stack)
+}
+
+func TestNoLeaks(t *testing.T) {
+	handles := &fakeHandles{pages: map[references.FPDF_DOCUMENT][]references.FPDF_PAGE{}}
+	tracker := handledebug.New(&pdfium.HandleDebugConfig{
+		OnLeak: func(leak pdfium.HandleLeak) {
+			t.Errorf("unexpected leak: %+v", leak)
+		},
+	}, handles.listOpenHandles)
+	interceptor := tracker.Interceptor()
+
+	call(interceptor, "FPDF_LoadMemDocument", &requests.FPDF_LoadMemDocument{}, &responses.FPDF_LoadMemDocument{Document: "document"})
+	handles.pages["document"] = nil
+
+	call(interceptor, "FPDF_CloseDocument", &requests.FPDF_CloseDocument{Document: "document"}, &responses.FPDF_CloseDocument{})
+	delete(handles.pages, "document")
+
+	tracker.Close(context.Background())
+}
@@ -0,0 +1,111 @@
+package image_tiff
+
+// encodeCCITTG4 compresses 1-bit data, where 1 is black and rows are padded
+// to whole bytes, with the two-dimensional coding of CCITT T.6 (Group 4).
+func encodeCCITTG4(data []byte, width, height int) []byte {
+	w := &bitWriter{}
+	rowSize := (width + 7) / 8
+
+	// The reference line of the first row is an imaginary white line.
+	reference := make([]byte, rowSize)
+	for y := 0; y < height; y++ {
+		row := data[y*rowSize : (y+1)*rowSize]
+		encodeCCITTG4Row(w, row, reference, width)
+		reference = row
+	}
+
+	// End of facsimile block.
+	w.write(faxEOLCode.code, faxEOLCode.length)
+	w.write(faxEOLCode.code, faxEOLCode.length)
+
+	return w.flush()
+}
+
+// encodeCCITTG4Row encodes a row with the changing elements of the reference
+// row, a0, a1, a2, b1 and b2 are the changing elements as in T.6.
+func encodeCCITTG4Row(w *bitWriter, row, reference []byte, width int) {
+	a0 := 0
+	a1 := nextChange(row, 0, width, false)
+	b1 := nextChange(reference, 0, width, false)
+
+	// a0 starts on an imaginary white pixel before the row.
+	a0Black := false
+	for {
+		b2 := nextChange(reference, b1, width, pixelAt(reference, b1, width))
+		if b2 < a1 {
+			// Pass mode.
+			w.write(faxPassCode.code, faxPassCode.length)
+			a0 = b2
+		} else if distance := a1 - b1; distance >= -3 && distance <= 3 {
+			// Vertical mode.
+			code := faxVerticalCodes[distance+3]
+			w.write(code.code, code.length)
+			a0 = a1
+			a0Black = !a0Black
+		} else {
+			// Horizontal mode.
+			a2 := nextChange(row, a1, width, pixelAt(row, a1, width))
+			w.write(faxHorizontalCode.code, faxHorizontalCode.length)
+			writeFaxRun(w, a1-a0, a0Black)
+			writeFaxRun(w, a2-a1, !a0Black)
+			a0 = a2
+		}
+
+		if a0 >= width {
+			return
+		}
+
+		a1 = nextChange(row, a0, width, a0Black)
+		b1 = nextChange(reference, a0, width, !a0Black)
+		b1 = nextChange(reference, b1, width, a0Black)
+	}
+}
+
+// pixelAt returns whether the pixel at x is black, pixels after the row are
+// white.
+func pixelAt(row []byte, x, width int) bool {
+	if x >= width {
+		return false
+	}
+	return row[x/8]&(0x80>>uint(x%8)) != 0
+}
+
+// nextChange returns the position of the first pixel at or after start that
+// doesn't have the given color, or the width when there is none.
+func nextChange(row []byte, start, width int, black bool) int {
+	for x := start; x < width; x++ {
+		if pixelAt(row, x, width) != black {
+			return x
+		}
+	}
+	return width
+}
+
+// writeFaxRun writes the codes of a run of pixels of a color.
+func writeFaxRun(w *bitWriter, run int, black bool) {
+	terminating := faxWhiteTerminatingCodes
+	makeup := faxWhiteMakeupCodes
+	if black {
+		terminating = faxBlackTerminatingCodes
+		makeup = faxBlackMakeupCodes
+	}
+
+	for run >= 2560 {
+		code := faxExtendedMakeupCodes[len(faxExtendedMakeupCodes)-1]
+		w.write(code.code, code.length)
+		run -= 2560
+	}
+
+	if run >= 1792 {
+		code := faxExtendedMakeupCodes[(run-1792)/64]
+		w.write(code.code, code.length)
+		run %= 64
+	} else if run >= 64 {
+		code := makeup[run/64-1]
+		w.write(code.code, code.length)
+		run %= 64
+	}
+
+	code := terminating[run]
+	w.write(code.code, code.length)
+}
@@ -0,0 +1,109 @@
+package image_tiff
+
+const (
+	lzwClearCode = 256
+	lzwEOICode   = 257
+	lzwFirstCode = 258
+	lzwMinWidth  = 9
+	lzwMaxWidth  = 12
+
+	// lzwTableFull is the code at which the table is reset, TIFF readers
+	// don't accept a table of more than 4094 codes.
+	lzwTableFull = 4094
+
+	// The hash table of the codes, like in compress/lzw. An entry is the key
+	// (the prefix code and the byte) in the high bits and the code in the low
+	// 12 bits, 0 is an empty entry.
+	lzwTableSize  = 1 << 14
+	lzwTableMask  = lzwTableSize - 1
+	lzwInvalidKey = 0
+)
+
+// bitWriter writes codes with the most significant bit first.
+type bitWriter struct {
+	data  []byte
+	bits  uint32
+	nBits uint
+}
+
+func (w *bitWriter) write(code uint32, width uint) {
+	w.bits = w.bits<<width | code
+	w.nBits += width
+	for w.nBits >= 8 {
+		w.nBits -= 8
+		w.data = append(w.data, byte(w.bits>>w.nBits))
+	}
+	w.bits &= 1<<w.nBits - 1
+}
+
+// flush writes the remaining bits, padded with zeros to a whole byte.
+func (w *bitWriter) flush() []byte {
+	if w.nBits > 0 {
+		w.data = append(w.data, byte(w.bits<<(8-w.nBits)))
+		w.bits = 0
+		w.nBits = 0
+	}
+	return w.data
+}
+
+// encodeLZW compresses data with the LZW variant of TIFF. Unlike the LZW of
+// compress/lzw, it switches to a wider code one code early, like TIFF readers
+// expect.
+func encodeLZW(data []byte) []byte {
+	w := &bitWriter{}
+	width := uint(lzwMinWidth)
+	next := uint32(lzwFirstCode)
+	table := make([]uint32, lzwTableSize)
+
+	w.write(lzwClearCode, width)
+
+	// addCode increments the next code after a code is written, like the
+	// reader does when it reads a code.
+	addCode := func() {
+		next++
+		if next == lzwTableFull {
+			w.write(lzwClearCode, width)
+			for i := range table {
+				table[i] = lzwInvalidKey
+			}
+			next = lzwFirstCode
+			width = lzwMinWidth
+		} else if next > 1<<width-1 && width < lzwMaxWidth {
+			width++
+		}
+	}
+
+	if len(data) == 0 {
+		w.write(lzwEOICode, width)
+		return w.flush()
+	}
+
+	prefix := uint32(data[0])
+	for _, b := range data[1:] {
+		key := prefix<<8 | uint32(b)
+		hash := (key>>12 ^ key) & lzwTableMask
+		found := false
+		for entry := table[hash]; entry != lzwInvalidKey; entry = table[hash] {
+			if entry>>12 == key {
+				prefix = entry & 0xFFF
+				found = true
+				break
+			}
+			hash = (hash + 1) & lzwTableMask
+		}
+		if found {
+			continue
+		}
+
+		w.write(prefix, width)
+		table[hash] = key<<12 | next
+		addCode()
+		prefix = uint32(b)
+	}
+
+	w.write(prefix, width)
+	addCode()
+	w.write(lzwEOICode, width)
+
+	return w.flush()
+}
@@ -0,0 +1,344 @@
+// Package image_tiff encodes images as a (multi-page) baseline TIFF file,
+// every page is written as its own image file directory (IFD).
+package image_tiff
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"io"
+	"math"
+	"sort"
+)
+
+// Compression is the compression of a page.
+type Compression int
+
+const (
+	CompressionNone    Compression = iota // Uncompressed.
+	CompressionLZW                        // LZW, lossless.
+	CompressionDeflate                    // Deflate (zlib), lossless.
+	CompressionCCITTG4                    // CCITT Group 4, the page is converted to 1-bit black and white.
+)
+
+// Page is a page of the TIFF file.
+type Page struct {
+	Image       image.Image
+	Compression Compression
+	DPI         float64 // The resolution of the page, not written when 0.
+}
+
+// BilevelThreshold is the gray level below which a pixel is black when a page
+// is converted to 1-bit black and white.
+const BilevelThreshold = 128
+
+const (
+	tagNewSubfileType            = 254
+	tagImageWidth                = 256
+	tagImageLength               = 257
+	tagBitsPerSample             = 258
+	tagCompression               = 259
+	tagPhotometricInterpretation = 262
+	tagStripOffsets              = 273
+	tagSamplesPerPixel           = 277
+	tagRowsPerStrip              = 278
+	tagStripByteCounts           = 279
+	tagXResolution               = 282
+	tagYResolution               = 283
+	tagPlanarConfiguration       = 284
+	tagT6Options                 = 293
+	tagResolutionUnit            = 296
+	tagPageNumber                = 297
+)
+
+const (
+	typeShort    = 3
+	typeLong     = 4
+	typeRational = 5
+)
+
+const (
+	compressionNone    = 1
+	compressionCCITTG4 = 4
+	compressionLZW     = 5
+	compressionDeflate = 8 // Adobe Deflate.
+)
+
+const (
+	photometricWhiteIsZero = 0
+	photometricBlackIsZero = 1
+	photometricRGB         = 2
+)
+
+// Encode writes the pages as a TIFF file to w.
+func Encode(w io.Writer, pages []Page) error {
+	if len(pages) == 0 {
+		return errors.New("no pages given")
+	}
+
+	buf := &bytes.Buffer{}
+
+	// Little endian header, the offset of the first IFD is set when the
+	// first IFD is written.
+	buf.Write([]byte{'I', 'I', 42, 0, 0, 0, 0, 0})
+	nextIFDOffsetPosition := 4
+
+	for i, page := range pages {
+		if page.Image == nil {
+			return errors.New("page has no image")
+		}
+
+		bounds := page.Image.Bounds()
+		if bounds.Empty() {
+			return errors.New("page has no size")
+		}
+
+		data, samplesPerPixel, bitsPerSample, photometric := pageData(page)
+
+		compression := compressionNone
+		switch page.Compression {
+		case CompressionNone:
+		case CompressionLZW:
+			compression = compressionLZW
+			data = encodeLZW(data)
+		case CompressionDeflate:
+			compression = compressionDeflate
+			var compressed bytes.Buffer
+			zw := zlib.NewWriter(&compressed)
+			if _, err := zw.Write(data); err != nil {
+				return err
+			}
+			if err := zw.Close(); err != nil {
+				return err
+			}
+			data = compressed.Bytes()
+		case CompressionCCITTG4:
+			compression = compressionCCITTG4
+			data = encodeCCITTG4(data, bounds.Dx(), bounds.Dy())
+		default:
+			return errors.New("invalid compression given")
+		}
+
+		stripOffset := buf.Len()
+		buf.Write(data)
+
+		// The IFD must start on a word boundary.
+		if buf.Len()%2 == 1 {
+			buf.WriteByte(0)
+		}
+
+		bitsPerSamples := make([]uint32, samplesPerPixel)
+		for j := range bitsPerSamples {
+			bitsPerSamples[j] = uint32(bitsPerSample)
+		}
+
+		entries := []ifdEntry{
+			{tag: tagNewSubfileType, dataType: typeLong, values: []uint32{newSubfileType(len(pages))}},
+			{tag: tagImageWidth, dataType: typeLong, values: []uint32{uint32(bounds.Dx())}},
+			{tag: tagImageLength, dataType: typeLong, values: []uint32{uint32(bounds.Dy())}},
+			{tag: tagBitsPerSample, dataType: typeShort, values: bitsPerSamples},
+			{tag: tagCompression, dataType: typeShort, values: []uint32{uint32(compression)}},
+			{tag: tagPhotometricInterpretation, dataType: typeShort, values: []uint32{uint32(photometric)}},
+			{tag: tagStripOffsets, dataType: typeLong, values: []uint32{uint32(stripOffset)}},
+			{tag: tagSamplesPerPixel, dataType: typeShort, values: []uint32{uint32(samplesPerPixel)}},
+			{tag: tagRowsPerStrip, dataType: typeLong, values: []uint32{uint32(bounds.Dy())}},
+			{tag: tagStripByteCounts, dataType: typeLong, values: []uint32{uint32(len(data))}},
+			{tag: tagPlanarConfiguration, dataType: typeShort, values: []uint32{1}},
+			{tag: tagPageNumber, dataType: typeShort, values: []uint32{uint32(i), uint32(len(pages))}},
+		}
+
+		if compression == compressionCCITTG4 {
+			entries = append(entries, ifdEntry{tag: tagT6Options, dataType: typeLong, values: []uint32{0}})
+		}
+
+		if page.DPI > 0 {
+			resolution := []uint32{uint32(math.Round(page.DPI * 100)), 100}
+			entries = append(entries,
+				ifdEntry{tag: tagXResolution, dataType: typeRational, values: resolution},
+				ifdEntry{tag: tagYResolution, dataType: typeRational, values: resolution},
+				ifdEntry{tag: tagResolutionUnit, dataType: typeShort, values: []uint32{2}}, // Inch.
+			)
+		}
+
+		ifdOffset := buf.Len()
+		if uint64(ifdOffset) > math.MaxUint32 {
+			return errors.New("TIFF file would exceed 4 GiB")
+		}
+
+		binary.LittleEndian.PutUint32(buf.Bytes()[nextIFDOffsetPosition:], uint32(ifdOffset))
+		nextIFDOffsetPosition = writeIFD(buf, entries)
+	}
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// newSubfileType marks the pages of a multi-page file as a page.
+func newSubfileType(pageCount int) uint32 {
+	if pageCount > 1 {
+		return 2
+	}
+	return 0
+}
+
+type ifdEntry struct {
+	tag      uint16
+	dataType uint16
+	values   []uint32 // A rational is written as 2 values, the numerator and the denominator.
+}
+
+// count returns the number of values of the type of the entry.
+func (e ifdEntry) count() int {
+	if e.dataType == typeRational {
+		return len(e.values) / 2
+	}
+	return len(e.values)
+}
+
+// size returns the size of the values of the entry in bytes.
+func (e ifdEntry) size() int {
+	if e.dataType == typeShort {
+		return 2 * len(e.values)
+	}
+	return 4 * len(e.values)
+}
+
+// writeIFD writes an IFD with the values that don't fit in an entry directly
+// after it, and returns the position of the offset of the next IFD.
+func writeIFD(buf *bytes.Buffer, entries []ifdEntry) int {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].tag < entries[j].tag
+	})
+
+	ifdOffset := buf.Len()
+	ifdSize := 2 + 12*len(entries) + 4
+	extraOffset := ifdOffset + ifdSize
+	var extra bytes.Buffer
+
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint16(b, uint16(len(entries)))
+	buf.Write(b[:2])
+
+	for _, entry := range entries {
+		binary.LittleEndian.PutUint16(b, entry.tag)
+		buf.Write(b[:2])
+		binary.LittleEndian.PutUint16(b, entry.dataType)
+		buf.Write(b[:2])
+		binary.LittleEndian.PutUint32(b, uint32(entry.count()))
+		buf.Write(b)
+
+		values := encodeValues(entry)
+		if entry.size() <= 4 {
+			value := make([]byte, 4)
+			copy(value, values)
+			buf.Write(value)
+			continue
+		}
+
+		binary.LittleEndian.PutUint32(b, uint32(extraOffset+extra.Len()))
+		buf.Write(b)
+		extra.Write(values)
+	}
+
+	nextIFDOffsetPosition := buf.Len()
+	buf.Write([]byte{0, 0, 0, 0})
+	buf.Write(extra.Bytes())
+
+	return nextIFDOffsetPosition
+}
+
+func encodeValues(entry ifdEntry) []byte {
+	values := make([]byte, entry.size())
+	for i, value := range entry.values {
+		if entry.dataType == typeShort {
+			binary.LittleEndian.PutUint16(values[i*2:], uint16(value))
+		} else {
+			binary.LittleEndian.PutUint32(values[i*4:], value)
+		}
+	}
+	return values
+}
+
+// pageData returns the uncompressed pixel data of a page, with the samples
+// per pixel, the bits per sample and the photometric interpretation. The
+// alpha channel is not written, transparent pixels are composited onto white
+// like on paper, instead of becoming black.
+func pageData(page Page) ([]byte, int, int, int) {
+	bounds := page.Image.Bounds()
+	width := bounds.Dx()
+	height := bounds.Dy()
+
+	if page.Compression == CompressionCCITTG4 {
+		// One bit per pixel, 1 is black, rows are padded to whole bytes.
+		rowSize := (width + 7) / 8
+		data := make([]byte, rowSize*height)
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				if grayAt(page.Image, bounds.Min.X+x, bounds.Min.Y+y) < BilevelThreshold {
+					data[y*rowSize+x/8] |= 0x80 >> uint(x%8)
+				}
+			}
+		}
+		return data, 1, 1, photometricWhiteIsZero
+	}
+
+	if img, ok := page.Image.(*image.Gray); ok {
+		data := make([]byte, width*height)
+		for y := 0; y < height; y++ {
+			start := img.PixOffset(bounds.Min.X, bounds.Min.Y+y)
+			copy(data[y*width:], img.Pix[start:start+width])
+		}
+		return data, 1, 8, photometricBlackIsZero
+	}
+
+	data := make([]byte, width*height*3)
+	if img, ok := page.Image.(*image.RGBA); ok {
+		for y := 0; y < height; y++ {
+			start := img.PixOffset(bounds.Min.X, bounds.Min.Y+y)
+			row := img.Pix[start : start+width*4]
+			for x := 0; x < width; x++ {
+				a := row[x*4+3]
+				i := (y*width + x) * 3
+				data[i], data[i+1], data[i+2] = onWhite(row[x*4], a), onWhite(row[x*4+1], a), onWhite(row[x*4+2], a)
+			}
+		}
+		return data, 3, 8, photometricRGB
+	}
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			c := color.RGBAModel.Convert(page.Image.At(bounds.Min.X+x, bounds.Min.Y+y)).(color.RGBA)
+			i := (y*width + x) * 3
+			data[i], data[i+1], data[i+2] = onWhite(c.R, c.A), onWhite(c.G, c.A), onWhite(c.B, c.A)
+		}
+	}
+	return data, 3, 8, photometricRGB
+}
+
+func grayAt(img image.Image, x, y int) uint8 {
+	var c color.RGBA
+	switch img := img.(type) {
+	case *image.Gray:
+		return img.Pix[img.PixOffset(x, y)]
+	case *image.RGBA:
+		i := img.PixOffset(x, y)
+		c = color.RGBA{R: img.Pix[i], G: img.Pix[i+1], B: img.Pix[i+2], A: img.Pix[i+3]}
+	default:
+		c = color.RGBAModel.Convert(img.At(x, y)).(color.RGBA)
+	}
+
+	a := c.A
+	return color.GrayModel.Convert(color.RGBA{R: onWhite(c.R, a), G: onWhite(c.G, a), B: onWhite(c.B, a), A: 0xFF}).(color.Gray).Y
+}
+
+// onWhite returns the value of a premultiplied color channel with the given
+// alpha composited onto white.
+func onWhite(c, a uint8) uint8 {
+	if int(c)+0xFF-int(a) > 0xFF {
+		return 0xFF
+	}
+	return c + 0xFF - a
+}
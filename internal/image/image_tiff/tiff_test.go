@@ -0,0 +1,184 @@
+package image_tiff
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"io"
+	"testing"
+)
+
+// readIFDs returns the tags of every IFD of a TIFF file, the values of the
+// tags that are written in the entry itself.
+func readIFDs(t *testing.T, data []byte) []map[uint16]uint32 {
+	if !bytes.Equal(data[:4], []byte{'I', 'I', 42, 0}) {
+		t.Fatalf("invalid TIFF header: %v", data[:4])
+	}
+
+	ifds := []map[uint16]uint32{}
+	offset := binary.LittleEndian.Uint32(data[4:])
+	for offset != 0 {
+		count := int(binary.LittleEndian.Uint16(data[offset:]))
+		tags := map[uint16]uint32{}
+		for i := 0; i < count; i++ {
+			entry := data[int(offset)+2+i*12:]
+			tag := binary.LittleEndian.Uint16(entry)
+			if binary.LittleEndian.Uint16(entry[2:]) == typeShort {
+				tags[tag] = uint32(binary.LittleEndian.Uint16(entry[8:]))
+			} else {
+				tags[tag] = binary.LittleEndian.Uint32(entry[8:])
+			}
+		}
+		ifds = append(ifds, tags)
+		offset = binary.LittleEndian.Uint32(data[int(offset)+2+count*12:])
+	}
+
+	return ifds
+}
+
+func TestEncode(t *testing.T) {
+	rgba := image.NewRGBA(image.Rect(0, 0, 20, 10))
+	for i := range rgba.Pix {
+		rgba.Pix[i] = 0xFF
+	}
+	rgba.Set(1, 1, color.RGBA{R: 0x10, G: 0x20, B: 0x30, A: 0xFF})
+
+	gray := image.NewGray(image.Rect(0, 0, 30, 15))
+	gray.Pix[0] = 0x40
+
+	testWriter := bytes.NewBuffer(nil)
+	err := Encode(testWriter, []Page{
+		{Image: rgba, Compression: CompressionDeflate, DPI: 150},
+		{Image: gray, Compression: CompressionLZW},
+		{Image: rgba, Compression: CompressionCCITTG4, DPI: 200},
+	})
+	if err != nil {
+		t.Fatalf("Encode resulted in error: %s", err.Error())
+	}
+
+	data := testWriter.Bytes()
+	ifds := readIFDs(t, data)
+	if len(ifds) != 3 {
+		t.Fatalf("Encode resulted in wrong number of pages, got %d, want %d", len(ifds), 3)
+	}
+
+	expected := []map[uint16]uint32{
+		{tagImageWidth: 20, tagImageLength: 10, tagCompression: compressionDeflate, tagPhotometricInterpretation: photometricRGB, tagSamplesPerPixel: 3, tagPageNumber: 0, tagResolutionUnit: 2},
+		{tagImageWidth: 30, tagImageLength: 15, tagCompression: compressionLZW, tagPhotometricInterpretation: photometricBlackIsZero, tagSamplesPerPixel: 1, tagBitsPerSample: 8, tagPageNumber: 1},
+		{tagImageWidth: 20, tagImageLength: 10, tagCompression: compressionCCITTG4, tagPhotometricInterpretation: photometricWhiteIsZero, tagSamplesPerPixel: 1, tagBitsPerSample: 1, tagPageNumber: 2, tagNewSubfileType: 2},
+	}
+	for i := range expected {
+		for tag, value := range expected[i] {
+			if ifds[i][tag] != value {
+				t.Fatalf("Encode resulted in wrong value of tag %d of page %d, got %d, want %d", tag, i, ifds[i][tag], value)
+			}
+		}
+	}
+
+	strip := data[ifds[0][tagStripOffsets] : ifds[0][tagStripOffsets]+ifds[0][tagStripByteCounts]]
+	reader, err := zlib.NewReader(bytes.NewReader(strip))
+	if err != nil {
+		t.Fatalf("could not read deflate strip: %s", err.Error())
+	}
+	pixels, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("could not read deflate strip: %s", err.Error())
+	}
+	if len(pixels) != 20*10*3 {
+		t.Fatalf("Encode resulted in wrong strip size, got %d, want %d", len(pixels), 20*10*3)
+	}
+	if !bytes.Equal(pixels[(20+1)*3:(20+1)*3+3], []byte{0x10, 0x20, 0x30}) {
+		t.Fatalf("Encode resulted in wrong pixel, got %v", pixels[(20+1)*3:(20+1)*3+3])
+	}
+}
+
+func TestEncodeTransparentPage(t *testing.T) {
+	// A page with transparency is rendered onto a transparent black fill,
+	// the transparent pixels must end up white, not black.
+	rgba := image.NewRGBA(image.Rect(0, 0, 16, 2))
+	rgba.Set(1, 1, color.RGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xFF})
+	rgba.Set(2, 1, color.RGBA{R: 0x40, G: 0x40, B: 0x40, A: 0x80})
+
+	data, _, _, _ := pageData(Page{Image: rgba, Compression: CompressionNone})
+	if !bytes.Equal(data[:3], []byte{0xFF, 0xFF, 0xFF}) {
+		t.Fatalf("pageData resulted in wrong transparent pixel, got %v", data[:3])
+	}
+	if !bytes.Equal(data[(16+1)*3:(16+1)*3+3], []byte{0x00, 0x00, 0x00}) {
+		t.Fatalf("pageData resulted in wrong opaque pixel, got %v", data[(16+1)*3:(16+1)*3+3])
+	}
+	if !bytes.Equal(data[(16+2)*3:(16+2)*3+3], []byte{0xBF, 0xBF, 0xBF}) {
+		t.Fatalf("pageData resulted in wrong semi-transparent pixel, got %v", data[(16+2)*3:(16+2)*3+3])
+	}
+
+	// Only the opaque black pixel is black in 1-bit black and white.
+	data, _, _, _ = pageData(Page{Image: rgba, Compression: CompressionCCITTG4})
+	if !bytes.Equal(data, []byte{0x00, 0x00, 0x40, 0x00}) {
+		t.Fatalf("pageData resulted in wrong bilevel pixels, got %x", data)
+	}
+
+	// The same goes for images that aren't *image.RGBA.
+	nrgba := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	data, _, _, _ = pageData(Page{Image: nrgba, Compression: CompressionNone})
+	if !bytes.Equal(data, []byte{0xFF, 0xFF, 0xFF}) {
+		t.Fatalf("pageData resulted in wrong transparent pixel, got %v", data)
+	}
+	data, _, _, _ = pageData(Page{Image: nrgba, Compression: CompressionCCITTG4})
+	if !bytes.Equal(data, []byte{0x00}) {
+		t.Fatalf("pageData resulted in wrong bilevel pixel, got %x", data)
+	}
+}
+
+func TestEncodeNoPages(t *testing.T) {
+	err := Encode(bytes.NewBuffer(nil), []Page{})
+	if err == nil || err.Error() != "no pages given" {
+		t.Fatalf("Encode resulted in wrong error, got %v", err)
+	}
+}
+
+func TestEncodeLZW(t *testing.T) {
+	// The example of the TIFF 6.0 specification, all codes are 9 bits.
+	data := encodeLZW([]byte{7, 7, 7, 8, 8, 7, 7, 6, 6})
+
+	codes := []uint32{}
+	bits := uint32(0)
+	nBits := uint(0)
+	for _, b := range data {
+		bits = bits<<8 | uint32(b)
+		nBits += 8
+		if nBits >= 9 {
+			nBits -= 9
+			codes = append(codes, bits>>nBits)
+			bits &= 1<<nBits - 1
+		}
+	}
+
+	expected := []uint32{256, 7, 258, 8, 8, 258, 6, 6, 257}
+	if len(codes) != len(expected) {
+		t.Fatalf("encodeLZW resulted in wrong codes, got %v, want %v", codes, expected)
+	}
+	for i := range expected {
+		if codes[i] != expected[i] {
+			t.Fatalf("encodeLZW resulted in wrong codes, got %v, want %v", codes, expected)
+		}
+	}
+}
+
+func TestEncodeCCITTG4(t *testing.T) {
+	// A white row is V0, followed by the end of facsimile block.
+	data := encodeCCITTG4([]byte{0x00}, 8, 1)
+	expected := []byte{0x80, 0x08, 0x00, 0x80}
+	if !bytes.Equal(data, expected) {
+		t.Fatalf("encodeCCITTG4 resulted in wrong bytes, got %x, want %x", data, expected)
+	}
+
+	// A row with a black run from 2 to 5 is encoded in horizontal mode, a
+	// white run of 2 (0111) and a black run of 3 (10), and V0 for the end of
+	// the row. The next row is the same, so it's V0 for every change.
+	data = encodeCCITTG4([]byte{0x38, 0x38}, 8, 2)
+	expected = []byte{0x2F, 0x78, 0x00, 0x80, 0x08}
+	if !bytes.Equal(data, expected) {
+		t.Fatalf("encodeCCITTG4 resulted in wrong bytes, got %x, want %x", data, expected)
+	}
+}
@@ -441,7 +441,6 @@ var formFillInfoHandles = map[unsafe.Pointer]*FormFillInfo{}
 
 // FPDFDOC_InitFormFillEnvironment initializes form fill environment
 // This function should be called before any form fill operation.
-// Not supported on multi-threaded usage due to its bidirectional nature.
 func (p *PdfiumImplementation) FPDFDOC_InitFormFillEnvironment(request *requests.FPDFDOC_InitFormFillEnvironment) (*responses.FPDFDOC_InitFormFillEnvironment, error) {
 	p.Lock()
 	defer p.Unlock()
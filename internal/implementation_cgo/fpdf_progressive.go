@@ -52,7 +52,6 @@ type PauseHandle struct {
 var pauseHandles = map[references.FPDF_PAGE]*PauseHandle{}
 
 // FPDF_RenderPageBitmap_Start starts to render page contents to a device independent bitmap progressively.
-// Not supported on multi-threaded usage.
 func (p *PdfiumImplementation) FPDF_RenderPageBitmap_Start(request *requests.FPDF_RenderPageBitmap_Start) (*responses.FPDF_RenderPageBitmap_Start, error) {
 	p.Lock()
 	defer p.Unlock()
@@ -94,7 +93,6 @@ func (p *PdfiumImplementation) FPDF_RenderPageBitmap_Start(request *requests.FPD
 }
 
 // FPDF_RenderPage_Continue continues rendering a PDF page.
-// Not supported on multi-threaded usage.
 func (p *PdfiumImplementation) FPDF_RenderPage_Continue(request *requests.FPDF_RenderPage_Continue) (*responses.FPDF_RenderPage_Continue, error) {
 	p.Lock()
 	defer p.Unlock()
@@ -137,7 +135,6 @@ func (p *PdfiumImplementation) FPDF_RenderPage_Continue(request *requests.FPDF_R
 }
 
 // FPDF_RenderPage_Close Release the resource allocate during page rendering. Need to be called after finishing rendering or cancel the rendering.
-// Not supported on multi-threaded usage.
 func (p *PdfiumImplementation) FPDF_RenderPage_Close(request *requests.FPDF_RenderPage_Close) (*responses.FPDF_RenderPage_Close, error) {
 	p.Lock()
 	defer p.Unlock()
@@ -10,7 +10,6 @@ import (
 )
 
 // FPDF_RenderPageBitmapWithColorScheme_Start starts to render page contents to a device independent bitmap progressively with a specified color scheme for the content.
-// Not supported on multi-threaded usage.
 // Experimental API.
 func (p *PdfiumImplementation) FPDF_RenderPageBitmapWithColorScheme_Start(request *requests.FPDF_RenderPageBitmapWithColorScheme_Start) (*responses.FPDF_RenderPageBitmapWithColorScheme_Start, error) {
 	return nil, pdfium_errors.ErrExperimentalUnsupported
@@ -396,26 +396,7 @@ func (p *PdfiumImplementation) OpenDocument(request *requests.OpenDocument) (*re
 	}
 
 	if doc == nil {
-		var pdfiumError error
-
-		switch errorCode {
-		case C.FPDF_ERR_SUCCESS:
-			pdfiumError = pdfium_errors.ErrSuccess
-		case C.FPDF_ERR_UNKNOWN:
-			pdfiumError = pdfium_errors.ErrUnknown
-		case C.FPDF_ERR_FILE:
-			pdfiumError = pdfium_errors.ErrFile
-		case C.FPDF_ERR_FORMAT:
-			pdfiumError = pdfium_errors.ErrFormat
-		case C.FPDF_ERR_PASSWORD:
-			pdfiumError = pdfium_errors.ErrPassword
-		case C.FPDF_ERR_SECURITY:
-			pdfiumError = pdfium_errors.ErrSecurity
-		case C.FPDF_ERR_PAGE:
-			pdfiumError = pdfium_errors.ErrPage
-		default:
-			pdfiumError = pdfium_errors.ErrUnexpected
-		}
+		pdfiumError := pdfium_errors.NewCodeError(int(errorCode))
 
 		// Cleanup when file loading didn't work.
 		if nativeDoc.fileHandleRef != nil {
@@ -438,6 +419,32 @@ func (p *PdfiumImplementation) OpenDocument(request *requests.OpenDocument) (*re
 	}, nil
 }
 
+// SetDocumentReference changes the reference of an open document. The
+// multi-threaded host process uses this to re-open a document under the
+// reference that it had on a worker that crashed.
+func (p *PdfiumImplementation) SetDocumentReference(document references.FPDF_DOCUMENT, reference references.FPDF_DOCUMENT) error {
+	p.Lock()
+	defer p.Unlock()
+
+	documentHandle, err := p.getDocumentHandle(document)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := Pdfium.documentRefs[reference]; ok {
+		return errors.New("document reference is already in use")
+	}
+
+	delete(Pdfium.documentRefs, document)
+	delete(p.documentRefs, document)
+
+	documentHandle.nativeRef = reference
+	Pdfium.documentRefs[documentHandle.nativeRef] = documentHandle
+	p.documentRefs[documentHandle.nativeRef] = documentHandle
+
+	return nil
+}
+
 func (p *PdfiumImplementation) Close() error {
 	p.Lock()
 	defer p.Unlock()
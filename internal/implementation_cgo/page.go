@@ -47,7 +47,9 @@ func (p *PdfiumImplementation) loadPage(page requests.Page) (*PageHandle, error)
 
 	pageObject := C.FPDF_LoadPage(documentHandle.handle, C.int(page.ByIndex.Index))
 	if pageObject == nil {
-		return nil, pdfium_errors.ErrPage
+		pageError := pdfium_errors.NewCodeError(pdfium_errors.CodePage)
+		pageError.Document = documentHandle.nativeRef
+		return nil, pageError
 	}
 
 	nativePage := p.registerPage(pageObject, page.ByIndex.Index, documentHandle)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/klippa-app/go-pdfium"
 	"github.com/klippa-app/go-pdfium/internal/commons"
+	"github.com/klippa-app/go-pdfium/internal/sandbox"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-plugin"
@@ -21,6 +22,13 @@ func StartPlugin(config *pdfium.LibraryConfig) {
 
 	Pdfium.logger = logger
 
+	// Apply the sandbox after loading PDFium, so that PDFium can still read
+	// the files that it needs to start.
+	if err := sandbox.Apply(); err != nil {
+		logger.Error("could not apply sandbox", "error", err)
+		os.Exit(1)
+	}
+
 	instance := Pdfium.GetInstance()
 
 	// pluginMap is the map of plugins we can dispense.
@@ -28,9 +36,19 @@ func StartPlugin(config *pdfium.LibraryConfig) {
 		"pdfium": &commons.PdfiumPlugin{Impl: instance},
 	}
 
+	// grpcPluginMap is served as protocol version 2, for hosts that use the
+	// gRPC transport.
+	var grpcPluginMap = map[int]plugin.PluginSet{
+		2: {
+			"pdfium": &commons.PdfiumGRPCPlugin{Impl: instance},
+		},
+	}
+
 	plugin.Serve(&plugin.ServeConfig{
-		HandshakeConfig: handshakeConfig,
-		Plugins:         pluginMap,
+		HandshakeConfig:  handshakeConfig,
+		Plugins:          pluginMap,
+		VersionedPlugins: grpcPluginMap,
+		GRPCServer:       commons.NewGRPCServer,
 	})
 }
 
@@ -15,6 +15,7 @@ import (
 	"image/draw"
 	"image/jpeg"
 	"image/png"
+	"io"
 	"io/ioutil"
 	"math"
 	"os"
@@ -22,9 +23,11 @@ import (
 
 	"github.com/klippa-app/go-pdfium/enums"
 	"github.com/klippa-app/go-pdfium/internal/image/image_jpeg"
+	"github.com/klippa-app/go-pdfium/internal/image/image_tiff"
 	"github.com/klippa-app/go-pdfium/references"
 	"github.com/klippa-app/go-pdfium/requests"
 	"github.com/klippa-app/go-pdfium/responses"
+	"github.com/klippa-app/go-pdfium/structs"
 )
 
 // getPageSize returns the points size of a page given the PDFium page index.
@@ -123,6 +126,8 @@ func (p *PdfiumImplementation) RenderPageInDPI(request *requests.RenderPageInDPI
 			RenderForm:        request.RenderForm,
 			Document:          request.Document,
 			ImageFormat:       request.ImageFormat,
+			ColorScheme:       request.ColorScheme,
+			BackgroundColor:   request.BackgroundColor,
 		},
 	}, 0)
 	if err != nil {
@@ -181,6 +186,8 @@ func (p *PdfiumImplementation) RenderPagesInDPI(request *requests.RenderPagesInD
 			RenderForm:        request.Pages[i].RenderForm,
 			Document:          request.Pages[i].Document,
 			ImageFormat:       request.Pages[i].ImageFormat,
+			ColorScheme:       request.Pages[i].ColorScheme,
+			BackgroundColor:   request.Pages[i].BackgroundColor,
 		}
 	}
 
@@ -262,6 +269,8 @@ func (p *PdfiumImplementation) RenderPageInPixels(request *requests.RenderPageIn
 			RenderForm:        request.RenderForm,
 			Document:          request.Document,
 			ImageFormat:       request.ImageFormat,
+			ColorScheme:       request.ColorScheme,
+			BackgroundColor:   request.BackgroundColor,
 		},
 	}, 0)
 	if err != nil {
@@ -322,6 +331,8 @@ func (p *PdfiumImplementation) RenderPagesInPixels(request *requests.RenderPages
 			RenderForm:        request.Pages[i].RenderForm,
 			Document:          request.Pages[i].Document,
 			ImageFormat:       request.Pages[i].ImageFormat,
+			ColorScheme:       request.Pages[i].ColorScheme,
+			BackgroundColor:   request.Pages[i].BackgroundColor,
 		}
 	}
 
@@ -335,6 +346,98 @@ func (p *PdfiumImplementation) RenderPagesInPixels(request *requests.RenderPages
 	}, nil
 }
 
+// calculateRegionSize returns the size in pixels and the point to pixel
+// ratio of a region. The given width and height are a maximum, like for
+// RenderPageInPixels, when no DPI is given.
+func calculateRegionSize(region requests.RenderRegion, dpi, width, height int) (int, int, float64, error) {
+	if region.Width <= 0 || region.Height <= 0 {
+		return 0, 0, 0, errors.New("no region width or height given")
+	}
+
+	if dpi > 0 {
+		scale := float64(dpi) / 72.0
+		return int(math.Ceil(region.Width * scale)), int(math.Ceil(region.Height * scale)), scale, nil
+	}
+
+	if width == 0 && height == 0 {
+		return 0, 0, 0, errors.New("no DPI, width or height given")
+	}
+
+	scale := float64(width) / region.Width
+	if width == 0 || (height > 0 && float64(height)/region.Height < scale) {
+		scale = float64(height) / region.Height
+	}
+
+	// Floating point errors could make the region a pixel larger than the
+	// given maximum, which is used by callers that need an exact size, so
+	// they are ignored when rounding up, and the size is clamped.
+	regionWidth := ceilPixels(region.Width * scale)
+	regionHeight := ceilPixels(region.Height * scale)
+	if width > 0 && regionWidth > width {
+		regionWidth = width
+	}
+	if height > 0 && regionHeight > height {
+		regionHeight = height
+	}
+
+	return regionWidth, regionHeight, scale, nil
+}
+
+// ceilPixels rounds a size in pixels up, without the floating point errors of
+// a scale that was calculated from a size in pixels.
+func ceilPixels(size float64) int {
+	return int(math.Ceil(size - 1e-9))
+}
+
+// RenderPageRegion renders a region of a page in a specific dpi or pixel
+// size, the result is an image of only the region.
+func (p *PdfiumImplementation) RenderPageRegion(request *requests.RenderPageRegion) (*responses.RenderPageRegion, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	err := validateRenderImageFormat(request.ImageFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	width, height, pointToPixelRatio, err := calculateRegionSize(request.Region, request.DPI, request.Width, request.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	region := request.Region
+	result, err := p.renderPages([]renderPage{
+		{
+			Page:              request.Page,
+			Width:             width,
+			Height:            height,
+			PointToPixelRatio: pointToPixelRatio,
+			Flags:             request.RenderFlags,
+			RenderForm:        request.RenderForm,
+			Document:          request.Document,
+			ImageFormat:       request.ImageFormat,
+			ColorScheme:       request.ColorScheme,
+			BackgroundColor:   request.BackgroundColor,
+			Region:            &region,
+		},
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &responses.RenderPageRegion{
+		Result: responses.RenderPage{
+			Page:              result.Pages[0].Page,
+			Image:             result.Image,
+			RenderedImage:     result.RenderedImage,
+			PointToPixelRatio: pointToPixelRatio,
+			Width:             width,
+			Height:            height,
+			HasTransparency:   result.Pages[0].HasTransparency,
+		},
+	}, nil
+}
+
 type renderPage struct {
 	Page              requests.Page
 	Flags             enums.FPDF_RENDER_FLAG
@@ -344,6 +447,20 @@ type renderPage struct {
 	RenderForm        bool
 	Document          *references.FPDF_DOCUMENT
 	ImageFormat       requests.RenderImageFormat
+	Region            *requests.RenderRegion // Only render this region of the page, Width and Height are the size of the region.
+	ColorScheme       *structs.FPDF_COLORSCHEME
+	BackgroundColor   *structs.FPDF_COLOR
+}
+
+// backgroundFillColor returns the background color as the ARGB value of
+// FPDFBitmap_FillRect. The fill doesn't use FPDF_REVERSE_BYTE_ORDER, so red
+// and blue are swapped for RGBA bitmaps, to end up in the right place.
+func backgroundFillColor(backgroundColor structs.FPDF_COLOR, imageFormat requests.RenderImageFormat) uint64 {
+	if imageFormat == requests.RenderImageFormatGrayscale {
+		return uint64(backgroundColor.A&0xFF)<<24 | uint64(backgroundColor.R&0xFF)<<16 | uint64(backgroundColor.G&0xFF)<<8 | uint64(backgroundColor.B&0xFF)
+	}
+
+	return uint64(backgroundColor.A&0xFF)<<24 | uint64(backgroundColor.B&0xFF)<<16 | uint64(backgroundColor.G&0xFF)<<8 | uint64(backgroundColor.R&0xFF)
 }
 
 // validateRenderImageFormat validates the given image format. An empty
@@ -418,7 +535,7 @@ func (p *PdfiumImplementation) renderPages(pages []renderPage, padding int) (*re
 			X:                 0,
 			Y:                 currentOffset,
 		}
-		index, hasTransparency, err := p.renderPage(bitmap, pages[i].Document, pages[i].Page, pages[i].Width, pages[i].Height, currentOffset, pages[i].Flags, pages[i].RenderForm, imageFormat)
+		index, hasTransparency, err := p.renderPage(bitmap, pages[i], currentOffset, imageFormat)
 		if err != nil {
 			// Release the bitmap handle, it would otherwise leak on render
 			// errors. This does not touch the Go image pixel buffer.
@@ -444,8 +561,12 @@ func (p *PdfiumImplementation) renderPages(pages []renderPage, padding int) (*re
 }
 
 // renderPage renders a specific page in a specific size on a bitmap.
-func (p *PdfiumImplementation) renderPage(bitmap C.FPDF_BITMAP, document *references.FPDF_DOCUMENT, page requests.Page, width, height, offset int, flags enums.FPDF_RENDER_FLAG, renderForm bool, imageFormat requests.RenderImageFormat) (int, bool, error) {
-	pageHandle, err := p.loadPage(page)
+func (p *PdfiumImplementation) renderPage(bitmap C.FPDF_BITMAP, page renderPage, offset int, imageFormat requests.RenderImageFormat) (int, bool, error) {
+	width := page.Width
+	height := page.Height
+	document := page.Document
+
+	pageHandle, err := p.loadPage(page.Page)
 	if err != nil {
 		return 0, false, err
 	}
@@ -457,7 +578,7 @@ func (p *PdfiumImplementation) renderPage(bitmap C.FPDF_BITMAP, document *refere
 
 	hasTransparency := int(alpha) == 1
 
-	renderFlags := C.int(flags)
+	renderFlags := C.int(page.Flags)
 	if imageFormat == requests.RenderImageFormatGrayscale {
 		// A grayscale bitmap has no alpha channel, so the transparent black
 		// fill can't be represented, always fill white like a PDF viewer.
@@ -475,15 +596,62 @@ func (p *PdfiumImplementation) renderPage(bitmap C.FPDF_BITMAP, document *refere
 		renderFlags |= C.FPDF_REVERSE_BYTE_ORDER
 	}
 
+	if page.BackgroundColor != nil {
+		fillColor = backgroundFillColor(*page.BackgroundColor, imageFormat)
+	}
+
 	// Fill the page rect with the specified color.
 	C.FPDFBitmap_FillRect(bitmap, 0, C.int(offset), C.int(width), C.int(height), C.ulong(fillColor))
 
-	// Render the bitmap into the given external bitmap.
-	C.FPDF_RenderPageBitmap(bitmap, pageHandle.handle, 0, C.int(offset), C.int(width), C.int(height), 0, renderFlags)
+	// The position and size of the full page on the bitmap.
+	startX := 0
+	startY := offset
+	sizeX := width
+	sizeY := height
+
+	if page.Region != nil {
+		scale := page.PointToPixelRatio
+		startX = int(math.Round(-page.Region.X * scale))
+		startY = offset + int(math.Round(-page.Region.Y*scale))
+		sizeX = int(math.Round(float64(C.FPDF_GetPageWidth(pageHandle.handle)) * scale))
+		sizeY = int(math.Round(float64(C.FPDF_GetPageHeight(pageHandle.handle)) * scale))
+	}
+
+	if page.ColorScheme != nil {
+		// There is no color scheme variant of the render with a matrix, a
+		// region is rendered by rendering the full page on its position on
+		// the bitmap, which clips it to the region.
+		err = p.renderPageBitmapWithColorScheme(bitmap, pageHandle.handle, startX, startY, sizeX, sizeY, renderFlags, *page.ColorScheme)
+		if err != nil {
+			return 0, false, err
+		}
+	} else if page.Region != nil {
+		// Move the region to the position on the bitmap and scale it, the
+		// clip rect makes sure that only the region is rendered.
+		scale := page.PointToPixelRatio
+		matrix := C.FS_MATRIX{
+			a: C.float(scale),
+			d: C.float(scale),
+			e: C.float(-page.Region.X * scale),
+			f: C.float(float64(offset) - page.Region.Y*scale),
+		}
 
-	if renderForm {
-		if document == nil && page.ByIndex != nil {
-			document = &page.ByIndex.Document
+		clipping := C.FS_RECTF{
+			left:   0,
+			top:    C.float(offset),
+			right:  C.float(width),
+			bottom: C.float(offset + height),
+		}
+
+		C.FPDF_RenderPageBitmapWithMatrix(bitmap, pageHandle.handle, &matrix, &clipping, renderFlags)
+	} else {
+		// Render the bitmap into the given external bitmap.
+		C.FPDF_RenderPageBitmap(bitmap, pageHandle.handle, 0, C.int(offset), C.int(width), C.int(height), 0, renderFlags)
+	}
+
+	if page.RenderForm {
+		if document == nil && page.Page.ByIndex != nil {
+			document = &page.Page.ByIndex.Document
 		}
 		if document == nil {
 			return 0, false, errors.New("document is required when rendering forms")
@@ -501,7 +669,7 @@ func (p *PdfiumImplementation) renderPage(bitmap C.FPDF_BITMAP, document *refere
 			return 0, false, errors.New("could not init form fill environment")
 		}
 
-		C.FPDF_FFLDraw(formFillEnvironment, bitmap, pageHandle.handle, 0, C.int(offset), C.int(width), C.int(height), 0, renderFlags)
+		C.FPDF_FFLDraw(formFillEnvironment, bitmap, pageHandle.handle, C.int(startX), C.int(startY), C.int(sizeX), C.int(sizeY), 0, renderFlags)
 		C.FPDFDOC_ExitFormFillEnvironment(formFillEnvironment)
 	}
 
@@ -600,6 +768,30 @@ func (p *PdfiumImplementation) RenderToFile(request *requests.RenderToFile) (*re
 			Height: resp.Result.Height,
 			Pages:  resp.Result.Pages,
 		}
+	} else if request.RenderPageRegion != nil {
+		resp, err := p.RenderPageRegion(request.RenderPageRegion)
+		if err != nil {
+			return nil, err
+		}
+
+		renderedImage = resp.Result.RenderedImage
+		hasTransparency = resp.Result.HasTransparency
+		myResp = &responses.RenderToFile{
+			Width:             resp.Result.Width,
+			Height:            resp.Result.Height,
+			PointToPixelRatio: resp.Result.PointToPixelRatio,
+			Pages: []responses.RenderPagesPage{
+				{
+					Page:              resp.Result.Page,
+					PointToPixelRatio: resp.Result.PointToPixelRatio,
+					Width:             resp.Result.Width,
+					Height:            resp.Result.Height,
+					X:                 0,
+					Y:                 0,
+					HasTransparency:   resp.Result.HasTransparency,
+				},
+			},
+		}
 	} else {
 		return nil, errors.New("no render operation given")
 	}
@@ -662,6 +854,15 @@ func (p *PdfiumImplementation) RenderToFile(request *requests.RenderToFile) (*re
 			return nil, err
 		}
 
+		if request.MaxFileSize != 0 && int64(imgBuf.Len()) > request.MaxFileSize {
+			return nil, errors.New("PDF image would exceed maximum filesize")
+		}
+	} else if request.OutputFormat == requests.RenderToFileOutputFormatTIFF {
+		err := encodeTIFF(&imgBuf, renderedImage, myResp.Pages, request)
+		if err != nil {
+			return nil, err
+		}
+
 		if request.MaxFileSize != 0 && int64(imgBuf.Len()) > request.MaxFileSize {
 			return nil, errors.New("PDF image would exceed maximum filesize")
 		}
@@ -705,3 +906,50 @@ func (p *PdfiumImplementation) RenderToFile(request *requests.RenderToFile) (*re
 
 	return myResp, nil
 }
+
+// encodeTIFF writes every page of the rendered image as its own page of a
+// TIFF file.
+func encodeTIFF(w io.Writer, renderedImage image.Image, pages []responses.RenderPagesPage, request *requests.RenderToFile) error {
+	subImager, ok := renderedImage.(interface {
+		SubImage(r image.Rectangle) image.Image
+	})
+	if !ok {
+		return errors.New("could not split rendered image into pages")
+	}
+
+	tiffPages := make([]image_tiff.Page, len(pages))
+	for i, page := range pages {
+		compression := request.TIFFCompression
+		if i < len(request.TIFFPageCompression) && request.TIFFPageCompression[i] != "" {
+			compression = request.TIFFPageCompression[i]
+		}
+
+		tiffCompression, err := tiffCompression(compression)
+		if err != nil {
+			return err
+		}
+
+		tiffPages[i] = image_tiff.Page{
+			Image:       subImager.SubImage(image.Rect(page.X, page.Y, page.X+page.Width, page.Y+page.Height)),
+			Compression: tiffCompression,
+			DPI:         page.PointToPixelRatio * 72,
+		}
+	}
+
+	return image_tiff.Encode(w, tiffPages)
+}
+
+func tiffCompression(compression requests.RenderToFileTIFFCompression) (image_tiff.Compression, error) {
+	switch compression {
+	case "", requests.RenderToFileTIFFCompressionDeflate:
+		return image_tiff.CompressionDeflate, nil
+	case requests.RenderToFileTIFFCompressionLZW:
+		return image_tiff.CompressionLZW, nil
+	case requests.RenderToFileTIFFCompressionNone:
+		return image_tiff.CompressionNone, nil
+	case requests.RenderToFileTIFFCompressionCCITTG4:
+		return image_tiff.CompressionCCITTG4, nil
+	}
+
+	return 0, errors.New("invalid TIFF compression given")
+}
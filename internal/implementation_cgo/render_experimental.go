@@ -0,0 +1,50 @@
+//go:build pdfium_experimental
+// +build pdfium_experimental
+
+package implementation_cgo
+
+/*
+#cgo pkg-config: pdfium
+#include "fpdfview.h"
+#include "fpdf_progressive.h"
+
+static FPDF_BOOL render_never_pause_cb(struct _IFSDK_PAUSE *me) {
+	return 0;
+}
+
+static inline void IFSDK_PAUSE_SET_NEVER_PAUSE(IFSDK_PAUSE *p) {
+	p->NeedToPauseNow = &render_never_pause_cb;
+}
+*/
+import "C"
+
+import (
+	"errors"
+
+	"github.com/klippa-app/go-pdfium/structs"
+)
+
+// renderPageBitmapWithColorScheme renders a page with a color scheme on the
+// bitmap. The progressive render is never paused, so it's done in one call.
+func (p *PdfiumImplementation) renderPageBitmapWithColorScheme(bitmap C.FPDF_BITMAP, page C.FPDF_PAGE, startX, startY, sizeX, sizeY int, flags C.int, colorScheme structs.FPDF_COLORSCHEME) error {
+	pauseStruct := &C.IFSDK_PAUSE{}
+	pauseStruct.version = 1
+	C.IFSDK_PAUSE_SET_NEVER_PAUSE(pauseStruct)
+
+	cColorScheme := &C.FPDF_COLORSCHEME{}
+	cColorScheme.path_fill_color = C.FPDF_DWORD(colorScheme.PathFillColor)
+	cColorScheme.path_stroke_color = C.FPDF_DWORD(colorScheme.PathStrokeColor)
+	cColorScheme.text_fill_color = C.FPDF_DWORD(colorScheme.TextFillColor)
+	cColorScheme.text_stroke_color = C.FPDF_DWORD(colorScheme.TextStrokeColor)
+
+	renderStatus := C.FPDF_RenderPageBitmapWithColorScheme_Start(bitmap, page, C.int(startX), C.int(startY), C.int(sizeX), C.int(sizeY), 0, flags, cColorScheme, pauseStruct)
+
+	// Release the resources of the progressive render.
+	C.FPDF_RenderPage_Close(page)
+
+	if renderStatus != C.FPDF_RENDER_DONE {
+		return errors.New("could not render page with color scheme")
+	}
+
+	return nil
+}
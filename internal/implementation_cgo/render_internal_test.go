@@ -0,0 +1,44 @@
+package implementation_cgo
+
+import (
+	"testing"
+
+	"github.com/klippa-app/go-pdfium/requests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateRegionSize(t *testing.T) {
+	// A DPI scales the region.
+	width, height, scale, err := calculateRegionSize(requests.RenderRegion{Width: 72, Height: 36}, 144, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 144, width)
+	assert.Equal(t, 72, height)
+	assert.Equal(t, 2.0, scale)
+
+	// The width and height are a maximum, the aspect ratio is kept.
+	width, height, _, err = calculateRegionSize(requests.RenderRegion{Width: 100, Height: 50}, 0, 400, 400)
+	require.NoError(t, err)
+	assert.Equal(t, 400, width)
+	assert.Equal(t, 200, height)
+
+	// 33.3 * (21 / 33.3) is 21.000000000000004, which must not be rounded
+	// up to a pixel more than the given width.
+	width, height, _, err = calculateRegionSize(requests.RenderRegion{Width: 33.3, Height: 33.3}, 0, 21, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 21, width)
+	assert.Equal(t, 21, height)
+
+	// 0.3 * (7 / 0.3) is 7.000000000000001, the same goes for the height.
+	width, height, _, err = calculateRegionSize(requests.RenderRegion{Width: 0.3, Height: 0.3}, 0, 0, 7)
+	require.NoError(t, err)
+	assert.Equal(t, 7, width)
+	assert.Equal(t, 7, height)
+
+	_, _, _, err = calculateRegionSize(requests.RenderRegion{Width: 0, Height: 10}, 72, 0, 0)
+	assert.EqualError(t, err, "no region width or height given")
+
+	_, _, _, err = calculateRegionSize(requests.RenderRegion{Width: 10, Height: 10}, 0, 0, 0)
+	assert.EqualError(t, err, "no DPI, width or height given")
+}
@@ -0,0 +1,19 @@
+//go:build !pdfium_experimental
+// +build !pdfium_experimental
+
+package implementation_cgo
+
+// #cgo pkg-config: pdfium
+// #include "fpdfview.h"
+import "C"
+
+import (
+	pdfium_errors "github.com/klippa-app/go-pdfium/errors"
+	"github.com/klippa-app/go-pdfium/structs"
+)
+
+// renderPageBitmapWithColorScheme renders a page with a color scheme on the
+// bitmap. The color scheme is only available in the experimental API.
+func (p *PdfiumImplementation) renderPageBitmapWithColorScheme(bitmap C.FPDF_BITMAP, page C.FPDF_PAGE, startX, startY, sizeX, sizeY int, flags C.int, colorScheme structs.FPDF_COLORSCHEME) error {
+	return pdfium_errors.ErrExperimentalUnsupported
+}
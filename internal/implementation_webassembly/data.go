@@ -11,6 +11,7 @@ import (
 	"unsafe"
 
 	"github.com/klippa-app/go-pdfium/enums"
+	pdfium_errors "github.com/klippa-app/go-pdfium/errors"
 	"github.com/klippa-app/go-pdfium/structs"
 
 	"github.com/tetratelabs/wazero/api"
@@ -713,7 +714,7 @@ func (p *PdfiumImplementation) MallocNoZero(size uint64) (uint64, error) {
 	pointer := results[0]
 	if pointer == 0 && size > 0 {
 		// malloc returned NULL: the instance is out of WebAssembly memory.
-		return 0, errors.New("could not allocate memory")
+		return 0, pdfium_errors.NewOutOfMemoryError("", errors.New("could not allocate memory"))
 	}
 
 	return pointer, nil
@@ -0,0 +1,54 @@
+package implementation_webassembly
+
+import (
+	"io"
+	"io/fs"
+	"os"
+)
+
+// FileSystem is used for the files that are opened and created by Go instead
+// of by PDFium, like the files of FPDF_SaveAsCopy and RenderToFile, so that
+// they end up in the same file system as the files of the module.
+type FileSystem interface {
+	// Open opens a file for reading.
+	Open(name string) (File, error)
+
+	// Create creates or truncates a file for writing.
+	Create(name string) (File, error)
+
+	// CreateTemp creates a new temporary file for writing.
+	CreateTemp() (File, error)
+}
+
+// File is a file of a FileSystem, it's implemented by *os.File.
+type File interface {
+	io.ReadWriteSeeker
+	io.Closer
+	Name() string
+	Stat() (fs.FileInfo, error)
+}
+
+// osFileSystem is the FileSystem of the host.
+type osFileSystem struct{}
+
+func (osFileSystem) Open(name string) (File, error) {
+	return os.Open(name)
+}
+
+func (osFileSystem) Create(name string) (File, error) {
+	return os.Create(name)
+}
+
+func (osFileSystem) CreateTemp() (File, error) {
+	return os.CreateTemp("", "")
+}
+
+// fileSystem returns the FileSystem of the instance, the file system of the
+// host when none is set.
+func (p *PdfiumImplementation) fileSystem() FileSystem {
+	if p.FileSystem == nil {
+		return osFileSystem{}
+	}
+
+	return p.FileSystem
+}
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"io"
 	"math"
-	"os"
 	"unsafe"
 
 	"github.com/klippa-app/go-pdfium/enums"
@@ -550,7 +549,7 @@ func (p *PdfiumImplementation) FPDFImageObj_LoadJpegFile(request *requests.FPDFI
 		fileReader = NewBytesReaderCloser(request.FileData)
 		request.FileReaderSize = int64(len(request.FileData))
 	} else {
-		openedFile, err := os.Open(request.FilePath)
+		openedFile, err := p.fileSystem().Open(request.FilePath)
 		if err != nil {
 			return nil, err
 		}
@@ -616,7 +615,7 @@ func (p *PdfiumImplementation) FPDFImageObj_LoadJpegFileInline(request *requests
 		fileReader = NewBytesReaderCloser(request.FileData)
 		request.FileReaderSize = int64(len(request.FileData))
 	} else {
-		openedFile, err := os.Open(request.FilePath)
+		openedFile, err := p.fileSystem().Open(request.FilePath)
 		if err != nil {
 			return nil, err
 		}
@@ -81,7 +81,6 @@ func (p *PdfiumImplementation) FPDF_RenderPageBitmap_Start(request *requests.FPD
 }
 
 // FPDF_RenderPage_Continue continues rendering a PDF page.
-// Not supported on multi-threaded usage.
 func (p *PdfiumImplementation) FPDF_RenderPage_Continue(request *requests.FPDF_RenderPage_Continue) (*responses.FPDF_RenderPage_Continue, error) {
 	p.Lock()
 	defer p.Unlock()
@@ -138,7 +137,6 @@ func (p *PdfiumImplementation) FPDF_RenderPage_Continue(request *requests.FPDF_R
 }
 
 // FPDF_RenderPage_Close Release the resource allocate during page rendering. Need to be called after finishing rendering or cancel the rendering.
-// Not supported on multi-threaded usage.
 func (p *PdfiumImplementation) FPDF_RenderPage_Close(request *requests.FPDF_RenderPage_Close) (*responses.FPDF_RenderPage_Close, error) {
 	p.Lock()
 	defer p.Unlock()
@@ -166,7 +164,6 @@ func (p *PdfiumImplementation) FPDF_RenderPage_Close(request *requests.FPDF_Rend
 }
 
 // FPDF_RenderPageBitmapWithColorScheme_Start starts to render page contents to a device independent bitmap progressively with a specified color scheme for the content.
-// Not supported on multi-threaded usage.
 // Experimental API.
 func (p *PdfiumImplementation) FPDF_RenderPageBitmapWithColorScheme_Start(request *requests.FPDF_RenderPageBitmapWithColorScheme_Start) (*responses.FPDF_RenderPageBitmapWithColorScheme_Start, error) {
 	p.Lock()
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"errors"
 	"io"
-	"os"
 	"unsafe"
 
 	"github.com/klippa-app/go-pdfium/requests"
@@ -55,12 +54,12 @@ func (p *PdfiumImplementation) FPDF_SaveWithVersion(request *requests.FPDF_SaveW
 	defer p.Free(fileWriterPointer)
 
 	var fileBuf *bytes.Buffer
-	var curFile *os.File
+	var curFile File
 	var currentWriter io.Writer
 	if request.FileWriter != nil {
 		currentWriter = request.FileWriter
 	} else if request.FilePath != nil {
-		newFile, err := os.Create(*request.FilePath)
+		newFile, err := p.fileSystem().Create(*request.FilePath)
 		if err != nil {
 			return nil, err
 		}
@@ -71,10 +71,9 @@ func (p *PdfiumImplementation) FPDF_LoadMemDocument64(request *requests.FPDF_Loa
 
 // FPDF_LoadCustomDocument loads a PDF document from a custom access descriptor.
 // This is implemented as an io.ReadSeeker in go-pdfium.
-// This is only really efficient for single threaded usage, the multi-threaded
-// usage will just load the file in memory because it can't transfer readers
-// over gRPC. The single-threaded usage will actually efficiently walk over
-// the PDF as it's being used by PDFium.
+// PDFium will efficiently walk over the PDF as it's being used. On
+// multi-threaded usage every block that PDFium reads is requested from the
+// reader in the host process.
 // Loaded document can be closed by FPDF_CloseDocument().
 // If this function fails, you can use FPDF_GetLastError() to retrieve
 // the reason why it failed.
@@ -169,7 +168,9 @@ func (p *PdfiumImplementation) FPDF_LoadPage(request *requests.FPDF_LoadPage) (*
 	}
 
 	if len(pageObject) == 0 || pageObject[0] == 0 {
-		return nil, pdfium_errors.ErrPage
+		pageError := pdfium_errors.NewCodeError(pdfium_errors.CodePage)
+		pageError.Document = documentHandle.nativeRef
+		return nil, pageError
 	}
 
 	pageHandle := p.registerPage(pageObject[0], request.Index, documentHandle)
@@ -129,6 +129,10 @@ type PdfiumImplementation struct {
 	Functions map[string]api.Function
 	Module    api.Module
 
+	// FileSystem is used for the files that Go opens and creates, the file
+	// system of the host when nil.
+	FileSystem FileSystem
+
 	// fnCache memoizes Module.ExportedFunction lookups: every lookup
 	// allocates a fresh call engine (~12 KB), so resolving each export once
 	// per instance matters. Guarded by fnCacheMutex because some callers
@@ -333,25 +337,7 @@ func (p *PdfiumImplementation) OpenDocument(request *requests.OpenDocument) (*re
 			return nil, err
 		}
 
-		var pdfiumError error
-		switch FPDF_ERR(errorCode[0]) {
-		case FPDF_ERR_SUCCESS:
-			pdfiumError = pdfium_errors.ErrSuccess
-		case FPDF_ERR_UNKNOWN:
-			pdfiumError = pdfium_errors.ErrUnknown
-		case FPDF_ERR_FILE:
-			pdfiumError = pdfium_errors.ErrFile
-		case FPDF_ERR_FORMAT:
-			pdfiumError = pdfium_errors.ErrFormat
-		case FPDF_ERR_PASSWORD:
-			pdfiumError = pdfium_errors.ErrPassword
-		case FPDF_ERR_SECURITY:
-			pdfiumError = pdfium_errors.ErrSecurity
-		case FPDF_ERR_PAGE:
-			pdfiumError = pdfium_errors.ErrPage
-		default:
-			pdfiumError = pdfium_errors.ErrUnexpected
-		}
+		pdfiumError := pdfium_errors.NewCodeError(int(errorCode[0]))
 
 		// Cleanup when file loading didn't work.
 		if nativeDoc.fileHandleRef != nil {
@@ -21,9 +21,10 @@ var _ = BeforeSuite(func() {
 	Expect(err).To(BeNil())
 
 	pool, err := webassembly.Init(webassembly.Config{
-		MinIdle:  1,
-		MaxIdle:  1,
-		MaxTotal: 1,
+		MinIdle:     1,
+		MaxIdle:     1,
+		MaxTotal:    1,
+		MountHostFS: true, // The shared tests load the test data by path.
 	})
 	Expect(err).To(BeNil())
 	shared_tests.PdfiumPool = pool
@@ -0,0 +1,54 @@
+package implementation_webassembly
+
+import (
+	pdfium_errors "github.com/klippa-app/go-pdfium/errors"
+)
+
+const (
+	// wasmPageSize is the size of a page of WebAssembly memory.
+	wasmPageSize = 65536
+
+	// wasmMaxPages is the maximum number of pages of 32-bit WebAssembly
+	// memory.
+	wasmMaxPages = 65536
+)
+
+// MemorySize returns the current size of the memory of the module in bytes.
+func (p *PdfiumImplementation) MemorySize() uint64 {
+	return uint64(p.Module.Memory().Size())
+}
+
+// MemoryLimit returns the size in bytes to which the memory of the module can
+// grow, which is limited by the memory limit of the runtime.
+func (p *PdfiumImplementation) MemoryLimit() uint64 {
+	if maxPages, ok := p.Module.Memory().Definition().Max(); ok {
+		return uint64(maxPages) * wasmPageSize
+	}
+
+	return wasmMaxPages * wasmPageSize
+}
+
+// MemoryExhausted returns whether the memory of the module can't grow by the
+// given amount of bytes anymore.
+func (p *PdfiumImplementation) MemoryExhausted(size uint64) bool {
+	return p.MemorySize()+size > p.MemoryLimit()
+}
+
+// MemoryAtLimit returns whether the memory of the module can't grow by another
+// page anymore. An allocation that fails inside PDFium isn't reported as such,
+// so a call that fails after the memory grew to the limit during the call most
+// likely failed because it ran out of memory.
+func (p *PdfiumImplementation) MemoryAtLimit() bool {
+	return p.MemoryExhausted(wasmPageSize)
+}
+
+// outOfMemoryError returns an out of memory error when the memory of the
+// module can't grow by the given amount of bytes anymore, or the given error
+// otherwise.
+func (p *PdfiumImplementation) outOfMemoryError(size uint64, err error) error {
+	if p.MemoryExhausted(size) {
+		return pdfium_errors.NewOutOfMemoryError("", err)
+	}
+
+	return err
+}
@@ -0,0 +1,116 @@
+package implementation_webassembly
+
+import (
+	"sort"
+
+	"github.com/klippa-app/go-pdfium/references"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+)
+
+// ListOpenHandles returns the handles that have been opened and not closed
+// yet, with their counts by handle type, per document.
+func (p *PdfiumImplementation) ListOpenHandles(request *requests.ListOpenHandles) (*responses.ListOpenHandles, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	if request.Document != "" {
+		documentHandle, err := p.getDocumentHandle(request.Document)
+		if err != nil {
+			return nil, err
+		}
+
+		return &responses.ListOpenHandles{
+			Documents: []responses.DocumentOpenHandles{documentHandle.openHandles()},
+		}, nil
+	}
+
+	documents := []responses.DocumentOpenHandles{}
+
+	instanceHandles := responses.DocumentOpenHandles{}
+	appendOpenHandles(&instanceHandles, "FPDF_AVAIL", p.dataAvailRefs)
+	appendOpenHandles(&instanceHandles, "FPDF_ANNOTATION", p.annotationRefs)
+	appendOpenHandles(&instanceHandles, "FPDF_BITMAP", p.bitmapRefs)
+	appendOpenHandles(&instanceHandles, "FPDF_CLIPPATH", p.clipPathRefs)
+	appendOpenHandles(&instanceHandles, "FPDF_FONT", p.fontRefs)
+	appendOpenHandles(&instanceHandles, "FPDF_FORMHANDLE", p.formHandleRefs)
+	appendOpenHandles(&instanceHandles, "FPDF_XOBJECT", p.xObjectRefs)
+	if len(instanceHandles.Handles) > 0 {
+		documents = append(documents, sortOpenHandles(instanceHandles))
+	}
+
+	documentRefs := make([]references.FPDF_DOCUMENT, 0, len(p.documentRefs))
+	for documentRef := range p.documentRefs {
+		documentRefs = append(documentRefs, documentRef)
+	}
+	sort.Slice(documentRefs, func(i, j int) bool {
+		return documentRefs[i] < documentRefs[j]
+	})
+
+	for _, documentRef := range documentRefs {
+		documents = append(documents, p.documentRefs[documentRef].openHandles())
+	}
+
+	return &responses.ListOpenHandles{
+		Documents: documents,
+	}, nil
+}
+
+// openHandles returns the open handles of the document that have to be
+// closed by the user.
+func (d *DocumentHandle) openHandles() responses.DocumentOpenHandles {
+	documentHandles := responses.DocumentOpenHandles{
+		Document: d.nativeRef,
+	}
+
+	pageRefs := map[references.FPDF_PAGE]*PageHandle{}
+	for pageRef, pageHandle := range d.pageRefs {
+		// The current page is cached and closed by the library itself.
+		if d.currentPage != nil && pageHandle == d.currentPage {
+			continue
+		}
+		pageRefs[pageRef] = pageHandle
+	}
+
+	appendOpenHandles(&documentHandles, "FPDF_JAVASCRIPT_ACTION", d.javaScriptActionRefs)
+	appendOpenHandles(&documentHandles, "FPDF_PAGE", pageRefs)
+	appendOpenHandles(&documentHandles, "FPDF_PAGELINK", d.pageLinkRefs)
+	appendOpenHandles(&documentHandles, "FPDF_SCHHANDLE", d.searchRefs)
+	appendOpenHandles(&documentHandles, "FPDF_STRUCTTREE", d.structTreeRefs)
+	appendOpenHandles(&documentHandles, "FPDF_TEXTPAGE", d.textPageRefs)
+
+	return sortOpenHandles(documentHandles)
+}
+
+func appendOpenHandles[Reference ~string, Handle any](documentHandles *responses.DocumentOpenHandles, handleType string, refs map[Reference]Handle) {
+	if len(refs) == 0 {
+		return
+	}
+
+	for ref := range refs {
+		documentHandles.Handles = append(documentHandles.Handles, responses.OpenHandle{
+			Type:      handleType,
+			Reference: string(ref),
+		})
+	}
+
+	documentHandles.Counts = append(documentHandles.Counts, responses.OpenHandleCount{
+		Type:  handleType,
+		Count: len(refs),
+	})
+}
+
+func sortOpenHandles(documentHandles responses.DocumentOpenHandles) responses.DocumentOpenHandles {
+	sort.Slice(documentHandles.Counts, func(i, j int) bool {
+		return documentHandles.Counts[i].Type < documentHandles.Counts[j].Type
+	})
+
+	sort.Slice(documentHandles.Handles, func(i, j int) bool {
+		if documentHandles.Handles[i].Type != documentHandles.Handles[j].Type {
+			return documentHandles.Handles[i].Type < documentHandles.Handles[j].Type
+		}
+		return documentHandles.Handles[i].Reference < documentHandles.Handles[j].Reference
+	})
+
+	return documentHandles
+}
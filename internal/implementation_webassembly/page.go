@@ -49,7 +49,9 @@ func (p *PdfiumImplementation) loadPage(page requests.Page) (*PageHandle, error)
 	}
 
 	if len(pageObject) == 0 || pageObject[0] == 0 {
-		return nil, pdfium_errors.ErrPage
+		pageError := pdfium_errors.NewCodeError(pdfium_errors.CodePage)
+		pageError.Document = documentHandle.nativeRef
+		return nil, pageError
 	}
 
 	nativePage := p.registerPage(pageObject[0], page.ByIndex.Index, documentHandle)
@@ -9,16 +9,17 @@ import (
 	"image/draw"
 	"image/jpeg"
 	"image/png"
-	"io/ioutil"
+	"io"
 	"math"
-	"os"
 	"unsafe"
 
 	"github.com/klippa-app/go-pdfium/enums"
 	"github.com/klippa-app/go-pdfium/internal/image/image_jpeg"
+	"github.com/klippa-app/go-pdfium/internal/image/image_tiff"
 	"github.com/klippa-app/go-pdfium/references"
 	"github.com/klippa-app/go-pdfium/requests"
 	"github.com/klippa-app/go-pdfium/responses"
+	"github.com/klippa-app/go-pdfium/structs"
 )
 
 // getPageSize returns the points size of a page given the PDFium page index.
@@ -128,6 +129,8 @@ func (p *PdfiumImplementation) RenderPageInDPI(request *requests.RenderPageInDPI
 			RenderForm:        request.RenderForm,
 			Document:          request.Document,
 			ImageFormat:       request.ImageFormat,
+			ColorScheme:       request.ColorScheme,
+			BackgroundColor:   request.BackgroundColor,
 		},
 	}, 0)
 	if err != nil {
@@ -187,6 +190,8 @@ func (p *PdfiumImplementation) RenderPagesInDPI(request *requests.RenderPagesInD
 			RenderForm:        request.Pages[i].RenderForm,
 			Document:          request.Pages[i].Document,
 			ImageFormat:       request.Pages[i].ImageFormat,
+			ColorScheme:       request.Pages[i].ColorScheme,
+			BackgroundColor:   request.Pages[i].BackgroundColor,
 		}
 	}
 
@@ -269,6 +274,8 @@ func (p *PdfiumImplementation) RenderPageInPixels(request *requests.RenderPageIn
 			RenderForm:        request.RenderForm,
 			Document:          request.Document,
 			ImageFormat:       request.ImageFormat,
+			ColorScheme:       request.ColorScheme,
+			BackgroundColor:   request.BackgroundColor,
 		},
 	}, 0)
 	if err != nil {
@@ -330,6 +337,8 @@ func (p *PdfiumImplementation) RenderPagesInPixels(request *requests.RenderPages
 			RenderForm:        request.Pages[i].RenderForm,
 			Document:          request.Pages[i].Document,
 			ImageFormat:       request.Pages[i].ImageFormat,
+			ColorScheme:       request.Pages[i].ColorScheme,
+			BackgroundColor:   request.Pages[i].BackgroundColor,
 		}
 	}
 
@@ -344,6 +353,99 @@ func (p *PdfiumImplementation) RenderPagesInPixels(request *requests.RenderPages
 	}, nil
 }
 
+// calculateRegionSize returns the size in pixels and the point to pixel
+// ratio of a region. The given width and height are a maximum, like for
+// RenderPageInPixels, when no DPI is given.
+func calculateRegionSize(region requests.RenderRegion, dpi, width, height int) (int, int, float64, error) {
+	if region.Width <= 0 || region.Height <= 0 {
+		return 0, 0, 0, errors.New("no region width or height given")
+	}
+
+	if dpi > 0 {
+		scale := float64(dpi) / 72.0
+		return int(math.Ceil(region.Width * scale)), int(math.Ceil(region.Height * scale)), scale, nil
+	}
+
+	if width == 0 && height == 0 {
+		return 0, 0, 0, errors.New("no DPI, width or height given")
+	}
+
+	scale := float64(width) / region.Width
+	if width == 0 || (height > 0 && float64(height)/region.Height < scale) {
+		scale = float64(height) / region.Height
+	}
+
+	// Floating point errors could make the region a pixel larger than the
+	// given maximum, which is used by callers that need an exact size, so
+	// they are ignored when rounding up, and the size is clamped.
+	regionWidth := ceilPixels(region.Width * scale)
+	regionHeight := ceilPixels(region.Height * scale)
+	if width > 0 && regionWidth > width {
+		regionWidth = width
+	}
+	if height > 0 && regionHeight > height {
+		regionHeight = height
+	}
+
+	return regionWidth, regionHeight, scale, nil
+}
+
+// ceilPixels rounds a size in pixels up, without the floating point errors of
+// a scale that was calculated from a size in pixels.
+func ceilPixels(size float64) int {
+	return int(math.Ceil(size - 1e-9))
+}
+
+// RenderPageRegion renders a region of a page in a specific dpi or pixel
+// size, the result is an image of only the region.
+func (p *PdfiumImplementation) RenderPageRegion(request *requests.RenderPageRegion) (*responses.RenderPageRegion, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	err := validateRenderImageFormat(request.ImageFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	width, height, pointToPixelRatio, err := calculateRegionSize(request.Region, request.DPI, request.Width, request.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	region := request.Region
+	result, cleanupFunc, err := p.renderPages([]renderPage{
+		{
+			Page:              request.Page,
+			Width:             width,
+			Height:            height,
+			PointToPixelRatio: pointToPixelRatio,
+			Flags:             request.RenderFlags,
+			RenderForm:        request.RenderForm,
+			Document:          request.Document,
+			ImageFormat:       request.ImageFormat,
+			ColorScheme:       request.ColorScheme,
+			BackgroundColor:   request.BackgroundColor,
+			Region:            &region,
+		},
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &responses.RenderPageRegion{
+		CleanupFunc: cleanupFunc,
+		Result: responses.RenderPage{
+			Page:              result.Pages[0].Page,
+			Image:             result.Image,
+			RenderedImage:     result.RenderedImage,
+			PointToPixelRatio: pointToPixelRatio,
+			Width:             width,
+			Height:            height,
+			HasTransparency:   result.Pages[0].HasTransparency,
+		},
+	}, nil
+}
+
 type renderPage struct {
 	Page              requests.Page
 	Flags             enums.FPDF_RENDER_FLAG
@@ -353,6 +455,20 @@ type renderPage struct {
 	RenderForm        bool
 	Document          *references.FPDF_DOCUMENT
 	ImageFormat       requests.RenderImageFormat
+	Region            *requests.RenderRegion // Only render this region of the page, Width and Height are the size of the region.
+	ColorScheme       *structs.FPDF_COLORSCHEME
+	BackgroundColor   *structs.FPDF_COLOR
+}
+
+// backgroundFillColor returns the background color as the ARGB value of
+// FPDFBitmap_FillRect. The fill doesn't use FPDF_REVERSE_BYTE_ORDER, so red
+// and blue are swapped for RGBA bitmaps, to end up in the right place.
+func backgroundFillColor(backgroundColor structs.FPDF_COLOR, imageFormat requests.RenderImageFormat) uint64 {
+	if imageFormat == requests.RenderImageFormatGrayscale {
+		return uint64(backgroundColor.A&0xFF)<<24 | uint64(backgroundColor.R&0xFF)<<16 | uint64(backgroundColor.G&0xFF)<<8 | uint64(backgroundColor.B&0xFF)
+	}
+
+	return uint64(backgroundColor.A&0xFF)<<24 | uint64(backgroundColor.B&0xFF)<<16 | uint64(backgroundColor.G&0xFF)<<8 | uint64(backgroundColor.R&0xFF)
 }
 
 // validateRenderImageFormat validates the given image format. An empty
@@ -436,7 +552,7 @@ func (p *PdfiumImplementation) renderPages(pages []renderPage, padding int) (*re
 	// check the render calls below silently become no-ops on a NULL handle
 	// and the returned image would contain garbage.
 	if bitmap == 0 {
-		return nil, nil, errors.New("could not create bitmap")
+		return nil, nil, p.outOfMemoryError(uint64(totalWidth)*uint64(totalHeight)*4, errors.New("could not create bitmap"))
 	}
 
 	releaseFunc := func() {
@@ -455,7 +571,7 @@ func (p *PdfiumImplementation) renderPages(pages []renderPage, padding int) (*re
 			X:                 0,
 			Y:                 currentOffset,
 		}
-		index, hasTransparency, err := p.renderPage(bitmap, pages[i].Document, pages[i].Page, pages[i].Width, pages[i].Height, currentOffset, pages[i].Flags, pages[i].RenderForm, imageFormat)
+		index, hasTransparency, err := p.renderPage(bitmap, pages[i], currentOffset, imageFormat)
 		if err != nil {
 			releaseFunc()
 			return nil, nil, err
@@ -514,8 +630,13 @@ func (p *PdfiumImplementation) renderPages(pages []renderPage, padding int) (*re
 }
 
 // renderPage renders a specific page in a specific size on a bitmap.
-func (p *PdfiumImplementation) renderPage(bitmap uint64, document *references.FPDF_DOCUMENT, page requests.Page, width, height, offset int, flags enums.FPDF_RENDER_FLAG, renderForm bool, imageFormat requests.RenderImageFormat) (int, bool, error) {
-	pageHandle, err := p.loadPage(page)
+func (p *PdfiumImplementation) renderPage(bitmap uint64, page renderPage, offset int, imageFormat requests.RenderImageFormat) (int, bool, error) {
+	width := page.Width
+	height := page.Height
+	document := page.Document
+	flags := page.Flags
+
+	pageHandle, err := p.loadPage(page.Page)
 	if err != nil {
 		return 0, false, err
 	}
@@ -549,21 +670,84 @@ func (p *PdfiumImplementation) renderPage(bitmap uint64, document *references.FP
 		flags = flags | enums.FPDF_RENDER_FLAG_REVERSE_BYTE_ORDER
 	}
 
+	if page.BackgroundColor != nil {
+		fillColor = backgroundFillColor(*page.BackgroundColor, imageFormat)
+	}
+
 	// Fill the page rect with the specified color.
 	_, err = p.call("FPDFBitmap_FillRect", bitmap, uint64(0), uint64(offset), uint64(width), uint64(height), fillColor)
 	if err != nil {
 		return 0, false, err
 	}
 
-	// Render the bitmap into the given external bitmap.
-	_, err = p.call("FPDF_RenderPageBitmap", bitmap, *pageHandle.handle, uint64(0), uint64(offset), uint64(width), uint64(height), uint64(0), *(*uint64)(unsafe.Pointer(&flags)))
-	if err != nil {
-		return 0, false, err
+	// The position and size of the full page on the bitmap.
+	startX := 0
+	startY := offset
+	sizeX := width
+	sizeY := height
+
+	if page.Region != nil {
+		_, pageWidth, pageHeight, err := p.getPageSize(page.Page)
+		if err != nil {
+			return 0, false, err
+		}
+
+		scale := page.PointToPixelRatio
+		startX = int(math.Round(-page.Region.X * scale))
+		startY = offset + int(math.Round(-page.Region.Y*scale))
+		sizeX = int(math.Round(pageWidth * scale))
+		sizeY = int(math.Round(pageHeight * scale))
+	}
+
+	if page.ColorScheme != nil {
+		// There is no color scheme variant of the render with a matrix, a
+		// region is rendered by rendering the full page on its position on
+		// the bitmap, which clips it to the region.
+		err = p.renderPageBitmapWithColorScheme(bitmap, pageHandle, startX, startY, sizeX, sizeY, flags, *page.ColorScheme)
+		if err != nil {
+			return 0, false, err
+		}
+	} else if page.Region != nil {
+		// Move the region to the position on the bitmap and scale it, the
+		// clip rect makes sure that only the region is rendered.
+		scale := page.PointToPixelRatio
+		matrixPointer, _, err := p.CStructFS_MATRIX(&structs.FPDF_FS_MATRIX{
+			A: float32(scale),
+			D: float32(scale),
+			E: float32(-page.Region.X * scale),
+			F: float32(float64(offset) - page.Region.Y*scale),
+		})
+		if err != nil {
+			return 0, false, err
+		}
+		defer p.Free(matrixPointer)
+
+		clippingPointer, _, err := p.CStructFS_RECTF(&structs.FPDF_FS_RECTF{
+			Left:   0,
+			Top:    float32(offset),
+			Right:  float32(width),
+			Bottom: float32(offset + height),
+		})
+		if err != nil {
+			return 0, false, err
+		}
+		defer p.Free(clippingPointer)
+
+		_, err = p.call("FPDF_RenderPageBitmapWithMatrix", bitmap, *pageHandle.handle, matrixPointer, clippingPointer, *(*uint64)(unsafe.Pointer(&flags)))
+		if err != nil {
+			return 0, false, err
+		}
+	} else {
+		// Render the bitmap into the given external bitmap.
+		_, err = p.call("FPDF_RenderPageBitmap", bitmap, *pageHandle.handle, uint64(0), uint64(offset), uint64(width), uint64(height), uint64(0), *(*uint64)(unsafe.Pointer(&flags)))
+		if err != nil {
+			return 0, false, err
+		}
 	}
 
-	if renderForm {
-		if document == nil && page.ByIndex != nil {
-			document = &page.ByIndex.Document
+	if page.RenderForm {
+		if document == nil && page.Page.ByIndex != nil {
+			document = &page.Page.ByIndex.Document
 		}
 		if document == nil {
 			return 0, false, errors.New("document is required when rendering forms")
@@ -594,7 +778,7 @@ func (p *PdfiumImplementation) renderPage(bitmap uint64, document *references.FP
 			return 0, false, errors.New("could not init form fill environment")
 		}
 
-		_, err = p.call("FPDF_FFLDraw", formHandle, bitmap, *pageHandle.handle, uint64(0), uint64(offset), uint64(width), uint64(height), uint64(0), *(*uint64)(unsafe.Pointer(&flags)))
+		_, err = p.call("FPDF_FFLDraw", formHandle, bitmap, *pageHandle.handle, *(*uint64)(unsafe.Pointer(&startX)), *(*uint64)(unsafe.Pointer(&startY)), *(*uint64)(unsafe.Pointer(&sizeX)), *(*uint64)(unsafe.Pointer(&sizeY)), uint64(0), *(*uint64)(unsafe.Pointer(&flags)))
 		if err != nil {
 			return 0, false, err
 		}
@@ -608,6 +792,70 @@ func (p *PdfiumImplementation) renderPage(bitmap uint64, document *references.FP
 	return pageHandle.index, hasTransparency, nil
 }
 
+// renderPageBitmapWithColorScheme renders a page with a color scheme on the
+// bitmap. The progressive render is never paused, so it's done in one call.
+func (p *PdfiumImplementation) renderPageBitmapWithColorScheme(bitmap uint64, pageHandle *PageHandle, startX, startY, sizeX, sizeY int, flags enums.FPDF_RENDER_FLAG, colorScheme structs.FPDF_COLORSCHEME) error {
+	refPointer, err := p.CString(string(pageHandle.nativeRef))
+	if err != nil {
+		return err
+	}
+
+	res, err := p.call("IFSDK_PAUSE_Create", refPointer.Pointer)
+	if err != nil {
+		refPointer.Free()
+		return err
+	}
+
+	pausePointer := res[0]
+	PauseHandles.Mutex.Lock()
+	PauseHandles.Refs[pageHandle.nativeRef] = &PauseHandle{
+		StringRef: refPointer.Pointer,
+		Pointer:   pausePointer,
+		Callback: func() bool {
+			return false
+		},
+	}
+	PauseHandles.Mutex.Unlock()
+
+	defer func() {
+		PauseHandles.Mutex.Lock()
+		delete(PauseHandles.Refs, pageHandle.nativeRef)
+		PauseHandles.Mutex.Unlock()
+		refPointer.Free()
+		p.Free(pausePointer)
+	}()
+
+	colorSchemePointer, err := p.Malloc(p.CSizeULong() * 4)
+	if err != nil {
+		return err
+	}
+	defer p.Free(colorSchemePointer)
+
+	p.Module.Memory().WriteUint32Le(uint32(colorSchemePointer), uint32(colorScheme.PathFillColor))
+	p.Module.Memory().WriteUint32Le(uint32(colorSchemePointer+4), uint32(colorScheme.PathStrokeColor))
+	p.Module.Memory().WriteUint32Le(uint32(colorSchemePointer+8), uint32(colorScheme.TextFillColor))
+	p.Module.Memory().WriteUint32Le(uint32(colorSchemePointer+12), uint32(colorScheme.TextStrokeColor))
+
+	res, err = p.call("FPDF_RenderPageBitmapWithColorScheme_Start", bitmap, *pageHandle.handle, *(*uint64)(unsafe.Pointer(&startX)), *(*uint64)(unsafe.Pointer(&startY)), *(*uint64)(unsafe.Pointer(&sizeX)), *(*uint64)(unsafe.Pointer(&sizeY)), uint64(0), *(*uint64)(unsafe.Pointer(&flags)), colorSchemePointer, pausePointer)
+	if err != nil {
+		return err
+	}
+
+	renderStatus := *(*int32)(unsafe.Pointer(&res[0]))
+
+	// Release the resources of the progressive render.
+	_, err = p.call("FPDF_RenderPage_Close", *pageHandle.handle)
+	if err != nil {
+		return err
+	}
+
+	if enums.FPDF_RENDER_STATUS(renderStatus) != enums.FPDF_RENDER_STATUS_DONE {
+		return errors.New("could not render page with color scheme")
+	}
+
+	return nil
+}
+
 func (p *PdfiumImplementation) RenderToFile(request *requests.RenderToFile) (*responses.RenderToFile, error) {
 	var renderedImage image.Image
 
@@ -704,6 +952,31 @@ func (p *PdfiumImplementation) RenderToFile(request *requests.RenderToFile) (*re
 			Height: resp.Result.Height,
 			Pages:  resp.Result.Pages,
 		}
+	} else if request.RenderPageRegion != nil {
+		resp, err := p.RenderPageRegion(request.RenderPageRegion)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Cleanup()
+
+		renderedImage = resp.Result.RenderedImage
+		hasTransparency = resp.Result.HasTransparency
+		myResp = &responses.RenderToFile{
+			Width:             resp.Result.Width,
+			Height:            resp.Result.Height,
+			PointToPixelRatio: resp.Result.PointToPixelRatio,
+			Pages: []responses.RenderPagesPage{
+				{
+					Page:              resp.Result.Page,
+					PointToPixelRatio: resp.Result.PointToPixelRatio,
+					Width:             resp.Result.Width,
+					Height:            resp.Result.Height,
+					X:                 0,
+					Y:                 0,
+					HasTransparency:   resp.Result.HasTransparency,
+				},
+			},
+		}
 	} else {
 		return nil, errors.New("no render operation given")
 	}
@@ -766,6 +1039,15 @@ func (p *PdfiumImplementation) RenderToFile(request *requests.RenderToFile) (*re
 			return nil, err
 		}
 
+		if request.MaxFileSize != 0 && int64(imgBuf.Len()) > request.MaxFileSize {
+			return nil, errors.New("PDF image would exceed maximum filesize")
+		}
+	} else if request.OutputFormat == requests.RenderToFileOutputFormatTIFF {
+		err := encodeTIFF(&imgBuf, renderedImage, myResp.Pages, request)
+		if err != nil {
+			return nil, err
+		}
+
 		if request.MaxFileSize != 0 && int64(imgBuf.Len()) > request.MaxFileSize {
 			return nil, errors.New("PDF image would exceed maximum filesize")
 		}
@@ -777,15 +1059,15 @@ func (p *PdfiumImplementation) RenderToFile(request *requests.RenderToFile) (*re
 		imageBytes := imgBuf.Bytes()
 		myResp.ImageBytes = &imageBytes
 	} else if request.OutputTarget == requests.RenderToFileOutputTargetFile {
-		var targetFile *os.File
+		var targetFile File
 		if request.TargetFilePath != "" {
-			existingFile, err := os.Create(request.TargetFilePath)
+			existingFile, err := p.fileSystem().Create(request.TargetFilePath)
 			if err != nil {
 				return nil, err
 			}
 			targetFile = existingFile
 		} else {
-			tempFile, err := ioutil.TempFile("", "")
+			tempFile, err := p.fileSystem().CreateTemp()
 			if err != nil {
 				return nil, err
 			}
@@ -809,3 +1091,50 @@ func (p *PdfiumImplementation) RenderToFile(request *requests.RenderToFile) (*re
 
 	return myResp, nil
 }
+
+// encodeTIFF writes every page of the rendered image as its own page of a
+// TIFF file.
+func encodeTIFF(w io.Writer, renderedImage image.Image, pages []responses.RenderPagesPage, request *requests.RenderToFile) error {
+	subImager, ok := renderedImage.(interface {
+		SubImage(r image.Rectangle) image.Image
+	})
+	if !ok {
+		return errors.New("could not split rendered image into pages")
+	}
+
+	tiffPages := make([]image_tiff.Page, len(pages))
+	for i, page := range pages {
+		compression := request.TIFFCompression
+		if i < len(request.TIFFPageCompression) && request.TIFFPageCompression[i] != "" {
+			compression = request.TIFFPageCompression[i]
+		}
+
+		tiffCompression, err := tiffCompression(compression)
+		if err != nil {
+			return err
+		}
+
+		tiffPages[i] = image_tiff.Page{
+			Image:       subImager.SubImage(image.Rect(page.X, page.Y, page.X+page.Width, page.Y+page.Height)),
+			Compression: tiffCompression,
+			DPI:         page.PointToPixelRatio * 72,
+		}
+	}
+
+	return image_tiff.Encode(w, tiffPages)
+}
+
+func tiffCompression(compression requests.RenderToFileTIFFCompression) (image_tiff.Compression, error) {
+	switch compression {
+	case "", requests.RenderToFileTIFFCompressionDeflate:
+		return image_tiff.CompressionDeflate, nil
+	case requests.RenderToFileTIFFCompressionLZW:
+		return image_tiff.CompressionLZW, nil
+	case requests.RenderToFileTIFFCompressionNone:
+		return image_tiff.CompressionNone, nil
+	case requests.RenderToFileTIFFCompressionCCITTG4:
+		return image_tiff.CompressionCCITTG4, nil
+	}
+
+	return 0, errors.New("invalid TIFF compression given")
+}
@@ -0,0 +1,50 @@
+// Package sandbox restricts the worker processes of the multi-threaded
+// implementation. The host process tells the worker which restrictions to
+// apply through an environment variable, because they can only be applied by
+// the worker itself.
+package sandbox
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Env is the environment variable with the comma separated restrictions that
+// the worker applies to itself.
+const Env = "GO_PDFIUM_WORKER_SANDBOX"
+
+const (
+	// NoNewPrivileges makes sure that the worker and its children can't gain
+	// privileges, like through setuid binaries.
+	NoNewPrivileges = "no_new_privileges"
+
+	// Seccomp blocks the system calls that a worker doesn't need, like
+	// opening network sockets. Implies NoNewPrivileges.
+	Seccomp = "seccomp"
+)
+
+// Apply applies the restrictions of Env to the current process, it does
+// nothing when Env is not set.
+func Apply() error {
+	value := os.Getenv(Env)
+	if value == "" {
+		return nil
+	}
+
+	noNewPrivileges := false
+	seccomp := false
+	for _, restriction := range strings.Split(value, ",") {
+		switch restriction {
+		case NoNewPrivileges:
+			noNewPrivileges = true
+		case Seccomp:
+			noNewPrivileges = true
+			seccomp = true
+		default:
+			return fmt.Errorf("unknown sandbox restriction %q", restriction)
+		}
+	}
+
+	return apply(noNewPrivileges, seccomp)
+}
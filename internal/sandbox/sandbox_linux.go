@@ -0,0 +1,69 @@
+package sandbox
+
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+func apply(noNewPrivileges bool, seccomp bool) error {
+	// The seccomp filter is installed on the current thread and synchronized
+	// to the other threads, it requires no_new_privs on the same thread.
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if noNewPrivileges {
+		err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0)
+		if err != nil {
+			return fmt.Errorf("could not set no_new_privs: %w", err)
+		}
+	}
+
+	if seccomp {
+		err := installSeccompFilter()
+		if err != nil {
+			return fmt.Errorf("could not install seccomp filter: %w", err)
+		}
+	} else if noNewPrivileges {
+		// no_new_privs is set per thread, installing a filter is the only way
+		// to set it on the other threads of the process too. This filter
+		// allows all system calls.
+		err := installFilter([]unix.SockFilter{
+			bpfStatement(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_ALLOW),
+		})
+		if err != nil {
+			return fmt.Errorf("could not set no_new_privs on all threads: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func bpfStatement(code uint16, k uint32) unix.SockFilter {
+	return unix.SockFilter{Code: code, K: k}
+}
+
+// installFilter installs a seccomp filter on all threads of the process. With
+// SECCOMP_FILTER_FLAG_TSYNC, the kernel also sets no_new_privs on all threads
+// when it's set on the current thread.
+func installFilter(filter []unix.SockFilter) error {
+	program := unix.SockFprog{
+		Len:    uint16(len(filter)),
+		Filter: &filter[0],
+	}
+
+	thread, _, errno := unix.Syscall(unix.SYS_SECCOMP, unix.SECCOMP_SET_MODE_FILTER, unix.SECCOMP_FILTER_FLAG_TSYNC, uintptr(unsafe.Pointer(&program)))
+	if errno != 0 {
+		return errno
+	}
+
+	// With TSYNC, the ID of the thread that couldn't be synchronized is
+	// returned on failure.
+	if thread != 0 {
+		return fmt.Errorf("could not synchronize thread %d", thread)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package sandbox_test
+
+import (
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/klippa-app/go-pdfium/internal/sandbox"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+// The sandbox can't be removed from a process, so the tests run the checks in
+// a child process that applies the sandbox.
+const childEnv = "GO_PDFIUM_SANDBOX_TEST_CHILD"
+
+// x32SyscallBit is set in the numbers of the system calls of the x32 ABI.
+const x32SyscallBit = 0x40000000
+
+func TestMain(m *testing.M) {
+	if os.Getenv(childEnv) != "" {
+		os.Exit(runChild())
+	}
+
+	os.Exit(m.Run())
+}
+
+// runChild applies the sandbox and returns 0 when no_new_privs is set on all
+// threads, and with seccomp, when only Unix sockets can be opened.
+func runChild() int {
+	if err := sandbox.Apply(); err != nil {
+		os.Stderr.WriteString(err.Error())
+		return 1
+	}
+
+	threads, err := filepath.Glob("/proc/self/task/*/status")
+	if err != nil || len(threads) < 2 {
+		os.Stderr.WriteString("could not find the threads of the process")
+		return 4
+	}
+
+	for _, thread := range threads {
+		status, err := os.ReadFile(thread)
+		if err != nil {
+			os.Stderr.WriteString(err.Error())
+			return 4
+		}
+
+		if !strings.Contains(string(status), "NoNewPrivs:\t1") {
+			os.Stderr.WriteString("no_new_privs is not set on " + thread)
+			return 5
+		}
+	}
+
+	if os.Getenv(sandbox.Env) != sandbox.Seccomp {
+		return 0
+	}
+
+	listener, err := net.Listen("unix", filepath.Join(os.Getenv(childEnv), "worker.sock"))
+	if err != nil {
+		os.Stderr.WriteString(err.Error())
+		return 2
+	}
+	listener.Close()
+
+	if _, err := net.Listen("tcp", "127.0.0.1:0"); err == nil {
+		os.Stderr.WriteString("could open a TCP socket")
+		return 3
+	}
+
+	if runtime.GOARCH == "amd64" {
+		// The x32 variant of socket must be denied, also when the kernel
+		// doesn't support x32 at all.
+		_, _, errno := unix.Syscall(x32SyscallBit|unix.SYS_SOCKET, unix.AF_INET, unix.SOCK_STREAM, 0)
+		if errno != unix.EPERM {
+			os.Stderr.WriteString("the x32 socket system call was not denied: " + errno.Error())
+			return 6
+		}
+	}
+
+	return 0
+}
+
+func TestSeccomp(t *testing.T) {
+	if runtime.GOARCH != "amd64" && runtime.GOARCH != "arm64" {
+		t.Skip("the seccomp filter is not supported on " + runtime.GOARCH)
+	}
+
+	cmd := exec.Command(os.Args[0])
+	cmd.Env = append(os.Environ(), childEnv+"="+t.TempDir(), sandbox.Env+"="+sandbox.Seccomp)
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+}
+
+func TestNoNewPrivileges(t *testing.T) {
+	cmd := exec.Command(os.Args[0])
+	cmd.Env = append(os.Environ(), childEnv+"="+t.TempDir(), sandbox.Env+"="+sandbox.NoNewPrivileges)
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+}
+
+func TestUnknownRestriction(t *testing.T) {
+	t.Setenv(sandbox.Env, "everything")
+	assert.EqualError(t, sandbox.Apply(), `unknown sandbox restriction "everything"`)
+}
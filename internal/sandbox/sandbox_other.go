@@ -0,0 +1,11 @@
+//go:build !linux
+
+package sandbox
+
+import (
+	"errors"
+)
+
+func apply(noNewPrivileges bool, seccomp bool) error {
+	return errors.New("sandboxing the worker is only supported on Linux")
+}
@@ -0,0 +1,96 @@
+//go:build linux && (amd64 || arm64)
+
+package sandbox
+
+import (
+	"runtime"
+
+	"golang.org/x/sys/unix"
+)
+
+// deniedSyscalls are the system calls that the worker doesn't need, and that
+// could be used to escape the sandbox or to attack the host.
+var deniedSyscalls = []uint32{
+	unix.SYS_ADD_KEY,
+	unix.SYS_BPF,
+	unix.SYS_CHROOT,
+	unix.SYS_DELETE_MODULE,
+	unix.SYS_FINIT_MODULE,
+	unix.SYS_INIT_MODULE,
+	unix.SYS_IO_URING_SETUP,
+	unix.SYS_KEXEC_LOAD,
+	unix.SYS_KEYCTL,
+	unix.SYS_MOUNT,
+	unix.SYS_PERF_EVENT_OPEN,
+	unix.SYS_PIVOT_ROOT,
+	unix.SYS_PROCESS_VM_READV,
+	unix.SYS_PROCESS_VM_WRITEV,
+	unix.SYS_PTRACE,
+	unix.SYS_REBOOT,
+	unix.SYS_REQUEST_KEY,
+	unix.SYS_SETNS,
+	unix.SYS_SWAPOFF,
+	unix.SYS_SWAPON,
+	unix.SYS_UMOUNT2,
+	unix.SYS_UNSHARE,
+}
+
+// Offsets in struct seccomp_data.
+const (
+	seccompDataNr   = 0
+	seccompDataArch = 4
+	seccompDataArg0 = 16
+)
+
+// x32SyscallBit is set in the numbers of the system calls of the x32 ABI,
+// which share the architecture with amd64.
+const x32SyscallBit = 0x40000000
+
+// seccompFilter returns the BPF program of the seccomp filter. It kills the
+// process on system calls of other architectures, denies the system calls of
+// the x32 ABI and of deniedSyscalls, and only allows Unix sockets, which the
+// worker needs to talk to the host process.
+func seccompFilter() []unix.SockFilter {
+	auditArch := uint32(unix.AUDIT_ARCH_X86_64)
+	if runtime.GOARCH == "arm64" {
+		auditArch = unix.AUDIT_ARCH_AARCH64
+	}
+
+	deny := unix.SECCOMP_RET_ERRNO | uint32(unix.EPERM)
+
+	filter := []unix.SockFilter{
+		bpfStatement(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, seccompDataArch),
+		bpfJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, auditArch, 1, 0),
+		bpfStatement(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_KILL_PROCESS),
+		bpfStatement(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, seccompDataNr),
+
+		// The x32 system calls have different numbers, so they would pass
+		// the checks below.
+		bpfJump(unix.BPF_JMP|unix.BPF_JGE|unix.BPF_K, x32SyscallBit, uint8(len(deniedSyscalls)+4), 0),
+	}
+
+	// The denied system calls jump to the deny statement at the end, which
+	// comes after the socket check and the allow statement.
+	for i, syscall := range deniedSyscalls {
+		toDeny := uint8(len(deniedSyscalls) - i - 1 + 4)
+		filter = append(filter, bpfJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, syscall, toDeny, 0))
+	}
+
+	return append(filter,
+		bpfJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, unix.SYS_SOCKET, 0, 2),
+		bpfStatement(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, seccompDataArg0),
+		bpfJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, unix.AF_UNIX, 0, 1),
+		bpfStatement(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_ALLOW),
+		bpfStatement(unix.BPF_RET|unix.BPF_K, deny),
+	)
+}
+
+func bpfJump(code uint16, k uint32, jumpTrue uint8, jumpFalse uint8) unix.SockFilter {
+	return unix.SockFilter{Code: code, Jt: jumpTrue, Jf: jumpFalse, K: k}
+}
+
+// installSeccompFilter installs the seccomp filter on all threads of the
+// process.
+func installSeccompFilter() error {
+	return installFilter(seccompFilter())
+}
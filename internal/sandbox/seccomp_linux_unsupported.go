@@ -0,0 +1,12 @@
+//go:build linux && !amd64 && !arm64
+
+package sandbox
+
+import (
+	"fmt"
+	"runtime"
+)
+
+func installSeccompFilter() error {
+	return fmt.Errorf("the seccomp filter is not supported on %s", runtime.GOARCH)
+}
@@ -0,0 +1,144 @@
+// Package stats keeps the statistics of a pool, which are the same for all
+// implementations, and passes every observation to the exporter of the user.
+package stats
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/klippa-app/go-pdfium"
+)
+
+// Recorder records the statistics of a pool. It's safe for concurrent use.
+type Recorder struct {
+	exporter pdfium.StatsExporter
+	buckets  []time.Duration
+
+	lock           *sync.Mutex
+	workerRestarts int
+	getInstance    *callStats
+	methods        map[string]*callStats
+}
+
+// New creates a recorder that passes every observation to the given
+// exporter, the exporter may be nil.
+func New(exporter pdfium.StatsExporter) *Recorder {
+	// Copy the buckets, so that changing them doesn't affect existing
+	// recorders.
+	buckets := append([]time.Duration{}, pdfium.DefaultLatencyBuckets...)
+
+	return &Recorder{
+		exporter:    exporter,
+		buckets:     buckets,
+		lock:        &sync.Mutex{},
+		getInstance: newCallStats(buckets),
+		methods:     map[string]*callStats{},
+	}
+}
+
+// ObserveGetInstance records a call to GetInstance.
+func (r *Recorder) ObserveGetInstance(wait time.Duration, err error) {
+	r.lock.Lock()
+	r.getInstance.observe(wait, err)
+	r.lock.Unlock()
+
+	if r.exporter != nil {
+		r.exporter.ObserveGetInstance(wait, err)
+	}
+}
+
+// ObserveCall records a call to a method of an instance.
+func (r *Recorder) ObserveCall(method string, duration time.Duration, err error) {
+	r.lock.Lock()
+	methodStats, ok := r.methods[method]
+	if !ok {
+		methodStats = newCallStats(r.buckets)
+		r.methods[method] = methodStats
+	}
+	methodStats.observe(duration, err)
+	r.lock.Unlock()
+
+	if r.exporter != nil {
+		r.exporter.ObserveCall(method, duration, err)
+	}
+}
+
+// ObserveWorkerRestart records that a worker was replaced.
+func (r *Recorder) ObserveWorkerRestart() {
+	r.lock.Lock()
+	r.workerRestarts++
+	r.lock.Unlock()
+
+	if r.exporter != nil {
+		r.exporter.ObserveWorkerRestart()
+	}
+}
+
+// StartCall returns a function that records the call to the given method
+// when it's called with the error of the call.
+func (r *Recorder) StartCall(method string) func(err error) {
+	start := time.Now()
+	return func(err error) {
+		r.ObserveCall(method, time.Since(start), err)
+	}
+}
+
+// Stats returns a copy of the recorded statistics. InstancesInUse and
+// IdleWorkers are not known to the recorder and should be set by the pool.
+func (r *Recorder) Stats() pdfium.PoolStats {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	stats := pdfium.PoolStats{
+		WorkerRestarts: r.workerRestarts,
+		GetInstance:    r.getInstance.copy(),
+		Methods:        make(map[string]pdfium.CallStats, len(r.methods)),
+	}
+
+	for method := range r.methods {
+		stats.Methods[method] = r.methods[method].copy()
+	}
+
+	return stats
+}
+
+type callStats struct {
+	buckets []time.Duration
+	calls   uint64
+	errors  uint64
+	counts  []uint64
+	sum     time.Duration
+}
+
+func newCallStats(buckets []time.Duration) *callStats {
+	return &callStats{
+		buckets: buckets,
+		counts:  make([]uint64, len(buckets)+1),
+	}
+}
+
+func (c *callStats) observe(duration time.Duration, err error) {
+	c.calls++
+	if err != nil {
+		c.errors++
+	}
+
+	bucket := sort.Search(len(c.buckets), func(i int) bool {
+		return duration <= c.buckets[i]
+	})
+	c.counts[bucket]++
+	c.sum += duration
+}
+
+func (c *callStats) copy() pdfium.CallStats {
+	return pdfium.CallStats{
+		Calls:  c.calls,
+		Errors: c.errors,
+		Latency: pdfium.LatencyHistogram{
+			Buckets: append([]time.Duration{}, c.buckets...),
+			Counts:  append([]uint64{}, c.counts...),
+			Sum:     c.sum,
+		},
+	}
+}
@@ -0,0 +1,96 @@
+package stats_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/klippa-app/go-pdfium"
+	"github.com/klippa-app/go-pdfium/internal/stats"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingExporter struct {
+	lock        sync.Mutex
+	getInstance []time.Duration
+	calls       []string
+	restarts    int
+}
+
+func (e *recordingExporter) ObserveGetInstance(wait time.Duration, err error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.getInstance = append(e.getInstance, wait)
+}
+
+func (e *recordingExporter) ObserveCall(method string, duration time.Duration, err error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.calls = append(e.calls, method)
+}
+
+func (e *recordingExporter) ObserveWorkerRestart() {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.restarts++
+}
+
+func TestRecorder(t *testing.T) {
+	exporter := &recordingExporter{}
+	recorder := stats.New(exporter)
+
+	recorder.ObserveGetInstance(2*time.Millisecond, nil)
+	recorder.ObserveGetInstance(time.Minute, errors.New("timeout"))
+	recorder.ObserveCall("FPDF_GetPageCount", 500*time.Microsecond, nil)
+	recorder.ObserveCall("FPDF_GetPageCount", time.Millisecond, errors.New("document not found"))
+	recorder.ObserveCall("FPDF_LoadPage", 20*time.Millisecond, nil)
+	recorder.ObserveWorkerRestart()
+
+	result := recorder.Stats()
+	assert.Equal(t, 1, result.WorkerRestarts)
+
+	require.Len(t, result.GetInstance.Latency.Counts, len(pdfium.DefaultLatencyBuckets)+1)
+	assert.Equal(t, uint64(2), result.GetInstance.Calls)
+	assert.Equal(t, uint64(1), result.GetInstance.Errors)
+	assert.Equal(t, uint64(1), result.GetInstance.Latency.Counts[1])
+	assert.Equal(t, uint64(1), result.GetInstance.Latency.Counts[len(pdfium.DefaultLatencyBuckets)])
+	assert.Equal(t, time.Minute+2*time.Millisecond, result.GetInstance.Latency.Sum)
+
+	// The buckets are inclusive upper bounds.
+	pageCount := result.Methods["FPDF_GetPageCount"]
+	assert.Equal(t, uint64(2), pageCount.Calls)
+	assert.Equal(t, uint64(1), pageCount.Errors)
+	assert.Equal(t, uint64(2), pageCount.Latency.Counts[0])
+	assert.Equal(t, uint64(1), result.Methods["FPDF_LoadPage"].Calls)
+
+	assert.Len(t, exporter.getInstance, 2)
+	assert.Equal(t, []string{"FPDF_GetPageCount", "FPDF_GetPageCount", "FPDF_LoadPage"}, exporter.calls)
+	assert.Equal(t, 1, exporter.restarts)
+}
+
+func TestRecorderStartCall(t *testing.T) {
+	recorder := stats.New(nil)
+
+	done := recorder.StartCall("FPDF_GetPageCount")
+	done(errors.New("document not found"))
+
+	result := recorder.Stats()
+	assert.Equal(t, uint64(1), result.Methods["FPDF_GetPageCount"].Calls)
+	assert.Equal(t, uint64(1), result.Methods["FPDF_GetPageCount"].Errors)
+}
+
+func TestRecorderStatsIsACopy(t *testing.T) {
+	recorder := stats.New(nil)
+	recorder.ObserveCall("FPDF_GetPageCount", time.Millisecond, nil)
+
+	result := recorder.Stats()
+	result.Methods["FPDF_GetPageCount"].Latency.Counts[0] = 100
+	result.Methods["FPDF_GetPageCount"].Latency.Buckets[0] = time.Hour
+
+	result = recorder.Stats()
+	assert.Equal(t, uint64(1), result.Methods["FPDF_GetPageCount"].Latency.Counts[0])
+	assert.Equal(t, pdfium.DefaultLatencyBuckets[0], result.Methods["FPDF_GetPageCount"].Latency.Buckets[0])
+}
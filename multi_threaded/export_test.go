@@ -0,0 +1,16 @@
+package multi_threaded
+
+import (
+	"github.com/klippa-app/go-pdfium"
+)
+
+// KillWorker kills the worker of the instance without closing the instance,
+// like a crash of the worker would.
+func KillWorker(instance pdfium.Pdfium) {
+	instance.(*pdfiumInstance).worker.pluginClient.Kill()
+}
+
+// WorkerPid returns the process ID of the worker of the instance.
+func WorkerPid(instance pdfium.Pdfium) int {
+	return instance.(*pdfiumInstance).worker.pluginClient.ReattachConfig().Pid
+}
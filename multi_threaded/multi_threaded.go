@@ -4,9 +4,12 @@ import (
 	goctx "context"
 	"errors"
 	"fmt"
+	"io"
+	"net/rpc"
 	"os"
 	"os/exec"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,15 +17,26 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-plugin"
 	pool "github.com/jolestar/go-commons-pool/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/klippa-app/go-pdfium"
+	pdfium_errors "github.com/klippa-app/go-pdfium/errors"
 	"github.com/klippa-app/go-pdfium/internal/commons"
+	"github.com/klippa-app/go-pdfium/internal/errorcontext"
+	"github.com/klippa-app/go-pdfium/internal/handledebug"
+	"github.com/klippa-app/go-pdfium/internal/stats"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
 )
 
 type worker struct {
-	plugin       commons.Pdfium
+	plugin       *commons.PdfiumRPC
 	pluginClient *plugin.Client
 	rpcClient    plugin.ClientProtocol
+
+	// requests is the number of requests that were done on the worker.
+	requests atomic.Uint64
 }
 
 type Config struct {
@@ -31,6 +45,97 @@ type Config struct {
 	MaxTotal    int
 	LogCallback func(string)
 	Command     Command
+
+	// Transport is the protocol that is used to talk to the workers, the
+	// default is TransportNetRPC.
+	Transport Transport
+
+	// SharedMemory enables the transfer of large documents and rendered
+	// images through shared memory instead of over RPC. Only supported on
+	// Linux. When enabled, you MUST call Cleanup() on render responses when
+	// you are done with the image to release the shared memory.
+	SharedMemory *SharedMemoryConfig
+
+	// CallTimeout is the maximum duration of a single call to the worker, 0
+	// means no timeout. When a call takes longer, the worker is killed and
+	// the call returns a *errors.TimeoutError. The instance can't be used
+	// anymore after that and should be closed, which replaces the worker.
+	CallTimeout time.Duration
+
+	// StatsExporter receives the statistics of the pool as they happen, see
+	// Stats() on the pool.
+	StatsExporter pdfium.StatsExporter
+
+	// Interceptors are called around every method call of the instances of
+	// the pool, the first interceptor is the outermost one.
+	Interceptors []pdfium.Interceptor
+
+	// HandleDebug enables the handle debug mode, which reports the handles
+	// that are not closed. See pdfium.HandleDebugConfig.
+	HandleDebug *pdfium.HandleDebugConfig
+
+	// Recycle replaces workers that have reached one of its limits. The
+	// limits are checked when a worker is returned to the pool, which is
+	// when the instance is closed, and when it's taken from the pool.
+	Recycle *RecycleConfig
+
+	// Resilient makes the instances survive a crash of their worker. The
+	// instances remember how their documents were opened, and when the
+	// worker crashes during a call, they take a new worker from the pool
+	// and re-open the documents under the same references. Read-only calls
+	// on documents and page indexes, like rendering and text extraction,
+	// are retried once, other calls, and calls that refer to a page by
+	// reference, still return the worker crashed error.
+	// Only documents that were opened from bytes or a path are re-opened,
+	// changes to the documents and all other references, like pages, are
+	// lost.
+	Resilient bool
+
+	// Sandbox restricts the resources and privileges of the workers, see
+	// SandboxConfig. Only supported on Linux.
+	Sandbox *SandboxConfig
+}
+
+type RecycleConfig struct {
+	// MaxRequests is the maximum number of requests that a worker handles
+	// before it's replaced, 0 means no limit.
+	MaxRequests uint64
+
+	// MaxAge is the maximum time that a worker is used before it's
+	// replaced, 0 means no limit.
+	MaxAge time.Duration
+
+	// MaxResidentMemory is the maximum resident memory in bytes of a worker
+	// before it's replaced, 0 means no limit. Only supported for workers
+	// that run on Linux.
+	MaxResidentMemory uint64
+}
+
+type Transport int
+
+const (
+	// TransportNetRPC talks to the workers over net/rpc with gob encoding.
+	TransportNetRPC Transport = iota
+
+	// TransportGRPC talks to the workers over gRPC with protobuf encoding.
+	// The protobuf encoding keeps the difference between nil and empty
+	// values, and the field numbers never change, so that a worker of a
+	// different go-pdfium version can be used. Requires a worker that
+	// supports gRPC. Instances of a pool with this transport stream the
+	// pages of RenderPagesInDPIStream and RenderPagesInPixelsStream while
+	// they are rendered.
+	TransportGRPC
+)
+
+type SharedMemoryConfig struct {
+	// Dir is the directory to create the shared memory files in. This
+	// should be a tmpfs, defaults to /dev/shm.
+	Dir string
+
+	// Threshold is the minimum size in bytes of a payload to transfer it
+	// through shared memory, smaller payloads are sent over RPC. Defaults
+	// to 1MB.
+	Threshold int
 }
 
 type Command struct {
@@ -48,6 +153,12 @@ type pdfiumPool struct {
 	poolRef      string
 	closed       bool
 	lock         *sync.Mutex
+	callTimeout  time.Duration
+	stats        *stats.Recorder
+	interceptor  pdfium.Interceptor
+	handleDebug  *pdfium.HandleDebugConfig
+	resilient    bool
+	logCallback  func(string)
 }
 
 var poolRefs = map[string]*pdfiumPool{}
@@ -76,34 +187,68 @@ func Init(config Config) pdfium.Pool {
 		"pdfium": &commons.PdfiumPlugin{},
 	}
 
+	// The gRPC plugin is served as protocol version 2, so that the worker
+	// can serve both transports.
+	var grpcPluginMap = map[int]plugin.PluginSet{
+		2: {
+			"pdfium": &commons.PdfiumGRPCPlugin{},
+		},
+	}
+
 	// If we don't have a log callback, make the callback no-op.
 	if config.LogCallback == nil {
 		config.LogCallback = func(s string) {}
 	}
 
+	statsRecorder := stats.New(config.StatsExporter)
+
 	factory := pool.NewPooledObjectFactory(
 		func(goctx.Context) (interface{}, error) {
 			newWorker := &worker{}
 
-			client := plugin.NewClient(&plugin.ClientConfig{
+			cmd := exec.Command(config.Command.BinPath, config.Command.Args...)
+			if config.Sandbox != nil {
+				err := prepareSandbox(config.Sandbox, cmd)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			clientConfig := &plugin.ClientConfig{
 				HandshakeConfig: handshakeConfig,
 				Plugins:         pluginMap,
-				Cmd:             exec.Command(config.Command.BinPath, config.Command.Args...),
+				Cmd:             cmd,
 				Logger:          logger,
 				StartTimeout:    config.Command.StartTimeout,
-			})
+			}
+
+			if config.Transport == TransportGRPC {
+				clientConfig.Plugins = nil
+				clientConfig.VersionedPlugins = grpcPluginMap
+				clientConfig.AllowedProtocols = []plugin.Protocol{plugin.ProtocolGRPC}
+			}
+
+			client := plugin.NewClient(clientConfig)
 
 			rpcClient, err := client.Client()
 			if err != nil {
 				return nil, err
 			}
 
+			if config.Sandbox != nil {
+				err = limitWorker(config.Sandbox, cmd.Process.Pid)
+				if err != nil {
+					client.Kill()
+					return nil, fmt.Errorf("could not limit worker: %w", err)
+				}
+			}
+
 			raw, err := rpcClient.Dispense("pdfium")
 			if err != nil {
 				return nil, err
 			}
 
-			pdfium := raw.(commons.Pdfium)
+			pdfium := raw.(*commons.PdfiumRPC)
 
 			pong, err := pdfium.Ping()
 			if err != nil {
@@ -114,6 +259,27 @@ func Init(config Config) pdfium.Pool {
 				return nil, errors.New("Wrong ping/pong result")
 			}
 
+			if config.SharedMemory != nil {
+				sharedMemoryConfig := commons.SharedMemoryConfig{
+					Dir:         config.SharedMemory.Dir,
+					Threshold:   config.SharedMemory.Threshold,
+					LogCallback: config.LogCallback,
+				}
+
+				if sharedMemoryConfig.Dir == "" {
+					sharedMemoryConfig.Dir = "/dev/shm"
+				}
+
+				if sharedMemoryConfig.Threshold == 0 {
+					sharedMemoryConfig.Threshold = 1024 * 1024
+				}
+
+				err = pdfium.EnableSharedMemory(sharedMemoryConfig)
+				if err != nil {
+					return nil, fmt.Errorf("could not enable shared memory: %w", err)
+				}
+			}
+
 			newWorker.pluginClient = client
 			newWorker.rpcClient = rpcClient
 			newWorker.plugin = pdfium
@@ -121,6 +287,18 @@ func Init(config Config) pdfium.Pool {
 			return newWorker, nil
 		}, func(ctx goctx.Context, object *pool.PooledObject) error {
 			worker := object.Object.(*worker)
+
+			// The shared memory files that were written for calls of which
+			// the response never arrived, like when the worker was killed,
+			// are only removed here, after the worker has stopped so that
+			// it can't write new files.
+			defer func() {
+				worker.pluginClient.Kill()
+				if err := worker.plugin.RemoveSharedMemory(); err != nil {
+					config.LogCallback(fmt.Sprintf("Could not remove shared memory: %s", err.Error()))
+				}
+			}()
+
 			err := worker.plugin.Close()
 			if err != nil {
 				return err
@@ -141,27 +319,39 @@ func Init(config Config) pdfium.Pool {
 			worker := object.Object.(*worker)
 			if worker.pluginClient.Exited() {
 				config.LogCallback("Worker exited")
+				statsRecorder.ObserveWorkerRestart()
 				return false
 			}
 
 			err := worker.rpcClient.Ping()
 			if err != nil {
 				config.LogCallback(fmt.Sprintf("Error on RPC ping: %s", err.Error()))
+				statsRecorder.ObserveWorkerRestart()
 				return false
 			}
 
 			pong, err := worker.plugin.Ping()
 			if err != nil {
 				config.LogCallback(fmt.Sprintf("Error on plugin ping:: %s", err.Error()))
+				statsRecorder.ObserveWorkerRestart()
 				return false
 			}
 
 			if pong != "Pong" {
 				err = errors.New("Wrong ping/pong result")
 				config.LogCallback(fmt.Sprintf("Error on plugin ping:: %s", err.Error()))
+				statsRecorder.ObserveWorkerRestart()
 				return false
 			}
 
+			if config.Recycle != nil {
+				if reason := recycleReason(config.Recycle, object, worker, config.LogCallback); reason != "" {
+					config.LogCallback(fmt.Sprintf("Recycling worker: %s", reason))
+					statsRecorder.ObserveWorkerRestart()
+					return false
+				}
+			}
+
 			return true
 		}, nil, nil)
 	p := pool.NewObjectPoolWithDefaultConfig(goctx.Background(), factory)
@@ -188,6 +378,12 @@ func Init(config Config) pdfium.Pool {
 		instanceRefs: map[string]*pdfiumInstance{},
 		lock:         &sync.Mutex{},
 		workerPool:   p,
+		callTimeout:  config.CallTimeout,
+		stats:        statsRecorder,
+		interceptor:  pdfium.ChainInterceptors(config.Interceptors...),
+		handleDebug:  config.HandleDebug,
+		resilient:    config.Resilient,
+		logCallback:  config.LogCallback,
 	}
 
 	poolRefs[newPool.poolRef] = newPool
@@ -195,6 +391,38 @@ func Init(config Config) pdfium.Pool {
 	return newPool
 }
 
+// recycleReason returns why the worker should be replaced, or an empty string
+// when the worker is still within the limits.
+func recycleReason(config *RecycleConfig, object *pool.PooledObject, worker *worker, logCallback func(string)) string {
+	if config.MaxRequests > 0 {
+		if requests := worker.requests.Load(); requests >= config.MaxRequests {
+			return fmt.Sprintf("handled %d requests, the maximum is %d", requests, config.MaxRequests)
+		}
+	}
+
+	if config.MaxAge > 0 {
+		if age := time.Since(object.CreateTime); age >= config.MaxAge {
+			return fmt.Sprintf("running for %s, the maximum is %s", age, config.MaxAge)
+		}
+	}
+
+	if config.MaxResidentMemory > 0 {
+		residentMemory, err := worker.plugin.ResidentMemory()
+		if err != nil {
+			// Don't replace workers that can't tell their memory usage,
+			// otherwise they would be replaced on every return.
+			logCallback(fmt.Sprintf("Could not get resident memory of worker: %s", err.Error()))
+			return ""
+		}
+
+		if residentMemory >= config.MaxResidentMemory {
+			return fmt.Sprintf("using %d bytes of resident memory, the maximum is %d", residentMemory, config.MaxResidentMemory)
+		}
+	}
+
+	return ""
+}
+
 func (p *pdfiumPool) GetNumActive() int {
 	return p.workerPool.GetNumActive()
 }
@@ -224,7 +452,9 @@ func (p *pdfiumPool) GetInstanceWithContext(ctx goctx.Context) (pdfium.Pdfium, e
 
 	p.lock.Unlock()
 
+	start := time.Now()
 	workerObject, err := p.workerPool.BorrowObject(ctx)
+	p.stats.ObserveGetInstance(time.Since(start), err)
 	if err != nil {
 		return nil, err
 	}
@@ -233,10 +463,25 @@ func (p *pdfiumPool) GetInstanceWithContext(ctx goctx.Context) (pdfium.Pdfium, e
 	defer p.lock.Unlock()
 
 	newInstance := &pdfiumInstance{
-		worker: workerObject.(*worker),
-		lock:   &sync.Mutex{},
+		worker:      workerObject.(*worker),
+		lock:        &sync.Mutex{},
+		callLock:    make(chan struct{}, 1),
+		interceptor: p.interceptor,
+	}
+
+	interceptors := []pdfium.Interceptor{p.interceptor}
+	if p.handleDebug != nil {
+		newInstance.handleDebug = handledebug.New(p.handleDebug, newInstance.ListOpenHandlesWithContext)
+		interceptors = append(interceptors, newInstance.handleDebug.Interceptor())
 	}
 
+	// The resilience is the innermost interceptor, so that the other
+	// interceptors only see the retried call.
+	if p.resilient {
+		interceptors = append(interceptors, newResilience(newInstance).interceptor)
+	}
+	newInstance.interceptor = pdfium.ChainInterceptors(interceptors...)
+
 	instanceRef := uuid.New()
 	newInstance.instanceRef = instanceRef.String()
 	newInstance.pool = p
@@ -245,6 +490,18 @@ func (p *pdfiumPool) GetInstanceWithContext(ctx goctx.Context) (pdfium.Pdfium, e
 	return newInstance, nil
 }
 
+func (p *pdfiumPool) Stats() pdfium.PoolStats {
+	stats := p.stats.Stats()
+
+	p.lock.Lock()
+	stats.InstancesInUse = len(p.instanceRefs)
+	p.lock.Unlock()
+
+	stats.IdleWorkers = p.workerPool.GetNumIdle()
+
+	return stats
+}
+
 func (p *pdfiumPool) Close() (err error) {
 	p.lock.Lock()
 
@@ -285,8 +542,26 @@ type pdfiumInstance struct {
 	instanceRef string
 	closed      bool
 	lock        *sync.Mutex
+
+	// closing is set when Close starts, so that the calls that are made
+	// while closing don't restart a crashed worker in resilient mode.
+	closing atomic.Bool
+
+	// callLock makes sure that the calls of the instance are done one at a
+	// time, it's a channel so that waiting for it can be cancelled.
+	callLock chan struct{}
+
+	// interceptor is called around every method call, it's copied from the
+	// pool because the pool is unset when the instance is closed.
+	interceptor pdfium.Interceptor
+
+	// handleDebug tracks the handles of the instance when the handle debug
+	// mode is enabled.
+	handleDebug *handledebug.Tracker
 }
 
+var _ pdfium.PdfiumWithContext = &pdfiumInstance{}
+
 // Close will close the instance and will clean up the underlying PDFium resources
 // by calling i.worker.plugin.Close().
 func (i *pdfiumInstance) Close() (err error) {
@@ -297,6 +572,16 @@ func (i *pdfiumInstance) Close() (err error) {
 		return errors.New("instance is already closed")
 	}
 
+	i.closing.Store(true)
+
+	if i.handleDebug != nil {
+		i.handleDebug.Close(goctx.Background())
+	}
+
+	// Wait until the running call is done, so that the worker isn't replaced
+	// by a restart in resilient mode while it's closed.
+	i.callLock <- struct{}{}
+
 	defer func() {
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "Close", panicError)
@@ -311,6 +596,7 @@ func (i *pdfiumInstance) Close() (err error) {
 		i.pool.lock.Unlock()
 		i.pool = nil
 		i.closed = true
+		<-i.callLock
 		i.lock.Unlock()
 	}()
 
@@ -342,10 +628,155 @@ func (i *pdfiumInstance) Kill() (err error) {
 		i.closed = true
 	}()
 
+	// The validator of the pool counts the restart when the killed worker
+	// is returned.
 	i.worker.pluginClient.Kill()
 	return
 }
 
+// startCall waits until the instance is available, counts the request on the
+// worker and kills the worker when the call takes longer than the call
+// timeout. The returned function must be deferred with the error of the
+// call, it replaces the error with a *errors.TimeoutError when the worker was
+// killed, or with a worker crashed *errors.Error when the worker exited. When
+// the context is done during the call, the worker is killed too, because it
+// keeps handling the call while the host process stops waiting for it.
+func (i *pdfiumInstance) startCall(ctx goctx.Context, method string, request interface{}) (func(err *error), error) {
+	// A select picks a random case when both are ready, so a context that
+	// is already done is checked first.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	select {
+	case i.callLock <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	i.worker.requests.Add(1)
+	observeCall := i.pool.stats.StartCall(method)
+
+	pluginClient := i.worker.pluginClient
+
+	// killCancelled kills the worker when the call returned the error of the
+	// context, so that the next call on the instance doesn't silently wait
+	// for the call that the worker is still handling.
+	killCancelled := func(err error) bool {
+		if err == nil || ctx.Err() == nil || !errors.Is(err, ctx.Err()) {
+			return false
+		}
+
+		pluginClient.Kill()
+		return true
+	}
+
+	timeout := i.pool.callTimeout
+	if timeout <= 0 {
+		return func(err *error) {
+			if !killCancelled(*err) {
+				*err = callError(pluginClient, method, request, *err)
+			}
+			observeCall(*err)
+			<-i.callLock
+		}, nil
+	}
+
+	killed := make(chan struct{})
+	timer := time.AfterFunc(timeout, func() {
+		pluginClient.Kill()
+		close(killed)
+	})
+
+	return func(err *error) {
+		defer func() {
+			observeCall(*err)
+			<-i.callLock
+		}()
+
+		if timer.Stop() {
+			if !killCancelled(*err) {
+				*err = callError(pluginClient, method, request, *err)
+			}
+			return
+		}
+
+		// Wait for the worker to be killed, so that the instance can be
+		// closed right away.
+		<-killed
+		*err = &pdfium_errors.TimeoutError{
+			Method:  method,
+			Timeout: timeout,
+		}
+	}, nil
+}
+
+// callError adds the context of the call to a *errors.Error of a call, and
+// replaces the error with a worker crashed error when the worker exited during
+// the call.
+func callError(pluginClient *plugin.Client, method string, request interface{}, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var pdfiumError *pdfium_errors.Error
+	if errors.As(err, &pdfiumError) {
+		errorcontext.Set(err, method, request)
+		return err
+	}
+
+	if errors.Is(err, rpc.ErrShutdown) || errors.Is(err, io.ErrUnexpectedEOF) || status.Code(err) == codes.Unavailable || pluginClient.Exited() {
+		return pdfium_errors.NewWorkerCrashedError(method, err)
+	}
+
+	return err
+}
+
+// RenderStreamer is implemented by the instances of the multi-threaded pool.
+// It renders pages one by one, and calls the callback for every page once it
+// has been rendered. The padding of the request is not used. With
+// TransportGRPC the worker streams the pages while it's rendering them, with
+// TransportNetRPC every page is a separate call. Rendering stops when the
+// callback returns an error.
+type RenderStreamer interface {
+	RenderPagesInDPIStream(request *requests.RenderPagesInDPI, callback func(*responses.RenderPageInDPI) error) error
+	RenderPagesInPixelsStream(request *requests.RenderPagesInPixels, callback func(*responses.RenderPageInPixels) error) error
+}
+
+func (i *pdfiumInstance) RenderPagesInDPIStream(request *requests.RenderPagesInDPI, callback func(*responses.RenderPageInDPI) error) error {
+	_, err := i.interceptor(goctx.Background(), "RenderPagesInDPIStream", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(goctx.Background(), "RenderPagesInDPIStream", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		return nil, i.worker.plugin.RenderPagesInDPIStream(request, callback)
+	})
+	return err
+}
+
+func (i *pdfiumInstance) RenderPagesInPixelsStream(request *requests.RenderPagesInPixels, callback func(*responses.RenderPageInPixels) error) error {
+	_, err := i.interceptor(goctx.Background(), "RenderPagesInPixelsStream", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(goctx.Background(), "RenderPagesInPixelsStream", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		return nil, i.worker.plugin.RenderPagesInPixelsStream(request, callback)
+	})
+	return err
+}
+
 func (i *pdfiumInstance) GetImplementation() interface{} {
 	return i.worker.plugin
 }
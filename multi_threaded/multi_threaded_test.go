@@ -2,7 +2,12 @@ package multi_threaded_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -10,7 +15,10 @@ import (
 	. "github.com/onsi/gomega/gleak"
 
 	"github.com/klippa-app/go-pdfium"
+	pdfium_errors "github.com/klippa-app/go-pdfium/errors"
 	"github.com/klippa-app/go-pdfium/multi_threaded"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
 	"github.com/klippa-app/go-pdfium/shared_tests"
 )
 
@@ -107,12 +115,578 @@ var _ = Describe("Multi Threaded", func() {
 				It("allows the pool to be closed when there are still open instances", func() {
 					// Do nothing here, we're testing closing the pool without closing the instance.
 				})
+
+				It("returns the error variables of the errors package over the RPC", func() {
+					if runtime.GOOS == "windows" {
+						Skip("FPDF_SetPrintMode is supported on Windows")
+					}
+
+					_, err := TestInstance.FPDF_SetPrintMode(&requests.FPDF_SetPrintMode{})
+					Expect(errors.Is(err, pdfium_errors.ErrWindowsUnsupported)).To(BeTrue())
+
+					err = TestInstance.Close()
+					Expect(err).To(BeNil())
+				})
+
+				It("adds the method and document of the request to the error", func() {
+					pdfData, err := os.ReadFile("../shared_tests/testdata/test.pdf")
+					Expect(err).To(BeNil())
+
+					doc, err := TestInstance.FPDF_LoadMemDocument(&requests.FPDF_LoadMemDocument{
+						Data: &pdfData,
+					})
+					Expect(err).To(BeNil())
+
+					_, err = TestInstance.RenderPageInDPI(&requests.RenderPageInDPI{
+						Page: requests.Page{
+							ByIndex: &requests.PageByIndex{
+								Document: doc.Document,
+								Index:    10,
+							},
+						},
+						DPI: 72,
+					})
+					Expect(errors.Is(err, pdfium_errors.ErrPage)).To(BeTrue())
+
+					var pdfiumError *pdfium_errors.Error
+					Expect(errors.As(err, &pdfiumError)).To(BeTrue())
+					Expect(pdfiumError.Method).To(Equal("RenderPageInDPI"))
+					Expect(pdfiumError.Document).To(Equal(doc.Document))
+
+					err = TestInstance.Close()
+					Expect(err).To(BeNil())
+				})
+			})
+
+			AfterEach(func(ctx context.Context) {
+				err := TestPool.Close()
+				Expect(err).To(BeNil())
+			}, NodeTimeout(time.Second))
+		})
+
+		When("a pool is opened with a recycle policy", func() {
+			var TestPool pdfium.Pool
+
+			BeforeEach(func() {
+				args := workerArgs()
+
+				pool := multi_threaded.Init(multi_threaded.Config{
+					MinIdle:  1,
+					MaxIdle:  1,
+					MaxTotal: 1,
+					Command: multi_threaded.Command{
+						BinPath:      "go",
+						Args:         args,
+						StartTimeout: time.Minute * 15,
+					},
+					Recycle: &multi_threaded.RecycleConfig{
+						MaxRequests: 2,
+					},
+				})
+				TestPool = pool
+			})
+
+			AfterEach(func(ctx context.Context) {
+				err := TestPool.Close()
+				Expect(err).To(BeNil())
+			}, NodeTimeout(time.Second))
+
+			It("replaces the worker when the limit has been reached", func() {
+				destroyedCounter := TestPool.(interface{ GetDestroyedCount() int })
+
+				instance, err := TestPool.GetInstance(time.Second * 30)
+				Expect(err).To(BeNil())
+
+				_, err = instance.FPDF_GetLastError(&requests.FPDF_GetLastError{})
+				Expect(err).To(BeNil())
+
+				err = instance.Close()
+				Expect(err).To(BeNil())
+				Expect(destroyedCounter.GetDestroyedCount()).To(Equal(0))
+
+				instance, err = TestPool.GetInstance(time.Second * 30)
+				Expect(err).To(BeNil())
+
+				_, err = instance.FPDF_GetLastError(&requests.FPDF_GetLastError{})
+				Expect(err).To(BeNil())
+
+				err = instance.Close()
+				Expect(err).To(BeNil())
+				Expect(destroyedCounter.GetDestroyedCount()).To(Equal(1))
+			})
+		})
+
+		When("a pool is opened with interceptors", func() {
+			var TestPool pdfium.Pool
+			var methods []string
+
+			BeforeEach(func() {
+				args := workerArgs()
+
+				methods = nil
+				pool := multi_threaded.Init(multi_threaded.Config{
+					MinIdle:  1,
+					MaxIdle:  1,
+					MaxTotal: 1,
+					Command: multi_threaded.Command{
+						BinPath:      "go",
+						Args:         args,
+						StartTimeout: time.Minute * 15,
+					},
+					Interceptors: []pdfium.Interceptor{
+						func(ctx context.Context, method string, request interface{}, next func() (interface{}, error)) (interface{}, error) {
+							methods = append(methods, method)
+							return next()
+						},
+						func(ctx context.Context, method string, request interface{}, next func() (interface{}, error)) (interface{}, error) {
+							if method == "FPDF_GetPageCount" {
+								return nil, errors.New("method not allowed")
+							}
+							return next()
+						},
+					},
+				})
+				TestPool = pool
+			})
+
+			AfterEach(func(ctx context.Context) {
+				err := TestPool.Close()
+				Expect(err).To(BeNil())
+			}, NodeTimeout(time.Second))
+
+			It("calls the interceptors around every method call", func() {
+				instance, err := TestPool.GetInstance(time.Second * 30)
+				Expect(err).To(BeNil())
+
+				lastError, err := instance.FPDF_GetLastError(&requests.FPDF_GetLastError{})
+				Expect(err).To(BeNil())
+				Expect(lastError).To(Not(BeNil()))
+
+				pageCount, err := instance.FPDF_GetPageCount(&requests.FPDF_GetPageCount{})
+				Expect(err).To(MatchError("method not allowed"))
+				Expect(pageCount).To(BeNil())
+
+				Expect(methods).To(Equal([]string{"FPDF_GetLastError", "FPDF_GetPageCount"}))
+
+				err = instance.Close()
+				Expect(err).To(BeNil())
+			})
+		})
+
+		When("a pool is opened with a call timeout", func() {
+			var TestPool pdfium.Pool
+
+			BeforeEach(func() {
+				args := workerArgs()
+
+				pool := multi_threaded.Init(multi_threaded.Config{
+					MinIdle:  1,
+					MaxIdle:  1,
+					MaxTotal: 1,
+					Command: multi_threaded.Command{
+						BinPath:      "go",
+						Args:         args,
+						StartTimeout: time.Minute * 15,
+					},
+					CallTimeout: time.Second * 5,
+				})
+				TestPool = pool
+			})
+
+			AfterEach(func(ctx context.Context) {
+				err := TestPool.Close()
+				Expect(err).To(BeNil())
+			}, NodeTimeout(time.Second))
+
+			// bug_451265.pdf is a malformed PDF from the PDFium test corpus
+			// that causes PDFium to hang indefinitely during rendering.
+			It("kills the worker of a stuck call and returns a timeout error", func() {
+				instance, err := TestPool.GetInstance(time.Second * 30)
+				Expect(err).To(BeNil())
+
+				pdfData, err := os.ReadFile("../shared_tests/testdata/bug_451265.pdf")
+				Expect(err).To(BeNil())
+
+				doc, err := instance.FPDF_LoadMemDocument(&requests.FPDF_LoadMemDocument{
+					Data: &pdfData,
+				})
+				Expect(err).To(BeNil())
+
+				renderedFile, err := instance.RenderToFile(&requests.RenderToFile{
+					RenderPageInDPI: &requests.RenderPageInDPI{
+						Page: requests.Page{
+							ByIndex: &requests.PageByIndex{
+								Document: doc.Document,
+								Index:    0,
+							},
+						},
+						DPI: 72,
+					},
+					OutputFormat:  requests.RenderToFileOutputFormatJPG,
+					OutputTarget:  requests.RenderToFileOutputTargetBytes,
+					OutputQuality: 50,
+				})
+				Expect(renderedFile).To(BeNil())
+
+				var timeoutError *pdfium_errors.TimeoutError
+				Expect(errors.As(err, &timeoutError)).To(BeTrue())
+				Expect(timeoutError.Method).To(Equal("RenderToFile"))
+
+				// The worker has been killed, closing the instance replaces it.
+				instance.Close()
+
+				instance, err = TestPool.GetInstance(time.Second * 30)
+				Expect(err).To(BeNil())
+
+				_, err = instance.FPDF_GetLastError(&requests.FPDF_GetLastError{})
+				Expect(err).To(BeNil())
+
+				err = instance.Close()
+				Expect(err).To(BeNil())
+			})
+
+			It("kills the worker of a call when the context is done", func() {
+				instance, err := TestPool.GetInstance(time.Second * 30)
+				Expect(err).To(BeNil())
+
+				pdfData, err := os.ReadFile("../shared_tests/testdata/bug_451265.pdf")
+				Expect(err).To(BeNil())
+
+				doc, err := instance.FPDF_LoadMemDocument(&requests.FPDF_LoadMemDocument{
+					Data: &pdfData,
+				})
+				Expect(err).To(BeNil())
+
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+
+				renderedPage, err := instance.(pdfium.PdfiumWithContext).RenderPageInDPIWithContext(ctx, &requests.RenderPageInDPI{
+					Page: requests.Page{
+						ByIndex: &requests.PageByIndex{
+							Document: doc.Document,
+							Index:    0,
+						},
+					},
+					DPI: 72,
+				})
+				Expect(renderedPage).To(BeNil())
+				Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+
+				// The next call doesn't wait for the stuck call, the worker
+				// has been killed.
+				_, err = instance.FPDF_GetLastError(&requests.FPDF_GetLastError{})
+				Expect(errors.Is(err, pdfium_errors.ErrWorkerCrashed)).To(BeTrue())
+
+				// Closing the instance replaces the worker.
+				instance.Close()
+
+				instance, err = TestPool.GetInstance(time.Second * 30)
+				Expect(err).To(BeNil())
+
+				_, err = instance.FPDF_GetLastError(&requests.FPDF_GetLastError{})
+				Expect(err).To(BeNil())
+
+				err = instance.Close()
+				Expect(err).To(BeNil())
+			})
+		})
+
+		When("a pool is opened with resilient instances", func() {
+			var TestPool pdfium.Pool
+
+			BeforeEach(func() {
+				args := workerArgs()
+
+				pool := multi_threaded.Init(multi_threaded.Config{
+					MinIdle:  1,
+					MaxIdle:  1,
+					MaxTotal: 1,
+					Command: multi_threaded.Command{
+						BinPath:      "go",
+						Args:         args,
+						StartTimeout: time.Minute * 15,
+					},
+					Resilient: true,
+				})
+				TestPool = pool
+			})
+
+			AfterEach(func(ctx context.Context) {
+				err := TestPool.Close()
+				Expect(err).To(BeNil())
+			}, NodeTimeout(time.Second))
+
+			It("re-opens the documents on a new worker and retries read-only calls", func() {
+				instance, err := TestPool.GetInstance(time.Second * 30)
+				Expect(err).To(BeNil())
+
+				pdfData, err := os.ReadFile("../shared_tests/testdata/test.pdf")
+				Expect(err).To(BeNil())
+
+				doc, err := instance.FPDF_LoadMemDocument(&requests.FPDF_LoadMemDocument{
+					Data: &pdfData,
+				})
+				Expect(err).To(BeNil())
+
+				multi_threaded.KillWorker(instance)
+
+				pageCount, err := instance.FPDF_GetPageCount(&requests.FPDF_GetPageCount{
+					Document: doc.Document,
+				})
+				Expect(err).To(BeNil())
+				Expect(pageCount).To(Equal(&responses.FPDF_GetPageCount{
+					PageCount: 1,
+				}))
+
+				page, err := instance.FPDF_LoadPage(&requests.FPDF_LoadPage{
+					Document: doc.Document,
+					Index:    0,
+				})
+				Expect(err).To(BeNil())
+
+				multi_threaded.KillWorker(instance)
+
+				// Pages that were loaded on the crashed worker are not
+				// loaded on the new worker, so calls that use them are not
+				// retried.
+				pageSize, err := instance.GetPageSize(&requests.GetPageSize{
+					Page: requests.Page{
+						ByReference: &page.Page,
+					},
+				})
+				Expect(errors.Is(err, pdfium_errors.ErrWorkerCrashed)).To(BeTrue())
+				Expect(pageSize).To(BeNil())
+
+				multi_threaded.KillWorker(instance)
+
+				// Calls that aren't read-only are not retried.
+				_, err = instance.FPDF_LoadPage(&requests.FPDF_LoadPage{
+					Document: doc.Document,
+					Index:    0,
+				})
+				Expect(errors.Is(err, pdfium_errors.ErrWorkerCrashed)).To(BeTrue())
+
+				// The document has been re-opened on a new worker.
+				page, err = instance.FPDF_LoadPage(&requests.FPDF_LoadPage{
+					Document: doc.Document,
+					Index:    0,
+				})
+				Expect(err).To(BeNil())
+
+				_, err = instance.FPDF_ClosePage(&requests.FPDF_ClosePage{
+					Page: page.Page,
+				})
+				Expect(err).To(BeNil())
+
+				_, err = instance.FPDF_CloseDocument(&requests.FPDF_CloseDocument{
+					Document: doc.Document,
+				})
+				Expect(err).To(BeNil())
+
+				// Every crashed worker is counted once.
+				Expect(TestPool.Stats().WorkerRestarts).To(BeEquivalentTo(3))
+
+				err = instance.Close()
+				Expect(err).To(BeNil())
+			})
+		})
+
+		When("a pool is opened in resilient mode with the handle debug mode", func() {
+			It("closes an instance of which the worker crashed", func() {
+				pool := multi_threaded.Init(multi_threaded.Config{
+					MinIdle:  1,
+					MaxIdle:  1,
+					MaxTotal: 1,
+					Command: multi_threaded.Command{
+						BinPath:      "go",
+						Args:         workerArgs(),
+						StartTimeout: time.Minute * 15,
+					},
+					Resilient:   true,
+					HandleDebug: &pdfium.HandleDebugConfig{OnLeak: func(leak pdfium.HandleLeak) {}},
+				})
+				defer pool.Close()
+
+				instance, err := pool.GetInstance(time.Second * 30)
+				Expect(err).To(BeNil())
+
+				multi_threaded.KillWorker(instance)
+
+				// The last call of the handle debug mode must not restart the
+				// worker while the instance is closing.
+				closed := make(chan error, 1)
+				go func() {
+					closed <- instance.Close()
+				}()
+				Eventually(closed, time.Second*30).Should(Receive())
+
+				// The crashed worker has been replaced.
+				instance, err = pool.GetInstance(time.Second * 30)
+				Expect(err).To(BeNil())
+				Expect(instance.Close()).To(Succeed())
+			})
+		})
+
+		When("a pool is opened with a sandbox", func() {
+			var TestPool pdfium.Pool
+
+			BeforeEach(func() {
+				if runtime.GOOS != "linux" {
+					Skip("Sandboxing the workers is only supported on Linux")
+				}
+
+				// The resource limits are set on the process that the pool
+				// starts, so the worker binary is started directly instead of
+				// through go run.
+				workerPath := filepath.Join(GinkgoT().TempDir(), "worker")
+				args := append([]string{"build", "-o", workerPath}, workerBuildTags...)
+				output, err := exec.Command("go", append(args, "../examples/multi_threaded/worker/main.go")...).CombinedOutput()
+				Expect(err).To(BeNil(), string(output))
+
+				pool := multi_threaded.Init(multi_threaded.Config{
+					MinIdle:  1,
+					MaxIdle:  1,
+					MaxTotal: 1,
+					Command: multi_threaded.Command{
+						BinPath:      workerPath,
+						StartTimeout: time.Minute * 15,
+					},
+					Sandbox: &multi_threaded.SandboxConfig{
+						CPUTime:      time.Hour,
+						AddressSpace: 64 << 30,
+						OpenFiles:    1024,
+						Seccomp:      true,
+					},
+				})
+				TestPool = pool
 			})
 
 			AfterEach(func(ctx context.Context) {
 				err := TestPool.Close()
 				Expect(err).To(BeNil())
 			}, NodeTimeout(time.Second))
+
+			It("handles calls in the sandboxed worker", func() {
+				instance, err := TestPool.GetInstance(time.Second * 30)
+				Expect(err).To(BeNil())
+
+				pdfData, err := os.ReadFile("../shared_tests/testdata/test.pdf")
+				Expect(err).To(BeNil())
+
+				doc, err := instance.FPDF_LoadMemDocument(&requests.FPDF_LoadMemDocument{
+					Data: &pdfData,
+				})
+				Expect(err).To(BeNil())
+
+				pageCount, err := instance.FPDF_GetPageCount(&requests.FPDF_GetPageCount{
+					Document: doc.Document,
+				})
+				Expect(err).To(BeNil())
+				Expect(pageCount).To(Equal(&responses.FPDF_GetPageCount{
+					PageCount: 1,
+				}))
+
+				err = instance.Close()
+				Expect(err).To(BeNil())
+			})
+
+			It("sets the resource limits on the worker", func() {
+				instance, err := TestPool.GetInstance(time.Second * 30)
+				Expect(err).To(BeNil())
+
+				limits, err := os.ReadFile(fmt.Sprintf("/proc/%d/limits", multi_threaded.WorkerPid(instance)))
+				Expect(err).To(BeNil())
+
+				// The soft and the hard limit are both set.
+				Expect(string(limits)).To(MatchRegexp(`Max cpu time\s+3600\s+3600\s+seconds`))
+				Expect(string(limits)).To(MatchRegexp(`Max address space\s+68719476736\s+68719476736\s+bytes`))
+				Expect(string(limits)).To(MatchRegexp(`Max open files\s+1024\s+1024\s+files`))
+
+				err = instance.Close()
+				Expect(err).To(BeNil())
+			})
+		})
+
+		When("a pool is opened with the gRPC transport", func() {
+			var TestPool pdfium.Pool
+			var TestInstance pdfium.Pdfium
+
+			BeforeEach(func() {
+				args := workerArgs()
+
+				pool := multi_threaded.Init(multi_threaded.Config{
+					MinIdle:  1,
+					MaxIdle:  1,
+					MaxTotal: 1,
+					Command: multi_threaded.Command{
+						BinPath:      "go",
+						Args:         args,
+						StartTimeout: time.Minute * 15,
+					},
+					Transport: multi_threaded.TransportGRPC,
+				})
+				TestPool = pool
+
+				instance, err := TestPool.GetInstance(time.Second * 30)
+				Expect(err).To(BeNil())
+				TestInstance = instance
+			})
+
+			AfterEach(func(ctx context.Context) {
+				err := TestInstance.Close()
+				Expect(err).To(BeNil())
+
+				err = TestPool.Close()
+				Expect(err).To(BeNil())
+			}, NodeTimeout(time.Second))
+
+			It("allows a document to be opened and rendered", func() {
+				pdfData, err := os.ReadFile("../shared_tests/testdata/test_multipage.pdf")
+				Expect(err).To(BeNil())
+
+				doc, err := TestInstance.FPDF_LoadMemDocument(&requests.FPDF_LoadMemDocument{
+					Data: &pdfData,
+				})
+				Expect(err).To(BeNil())
+
+				pageCount, err := TestInstance.FPDF_GetPageCount(&requests.FPDF_GetPageCount{
+					Document: doc.Document,
+				})
+				Expect(err).To(BeNil())
+				Expect(pageCount.PageCount).To(BeNumerically(">", 1))
+
+				renderRequest := &requests.RenderPagesInDPI{}
+				for i := 0; i < pageCount.PageCount; i++ {
+					renderRequest.Pages = append(renderRequest.Pages, requests.RenderPageInDPI{
+						Page: requests.Page{
+							ByIndex: &requests.PageByIndex{
+								Document: doc.Document,
+								Index:    i,
+							},
+						},
+						DPI: 50,
+					})
+				}
+
+				var renderedPages []int
+				err = TestInstance.(multi_threaded.RenderStreamer).RenderPagesInDPIStream(renderRequest, func(renderedPage *responses.RenderPageInDPI) error {
+					renderedPages = append(renderedPages, renderedPage.Result.Page)
+					Expect(renderedPage.Result.RenderedImage).To(Not(BeNil()))
+					return nil
+				})
+				Expect(err).To(BeNil())
+				Expect(renderedPages).To(HaveLen(pageCount.PageCount))
+
+				_, err = TestInstance.FPDF_CloseDocument(&requests.FPDF_CloseDocument{
+					Document: doc.Document,
+				})
+				Expect(err).To(BeNil())
+			})
+
+			It("returns the errors of the worker", func() {
+				_, err := TestInstance.FPDF_GetPageCount(&requests.FPDF_GetPageCount{})
+				Expect(err).To(MatchError("document not given"))
+			})
 		})
 	})
 })
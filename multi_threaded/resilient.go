@@ -0,0 +1,251 @@
+package multi_threaded
+
+import (
+	goctx "context"
+	"errors"
+	"fmt"
+	"sync"
+
+	pdfium_errors "github.com/klippa-app/go-pdfium/errors"
+	"github.com/klippa-app/go-pdfium/references"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+)
+
+// retriedMethods are the read-only methods that are retried once when the
+// worker crashed during the call. They use documents and pages, but only
+// documents and page indexes are still valid after the documents have been
+// re-opened, so calls that refer to a page by reference are not retried. See
+// usesPageReference.
+var retriedMethods = map[string]bool{
+	"FPDF_GetPageCount":        true,
+	"FPDF_GetPageSizeByIndex":  true,
+	"FPDF_GetPageSizeByIndexF": true,
+	"GetAttachments":           true,
+	"GetBookmarks":             true,
+	"GetJavaScriptActions":     true,
+	"GetMetaData":              true,
+	"GetPageSize":              true,
+	"GetPageSizeInPixels":      true,
+	"GetPageText":              true,
+	"GetPageTextStructured":    true,
+	"RenderPageInDPI":          true,
+	"RenderPageInPixels":       true,
+	"RenderPagesInDPI":         true,
+	"RenderPagesInPixels":      true,
+	"RenderPageRegion":         true,
+	"RenderToFile":             true,
+}
+
+// resilience keeps track of how the documents of an instance were opened, so
+// that they can be re-opened on a new worker when the worker crashed.
+type resilience struct {
+	instance *pdfiumInstance
+
+	lock      sync.Mutex
+	documents map[references.FPDF_DOCUMENT]*requests.OpenDocument
+}
+
+func newResilience(instance *pdfiumInstance) *resilience {
+	return &resilience{
+		instance:  instance,
+		documents: map[references.FPDF_DOCUMENT]*requests.OpenDocument{},
+	}
+}
+
+// interceptor replaces the worker when it crashed during a call, and retries
+// the call once when it's in retriedMethods and doesn't use a page reference.
+func (r *resilience) interceptor(ctx goctx.Context, method string, request interface{}, next func() (interface{}, error)) (interface{}, error) {
+	resp, err := next()
+	if err == nil {
+		r.track(method, request, resp)
+		return resp, nil
+	}
+
+	if pdfium_errors.GetCategory(err) != pdfium_errors.CategoryWorkerCrashed {
+		return resp, err
+	}
+
+	if restartErr := r.restartWorker(ctx); restartErr != nil {
+		return resp, fmt.Errorf("%w, could not restart the worker: %v", err, restartErr)
+	}
+
+	if !retriedMethods[method] || usesPageReference(request) {
+		return resp, err
+	}
+
+	return next()
+}
+
+// usesPageReference returns whether a request of retriedMethods refers to a
+// page by reference, the pages that were loaded on the crashed worker are not
+// loaded on the new worker.
+func usesPageReference(request interface{}) bool {
+	switch typedRequest := request.(type) {
+	case *requests.GetPageSize:
+		return typedRequest != nil && typedRequest.Page.ByReference != nil
+	case *requests.GetPageSizeInPixels:
+		return typedRequest != nil && typedRequest.Page.ByReference != nil
+	case *requests.GetPageText:
+		return typedRequest != nil && typedRequest.Page.ByReference != nil
+	case *requests.GetPageTextStructured:
+		return typedRequest != nil && typedRequest.Page.ByReference != nil
+	case *requests.RenderPageInDPI:
+		return typedRequest != nil && typedRequest.Page.ByReference != nil
+	case *requests.RenderPageInPixels:
+		return typedRequest != nil && typedRequest.Page.ByReference != nil
+	case *requests.RenderPageRegion:
+		return typedRequest != nil && typedRequest.Page.ByReference != nil
+	case *requests.RenderPagesInDPI:
+		if typedRequest == nil {
+			return false
+		}
+
+		for i := range typedRequest.Pages {
+			if typedRequest.Pages[i].Page.ByReference != nil {
+				return true
+			}
+		}
+	case *requests.RenderPagesInPixels:
+		if typedRequest == nil {
+			return false
+		}
+
+		for i := range typedRequest.Pages {
+			if typedRequest.Pages[i].Page.ByReference != nil {
+				return true
+			}
+		}
+	case *requests.RenderToFile:
+		return typedRequest != nil && (usesPageReference(typedRequest.RenderPageInDPI) ||
+			usesPageReference(typedRequest.RenderPagesInDPI) ||
+			usesPageReference(typedRequest.RenderPageInPixels) ||
+			usesPageReference(typedRequest.RenderPagesInPixels) ||
+			usesPageReference(typedRequest.RenderPageRegion))
+	}
+
+	return false
+}
+
+// track remembers how the documents were opened, only documents that were
+// opened from bytes or a path can be re-opened.
+func (r *resilience) track(method string, request interface{}, resp interface{}) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	switch method {
+	case "OpenDocument":
+		openRequest, _ := request.(*requests.OpenDocument)
+		openResp, _ := resp.(*responses.OpenDocument)
+		if openRequest == nil || openResp == nil || (openRequest.File == nil && openRequest.FilePath == nil) {
+			return
+		}
+
+		r.documents[openResp.Document] = &requests.OpenDocument{
+			File:     openRequest.File,
+			FilePath: openRequest.FilePath,
+			Password: openRequest.Password,
+		}
+	case "FPDF_LoadDocument":
+		loadRequest, _ := request.(*requests.FPDF_LoadDocument)
+		loadResp, _ := resp.(*responses.FPDF_LoadDocument)
+		if loadRequest == nil || loadResp == nil {
+			return
+		}
+
+		r.documents[loadResp.Document] = &requests.OpenDocument{
+			FilePath: loadRequest.Path,
+			Password: loadRequest.Password,
+		}
+	case "FPDF_LoadMemDocument":
+		loadRequest, _ := request.(*requests.FPDF_LoadMemDocument)
+		loadResp, _ := resp.(*responses.FPDF_LoadMemDocument)
+		if loadRequest == nil || loadResp == nil {
+			return
+		}
+
+		r.documents[loadResp.Document] = &requests.OpenDocument{
+			File:     loadRequest.Data,
+			Password: loadRequest.Password,
+		}
+	case "FPDF_LoadMemDocument64":
+		loadRequest, _ := request.(*requests.FPDF_LoadMemDocument64)
+		loadResp, _ := resp.(*responses.FPDF_LoadMemDocument64)
+		if loadRequest == nil || loadResp == nil {
+			return
+		}
+
+		r.documents[loadResp.Document] = &requests.OpenDocument{
+			File:     loadRequest.Data,
+			Password: loadRequest.Password,
+		}
+	case "FPDF_CloseDocument":
+		closeRequest, _ := request.(*requests.FPDF_CloseDocument)
+		if closeRequest == nil {
+			return
+		}
+
+		delete(r.documents, closeRequest.Document)
+	}
+}
+
+// restartWorker replaces the crashed worker of the instance with a new worker
+// from the pool, and re-opens the documents under their references. Documents
+// that can't be re-opened are forgotten. The worker is replaced under the call
+// lock and not under the instance lock, because Close holds the instance lock
+// while the handle debug mode does its last call.
+func (r *resilience) restartWorker(ctx goctx.Context) error {
+	i := r.instance
+
+	// Make sure that no call is using the worker while it's replaced.
+	select {
+	case i.callLock <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() {
+		<-i.callLock
+	}()
+
+	// The worker of an instance that is closing is returned to the pool by
+	// Close, which replaces it when it crashed.
+	if i.closing.Load() {
+		return errors.New("instance is closed")
+	}
+
+	// The worker can still be running when the connection broke. The
+	// validator of the pool replaces the killed worker when it's returned,
+	// and counts the restart.
+	i.worker.pluginClient.Kill()
+	err := i.pool.workerPool.ReturnObject(goctx.Background(), i.worker)
+	if err != nil {
+		i.pool.logCallback(fmt.Sprintf("Could not return crashed worker: %s", err.Error()))
+	}
+
+	workerObject, err := i.pool.workerPool.BorrowObject(ctx)
+	if err != nil {
+		// The crashed worker has been returned to the pool already, so the
+		// instance can't be used anymore.
+		i.closing.Store(true)
+		i.closed = true
+		i.worker = nil
+		i.pool.lock.Lock()
+		delete(i.pool.instanceRefs, i.instanceRef)
+		i.pool.lock.Unlock()
+		return fmt.Errorf("could not get a new worker, the instance is closed: %w", err)
+	}
+	i.worker = workerObject.(*worker)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for document, request := range r.documents {
+		err := i.worker.plugin.ReopenDocument(document, request)
+		if err != nil {
+			i.pool.logCallback(fmt.Sprintf("Could not re-open document %s: %s", document, err.Error()))
+			delete(r.documents, document)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package multi_threaded
+
+import (
+	"time"
+)
+
+// SandboxConfig restricts the worker processes, so that the documents that
+// they parse can't exhaust the host or reach the network. Only supported on
+// Linux. The resource limits are set on the process that is started by the
+// Command, so the Command must start the worker binary itself, and not a
+// wrapper like "go run".
+type SandboxConfig struct {
+	// CPUTime is the maximum CPU time of a worker (RLIMIT_CPU), 0 means no
+	// limit. The CPU time adds up over all calls that a worker handles, and
+	// the worker is killed when it reaches the limit, so you probably want
+	// to combine this with MaxRequests or MaxAge of the Recycle config.
+	CPUTime time.Duration
+
+	// AddressSpace is the maximum size of the virtual memory of a worker in
+	// bytes (RLIMIT_AS), 0 means no limit. Memory allocations fail once a
+	// worker reaches the limit, which usually crashes the worker. The Go
+	// runtime reserves quite some virtual memory, so don't set this too
+	// low.
+	AddressSpace uint64
+
+	// OpenFiles is the maximum number of open files of a worker
+	// (RLIMIT_NOFILE), 0 means no limit.
+	OpenFiles uint64
+
+	// NoNewPrivileges makes sure that a worker can't gain privileges, like
+	// by executing setuid binaries.
+	NoNewPrivileges bool
+
+	// Seccomp installs a seccomp filter in the workers that blocks the
+	// system calls that they don't need, like opening network sockets other
+	// than the Unix socket to the host process and loading kernel modules.
+	// Implies NoNewPrivileges. Only supported on amd64 and arm64.
+	Seccomp bool
+
+	// IsolateNetwork starts the workers in their own network namespace,
+	// which has no network interfaces. When the host process doesn't run as
+	// root, the workers also get their own user namespace, which the kernel
+	// must allow for unprivileged users.
+	IsolateNetwork bool
+}
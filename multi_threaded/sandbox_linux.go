@@ -0,0 +1,77 @@
+package multi_threaded
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/klippa-app/go-pdfium/internal/sandbox"
+)
+
+// prepareSandbox configures the command of a worker to start it in the
+// sandbox. The restrictions that a worker applies to itself are passed in
+// the environment.
+func prepareSandbox(config *SandboxConfig, cmd *exec.Cmd) error {
+	restrictions := []string{}
+	if config.NoNewPrivileges {
+		restrictions = append(restrictions, sandbox.NoNewPrivileges)
+	}
+	if config.Seccomp {
+		restrictions = append(restrictions, sandbox.Seccomp)
+	}
+	if len(restrictions) > 0 {
+		cmd.Env = append(cmd.Env, sandbox.Env+"="+strings.Join(restrictions, ","))
+	}
+
+	if config.IsolateNetwork {
+		if cmd.SysProcAttr == nil {
+			cmd.SysProcAttr = &syscall.SysProcAttr{}
+		}
+
+		cmd.SysProcAttr.Cloneflags |= syscall.CLONE_NEWNET
+
+		// Unprivileged users can only create a network namespace in their
+		// own user namespace, in which they keep their user and group.
+		if uid := os.Getuid(); uid != 0 {
+			cmd.SysProcAttr.Cloneflags |= syscall.CLONE_NEWUSER
+			cmd.SysProcAttr.UidMappings = []syscall.SysProcIDMap{{ContainerID: uid, HostID: uid, Size: 1}}
+			cmd.SysProcAttr.GidMappings = []syscall.SysProcIDMap{{ContainerID: os.Getgid(), HostID: os.Getgid(), Size: 1}}
+		}
+	}
+
+	return nil
+}
+
+// limitWorker sets the resource limits of the sandbox on a started worker,
+// before it handles any call.
+func limitWorker(config *SandboxConfig, pid int) error {
+	if config.CPUTime > 0 {
+		seconds := uint64((config.CPUTime + time.Second - 1) / time.Second)
+		if err := setLimit(pid, unix.RLIMIT_CPU, seconds); err != nil {
+			return fmt.Errorf("could not limit CPU time: %w", err)
+		}
+	}
+
+	if config.AddressSpace > 0 {
+		if err := setLimit(pid, unix.RLIMIT_AS, config.AddressSpace); err != nil {
+			return fmt.Errorf("could not limit address space: %w", err)
+		}
+	}
+
+	if config.OpenFiles > 0 {
+		if err := setLimit(pid, unix.RLIMIT_NOFILE, config.OpenFiles); err != nil {
+			return fmt.Errorf("could not limit open files: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func setLimit(pid int, resource int, limit uint64) error {
+	return unix.Prlimit(pid, resource, &unix.Rlimit{Cur: limit, Max: limit}, nil)
+}
@@ -0,0 +1,16 @@
+//go:build !linux
+
+package multi_threaded
+
+import (
+	"errors"
+	"os/exec"
+)
+
+func prepareSandbox(config *SandboxConfig, cmd *exec.Cmd) error {
+	return errors.New("sandboxing the workers is only supported on Linux")
+}
+
+func limitWorker(config *SandboxConfig, pid int) error {
+	return errors.New("sandboxing the workers is only supported on Linux")
+}
@@ -27,6 +27,9 @@ type Pool interface {
 	// might block forever. It is the user's responsibility to handle this context.
 	GetInstanceWithContext(ctx goctx.Context) (Pdfium, error)
 
+	// Stats returns the statistics of the pool since it was created.
+	Stats() PoolStats
+
 	// Close closes the pool.
 	// It will close any unclosed instances.
 	// For single-threaded it will unload the library if it's the last pool.
@@ -42,13 +45,19 @@ type Pdfium interface {
 
 	// OpenDocument returns a PDFium references for the given file data.
 	// This is a gateway to FPDF_LoadMemDocument, FPDF_LoadMemDocument64,
-	// FPDF_LoadDocument and FPDF_LoadCustomDocument. Please note that
-	// FPDF_LoadCustomDocument will only work efficiently on single-threaded
-	// usage, on multi-threaded this will just fully read from the reader
-	// into a byte array before it's being sent over to PDFium.
+	// FPDF_LoadDocument and FPDF_LoadCustomDocument. When using a FileReader,
+	// the reader is read while the document is open, on multi-threaded usage
+	// the worker requests the blocks that PDFium needs from this process.
 	// This method already checks FPDF_GetLastError internally for the result.
 	OpenDocument(request *requests.OpenDocument) (*responses.OpenDocument, error)
 
+	// ListOpenHandles returns the handles that have been opened and not closed
+	// yet, with their counts by handle type, per document. Handles that are
+	// closed together with their document, like bookmarks, are not listed.
+	// Use this to find handle leaks, also see the HandleDebug option of the
+	// pool configs.
+	ListOpenHandles(request *requests.ListOpenHandles) (*responses.ListOpenHandles, error)
+
 	// Close closes the instance.
 	// It will close any unclosed documents.
 	// For multi-threaded it will give back the worker to the pool.
@@ -97,6 +106,10 @@ type Pdfium interface {
 	// RenderPagesInPixels renders the given pages in the given pixel sizes.
 	RenderPagesInPixels(request *requests.RenderPagesInPixels) (*responses.RenderPagesInPixels, error)
 
+	// RenderPageRegion renders a region of a page in the given DPI or pixel
+	// size, only the pixels of the region are allocated.
+	RenderPageRegion(request *requests.RenderPageRegion) (*responses.RenderPageRegion, error)
+
 	// GetPageSize returns the size of the page in points.
 	GetPageSize(request *requests.GetPageSize) (*responses.GetPageSize, error)
 
@@ -158,10 +171,9 @@ type Pdfium interface {
 
 	// FPDF_LoadCustomDocument loads a PDF document from a custom access descriptor.
 	// This is implemented as an io.ReadSeeker in go-pdfium.
-	// This is only really efficient for single threaded usage, the multi-threaded
-	// usage will just load the file in memory because it can't transfer readers
-	// over gRPC. The single-threaded usage will actually efficiently walk over
-	// the PDF as it's being used by PDFium.
+	// PDFium will efficiently walk over the PDF as it's being used. On
+	// multi-threaded usage every block that PDFium reads is requested from the
+	// reader in the host process.
 	// Loaded document can be closed by FPDF_CloseDocument().
 	// This method already checks FPDF_GetLastError internally for the result.
 	FPDF_LoadCustomDocument(request *requests.FPDF_LoadCustomDocument) (*responses.FPDF_LoadCustomDocument, error)
@@ -1114,16 +1126,14 @@ type Pdfium interface {
 
 	// FPDF_SaveAsCopy saves the document to a copy.
 	// If no path or writer is given, it will return the saved file as a byte array.
-	// Note that using a fileWriter only works when using the single-threaded version,
-	// the reason for that is that a fileWriter can't be transferred over gRPC
-	// (or between processes at all).
+	// On multi-threaded usage the output is sent to the fileWriter in chunks
+	// while PDFium is saving the document.
 	FPDF_SaveAsCopy(request *requests.FPDF_SaveAsCopy) (*responses.FPDF_SaveAsCopy, error)
 
 	// FPDF_SaveWithVersion save the document to a copy, with a specific file version.
 	// If no path or writer is given, it will return the saved file as a byte array.
-	// Note that using a fileWriter only works when using the single-threaded version,
-	// the reason for that is that a fileWriter can't be transferred over gRPC
-	// (or between processes at all).
+	// On multi-threaded usage the output is sent to the fileWriter in chunks
+	// while PDFium is saving the document.
 	FPDF_SaveWithVersion(request *requests.FPDF_SaveWithVersion) (*responses.FPDF_SaveWithVersion, error)
 
 	// End fpdf_save.h
@@ -1538,20 +1548,16 @@ type Pdfium interface {
 	// Start fpdf_progressive.h
 
 	// FPDF_RenderPageBitmapWithColorScheme_Start starts to render page contents to a device independent bitmap progressively with a specified color scheme for the content.
-	// Not supported on multi-threaded usage.
 	// Experimental API.
 	FPDF_RenderPageBitmapWithColorScheme_Start(request *requests.FPDF_RenderPageBitmapWithColorScheme_Start) (*responses.FPDF_RenderPageBitmapWithColorScheme_Start, error)
 
 	// FPDF_RenderPageBitmap_Start starts to render page contents to a device independent bitmap progressively.
-	// Not supported on multi-threaded usage.
 	FPDF_RenderPageBitmap_Start(request *requests.FPDF_RenderPageBitmap_Start) (*responses.FPDF_RenderPageBitmap_Start, error)
 
 	// FPDF_RenderPage_Continue continues rendering a PDF page.
-	// Not supported on multi-threaded usage.
 	FPDF_RenderPage_Continue(request *requests.FPDF_RenderPage_Continue) (*responses.FPDF_RenderPage_Continue, error)
 
 	// FPDF_RenderPage_Close Release the resource allocate during page rendering. Need to be called after finishing rendering or cancel the rendering.
-	// Not supported on multi-threaded usage.
 	FPDF_RenderPage_Close(request *requests.FPDF_RenderPage_Close) (*responses.FPDF_RenderPage_Close, error)
 
 	// End fpdf_progressive.h
@@ -2091,6 +2097,7 @@ type Pdfium interface {
 
 	// FPDFDOC_InitFormFillEnvironment initializes form fill environment
 	// This function should be called before any form fill operation.
+	// On multi-threaded usage the callbacks are called over RPC from the worker.
 	FPDFDOC_InitFormFillEnvironment(request *requests.FPDFDOC_InitFormFillEnvironment) (*responses.FPDFDOC_InitFormFillEnvironment, error)
 
 	// FPDFDOC_ExitFormFillEnvironment takes ownership of the handle and exits form fill environment.
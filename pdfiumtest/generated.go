@@ -0,0 +1,8512 @@
+// Code generated by tool. DO NOT EDIT.
+// See the code_generation package.
+
+package pdfiumtest
+
+import (
+	goctx "context"
+
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+)
+
+// Stubs contains a stub for every method of the Pdfium interface. A method
+// that is called without a stub returns ErrNotStubbed.
+type Stubs struct {
+	FORM_CanRedo                                 func(request *requests.FORM_CanRedo) (*responses.FORM_CanRedo, error)
+	FORM_CanUndo                                 func(request *requests.FORM_CanUndo) (*responses.FORM_CanUndo, error)
+	FORM_DoDocumentAAction                       func(request *requests.FORM_DoDocumentAAction) (*responses.FORM_DoDocumentAAction, error)
+	FORM_DoDocumentJSAction                      func(request *requests.FORM_DoDocumentJSAction) (*responses.FORM_DoDocumentJSAction, error)
+	FORM_DoDocumentOpenAction                    func(request *requests.FORM_DoDocumentOpenAction) (*responses.FORM_DoDocumentOpenAction, error)
+	FORM_DoPageAAction                           func(request *requests.FORM_DoPageAAction) (*responses.FORM_DoPageAAction, error)
+	FORM_ForceToKillFocus                        func(request *requests.FORM_ForceToKillFocus) (*responses.FORM_ForceToKillFocus, error)
+	FORM_GetFocusedAnnot                         func(request *requests.FORM_GetFocusedAnnot) (*responses.FORM_GetFocusedAnnot, error)
+	FORM_GetFocusedText                          func(request *requests.FORM_GetFocusedText) (*responses.FORM_GetFocusedText, error)
+	FORM_GetSelectedText                         func(request *requests.FORM_GetSelectedText) (*responses.FORM_GetSelectedText, error)
+	FORM_IsIndexSelected                         func(request *requests.FORM_IsIndexSelected) (*responses.FORM_IsIndexSelected, error)
+	FORM_OnAfterLoadPage                         func(request *requests.FORM_OnAfterLoadPage) (*responses.FORM_OnAfterLoadPage, error)
+	FORM_OnBeforeClosePage                       func(request *requests.FORM_OnBeforeClosePage) (*responses.FORM_OnBeforeClosePage, error)
+	FORM_OnChar                                  func(request *requests.FORM_OnChar) (*responses.FORM_OnChar, error)
+	FORM_OnFocus                                 func(request *requests.FORM_OnFocus) (*responses.FORM_OnFocus, error)
+	FORM_OnKeyDown                               func(request *requests.FORM_OnKeyDown) (*responses.FORM_OnKeyDown, error)
+	FORM_OnKeyUp                                 func(request *requests.FORM_OnKeyUp) (*responses.FORM_OnKeyUp, error)
+	FORM_OnLButtonDoubleClick                    func(request *requests.FORM_OnLButtonDoubleClick) (*responses.FORM_OnLButtonDoubleClick, error)
+	FORM_OnLButtonDown                           func(request *requests.FORM_OnLButtonDown) (*responses.FORM_OnLButtonDown, error)
+	FORM_OnLButtonUp                             func(request *requests.FORM_OnLButtonUp) (*responses.FORM_OnLButtonUp, error)
+	FORM_OnMouseMove                             func(request *requests.FORM_OnMouseMove) (*responses.FORM_OnMouseMove, error)
+	FORM_OnMouseWheel                            func(request *requests.FORM_OnMouseWheel) (*responses.FORM_OnMouseWheel, error)
+	FORM_OnRButtonDown                           func(request *requests.FORM_OnRButtonDown) (*responses.FORM_OnRButtonDown, error)
+	FORM_OnRButtonUp                             func(request *requests.FORM_OnRButtonUp) (*responses.FORM_OnRButtonUp, error)
+	FORM_Redo                                    func(request *requests.FORM_Redo) (*responses.FORM_Redo, error)
+	FORM_ReplaceAndKeepSelection                 func(request *requests.FORM_ReplaceAndKeepSelection) (*responses.FORM_ReplaceAndKeepSelection, error)
+	FORM_ReplaceSelection                        func(request *requests.FORM_ReplaceSelection) (*responses.FORM_ReplaceSelection, error)
+	FORM_SelectAllText                           func(request *requests.FORM_SelectAllText) (*responses.FORM_SelectAllText, error)
+	FORM_SetFocusedAnnot                         func(request *requests.FORM_SetFocusedAnnot) (*responses.FORM_SetFocusedAnnot, error)
+	FORM_SetIndexSelected                        func(request *requests.FORM_SetIndexSelected) (*responses.FORM_SetIndexSelected, error)
+	FORM_Undo                                    func(request *requests.FORM_Undo) (*responses.FORM_Undo, error)
+	FPDFAction_GetDest                           func(request *requests.FPDFAction_GetDest) (*responses.FPDFAction_GetDest, error)
+	FPDFAction_GetFilePath                       func(request *requests.FPDFAction_GetFilePath) (*responses.FPDFAction_GetFilePath, error)
+	FPDFAction_GetType                           func(request *requests.FPDFAction_GetType) (*responses.FPDFAction_GetType, error)
+	FPDFAction_GetURIPath                        func(request *requests.FPDFAction_GetURIPath) (*responses.FPDFAction_GetURIPath, error)
+	FPDFAnnot_AddFileAttachment                  func(request *requests.FPDFAnnot_AddFileAttachment) (*responses.FPDFAnnot_AddFileAttachment, error)
+	FPDFAnnot_AddInkStroke                       func(request *requests.FPDFAnnot_AddInkStroke) (*responses.FPDFAnnot_AddInkStroke, error)
+	FPDFAnnot_AppendAttachmentPoints             func(request *requests.FPDFAnnot_AppendAttachmentPoints) (*responses.FPDFAnnot_AppendAttachmentPoints, error)
+	FPDFAnnot_AppendObject                       func(request *requests.FPDFAnnot_AppendObject) (*responses.FPDFAnnot_AppendObject, error)
+	FPDFAnnot_CountAttachmentPoints              func(request *requests.FPDFAnnot_CountAttachmentPoints) (*responses.FPDFAnnot_CountAttachmentPoints, error)
+	FPDFAnnot_GetAP                              func(request *requests.FPDFAnnot_GetAP) (*responses.FPDFAnnot_GetAP, error)
+	FPDFAnnot_GetAttachmentPoints                func(request *requests.FPDFAnnot_GetAttachmentPoints) (*responses.FPDFAnnot_GetAttachmentPoints, error)
+	FPDFAnnot_GetBorder                          func(request *requests.FPDFAnnot_GetBorder) (*responses.FPDFAnnot_GetBorder, error)
+	FPDFAnnot_GetColor                           func(request *requests.FPDFAnnot_GetColor) (*responses.FPDFAnnot_GetColor, error)
+	FPDFAnnot_GetFileAttachment                  func(request *requests.FPDFAnnot_GetFileAttachment) (*responses.FPDFAnnot_GetFileAttachment, error)
+	FPDFAnnot_GetFlags                           func(request *requests.FPDFAnnot_GetFlags) (*responses.FPDFAnnot_GetFlags, error)
+	FPDFAnnot_GetFocusableSubtypes               func(request *requests.FPDFAnnot_GetFocusableSubtypes) (*responses.FPDFAnnot_GetFocusableSubtypes, error)
+	FPDFAnnot_GetFocusableSubtypesCount          func(request *requests.FPDFAnnot_GetFocusableSubtypesCount) (*responses.FPDFAnnot_GetFocusableSubtypesCount, error)
+	FPDFAnnot_GetFontColor                       func(request *requests.FPDFAnnot_GetFontColor) (*responses.FPDFAnnot_GetFontColor, error)
+	FPDFAnnot_GetFontSize                        func(request *requests.FPDFAnnot_GetFontSize) (*responses.FPDFAnnot_GetFontSize, error)
+	FPDFAnnot_GetFormAdditionalActionJavaScript  func(request *requests.FPDFAnnot_GetFormAdditionalActionJavaScript) (*responses.FPDFAnnot_GetFormAdditionalActionJavaScript, error)
+	FPDFAnnot_GetFormControlCount                func(request *requests.FPDFAnnot_GetFormControlCount) (*responses.FPDFAnnot_GetFormControlCount, error)
+	FPDFAnnot_GetFormControlIndex                func(request *requests.FPDFAnnot_GetFormControlIndex) (*responses.FPDFAnnot_GetFormControlIndex, error)
+	FPDFAnnot_GetFormFieldAlternateName          func(request *requests.FPDFAnnot_GetFormFieldAlternateName) (*responses.FPDFAnnot_GetFormFieldAlternateName, error)
+	FPDFAnnot_GetFormFieldAtPoint                func(request *requests.FPDFAnnot_GetFormFieldAtPoint) (*responses.FPDFAnnot_GetFormFieldAtPoint, error)
+	FPDFAnnot_GetFormFieldExportValue            func(request *requests.FPDFAnnot_GetFormFieldExportValue) (*responses.FPDFAnnot_GetFormFieldExportValue, error)
+	FPDFAnnot_GetFormFieldFlags                  func(request *requests.FPDFAnnot_GetFormFieldFlags) (*responses.FPDFAnnot_GetFormFieldFlags, error)
+	FPDFAnnot_GetFormFieldName                   func(request *requests.FPDFAnnot_GetFormFieldName) (*responses.FPDFAnnot_GetFormFieldName, error)
+	FPDFAnnot_GetFormFieldType                   func(request *requests.FPDFAnnot_GetFormFieldType) (*responses.FPDFAnnot_GetFormFieldType, error)
+	FPDFAnnot_GetFormFieldValue                  func(request *requests.FPDFAnnot_GetFormFieldValue) (*responses.FPDFAnnot_GetFormFieldValue, error)
+	FPDFAnnot_GetInkListCount                    func(request *requests.FPDFAnnot_GetInkListCount) (*responses.FPDFAnnot_GetInkListCount, error)
+	FPDFAnnot_GetInkListPath                     func(request *requests.FPDFAnnot_GetInkListPath) (*responses.FPDFAnnot_GetInkListPath, error)
+	FPDFAnnot_GetLine                            func(request *requests.FPDFAnnot_GetLine) (*responses.FPDFAnnot_GetLine, error)
+	FPDFAnnot_GetLink                            func(request *requests.FPDFAnnot_GetLink) (*responses.FPDFAnnot_GetLink, error)
+	FPDFAnnot_GetLinkedAnnot                     func(request *requests.FPDFAnnot_GetLinkedAnnot) (*responses.FPDFAnnot_GetLinkedAnnot, error)
+	FPDFAnnot_GetNumberValue                     func(request *requests.FPDFAnnot_GetNumberValue) (*responses.FPDFAnnot_GetNumberValue, error)
+	FPDFAnnot_GetObject                          func(request *requests.FPDFAnnot_GetObject) (*responses.FPDFAnnot_GetObject, error)
+	FPDFAnnot_GetObjectCount                     func(request *requests.FPDFAnnot_GetObjectCount) (*responses.FPDFAnnot_GetObjectCount, error)
+	FPDFAnnot_GetOptionCount                     func(request *requests.FPDFAnnot_GetOptionCount) (*responses.FPDFAnnot_GetOptionCount, error)
+	FPDFAnnot_GetOptionLabel                     func(request *requests.FPDFAnnot_GetOptionLabel) (*responses.FPDFAnnot_GetOptionLabel, error)
+	FPDFAnnot_GetRect                            func(request *requests.FPDFAnnot_GetRect) (*responses.FPDFAnnot_GetRect, error)
+	FPDFAnnot_GetStringValue                     func(request *requests.FPDFAnnot_GetStringValue) (*responses.FPDFAnnot_GetStringValue, error)
+	FPDFAnnot_GetSubtype                         func(request *requests.FPDFAnnot_GetSubtype) (*responses.FPDFAnnot_GetSubtype, error)
+	FPDFAnnot_GetValueType                       func(request *requests.FPDFAnnot_GetValueType) (*responses.FPDFAnnot_GetValueType, error)
+	FPDFAnnot_GetVertices                        func(request *requests.FPDFAnnot_GetVertices) (*responses.FPDFAnnot_GetVertices, error)
+	FPDFAnnot_HasAttachmentPoints                func(request *requests.FPDFAnnot_HasAttachmentPoints) (*responses.FPDFAnnot_HasAttachmentPoints, error)
+	FPDFAnnot_HasKey                             func(request *requests.FPDFAnnot_HasKey) (*responses.FPDFAnnot_HasKey, error)
+	FPDFAnnot_IsChecked                          func(request *requests.FPDFAnnot_IsChecked) (*responses.FPDFAnnot_IsChecked, error)
+	FPDFAnnot_IsObjectSupportedSubtype           func(request *requests.FPDFAnnot_IsObjectSupportedSubtype) (*responses.FPDFAnnot_IsObjectSupportedSubtype, error)
+	FPDFAnnot_IsOptionSelected                   func(request *requests.FPDFAnnot_IsOptionSelected) (*responses.FPDFAnnot_IsOptionSelected, error)
+	FPDFAnnot_IsSupportedSubtype                 func(request *requests.FPDFAnnot_IsSupportedSubtype) (*responses.FPDFAnnot_IsSupportedSubtype, error)
+	FPDFAnnot_RemoveInkList                      func(request *requests.FPDFAnnot_RemoveInkList) (*responses.FPDFAnnot_RemoveInkList, error)
+	FPDFAnnot_RemoveObject                       func(request *requests.FPDFAnnot_RemoveObject) (*responses.FPDFAnnot_RemoveObject, error)
+	FPDFAnnot_SetAP                              func(request *requests.FPDFAnnot_SetAP) (*responses.FPDFAnnot_SetAP, error)
+	FPDFAnnot_SetAttachmentPoints                func(request *requests.FPDFAnnot_SetAttachmentPoints) (*responses.FPDFAnnot_SetAttachmentPoints, error)
+	FPDFAnnot_SetBorder                          func(request *requests.FPDFAnnot_SetBorder) (*responses.FPDFAnnot_SetBorder, error)
+	FPDFAnnot_SetColor                           func(request *requests.FPDFAnnot_SetColor) (*responses.FPDFAnnot_SetColor, error)
+	FPDFAnnot_SetFlags                           func(request *requests.FPDFAnnot_SetFlags) (*responses.FPDFAnnot_SetFlags, error)
+	FPDFAnnot_SetFocusableSubtypes               func(request *requests.FPDFAnnot_SetFocusableSubtypes) (*responses.FPDFAnnot_SetFocusableSubtypes, error)
+	FPDFAnnot_SetFontColor                       func(request *requests.FPDFAnnot_SetFontColor) (*responses.FPDFAnnot_SetFontColor, error)
+	FPDFAnnot_SetFormFieldFlags                  func(request *requests.FPDFAnnot_SetFormFieldFlags) (*responses.FPDFAnnot_SetFormFieldFlags, error)
+	FPDFAnnot_SetRect                            func(request *requests.FPDFAnnot_SetRect) (*responses.FPDFAnnot_SetRect, error)
+	FPDFAnnot_SetStringValue                     func(request *requests.FPDFAnnot_SetStringValue) (*responses.FPDFAnnot_SetStringValue, error)
+	FPDFAnnot_SetURI                             func(request *requests.FPDFAnnot_SetURI) (*responses.FPDFAnnot_SetURI, error)
+	FPDFAnnot_UpdateObject                       func(request *requests.FPDFAnnot_UpdateObject) (*responses.FPDFAnnot_UpdateObject, error)
+	FPDFAttachment_GetDescription                func(request *requests.FPDFAttachment_GetDescription) (*responses.FPDFAttachment_GetDescription, error)
+	FPDFAttachment_GetFile                       func(request *requests.FPDFAttachment_GetFile) (*responses.FPDFAttachment_GetFile, error)
+	FPDFAttachment_GetName                       func(request *requests.FPDFAttachment_GetName) (*responses.FPDFAttachment_GetName, error)
+	FPDFAttachment_GetStringValue                func(request *requests.FPDFAttachment_GetStringValue) (*responses.FPDFAttachment_GetStringValue, error)
+	FPDFAttachment_GetSubtype                    func(request *requests.FPDFAttachment_GetSubtype) (*responses.FPDFAttachment_GetSubtype, error)
+	FPDFAttachment_GetValueType                  func(request *requests.FPDFAttachment_GetValueType) (*responses.FPDFAttachment_GetValueType, error)
+	FPDFAttachment_HasKey                        func(request *requests.FPDFAttachment_HasKey) (*responses.FPDFAttachment_HasKey, error)
+	FPDFAttachment_SetDescription                func(request *requests.FPDFAttachment_SetDescription) (*responses.FPDFAttachment_SetDescription, error)
+	FPDFAttachment_SetFile                       func(request *requests.FPDFAttachment_SetFile) (*responses.FPDFAttachment_SetFile, error)
+	FPDFAttachment_SetStringValue                func(request *requests.FPDFAttachment_SetStringValue) (*responses.FPDFAttachment_SetStringValue, error)
+	FPDFAvail_Create                             func(request *requests.FPDFAvail_Create) (*responses.FPDFAvail_Create, error)
+	FPDFAvail_Destroy                            func(request *requests.FPDFAvail_Destroy) (*responses.FPDFAvail_Destroy, error)
+	FPDFAvail_GetDocument                        func(request *requests.FPDFAvail_GetDocument) (*responses.FPDFAvail_GetDocument, error)
+	FPDFAvail_GetFirstPageNum                    func(request *requests.FPDFAvail_GetFirstPageNum) (*responses.FPDFAvail_GetFirstPageNum, error)
+	FPDFAvail_IsDocAvail                         func(request *requests.FPDFAvail_IsDocAvail) (*responses.FPDFAvail_IsDocAvail, error)
+	FPDFAvail_IsFormAvail                        func(request *requests.FPDFAvail_IsFormAvail) (*responses.FPDFAvail_IsFormAvail, error)
+	FPDFAvail_IsLinearized                       func(request *requests.FPDFAvail_IsLinearized) (*responses.FPDFAvail_IsLinearized, error)
+	FPDFAvail_IsPageAvail                        func(request *requests.FPDFAvail_IsPageAvail) (*responses.FPDFAvail_IsPageAvail, error)
+	FPDFBitmap_Create                            func(request *requests.FPDFBitmap_Create) (*responses.FPDFBitmap_Create, error)
+	FPDFBitmap_CreateEx                          func(request *requests.FPDFBitmap_CreateEx) (*responses.FPDFBitmap_CreateEx, error)
+	FPDFBitmap_Destroy                           func(request *requests.FPDFBitmap_Destroy) (*responses.FPDFBitmap_Destroy, error)
+	FPDFBitmap_FillRect                          func(request *requests.FPDFBitmap_FillRect) (*responses.FPDFBitmap_FillRect, error)
+	FPDFBitmap_GetBuffer                         func(request *requests.FPDFBitmap_GetBuffer) (*responses.FPDFBitmap_GetBuffer, error)
+	FPDFBitmap_GetFormat                         func(request *requests.FPDFBitmap_GetFormat) (*responses.FPDFBitmap_GetFormat, error)
+	FPDFBitmap_GetHeight                         func(request *requests.FPDFBitmap_GetHeight) (*responses.FPDFBitmap_GetHeight, error)
+	FPDFBitmap_GetStride                         func(request *requests.FPDFBitmap_GetStride) (*responses.FPDFBitmap_GetStride, error)
+	FPDFBitmap_GetWidth                          func(request *requests.FPDFBitmap_GetWidth) (*responses.FPDFBitmap_GetWidth, error)
+	FPDFBookmark_Find                            func(request *requests.FPDFBookmark_Find) (*responses.FPDFBookmark_Find, error)
+	FPDFBookmark_GetAction                       func(request *requests.FPDFBookmark_GetAction) (*responses.FPDFBookmark_GetAction, error)
+	FPDFBookmark_GetColor                        func(request *requests.FPDFBookmark_GetColor) (*responses.FPDFBookmark_GetColor, error)
+	FPDFBookmark_GetCount                        func(request *requests.FPDFBookmark_GetCount) (*responses.FPDFBookmark_GetCount, error)
+	FPDFBookmark_GetDest                         func(request *requests.FPDFBookmark_GetDest) (*responses.FPDFBookmark_GetDest, error)
+	FPDFBookmark_GetFirstChild                   func(request *requests.FPDFBookmark_GetFirstChild) (*responses.FPDFBookmark_GetFirstChild, error)
+	FPDFBookmark_GetNextSibling                  func(request *requests.FPDFBookmark_GetNextSibling) (*responses.FPDFBookmark_GetNextSibling, error)
+	FPDFBookmark_GetTitle                        func(request *requests.FPDFBookmark_GetTitle) (*responses.FPDFBookmark_GetTitle, error)
+	FPDFCatalog_GetLanguage                      func(request *requests.FPDFCatalog_GetLanguage) (*responses.FPDFCatalog_GetLanguage, error)
+	FPDFCatalog_IsTagged                         func(request *requests.FPDFCatalog_IsTagged) (*responses.FPDFCatalog_IsTagged, error)
+	FPDFCatalog_SetLanguage                      func(request *requests.FPDFCatalog_SetLanguage) (*responses.FPDFCatalog_SetLanguage, error)
+	FPDFClipPath_CountPathSegments               func(request *requests.FPDFClipPath_CountPathSegments) (*responses.FPDFClipPath_CountPathSegments, error)
+	FPDFClipPath_CountPaths                      func(request *requests.FPDFClipPath_CountPaths) (*responses.FPDFClipPath_CountPaths, error)
+	FPDFClipPath_GetPathSegment                  func(request *requests.FPDFClipPath_GetPathSegment) (*responses.FPDFClipPath_GetPathSegment, error)
+	FPDFDOC_ExitFormFillEnvironment              func(request *requests.FPDFDOC_ExitFormFillEnvironment) (*responses.FPDFDOC_ExitFormFillEnvironment, error)
+	FPDFDOC_InitFormFillEnvironment              func(request *requests.FPDFDOC_InitFormFillEnvironment) (*responses.FPDFDOC_InitFormFillEnvironment, error)
+	FPDFDest_GetDestPageIndex                    func(request *requests.FPDFDest_GetDestPageIndex) (*responses.FPDFDest_GetDestPageIndex, error)
+	FPDFDest_GetLocationInPage                   func(request *requests.FPDFDest_GetLocationInPage) (*responses.FPDFDest_GetLocationInPage, error)
+	FPDFDest_GetView                             func(request *requests.FPDFDest_GetView) (*responses.FPDFDest_GetView, error)
+	FPDFDoc_AddAttachment                        func(request *requests.FPDFDoc_AddAttachment) (*responses.FPDFDoc_AddAttachment, error)
+	FPDFDoc_CloseJavaScriptAction                func(request *requests.FPDFDoc_CloseJavaScriptAction) (*responses.FPDFDoc_CloseJavaScriptAction, error)
+	FPDFDoc_DeleteAttachment                     func(request *requests.FPDFDoc_DeleteAttachment) (*responses.FPDFDoc_DeleteAttachment, error)
+	FPDFDoc_GetAttachment                        func(request *requests.FPDFDoc_GetAttachment) (*responses.FPDFDoc_GetAttachment, error)
+	FPDFDoc_GetAttachmentCount                   func(request *requests.FPDFDoc_GetAttachmentCount) (*responses.FPDFDoc_GetAttachmentCount, error)
+	FPDFDoc_GetJavaScriptAction                  func(request *requests.FPDFDoc_GetJavaScriptAction) (*responses.FPDFDoc_GetJavaScriptAction, error)
+	FPDFDoc_GetJavaScriptActionCount             func(request *requests.FPDFDoc_GetJavaScriptActionCount) (*responses.FPDFDoc_GetJavaScriptActionCount, error)
+	FPDFDoc_GetPageMode                          func(request *requests.FPDFDoc_GetPageMode) (*responses.FPDFDoc_GetPageMode, error)
+	FPDFFont_Close                               func(request *requests.FPDFFont_Close) (*responses.FPDFFont_Close, error)
+	FPDFFont_GetAscent                           func(request *requests.FPDFFont_GetAscent) (*responses.FPDFFont_GetAscent, error)
+	FPDFFont_GetBaseFontName                     func(request *requests.FPDFFont_GetBaseFontName) (*responses.FPDFFont_GetBaseFontName, error)
+	FPDFFont_GetDescent                          func(request *requests.FPDFFont_GetDescent) (*responses.FPDFFont_GetDescent, error)
+	FPDFFont_GetFamilyName                       func(request *requests.FPDFFont_GetFamilyName) (*responses.FPDFFont_GetFamilyName, error)
+	FPDFFont_GetFlags                            func(request *requests.FPDFFont_GetFlags) (*responses.FPDFFont_GetFlags, error)
+	FPDFFont_GetFontData                         func(request *requests.FPDFFont_GetFontData) (*responses.FPDFFont_GetFontData, error)
+	FPDFFont_GetGlyphPath                        func(request *requests.FPDFFont_GetGlyphPath) (*responses.FPDFFont_GetGlyphPath, error)
+	FPDFFont_GetGlyphWidth                       func(request *requests.FPDFFont_GetGlyphWidth) (*responses.FPDFFont_GetGlyphWidth, error)
+	FPDFFont_GetIsEmbedded                       func(request *requests.FPDFFont_GetIsEmbedded) (*responses.FPDFFont_GetIsEmbedded, error)
+	FPDFFont_GetItalicAngle                      func(request *requests.FPDFFont_GetItalicAngle) (*responses.FPDFFont_GetItalicAngle, error)
+	FPDFFont_GetWeight                           func(request *requests.FPDFFont_GetWeight) (*responses.FPDFFont_GetWeight, error)
+	FPDFFormObj_CountObjects                     func(request *requests.FPDFFormObj_CountObjects) (*responses.FPDFFormObj_CountObjects, error)
+	FPDFFormObj_GetObject                        func(request *requests.FPDFFormObj_GetObject) (*responses.FPDFFormObj_GetObject, error)
+	FPDFFormObj_RemoveObject                     func(request *requests.FPDFFormObj_RemoveObject) (*responses.FPDFFormObj_RemoveObject, error)
+	FPDFGlyphPath_CountGlyphSegments             func(request *requests.FPDFGlyphPath_CountGlyphSegments) (*responses.FPDFGlyphPath_CountGlyphSegments, error)
+	FPDFGlyphPath_GetGlyphPathSegment            func(request *requests.FPDFGlyphPath_GetGlyphPathSegment) (*responses.FPDFGlyphPath_GetGlyphPathSegment, error)
+	FPDFImageObj_GetBitmap                       func(request *requests.FPDFImageObj_GetBitmap) (*responses.FPDFImageObj_GetBitmap, error)
+	FPDFImageObj_GetIccProfileDataDecoded        func(request *requests.FPDFImageObj_GetIccProfileDataDecoded) (*responses.FPDFImageObj_GetIccProfileDataDecoded, error)
+	FPDFImageObj_GetImageDataDecoded             func(request *requests.FPDFImageObj_GetImageDataDecoded) (*responses.FPDFImageObj_GetImageDataDecoded, error)
+	FPDFImageObj_GetImageDataRaw                 func(request *requests.FPDFImageObj_GetImageDataRaw) (*responses.FPDFImageObj_GetImageDataRaw, error)
+	FPDFImageObj_GetImageFilter                  func(request *requests.FPDFImageObj_GetImageFilter) (*responses.FPDFImageObj_GetImageFilter, error)
+	FPDFImageObj_GetImageFilterCount             func(request *requests.FPDFImageObj_GetImageFilterCount) (*responses.FPDFImageObj_GetImageFilterCount, error)
+	FPDFImageObj_GetImageMetadata                func(request *requests.FPDFImageObj_GetImageMetadata) (*responses.FPDFImageObj_GetImageMetadata, error)
+	FPDFImageObj_GetImagePixelSize               func(request *requests.FPDFImageObj_GetImagePixelSize) (*responses.FPDFImageObj_GetImagePixelSize, error)
+	FPDFImageObj_GetRenderedBitmap               func(request *requests.FPDFImageObj_GetRenderedBitmap) (*responses.FPDFImageObj_GetRenderedBitmap, error)
+	FPDFImageObj_LoadJpegFile                    func(request *requests.FPDFImageObj_LoadJpegFile) (*responses.FPDFImageObj_LoadJpegFile, error)
+	FPDFImageObj_LoadJpegFileInline              func(request *requests.FPDFImageObj_LoadJpegFileInline) (*responses.FPDFImageObj_LoadJpegFileInline, error)
+	FPDFImageObj_SetBitmap                       func(request *requests.FPDFImageObj_SetBitmap) (*responses.FPDFImageObj_SetBitmap, error)
+	FPDFImageObj_SetMatrix                       func(request *requests.FPDFImageObj_SetMatrix) (*responses.FPDFImageObj_SetMatrix, error)
+	FPDFJavaScriptAction_GetName                 func(request *requests.FPDFJavaScriptAction_GetName) (*responses.FPDFJavaScriptAction_GetName, error)
+	FPDFJavaScriptAction_GetScript               func(request *requests.FPDFJavaScriptAction_GetScript) (*responses.FPDFJavaScriptAction_GetScript, error)
+	FPDFLink_CloseWebLinks                       func(request *requests.FPDFLink_CloseWebLinks) (*responses.FPDFLink_CloseWebLinks, error)
+	FPDFLink_CountQuadPoints                     func(request *requests.FPDFLink_CountQuadPoints) (*responses.FPDFLink_CountQuadPoints, error)
+	FPDFLink_CountRects                          func(request *requests.FPDFLink_CountRects) (*responses.FPDFLink_CountRects, error)
+	FPDFLink_CountWebLinks                       func(request *requests.FPDFLink_CountWebLinks) (*responses.FPDFLink_CountWebLinks, error)
+	FPDFLink_Enumerate                           func(request *requests.FPDFLink_Enumerate) (*responses.FPDFLink_Enumerate, error)
+	FPDFLink_GetAction                           func(request *requests.FPDFLink_GetAction) (*responses.FPDFLink_GetAction, error)
+	FPDFLink_GetAnnot                            func(request *requests.FPDFLink_GetAnnot) (*responses.FPDFLink_GetAnnot, error)
+	FPDFLink_GetAnnotRect                        func(request *requests.FPDFLink_GetAnnotRect) (*responses.FPDFLink_GetAnnotRect, error)
+	FPDFLink_GetDest                             func(request *requests.FPDFLink_GetDest) (*responses.FPDFLink_GetDest, error)
+	FPDFLink_GetLinkAtPoint                      func(request *requests.FPDFLink_GetLinkAtPoint) (*responses.FPDFLink_GetLinkAtPoint, error)
+	FPDFLink_GetLinkZOrderAtPoint                func(request *requests.FPDFLink_GetLinkZOrderAtPoint) (*responses.FPDFLink_GetLinkZOrderAtPoint, error)
+	FPDFLink_GetQuadPoints                       func(request *requests.FPDFLink_GetQuadPoints) (*responses.FPDFLink_GetQuadPoints, error)
+	FPDFLink_GetRect                             func(request *requests.FPDFLink_GetRect) (*responses.FPDFLink_GetRect, error)
+	FPDFLink_GetTextRange                        func(request *requests.FPDFLink_GetTextRange) (*responses.FPDFLink_GetTextRange, error)
+	FPDFLink_GetURL                              func(request *requests.FPDFLink_GetURL) (*responses.FPDFLink_GetURL, error)
+	FPDFLink_LoadWebLinks                        func(request *requests.FPDFLink_LoadWebLinks) (*responses.FPDFLink_LoadWebLinks, error)
+	FPDFPageObjMark_CountParams                  func(request *requests.FPDFPageObjMark_CountParams) (*responses.FPDFPageObjMark_CountParams, error)
+	FPDFPageObjMark_GetName                      func(request *requests.FPDFPageObjMark_GetName) (*responses.FPDFPageObjMark_GetName, error)
+	FPDFPageObjMark_GetParamBlobValue            func(request *requests.FPDFPageObjMark_GetParamBlobValue) (*responses.FPDFPageObjMark_GetParamBlobValue, error)
+	FPDFPageObjMark_GetParamFloatValue           func(request *requests.FPDFPageObjMark_GetParamFloatValue) (*responses.FPDFPageObjMark_GetParamFloatValue, error)
+	FPDFPageObjMark_GetParamIntValue             func(request *requests.FPDFPageObjMark_GetParamIntValue) (*responses.FPDFPageObjMark_GetParamIntValue, error)
+	FPDFPageObjMark_GetParamKey                  func(request *requests.FPDFPageObjMark_GetParamKey) (*responses.FPDFPageObjMark_GetParamKey, error)
+	FPDFPageObjMark_GetParamStringValue          func(request *requests.FPDFPageObjMark_GetParamStringValue) (*responses.FPDFPageObjMark_GetParamStringValue, error)
+	FPDFPageObjMark_GetParamValueType            func(request *requests.FPDFPageObjMark_GetParamValueType) (*responses.FPDFPageObjMark_GetParamValueType, error)
+	FPDFPageObjMark_RemoveParam                  func(request *requests.FPDFPageObjMark_RemoveParam) (*responses.FPDFPageObjMark_RemoveParam, error)
+	FPDFPageObjMark_SetBlobParam                 func(request *requests.FPDFPageObjMark_SetBlobParam) (*responses.FPDFPageObjMark_SetBlobParam, error)
+	FPDFPageObjMark_SetFloatParam                func(request *requests.FPDFPageObjMark_SetFloatParam) (*responses.FPDFPageObjMark_SetFloatParam, error)
+	FPDFPageObjMark_SetIntParam                  func(request *requests.FPDFPageObjMark_SetIntParam) (*responses.FPDFPageObjMark_SetIntParam, error)
+	FPDFPageObjMark_SetStringParam               func(request *requests.FPDFPageObjMark_SetStringParam) (*responses.FPDFPageObjMark_SetStringParam, error)
+	FPDFPageObj_AddExistingMark                  func(request *requests.FPDFPageObj_AddExistingMark) (*responses.FPDFPageObj_AddExistingMark, error)
+	FPDFPageObj_AddMark                          func(request *requests.FPDFPageObj_AddMark) (*responses.FPDFPageObj_AddMark, error)
+	FPDFPageObj_CountMarks                       func(request *requests.FPDFPageObj_CountMarks) (*responses.FPDFPageObj_CountMarks, error)
+	FPDFPageObj_CreateNewPath                    func(request *requests.FPDFPageObj_CreateNewPath) (*responses.FPDFPageObj_CreateNewPath, error)
+	FPDFPageObj_CreateNewRect                    func(request *requests.FPDFPageObj_CreateNewRect) (*responses.FPDFPageObj_CreateNewRect, error)
+	FPDFPageObj_CreateTextObj                    func(request *requests.FPDFPageObj_CreateTextObj) (*responses.FPDFPageObj_CreateTextObj, error)
+	FPDFPageObj_Destroy                          func(request *requests.FPDFPageObj_Destroy) (*responses.FPDFPageObj_Destroy, error)
+	FPDFPageObj_GetBounds                        func(request *requests.FPDFPageObj_GetBounds) (*responses.FPDFPageObj_GetBounds, error)
+	FPDFPageObj_GetClipPath                      func(request *requests.FPDFPageObj_GetClipPath) (*responses.FPDFPageObj_GetClipPath, error)
+	FPDFPageObj_GetDashArray                     func(request *requests.FPDFPageObj_GetDashArray) (*responses.FPDFPageObj_GetDashArray, error)
+	FPDFPageObj_GetDashCount                     func(request *requests.FPDFPageObj_GetDashCount) (*responses.FPDFPageObj_GetDashCount, error)
+	FPDFPageObj_GetDashPhase                     func(request *requests.FPDFPageObj_GetDashPhase) (*responses.FPDFPageObj_GetDashPhase, error)
+	FPDFPageObj_GetFillColor                     func(request *requests.FPDFPageObj_GetFillColor) (*responses.FPDFPageObj_GetFillColor, error)
+	FPDFPageObj_GetIsActive                      func(request *requests.FPDFPageObj_GetIsActive) (*responses.FPDFPageObj_GetIsActive, error)
+	FPDFPageObj_GetLineCap                       func(request *requests.FPDFPageObj_GetLineCap) (*responses.FPDFPageObj_GetLineCap, error)
+	FPDFPageObj_GetLineJoin                      func(request *requests.FPDFPageObj_GetLineJoin) (*responses.FPDFPageObj_GetLineJoin, error)
+	FPDFPageObj_GetMark                          func(request *requests.FPDFPageObj_GetMark) (*responses.FPDFPageObj_GetMark, error)
+	FPDFPageObj_GetMarkedContentID               func(request *requests.FPDFPageObj_GetMarkedContentID) (*responses.FPDFPageObj_GetMarkedContentID, error)
+	FPDFPageObj_GetMatrix                        func(request *requests.FPDFPageObj_GetMatrix) (*responses.FPDFPageObj_GetMatrix, error)
+	FPDFPageObj_GetRotatedBounds                 func(request *requests.FPDFPageObj_GetRotatedBounds) (*responses.FPDFPageObj_GetRotatedBounds, error)
+	FPDFPageObj_GetStrokeColor                   func(request *requests.FPDFPageObj_GetStrokeColor) (*responses.FPDFPageObj_GetStrokeColor, error)
+	FPDFPageObj_GetStrokeWidth                   func(request *requests.FPDFPageObj_GetStrokeWidth) (*responses.FPDFPageObj_GetStrokeWidth, error)
+	FPDFPageObj_GetType                          func(request *requests.FPDFPageObj_GetType) (*responses.FPDFPageObj_GetType, error)
+	FPDFPageObj_HasTransparency                  func(request *requests.FPDFPageObj_HasTransparency) (*responses.FPDFPageObj_HasTransparency, error)
+	FPDFPageObj_NewImageObj                      func(request *requests.FPDFPageObj_NewImageObj) (*responses.FPDFPageObj_NewImageObj, error)
+	FPDFPageObj_NewTextObj                       func(request *requests.FPDFPageObj_NewTextObj) (*responses.FPDFPageObj_NewTextObj, error)
+	FPDFPageObj_RemoveMark                       func(request *requests.FPDFPageObj_RemoveMark) (*responses.FPDFPageObj_RemoveMark, error)
+	FPDFPageObj_SetBlendMode                     func(request *requests.FPDFPageObj_SetBlendMode) (*responses.FPDFPageObj_SetBlendMode, error)
+	FPDFPageObj_SetDashArray                     func(request *requests.FPDFPageObj_SetDashArray) (*responses.FPDFPageObj_SetDashArray, error)
+	FPDFPageObj_SetDashPhase                     func(request *requests.FPDFPageObj_SetDashPhase) (*responses.FPDFPageObj_SetDashPhase, error)
+	FPDFPageObj_SetFillColor                     func(request *requests.FPDFPageObj_SetFillColor) (*responses.FPDFPageObj_SetFillColor, error)
+	FPDFPageObj_SetIsActive                      func(request *requests.FPDFPageObj_SetIsActive) (*responses.FPDFPageObj_SetIsActive, error)
+	FPDFPageObj_SetLineCap                       func(request *requests.FPDFPageObj_SetLineCap) (*responses.FPDFPageObj_SetLineCap, error)
+	FPDFPageObj_SetLineJoin                      func(request *requests.FPDFPageObj_SetLineJoin) (*responses.FPDFPageObj_SetLineJoin, error)
+	FPDFPageObj_SetMatrix                        func(request *requests.FPDFPageObj_SetMatrix) (*responses.FPDFPageObj_SetMatrix, error)
+	FPDFPageObj_SetStrokeColor                   func(request *requests.FPDFPageObj_SetStrokeColor) (*responses.FPDFPageObj_SetStrokeColor, error)
+	FPDFPageObj_SetStrokeWidth                   func(request *requests.FPDFPageObj_SetStrokeWidth) (*responses.FPDFPageObj_SetStrokeWidth, error)
+	FPDFPageObj_Transform                        func(request *requests.FPDFPageObj_Transform) (*responses.FPDFPageObj_Transform, error)
+	FPDFPageObj_TransformClipPath                func(request *requests.FPDFPageObj_TransformClipPath) (*responses.FPDFPageObj_TransformClipPath, error)
+	FPDFPageObj_TransformF                       func(request *requests.FPDFPageObj_TransformF) (*responses.FPDFPageObj_TransformF, error)
+	FPDFPage_CloseAnnot                          func(request *requests.FPDFPage_CloseAnnot) (*responses.FPDFPage_CloseAnnot, error)
+	FPDFPage_CountObjects                        func(request *requests.FPDFPage_CountObjects) (*responses.FPDFPage_CountObjects, error)
+	FPDFPage_CreateAnnot                         func(request *requests.FPDFPage_CreateAnnot) (*responses.FPDFPage_CreateAnnot, error)
+	FPDFPage_Delete                              func(request *requests.FPDFPage_Delete) (*responses.FPDFPage_Delete, error)
+	FPDFPage_Flatten                             func(request *requests.FPDFPage_Flatten) (*responses.FPDFPage_Flatten, error)
+	FPDFPage_FormFieldZOrderAtPoint              func(request *requests.FPDFPage_FormFieldZOrderAtPoint) (*responses.FPDFPage_FormFieldZOrderAtPoint, error)
+	FPDFPage_GenerateContent                     func(request *requests.FPDFPage_GenerateContent) (*responses.FPDFPage_GenerateContent, error)
+	FPDFPage_GetAnnot                            func(request *requests.FPDFPage_GetAnnot) (*responses.FPDFPage_GetAnnot, error)
+	FPDFPage_GetAnnotCount                       func(request *requests.FPDFPage_GetAnnotCount) (*responses.FPDFPage_GetAnnotCount, error)
+	FPDFPage_GetAnnotIndex                       func(request *requests.FPDFPage_GetAnnotIndex) (*responses.FPDFPage_GetAnnotIndex, error)
+	FPDFPage_GetArtBox                           func(request *requests.FPDFPage_GetArtBox) (*responses.FPDFPage_GetArtBox, error)
+	FPDFPage_GetBleedBox                         func(request *requests.FPDFPage_GetBleedBox) (*responses.FPDFPage_GetBleedBox, error)
+	FPDFPage_GetCropBox                          func(request *requests.FPDFPage_GetCropBox) (*responses.FPDFPage_GetCropBox, error)
+	FPDFPage_GetDecodedThumbnailData             func(request *requests.FPDFPage_GetDecodedThumbnailData) (*responses.FPDFPage_GetDecodedThumbnailData, error)
+	FPDFPage_GetMediaBox                         func(request *requests.FPDFPage_GetMediaBox) (*responses.FPDFPage_GetMediaBox, error)
+	FPDFPage_GetObject                           func(request *requests.FPDFPage_GetObject) (*responses.FPDFPage_GetObject, error)
+	FPDFPage_GetRawThumbnailData                 func(request *requests.FPDFPage_GetRawThumbnailData) (*responses.FPDFPage_GetRawThumbnailData, error)
+	FPDFPage_GetRotation                         func(request *requests.FPDFPage_GetRotation) (*responses.FPDFPage_GetRotation, error)
+	FPDFPage_GetThumbnailAsBitmap                func(request *requests.FPDFPage_GetThumbnailAsBitmap) (*responses.FPDFPage_GetThumbnailAsBitmap, error)
+	FPDFPage_GetTrimBox                          func(request *requests.FPDFPage_GetTrimBox) (*responses.FPDFPage_GetTrimBox, error)
+	FPDFPage_HasFormFieldAtPoint                 func(request *requests.FPDFPage_HasFormFieldAtPoint) (*responses.FPDFPage_HasFormFieldAtPoint, error)
+	FPDFPage_HasTransparency                     func(request *requests.FPDFPage_HasTransparency) (*responses.FPDFPage_HasTransparency, error)
+	FPDFPage_InsertClipPath                      func(request *requests.FPDFPage_InsertClipPath) (*responses.FPDFPage_InsertClipPath, error)
+	FPDFPage_InsertObject                        func(request *requests.FPDFPage_InsertObject) (*responses.FPDFPage_InsertObject, error)
+	FPDFPage_InsertObjectAtIndex                 func(request *requests.FPDFPage_InsertObjectAtIndex) (*responses.FPDFPage_InsertObjectAtIndex, error)
+	FPDFPage_New                                 func(request *requests.FPDFPage_New) (*responses.FPDFPage_New, error)
+	FPDFPage_RemoveAnnot                         func(request *requests.FPDFPage_RemoveAnnot) (*responses.FPDFPage_RemoveAnnot, error)
+	FPDFPage_RemoveObject                        func(request *requests.FPDFPage_RemoveObject) (*responses.FPDFPage_RemoveObject, error)
+	FPDFPage_SetArtBox                           func(request *requests.FPDFPage_SetArtBox) (*responses.FPDFPage_SetArtBox, error)
+	FPDFPage_SetBleedBox                         func(request *requests.FPDFPage_SetBleedBox) (*responses.FPDFPage_SetBleedBox, error)
+	FPDFPage_SetCropBox                          func(request *requests.FPDFPage_SetCropBox) (*responses.FPDFPage_SetCropBox, error)
+	FPDFPage_SetMediaBox                         func(request *requests.FPDFPage_SetMediaBox) (*responses.FPDFPage_SetMediaBox, error)
+	FPDFPage_SetRotation                         func(request *requests.FPDFPage_SetRotation) (*responses.FPDFPage_SetRotation, error)
+	FPDFPage_SetTrimBox                          func(request *requests.FPDFPage_SetTrimBox) (*responses.FPDFPage_SetTrimBox, error)
+	FPDFPage_TransFormWithClip                   func(request *requests.FPDFPage_TransFormWithClip) (*responses.FPDFPage_TransFormWithClip, error)
+	FPDFPage_TransformAnnots                     func(request *requests.FPDFPage_TransformAnnots) (*responses.FPDFPage_TransformAnnots, error)
+	FPDFPathSegment_GetClose                     func(request *requests.FPDFPathSegment_GetClose) (*responses.FPDFPathSegment_GetClose, error)
+	FPDFPathSegment_GetPoint                     func(request *requests.FPDFPathSegment_GetPoint) (*responses.FPDFPathSegment_GetPoint, error)
+	FPDFPathSegment_GetType                      func(request *requests.FPDFPathSegment_GetType) (*responses.FPDFPathSegment_GetType, error)
+	FPDFPath_BezierTo                            func(request *requests.FPDFPath_BezierTo) (*responses.FPDFPath_BezierTo, error)
+	FPDFPath_Close                               func(request *requests.FPDFPath_Close) (*responses.FPDFPath_Close, error)
+	FPDFPath_CountSegments                       func(request *requests.FPDFPath_CountSegments) (*responses.FPDFPath_CountSegments, error)
+	FPDFPath_GetDrawMode                         func(request *requests.FPDFPath_GetDrawMode) (*responses.FPDFPath_GetDrawMode, error)
+	FPDFPath_GetPathSegment                      func(request *requests.FPDFPath_GetPathSegment) (*responses.FPDFPath_GetPathSegment, error)
+	FPDFPath_LineTo                              func(request *requests.FPDFPath_LineTo) (*responses.FPDFPath_LineTo, error)
+	FPDFPath_MoveTo                              func(request *requests.FPDFPath_MoveTo) (*responses.FPDFPath_MoveTo, error)
+	FPDFPath_SetDrawMode                         func(request *requests.FPDFPath_SetDrawMode) (*responses.FPDFPath_SetDrawMode, error)
+	FPDFSignatureObj_GetByteRange                func(request *requests.FPDFSignatureObj_GetByteRange) (*responses.FPDFSignatureObj_GetByteRange, error)
+	FPDFSignatureObj_GetContents                 func(request *requests.FPDFSignatureObj_GetContents) (*responses.FPDFSignatureObj_GetContents, error)
+	FPDFSignatureObj_GetDocMDPPermission         func(request *requests.FPDFSignatureObj_GetDocMDPPermission) (*responses.FPDFSignatureObj_GetDocMDPPermission, error)
+	FPDFSignatureObj_GetReason                   func(request *requests.FPDFSignatureObj_GetReason) (*responses.FPDFSignatureObj_GetReason, error)
+	FPDFSignatureObj_GetSubFilter                func(request *requests.FPDFSignatureObj_GetSubFilter) (*responses.FPDFSignatureObj_GetSubFilter, error)
+	FPDFSignatureObj_GetTime                     func(request *requests.FPDFSignatureObj_GetTime) (*responses.FPDFSignatureObj_GetTime, error)
+	FPDFTextObj_GetFont                          func(request *requests.FPDFTextObj_GetFont) (*responses.FPDFTextObj_GetFont, error)
+	FPDFTextObj_GetFontSize                      func(request *requests.FPDFTextObj_GetFontSize) (*responses.FPDFTextObj_GetFontSize, error)
+	FPDFTextObj_GetRenderedBitmap                func(request *requests.FPDFTextObj_GetRenderedBitmap) (*responses.FPDFTextObj_GetRenderedBitmap, error)
+	FPDFTextObj_GetText                          func(request *requests.FPDFTextObj_GetText) (*responses.FPDFTextObj_GetText, error)
+	FPDFTextObj_GetTextRenderMode                func(request *requests.FPDFTextObj_GetTextRenderMode) (*responses.FPDFTextObj_GetTextRenderMode, error)
+	FPDFTextObj_SetFontSize                      func(request *requests.FPDFTextObj_SetFontSize) (*responses.FPDFTextObj_SetFontSize, error)
+	FPDFTextObj_SetTextRenderMode                func(request *requests.FPDFTextObj_SetTextRenderMode) (*responses.FPDFTextObj_SetTextRenderMode, error)
+	FPDFText_ClosePage                           func(request *requests.FPDFText_ClosePage) (*responses.FPDFText_ClosePage, error)
+	FPDFText_CountChars                          func(request *requests.FPDFText_CountChars) (*responses.FPDFText_CountChars, error)
+	FPDFText_CountRects                          func(request *requests.FPDFText_CountRects) (*responses.FPDFText_CountRects, error)
+	FPDFText_FindClose                           func(request *requests.FPDFText_FindClose) (*responses.FPDFText_FindClose, error)
+	FPDFText_FindNext                            func(request *requests.FPDFText_FindNext) (*responses.FPDFText_FindNext, error)
+	FPDFText_FindPrev                            func(request *requests.FPDFText_FindPrev) (*responses.FPDFText_FindPrev, error)
+	FPDFText_FindStart                           func(request *requests.FPDFText_FindStart) (*responses.FPDFText_FindStart, error)
+	FPDFText_GetBoundedText                      func(request *requests.FPDFText_GetBoundedText) (*responses.FPDFText_GetBoundedText, error)
+	FPDFText_GetCharAngle                        func(request *requests.FPDFText_GetCharAngle) (*responses.FPDFText_GetCharAngle, error)
+	FPDFText_GetCharBox                          func(request *requests.FPDFText_GetCharBox) (*responses.FPDFText_GetCharBox, error)
+	FPDFText_GetCharIndexAtPos                   func(request *requests.FPDFText_GetCharIndexAtPos) (*responses.FPDFText_GetCharIndexAtPos, error)
+	FPDFText_GetCharIndexFromTextIndex           func(request *requests.FPDFText_GetCharIndexFromTextIndex) (*responses.FPDFText_GetCharIndexFromTextIndex, error)
+	FPDFText_GetCharOrigin                       func(request *requests.FPDFText_GetCharOrigin) (*responses.FPDFText_GetCharOrigin, error)
+	FPDFText_GetFillColor                        func(request *requests.FPDFText_GetFillColor) (*responses.FPDFText_GetFillColor, error)
+	FPDFText_GetFontInfo                         func(request *requests.FPDFText_GetFontInfo) (*responses.FPDFText_GetFontInfo, error)
+	FPDFText_GetFontSize                         func(request *requests.FPDFText_GetFontSize) (*responses.FPDFText_GetFontSize, error)
+	FPDFText_GetFontWeight                       func(request *requests.FPDFText_GetFontWeight) (*responses.FPDFText_GetFontWeight, error)
+	FPDFText_GetLooseCharBox                     func(request *requests.FPDFText_GetLooseCharBox) (*responses.FPDFText_GetLooseCharBox, error)
+	FPDFText_GetMatrix                           func(request *requests.FPDFText_GetMatrix) (*responses.FPDFText_GetMatrix, error)
+	FPDFText_GetRect                             func(request *requests.FPDFText_GetRect) (*responses.FPDFText_GetRect, error)
+	FPDFText_GetSchCount                         func(request *requests.FPDFText_GetSchCount) (*responses.FPDFText_GetSchCount, error)
+	FPDFText_GetSchResultIndex                   func(request *requests.FPDFText_GetSchResultIndex) (*responses.FPDFText_GetSchResultIndex, error)
+	FPDFText_GetStrokeColor                      func(request *requests.FPDFText_GetStrokeColor) (*responses.FPDFText_GetStrokeColor, error)
+	FPDFText_GetText                             func(request *requests.FPDFText_GetText) (*responses.FPDFText_GetText, error)
+	FPDFText_GetTextIndexFromCharIndex           func(request *requests.FPDFText_GetTextIndexFromCharIndex) (*responses.FPDFText_GetTextIndexFromCharIndex, error)
+	FPDFText_GetTextObject                       func(request *requests.FPDFText_GetTextObject) (*responses.FPDFText_GetTextObject, error)
+	FPDFText_GetUnicode                          func(request *requests.FPDFText_GetUnicode) (*responses.FPDFText_GetUnicode, error)
+	FPDFText_HasUnicodeMapError                  func(request *requests.FPDFText_HasUnicodeMapError) (*responses.FPDFText_HasUnicodeMapError, error)
+	FPDFText_IsGenerated                         func(request *requests.FPDFText_IsGenerated) (*responses.FPDFText_IsGenerated, error)
+	FPDFText_IsHyphen                            func(request *requests.FPDFText_IsHyphen) (*responses.FPDFText_IsHyphen, error)
+	FPDFText_LoadCidType2Font                    func(request *requests.FPDFText_LoadCidType2Font) (*responses.FPDFText_LoadCidType2Font, error)
+	FPDFText_LoadFont                            func(request *requests.FPDFText_LoadFont) (*responses.FPDFText_LoadFont, error)
+	FPDFText_LoadPage                            func(request *requests.FPDFText_LoadPage) (*responses.FPDFText_LoadPage, error)
+	FPDFText_LoadStandardFont                    func(request *requests.FPDFText_LoadStandardFont) (*responses.FPDFText_LoadStandardFont, error)
+	FPDFText_SetCharcodes                        func(request *requests.FPDFText_SetCharcodes) (*responses.FPDFText_SetCharcodes, error)
+	FPDFText_SetPositions                        func(request *requests.FPDFText_SetPositions) (*responses.FPDFText_SetPositions, error)
+	FPDFText_SetText                             func(request *requests.FPDFText_SetText) (*responses.FPDFText_SetText, error)
+	FPDF_CloseDocument                           func(request *requests.FPDF_CloseDocument) (*responses.FPDF_CloseDocument, error)
+	FPDF_ClosePage                               func(request *requests.FPDF_ClosePage) (*responses.FPDF_ClosePage, error)
+	FPDF_CloseXObject                            func(request *requests.FPDF_CloseXObject) (*responses.FPDF_CloseXObject, error)
+	FPDF_CopyViewerPreferences                   func(request *requests.FPDF_CopyViewerPreferences) (*responses.FPDF_CopyViewerPreferences, error)
+	FPDF_CountNamedDests                         func(request *requests.FPDF_CountNamedDests) (*responses.FPDF_CountNamedDests, error)
+	FPDF_CreateClipPath                          func(request *requests.FPDF_CreateClipPath) (*responses.FPDF_CreateClipPath, error)
+	FPDF_CreateNewDocument                       func(request *requests.FPDF_CreateNewDocument) (*responses.FPDF_CreateNewDocument, error)
+	FPDF_DestroyClipPath                         func(request *requests.FPDF_DestroyClipPath) (*responses.FPDF_DestroyClipPath, error)
+	FPDF_DeviceToPage                            func(request *requests.FPDF_DeviceToPage) (*responses.FPDF_DeviceToPage, error)
+	FPDF_DocumentHasValidCrossReferenceTable     func(request *requests.FPDF_DocumentHasValidCrossReferenceTable) (*responses.FPDF_DocumentHasValidCrossReferenceTable, error)
+	FPDF_FFLDraw                                 func(request *requests.FPDF_FFLDraw) (*responses.FPDF_FFLDraw, error)
+	FPDF_GetDocPermissions                       func(request *requests.FPDF_GetDocPermissions) (*responses.FPDF_GetDocPermissions, error)
+	FPDF_GetDocUserPermissions                   func(request *requests.FPDF_GetDocUserPermissions) (*responses.FPDF_GetDocUserPermissions, error)
+	FPDF_GetFileIdentifier                       func(request *requests.FPDF_GetFileIdentifier) (*responses.FPDF_GetFileIdentifier, error)
+	FPDF_GetFileVersion                          func(request *requests.FPDF_GetFileVersion) (*responses.FPDF_GetFileVersion, error)
+	FPDF_GetFormType                             func(request *requests.FPDF_GetFormType) (*responses.FPDF_GetFormType, error)
+	FPDF_GetLastError                            func(request *requests.FPDF_GetLastError) (*responses.FPDF_GetLastError, error)
+	FPDF_GetMetaText                             func(request *requests.FPDF_GetMetaText) (*responses.FPDF_GetMetaText, error)
+	FPDF_GetNamedDest                            func(request *requests.FPDF_GetNamedDest) (*responses.FPDF_GetNamedDest, error)
+	FPDF_GetNamedDestByName                      func(request *requests.FPDF_GetNamedDestByName) (*responses.FPDF_GetNamedDestByName, error)
+	FPDF_GetPageAAction                          func(request *requests.FPDF_GetPageAAction) (*responses.FPDF_GetPageAAction, error)
+	FPDF_GetPageBoundingBox                      func(request *requests.FPDF_GetPageBoundingBox) (*responses.FPDF_GetPageBoundingBox, error)
+	FPDF_GetPageCount                            func(request *requests.FPDF_GetPageCount) (*responses.FPDF_GetPageCount, error)
+	FPDF_GetPageHeight                           func(request *requests.FPDF_GetPageHeight) (*responses.FPDF_GetPageHeight, error)
+	FPDF_GetPageHeightF                          func(request *requests.FPDF_GetPageHeightF) (*responses.FPDF_GetPageHeightF, error)
+	FPDF_GetPageLabel                            func(request *requests.FPDF_GetPageLabel) (*responses.FPDF_GetPageLabel, error)
+	FPDF_GetPageSizeByIndex                      func(request *requests.FPDF_GetPageSizeByIndex) (*responses.FPDF_GetPageSizeByIndex, error)
+	FPDF_GetPageSizeByIndexF                     func(request *requests.FPDF_GetPageSizeByIndexF) (*responses.FPDF_GetPageSizeByIndexF, error)
+	FPDF_GetPageWidth                            func(request *requests.FPDF_GetPageWidth) (*responses.FPDF_GetPageWidth, error)
+	FPDF_GetPageWidthF                           func(request *requests.FPDF_GetPageWidthF) (*responses.FPDF_GetPageWidthF, error)
+	FPDF_GetSecurityHandlerRevision              func(request *requests.FPDF_GetSecurityHandlerRevision) (*responses.FPDF_GetSecurityHandlerRevision, error)
+	FPDF_GetSignatureCount                       func(request *requests.FPDF_GetSignatureCount) (*responses.FPDF_GetSignatureCount, error)
+	FPDF_GetSignatureObject                      func(request *requests.FPDF_GetSignatureObject) (*responses.FPDF_GetSignatureObject, error)
+	FPDF_GetTrailerEnds                          func(request *requests.FPDF_GetTrailerEnds) (*responses.FPDF_GetTrailerEnds, error)
+	FPDF_GetXFAPacketContent                     func(request *requests.FPDF_GetXFAPacketContent) (*responses.FPDF_GetXFAPacketContent, error)
+	FPDF_GetXFAPacketCount                       func(request *requests.FPDF_GetXFAPacketCount) (*responses.FPDF_GetXFAPacketCount, error)
+	FPDF_GetXFAPacketName                        func(request *requests.FPDF_GetXFAPacketName) (*responses.FPDF_GetXFAPacketName, error)
+	FPDF_ImportNPagesToOne                       func(request *requests.FPDF_ImportNPagesToOne) (*responses.FPDF_ImportNPagesToOne, error)
+	FPDF_ImportPages                             func(request *requests.FPDF_ImportPages) (*responses.FPDF_ImportPages, error)
+	FPDF_ImportPagesByIndex                      func(request *requests.FPDF_ImportPagesByIndex) (*responses.FPDF_ImportPagesByIndex, error)
+	FPDF_LoadCustomDocument                      func(request *requests.FPDF_LoadCustomDocument) (*responses.FPDF_LoadCustomDocument, error)
+	FPDF_LoadDocument                            func(request *requests.FPDF_LoadDocument) (*responses.FPDF_LoadDocument, error)
+	FPDF_LoadMemDocument                         func(request *requests.FPDF_LoadMemDocument) (*responses.FPDF_LoadMemDocument, error)
+	FPDF_LoadMemDocument64                       func(request *requests.FPDF_LoadMemDocument64) (*responses.FPDF_LoadMemDocument64, error)
+	FPDF_LoadPage                                func(request *requests.FPDF_LoadPage) (*responses.FPDF_LoadPage, error)
+	FPDF_LoadXFA                                 func(request *requests.FPDF_LoadXFA) (*responses.FPDF_LoadXFA, error)
+	FPDF_MovePages                               func(request *requests.FPDF_MovePages) (*responses.FPDF_MovePages, error)
+	FPDF_NewFormObjectFromXObject                func(request *requests.FPDF_NewFormObjectFromXObject) (*responses.FPDF_NewFormObjectFromXObject, error)
+	FPDF_NewXObjectFromPage                      func(request *requests.FPDF_NewXObjectFromPage) (*responses.FPDF_NewXObjectFromPage, error)
+	FPDF_PageToDevice                            func(request *requests.FPDF_PageToDevice) (*responses.FPDF_PageToDevice, error)
+	FPDF_RemoveFormFieldHighlight                func(request *requests.FPDF_RemoveFormFieldHighlight) (*responses.FPDF_RemoveFormFieldHighlight, error)
+	FPDF_RenderPage                              func(request *requests.FPDF_RenderPage) (*responses.FPDF_RenderPage, error)
+	FPDF_RenderPageBitmap                        func(request *requests.FPDF_RenderPageBitmap) (*responses.FPDF_RenderPageBitmap, error)
+	FPDF_RenderPageBitmapWithColorScheme_Start   func(request *requests.FPDF_RenderPageBitmapWithColorScheme_Start) (*responses.FPDF_RenderPageBitmapWithColorScheme_Start, error)
+	FPDF_RenderPageBitmapWithMatrix              func(request *requests.FPDF_RenderPageBitmapWithMatrix) (*responses.FPDF_RenderPageBitmapWithMatrix, error)
+	FPDF_RenderPageBitmap_Start                  func(request *requests.FPDF_RenderPageBitmap_Start) (*responses.FPDF_RenderPageBitmap_Start, error)
+	FPDF_RenderPage_Close                        func(request *requests.FPDF_RenderPage_Close) (*responses.FPDF_RenderPage_Close, error)
+	FPDF_RenderPage_Continue                     func(request *requests.FPDF_RenderPage_Continue) (*responses.FPDF_RenderPage_Continue, error)
+	FPDF_SaveAsCopy                              func(request *requests.FPDF_SaveAsCopy) (*responses.FPDF_SaveAsCopy, error)
+	FPDF_SaveWithVersion                         func(request *requests.FPDF_SaveWithVersion) (*responses.FPDF_SaveWithVersion, error)
+	FPDF_SetFormFieldHighlightAlpha              func(request *requests.FPDF_SetFormFieldHighlightAlpha) (*responses.FPDF_SetFormFieldHighlightAlpha, error)
+	FPDF_SetFormFieldHighlightColor              func(request *requests.FPDF_SetFormFieldHighlightColor) (*responses.FPDF_SetFormFieldHighlightColor, error)
+	FPDF_SetPrintMode                            func(request *requests.FPDF_SetPrintMode) (*responses.FPDF_SetPrintMode, error)
+	FPDF_SetSandBoxPolicy                        func(request *requests.FPDF_SetSandBoxPolicy) (*responses.FPDF_SetSandBoxPolicy, error)
+	FPDF_StructElement_Attr_CountChildren        func(request *requests.FPDF_StructElement_Attr_CountChildren) (*responses.FPDF_StructElement_Attr_CountChildren, error)
+	FPDF_StructElement_Attr_GetBlobValue         func(request *requests.FPDF_StructElement_Attr_GetBlobValue) (*responses.FPDF_StructElement_Attr_GetBlobValue, error)
+	FPDF_StructElement_Attr_GetBooleanValue      func(request *requests.FPDF_StructElement_Attr_GetBooleanValue) (*responses.FPDF_StructElement_Attr_GetBooleanValue, error)
+	FPDF_StructElement_Attr_GetChildAtIndex      func(request *requests.FPDF_StructElement_Attr_GetChildAtIndex) (*responses.FPDF_StructElement_Attr_GetChildAtIndex, error)
+	FPDF_StructElement_Attr_GetCount             func(request *requests.FPDF_StructElement_Attr_GetCount) (*responses.FPDF_StructElement_Attr_GetCount, error)
+	FPDF_StructElement_Attr_GetName              func(request *requests.FPDF_StructElement_Attr_GetName) (*responses.FPDF_StructElement_Attr_GetName, error)
+	FPDF_StructElement_Attr_GetNumberValue       func(request *requests.FPDF_StructElement_Attr_GetNumberValue) (*responses.FPDF_StructElement_Attr_GetNumberValue, error)
+	FPDF_StructElement_Attr_GetStringValue       func(request *requests.FPDF_StructElement_Attr_GetStringValue) (*responses.FPDF_StructElement_Attr_GetStringValue, error)
+	FPDF_StructElement_Attr_GetType              func(request *requests.FPDF_StructElement_Attr_GetType) (*responses.FPDF_StructElement_Attr_GetType, error)
+	FPDF_StructElement_Attr_GetValue             func(request *requests.FPDF_StructElement_Attr_GetValue) (*responses.FPDF_StructElement_Attr_GetValue, error)
+	FPDF_StructElement_CountChildren             func(request *requests.FPDF_StructElement_CountChildren) (*responses.FPDF_StructElement_CountChildren, error)
+	FPDF_StructElement_GetActualText             func(request *requests.FPDF_StructElement_GetActualText) (*responses.FPDF_StructElement_GetActualText, error)
+	FPDF_StructElement_GetAltText                func(request *requests.FPDF_StructElement_GetAltText) (*responses.FPDF_StructElement_GetAltText, error)
+	FPDF_StructElement_GetAttributeAtIndex       func(request *requests.FPDF_StructElement_GetAttributeAtIndex) (*responses.FPDF_StructElement_GetAttributeAtIndex, error)
+	FPDF_StructElement_GetAttributeCount         func(request *requests.FPDF_StructElement_GetAttributeCount) (*responses.FPDF_StructElement_GetAttributeCount, error)
+	FPDF_StructElement_GetChildAtIndex           func(request *requests.FPDF_StructElement_GetChildAtIndex) (*responses.FPDF_StructElement_GetChildAtIndex, error)
+	FPDF_StructElement_GetChildMarkedContentID   func(request *requests.FPDF_StructElement_GetChildMarkedContentID) (*responses.FPDF_StructElement_GetChildMarkedContentID, error)
+	FPDF_StructElement_GetExpansion              func(request *requests.FPDF_StructElement_GetExpansion) (*responses.FPDF_StructElement_GetExpansion, error)
+	FPDF_StructElement_GetID                     func(request *requests.FPDF_StructElement_GetID) (*responses.FPDF_StructElement_GetID, error)
+	FPDF_StructElement_GetLang                   func(request *requests.FPDF_StructElement_GetLang) (*responses.FPDF_StructElement_GetLang, error)
+	FPDF_StructElement_GetMarkedContentID        func(request *requests.FPDF_StructElement_GetMarkedContentID) (*responses.FPDF_StructElement_GetMarkedContentID, error)
+	FPDF_StructElement_GetMarkedContentIdAtIndex func(request *requests.FPDF_StructElement_GetMarkedContentIdAtIndex) (*responses.FPDF_StructElement_GetMarkedContentIdAtIndex, error)
+	FPDF_StructElement_GetMarkedContentIdCount   func(request *requests.FPDF_StructElement_GetMarkedContentIdCount) (*responses.FPDF_StructElement_GetMarkedContentIdCount, error)
+	FPDF_StructElement_GetObjType                func(request *requests.FPDF_StructElement_GetObjType) (*responses.FPDF_StructElement_GetObjType, error)
+	FPDF_StructElement_GetParent                 func(request *requests.FPDF_StructElement_GetParent) (*responses.FPDF_StructElement_GetParent, error)
+	FPDF_StructElement_GetStringAttribute        func(request *requests.FPDF_StructElement_GetStringAttribute) (*responses.FPDF_StructElement_GetStringAttribute, error)
+	FPDF_StructElement_GetTitle                  func(request *requests.FPDF_StructElement_GetTitle) (*responses.FPDF_StructElement_GetTitle, error)
+	FPDF_StructElement_GetType                   func(request *requests.FPDF_StructElement_GetType) (*responses.FPDF_StructElement_GetType, error)
+	FPDF_StructTree_Close                        func(request *requests.FPDF_StructTree_Close) (*responses.FPDF_StructTree_Close, error)
+	FPDF_StructTree_CountChildren                func(request *requests.FPDF_StructTree_CountChildren) (*responses.FPDF_StructTree_CountChildren, error)
+	FPDF_StructTree_GetChildAtIndex              func(request *requests.FPDF_StructTree_GetChildAtIndex) (*responses.FPDF_StructTree_GetChildAtIndex, error)
+	FPDF_StructTree_GetForPage                   func(request *requests.FPDF_StructTree_GetForPage) (*responses.FPDF_StructTree_GetForPage, error)
+	FPDF_VIEWERREF_GetDuplex                     func(request *requests.FPDF_VIEWERREF_GetDuplex) (*responses.FPDF_VIEWERREF_GetDuplex, error)
+	FPDF_VIEWERREF_GetName                       func(request *requests.FPDF_VIEWERREF_GetName) (*responses.FPDF_VIEWERREF_GetName, error)
+	FPDF_VIEWERREF_GetNumCopies                  func(request *requests.FPDF_VIEWERREF_GetNumCopies) (*responses.FPDF_VIEWERREF_GetNumCopies, error)
+	FPDF_VIEWERREF_GetPrintPageRange             func(request *requests.FPDF_VIEWERREF_GetPrintPageRange) (*responses.FPDF_VIEWERREF_GetPrintPageRange, error)
+	FPDF_VIEWERREF_GetPrintPageRangeCount        func(request *requests.FPDF_VIEWERREF_GetPrintPageRangeCount) (*responses.FPDF_VIEWERREF_GetPrintPageRangeCount, error)
+	FPDF_VIEWERREF_GetPrintPageRangeElement      func(request *requests.FPDF_VIEWERREF_GetPrintPageRangeElement) (*responses.FPDF_VIEWERREF_GetPrintPageRangeElement, error)
+	FPDF_VIEWERREF_GetPrintScaling               func(request *requests.FPDF_VIEWERREF_GetPrintScaling) (*responses.FPDF_VIEWERREF_GetPrintScaling, error)
+	FSDK_SetLocaltimeFunction                    func(request *requests.FSDK_SetLocaltimeFunction) (*responses.FSDK_SetLocaltimeFunction, error)
+	FSDK_SetTimeFunction                         func(request *requests.FSDK_SetTimeFunction) (*responses.FSDK_SetTimeFunction, error)
+	FSDK_SetUnSpObjProcessHandler                func(request *requests.FSDK_SetUnSpObjProcessHandler) (*responses.FSDK_SetUnSpObjProcessHandler, error)
+	GetActionInfo                                func(request *requests.GetActionInfo) (*responses.GetActionInfo, error)
+	GetAttachments                               func(request *requests.GetAttachments) (*responses.GetAttachments, error)
+	GetBookmarks                                 func(request *requests.GetBookmarks) (*responses.GetBookmarks, error)
+	GetDestInfo                                  func(request *requests.GetDestInfo) (*responses.GetDestInfo, error)
+	GetForm                                      func(request *requests.GetForm) (*responses.GetForm, error)
+	GetJavaScriptActions                         func(request *requests.GetJavaScriptActions) (*responses.GetJavaScriptActions, error)
+	GetMetaData                                  func(request *requests.GetMetaData) (*responses.GetMetaData, error)
+	GetPageSize                                  func(request *requests.GetPageSize) (*responses.GetPageSize, error)
+	GetPageSizeInPixels                          func(request *requests.GetPageSizeInPixels) (*responses.GetPageSizeInPixels, error)
+	GetPageText                                  func(request *requests.GetPageText) (*responses.GetPageText, error)
+	GetPageTextStructured                        func(request *requests.GetPageTextStructured) (*responses.GetPageTextStructured, error)
+	ListOpenHandles                              func(request *requests.ListOpenHandles) (*responses.ListOpenHandles, error)
+	OpenDocument                                 func(request *requests.OpenDocument) (*responses.OpenDocument, error)
+	RenderPageInDPI                              func(request *requests.RenderPageInDPI) (*responses.RenderPageInDPI, error)
+	RenderPageInPixels                           func(request *requests.RenderPageInPixels) (*responses.RenderPageInPixels, error)
+	RenderPageRegion                             func(request *requests.RenderPageRegion) (*responses.RenderPageRegion, error)
+	RenderPagesInDPI                             func(request *requests.RenderPagesInDPI) (*responses.RenderPagesInDPI, error)
+	RenderPagesInPixels                          func(request *requests.RenderPagesInPixels) (*responses.RenderPagesInPixels, error)
+	RenderToFile                                 func(request *requests.RenderToFile) (*responses.RenderToFile, error)
+}
+
+func (i *Instance) FORM_CanRedo(request *requests.FORM_CanRedo) (*responses.FORM_CanRedo, error) {
+	return i.FORM_CanRedoWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_CanRedoWithContext(ctx goctx.Context, request *requests.FORM_CanRedo) (*responses.FORM_CanRedo, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_CanRedo != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_CanRedo(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_CanRedo", request, stub)
+	typedResp, _ := resp.(*responses.FORM_CanRedo)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_CanUndo(request *requests.FORM_CanUndo) (*responses.FORM_CanUndo, error) {
+	return i.FORM_CanUndoWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_CanUndoWithContext(ctx goctx.Context, request *requests.FORM_CanUndo) (*responses.FORM_CanUndo, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_CanUndo != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_CanUndo(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_CanUndo", request, stub)
+	typedResp, _ := resp.(*responses.FORM_CanUndo)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_DoDocumentAAction(request *requests.FORM_DoDocumentAAction) (*responses.FORM_DoDocumentAAction, error) {
+	return i.FORM_DoDocumentAActionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_DoDocumentAActionWithContext(ctx goctx.Context, request *requests.FORM_DoDocumentAAction) (*responses.FORM_DoDocumentAAction, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_DoDocumentAAction != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_DoDocumentAAction(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_DoDocumentAAction", request, stub)
+	typedResp, _ := resp.(*responses.FORM_DoDocumentAAction)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_DoDocumentJSAction(request *requests.FORM_DoDocumentJSAction) (*responses.FORM_DoDocumentJSAction, error) {
+	return i.FORM_DoDocumentJSActionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_DoDocumentJSActionWithContext(ctx goctx.Context, request *requests.FORM_DoDocumentJSAction) (*responses.FORM_DoDocumentJSAction, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_DoDocumentJSAction != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_DoDocumentJSAction(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_DoDocumentJSAction", request, stub)
+	typedResp, _ := resp.(*responses.FORM_DoDocumentJSAction)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_DoDocumentOpenAction(request *requests.FORM_DoDocumentOpenAction) (*responses.FORM_DoDocumentOpenAction, error) {
+	return i.FORM_DoDocumentOpenActionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_DoDocumentOpenActionWithContext(ctx goctx.Context, request *requests.FORM_DoDocumentOpenAction) (*responses.FORM_DoDocumentOpenAction, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_DoDocumentOpenAction != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_DoDocumentOpenAction(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_DoDocumentOpenAction", request, stub)
+	typedResp, _ := resp.(*responses.FORM_DoDocumentOpenAction)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_DoPageAAction(request *requests.FORM_DoPageAAction) (*responses.FORM_DoPageAAction, error) {
+	return i.FORM_DoPageAActionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_DoPageAActionWithContext(ctx goctx.Context, request *requests.FORM_DoPageAAction) (*responses.FORM_DoPageAAction, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_DoPageAAction != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_DoPageAAction(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_DoPageAAction", request, stub)
+	typedResp, _ := resp.(*responses.FORM_DoPageAAction)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_ForceToKillFocus(request *requests.FORM_ForceToKillFocus) (*responses.FORM_ForceToKillFocus, error) {
+	return i.FORM_ForceToKillFocusWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_ForceToKillFocusWithContext(ctx goctx.Context, request *requests.FORM_ForceToKillFocus) (*responses.FORM_ForceToKillFocus, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_ForceToKillFocus != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_ForceToKillFocus(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_ForceToKillFocus", request, stub)
+	typedResp, _ := resp.(*responses.FORM_ForceToKillFocus)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_GetFocusedAnnot(request *requests.FORM_GetFocusedAnnot) (*responses.FORM_GetFocusedAnnot, error) {
+	return i.FORM_GetFocusedAnnotWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_GetFocusedAnnotWithContext(ctx goctx.Context, request *requests.FORM_GetFocusedAnnot) (*responses.FORM_GetFocusedAnnot, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_GetFocusedAnnot != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_GetFocusedAnnot(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_GetFocusedAnnot", request, stub)
+	typedResp, _ := resp.(*responses.FORM_GetFocusedAnnot)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_GetFocusedText(request *requests.FORM_GetFocusedText) (*responses.FORM_GetFocusedText, error) {
+	return i.FORM_GetFocusedTextWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_GetFocusedTextWithContext(ctx goctx.Context, request *requests.FORM_GetFocusedText) (*responses.FORM_GetFocusedText, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_GetFocusedText != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_GetFocusedText(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_GetFocusedText", request, stub)
+	typedResp, _ := resp.(*responses.FORM_GetFocusedText)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_GetSelectedText(request *requests.FORM_GetSelectedText) (*responses.FORM_GetSelectedText, error) {
+	return i.FORM_GetSelectedTextWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_GetSelectedTextWithContext(ctx goctx.Context, request *requests.FORM_GetSelectedText) (*responses.FORM_GetSelectedText, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_GetSelectedText != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_GetSelectedText(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_GetSelectedText", request, stub)
+	typedResp, _ := resp.(*responses.FORM_GetSelectedText)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_IsIndexSelected(request *requests.FORM_IsIndexSelected) (*responses.FORM_IsIndexSelected, error) {
+	return i.FORM_IsIndexSelectedWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_IsIndexSelectedWithContext(ctx goctx.Context, request *requests.FORM_IsIndexSelected) (*responses.FORM_IsIndexSelected, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_IsIndexSelected != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_IsIndexSelected(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_IsIndexSelected", request, stub)
+	typedResp, _ := resp.(*responses.FORM_IsIndexSelected)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_OnAfterLoadPage(request *requests.FORM_OnAfterLoadPage) (*responses.FORM_OnAfterLoadPage, error) {
+	return i.FORM_OnAfterLoadPageWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_OnAfterLoadPageWithContext(ctx goctx.Context, request *requests.FORM_OnAfterLoadPage) (*responses.FORM_OnAfterLoadPage, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_OnAfterLoadPage != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_OnAfterLoadPage(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_OnAfterLoadPage", request, stub)
+	typedResp, _ := resp.(*responses.FORM_OnAfterLoadPage)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_OnBeforeClosePage(request *requests.FORM_OnBeforeClosePage) (*responses.FORM_OnBeforeClosePage, error) {
+	return i.FORM_OnBeforeClosePageWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_OnBeforeClosePageWithContext(ctx goctx.Context, request *requests.FORM_OnBeforeClosePage) (*responses.FORM_OnBeforeClosePage, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_OnBeforeClosePage != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_OnBeforeClosePage(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_OnBeforeClosePage", request, stub)
+	typedResp, _ := resp.(*responses.FORM_OnBeforeClosePage)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_OnChar(request *requests.FORM_OnChar) (*responses.FORM_OnChar, error) {
+	return i.FORM_OnCharWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_OnCharWithContext(ctx goctx.Context, request *requests.FORM_OnChar) (*responses.FORM_OnChar, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_OnChar != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_OnChar(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_OnChar", request, stub)
+	typedResp, _ := resp.(*responses.FORM_OnChar)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_OnFocus(request *requests.FORM_OnFocus) (*responses.FORM_OnFocus, error) {
+	return i.FORM_OnFocusWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_OnFocusWithContext(ctx goctx.Context, request *requests.FORM_OnFocus) (*responses.FORM_OnFocus, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_OnFocus != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_OnFocus(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_OnFocus", request, stub)
+	typedResp, _ := resp.(*responses.FORM_OnFocus)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_OnKeyDown(request *requests.FORM_OnKeyDown) (*responses.FORM_OnKeyDown, error) {
+	return i.FORM_OnKeyDownWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_OnKeyDownWithContext(ctx goctx.Context, request *requests.FORM_OnKeyDown) (*responses.FORM_OnKeyDown, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_OnKeyDown != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_OnKeyDown(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_OnKeyDown", request, stub)
+	typedResp, _ := resp.(*responses.FORM_OnKeyDown)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_OnKeyUp(request *requests.FORM_OnKeyUp) (*responses.FORM_OnKeyUp, error) {
+	return i.FORM_OnKeyUpWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_OnKeyUpWithContext(ctx goctx.Context, request *requests.FORM_OnKeyUp) (*responses.FORM_OnKeyUp, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_OnKeyUp != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_OnKeyUp(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_OnKeyUp", request, stub)
+	typedResp, _ := resp.(*responses.FORM_OnKeyUp)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_OnLButtonDoubleClick(request *requests.FORM_OnLButtonDoubleClick) (*responses.FORM_OnLButtonDoubleClick, error) {
+	return i.FORM_OnLButtonDoubleClickWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_OnLButtonDoubleClickWithContext(ctx goctx.Context, request *requests.FORM_OnLButtonDoubleClick) (*responses.FORM_OnLButtonDoubleClick, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_OnLButtonDoubleClick != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_OnLButtonDoubleClick(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_OnLButtonDoubleClick", request, stub)
+	typedResp, _ := resp.(*responses.FORM_OnLButtonDoubleClick)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_OnLButtonDown(request *requests.FORM_OnLButtonDown) (*responses.FORM_OnLButtonDown, error) {
+	return i.FORM_OnLButtonDownWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_OnLButtonDownWithContext(ctx goctx.Context, request *requests.FORM_OnLButtonDown) (*responses.FORM_OnLButtonDown, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_OnLButtonDown != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_OnLButtonDown(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_OnLButtonDown", request, stub)
+	typedResp, _ := resp.(*responses.FORM_OnLButtonDown)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_OnLButtonUp(request *requests.FORM_OnLButtonUp) (*responses.FORM_OnLButtonUp, error) {
+	return i.FORM_OnLButtonUpWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_OnLButtonUpWithContext(ctx goctx.Context, request *requests.FORM_OnLButtonUp) (*responses.FORM_OnLButtonUp, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_OnLButtonUp != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_OnLButtonUp(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_OnLButtonUp", request, stub)
+	typedResp, _ := resp.(*responses.FORM_OnLButtonUp)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_OnMouseMove(request *requests.FORM_OnMouseMove) (*responses.FORM_OnMouseMove, error) {
+	return i.FORM_OnMouseMoveWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_OnMouseMoveWithContext(ctx goctx.Context, request *requests.FORM_OnMouseMove) (*responses.FORM_OnMouseMove, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_OnMouseMove != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_OnMouseMove(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_OnMouseMove", request, stub)
+	typedResp, _ := resp.(*responses.FORM_OnMouseMove)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_OnMouseWheel(request *requests.FORM_OnMouseWheel) (*responses.FORM_OnMouseWheel, error) {
+	return i.FORM_OnMouseWheelWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_OnMouseWheelWithContext(ctx goctx.Context, request *requests.FORM_OnMouseWheel) (*responses.FORM_OnMouseWheel, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_OnMouseWheel != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_OnMouseWheel(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_OnMouseWheel", request, stub)
+	typedResp, _ := resp.(*responses.FORM_OnMouseWheel)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_OnRButtonDown(request *requests.FORM_OnRButtonDown) (*responses.FORM_OnRButtonDown, error) {
+	return i.FORM_OnRButtonDownWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_OnRButtonDownWithContext(ctx goctx.Context, request *requests.FORM_OnRButtonDown) (*responses.FORM_OnRButtonDown, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_OnRButtonDown != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_OnRButtonDown(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_OnRButtonDown", request, stub)
+	typedResp, _ := resp.(*responses.FORM_OnRButtonDown)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_OnRButtonUp(request *requests.FORM_OnRButtonUp) (*responses.FORM_OnRButtonUp, error) {
+	return i.FORM_OnRButtonUpWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_OnRButtonUpWithContext(ctx goctx.Context, request *requests.FORM_OnRButtonUp) (*responses.FORM_OnRButtonUp, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_OnRButtonUp != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_OnRButtonUp(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_OnRButtonUp", request, stub)
+	typedResp, _ := resp.(*responses.FORM_OnRButtonUp)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_Redo(request *requests.FORM_Redo) (*responses.FORM_Redo, error) {
+	return i.FORM_RedoWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_RedoWithContext(ctx goctx.Context, request *requests.FORM_Redo) (*responses.FORM_Redo, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_Redo != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_Redo(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_Redo", request, stub)
+	typedResp, _ := resp.(*responses.FORM_Redo)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_ReplaceAndKeepSelection(request *requests.FORM_ReplaceAndKeepSelection) (*responses.FORM_ReplaceAndKeepSelection, error) {
+	return i.FORM_ReplaceAndKeepSelectionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_ReplaceAndKeepSelectionWithContext(ctx goctx.Context, request *requests.FORM_ReplaceAndKeepSelection) (*responses.FORM_ReplaceAndKeepSelection, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_ReplaceAndKeepSelection != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_ReplaceAndKeepSelection(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_ReplaceAndKeepSelection", request, stub)
+	typedResp, _ := resp.(*responses.FORM_ReplaceAndKeepSelection)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_ReplaceSelection(request *requests.FORM_ReplaceSelection) (*responses.FORM_ReplaceSelection, error) {
+	return i.FORM_ReplaceSelectionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_ReplaceSelectionWithContext(ctx goctx.Context, request *requests.FORM_ReplaceSelection) (*responses.FORM_ReplaceSelection, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_ReplaceSelection != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_ReplaceSelection(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_ReplaceSelection", request, stub)
+	typedResp, _ := resp.(*responses.FORM_ReplaceSelection)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_SelectAllText(request *requests.FORM_SelectAllText) (*responses.FORM_SelectAllText, error) {
+	return i.FORM_SelectAllTextWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_SelectAllTextWithContext(ctx goctx.Context, request *requests.FORM_SelectAllText) (*responses.FORM_SelectAllText, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_SelectAllText != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_SelectAllText(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_SelectAllText", request, stub)
+	typedResp, _ := resp.(*responses.FORM_SelectAllText)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_SetFocusedAnnot(request *requests.FORM_SetFocusedAnnot) (*responses.FORM_SetFocusedAnnot, error) {
+	return i.FORM_SetFocusedAnnotWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_SetFocusedAnnotWithContext(ctx goctx.Context, request *requests.FORM_SetFocusedAnnot) (*responses.FORM_SetFocusedAnnot, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_SetFocusedAnnot != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_SetFocusedAnnot(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_SetFocusedAnnot", request, stub)
+	typedResp, _ := resp.(*responses.FORM_SetFocusedAnnot)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_SetIndexSelected(request *requests.FORM_SetIndexSelected) (*responses.FORM_SetIndexSelected, error) {
+	return i.FORM_SetIndexSelectedWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_SetIndexSelectedWithContext(ctx goctx.Context, request *requests.FORM_SetIndexSelected) (*responses.FORM_SetIndexSelected, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_SetIndexSelected != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_SetIndexSelected(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_SetIndexSelected", request, stub)
+	typedResp, _ := resp.(*responses.FORM_SetIndexSelected)
+	return typedResp, err
+}
+
+func (i *Instance) FORM_Undo(request *requests.FORM_Undo) (*responses.FORM_Undo, error) {
+	return i.FORM_UndoWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FORM_UndoWithContext(ctx goctx.Context, request *requests.FORM_Undo) (*responses.FORM_Undo, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FORM_Undo != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FORM_Undo(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FORM_Undo", request, stub)
+	typedResp, _ := resp.(*responses.FORM_Undo)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAction_GetDest(request *requests.FPDFAction_GetDest) (*responses.FPDFAction_GetDest, error) {
+	return i.FPDFAction_GetDestWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAction_GetDestWithContext(ctx goctx.Context, request *requests.FPDFAction_GetDest) (*responses.FPDFAction_GetDest, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAction_GetDest != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAction_GetDest(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAction_GetDest", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAction_GetDest)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAction_GetFilePath(request *requests.FPDFAction_GetFilePath) (*responses.FPDFAction_GetFilePath, error) {
+	return i.FPDFAction_GetFilePathWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAction_GetFilePathWithContext(ctx goctx.Context, request *requests.FPDFAction_GetFilePath) (*responses.FPDFAction_GetFilePath, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAction_GetFilePath != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAction_GetFilePath(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAction_GetFilePath", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAction_GetFilePath)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAction_GetType(request *requests.FPDFAction_GetType) (*responses.FPDFAction_GetType, error) {
+	return i.FPDFAction_GetTypeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAction_GetTypeWithContext(ctx goctx.Context, request *requests.FPDFAction_GetType) (*responses.FPDFAction_GetType, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAction_GetType != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAction_GetType(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAction_GetType", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAction_GetType)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAction_GetURIPath(request *requests.FPDFAction_GetURIPath) (*responses.FPDFAction_GetURIPath, error) {
+	return i.FPDFAction_GetURIPathWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAction_GetURIPathWithContext(ctx goctx.Context, request *requests.FPDFAction_GetURIPath) (*responses.FPDFAction_GetURIPath, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAction_GetURIPath != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAction_GetURIPath(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAction_GetURIPath", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAction_GetURIPath)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_AddFileAttachment(request *requests.FPDFAnnot_AddFileAttachment) (*responses.FPDFAnnot_AddFileAttachment, error) {
+	return i.FPDFAnnot_AddFileAttachmentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_AddFileAttachmentWithContext(ctx goctx.Context, request *requests.FPDFAnnot_AddFileAttachment) (*responses.FPDFAnnot_AddFileAttachment, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_AddFileAttachment != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_AddFileAttachment(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_AddFileAttachment", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_AddFileAttachment)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_AddInkStroke(request *requests.FPDFAnnot_AddInkStroke) (*responses.FPDFAnnot_AddInkStroke, error) {
+	return i.FPDFAnnot_AddInkStrokeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_AddInkStrokeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_AddInkStroke) (*responses.FPDFAnnot_AddInkStroke, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_AddInkStroke != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_AddInkStroke(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_AddInkStroke", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_AddInkStroke)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_AppendAttachmentPoints(request *requests.FPDFAnnot_AppendAttachmentPoints) (*responses.FPDFAnnot_AppendAttachmentPoints, error) {
+	return i.FPDFAnnot_AppendAttachmentPointsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_AppendAttachmentPointsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_AppendAttachmentPoints) (*responses.FPDFAnnot_AppendAttachmentPoints, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_AppendAttachmentPoints != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_AppendAttachmentPoints(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_AppendAttachmentPoints", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_AppendAttachmentPoints)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_AppendObject(request *requests.FPDFAnnot_AppendObject) (*responses.FPDFAnnot_AppendObject, error) {
+	return i.FPDFAnnot_AppendObjectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_AppendObjectWithContext(ctx goctx.Context, request *requests.FPDFAnnot_AppendObject) (*responses.FPDFAnnot_AppendObject, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_AppendObject != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_AppendObject(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_AppendObject", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_AppendObject)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_CountAttachmentPoints(request *requests.FPDFAnnot_CountAttachmentPoints) (*responses.FPDFAnnot_CountAttachmentPoints, error) {
+	return i.FPDFAnnot_CountAttachmentPointsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_CountAttachmentPointsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_CountAttachmentPoints) (*responses.FPDFAnnot_CountAttachmentPoints, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_CountAttachmentPoints != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_CountAttachmentPoints(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_CountAttachmentPoints", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_CountAttachmentPoints)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetAP(request *requests.FPDFAnnot_GetAP) (*responses.FPDFAnnot_GetAP, error) {
+	return i.FPDFAnnot_GetAPWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetAPWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetAP) (*responses.FPDFAnnot_GetAP, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetAP != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetAP(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetAP", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetAP)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetAttachmentPoints(request *requests.FPDFAnnot_GetAttachmentPoints) (*responses.FPDFAnnot_GetAttachmentPoints, error) {
+	return i.FPDFAnnot_GetAttachmentPointsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetAttachmentPointsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetAttachmentPoints) (*responses.FPDFAnnot_GetAttachmentPoints, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetAttachmentPoints != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetAttachmentPoints(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetAttachmentPoints", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetAttachmentPoints)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetBorder(request *requests.FPDFAnnot_GetBorder) (*responses.FPDFAnnot_GetBorder, error) {
+	return i.FPDFAnnot_GetBorderWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetBorderWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetBorder) (*responses.FPDFAnnot_GetBorder, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetBorder != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetBorder(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetBorder", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetBorder)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetColor(request *requests.FPDFAnnot_GetColor) (*responses.FPDFAnnot_GetColor, error) {
+	return i.FPDFAnnot_GetColorWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetColorWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetColor) (*responses.FPDFAnnot_GetColor, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetColor != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetColor(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetColor", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetColor)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetFileAttachment(request *requests.FPDFAnnot_GetFileAttachment) (*responses.FPDFAnnot_GetFileAttachment, error) {
+	return i.FPDFAnnot_GetFileAttachmentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetFileAttachmentWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFileAttachment) (*responses.FPDFAnnot_GetFileAttachment, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetFileAttachment != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetFileAttachment(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetFileAttachment", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFileAttachment)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetFlags(request *requests.FPDFAnnot_GetFlags) (*responses.FPDFAnnot_GetFlags, error) {
+	return i.FPDFAnnot_GetFlagsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetFlagsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFlags) (*responses.FPDFAnnot_GetFlags, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetFlags != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetFlags(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetFlags", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFlags)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetFocusableSubtypes(request *requests.FPDFAnnot_GetFocusableSubtypes) (*responses.FPDFAnnot_GetFocusableSubtypes, error) {
+	return i.FPDFAnnot_GetFocusableSubtypesWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetFocusableSubtypesWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFocusableSubtypes) (*responses.FPDFAnnot_GetFocusableSubtypes, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetFocusableSubtypes != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetFocusableSubtypes(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetFocusableSubtypes", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFocusableSubtypes)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetFocusableSubtypesCount(request *requests.FPDFAnnot_GetFocusableSubtypesCount) (*responses.FPDFAnnot_GetFocusableSubtypesCount, error) {
+	return i.FPDFAnnot_GetFocusableSubtypesCountWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetFocusableSubtypesCountWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFocusableSubtypesCount) (*responses.FPDFAnnot_GetFocusableSubtypesCount, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetFocusableSubtypesCount != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetFocusableSubtypesCount(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetFocusableSubtypesCount", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFocusableSubtypesCount)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetFontColor(request *requests.FPDFAnnot_GetFontColor) (*responses.FPDFAnnot_GetFontColor, error) {
+	return i.FPDFAnnot_GetFontColorWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetFontColorWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFontColor) (*responses.FPDFAnnot_GetFontColor, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetFontColor != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetFontColor(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetFontColor", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFontColor)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetFontSize(request *requests.FPDFAnnot_GetFontSize) (*responses.FPDFAnnot_GetFontSize, error) {
+	return i.FPDFAnnot_GetFontSizeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetFontSizeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFontSize) (*responses.FPDFAnnot_GetFontSize, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetFontSize != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetFontSize(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetFontSize", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFontSize)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetFormAdditionalActionJavaScript(request *requests.FPDFAnnot_GetFormAdditionalActionJavaScript) (*responses.FPDFAnnot_GetFormAdditionalActionJavaScript, error) {
+	return i.FPDFAnnot_GetFormAdditionalActionJavaScriptWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetFormAdditionalActionJavaScriptWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormAdditionalActionJavaScript) (*responses.FPDFAnnot_GetFormAdditionalActionJavaScript, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetFormAdditionalActionJavaScript != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetFormAdditionalActionJavaScript(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetFormAdditionalActionJavaScript", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormAdditionalActionJavaScript)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetFormControlCount(request *requests.FPDFAnnot_GetFormControlCount) (*responses.FPDFAnnot_GetFormControlCount, error) {
+	return i.FPDFAnnot_GetFormControlCountWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetFormControlCountWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormControlCount) (*responses.FPDFAnnot_GetFormControlCount, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetFormControlCount != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetFormControlCount(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetFormControlCount", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormControlCount)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetFormControlIndex(request *requests.FPDFAnnot_GetFormControlIndex) (*responses.FPDFAnnot_GetFormControlIndex, error) {
+	return i.FPDFAnnot_GetFormControlIndexWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetFormControlIndexWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormControlIndex) (*responses.FPDFAnnot_GetFormControlIndex, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetFormControlIndex != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetFormControlIndex(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetFormControlIndex", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormControlIndex)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetFormFieldAlternateName(request *requests.FPDFAnnot_GetFormFieldAlternateName) (*responses.FPDFAnnot_GetFormFieldAlternateName, error) {
+	return i.FPDFAnnot_GetFormFieldAlternateNameWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetFormFieldAlternateNameWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldAlternateName) (*responses.FPDFAnnot_GetFormFieldAlternateName, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetFormFieldAlternateName != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetFormFieldAlternateName(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetFormFieldAlternateName", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormFieldAlternateName)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetFormFieldAtPoint(request *requests.FPDFAnnot_GetFormFieldAtPoint) (*responses.FPDFAnnot_GetFormFieldAtPoint, error) {
+	return i.FPDFAnnot_GetFormFieldAtPointWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetFormFieldAtPointWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldAtPoint) (*responses.FPDFAnnot_GetFormFieldAtPoint, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetFormFieldAtPoint != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetFormFieldAtPoint(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetFormFieldAtPoint", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormFieldAtPoint)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetFormFieldExportValue(request *requests.FPDFAnnot_GetFormFieldExportValue) (*responses.FPDFAnnot_GetFormFieldExportValue, error) {
+	return i.FPDFAnnot_GetFormFieldExportValueWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetFormFieldExportValueWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldExportValue) (*responses.FPDFAnnot_GetFormFieldExportValue, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetFormFieldExportValue != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetFormFieldExportValue(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetFormFieldExportValue", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormFieldExportValue)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetFormFieldFlags(request *requests.FPDFAnnot_GetFormFieldFlags) (*responses.FPDFAnnot_GetFormFieldFlags, error) {
+	return i.FPDFAnnot_GetFormFieldFlagsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetFormFieldFlagsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldFlags) (*responses.FPDFAnnot_GetFormFieldFlags, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetFormFieldFlags != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetFormFieldFlags(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetFormFieldFlags", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormFieldFlags)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetFormFieldName(request *requests.FPDFAnnot_GetFormFieldName) (*responses.FPDFAnnot_GetFormFieldName, error) {
+	return i.FPDFAnnot_GetFormFieldNameWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetFormFieldNameWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldName) (*responses.FPDFAnnot_GetFormFieldName, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetFormFieldName != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetFormFieldName(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetFormFieldName", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormFieldName)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetFormFieldType(request *requests.FPDFAnnot_GetFormFieldType) (*responses.FPDFAnnot_GetFormFieldType, error) {
+	return i.FPDFAnnot_GetFormFieldTypeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetFormFieldTypeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldType) (*responses.FPDFAnnot_GetFormFieldType, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetFormFieldType != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetFormFieldType(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetFormFieldType", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormFieldType)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetFormFieldValue(request *requests.FPDFAnnot_GetFormFieldValue) (*responses.FPDFAnnot_GetFormFieldValue, error) {
+	return i.FPDFAnnot_GetFormFieldValueWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetFormFieldValueWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldValue) (*responses.FPDFAnnot_GetFormFieldValue, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetFormFieldValue != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetFormFieldValue(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetFormFieldValue", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormFieldValue)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetInkListCount(request *requests.FPDFAnnot_GetInkListCount) (*responses.FPDFAnnot_GetInkListCount, error) {
+	return i.FPDFAnnot_GetInkListCountWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetInkListCountWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetInkListCount) (*responses.FPDFAnnot_GetInkListCount, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetInkListCount != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetInkListCount(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetInkListCount", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetInkListCount)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetInkListPath(request *requests.FPDFAnnot_GetInkListPath) (*responses.FPDFAnnot_GetInkListPath, error) {
+	return i.FPDFAnnot_GetInkListPathWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetInkListPathWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetInkListPath) (*responses.FPDFAnnot_GetInkListPath, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetInkListPath != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetInkListPath(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetInkListPath", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetInkListPath)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetLine(request *requests.FPDFAnnot_GetLine) (*responses.FPDFAnnot_GetLine, error) {
+	return i.FPDFAnnot_GetLineWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetLineWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetLine) (*responses.FPDFAnnot_GetLine, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetLine != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetLine(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetLine", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetLine)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetLink(request *requests.FPDFAnnot_GetLink) (*responses.FPDFAnnot_GetLink, error) {
+	return i.FPDFAnnot_GetLinkWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetLinkWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetLink) (*responses.FPDFAnnot_GetLink, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetLink != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetLink(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetLink", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetLink)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetLinkedAnnot(request *requests.FPDFAnnot_GetLinkedAnnot) (*responses.FPDFAnnot_GetLinkedAnnot, error) {
+	return i.FPDFAnnot_GetLinkedAnnotWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetLinkedAnnotWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetLinkedAnnot) (*responses.FPDFAnnot_GetLinkedAnnot, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetLinkedAnnot != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetLinkedAnnot(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetLinkedAnnot", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetLinkedAnnot)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetNumberValue(request *requests.FPDFAnnot_GetNumberValue) (*responses.FPDFAnnot_GetNumberValue, error) {
+	return i.FPDFAnnot_GetNumberValueWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetNumberValueWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetNumberValue) (*responses.FPDFAnnot_GetNumberValue, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetNumberValue != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetNumberValue(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetNumberValue", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetNumberValue)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetObject(request *requests.FPDFAnnot_GetObject) (*responses.FPDFAnnot_GetObject, error) {
+	return i.FPDFAnnot_GetObjectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetObjectWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetObject) (*responses.FPDFAnnot_GetObject, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetObject != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetObject(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetObject", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetObject)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetObjectCount(request *requests.FPDFAnnot_GetObjectCount) (*responses.FPDFAnnot_GetObjectCount, error) {
+	return i.FPDFAnnot_GetObjectCountWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetObjectCountWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetObjectCount) (*responses.FPDFAnnot_GetObjectCount, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetObjectCount != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetObjectCount(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetObjectCount", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetObjectCount)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetOptionCount(request *requests.FPDFAnnot_GetOptionCount) (*responses.FPDFAnnot_GetOptionCount, error) {
+	return i.FPDFAnnot_GetOptionCountWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetOptionCountWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetOptionCount) (*responses.FPDFAnnot_GetOptionCount, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetOptionCount != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetOptionCount(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetOptionCount", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetOptionCount)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetOptionLabel(request *requests.FPDFAnnot_GetOptionLabel) (*responses.FPDFAnnot_GetOptionLabel, error) {
+	return i.FPDFAnnot_GetOptionLabelWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetOptionLabelWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetOptionLabel) (*responses.FPDFAnnot_GetOptionLabel, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetOptionLabel != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetOptionLabel(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetOptionLabel", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetOptionLabel)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetRect(request *requests.FPDFAnnot_GetRect) (*responses.FPDFAnnot_GetRect, error) {
+	return i.FPDFAnnot_GetRectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetRectWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetRect) (*responses.FPDFAnnot_GetRect, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetRect != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetRect(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetRect", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetRect)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetStringValue(request *requests.FPDFAnnot_GetStringValue) (*responses.FPDFAnnot_GetStringValue, error) {
+	return i.FPDFAnnot_GetStringValueWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetStringValueWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetStringValue) (*responses.FPDFAnnot_GetStringValue, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetStringValue != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetStringValue(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetStringValue", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetStringValue)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetSubtype(request *requests.FPDFAnnot_GetSubtype) (*responses.FPDFAnnot_GetSubtype, error) {
+	return i.FPDFAnnot_GetSubtypeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetSubtypeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetSubtype) (*responses.FPDFAnnot_GetSubtype, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetSubtype != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetSubtype(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetSubtype", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetSubtype)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetValueType(request *requests.FPDFAnnot_GetValueType) (*responses.FPDFAnnot_GetValueType, error) {
+	return i.FPDFAnnot_GetValueTypeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetValueTypeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetValueType) (*responses.FPDFAnnot_GetValueType, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetValueType != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetValueType(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetValueType", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetValueType)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_GetVertices(request *requests.FPDFAnnot_GetVertices) (*responses.FPDFAnnot_GetVertices, error) {
+	return i.FPDFAnnot_GetVerticesWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_GetVerticesWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetVertices) (*responses.FPDFAnnot_GetVertices, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_GetVertices != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_GetVertices(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_GetVertices", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetVertices)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_HasAttachmentPoints(request *requests.FPDFAnnot_HasAttachmentPoints) (*responses.FPDFAnnot_HasAttachmentPoints, error) {
+	return i.FPDFAnnot_HasAttachmentPointsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_HasAttachmentPointsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_HasAttachmentPoints) (*responses.FPDFAnnot_HasAttachmentPoints, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_HasAttachmentPoints != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_HasAttachmentPoints(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_HasAttachmentPoints", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_HasAttachmentPoints)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_HasKey(request *requests.FPDFAnnot_HasKey) (*responses.FPDFAnnot_HasKey, error) {
+	return i.FPDFAnnot_HasKeyWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_HasKeyWithContext(ctx goctx.Context, request *requests.FPDFAnnot_HasKey) (*responses.FPDFAnnot_HasKey, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_HasKey != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_HasKey(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_HasKey", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_HasKey)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_IsChecked(request *requests.FPDFAnnot_IsChecked) (*responses.FPDFAnnot_IsChecked, error) {
+	return i.FPDFAnnot_IsCheckedWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_IsCheckedWithContext(ctx goctx.Context, request *requests.FPDFAnnot_IsChecked) (*responses.FPDFAnnot_IsChecked, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_IsChecked != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_IsChecked(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_IsChecked", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_IsChecked)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_IsObjectSupportedSubtype(request *requests.FPDFAnnot_IsObjectSupportedSubtype) (*responses.FPDFAnnot_IsObjectSupportedSubtype, error) {
+	return i.FPDFAnnot_IsObjectSupportedSubtypeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_IsObjectSupportedSubtypeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_IsObjectSupportedSubtype) (*responses.FPDFAnnot_IsObjectSupportedSubtype, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_IsObjectSupportedSubtype != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_IsObjectSupportedSubtype(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_IsObjectSupportedSubtype", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_IsObjectSupportedSubtype)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_IsOptionSelected(request *requests.FPDFAnnot_IsOptionSelected) (*responses.FPDFAnnot_IsOptionSelected, error) {
+	return i.FPDFAnnot_IsOptionSelectedWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_IsOptionSelectedWithContext(ctx goctx.Context, request *requests.FPDFAnnot_IsOptionSelected) (*responses.FPDFAnnot_IsOptionSelected, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_IsOptionSelected != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_IsOptionSelected(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_IsOptionSelected", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_IsOptionSelected)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_IsSupportedSubtype(request *requests.FPDFAnnot_IsSupportedSubtype) (*responses.FPDFAnnot_IsSupportedSubtype, error) {
+	return i.FPDFAnnot_IsSupportedSubtypeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_IsSupportedSubtypeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_IsSupportedSubtype) (*responses.FPDFAnnot_IsSupportedSubtype, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_IsSupportedSubtype != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_IsSupportedSubtype(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_IsSupportedSubtype", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_IsSupportedSubtype)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_RemoveInkList(request *requests.FPDFAnnot_RemoveInkList) (*responses.FPDFAnnot_RemoveInkList, error) {
+	return i.FPDFAnnot_RemoveInkListWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_RemoveInkListWithContext(ctx goctx.Context, request *requests.FPDFAnnot_RemoveInkList) (*responses.FPDFAnnot_RemoveInkList, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_RemoveInkList != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_RemoveInkList(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_RemoveInkList", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_RemoveInkList)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_RemoveObject(request *requests.FPDFAnnot_RemoveObject) (*responses.FPDFAnnot_RemoveObject, error) {
+	return i.FPDFAnnot_RemoveObjectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_RemoveObjectWithContext(ctx goctx.Context, request *requests.FPDFAnnot_RemoveObject) (*responses.FPDFAnnot_RemoveObject, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_RemoveObject != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_RemoveObject(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_RemoveObject", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_RemoveObject)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_SetAP(request *requests.FPDFAnnot_SetAP) (*responses.FPDFAnnot_SetAP, error) {
+	return i.FPDFAnnot_SetAPWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_SetAPWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetAP) (*responses.FPDFAnnot_SetAP, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_SetAP != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_SetAP(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_SetAP", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetAP)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_SetAttachmentPoints(request *requests.FPDFAnnot_SetAttachmentPoints) (*responses.FPDFAnnot_SetAttachmentPoints, error) {
+	return i.FPDFAnnot_SetAttachmentPointsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_SetAttachmentPointsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetAttachmentPoints) (*responses.FPDFAnnot_SetAttachmentPoints, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_SetAttachmentPoints != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_SetAttachmentPoints(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_SetAttachmentPoints", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetAttachmentPoints)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_SetBorder(request *requests.FPDFAnnot_SetBorder) (*responses.FPDFAnnot_SetBorder, error) {
+	return i.FPDFAnnot_SetBorderWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_SetBorderWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetBorder) (*responses.FPDFAnnot_SetBorder, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_SetBorder != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_SetBorder(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_SetBorder", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetBorder)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_SetColor(request *requests.FPDFAnnot_SetColor) (*responses.FPDFAnnot_SetColor, error) {
+	return i.FPDFAnnot_SetColorWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_SetColorWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetColor) (*responses.FPDFAnnot_SetColor, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_SetColor != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_SetColor(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_SetColor", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetColor)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_SetFlags(request *requests.FPDFAnnot_SetFlags) (*responses.FPDFAnnot_SetFlags, error) {
+	return i.FPDFAnnot_SetFlagsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_SetFlagsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetFlags) (*responses.FPDFAnnot_SetFlags, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_SetFlags != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_SetFlags(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_SetFlags", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetFlags)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_SetFocusableSubtypes(request *requests.FPDFAnnot_SetFocusableSubtypes) (*responses.FPDFAnnot_SetFocusableSubtypes, error) {
+	return i.FPDFAnnot_SetFocusableSubtypesWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_SetFocusableSubtypesWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetFocusableSubtypes) (*responses.FPDFAnnot_SetFocusableSubtypes, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_SetFocusableSubtypes != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_SetFocusableSubtypes(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_SetFocusableSubtypes", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetFocusableSubtypes)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_SetFontColor(request *requests.FPDFAnnot_SetFontColor) (*responses.FPDFAnnot_SetFontColor, error) {
+	return i.FPDFAnnot_SetFontColorWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_SetFontColorWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetFontColor) (*responses.FPDFAnnot_SetFontColor, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_SetFontColor != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_SetFontColor(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_SetFontColor", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetFontColor)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_SetFormFieldFlags(request *requests.FPDFAnnot_SetFormFieldFlags) (*responses.FPDFAnnot_SetFormFieldFlags, error) {
+	return i.FPDFAnnot_SetFormFieldFlagsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_SetFormFieldFlagsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetFormFieldFlags) (*responses.FPDFAnnot_SetFormFieldFlags, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_SetFormFieldFlags != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_SetFormFieldFlags(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_SetFormFieldFlags", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetFormFieldFlags)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_SetRect(request *requests.FPDFAnnot_SetRect) (*responses.FPDFAnnot_SetRect, error) {
+	return i.FPDFAnnot_SetRectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_SetRectWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetRect) (*responses.FPDFAnnot_SetRect, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_SetRect != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_SetRect(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_SetRect", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetRect)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_SetStringValue(request *requests.FPDFAnnot_SetStringValue) (*responses.FPDFAnnot_SetStringValue, error) {
+	return i.FPDFAnnot_SetStringValueWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_SetStringValueWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetStringValue) (*responses.FPDFAnnot_SetStringValue, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_SetStringValue != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_SetStringValue(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_SetStringValue", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetStringValue)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_SetURI(request *requests.FPDFAnnot_SetURI) (*responses.FPDFAnnot_SetURI, error) {
+	return i.FPDFAnnot_SetURIWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_SetURIWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetURI) (*responses.FPDFAnnot_SetURI, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_SetURI != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_SetURI(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_SetURI", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetURI)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAnnot_UpdateObject(request *requests.FPDFAnnot_UpdateObject) (*responses.FPDFAnnot_UpdateObject, error) {
+	return i.FPDFAnnot_UpdateObjectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAnnot_UpdateObjectWithContext(ctx goctx.Context, request *requests.FPDFAnnot_UpdateObject) (*responses.FPDFAnnot_UpdateObject, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAnnot_UpdateObject != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAnnot_UpdateObject(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAnnot_UpdateObject", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAnnot_UpdateObject)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAttachment_GetDescription(request *requests.FPDFAttachment_GetDescription) (*responses.FPDFAttachment_GetDescription, error) {
+	return i.FPDFAttachment_GetDescriptionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAttachment_GetDescriptionWithContext(ctx goctx.Context, request *requests.FPDFAttachment_GetDescription) (*responses.FPDFAttachment_GetDescription, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAttachment_GetDescription != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAttachment_GetDescription(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAttachment_GetDescription", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAttachment_GetDescription)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAttachment_GetFile(request *requests.FPDFAttachment_GetFile) (*responses.FPDFAttachment_GetFile, error) {
+	return i.FPDFAttachment_GetFileWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAttachment_GetFileWithContext(ctx goctx.Context, request *requests.FPDFAttachment_GetFile) (*responses.FPDFAttachment_GetFile, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAttachment_GetFile != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAttachment_GetFile(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAttachment_GetFile", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAttachment_GetFile)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAttachment_GetName(request *requests.FPDFAttachment_GetName) (*responses.FPDFAttachment_GetName, error) {
+	return i.FPDFAttachment_GetNameWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAttachment_GetNameWithContext(ctx goctx.Context, request *requests.FPDFAttachment_GetName) (*responses.FPDFAttachment_GetName, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAttachment_GetName != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAttachment_GetName(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAttachment_GetName", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAttachment_GetName)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAttachment_GetStringValue(request *requests.FPDFAttachment_GetStringValue) (*responses.FPDFAttachment_GetStringValue, error) {
+	return i.FPDFAttachment_GetStringValueWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAttachment_GetStringValueWithContext(ctx goctx.Context, request *requests.FPDFAttachment_GetStringValue) (*responses.FPDFAttachment_GetStringValue, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAttachment_GetStringValue != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAttachment_GetStringValue(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAttachment_GetStringValue", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAttachment_GetStringValue)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAttachment_GetSubtype(request *requests.FPDFAttachment_GetSubtype) (*responses.FPDFAttachment_GetSubtype, error) {
+	return i.FPDFAttachment_GetSubtypeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAttachment_GetSubtypeWithContext(ctx goctx.Context, request *requests.FPDFAttachment_GetSubtype) (*responses.FPDFAttachment_GetSubtype, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAttachment_GetSubtype != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAttachment_GetSubtype(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAttachment_GetSubtype", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAttachment_GetSubtype)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAttachment_GetValueType(request *requests.FPDFAttachment_GetValueType) (*responses.FPDFAttachment_GetValueType, error) {
+	return i.FPDFAttachment_GetValueTypeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAttachment_GetValueTypeWithContext(ctx goctx.Context, request *requests.FPDFAttachment_GetValueType) (*responses.FPDFAttachment_GetValueType, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAttachment_GetValueType != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAttachment_GetValueType(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAttachment_GetValueType", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAttachment_GetValueType)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAttachment_HasKey(request *requests.FPDFAttachment_HasKey) (*responses.FPDFAttachment_HasKey, error) {
+	return i.FPDFAttachment_HasKeyWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAttachment_HasKeyWithContext(ctx goctx.Context, request *requests.FPDFAttachment_HasKey) (*responses.FPDFAttachment_HasKey, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAttachment_HasKey != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAttachment_HasKey(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAttachment_HasKey", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAttachment_HasKey)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAttachment_SetDescription(request *requests.FPDFAttachment_SetDescription) (*responses.FPDFAttachment_SetDescription, error) {
+	return i.FPDFAttachment_SetDescriptionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAttachment_SetDescriptionWithContext(ctx goctx.Context, request *requests.FPDFAttachment_SetDescription) (*responses.FPDFAttachment_SetDescription, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAttachment_SetDescription != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAttachment_SetDescription(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAttachment_SetDescription", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAttachment_SetDescription)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAttachment_SetFile(request *requests.FPDFAttachment_SetFile) (*responses.FPDFAttachment_SetFile, error) {
+	return i.FPDFAttachment_SetFileWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAttachment_SetFileWithContext(ctx goctx.Context, request *requests.FPDFAttachment_SetFile) (*responses.FPDFAttachment_SetFile, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAttachment_SetFile != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAttachment_SetFile(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAttachment_SetFile", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAttachment_SetFile)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAttachment_SetStringValue(request *requests.FPDFAttachment_SetStringValue) (*responses.FPDFAttachment_SetStringValue, error) {
+	return i.FPDFAttachment_SetStringValueWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAttachment_SetStringValueWithContext(ctx goctx.Context, request *requests.FPDFAttachment_SetStringValue) (*responses.FPDFAttachment_SetStringValue, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAttachment_SetStringValue != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAttachment_SetStringValue(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAttachment_SetStringValue", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAttachment_SetStringValue)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAvail_Create(request *requests.FPDFAvail_Create) (*responses.FPDFAvail_Create, error) {
+	return i.FPDFAvail_CreateWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAvail_CreateWithContext(ctx goctx.Context, request *requests.FPDFAvail_Create) (*responses.FPDFAvail_Create, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAvail_Create != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAvail_Create(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAvail_Create", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAvail_Create)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAvail_Destroy(request *requests.FPDFAvail_Destroy) (*responses.FPDFAvail_Destroy, error) {
+	return i.FPDFAvail_DestroyWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAvail_DestroyWithContext(ctx goctx.Context, request *requests.FPDFAvail_Destroy) (*responses.FPDFAvail_Destroy, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAvail_Destroy != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAvail_Destroy(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAvail_Destroy", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAvail_Destroy)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAvail_GetDocument(request *requests.FPDFAvail_GetDocument) (*responses.FPDFAvail_GetDocument, error) {
+	return i.FPDFAvail_GetDocumentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAvail_GetDocumentWithContext(ctx goctx.Context, request *requests.FPDFAvail_GetDocument) (*responses.FPDFAvail_GetDocument, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAvail_GetDocument != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAvail_GetDocument(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAvail_GetDocument", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAvail_GetDocument)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAvail_GetFirstPageNum(request *requests.FPDFAvail_GetFirstPageNum) (*responses.FPDFAvail_GetFirstPageNum, error) {
+	return i.FPDFAvail_GetFirstPageNumWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAvail_GetFirstPageNumWithContext(ctx goctx.Context, request *requests.FPDFAvail_GetFirstPageNum) (*responses.FPDFAvail_GetFirstPageNum, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAvail_GetFirstPageNum != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAvail_GetFirstPageNum(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAvail_GetFirstPageNum", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAvail_GetFirstPageNum)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAvail_IsDocAvail(request *requests.FPDFAvail_IsDocAvail) (*responses.FPDFAvail_IsDocAvail, error) {
+	return i.FPDFAvail_IsDocAvailWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAvail_IsDocAvailWithContext(ctx goctx.Context, request *requests.FPDFAvail_IsDocAvail) (*responses.FPDFAvail_IsDocAvail, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAvail_IsDocAvail != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAvail_IsDocAvail(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAvail_IsDocAvail", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAvail_IsDocAvail)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAvail_IsFormAvail(request *requests.FPDFAvail_IsFormAvail) (*responses.FPDFAvail_IsFormAvail, error) {
+	return i.FPDFAvail_IsFormAvailWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAvail_IsFormAvailWithContext(ctx goctx.Context, request *requests.FPDFAvail_IsFormAvail) (*responses.FPDFAvail_IsFormAvail, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAvail_IsFormAvail != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAvail_IsFormAvail(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAvail_IsFormAvail", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAvail_IsFormAvail)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAvail_IsLinearized(request *requests.FPDFAvail_IsLinearized) (*responses.FPDFAvail_IsLinearized, error) {
+	return i.FPDFAvail_IsLinearizedWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAvail_IsLinearizedWithContext(ctx goctx.Context, request *requests.FPDFAvail_IsLinearized) (*responses.FPDFAvail_IsLinearized, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAvail_IsLinearized != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAvail_IsLinearized(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAvail_IsLinearized", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAvail_IsLinearized)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFAvail_IsPageAvail(request *requests.FPDFAvail_IsPageAvail) (*responses.FPDFAvail_IsPageAvail, error) {
+	return i.FPDFAvail_IsPageAvailWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFAvail_IsPageAvailWithContext(ctx goctx.Context, request *requests.FPDFAvail_IsPageAvail) (*responses.FPDFAvail_IsPageAvail, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFAvail_IsPageAvail != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFAvail_IsPageAvail(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFAvail_IsPageAvail", request, stub)
+	typedResp, _ := resp.(*responses.FPDFAvail_IsPageAvail)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFBitmap_Create(request *requests.FPDFBitmap_Create) (*responses.FPDFBitmap_Create, error) {
+	return i.FPDFBitmap_CreateWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFBitmap_CreateWithContext(ctx goctx.Context, request *requests.FPDFBitmap_Create) (*responses.FPDFBitmap_Create, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFBitmap_Create != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFBitmap_Create(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFBitmap_Create", request, stub)
+	typedResp, _ := resp.(*responses.FPDFBitmap_Create)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFBitmap_CreateEx(request *requests.FPDFBitmap_CreateEx) (*responses.FPDFBitmap_CreateEx, error) {
+	return i.FPDFBitmap_CreateExWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFBitmap_CreateExWithContext(ctx goctx.Context, request *requests.FPDFBitmap_CreateEx) (*responses.FPDFBitmap_CreateEx, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFBitmap_CreateEx != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFBitmap_CreateEx(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFBitmap_CreateEx", request, stub)
+	typedResp, _ := resp.(*responses.FPDFBitmap_CreateEx)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFBitmap_Destroy(request *requests.FPDFBitmap_Destroy) (*responses.FPDFBitmap_Destroy, error) {
+	return i.FPDFBitmap_DestroyWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFBitmap_DestroyWithContext(ctx goctx.Context, request *requests.FPDFBitmap_Destroy) (*responses.FPDFBitmap_Destroy, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFBitmap_Destroy != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFBitmap_Destroy(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFBitmap_Destroy", request, stub)
+	typedResp, _ := resp.(*responses.FPDFBitmap_Destroy)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFBitmap_FillRect(request *requests.FPDFBitmap_FillRect) (*responses.FPDFBitmap_FillRect, error) {
+	return i.FPDFBitmap_FillRectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFBitmap_FillRectWithContext(ctx goctx.Context, request *requests.FPDFBitmap_FillRect) (*responses.FPDFBitmap_FillRect, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFBitmap_FillRect != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFBitmap_FillRect(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFBitmap_FillRect", request, stub)
+	typedResp, _ := resp.(*responses.FPDFBitmap_FillRect)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFBitmap_GetBuffer(request *requests.FPDFBitmap_GetBuffer) (*responses.FPDFBitmap_GetBuffer, error) {
+	return i.FPDFBitmap_GetBufferWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFBitmap_GetBufferWithContext(ctx goctx.Context, request *requests.FPDFBitmap_GetBuffer) (*responses.FPDFBitmap_GetBuffer, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFBitmap_GetBuffer != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFBitmap_GetBuffer(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFBitmap_GetBuffer", request, stub)
+	typedResp, _ := resp.(*responses.FPDFBitmap_GetBuffer)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFBitmap_GetFormat(request *requests.FPDFBitmap_GetFormat) (*responses.FPDFBitmap_GetFormat, error) {
+	return i.FPDFBitmap_GetFormatWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFBitmap_GetFormatWithContext(ctx goctx.Context, request *requests.FPDFBitmap_GetFormat) (*responses.FPDFBitmap_GetFormat, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFBitmap_GetFormat != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFBitmap_GetFormat(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFBitmap_GetFormat", request, stub)
+	typedResp, _ := resp.(*responses.FPDFBitmap_GetFormat)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFBitmap_GetHeight(request *requests.FPDFBitmap_GetHeight) (*responses.FPDFBitmap_GetHeight, error) {
+	return i.FPDFBitmap_GetHeightWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFBitmap_GetHeightWithContext(ctx goctx.Context, request *requests.FPDFBitmap_GetHeight) (*responses.FPDFBitmap_GetHeight, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFBitmap_GetHeight != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFBitmap_GetHeight(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFBitmap_GetHeight", request, stub)
+	typedResp, _ := resp.(*responses.FPDFBitmap_GetHeight)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFBitmap_GetStride(request *requests.FPDFBitmap_GetStride) (*responses.FPDFBitmap_GetStride, error) {
+	return i.FPDFBitmap_GetStrideWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFBitmap_GetStrideWithContext(ctx goctx.Context, request *requests.FPDFBitmap_GetStride) (*responses.FPDFBitmap_GetStride, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFBitmap_GetStride != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFBitmap_GetStride(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFBitmap_GetStride", request, stub)
+	typedResp, _ := resp.(*responses.FPDFBitmap_GetStride)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFBitmap_GetWidth(request *requests.FPDFBitmap_GetWidth) (*responses.FPDFBitmap_GetWidth, error) {
+	return i.FPDFBitmap_GetWidthWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFBitmap_GetWidthWithContext(ctx goctx.Context, request *requests.FPDFBitmap_GetWidth) (*responses.FPDFBitmap_GetWidth, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFBitmap_GetWidth != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFBitmap_GetWidth(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFBitmap_GetWidth", request, stub)
+	typedResp, _ := resp.(*responses.FPDFBitmap_GetWidth)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFBookmark_Find(request *requests.FPDFBookmark_Find) (*responses.FPDFBookmark_Find, error) {
+	return i.FPDFBookmark_FindWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFBookmark_FindWithContext(ctx goctx.Context, request *requests.FPDFBookmark_Find) (*responses.FPDFBookmark_Find, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFBookmark_Find != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFBookmark_Find(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFBookmark_Find", request, stub)
+	typedResp, _ := resp.(*responses.FPDFBookmark_Find)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFBookmark_GetAction(request *requests.FPDFBookmark_GetAction) (*responses.FPDFBookmark_GetAction, error) {
+	return i.FPDFBookmark_GetActionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFBookmark_GetActionWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetAction) (*responses.FPDFBookmark_GetAction, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFBookmark_GetAction != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFBookmark_GetAction(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFBookmark_GetAction", request, stub)
+	typedResp, _ := resp.(*responses.FPDFBookmark_GetAction)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFBookmark_GetColor(request *requests.FPDFBookmark_GetColor) (*responses.FPDFBookmark_GetColor, error) {
+	return i.FPDFBookmark_GetColorWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFBookmark_GetColorWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetColor) (*responses.FPDFBookmark_GetColor, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFBookmark_GetColor != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFBookmark_GetColor(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFBookmark_GetColor", request, stub)
+	typedResp, _ := resp.(*responses.FPDFBookmark_GetColor)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFBookmark_GetCount(request *requests.FPDFBookmark_GetCount) (*responses.FPDFBookmark_GetCount, error) {
+	return i.FPDFBookmark_GetCountWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFBookmark_GetCountWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetCount) (*responses.FPDFBookmark_GetCount, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFBookmark_GetCount != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFBookmark_GetCount(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFBookmark_GetCount", request, stub)
+	typedResp, _ := resp.(*responses.FPDFBookmark_GetCount)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFBookmark_GetDest(request *requests.FPDFBookmark_GetDest) (*responses.FPDFBookmark_GetDest, error) {
+	return i.FPDFBookmark_GetDestWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFBookmark_GetDestWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetDest) (*responses.FPDFBookmark_GetDest, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFBookmark_GetDest != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFBookmark_GetDest(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFBookmark_GetDest", request, stub)
+	typedResp, _ := resp.(*responses.FPDFBookmark_GetDest)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFBookmark_GetFirstChild(request *requests.FPDFBookmark_GetFirstChild) (*responses.FPDFBookmark_GetFirstChild, error) {
+	return i.FPDFBookmark_GetFirstChildWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFBookmark_GetFirstChildWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetFirstChild) (*responses.FPDFBookmark_GetFirstChild, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFBookmark_GetFirstChild != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFBookmark_GetFirstChild(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFBookmark_GetFirstChild", request, stub)
+	typedResp, _ := resp.(*responses.FPDFBookmark_GetFirstChild)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFBookmark_GetNextSibling(request *requests.FPDFBookmark_GetNextSibling) (*responses.FPDFBookmark_GetNextSibling, error) {
+	return i.FPDFBookmark_GetNextSiblingWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFBookmark_GetNextSiblingWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetNextSibling) (*responses.FPDFBookmark_GetNextSibling, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFBookmark_GetNextSibling != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFBookmark_GetNextSibling(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFBookmark_GetNextSibling", request, stub)
+	typedResp, _ := resp.(*responses.FPDFBookmark_GetNextSibling)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFBookmark_GetTitle(request *requests.FPDFBookmark_GetTitle) (*responses.FPDFBookmark_GetTitle, error) {
+	return i.FPDFBookmark_GetTitleWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFBookmark_GetTitleWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetTitle) (*responses.FPDFBookmark_GetTitle, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFBookmark_GetTitle != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFBookmark_GetTitle(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFBookmark_GetTitle", request, stub)
+	typedResp, _ := resp.(*responses.FPDFBookmark_GetTitle)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFCatalog_GetLanguage(request *requests.FPDFCatalog_GetLanguage) (*responses.FPDFCatalog_GetLanguage, error) {
+	return i.FPDFCatalog_GetLanguageWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFCatalog_GetLanguageWithContext(ctx goctx.Context, request *requests.FPDFCatalog_GetLanguage) (*responses.FPDFCatalog_GetLanguage, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFCatalog_GetLanguage != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFCatalog_GetLanguage(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFCatalog_GetLanguage", request, stub)
+	typedResp, _ := resp.(*responses.FPDFCatalog_GetLanguage)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFCatalog_IsTagged(request *requests.FPDFCatalog_IsTagged) (*responses.FPDFCatalog_IsTagged, error) {
+	return i.FPDFCatalog_IsTaggedWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFCatalog_IsTaggedWithContext(ctx goctx.Context, request *requests.FPDFCatalog_IsTagged) (*responses.FPDFCatalog_IsTagged, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFCatalog_IsTagged != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFCatalog_IsTagged(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFCatalog_IsTagged", request, stub)
+	typedResp, _ := resp.(*responses.FPDFCatalog_IsTagged)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFCatalog_SetLanguage(request *requests.FPDFCatalog_SetLanguage) (*responses.FPDFCatalog_SetLanguage, error) {
+	return i.FPDFCatalog_SetLanguageWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFCatalog_SetLanguageWithContext(ctx goctx.Context, request *requests.FPDFCatalog_SetLanguage) (*responses.FPDFCatalog_SetLanguage, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFCatalog_SetLanguage != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFCatalog_SetLanguage(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFCatalog_SetLanguage", request, stub)
+	typedResp, _ := resp.(*responses.FPDFCatalog_SetLanguage)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFClipPath_CountPathSegments(request *requests.FPDFClipPath_CountPathSegments) (*responses.FPDFClipPath_CountPathSegments, error) {
+	return i.FPDFClipPath_CountPathSegmentsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFClipPath_CountPathSegmentsWithContext(ctx goctx.Context, request *requests.FPDFClipPath_CountPathSegments) (*responses.FPDFClipPath_CountPathSegments, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFClipPath_CountPathSegments != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFClipPath_CountPathSegments(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFClipPath_CountPathSegments", request, stub)
+	typedResp, _ := resp.(*responses.FPDFClipPath_CountPathSegments)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFClipPath_CountPaths(request *requests.FPDFClipPath_CountPaths) (*responses.FPDFClipPath_CountPaths, error) {
+	return i.FPDFClipPath_CountPathsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFClipPath_CountPathsWithContext(ctx goctx.Context, request *requests.FPDFClipPath_CountPaths) (*responses.FPDFClipPath_CountPaths, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFClipPath_CountPaths != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFClipPath_CountPaths(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFClipPath_CountPaths", request, stub)
+	typedResp, _ := resp.(*responses.FPDFClipPath_CountPaths)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFClipPath_GetPathSegment(request *requests.FPDFClipPath_GetPathSegment) (*responses.FPDFClipPath_GetPathSegment, error) {
+	return i.FPDFClipPath_GetPathSegmentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFClipPath_GetPathSegmentWithContext(ctx goctx.Context, request *requests.FPDFClipPath_GetPathSegment) (*responses.FPDFClipPath_GetPathSegment, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFClipPath_GetPathSegment != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFClipPath_GetPathSegment(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFClipPath_GetPathSegment", request, stub)
+	typedResp, _ := resp.(*responses.FPDFClipPath_GetPathSegment)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFDOC_ExitFormFillEnvironment(request *requests.FPDFDOC_ExitFormFillEnvironment) (*responses.FPDFDOC_ExitFormFillEnvironment, error) {
+	return i.FPDFDOC_ExitFormFillEnvironmentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFDOC_ExitFormFillEnvironmentWithContext(ctx goctx.Context, request *requests.FPDFDOC_ExitFormFillEnvironment) (*responses.FPDFDOC_ExitFormFillEnvironment, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFDOC_ExitFormFillEnvironment != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFDOC_ExitFormFillEnvironment(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFDOC_ExitFormFillEnvironment", request, stub)
+	typedResp, _ := resp.(*responses.FPDFDOC_ExitFormFillEnvironment)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFDOC_InitFormFillEnvironment(request *requests.FPDFDOC_InitFormFillEnvironment) (*responses.FPDFDOC_InitFormFillEnvironment, error) {
+	return i.FPDFDOC_InitFormFillEnvironmentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFDOC_InitFormFillEnvironmentWithContext(ctx goctx.Context, request *requests.FPDFDOC_InitFormFillEnvironment) (*responses.FPDFDOC_InitFormFillEnvironment, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFDOC_InitFormFillEnvironment != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFDOC_InitFormFillEnvironment(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFDOC_InitFormFillEnvironment", request, stub)
+	typedResp, _ := resp.(*responses.FPDFDOC_InitFormFillEnvironment)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFDest_GetDestPageIndex(request *requests.FPDFDest_GetDestPageIndex) (*responses.FPDFDest_GetDestPageIndex, error) {
+	return i.FPDFDest_GetDestPageIndexWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFDest_GetDestPageIndexWithContext(ctx goctx.Context, request *requests.FPDFDest_GetDestPageIndex) (*responses.FPDFDest_GetDestPageIndex, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFDest_GetDestPageIndex != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFDest_GetDestPageIndex(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFDest_GetDestPageIndex", request, stub)
+	typedResp, _ := resp.(*responses.FPDFDest_GetDestPageIndex)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFDest_GetLocationInPage(request *requests.FPDFDest_GetLocationInPage) (*responses.FPDFDest_GetLocationInPage, error) {
+	return i.FPDFDest_GetLocationInPageWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFDest_GetLocationInPageWithContext(ctx goctx.Context, request *requests.FPDFDest_GetLocationInPage) (*responses.FPDFDest_GetLocationInPage, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFDest_GetLocationInPage != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFDest_GetLocationInPage(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFDest_GetLocationInPage", request, stub)
+	typedResp, _ := resp.(*responses.FPDFDest_GetLocationInPage)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFDest_GetView(request *requests.FPDFDest_GetView) (*responses.FPDFDest_GetView, error) {
+	return i.FPDFDest_GetViewWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFDest_GetViewWithContext(ctx goctx.Context, request *requests.FPDFDest_GetView) (*responses.FPDFDest_GetView, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFDest_GetView != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFDest_GetView(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFDest_GetView", request, stub)
+	typedResp, _ := resp.(*responses.FPDFDest_GetView)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFDoc_AddAttachment(request *requests.FPDFDoc_AddAttachment) (*responses.FPDFDoc_AddAttachment, error) {
+	return i.FPDFDoc_AddAttachmentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFDoc_AddAttachmentWithContext(ctx goctx.Context, request *requests.FPDFDoc_AddAttachment) (*responses.FPDFDoc_AddAttachment, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFDoc_AddAttachment != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFDoc_AddAttachment(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFDoc_AddAttachment", request, stub)
+	typedResp, _ := resp.(*responses.FPDFDoc_AddAttachment)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFDoc_CloseJavaScriptAction(request *requests.FPDFDoc_CloseJavaScriptAction) (*responses.FPDFDoc_CloseJavaScriptAction, error) {
+	return i.FPDFDoc_CloseJavaScriptActionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFDoc_CloseJavaScriptActionWithContext(ctx goctx.Context, request *requests.FPDFDoc_CloseJavaScriptAction) (*responses.FPDFDoc_CloseJavaScriptAction, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFDoc_CloseJavaScriptAction != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFDoc_CloseJavaScriptAction(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFDoc_CloseJavaScriptAction", request, stub)
+	typedResp, _ := resp.(*responses.FPDFDoc_CloseJavaScriptAction)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFDoc_DeleteAttachment(request *requests.FPDFDoc_DeleteAttachment) (*responses.FPDFDoc_DeleteAttachment, error) {
+	return i.FPDFDoc_DeleteAttachmentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFDoc_DeleteAttachmentWithContext(ctx goctx.Context, request *requests.FPDFDoc_DeleteAttachment) (*responses.FPDFDoc_DeleteAttachment, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFDoc_DeleteAttachment != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFDoc_DeleteAttachment(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFDoc_DeleteAttachment", request, stub)
+	typedResp, _ := resp.(*responses.FPDFDoc_DeleteAttachment)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFDoc_GetAttachment(request *requests.FPDFDoc_GetAttachment) (*responses.FPDFDoc_GetAttachment, error) {
+	return i.FPDFDoc_GetAttachmentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFDoc_GetAttachmentWithContext(ctx goctx.Context, request *requests.FPDFDoc_GetAttachment) (*responses.FPDFDoc_GetAttachment, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFDoc_GetAttachment != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFDoc_GetAttachment(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFDoc_GetAttachment", request, stub)
+	typedResp, _ := resp.(*responses.FPDFDoc_GetAttachment)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFDoc_GetAttachmentCount(request *requests.FPDFDoc_GetAttachmentCount) (*responses.FPDFDoc_GetAttachmentCount, error) {
+	return i.FPDFDoc_GetAttachmentCountWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFDoc_GetAttachmentCountWithContext(ctx goctx.Context, request *requests.FPDFDoc_GetAttachmentCount) (*responses.FPDFDoc_GetAttachmentCount, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFDoc_GetAttachmentCount != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFDoc_GetAttachmentCount(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFDoc_GetAttachmentCount", request, stub)
+	typedResp, _ := resp.(*responses.FPDFDoc_GetAttachmentCount)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFDoc_GetJavaScriptAction(request *requests.FPDFDoc_GetJavaScriptAction) (*responses.FPDFDoc_GetJavaScriptAction, error) {
+	return i.FPDFDoc_GetJavaScriptActionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFDoc_GetJavaScriptActionWithContext(ctx goctx.Context, request *requests.FPDFDoc_GetJavaScriptAction) (*responses.FPDFDoc_GetJavaScriptAction, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFDoc_GetJavaScriptAction != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFDoc_GetJavaScriptAction(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFDoc_GetJavaScriptAction", request, stub)
+	typedResp, _ := resp.(*responses.FPDFDoc_GetJavaScriptAction)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFDoc_GetJavaScriptActionCount(request *requests.FPDFDoc_GetJavaScriptActionCount) (*responses.FPDFDoc_GetJavaScriptActionCount, error) {
+	return i.FPDFDoc_GetJavaScriptActionCountWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFDoc_GetJavaScriptActionCountWithContext(ctx goctx.Context, request *requests.FPDFDoc_GetJavaScriptActionCount) (*responses.FPDFDoc_GetJavaScriptActionCount, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFDoc_GetJavaScriptActionCount != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFDoc_GetJavaScriptActionCount(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFDoc_GetJavaScriptActionCount", request, stub)
+	typedResp, _ := resp.(*responses.FPDFDoc_GetJavaScriptActionCount)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFDoc_GetPageMode(request *requests.FPDFDoc_GetPageMode) (*responses.FPDFDoc_GetPageMode, error) {
+	return i.FPDFDoc_GetPageModeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFDoc_GetPageModeWithContext(ctx goctx.Context, request *requests.FPDFDoc_GetPageMode) (*responses.FPDFDoc_GetPageMode, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFDoc_GetPageMode != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFDoc_GetPageMode(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFDoc_GetPageMode", request, stub)
+	typedResp, _ := resp.(*responses.FPDFDoc_GetPageMode)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFFont_Close(request *requests.FPDFFont_Close) (*responses.FPDFFont_Close, error) {
+	return i.FPDFFont_CloseWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFFont_CloseWithContext(ctx goctx.Context, request *requests.FPDFFont_Close) (*responses.FPDFFont_Close, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFFont_Close != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFFont_Close(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFFont_Close", request, stub)
+	typedResp, _ := resp.(*responses.FPDFFont_Close)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFFont_GetAscent(request *requests.FPDFFont_GetAscent) (*responses.FPDFFont_GetAscent, error) {
+	return i.FPDFFont_GetAscentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFFont_GetAscentWithContext(ctx goctx.Context, request *requests.FPDFFont_GetAscent) (*responses.FPDFFont_GetAscent, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFFont_GetAscent != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFFont_GetAscent(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFFont_GetAscent", request, stub)
+	typedResp, _ := resp.(*responses.FPDFFont_GetAscent)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFFont_GetBaseFontName(request *requests.FPDFFont_GetBaseFontName) (*responses.FPDFFont_GetBaseFontName, error) {
+	return i.FPDFFont_GetBaseFontNameWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFFont_GetBaseFontNameWithContext(ctx goctx.Context, request *requests.FPDFFont_GetBaseFontName) (*responses.FPDFFont_GetBaseFontName, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFFont_GetBaseFontName != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFFont_GetBaseFontName(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFFont_GetBaseFontName", request, stub)
+	typedResp, _ := resp.(*responses.FPDFFont_GetBaseFontName)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFFont_GetDescent(request *requests.FPDFFont_GetDescent) (*responses.FPDFFont_GetDescent, error) {
+	return i.FPDFFont_GetDescentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFFont_GetDescentWithContext(ctx goctx.Context, request *requests.FPDFFont_GetDescent) (*responses.FPDFFont_GetDescent, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFFont_GetDescent != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFFont_GetDescent(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFFont_GetDescent", request, stub)
+	typedResp, _ := resp.(*responses.FPDFFont_GetDescent)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFFont_GetFamilyName(request *requests.FPDFFont_GetFamilyName) (*responses.FPDFFont_GetFamilyName, error) {
+	return i.FPDFFont_GetFamilyNameWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFFont_GetFamilyNameWithContext(ctx goctx.Context, request *requests.FPDFFont_GetFamilyName) (*responses.FPDFFont_GetFamilyName, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFFont_GetFamilyName != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFFont_GetFamilyName(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFFont_GetFamilyName", request, stub)
+	typedResp, _ := resp.(*responses.FPDFFont_GetFamilyName)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFFont_GetFlags(request *requests.FPDFFont_GetFlags) (*responses.FPDFFont_GetFlags, error) {
+	return i.FPDFFont_GetFlagsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFFont_GetFlagsWithContext(ctx goctx.Context, request *requests.FPDFFont_GetFlags) (*responses.FPDFFont_GetFlags, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFFont_GetFlags != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFFont_GetFlags(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFFont_GetFlags", request, stub)
+	typedResp, _ := resp.(*responses.FPDFFont_GetFlags)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFFont_GetFontData(request *requests.FPDFFont_GetFontData) (*responses.FPDFFont_GetFontData, error) {
+	return i.FPDFFont_GetFontDataWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFFont_GetFontDataWithContext(ctx goctx.Context, request *requests.FPDFFont_GetFontData) (*responses.FPDFFont_GetFontData, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFFont_GetFontData != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFFont_GetFontData(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFFont_GetFontData", request, stub)
+	typedResp, _ := resp.(*responses.FPDFFont_GetFontData)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFFont_GetGlyphPath(request *requests.FPDFFont_GetGlyphPath) (*responses.FPDFFont_GetGlyphPath, error) {
+	return i.FPDFFont_GetGlyphPathWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFFont_GetGlyphPathWithContext(ctx goctx.Context, request *requests.FPDFFont_GetGlyphPath) (*responses.FPDFFont_GetGlyphPath, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFFont_GetGlyphPath != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFFont_GetGlyphPath(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFFont_GetGlyphPath", request, stub)
+	typedResp, _ := resp.(*responses.FPDFFont_GetGlyphPath)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFFont_GetGlyphWidth(request *requests.FPDFFont_GetGlyphWidth) (*responses.FPDFFont_GetGlyphWidth, error) {
+	return i.FPDFFont_GetGlyphWidthWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFFont_GetGlyphWidthWithContext(ctx goctx.Context, request *requests.FPDFFont_GetGlyphWidth) (*responses.FPDFFont_GetGlyphWidth, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFFont_GetGlyphWidth != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFFont_GetGlyphWidth(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFFont_GetGlyphWidth", request, stub)
+	typedResp, _ := resp.(*responses.FPDFFont_GetGlyphWidth)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFFont_GetIsEmbedded(request *requests.FPDFFont_GetIsEmbedded) (*responses.FPDFFont_GetIsEmbedded, error) {
+	return i.FPDFFont_GetIsEmbeddedWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFFont_GetIsEmbeddedWithContext(ctx goctx.Context, request *requests.FPDFFont_GetIsEmbedded) (*responses.FPDFFont_GetIsEmbedded, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFFont_GetIsEmbedded != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFFont_GetIsEmbedded(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFFont_GetIsEmbedded", request, stub)
+	typedResp, _ := resp.(*responses.FPDFFont_GetIsEmbedded)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFFont_GetItalicAngle(request *requests.FPDFFont_GetItalicAngle) (*responses.FPDFFont_GetItalicAngle, error) {
+	return i.FPDFFont_GetItalicAngleWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFFont_GetItalicAngleWithContext(ctx goctx.Context, request *requests.FPDFFont_GetItalicAngle) (*responses.FPDFFont_GetItalicAngle, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFFont_GetItalicAngle != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFFont_GetItalicAngle(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFFont_GetItalicAngle", request, stub)
+	typedResp, _ := resp.(*responses.FPDFFont_GetItalicAngle)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFFont_GetWeight(request *requests.FPDFFont_GetWeight) (*responses.FPDFFont_GetWeight, error) {
+	return i.FPDFFont_GetWeightWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFFont_GetWeightWithContext(ctx goctx.Context, request *requests.FPDFFont_GetWeight) (*responses.FPDFFont_GetWeight, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFFont_GetWeight != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFFont_GetWeight(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFFont_GetWeight", request, stub)
+	typedResp, _ := resp.(*responses.FPDFFont_GetWeight)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFFormObj_CountObjects(request *requests.FPDFFormObj_CountObjects) (*responses.FPDFFormObj_CountObjects, error) {
+	return i.FPDFFormObj_CountObjectsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFFormObj_CountObjectsWithContext(ctx goctx.Context, request *requests.FPDFFormObj_CountObjects) (*responses.FPDFFormObj_CountObjects, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFFormObj_CountObjects != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFFormObj_CountObjects(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFFormObj_CountObjects", request, stub)
+	typedResp, _ := resp.(*responses.FPDFFormObj_CountObjects)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFFormObj_GetObject(request *requests.FPDFFormObj_GetObject) (*responses.FPDFFormObj_GetObject, error) {
+	return i.FPDFFormObj_GetObjectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFFormObj_GetObjectWithContext(ctx goctx.Context, request *requests.FPDFFormObj_GetObject) (*responses.FPDFFormObj_GetObject, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFFormObj_GetObject != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFFormObj_GetObject(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFFormObj_GetObject", request, stub)
+	typedResp, _ := resp.(*responses.FPDFFormObj_GetObject)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFFormObj_RemoveObject(request *requests.FPDFFormObj_RemoveObject) (*responses.FPDFFormObj_RemoveObject, error) {
+	return i.FPDFFormObj_RemoveObjectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFFormObj_RemoveObjectWithContext(ctx goctx.Context, request *requests.FPDFFormObj_RemoveObject) (*responses.FPDFFormObj_RemoveObject, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFFormObj_RemoveObject != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFFormObj_RemoveObject(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFFormObj_RemoveObject", request, stub)
+	typedResp, _ := resp.(*responses.FPDFFormObj_RemoveObject)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFGlyphPath_CountGlyphSegments(request *requests.FPDFGlyphPath_CountGlyphSegments) (*responses.FPDFGlyphPath_CountGlyphSegments, error) {
+	return i.FPDFGlyphPath_CountGlyphSegmentsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFGlyphPath_CountGlyphSegmentsWithContext(ctx goctx.Context, request *requests.FPDFGlyphPath_CountGlyphSegments) (*responses.FPDFGlyphPath_CountGlyphSegments, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFGlyphPath_CountGlyphSegments != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFGlyphPath_CountGlyphSegments(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFGlyphPath_CountGlyphSegments", request, stub)
+	typedResp, _ := resp.(*responses.FPDFGlyphPath_CountGlyphSegments)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFGlyphPath_GetGlyphPathSegment(request *requests.FPDFGlyphPath_GetGlyphPathSegment) (*responses.FPDFGlyphPath_GetGlyphPathSegment, error) {
+	return i.FPDFGlyphPath_GetGlyphPathSegmentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFGlyphPath_GetGlyphPathSegmentWithContext(ctx goctx.Context, request *requests.FPDFGlyphPath_GetGlyphPathSegment) (*responses.FPDFGlyphPath_GetGlyphPathSegment, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFGlyphPath_GetGlyphPathSegment != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFGlyphPath_GetGlyphPathSegment(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFGlyphPath_GetGlyphPathSegment", request, stub)
+	typedResp, _ := resp.(*responses.FPDFGlyphPath_GetGlyphPathSegment)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFImageObj_GetBitmap(request *requests.FPDFImageObj_GetBitmap) (*responses.FPDFImageObj_GetBitmap, error) {
+	return i.FPDFImageObj_GetBitmapWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFImageObj_GetBitmapWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetBitmap) (*responses.FPDFImageObj_GetBitmap, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFImageObj_GetBitmap != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFImageObj_GetBitmap(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFImageObj_GetBitmap", request, stub)
+	typedResp, _ := resp.(*responses.FPDFImageObj_GetBitmap)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFImageObj_GetIccProfileDataDecoded(request *requests.FPDFImageObj_GetIccProfileDataDecoded) (*responses.FPDFImageObj_GetIccProfileDataDecoded, error) {
+	return i.FPDFImageObj_GetIccProfileDataDecodedWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFImageObj_GetIccProfileDataDecodedWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetIccProfileDataDecoded) (*responses.FPDFImageObj_GetIccProfileDataDecoded, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFImageObj_GetIccProfileDataDecoded != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFImageObj_GetIccProfileDataDecoded(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFImageObj_GetIccProfileDataDecoded", request, stub)
+	typedResp, _ := resp.(*responses.FPDFImageObj_GetIccProfileDataDecoded)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFImageObj_GetImageDataDecoded(request *requests.FPDFImageObj_GetImageDataDecoded) (*responses.FPDFImageObj_GetImageDataDecoded, error) {
+	return i.FPDFImageObj_GetImageDataDecodedWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFImageObj_GetImageDataDecodedWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetImageDataDecoded) (*responses.FPDFImageObj_GetImageDataDecoded, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFImageObj_GetImageDataDecoded != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFImageObj_GetImageDataDecoded(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFImageObj_GetImageDataDecoded", request, stub)
+	typedResp, _ := resp.(*responses.FPDFImageObj_GetImageDataDecoded)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFImageObj_GetImageDataRaw(request *requests.FPDFImageObj_GetImageDataRaw) (*responses.FPDFImageObj_GetImageDataRaw, error) {
+	return i.FPDFImageObj_GetImageDataRawWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFImageObj_GetImageDataRawWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetImageDataRaw) (*responses.FPDFImageObj_GetImageDataRaw, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFImageObj_GetImageDataRaw != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFImageObj_GetImageDataRaw(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFImageObj_GetImageDataRaw", request, stub)
+	typedResp, _ := resp.(*responses.FPDFImageObj_GetImageDataRaw)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFImageObj_GetImageFilter(request *requests.FPDFImageObj_GetImageFilter) (*responses.FPDFImageObj_GetImageFilter, error) {
+	return i.FPDFImageObj_GetImageFilterWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFImageObj_GetImageFilterWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetImageFilter) (*responses.FPDFImageObj_GetImageFilter, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFImageObj_GetImageFilter != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFImageObj_GetImageFilter(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFImageObj_GetImageFilter", request, stub)
+	typedResp, _ := resp.(*responses.FPDFImageObj_GetImageFilter)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFImageObj_GetImageFilterCount(request *requests.FPDFImageObj_GetImageFilterCount) (*responses.FPDFImageObj_GetImageFilterCount, error) {
+	return i.FPDFImageObj_GetImageFilterCountWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFImageObj_GetImageFilterCountWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetImageFilterCount) (*responses.FPDFImageObj_GetImageFilterCount, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFImageObj_GetImageFilterCount != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFImageObj_GetImageFilterCount(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFImageObj_GetImageFilterCount", request, stub)
+	typedResp, _ := resp.(*responses.FPDFImageObj_GetImageFilterCount)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFImageObj_GetImageMetadata(request *requests.FPDFImageObj_GetImageMetadata) (*responses.FPDFImageObj_GetImageMetadata, error) {
+	return i.FPDFImageObj_GetImageMetadataWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFImageObj_GetImageMetadataWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetImageMetadata) (*responses.FPDFImageObj_GetImageMetadata, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFImageObj_GetImageMetadata != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFImageObj_GetImageMetadata(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFImageObj_GetImageMetadata", request, stub)
+	typedResp, _ := resp.(*responses.FPDFImageObj_GetImageMetadata)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFImageObj_GetImagePixelSize(request *requests.FPDFImageObj_GetImagePixelSize) (*responses.FPDFImageObj_GetImagePixelSize, error) {
+	return i.FPDFImageObj_GetImagePixelSizeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFImageObj_GetImagePixelSizeWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetImagePixelSize) (*responses.FPDFImageObj_GetImagePixelSize, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFImageObj_GetImagePixelSize != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFImageObj_GetImagePixelSize(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFImageObj_GetImagePixelSize", request, stub)
+	typedResp, _ := resp.(*responses.FPDFImageObj_GetImagePixelSize)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFImageObj_GetRenderedBitmap(request *requests.FPDFImageObj_GetRenderedBitmap) (*responses.FPDFImageObj_GetRenderedBitmap, error) {
+	return i.FPDFImageObj_GetRenderedBitmapWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFImageObj_GetRenderedBitmapWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetRenderedBitmap) (*responses.FPDFImageObj_GetRenderedBitmap, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFImageObj_GetRenderedBitmap != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFImageObj_GetRenderedBitmap(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFImageObj_GetRenderedBitmap", request, stub)
+	typedResp, _ := resp.(*responses.FPDFImageObj_GetRenderedBitmap)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFImageObj_LoadJpegFile(request *requests.FPDFImageObj_LoadJpegFile) (*responses.FPDFImageObj_LoadJpegFile, error) {
+	return i.FPDFImageObj_LoadJpegFileWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFImageObj_LoadJpegFileWithContext(ctx goctx.Context, request *requests.FPDFImageObj_LoadJpegFile) (*responses.FPDFImageObj_LoadJpegFile, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFImageObj_LoadJpegFile != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFImageObj_LoadJpegFile(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFImageObj_LoadJpegFile", request, stub)
+	typedResp, _ := resp.(*responses.FPDFImageObj_LoadJpegFile)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFImageObj_LoadJpegFileInline(request *requests.FPDFImageObj_LoadJpegFileInline) (*responses.FPDFImageObj_LoadJpegFileInline, error) {
+	return i.FPDFImageObj_LoadJpegFileInlineWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFImageObj_LoadJpegFileInlineWithContext(ctx goctx.Context, request *requests.FPDFImageObj_LoadJpegFileInline) (*responses.FPDFImageObj_LoadJpegFileInline, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFImageObj_LoadJpegFileInline != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFImageObj_LoadJpegFileInline(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFImageObj_LoadJpegFileInline", request, stub)
+	typedResp, _ := resp.(*responses.FPDFImageObj_LoadJpegFileInline)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFImageObj_SetBitmap(request *requests.FPDFImageObj_SetBitmap) (*responses.FPDFImageObj_SetBitmap, error) {
+	return i.FPDFImageObj_SetBitmapWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFImageObj_SetBitmapWithContext(ctx goctx.Context, request *requests.FPDFImageObj_SetBitmap) (*responses.FPDFImageObj_SetBitmap, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFImageObj_SetBitmap != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFImageObj_SetBitmap(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFImageObj_SetBitmap", request, stub)
+	typedResp, _ := resp.(*responses.FPDFImageObj_SetBitmap)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFImageObj_SetMatrix(request *requests.FPDFImageObj_SetMatrix) (*responses.FPDFImageObj_SetMatrix, error) {
+	return i.FPDFImageObj_SetMatrixWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFImageObj_SetMatrixWithContext(ctx goctx.Context, request *requests.FPDFImageObj_SetMatrix) (*responses.FPDFImageObj_SetMatrix, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFImageObj_SetMatrix != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFImageObj_SetMatrix(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFImageObj_SetMatrix", request, stub)
+	typedResp, _ := resp.(*responses.FPDFImageObj_SetMatrix)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFJavaScriptAction_GetName(request *requests.FPDFJavaScriptAction_GetName) (*responses.FPDFJavaScriptAction_GetName, error) {
+	return i.FPDFJavaScriptAction_GetNameWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFJavaScriptAction_GetNameWithContext(ctx goctx.Context, request *requests.FPDFJavaScriptAction_GetName) (*responses.FPDFJavaScriptAction_GetName, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFJavaScriptAction_GetName != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFJavaScriptAction_GetName(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFJavaScriptAction_GetName", request, stub)
+	typedResp, _ := resp.(*responses.FPDFJavaScriptAction_GetName)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFJavaScriptAction_GetScript(request *requests.FPDFJavaScriptAction_GetScript) (*responses.FPDFJavaScriptAction_GetScript, error) {
+	return i.FPDFJavaScriptAction_GetScriptWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFJavaScriptAction_GetScriptWithContext(ctx goctx.Context, request *requests.FPDFJavaScriptAction_GetScript) (*responses.FPDFJavaScriptAction_GetScript, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFJavaScriptAction_GetScript != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFJavaScriptAction_GetScript(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFJavaScriptAction_GetScript", request, stub)
+	typedResp, _ := resp.(*responses.FPDFJavaScriptAction_GetScript)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFLink_CloseWebLinks(request *requests.FPDFLink_CloseWebLinks) (*responses.FPDFLink_CloseWebLinks, error) {
+	return i.FPDFLink_CloseWebLinksWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFLink_CloseWebLinksWithContext(ctx goctx.Context, request *requests.FPDFLink_CloseWebLinks) (*responses.FPDFLink_CloseWebLinks, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFLink_CloseWebLinks != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFLink_CloseWebLinks(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFLink_CloseWebLinks", request, stub)
+	typedResp, _ := resp.(*responses.FPDFLink_CloseWebLinks)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFLink_CountQuadPoints(request *requests.FPDFLink_CountQuadPoints) (*responses.FPDFLink_CountQuadPoints, error) {
+	return i.FPDFLink_CountQuadPointsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFLink_CountQuadPointsWithContext(ctx goctx.Context, request *requests.FPDFLink_CountQuadPoints) (*responses.FPDFLink_CountQuadPoints, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFLink_CountQuadPoints != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFLink_CountQuadPoints(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFLink_CountQuadPoints", request, stub)
+	typedResp, _ := resp.(*responses.FPDFLink_CountQuadPoints)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFLink_CountRects(request *requests.FPDFLink_CountRects) (*responses.FPDFLink_CountRects, error) {
+	return i.FPDFLink_CountRectsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFLink_CountRectsWithContext(ctx goctx.Context, request *requests.FPDFLink_CountRects) (*responses.FPDFLink_CountRects, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFLink_CountRects != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFLink_CountRects(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFLink_CountRects", request, stub)
+	typedResp, _ := resp.(*responses.FPDFLink_CountRects)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFLink_CountWebLinks(request *requests.FPDFLink_CountWebLinks) (*responses.FPDFLink_CountWebLinks, error) {
+	return i.FPDFLink_CountWebLinksWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFLink_CountWebLinksWithContext(ctx goctx.Context, request *requests.FPDFLink_CountWebLinks) (*responses.FPDFLink_CountWebLinks, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFLink_CountWebLinks != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFLink_CountWebLinks(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFLink_CountWebLinks", request, stub)
+	typedResp, _ := resp.(*responses.FPDFLink_CountWebLinks)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFLink_Enumerate(request *requests.FPDFLink_Enumerate) (*responses.FPDFLink_Enumerate, error) {
+	return i.FPDFLink_EnumerateWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFLink_EnumerateWithContext(ctx goctx.Context, request *requests.FPDFLink_Enumerate) (*responses.FPDFLink_Enumerate, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFLink_Enumerate != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFLink_Enumerate(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFLink_Enumerate", request, stub)
+	typedResp, _ := resp.(*responses.FPDFLink_Enumerate)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFLink_GetAction(request *requests.FPDFLink_GetAction) (*responses.FPDFLink_GetAction, error) {
+	return i.FPDFLink_GetActionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFLink_GetActionWithContext(ctx goctx.Context, request *requests.FPDFLink_GetAction) (*responses.FPDFLink_GetAction, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFLink_GetAction != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFLink_GetAction(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFLink_GetAction", request, stub)
+	typedResp, _ := resp.(*responses.FPDFLink_GetAction)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFLink_GetAnnot(request *requests.FPDFLink_GetAnnot) (*responses.FPDFLink_GetAnnot, error) {
+	return i.FPDFLink_GetAnnotWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFLink_GetAnnotWithContext(ctx goctx.Context, request *requests.FPDFLink_GetAnnot) (*responses.FPDFLink_GetAnnot, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFLink_GetAnnot != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFLink_GetAnnot(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFLink_GetAnnot", request, stub)
+	typedResp, _ := resp.(*responses.FPDFLink_GetAnnot)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFLink_GetAnnotRect(request *requests.FPDFLink_GetAnnotRect) (*responses.FPDFLink_GetAnnotRect, error) {
+	return i.FPDFLink_GetAnnotRectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFLink_GetAnnotRectWithContext(ctx goctx.Context, request *requests.FPDFLink_GetAnnotRect) (*responses.FPDFLink_GetAnnotRect, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFLink_GetAnnotRect != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFLink_GetAnnotRect(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFLink_GetAnnotRect", request, stub)
+	typedResp, _ := resp.(*responses.FPDFLink_GetAnnotRect)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFLink_GetDest(request *requests.FPDFLink_GetDest) (*responses.FPDFLink_GetDest, error) {
+	return i.FPDFLink_GetDestWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFLink_GetDestWithContext(ctx goctx.Context, request *requests.FPDFLink_GetDest) (*responses.FPDFLink_GetDest, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFLink_GetDest != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFLink_GetDest(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFLink_GetDest", request, stub)
+	typedResp, _ := resp.(*responses.FPDFLink_GetDest)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFLink_GetLinkAtPoint(request *requests.FPDFLink_GetLinkAtPoint) (*responses.FPDFLink_GetLinkAtPoint, error) {
+	return i.FPDFLink_GetLinkAtPointWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFLink_GetLinkAtPointWithContext(ctx goctx.Context, request *requests.FPDFLink_GetLinkAtPoint) (*responses.FPDFLink_GetLinkAtPoint, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFLink_GetLinkAtPoint != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFLink_GetLinkAtPoint(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFLink_GetLinkAtPoint", request, stub)
+	typedResp, _ := resp.(*responses.FPDFLink_GetLinkAtPoint)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFLink_GetLinkZOrderAtPoint(request *requests.FPDFLink_GetLinkZOrderAtPoint) (*responses.FPDFLink_GetLinkZOrderAtPoint, error) {
+	return i.FPDFLink_GetLinkZOrderAtPointWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFLink_GetLinkZOrderAtPointWithContext(ctx goctx.Context, request *requests.FPDFLink_GetLinkZOrderAtPoint) (*responses.FPDFLink_GetLinkZOrderAtPoint, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFLink_GetLinkZOrderAtPoint != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFLink_GetLinkZOrderAtPoint(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFLink_GetLinkZOrderAtPoint", request, stub)
+	typedResp, _ := resp.(*responses.FPDFLink_GetLinkZOrderAtPoint)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFLink_GetQuadPoints(request *requests.FPDFLink_GetQuadPoints) (*responses.FPDFLink_GetQuadPoints, error) {
+	return i.FPDFLink_GetQuadPointsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFLink_GetQuadPointsWithContext(ctx goctx.Context, request *requests.FPDFLink_GetQuadPoints) (*responses.FPDFLink_GetQuadPoints, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFLink_GetQuadPoints != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFLink_GetQuadPoints(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFLink_GetQuadPoints", request, stub)
+	typedResp, _ := resp.(*responses.FPDFLink_GetQuadPoints)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFLink_GetRect(request *requests.FPDFLink_GetRect) (*responses.FPDFLink_GetRect, error) {
+	return i.FPDFLink_GetRectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFLink_GetRectWithContext(ctx goctx.Context, request *requests.FPDFLink_GetRect) (*responses.FPDFLink_GetRect, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFLink_GetRect != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFLink_GetRect(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFLink_GetRect", request, stub)
+	typedResp, _ := resp.(*responses.FPDFLink_GetRect)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFLink_GetTextRange(request *requests.FPDFLink_GetTextRange) (*responses.FPDFLink_GetTextRange, error) {
+	return i.FPDFLink_GetTextRangeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFLink_GetTextRangeWithContext(ctx goctx.Context, request *requests.FPDFLink_GetTextRange) (*responses.FPDFLink_GetTextRange, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFLink_GetTextRange != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFLink_GetTextRange(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFLink_GetTextRange", request, stub)
+	typedResp, _ := resp.(*responses.FPDFLink_GetTextRange)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFLink_GetURL(request *requests.FPDFLink_GetURL) (*responses.FPDFLink_GetURL, error) {
+	return i.FPDFLink_GetURLWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFLink_GetURLWithContext(ctx goctx.Context, request *requests.FPDFLink_GetURL) (*responses.FPDFLink_GetURL, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFLink_GetURL != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFLink_GetURL(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFLink_GetURL", request, stub)
+	typedResp, _ := resp.(*responses.FPDFLink_GetURL)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFLink_LoadWebLinks(request *requests.FPDFLink_LoadWebLinks) (*responses.FPDFLink_LoadWebLinks, error) {
+	return i.FPDFLink_LoadWebLinksWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFLink_LoadWebLinksWithContext(ctx goctx.Context, request *requests.FPDFLink_LoadWebLinks) (*responses.FPDFLink_LoadWebLinks, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFLink_LoadWebLinks != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFLink_LoadWebLinks(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFLink_LoadWebLinks", request, stub)
+	typedResp, _ := resp.(*responses.FPDFLink_LoadWebLinks)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObjMark_CountParams(request *requests.FPDFPageObjMark_CountParams) (*responses.FPDFPageObjMark_CountParams, error) {
+	return i.FPDFPageObjMark_CountParamsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObjMark_CountParamsWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_CountParams) (*responses.FPDFPageObjMark_CountParams, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObjMark_CountParams != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObjMark_CountParams(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObjMark_CountParams", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_CountParams)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObjMark_GetName(request *requests.FPDFPageObjMark_GetName) (*responses.FPDFPageObjMark_GetName, error) {
+	return i.FPDFPageObjMark_GetNameWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObjMark_GetNameWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetName) (*responses.FPDFPageObjMark_GetName, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObjMark_GetName != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObjMark_GetName(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObjMark_GetName", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_GetName)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObjMark_GetParamBlobValue(request *requests.FPDFPageObjMark_GetParamBlobValue) (*responses.FPDFPageObjMark_GetParamBlobValue, error) {
+	return i.FPDFPageObjMark_GetParamBlobValueWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObjMark_GetParamBlobValueWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetParamBlobValue) (*responses.FPDFPageObjMark_GetParamBlobValue, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObjMark_GetParamBlobValue != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObjMark_GetParamBlobValue(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObjMark_GetParamBlobValue", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_GetParamBlobValue)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObjMark_GetParamFloatValue(request *requests.FPDFPageObjMark_GetParamFloatValue) (*responses.FPDFPageObjMark_GetParamFloatValue, error) {
+	return i.FPDFPageObjMark_GetParamFloatValueWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObjMark_GetParamFloatValueWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetParamFloatValue) (*responses.FPDFPageObjMark_GetParamFloatValue, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObjMark_GetParamFloatValue != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObjMark_GetParamFloatValue(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObjMark_GetParamFloatValue", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_GetParamFloatValue)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObjMark_GetParamIntValue(request *requests.FPDFPageObjMark_GetParamIntValue) (*responses.FPDFPageObjMark_GetParamIntValue, error) {
+	return i.FPDFPageObjMark_GetParamIntValueWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObjMark_GetParamIntValueWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetParamIntValue) (*responses.FPDFPageObjMark_GetParamIntValue, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObjMark_GetParamIntValue != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObjMark_GetParamIntValue(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObjMark_GetParamIntValue", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_GetParamIntValue)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObjMark_GetParamKey(request *requests.FPDFPageObjMark_GetParamKey) (*responses.FPDFPageObjMark_GetParamKey, error) {
+	return i.FPDFPageObjMark_GetParamKeyWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObjMark_GetParamKeyWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetParamKey) (*responses.FPDFPageObjMark_GetParamKey, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObjMark_GetParamKey != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObjMark_GetParamKey(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObjMark_GetParamKey", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_GetParamKey)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObjMark_GetParamStringValue(request *requests.FPDFPageObjMark_GetParamStringValue) (*responses.FPDFPageObjMark_GetParamStringValue, error) {
+	return i.FPDFPageObjMark_GetParamStringValueWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObjMark_GetParamStringValueWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetParamStringValue) (*responses.FPDFPageObjMark_GetParamStringValue, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObjMark_GetParamStringValue != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObjMark_GetParamStringValue(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObjMark_GetParamStringValue", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_GetParamStringValue)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObjMark_GetParamValueType(request *requests.FPDFPageObjMark_GetParamValueType) (*responses.FPDFPageObjMark_GetParamValueType, error) {
+	return i.FPDFPageObjMark_GetParamValueTypeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObjMark_GetParamValueTypeWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetParamValueType) (*responses.FPDFPageObjMark_GetParamValueType, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObjMark_GetParamValueType != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObjMark_GetParamValueType(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObjMark_GetParamValueType", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_GetParamValueType)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObjMark_RemoveParam(request *requests.FPDFPageObjMark_RemoveParam) (*responses.FPDFPageObjMark_RemoveParam, error) {
+	return i.FPDFPageObjMark_RemoveParamWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObjMark_RemoveParamWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_RemoveParam) (*responses.FPDFPageObjMark_RemoveParam, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObjMark_RemoveParam != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObjMark_RemoveParam(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObjMark_RemoveParam", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_RemoveParam)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObjMark_SetBlobParam(request *requests.FPDFPageObjMark_SetBlobParam) (*responses.FPDFPageObjMark_SetBlobParam, error) {
+	return i.FPDFPageObjMark_SetBlobParamWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObjMark_SetBlobParamWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_SetBlobParam) (*responses.FPDFPageObjMark_SetBlobParam, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObjMark_SetBlobParam != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObjMark_SetBlobParam(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObjMark_SetBlobParam", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_SetBlobParam)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObjMark_SetFloatParam(request *requests.FPDFPageObjMark_SetFloatParam) (*responses.FPDFPageObjMark_SetFloatParam, error) {
+	return i.FPDFPageObjMark_SetFloatParamWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObjMark_SetFloatParamWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_SetFloatParam) (*responses.FPDFPageObjMark_SetFloatParam, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObjMark_SetFloatParam != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObjMark_SetFloatParam(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObjMark_SetFloatParam", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_SetFloatParam)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObjMark_SetIntParam(request *requests.FPDFPageObjMark_SetIntParam) (*responses.FPDFPageObjMark_SetIntParam, error) {
+	return i.FPDFPageObjMark_SetIntParamWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObjMark_SetIntParamWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_SetIntParam) (*responses.FPDFPageObjMark_SetIntParam, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObjMark_SetIntParam != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObjMark_SetIntParam(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObjMark_SetIntParam", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_SetIntParam)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObjMark_SetStringParam(request *requests.FPDFPageObjMark_SetStringParam) (*responses.FPDFPageObjMark_SetStringParam, error) {
+	return i.FPDFPageObjMark_SetStringParamWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObjMark_SetStringParamWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_SetStringParam) (*responses.FPDFPageObjMark_SetStringParam, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObjMark_SetStringParam != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObjMark_SetStringParam(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObjMark_SetStringParam", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_SetStringParam)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_AddExistingMark(request *requests.FPDFPageObj_AddExistingMark) (*responses.FPDFPageObj_AddExistingMark, error) {
+	return i.FPDFPageObj_AddExistingMarkWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_AddExistingMarkWithContext(ctx goctx.Context, request *requests.FPDFPageObj_AddExistingMark) (*responses.FPDFPageObj_AddExistingMark, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_AddExistingMark != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_AddExistingMark(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_AddExistingMark", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_AddExistingMark)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_AddMark(request *requests.FPDFPageObj_AddMark) (*responses.FPDFPageObj_AddMark, error) {
+	return i.FPDFPageObj_AddMarkWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_AddMarkWithContext(ctx goctx.Context, request *requests.FPDFPageObj_AddMark) (*responses.FPDFPageObj_AddMark, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_AddMark != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_AddMark(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_AddMark", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_AddMark)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_CountMarks(request *requests.FPDFPageObj_CountMarks) (*responses.FPDFPageObj_CountMarks, error) {
+	return i.FPDFPageObj_CountMarksWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_CountMarksWithContext(ctx goctx.Context, request *requests.FPDFPageObj_CountMarks) (*responses.FPDFPageObj_CountMarks, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_CountMarks != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_CountMarks(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_CountMarks", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_CountMarks)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_CreateNewPath(request *requests.FPDFPageObj_CreateNewPath) (*responses.FPDFPageObj_CreateNewPath, error) {
+	return i.FPDFPageObj_CreateNewPathWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_CreateNewPathWithContext(ctx goctx.Context, request *requests.FPDFPageObj_CreateNewPath) (*responses.FPDFPageObj_CreateNewPath, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_CreateNewPath != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_CreateNewPath(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_CreateNewPath", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_CreateNewPath)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_CreateNewRect(request *requests.FPDFPageObj_CreateNewRect) (*responses.FPDFPageObj_CreateNewRect, error) {
+	return i.FPDFPageObj_CreateNewRectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_CreateNewRectWithContext(ctx goctx.Context, request *requests.FPDFPageObj_CreateNewRect) (*responses.FPDFPageObj_CreateNewRect, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_CreateNewRect != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_CreateNewRect(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_CreateNewRect", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_CreateNewRect)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_CreateTextObj(request *requests.FPDFPageObj_CreateTextObj) (*responses.FPDFPageObj_CreateTextObj, error) {
+	return i.FPDFPageObj_CreateTextObjWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_CreateTextObjWithContext(ctx goctx.Context, request *requests.FPDFPageObj_CreateTextObj) (*responses.FPDFPageObj_CreateTextObj, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_CreateTextObj != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_CreateTextObj(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_CreateTextObj", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_CreateTextObj)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_Destroy(request *requests.FPDFPageObj_Destroy) (*responses.FPDFPageObj_Destroy, error) {
+	return i.FPDFPageObj_DestroyWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_DestroyWithContext(ctx goctx.Context, request *requests.FPDFPageObj_Destroy) (*responses.FPDFPageObj_Destroy, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_Destroy != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_Destroy(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_Destroy", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_Destroy)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_GetBounds(request *requests.FPDFPageObj_GetBounds) (*responses.FPDFPageObj_GetBounds, error) {
+	return i.FPDFPageObj_GetBoundsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_GetBoundsWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetBounds) (*responses.FPDFPageObj_GetBounds, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_GetBounds != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_GetBounds(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_GetBounds", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetBounds)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_GetClipPath(request *requests.FPDFPageObj_GetClipPath) (*responses.FPDFPageObj_GetClipPath, error) {
+	return i.FPDFPageObj_GetClipPathWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_GetClipPathWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetClipPath) (*responses.FPDFPageObj_GetClipPath, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_GetClipPath != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_GetClipPath(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_GetClipPath", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetClipPath)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_GetDashArray(request *requests.FPDFPageObj_GetDashArray) (*responses.FPDFPageObj_GetDashArray, error) {
+	return i.FPDFPageObj_GetDashArrayWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_GetDashArrayWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetDashArray) (*responses.FPDFPageObj_GetDashArray, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_GetDashArray != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_GetDashArray(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_GetDashArray", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetDashArray)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_GetDashCount(request *requests.FPDFPageObj_GetDashCount) (*responses.FPDFPageObj_GetDashCount, error) {
+	return i.FPDFPageObj_GetDashCountWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_GetDashCountWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetDashCount) (*responses.FPDFPageObj_GetDashCount, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_GetDashCount != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_GetDashCount(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_GetDashCount", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetDashCount)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_GetDashPhase(request *requests.FPDFPageObj_GetDashPhase) (*responses.FPDFPageObj_GetDashPhase, error) {
+	return i.FPDFPageObj_GetDashPhaseWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_GetDashPhaseWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetDashPhase) (*responses.FPDFPageObj_GetDashPhase, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_GetDashPhase != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_GetDashPhase(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_GetDashPhase", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetDashPhase)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_GetFillColor(request *requests.FPDFPageObj_GetFillColor) (*responses.FPDFPageObj_GetFillColor, error) {
+	return i.FPDFPageObj_GetFillColorWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_GetFillColorWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetFillColor) (*responses.FPDFPageObj_GetFillColor, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_GetFillColor != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_GetFillColor(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_GetFillColor", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetFillColor)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_GetIsActive(request *requests.FPDFPageObj_GetIsActive) (*responses.FPDFPageObj_GetIsActive, error) {
+	return i.FPDFPageObj_GetIsActiveWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_GetIsActiveWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetIsActive) (*responses.FPDFPageObj_GetIsActive, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_GetIsActive != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_GetIsActive(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_GetIsActive", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetIsActive)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_GetLineCap(request *requests.FPDFPageObj_GetLineCap) (*responses.FPDFPageObj_GetLineCap, error) {
+	return i.FPDFPageObj_GetLineCapWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_GetLineCapWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetLineCap) (*responses.FPDFPageObj_GetLineCap, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_GetLineCap != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_GetLineCap(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_GetLineCap", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetLineCap)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_GetLineJoin(request *requests.FPDFPageObj_GetLineJoin) (*responses.FPDFPageObj_GetLineJoin, error) {
+	return i.FPDFPageObj_GetLineJoinWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_GetLineJoinWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetLineJoin) (*responses.FPDFPageObj_GetLineJoin, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_GetLineJoin != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_GetLineJoin(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_GetLineJoin", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetLineJoin)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_GetMark(request *requests.FPDFPageObj_GetMark) (*responses.FPDFPageObj_GetMark, error) {
+	return i.FPDFPageObj_GetMarkWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_GetMarkWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetMark) (*responses.FPDFPageObj_GetMark, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_GetMark != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_GetMark(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_GetMark", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetMark)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_GetMarkedContentID(request *requests.FPDFPageObj_GetMarkedContentID) (*responses.FPDFPageObj_GetMarkedContentID, error) {
+	return i.FPDFPageObj_GetMarkedContentIDWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_GetMarkedContentIDWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetMarkedContentID) (*responses.FPDFPageObj_GetMarkedContentID, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_GetMarkedContentID != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_GetMarkedContentID(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_GetMarkedContentID", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetMarkedContentID)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_GetMatrix(request *requests.FPDFPageObj_GetMatrix) (*responses.FPDFPageObj_GetMatrix, error) {
+	return i.FPDFPageObj_GetMatrixWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_GetMatrixWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetMatrix) (*responses.FPDFPageObj_GetMatrix, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_GetMatrix != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_GetMatrix(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_GetMatrix", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetMatrix)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_GetRotatedBounds(request *requests.FPDFPageObj_GetRotatedBounds) (*responses.FPDFPageObj_GetRotatedBounds, error) {
+	return i.FPDFPageObj_GetRotatedBoundsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_GetRotatedBoundsWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetRotatedBounds) (*responses.FPDFPageObj_GetRotatedBounds, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_GetRotatedBounds != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_GetRotatedBounds(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_GetRotatedBounds", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetRotatedBounds)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_GetStrokeColor(request *requests.FPDFPageObj_GetStrokeColor) (*responses.FPDFPageObj_GetStrokeColor, error) {
+	return i.FPDFPageObj_GetStrokeColorWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_GetStrokeColorWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetStrokeColor) (*responses.FPDFPageObj_GetStrokeColor, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_GetStrokeColor != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_GetStrokeColor(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_GetStrokeColor", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetStrokeColor)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_GetStrokeWidth(request *requests.FPDFPageObj_GetStrokeWidth) (*responses.FPDFPageObj_GetStrokeWidth, error) {
+	return i.FPDFPageObj_GetStrokeWidthWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_GetStrokeWidthWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetStrokeWidth) (*responses.FPDFPageObj_GetStrokeWidth, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_GetStrokeWidth != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_GetStrokeWidth(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_GetStrokeWidth", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetStrokeWidth)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_GetType(request *requests.FPDFPageObj_GetType) (*responses.FPDFPageObj_GetType, error) {
+	return i.FPDFPageObj_GetTypeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_GetTypeWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetType) (*responses.FPDFPageObj_GetType, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_GetType != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_GetType(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_GetType", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetType)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_HasTransparency(request *requests.FPDFPageObj_HasTransparency) (*responses.FPDFPageObj_HasTransparency, error) {
+	return i.FPDFPageObj_HasTransparencyWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_HasTransparencyWithContext(ctx goctx.Context, request *requests.FPDFPageObj_HasTransparency) (*responses.FPDFPageObj_HasTransparency, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_HasTransparency != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_HasTransparency(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_HasTransparency", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_HasTransparency)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_NewImageObj(request *requests.FPDFPageObj_NewImageObj) (*responses.FPDFPageObj_NewImageObj, error) {
+	return i.FPDFPageObj_NewImageObjWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_NewImageObjWithContext(ctx goctx.Context, request *requests.FPDFPageObj_NewImageObj) (*responses.FPDFPageObj_NewImageObj, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_NewImageObj != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_NewImageObj(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_NewImageObj", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_NewImageObj)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_NewTextObj(request *requests.FPDFPageObj_NewTextObj) (*responses.FPDFPageObj_NewTextObj, error) {
+	return i.FPDFPageObj_NewTextObjWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_NewTextObjWithContext(ctx goctx.Context, request *requests.FPDFPageObj_NewTextObj) (*responses.FPDFPageObj_NewTextObj, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_NewTextObj != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_NewTextObj(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_NewTextObj", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_NewTextObj)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_RemoveMark(request *requests.FPDFPageObj_RemoveMark) (*responses.FPDFPageObj_RemoveMark, error) {
+	return i.FPDFPageObj_RemoveMarkWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_RemoveMarkWithContext(ctx goctx.Context, request *requests.FPDFPageObj_RemoveMark) (*responses.FPDFPageObj_RemoveMark, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_RemoveMark != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_RemoveMark(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_RemoveMark", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_RemoveMark)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_SetBlendMode(request *requests.FPDFPageObj_SetBlendMode) (*responses.FPDFPageObj_SetBlendMode, error) {
+	return i.FPDFPageObj_SetBlendModeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_SetBlendModeWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetBlendMode) (*responses.FPDFPageObj_SetBlendMode, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_SetBlendMode != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_SetBlendMode(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_SetBlendMode", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetBlendMode)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_SetDashArray(request *requests.FPDFPageObj_SetDashArray) (*responses.FPDFPageObj_SetDashArray, error) {
+	return i.FPDFPageObj_SetDashArrayWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_SetDashArrayWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetDashArray) (*responses.FPDFPageObj_SetDashArray, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_SetDashArray != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_SetDashArray(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_SetDashArray", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetDashArray)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_SetDashPhase(request *requests.FPDFPageObj_SetDashPhase) (*responses.FPDFPageObj_SetDashPhase, error) {
+	return i.FPDFPageObj_SetDashPhaseWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_SetDashPhaseWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetDashPhase) (*responses.FPDFPageObj_SetDashPhase, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_SetDashPhase != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_SetDashPhase(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_SetDashPhase", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetDashPhase)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_SetFillColor(request *requests.FPDFPageObj_SetFillColor) (*responses.FPDFPageObj_SetFillColor, error) {
+	return i.FPDFPageObj_SetFillColorWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_SetFillColorWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetFillColor) (*responses.FPDFPageObj_SetFillColor, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_SetFillColor != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_SetFillColor(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_SetFillColor", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetFillColor)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_SetIsActive(request *requests.FPDFPageObj_SetIsActive) (*responses.FPDFPageObj_SetIsActive, error) {
+	return i.FPDFPageObj_SetIsActiveWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_SetIsActiveWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetIsActive) (*responses.FPDFPageObj_SetIsActive, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_SetIsActive != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_SetIsActive(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_SetIsActive", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetIsActive)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_SetLineCap(request *requests.FPDFPageObj_SetLineCap) (*responses.FPDFPageObj_SetLineCap, error) {
+	return i.FPDFPageObj_SetLineCapWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_SetLineCapWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetLineCap) (*responses.FPDFPageObj_SetLineCap, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_SetLineCap != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_SetLineCap(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_SetLineCap", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetLineCap)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_SetLineJoin(request *requests.FPDFPageObj_SetLineJoin) (*responses.FPDFPageObj_SetLineJoin, error) {
+	return i.FPDFPageObj_SetLineJoinWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_SetLineJoinWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetLineJoin) (*responses.FPDFPageObj_SetLineJoin, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_SetLineJoin != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_SetLineJoin(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_SetLineJoin", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetLineJoin)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_SetMatrix(request *requests.FPDFPageObj_SetMatrix) (*responses.FPDFPageObj_SetMatrix, error) {
+	return i.FPDFPageObj_SetMatrixWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_SetMatrixWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetMatrix) (*responses.FPDFPageObj_SetMatrix, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_SetMatrix != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_SetMatrix(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_SetMatrix", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetMatrix)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_SetStrokeColor(request *requests.FPDFPageObj_SetStrokeColor) (*responses.FPDFPageObj_SetStrokeColor, error) {
+	return i.FPDFPageObj_SetStrokeColorWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_SetStrokeColorWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetStrokeColor) (*responses.FPDFPageObj_SetStrokeColor, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_SetStrokeColor != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_SetStrokeColor(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_SetStrokeColor", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetStrokeColor)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_SetStrokeWidth(request *requests.FPDFPageObj_SetStrokeWidth) (*responses.FPDFPageObj_SetStrokeWidth, error) {
+	return i.FPDFPageObj_SetStrokeWidthWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_SetStrokeWidthWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetStrokeWidth) (*responses.FPDFPageObj_SetStrokeWidth, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_SetStrokeWidth != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_SetStrokeWidth(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_SetStrokeWidth", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetStrokeWidth)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_Transform(request *requests.FPDFPageObj_Transform) (*responses.FPDFPageObj_Transform, error) {
+	return i.FPDFPageObj_TransformWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_TransformWithContext(ctx goctx.Context, request *requests.FPDFPageObj_Transform) (*responses.FPDFPageObj_Transform, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_Transform != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_Transform(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_Transform", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_Transform)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_TransformClipPath(request *requests.FPDFPageObj_TransformClipPath) (*responses.FPDFPageObj_TransformClipPath, error) {
+	return i.FPDFPageObj_TransformClipPathWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_TransformClipPathWithContext(ctx goctx.Context, request *requests.FPDFPageObj_TransformClipPath) (*responses.FPDFPageObj_TransformClipPath, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_TransformClipPath != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_TransformClipPath(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_TransformClipPath", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_TransformClipPath)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPageObj_TransformF(request *requests.FPDFPageObj_TransformF) (*responses.FPDFPageObj_TransformF, error) {
+	return i.FPDFPageObj_TransformFWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPageObj_TransformFWithContext(ctx goctx.Context, request *requests.FPDFPageObj_TransformF) (*responses.FPDFPageObj_TransformF, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPageObj_TransformF != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPageObj_TransformF(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPageObj_TransformF", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPageObj_TransformF)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_CloseAnnot(request *requests.FPDFPage_CloseAnnot) (*responses.FPDFPage_CloseAnnot, error) {
+	return i.FPDFPage_CloseAnnotWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_CloseAnnotWithContext(ctx goctx.Context, request *requests.FPDFPage_CloseAnnot) (*responses.FPDFPage_CloseAnnot, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_CloseAnnot != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_CloseAnnot(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_CloseAnnot", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_CloseAnnot)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_CountObjects(request *requests.FPDFPage_CountObjects) (*responses.FPDFPage_CountObjects, error) {
+	return i.FPDFPage_CountObjectsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_CountObjectsWithContext(ctx goctx.Context, request *requests.FPDFPage_CountObjects) (*responses.FPDFPage_CountObjects, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_CountObjects != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_CountObjects(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_CountObjects", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_CountObjects)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_CreateAnnot(request *requests.FPDFPage_CreateAnnot) (*responses.FPDFPage_CreateAnnot, error) {
+	return i.FPDFPage_CreateAnnotWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_CreateAnnotWithContext(ctx goctx.Context, request *requests.FPDFPage_CreateAnnot) (*responses.FPDFPage_CreateAnnot, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_CreateAnnot != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_CreateAnnot(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_CreateAnnot", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_CreateAnnot)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_Delete(request *requests.FPDFPage_Delete) (*responses.FPDFPage_Delete, error) {
+	return i.FPDFPage_DeleteWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_DeleteWithContext(ctx goctx.Context, request *requests.FPDFPage_Delete) (*responses.FPDFPage_Delete, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_Delete != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_Delete(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_Delete", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_Delete)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_Flatten(request *requests.FPDFPage_Flatten) (*responses.FPDFPage_Flatten, error) {
+	return i.FPDFPage_FlattenWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_FlattenWithContext(ctx goctx.Context, request *requests.FPDFPage_Flatten) (*responses.FPDFPage_Flatten, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_Flatten != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_Flatten(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_Flatten", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_Flatten)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_FormFieldZOrderAtPoint(request *requests.FPDFPage_FormFieldZOrderAtPoint) (*responses.FPDFPage_FormFieldZOrderAtPoint, error) {
+	return i.FPDFPage_FormFieldZOrderAtPointWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_FormFieldZOrderAtPointWithContext(ctx goctx.Context, request *requests.FPDFPage_FormFieldZOrderAtPoint) (*responses.FPDFPage_FormFieldZOrderAtPoint, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_FormFieldZOrderAtPoint != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_FormFieldZOrderAtPoint(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_FormFieldZOrderAtPoint", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_FormFieldZOrderAtPoint)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_GenerateContent(request *requests.FPDFPage_GenerateContent) (*responses.FPDFPage_GenerateContent, error) {
+	return i.FPDFPage_GenerateContentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_GenerateContentWithContext(ctx goctx.Context, request *requests.FPDFPage_GenerateContent) (*responses.FPDFPage_GenerateContent, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_GenerateContent != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_GenerateContent(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_GenerateContent", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_GenerateContent)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_GetAnnot(request *requests.FPDFPage_GetAnnot) (*responses.FPDFPage_GetAnnot, error) {
+	return i.FPDFPage_GetAnnotWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_GetAnnotWithContext(ctx goctx.Context, request *requests.FPDFPage_GetAnnot) (*responses.FPDFPage_GetAnnot, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_GetAnnot != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_GetAnnot(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_GetAnnot", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_GetAnnot)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_GetAnnotCount(request *requests.FPDFPage_GetAnnotCount) (*responses.FPDFPage_GetAnnotCount, error) {
+	return i.FPDFPage_GetAnnotCountWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_GetAnnotCountWithContext(ctx goctx.Context, request *requests.FPDFPage_GetAnnotCount) (*responses.FPDFPage_GetAnnotCount, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_GetAnnotCount != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_GetAnnotCount(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_GetAnnotCount", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_GetAnnotCount)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_GetAnnotIndex(request *requests.FPDFPage_GetAnnotIndex) (*responses.FPDFPage_GetAnnotIndex, error) {
+	return i.FPDFPage_GetAnnotIndexWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_GetAnnotIndexWithContext(ctx goctx.Context, request *requests.FPDFPage_GetAnnotIndex) (*responses.FPDFPage_GetAnnotIndex, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_GetAnnotIndex != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_GetAnnotIndex(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_GetAnnotIndex", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_GetAnnotIndex)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_GetArtBox(request *requests.FPDFPage_GetArtBox) (*responses.FPDFPage_GetArtBox, error) {
+	return i.FPDFPage_GetArtBoxWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_GetArtBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_GetArtBox) (*responses.FPDFPage_GetArtBox, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_GetArtBox != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_GetArtBox(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_GetArtBox", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_GetArtBox)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_GetBleedBox(request *requests.FPDFPage_GetBleedBox) (*responses.FPDFPage_GetBleedBox, error) {
+	return i.FPDFPage_GetBleedBoxWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_GetBleedBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_GetBleedBox) (*responses.FPDFPage_GetBleedBox, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_GetBleedBox != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_GetBleedBox(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_GetBleedBox", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_GetBleedBox)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_GetCropBox(request *requests.FPDFPage_GetCropBox) (*responses.FPDFPage_GetCropBox, error) {
+	return i.FPDFPage_GetCropBoxWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_GetCropBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_GetCropBox) (*responses.FPDFPage_GetCropBox, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_GetCropBox != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_GetCropBox(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_GetCropBox", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_GetCropBox)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_GetDecodedThumbnailData(request *requests.FPDFPage_GetDecodedThumbnailData) (*responses.FPDFPage_GetDecodedThumbnailData, error) {
+	return i.FPDFPage_GetDecodedThumbnailDataWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_GetDecodedThumbnailDataWithContext(ctx goctx.Context, request *requests.FPDFPage_GetDecodedThumbnailData) (*responses.FPDFPage_GetDecodedThumbnailData, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_GetDecodedThumbnailData != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_GetDecodedThumbnailData(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_GetDecodedThumbnailData", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_GetDecodedThumbnailData)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_GetMediaBox(request *requests.FPDFPage_GetMediaBox) (*responses.FPDFPage_GetMediaBox, error) {
+	return i.FPDFPage_GetMediaBoxWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_GetMediaBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_GetMediaBox) (*responses.FPDFPage_GetMediaBox, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_GetMediaBox != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_GetMediaBox(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_GetMediaBox", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_GetMediaBox)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_GetObject(request *requests.FPDFPage_GetObject) (*responses.FPDFPage_GetObject, error) {
+	return i.FPDFPage_GetObjectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_GetObjectWithContext(ctx goctx.Context, request *requests.FPDFPage_GetObject) (*responses.FPDFPage_GetObject, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_GetObject != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_GetObject(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_GetObject", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_GetObject)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_GetRawThumbnailData(request *requests.FPDFPage_GetRawThumbnailData) (*responses.FPDFPage_GetRawThumbnailData, error) {
+	return i.FPDFPage_GetRawThumbnailDataWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_GetRawThumbnailDataWithContext(ctx goctx.Context, request *requests.FPDFPage_GetRawThumbnailData) (*responses.FPDFPage_GetRawThumbnailData, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_GetRawThumbnailData != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_GetRawThumbnailData(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_GetRawThumbnailData", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_GetRawThumbnailData)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_GetRotation(request *requests.FPDFPage_GetRotation) (*responses.FPDFPage_GetRotation, error) {
+	return i.FPDFPage_GetRotationWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_GetRotationWithContext(ctx goctx.Context, request *requests.FPDFPage_GetRotation) (*responses.FPDFPage_GetRotation, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_GetRotation != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_GetRotation(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_GetRotation", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_GetRotation)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_GetThumbnailAsBitmap(request *requests.FPDFPage_GetThumbnailAsBitmap) (*responses.FPDFPage_GetThumbnailAsBitmap, error) {
+	return i.FPDFPage_GetThumbnailAsBitmapWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_GetThumbnailAsBitmapWithContext(ctx goctx.Context, request *requests.FPDFPage_GetThumbnailAsBitmap) (*responses.FPDFPage_GetThumbnailAsBitmap, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_GetThumbnailAsBitmap != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_GetThumbnailAsBitmap(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_GetThumbnailAsBitmap", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_GetThumbnailAsBitmap)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_GetTrimBox(request *requests.FPDFPage_GetTrimBox) (*responses.FPDFPage_GetTrimBox, error) {
+	return i.FPDFPage_GetTrimBoxWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_GetTrimBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_GetTrimBox) (*responses.FPDFPage_GetTrimBox, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_GetTrimBox != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_GetTrimBox(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_GetTrimBox", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_GetTrimBox)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_HasFormFieldAtPoint(request *requests.FPDFPage_HasFormFieldAtPoint) (*responses.FPDFPage_HasFormFieldAtPoint, error) {
+	return i.FPDFPage_HasFormFieldAtPointWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_HasFormFieldAtPointWithContext(ctx goctx.Context, request *requests.FPDFPage_HasFormFieldAtPoint) (*responses.FPDFPage_HasFormFieldAtPoint, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_HasFormFieldAtPoint != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_HasFormFieldAtPoint(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_HasFormFieldAtPoint", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_HasFormFieldAtPoint)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_HasTransparency(request *requests.FPDFPage_HasTransparency) (*responses.FPDFPage_HasTransparency, error) {
+	return i.FPDFPage_HasTransparencyWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_HasTransparencyWithContext(ctx goctx.Context, request *requests.FPDFPage_HasTransparency) (*responses.FPDFPage_HasTransparency, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_HasTransparency != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_HasTransparency(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_HasTransparency", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_HasTransparency)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_InsertClipPath(request *requests.FPDFPage_InsertClipPath) (*responses.FPDFPage_InsertClipPath, error) {
+	return i.FPDFPage_InsertClipPathWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_InsertClipPathWithContext(ctx goctx.Context, request *requests.FPDFPage_InsertClipPath) (*responses.FPDFPage_InsertClipPath, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_InsertClipPath != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_InsertClipPath(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_InsertClipPath", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_InsertClipPath)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_InsertObject(request *requests.FPDFPage_InsertObject) (*responses.FPDFPage_InsertObject, error) {
+	return i.FPDFPage_InsertObjectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_InsertObjectWithContext(ctx goctx.Context, request *requests.FPDFPage_InsertObject) (*responses.FPDFPage_InsertObject, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_InsertObject != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_InsertObject(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_InsertObject", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_InsertObject)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_InsertObjectAtIndex(request *requests.FPDFPage_InsertObjectAtIndex) (*responses.FPDFPage_InsertObjectAtIndex, error) {
+	return i.FPDFPage_InsertObjectAtIndexWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_InsertObjectAtIndexWithContext(ctx goctx.Context, request *requests.FPDFPage_InsertObjectAtIndex) (*responses.FPDFPage_InsertObjectAtIndex, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_InsertObjectAtIndex != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_InsertObjectAtIndex(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_InsertObjectAtIndex", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_InsertObjectAtIndex)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_New(request *requests.FPDFPage_New) (*responses.FPDFPage_New, error) {
+	return i.FPDFPage_NewWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_NewWithContext(ctx goctx.Context, request *requests.FPDFPage_New) (*responses.FPDFPage_New, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_New != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_New(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_New", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_New)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_RemoveAnnot(request *requests.FPDFPage_RemoveAnnot) (*responses.FPDFPage_RemoveAnnot, error) {
+	return i.FPDFPage_RemoveAnnotWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_RemoveAnnotWithContext(ctx goctx.Context, request *requests.FPDFPage_RemoveAnnot) (*responses.FPDFPage_RemoveAnnot, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_RemoveAnnot != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_RemoveAnnot(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_RemoveAnnot", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_RemoveAnnot)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_RemoveObject(request *requests.FPDFPage_RemoveObject) (*responses.FPDFPage_RemoveObject, error) {
+	return i.FPDFPage_RemoveObjectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_RemoveObjectWithContext(ctx goctx.Context, request *requests.FPDFPage_RemoveObject) (*responses.FPDFPage_RemoveObject, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_RemoveObject != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_RemoveObject(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_RemoveObject", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_RemoveObject)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_SetArtBox(request *requests.FPDFPage_SetArtBox) (*responses.FPDFPage_SetArtBox, error) {
+	return i.FPDFPage_SetArtBoxWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_SetArtBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_SetArtBox) (*responses.FPDFPage_SetArtBox, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_SetArtBox != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_SetArtBox(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_SetArtBox", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_SetArtBox)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_SetBleedBox(request *requests.FPDFPage_SetBleedBox) (*responses.FPDFPage_SetBleedBox, error) {
+	return i.FPDFPage_SetBleedBoxWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_SetBleedBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_SetBleedBox) (*responses.FPDFPage_SetBleedBox, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_SetBleedBox != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_SetBleedBox(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_SetBleedBox", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_SetBleedBox)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_SetCropBox(request *requests.FPDFPage_SetCropBox) (*responses.FPDFPage_SetCropBox, error) {
+	return i.FPDFPage_SetCropBoxWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_SetCropBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_SetCropBox) (*responses.FPDFPage_SetCropBox, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_SetCropBox != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_SetCropBox(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_SetCropBox", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_SetCropBox)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_SetMediaBox(request *requests.FPDFPage_SetMediaBox) (*responses.FPDFPage_SetMediaBox, error) {
+	return i.FPDFPage_SetMediaBoxWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_SetMediaBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_SetMediaBox) (*responses.FPDFPage_SetMediaBox, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_SetMediaBox != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_SetMediaBox(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_SetMediaBox", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_SetMediaBox)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_SetRotation(request *requests.FPDFPage_SetRotation) (*responses.FPDFPage_SetRotation, error) {
+	return i.FPDFPage_SetRotationWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_SetRotationWithContext(ctx goctx.Context, request *requests.FPDFPage_SetRotation) (*responses.FPDFPage_SetRotation, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_SetRotation != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_SetRotation(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_SetRotation", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_SetRotation)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_SetTrimBox(request *requests.FPDFPage_SetTrimBox) (*responses.FPDFPage_SetTrimBox, error) {
+	return i.FPDFPage_SetTrimBoxWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_SetTrimBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_SetTrimBox) (*responses.FPDFPage_SetTrimBox, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_SetTrimBox != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_SetTrimBox(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_SetTrimBox", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_SetTrimBox)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_TransFormWithClip(request *requests.FPDFPage_TransFormWithClip) (*responses.FPDFPage_TransFormWithClip, error) {
+	return i.FPDFPage_TransFormWithClipWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_TransFormWithClipWithContext(ctx goctx.Context, request *requests.FPDFPage_TransFormWithClip) (*responses.FPDFPage_TransFormWithClip, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_TransFormWithClip != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_TransFormWithClip(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_TransFormWithClip", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_TransFormWithClip)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPage_TransformAnnots(request *requests.FPDFPage_TransformAnnots) (*responses.FPDFPage_TransformAnnots, error) {
+	return i.FPDFPage_TransformAnnotsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPage_TransformAnnotsWithContext(ctx goctx.Context, request *requests.FPDFPage_TransformAnnots) (*responses.FPDFPage_TransformAnnots, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPage_TransformAnnots != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPage_TransformAnnots(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPage_TransformAnnots", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPage_TransformAnnots)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPathSegment_GetClose(request *requests.FPDFPathSegment_GetClose) (*responses.FPDFPathSegment_GetClose, error) {
+	return i.FPDFPathSegment_GetCloseWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPathSegment_GetCloseWithContext(ctx goctx.Context, request *requests.FPDFPathSegment_GetClose) (*responses.FPDFPathSegment_GetClose, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPathSegment_GetClose != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPathSegment_GetClose(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPathSegment_GetClose", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPathSegment_GetClose)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPathSegment_GetPoint(request *requests.FPDFPathSegment_GetPoint) (*responses.FPDFPathSegment_GetPoint, error) {
+	return i.FPDFPathSegment_GetPointWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPathSegment_GetPointWithContext(ctx goctx.Context, request *requests.FPDFPathSegment_GetPoint) (*responses.FPDFPathSegment_GetPoint, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPathSegment_GetPoint != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPathSegment_GetPoint(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPathSegment_GetPoint", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPathSegment_GetPoint)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPathSegment_GetType(request *requests.FPDFPathSegment_GetType) (*responses.FPDFPathSegment_GetType, error) {
+	return i.FPDFPathSegment_GetTypeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPathSegment_GetTypeWithContext(ctx goctx.Context, request *requests.FPDFPathSegment_GetType) (*responses.FPDFPathSegment_GetType, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPathSegment_GetType != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPathSegment_GetType(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPathSegment_GetType", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPathSegment_GetType)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPath_BezierTo(request *requests.FPDFPath_BezierTo) (*responses.FPDFPath_BezierTo, error) {
+	return i.FPDFPath_BezierToWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPath_BezierToWithContext(ctx goctx.Context, request *requests.FPDFPath_BezierTo) (*responses.FPDFPath_BezierTo, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPath_BezierTo != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPath_BezierTo(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPath_BezierTo", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPath_BezierTo)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPath_Close(request *requests.FPDFPath_Close) (*responses.FPDFPath_Close, error) {
+	return i.FPDFPath_CloseWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPath_CloseWithContext(ctx goctx.Context, request *requests.FPDFPath_Close) (*responses.FPDFPath_Close, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPath_Close != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPath_Close(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPath_Close", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPath_Close)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPath_CountSegments(request *requests.FPDFPath_CountSegments) (*responses.FPDFPath_CountSegments, error) {
+	return i.FPDFPath_CountSegmentsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPath_CountSegmentsWithContext(ctx goctx.Context, request *requests.FPDFPath_CountSegments) (*responses.FPDFPath_CountSegments, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPath_CountSegments != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPath_CountSegments(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPath_CountSegments", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPath_CountSegments)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPath_GetDrawMode(request *requests.FPDFPath_GetDrawMode) (*responses.FPDFPath_GetDrawMode, error) {
+	return i.FPDFPath_GetDrawModeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPath_GetDrawModeWithContext(ctx goctx.Context, request *requests.FPDFPath_GetDrawMode) (*responses.FPDFPath_GetDrawMode, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPath_GetDrawMode != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPath_GetDrawMode(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPath_GetDrawMode", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPath_GetDrawMode)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPath_GetPathSegment(request *requests.FPDFPath_GetPathSegment) (*responses.FPDFPath_GetPathSegment, error) {
+	return i.FPDFPath_GetPathSegmentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPath_GetPathSegmentWithContext(ctx goctx.Context, request *requests.FPDFPath_GetPathSegment) (*responses.FPDFPath_GetPathSegment, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPath_GetPathSegment != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPath_GetPathSegment(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPath_GetPathSegment", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPath_GetPathSegment)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPath_LineTo(request *requests.FPDFPath_LineTo) (*responses.FPDFPath_LineTo, error) {
+	return i.FPDFPath_LineToWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPath_LineToWithContext(ctx goctx.Context, request *requests.FPDFPath_LineTo) (*responses.FPDFPath_LineTo, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPath_LineTo != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPath_LineTo(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPath_LineTo", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPath_LineTo)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPath_MoveTo(request *requests.FPDFPath_MoveTo) (*responses.FPDFPath_MoveTo, error) {
+	return i.FPDFPath_MoveToWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPath_MoveToWithContext(ctx goctx.Context, request *requests.FPDFPath_MoveTo) (*responses.FPDFPath_MoveTo, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPath_MoveTo != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPath_MoveTo(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPath_MoveTo", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPath_MoveTo)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFPath_SetDrawMode(request *requests.FPDFPath_SetDrawMode) (*responses.FPDFPath_SetDrawMode, error) {
+	return i.FPDFPath_SetDrawModeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFPath_SetDrawModeWithContext(ctx goctx.Context, request *requests.FPDFPath_SetDrawMode) (*responses.FPDFPath_SetDrawMode, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFPath_SetDrawMode != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFPath_SetDrawMode(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFPath_SetDrawMode", request, stub)
+	typedResp, _ := resp.(*responses.FPDFPath_SetDrawMode)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFSignatureObj_GetByteRange(request *requests.FPDFSignatureObj_GetByteRange) (*responses.FPDFSignatureObj_GetByteRange, error) {
+	return i.FPDFSignatureObj_GetByteRangeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFSignatureObj_GetByteRangeWithContext(ctx goctx.Context, request *requests.FPDFSignatureObj_GetByteRange) (*responses.FPDFSignatureObj_GetByteRange, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFSignatureObj_GetByteRange != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFSignatureObj_GetByteRange(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFSignatureObj_GetByteRange", request, stub)
+	typedResp, _ := resp.(*responses.FPDFSignatureObj_GetByteRange)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFSignatureObj_GetContents(request *requests.FPDFSignatureObj_GetContents) (*responses.FPDFSignatureObj_GetContents, error) {
+	return i.FPDFSignatureObj_GetContentsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFSignatureObj_GetContentsWithContext(ctx goctx.Context, request *requests.FPDFSignatureObj_GetContents) (*responses.FPDFSignatureObj_GetContents, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFSignatureObj_GetContents != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFSignatureObj_GetContents(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFSignatureObj_GetContents", request, stub)
+	typedResp, _ := resp.(*responses.FPDFSignatureObj_GetContents)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFSignatureObj_GetDocMDPPermission(request *requests.FPDFSignatureObj_GetDocMDPPermission) (*responses.FPDFSignatureObj_GetDocMDPPermission, error) {
+	return i.FPDFSignatureObj_GetDocMDPPermissionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFSignatureObj_GetDocMDPPermissionWithContext(ctx goctx.Context, request *requests.FPDFSignatureObj_GetDocMDPPermission) (*responses.FPDFSignatureObj_GetDocMDPPermission, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFSignatureObj_GetDocMDPPermission != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFSignatureObj_GetDocMDPPermission(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFSignatureObj_GetDocMDPPermission", request, stub)
+	typedResp, _ := resp.(*responses.FPDFSignatureObj_GetDocMDPPermission)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFSignatureObj_GetReason(request *requests.FPDFSignatureObj_GetReason) (*responses.FPDFSignatureObj_GetReason, error) {
+	return i.FPDFSignatureObj_GetReasonWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFSignatureObj_GetReasonWithContext(ctx goctx.Context, request *requests.FPDFSignatureObj_GetReason) (*responses.FPDFSignatureObj_GetReason, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFSignatureObj_GetReason != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFSignatureObj_GetReason(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFSignatureObj_GetReason", request, stub)
+	typedResp, _ := resp.(*responses.FPDFSignatureObj_GetReason)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFSignatureObj_GetSubFilter(request *requests.FPDFSignatureObj_GetSubFilter) (*responses.FPDFSignatureObj_GetSubFilter, error) {
+	return i.FPDFSignatureObj_GetSubFilterWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFSignatureObj_GetSubFilterWithContext(ctx goctx.Context, request *requests.FPDFSignatureObj_GetSubFilter) (*responses.FPDFSignatureObj_GetSubFilter, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFSignatureObj_GetSubFilter != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFSignatureObj_GetSubFilter(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFSignatureObj_GetSubFilter", request, stub)
+	typedResp, _ := resp.(*responses.FPDFSignatureObj_GetSubFilter)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFSignatureObj_GetTime(request *requests.FPDFSignatureObj_GetTime) (*responses.FPDFSignatureObj_GetTime, error) {
+	return i.FPDFSignatureObj_GetTimeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFSignatureObj_GetTimeWithContext(ctx goctx.Context, request *requests.FPDFSignatureObj_GetTime) (*responses.FPDFSignatureObj_GetTime, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFSignatureObj_GetTime != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFSignatureObj_GetTime(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFSignatureObj_GetTime", request, stub)
+	typedResp, _ := resp.(*responses.FPDFSignatureObj_GetTime)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFTextObj_GetFont(request *requests.FPDFTextObj_GetFont) (*responses.FPDFTextObj_GetFont, error) {
+	return i.FPDFTextObj_GetFontWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFTextObj_GetFontWithContext(ctx goctx.Context, request *requests.FPDFTextObj_GetFont) (*responses.FPDFTextObj_GetFont, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFTextObj_GetFont != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFTextObj_GetFont(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFTextObj_GetFont", request, stub)
+	typedResp, _ := resp.(*responses.FPDFTextObj_GetFont)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFTextObj_GetFontSize(request *requests.FPDFTextObj_GetFontSize) (*responses.FPDFTextObj_GetFontSize, error) {
+	return i.FPDFTextObj_GetFontSizeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFTextObj_GetFontSizeWithContext(ctx goctx.Context, request *requests.FPDFTextObj_GetFontSize) (*responses.FPDFTextObj_GetFontSize, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFTextObj_GetFontSize != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFTextObj_GetFontSize(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFTextObj_GetFontSize", request, stub)
+	typedResp, _ := resp.(*responses.FPDFTextObj_GetFontSize)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFTextObj_GetRenderedBitmap(request *requests.FPDFTextObj_GetRenderedBitmap) (*responses.FPDFTextObj_GetRenderedBitmap, error) {
+	return i.FPDFTextObj_GetRenderedBitmapWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFTextObj_GetRenderedBitmapWithContext(ctx goctx.Context, request *requests.FPDFTextObj_GetRenderedBitmap) (*responses.FPDFTextObj_GetRenderedBitmap, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFTextObj_GetRenderedBitmap != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFTextObj_GetRenderedBitmap(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFTextObj_GetRenderedBitmap", request, stub)
+	typedResp, _ := resp.(*responses.FPDFTextObj_GetRenderedBitmap)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFTextObj_GetText(request *requests.FPDFTextObj_GetText) (*responses.FPDFTextObj_GetText, error) {
+	return i.FPDFTextObj_GetTextWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFTextObj_GetTextWithContext(ctx goctx.Context, request *requests.FPDFTextObj_GetText) (*responses.FPDFTextObj_GetText, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFTextObj_GetText != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFTextObj_GetText(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFTextObj_GetText", request, stub)
+	typedResp, _ := resp.(*responses.FPDFTextObj_GetText)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFTextObj_GetTextRenderMode(request *requests.FPDFTextObj_GetTextRenderMode) (*responses.FPDFTextObj_GetTextRenderMode, error) {
+	return i.FPDFTextObj_GetTextRenderModeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFTextObj_GetTextRenderModeWithContext(ctx goctx.Context, request *requests.FPDFTextObj_GetTextRenderMode) (*responses.FPDFTextObj_GetTextRenderMode, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFTextObj_GetTextRenderMode != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFTextObj_GetTextRenderMode(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFTextObj_GetTextRenderMode", request, stub)
+	typedResp, _ := resp.(*responses.FPDFTextObj_GetTextRenderMode)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFTextObj_SetFontSize(request *requests.FPDFTextObj_SetFontSize) (*responses.FPDFTextObj_SetFontSize, error) {
+	return i.FPDFTextObj_SetFontSizeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFTextObj_SetFontSizeWithContext(ctx goctx.Context, request *requests.FPDFTextObj_SetFontSize) (*responses.FPDFTextObj_SetFontSize, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFTextObj_SetFontSize != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFTextObj_SetFontSize(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFTextObj_SetFontSize", request, stub)
+	typedResp, _ := resp.(*responses.FPDFTextObj_SetFontSize)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFTextObj_SetTextRenderMode(request *requests.FPDFTextObj_SetTextRenderMode) (*responses.FPDFTextObj_SetTextRenderMode, error) {
+	return i.FPDFTextObj_SetTextRenderModeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFTextObj_SetTextRenderModeWithContext(ctx goctx.Context, request *requests.FPDFTextObj_SetTextRenderMode) (*responses.FPDFTextObj_SetTextRenderMode, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFTextObj_SetTextRenderMode != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFTextObj_SetTextRenderMode(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFTextObj_SetTextRenderMode", request, stub)
+	typedResp, _ := resp.(*responses.FPDFTextObj_SetTextRenderMode)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_ClosePage(request *requests.FPDFText_ClosePage) (*responses.FPDFText_ClosePage, error) {
+	return i.FPDFText_ClosePageWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_ClosePageWithContext(ctx goctx.Context, request *requests.FPDFText_ClosePage) (*responses.FPDFText_ClosePage, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_ClosePage != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_ClosePage(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_ClosePage", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_ClosePage)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_CountChars(request *requests.FPDFText_CountChars) (*responses.FPDFText_CountChars, error) {
+	return i.FPDFText_CountCharsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_CountCharsWithContext(ctx goctx.Context, request *requests.FPDFText_CountChars) (*responses.FPDFText_CountChars, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_CountChars != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_CountChars(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_CountChars", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_CountChars)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_CountRects(request *requests.FPDFText_CountRects) (*responses.FPDFText_CountRects, error) {
+	return i.FPDFText_CountRectsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_CountRectsWithContext(ctx goctx.Context, request *requests.FPDFText_CountRects) (*responses.FPDFText_CountRects, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_CountRects != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_CountRects(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_CountRects", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_CountRects)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_FindClose(request *requests.FPDFText_FindClose) (*responses.FPDFText_FindClose, error) {
+	return i.FPDFText_FindCloseWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_FindCloseWithContext(ctx goctx.Context, request *requests.FPDFText_FindClose) (*responses.FPDFText_FindClose, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_FindClose != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_FindClose(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_FindClose", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_FindClose)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_FindNext(request *requests.FPDFText_FindNext) (*responses.FPDFText_FindNext, error) {
+	return i.FPDFText_FindNextWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_FindNextWithContext(ctx goctx.Context, request *requests.FPDFText_FindNext) (*responses.FPDFText_FindNext, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_FindNext != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_FindNext(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_FindNext", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_FindNext)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_FindPrev(request *requests.FPDFText_FindPrev) (*responses.FPDFText_FindPrev, error) {
+	return i.FPDFText_FindPrevWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_FindPrevWithContext(ctx goctx.Context, request *requests.FPDFText_FindPrev) (*responses.FPDFText_FindPrev, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_FindPrev != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_FindPrev(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_FindPrev", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_FindPrev)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_FindStart(request *requests.FPDFText_FindStart) (*responses.FPDFText_FindStart, error) {
+	return i.FPDFText_FindStartWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_FindStartWithContext(ctx goctx.Context, request *requests.FPDFText_FindStart) (*responses.FPDFText_FindStart, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_FindStart != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_FindStart(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_FindStart", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_FindStart)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetBoundedText(request *requests.FPDFText_GetBoundedText) (*responses.FPDFText_GetBoundedText, error) {
+	return i.FPDFText_GetBoundedTextWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetBoundedTextWithContext(ctx goctx.Context, request *requests.FPDFText_GetBoundedText) (*responses.FPDFText_GetBoundedText, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetBoundedText != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetBoundedText(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetBoundedText", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetBoundedText)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetCharAngle(request *requests.FPDFText_GetCharAngle) (*responses.FPDFText_GetCharAngle, error) {
+	return i.FPDFText_GetCharAngleWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetCharAngleWithContext(ctx goctx.Context, request *requests.FPDFText_GetCharAngle) (*responses.FPDFText_GetCharAngle, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetCharAngle != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetCharAngle(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetCharAngle", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetCharAngle)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetCharBox(request *requests.FPDFText_GetCharBox) (*responses.FPDFText_GetCharBox, error) {
+	return i.FPDFText_GetCharBoxWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetCharBoxWithContext(ctx goctx.Context, request *requests.FPDFText_GetCharBox) (*responses.FPDFText_GetCharBox, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetCharBox != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetCharBox(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetCharBox", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetCharBox)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetCharIndexAtPos(request *requests.FPDFText_GetCharIndexAtPos) (*responses.FPDFText_GetCharIndexAtPos, error) {
+	return i.FPDFText_GetCharIndexAtPosWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetCharIndexAtPosWithContext(ctx goctx.Context, request *requests.FPDFText_GetCharIndexAtPos) (*responses.FPDFText_GetCharIndexAtPos, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetCharIndexAtPos != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetCharIndexAtPos(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetCharIndexAtPos", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetCharIndexAtPos)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetCharIndexFromTextIndex(request *requests.FPDFText_GetCharIndexFromTextIndex) (*responses.FPDFText_GetCharIndexFromTextIndex, error) {
+	return i.FPDFText_GetCharIndexFromTextIndexWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetCharIndexFromTextIndexWithContext(ctx goctx.Context, request *requests.FPDFText_GetCharIndexFromTextIndex) (*responses.FPDFText_GetCharIndexFromTextIndex, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetCharIndexFromTextIndex != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetCharIndexFromTextIndex(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetCharIndexFromTextIndex", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetCharIndexFromTextIndex)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetCharOrigin(request *requests.FPDFText_GetCharOrigin) (*responses.FPDFText_GetCharOrigin, error) {
+	return i.FPDFText_GetCharOriginWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetCharOriginWithContext(ctx goctx.Context, request *requests.FPDFText_GetCharOrigin) (*responses.FPDFText_GetCharOrigin, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetCharOrigin != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetCharOrigin(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetCharOrigin", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetCharOrigin)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetFillColor(request *requests.FPDFText_GetFillColor) (*responses.FPDFText_GetFillColor, error) {
+	return i.FPDFText_GetFillColorWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetFillColorWithContext(ctx goctx.Context, request *requests.FPDFText_GetFillColor) (*responses.FPDFText_GetFillColor, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetFillColor != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetFillColor(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetFillColor", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetFillColor)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetFontInfo(request *requests.FPDFText_GetFontInfo) (*responses.FPDFText_GetFontInfo, error) {
+	return i.FPDFText_GetFontInfoWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetFontInfoWithContext(ctx goctx.Context, request *requests.FPDFText_GetFontInfo) (*responses.FPDFText_GetFontInfo, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetFontInfo != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetFontInfo(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetFontInfo", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetFontInfo)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetFontSize(request *requests.FPDFText_GetFontSize) (*responses.FPDFText_GetFontSize, error) {
+	return i.FPDFText_GetFontSizeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetFontSizeWithContext(ctx goctx.Context, request *requests.FPDFText_GetFontSize) (*responses.FPDFText_GetFontSize, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetFontSize != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetFontSize(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetFontSize", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetFontSize)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetFontWeight(request *requests.FPDFText_GetFontWeight) (*responses.FPDFText_GetFontWeight, error) {
+	return i.FPDFText_GetFontWeightWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetFontWeightWithContext(ctx goctx.Context, request *requests.FPDFText_GetFontWeight) (*responses.FPDFText_GetFontWeight, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetFontWeight != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetFontWeight(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetFontWeight", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetFontWeight)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetLooseCharBox(request *requests.FPDFText_GetLooseCharBox) (*responses.FPDFText_GetLooseCharBox, error) {
+	return i.FPDFText_GetLooseCharBoxWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetLooseCharBoxWithContext(ctx goctx.Context, request *requests.FPDFText_GetLooseCharBox) (*responses.FPDFText_GetLooseCharBox, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetLooseCharBox != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetLooseCharBox(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetLooseCharBox", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetLooseCharBox)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetMatrix(request *requests.FPDFText_GetMatrix) (*responses.FPDFText_GetMatrix, error) {
+	return i.FPDFText_GetMatrixWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetMatrixWithContext(ctx goctx.Context, request *requests.FPDFText_GetMatrix) (*responses.FPDFText_GetMatrix, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetMatrix != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetMatrix(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetMatrix", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetMatrix)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetRect(request *requests.FPDFText_GetRect) (*responses.FPDFText_GetRect, error) {
+	return i.FPDFText_GetRectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetRectWithContext(ctx goctx.Context, request *requests.FPDFText_GetRect) (*responses.FPDFText_GetRect, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetRect != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetRect(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetRect", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetRect)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetSchCount(request *requests.FPDFText_GetSchCount) (*responses.FPDFText_GetSchCount, error) {
+	return i.FPDFText_GetSchCountWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetSchCountWithContext(ctx goctx.Context, request *requests.FPDFText_GetSchCount) (*responses.FPDFText_GetSchCount, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetSchCount != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetSchCount(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetSchCount", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetSchCount)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetSchResultIndex(request *requests.FPDFText_GetSchResultIndex) (*responses.FPDFText_GetSchResultIndex, error) {
+	return i.FPDFText_GetSchResultIndexWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetSchResultIndexWithContext(ctx goctx.Context, request *requests.FPDFText_GetSchResultIndex) (*responses.FPDFText_GetSchResultIndex, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetSchResultIndex != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetSchResultIndex(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetSchResultIndex", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetSchResultIndex)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetStrokeColor(request *requests.FPDFText_GetStrokeColor) (*responses.FPDFText_GetStrokeColor, error) {
+	return i.FPDFText_GetStrokeColorWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetStrokeColorWithContext(ctx goctx.Context, request *requests.FPDFText_GetStrokeColor) (*responses.FPDFText_GetStrokeColor, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetStrokeColor != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetStrokeColor(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetStrokeColor", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetStrokeColor)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetText(request *requests.FPDFText_GetText) (*responses.FPDFText_GetText, error) {
+	return i.FPDFText_GetTextWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetTextWithContext(ctx goctx.Context, request *requests.FPDFText_GetText) (*responses.FPDFText_GetText, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetText != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetText(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetText", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetText)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetTextIndexFromCharIndex(request *requests.FPDFText_GetTextIndexFromCharIndex) (*responses.FPDFText_GetTextIndexFromCharIndex, error) {
+	return i.FPDFText_GetTextIndexFromCharIndexWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetTextIndexFromCharIndexWithContext(ctx goctx.Context, request *requests.FPDFText_GetTextIndexFromCharIndex) (*responses.FPDFText_GetTextIndexFromCharIndex, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetTextIndexFromCharIndex != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetTextIndexFromCharIndex(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetTextIndexFromCharIndex", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetTextIndexFromCharIndex)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetTextObject(request *requests.FPDFText_GetTextObject) (*responses.FPDFText_GetTextObject, error) {
+	return i.FPDFText_GetTextObjectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetTextObjectWithContext(ctx goctx.Context, request *requests.FPDFText_GetTextObject) (*responses.FPDFText_GetTextObject, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetTextObject != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetTextObject(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetTextObject", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetTextObject)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_GetUnicode(request *requests.FPDFText_GetUnicode) (*responses.FPDFText_GetUnicode, error) {
+	return i.FPDFText_GetUnicodeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_GetUnicodeWithContext(ctx goctx.Context, request *requests.FPDFText_GetUnicode) (*responses.FPDFText_GetUnicode, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_GetUnicode != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_GetUnicode(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_GetUnicode", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_GetUnicode)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_HasUnicodeMapError(request *requests.FPDFText_HasUnicodeMapError) (*responses.FPDFText_HasUnicodeMapError, error) {
+	return i.FPDFText_HasUnicodeMapErrorWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_HasUnicodeMapErrorWithContext(ctx goctx.Context, request *requests.FPDFText_HasUnicodeMapError) (*responses.FPDFText_HasUnicodeMapError, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_HasUnicodeMapError != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_HasUnicodeMapError(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_HasUnicodeMapError", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_HasUnicodeMapError)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_IsGenerated(request *requests.FPDFText_IsGenerated) (*responses.FPDFText_IsGenerated, error) {
+	return i.FPDFText_IsGeneratedWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_IsGeneratedWithContext(ctx goctx.Context, request *requests.FPDFText_IsGenerated) (*responses.FPDFText_IsGenerated, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_IsGenerated != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_IsGenerated(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_IsGenerated", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_IsGenerated)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_IsHyphen(request *requests.FPDFText_IsHyphen) (*responses.FPDFText_IsHyphen, error) {
+	return i.FPDFText_IsHyphenWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_IsHyphenWithContext(ctx goctx.Context, request *requests.FPDFText_IsHyphen) (*responses.FPDFText_IsHyphen, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_IsHyphen != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_IsHyphen(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_IsHyphen", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_IsHyphen)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_LoadCidType2Font(request *requests.FPDFText_LoadCidType2Font) (*responses.FPDFText_LoadCidType2Font, error) {
+	return i.FPDFText_LoadCidType2FontWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_LoadCidType2FontWithContext(ctx goctx.Context, request *requests.FPDFText_LoadCidType2Font) (*responses.FPDFText_LoadCidType2Font, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_LoadCidType2Font != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_LoadCidType2Font(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_LoadCidType2Font", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_LoadCidType2Font)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_LoadFont(request *requests.FPDFText_LoadFont) (*responses.FPDFText_LoadFont, error) {
+	return i.FPDFText_LoadFontWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_LoadFontWithContext(ctx goctx.Context, request *requests.FPDFText_LoadFont) (*responses.FPDFText_LoadFont, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_LoadFont != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_LoadFont(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_LoadFont", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_LoadFont)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_LoadPage(request *requests.FPDFText_LoadPage) (*responses.FPDFText_LoadPage, error) {
+	return i.FPDFText_LoadPageWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_LoadPageWithContext(ctx goctx.Context, request *requests.FPDFText_LoadPage) (*responses.FPDFText_LoadPage, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_LoadPage != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_LoadPage(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_LoadPage", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_LoadPage)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_LoadStandardFont(request *requests.FPDFText_LoadStandardFont) (*responses.FPDFText_LoadStandardFont, error) {
+	return i.FPDFText_LoadStandardFontWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_LoadStandardFontWithContext(ctx goctx.Context, request *requests.FPDFText_LoadStandardFont) (*responses.FPDFText_LoadStandardFont, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_LoadStandardFont != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_LoadStandardFont(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_LoadStandardFont", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_LoadStandardFont)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_SetCharcodes(request *requests.FPDFText_SetCharcodes) (*responses.FPDFText_SetCharcodes, error) {
+	return i.FPDFText_SetCharcodesWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_SetCharcodesWithContext(ctx goctx.Context, request *requests.FPDFText_SetCharcodes) (*responses.FPDFText_SetCharcodes, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_SetCharcodes != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_SetCharcodes(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_SetCharcodes", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_SetCharcodes)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_SetPositions(request *requests.FPDFText_SetPositions) (*responses.FPDFText_SetPositions, error) {
+	return i.FPDFText_SetPositionsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_SetPositionsWithContext(ctx goctx.Context, request *requests.FPDFText_SetPositions) (*responses.FPDFText_SetPositions, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_SetPositions != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_SetPositions(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_SetPositions", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_SetPositions)
+	return typedResp, err
+}
+
+func (i *Instance) FPDFText_SetText(request *requests.FPDFText_SetText) (*responses.FPDFText_SetText, error) {
+	return i.FPDFText_SetTextWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDFText_SetTextWithContext(ctx goctx.Context, request *requests.FPDFText_SetText) (*responses.FPDFText_SetText, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDFText_SetText != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDFText_SetText(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDFText_SetText", request, stub)
+	typedResp, _ := resp.(*responses.FPDFText_SetText)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_CloseDocument(request *requests.FPDF_CloseDocument) (*responses.FPDF_CloseDocument, error) {
+	return i.FPDF_CloseDocumentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_CloseDocumentWithContext(ctx goctx.Context, request *requests.FPDF_CloseDocument) (*responses.FPDF_CloseDocument, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_CloseDocument != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_CloseDocument(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_CloseDocument", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_CloseDocument)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_ClosePage(request *requests.FPDF_ClosePage) (*responses.FPDF_ClosePage, error) {
+	return i.FPDF_ClosePageWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_ClosePageWithContext(ctx goctx.Context, request *requests.FPDF_ClosePage) (*responses.FPDF_ClosePage, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_ClosePage != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_ClosePage(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_ClosePage", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_ClosePage)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_CloseXObject(request *requests.FPDF_CloseXObject) (*responses.FPDF_CloseXObject, error) {
+	return i.FPDF_CloseXObjectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_CloseXObjectWithContext(ctx goctx.Context, request *requests.FPDF_CloseXObject) (*responses.FPDF_CloseXObject, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_CloseXObject != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_CloseXObject(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_CloseXObject", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_CloseXObject)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_CopyViewerPreferences(request *requests.FPDF_CopyViewerPreferences) (*responses.FPDF_CopyViewerPreferences, error) {
+	return i.FPDF_CopyViewerPreferencesWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_CopyViewerPreferencesWithContext(ctx goctx.Context, request *requests.FPDF_CopyViewerPreferences) (*responses.FPDF_CopyViewerPreferences, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_CopyViewerPreferences != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_CopyViewerPreferences(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_CopyViewerPreferences", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_CopyViewerPreferences)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_CountNamedDests(request *requests.FPDF_CountNamedDests) (*responses.FPDF_CountNamedDests, error) {
+	return i.FPDF_CountNamedDestsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_CountNamedDestsWithContext(ctx goctx.Context, request *requests.FPDF_CountNamedDests) (*responses.FPDF_CountNamedDests, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_CountNamedDests != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_CountNamedDests(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_CountNamedDests", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_CountNamedDests)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_CreateClipPath(request *requests.FPDF_CreateClipPath) (*responses.FPDF_CreateClipPath, error) {
+	return i.FPDF_CreateClipPathWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_CreateClipPathWithContext(ctx goctx.Context, request *requests.FPDF_CreateClipPath) (*responses.FPDF_CreateClipPath, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_CreateClipPath != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_CreateClipPath(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_CreateClipPath", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_CreateClipPath)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_CreateNewDocument(request *requests.FPDF_CreateNewDocument) (*responses.FPDF_CreateNewDocument, error) {
+	return i.FPDF_CreateNewDocumentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_CreateNewDocumentWithContext(ctx goctx.Context, request *requests.FPDF_CreateNewDocument) (*responses.FPDF_CreateNewDocument, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_CreateNewDocument != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_CreateNewDocument(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_CreateNewDocument", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_CreateNewDocument)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_DestroyClipPath(request *requests.FPDF_DestroyClipPath) (*responses.FPDF_DestroyClipPath, error) {
+	return i.FPDF_DestroyClipPathWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_DestroyClipPathWithContext(ctx goctx.Context, request *requests.FPDF_DestroyClipPath) (*responses.FPDF_DestroyClipPath, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_DestroyClipPath != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_DestroyClipPath(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_DestroyClipPath", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_DestroyClipPath)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_DeviceToPage(request *requests.FPDF_DeviceToPage) (*responses.FPDF_DeviceToPage, error) {
+	return i.FPDF_DeviceToPageWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_DeviceToPageWithContext(ctx goctx.Context, request *requests.FPDF_DeviceToPage) (*responses.FPDF_DeviceToPage, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_DeviceToPage != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_DeviceToPage(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_DeviceToPage", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_DeviceToPage)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_DocumentHasValidCrossReferenceTable(request *requests.FPDF_DocumentHasValidCrossReferenceTable) (*responses.FPDF_DocumentHasValidCrossReferenceTable, error) {
+	return i.FPDF_DocumentHasValidCrossReferenceTableWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_DocumentHasValidCrossReferenceTableWithContext(ctx goctx.Context, request *requests.FPDF_DocumentHasValidCrossReferenceTable) (*responses.FPDF_DocumentHasValidCrossReferenceTable, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_DocumentHasValidCrossReferenceTable != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_DocumentHasValidCrossReferenceTable(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_DocumentHasValidCrossReferenceTable", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_DocumentHasValidCrossReferenceTable)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_FFLDraw(request *requests.FPDF_FFLDraw) (*responses.FPDF_FFLDraw, error) {
+	return i.FPDF_FFLDrawWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_FFLDrawWithContext(ctx goctx.Context, request *requests.FPDF_FFLDraw) (*responses.FPDF_FFLDraw, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_FFLDraw != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_FFLDraw(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_FFLDraw", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_FFLDraw)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetDocPermissions(request *requests.FPDF_GetDocPermissions) (*responses.FPDF_GetDocPermissions, error) {
+	return i.FPDF_GetDocPermissionsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetDocPermissionsWithContext(ctx goctx.Context, request *requests.FPDF_GetDocPermissions) (*responses.FPDF_GetDocPermissions, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetDocPermissions != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetDocPermissions(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetDocPermissions", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetDocPermissions)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetDocUserPermissions(request *requests.FPDF_GetDocUserPermissions) (*responses.FPDF_GetDocUserPermissions, error) {
+	return i.FPDF_GetDocUserPermissionsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetDocUserPermissionsWithContext(ctx goctx.Context, request *requests.FPDF_GetDocUserPermissions) (*responses.FPDF_GetDocUserPermissions, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetDocUserPermissions != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetDocUserPermissions(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetDocUserPermissions", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetDocUserPermissions)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetFileIdentifier(request *requests.FPDF_GetFileIdentifier) (*responses.FPDF_GetFileIdentifier, error) {
+	return i.FPDF_GetFileIdentifierWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetFileIdentifierWithContext(ctx goctx.Context, request *requests.FPDF_GetFileIdentifier) (*responses.FPDF_GetFileIdentifier, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetFileIdentifier != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetFileIdentifier(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetFileIdentifier", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetFileIdentifier)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetFileVersion(request *requests.FPDF_GetFileVersion) (*responses.FPDF_GetFileVersion, error) {
+	return i.FPDF_GetFileVersionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetFileVersionWithContext(ctx goctx.Context, request *requests.FPDF_GetFileVersion) (*responses.FPDF_GetFileVersion, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetFileVersion != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetFileVersion(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetFileVersion", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetFileVersion)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetFormType(request *requests.FPDF_GetFormType) (*responses.FPDF_GetFormType, error) {
+	return i.FPDF_GetFormTypeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetFormTypeWithContext(ctx goctx.Context, request *requests.FPDF_GetFormType) (*responses.FPDF_GetFormType, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetFormType != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetFormType(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetFormType", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetFormType)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetLastError(request *requests.FPDF_GetLastError) (*responses.FPDF_GetLastError, error) {
+	return i.FPDF_GetLastErrorWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetLastErrorWithContext(ctx goctx.Context, request *requests.FPDF_GetLastError) (*responses.FPDF_GetLastError, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetLastError != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetLastError(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetLastError", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetLastError)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetMetaText(request *requests.FPDF_GetMetaText) (*responses.FPDF_GetMetaText, error) {
+	return i.FPDF_GetMetaTextWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetMetaTextWithContext(ctx goctx.Context, request *requests.FPDF_GetMetaText) (*responses.FPDF_GetMetaText, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetMetaText != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetMetaText(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetMetaText", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetMetaText)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetNamedDest(request *requests.FPDF_GetNamedDest) (*responses.FPDF_GetNamedDest, error) {
+	return i.FPDF_GetNamedDestWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetNamedDestWithContext(ctx goctx.Context, request *requests.FPDF_GetNamedDest) (*responses.FPDF_GetNamedDest, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetNamedDest != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetNamedDest(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetNamedDest", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetNamedDest)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetNamedDestByName(request *requests.FPDF_GetNamedDestByName) (*responses.FPDF_GetNamedDestByName, error) {
+	return i.FPDF_GetNamedDestByNameWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetNamedDestByNameWithContext(ctx goctx.Context, request *requests.FPDF_GetNamedDestByName) (*responses.FPDF_GetNamedDestByName, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetNamedDestByName != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetNamedDestByName(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetNamedDestByName", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetNamedDestByName)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetPageAAction(request *requests.FPDF_GetPageAAction) (*responses.FPDF_GetPageAAction, error) {
+	return i.FPDF_GetPageAActionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetPageAActionWithContext(ctx goctx.Context, request *requests.FPDF_GetPageAAction) (*responses.FPDF_GetPageAAction, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetPageAAction != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetPageAAction(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetPageAAction", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetPageAAction)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetPageBoundingBox(request *requests.FPDF_GetPageBoundingBox) (*responses.FPDF_GetPageBoundingBox, error) {
+	return i.FPDF_GetPageBoundingBoxWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetPageBoundingBoxWithContext(ctx goctx.Context, request *requests.FPDF_GetPageBoundingBox) (*responses.FPDF_GetPageBoundingBox, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetPageBoundingBox != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetPageBoundingBox(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetPageBoundingBox", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetPageBoundingBox)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetPageCount(request *requests.FPDF_GetPageCount) (*responses.FPDF_GetPageCount, error) {
+	return i.FPDF_GetPageCountWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetPageCountWithContext(ctx goctx.Context, request *requests.FPDF_GetPageCount) (*responses.FPDF_GetPageCount, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetPageCount != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetPageCount(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetPageCount", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetPageCount)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetPageHeight(request *requests.FPDF_GetPageHeight) (*responses.FPDF_GetPageHeight, error) {
+	return i.FPDF_GetPageHeightWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetPageHeightWithContext(ctx goctx.Context, request *requests.FPDF_GetPageHeight) (*responses.FPDF_GetPageHeight, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetPageHeight != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetPageHeight(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetPageHeight", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetPageHeight)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetPageHeightF(request *requests.FPDF_GetPageHeightF) (*responses.FPDF_GetPageHeightF, error) {
+	return i.FPDF_GetPageHeightFWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetPageHeightFWithContext(ctx goctx.Context, request *requests.FPDF_GetPageHeightF) (*responses.FPDF_GetPageHeightF, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetPageHeightF != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetPageHeightF(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetPageHeightF", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetPageHeightF)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetPageLabel(request *requests.FPDF_GetPageLabel) (*responses.FPDF_GetPageLabel, error) {
+	return i.FPDF_GetPageLabelWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetPageLabelWithContext(ctx goctx.Context, request *requests.FPDF_GetPageLabel) (*responses.FPDF_GetPageLabel, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetPageLabel != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetPageLabel(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetPageLabel", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetPageLabel)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetPageSizeByIndex(request *requests.FPDF_GetPageSizeByIndex) (*responses.FPDF_GetPageSizeByIndex, error) {
+	return i.FPDF_GetPageSizeByIndexWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetPageSizeByIndexWithContext(ctx goctx.Context, request *requests.FPDF_GetPageSizeByIndex) (*responses.FPDF_GetPageSizeByIndex, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetPageSizeByIndex != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetPageSizeByIndex(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetPageSizeByIndex", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetPageSizeByIndex)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetPageSizeByIndexF(request *requests.FPDF_GetPageSizeByIndexF) (*responses.FPDF_GetPageSizeByIndexF, error) {
+	return i.FPDF_GetPageSizeByIndexFWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetPageSizeByIndexFWithContext(ctx goctx.Context, request *requests.FPDF_GetPageSizeByIndexF) (*responses.FPDF_GetPageSizeByIndexF, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetPageSizeByIndexF != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetPageSizeByIndexF(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetPageSizeByIndexF", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetPageSizeByIndexF)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetPageWidth(request *requests.FPDF_GetPageWidth) (*responses.FPDF_GetPageWidth, error) {
+	return i.FPDF_GetPageWidthWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetPageWidthWithContext(ctx goctx.Context, request *requests.FPDF_GetPageWidth) (*responses.FPDF_GetPageWidth, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetPageWidth != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetPageWidth(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetPageWidth", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetPageWidth)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetPageWidthF(request *requests.FPDF_GetPageWidthF) (*responses.FPDF_GetPageWidthF, error) {
+	return i.FPDF_GetPageWidthFWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetPageWidthFWithContext(ctx goctx.Context, request *requests.FPDF_GetPageWidthF) (*responses.FPDF_GetPageWidthF, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetPageWidthF != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetPageWidthF(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetPageWidthF", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetPageWidthF)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetSecurityHandlerRevision(request *requests.FPDF_GetSecurityHandlerRevision) (*responses.FPDF_GetSecurityHandlerRevision, error) {
+	return i.FPDF_GetSecurityHandlerRevisionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetSecurityHandlerRevisionWithContext(ctx goctx.Context, request *requests.FPDF_GetSecurityHandlerRevision) (*responses.FPDF_GetSecurityHandlerRevision, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetSecurityHandlerRevision != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetSecurityHandlerRevision(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetSecurityHandlerRevision", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetSecurityHandlerRevision)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetSignatureCount(request *requests.FPDF_GetSignatureCount) (*responses.FPDF_GetSignatureCount, error) {
+	return i.FPDF_GetSignatureCountWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetSignatureCountWithContext(ctx goctx.Context, request *requests.FPDF_GetSignatureCount) (*responses.FPDF_GetSignatureCount, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetSignatureCount != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetSignatureCount(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetSignatureCount", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetSignatureCount)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetSignatureObject(request *requests.FPDF_GetSignatureObject) (*responses.FPDF_GetSignatureObject, error) {
+	return i.FPDF_GetSignatureObjectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetSignatureObjectWithContext(ctx goctx.Context, request *requests.FPDF_GetSignatureObject) (*responses.FPDF_GetSignatureObject, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetSignatureObject != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetSignatureObject(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetSignatureObject", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetSignatureObject)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetTrailerEnds(request *requests.FPDF_GetTrailerEnds) (*responses.FPDF_GetTrailerEnds, error) {
+	return i.FPDF_GetTrailerEndsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetTrailerEndsWithContext(ctx goctx.Context, request *requests.FPDF_GetTrailerEnds) (*responses.FPDF_GetTrailerEnds, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetTrailerEnds != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetTrailerEnds(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetTrailerEnds", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetTrailerEnds)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetXFAPacketContent(request *requests.FPDF_GetXFAPacketContent) (*responses.FPDF_GetXFAPacketContent, error) {
+	return i.FPDF_GetXFAPacketContentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetXFAPacketContentWithContext(ctx goctx.Context, request *requests.FPDF_GetXFAPacketContent) (*responses.FPDF_GetXFAPacketContent, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetXFAPacketContent != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetXFAPacketContent(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetXFAPacketContent", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetXFAPacketContent)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetXFAPacketCount(request *requests.FPDF_GetXFAPacketCount) (*responses.FPDF_GetXFAPacketCount, error) {
+	return i.FPDF_GetXFAPacketCountWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetXFAPacketCountWithContext(ctx goctx.Context, request *requests.FPDF_GetXFAPacketCount) (*responses.FPDF_GetXFAPacketCount, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetXFAPacketCount != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetXFAPacketCount(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetXFAPacketCount", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetXFAPacketCount)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_GetXFAPacketName(request *requests.FPDF_GetXFAPacketName) (*responses.FPDF_GetXFAPacketName, error) {
+	return i.FPDF_GetXFAPacketNameWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_GetXFAPacketNameWithContext(ctx goctx.Context, request *requests.FPDF_GetXFAPacketName) (*responses.FPDF_GetXFAPacketName, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_GetXFAPacketName != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_GetXFAPacketName(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_GetXFAPacketName", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_GetXFAPacketName)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_ImportNPagesToOne(request *requests.FPDF_ImportNPagesToOne) (*responses.FPDF_ImportNPagesToOne, error) {
+	return i.FPDF_ImportNPagesToOneWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_ImportNPagesToOneWithContext(ctx goctx.Context, request *requests.FPDF_ImportNPagesToOne) (*responses.FPDF_ImportNPagesToOne, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_ImportNPagesToOne != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_ImportNPagesToOne(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_ImportNPagesToOne", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_ImportNPagesToOne)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_ImportPages(request *requests.FPDF_ImportPages) (*responses.FPDF_ImportPages, error) {
+	return i.FPDF_ImportPagesWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_ImportPagesWithContext(ctx goctx.Context, request *requests.FPDF_ImportPages) (*responses.FPDF_ImportPages, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_ImportPages != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_ImportPages(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_ImportPages", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_ImportPages)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_ImportPagesByIndex(request *requests.FPDF_ImportPagesByIndex) (*responses.FPDF_ImportPagesByIndex, error) {
+	return i.FPDF_ImportPagesByIndexWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_ImportPagesByIndexWithContext(ctx goctx.Context, request *requests.FPDF_ImportPagesByIndex) (*responses.FPDF_ImportPagesByIndex, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_ImportPagesByIndex != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_ImportPagesByIndex(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_ImportPagesByIndex", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_ImportPagesByIndex)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_LoadCustomDocument(request *requests.FPDF_LoadCustomDocument) (*responses.FPDF_LoadCustomDocument, error) {
+	return i.FPDF_LoadCustomDocumentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_LoadCustomDocumentWithContext(ctx goctx.Context, request *requests.FPDF_LoadCustomDocument) (*responses.FPDF_LoadCustomDocument, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_LoadCustomDocument != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_LoadCustomDocument(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_LoadCustomDocument", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_LoadCustomDocument)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_LoadDocument(request *requests.FPDF_LoadDocument) (*responses.FPDF_LoadDocument, error) {
+	return i.FPDF_LoadDocumentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_LoadDocumentWithContext(ctx goctx.Context, request *requests.FPDF_LoadDocument) (*responses.FPDF_LoadDocument, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_LoadDocument != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_LoadDocument(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_LoadDocument", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_LoadDocument)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_LoadMemDocument(request *requests.FPDF_LoadMemDocument) (*responses.FPDF_LoadMemDocument, error) {
+	return i.FPDF_LoadMemDocumentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_LoadMemDocumentWithContext(ctx goctx.Context, request *requests.FPDF_LoadMemDocument) (*responses.FPDF_LoadMemDocument, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_LoadMemDocument != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_LoadMemDocument(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_LoadMemDocument", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_LoadMemDocument)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_LoadMemDocument64(request *requests.FPDF_LoadMemDocument64) (*responses.FPDF_LoadMemDocument64, error) {
+	return i.FPDF_LoadMemDocument64WithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_LoadMemDocument64WithContext(ctx goctx.Context, request *requests.FPDF_LoadMemDocument64) (*responses.FPDF_LoadMemDocument64, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_LoadMemDocument64 != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_LoadMemDocument64(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_LoadMemDocument64", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_LoadMemDocument64)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_LoadPage(request *requests.FPDF_LoadPage) (*responses.FPDF_LoadPage, error) {
+	return i.FPDF_LoadPageWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_LoadPageWithContext(ctx goctx.Context, request *requests.FPDF_LoadPage) (*responses.FPDF_LoadPage, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_LoadPage != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_LoadPage(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_LoadPage", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_LoadPage)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_LoadXFA(request *requests.FPDF_LoadXFA) (*responses.FPDF_LoadXFA, error) {
+	return i.FPDF_LoadXFAWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_LoadXFAWithContext(ctx goctx.Context, request *requests.FPDF_LoadXFA) (*responses.FPDF_LoadXFA, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_LoadXFA != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_LoadXFA(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_LoadXFA", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_LoadXFA)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_MovePages(request *requests.FPDF_MovePages) (*responses.FPDF_MovePages, error) {
+	return i.FPDF_MovePagesWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_MovePagesWithContext(ctx goctx.Context, request *requests.FPDF_MovePages) (*responses.FPDF_MovePages, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_MovePages != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_MovePages(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_MovePages", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_MovePages)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_NewFormObjectFromXObject(request *requests.FPDF_NewFormObjectFromXObject) (*responses.FPDF_NewFormObjectFromXObject, error) {
+	return i.FPDF_NewFormObjectFromXObjectWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_NewFormObjectFromXObjectWithContext(ctx goctx.Context, request *requests.FPDF_NewFormObjectFromXObject) (*responses.FPDF_NewFormObjectFromXObject, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_NewFormObjectFromXObject != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_NewFormObjectFromXObject(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_NewFormObjectFromXObject", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_NewFormObjectFromXObject)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_NewXObjectFromPage(request *requests.FPDF_NewXObjectFromPage) (*responses.FPDF_NewXObjectFromPage, error) {
+	return i.FPDF_NewXObjectFromPageWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_NewXObjectFromPageWithContext(ctx goctx.Context, request *requests.FPDF_NewXObjectFromPage) (*responses.FPDF_NewXObjectFromPage, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_NewXObjectFromPage != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_NewXObjectFromPage(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_NewXObjectFromPage", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_NewXObjectFromPage)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_PageToDevice(request *requests.FPDF_PageToDevice) (*responses.FPDF_PageToDevice, error) {
+	return i.FPDF_PageToDeviceWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_PageToDeviceWithContext(ctx goctx.Context, request *requests.FPDF_PageToDevice) (*responses.FPDF_PageToDevice, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_PageToDevice != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_PageToDevice(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_PageToDevice", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_PageToDevice)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_RemoveFormFieldHighlight(request *requests.FPDF_RemoveFormFieldHighlight) (*responses.FPDF_RemoveFormFieldHighlight, error) {
+	return i.FPDF_RemoveFormFieldHighlightWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_RemoveFormFieldHighlightWithContext(ctx goctx.Context, request *requests.FPDF_RemoveFormFieldHighlight) (*responses.FPDF_RemoveFormFieldHighlight, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_RemoveFormFieldHighlight != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_RemoveFormFieldHighlight(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_RemoveFormFieldHighlight", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_RemoveFormFieldHighlight)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_RenderPage(request *requests.FPDF_RenderPage) (*responses.FPDF_RenderPage, error) {
+	return i.FPDF_RenderPageWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_RenderPageWithContext(ctx goctx.Context, request *requests.FPDF_RenderPage) (*responses.FPDF_RenderPage, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_RenderPage != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_RenderPage(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_RenderPage", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_RenderPage)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_RenderPageBitmap(request *requests.FPDF_RenderPageBitmap) (*responses.FPDF_RenderPageBitmap, error) {
+	return i.FPDF_RenderPageBitmapWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_RenderPageBitmapWithContext(ctx goctx.Context, request *requests.FPDF_RenderPageBitmap) (*responses.FPDF_RenderPageBitmap, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_RenderPageBitmap != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_RenderPageBitmap(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_RenderPageBitmap", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_RenderPageBitmap)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_RenderPageBitmapWithColorScheme_Start(request *requests.FPDF_RenderPageBitmapWithColorScheme_Start) (*responses.FPDF_RenderPageBitmapWithColorScheme_Start, error) {
+	return i.FPDF_RenderPageBitmapWithColorScheme_StartWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_RenderPageBitmapWithColorScheme_StartWithContext(ctx goctx.Context, request *requests.FPDF_RenderPageBitmapWithColorScheme_Start) (*responses.FPDF_RenderPageBitmapWithColorScheme_Start, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_RenderPageBitmapWithColorScheme_Start != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_RenderPageBitmapWithColorScheme_Start(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_RenderPageBitmapWithColorScheme_Start", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_RenderPageBitmapWithColorScheme_Start)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_RenderPageBitmapWithMatrix(request *requests.FPDF_RenderPageBitmapWithMatrix) (*responses.FPDF_RenderPageBitmapWithMatrix, error) {
+	return i.FPDF_RenderPageBitmapWithMatrixWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_RenderPageBitmapWithMatrixWithContext(ctx goctx.Context, request *requests.FPDF_RenderPageBitmapWithMatrix) (*responses.FPDF_RenderPageBitmapWithMatrix, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_RenderPageBitmapWithMatrix != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_RenderPageBitmapWithMatrix(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_RenderPageBitmapWithMatrix", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_RenderPageBitmapWithMatrix)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_RenderPageBitmap_Start(request *requests.FPDF_RenderPageBitmap_Start) (*responses.FPDF_RenderPageBitmap_Start, error) {
+	return i.FPDF_RenderPageBitmap_StartWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_RenderPageBitmap_StartWithContext(ctx goctx.Context, request *requests.FPDF_RenderPageBitmap_Start) (*responses.FPDF_RenderPageBitmap_Start, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_RenderPageBitmap_Start != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_RenderPageBitmap_Start(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_RenderPageBitmap_Start", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_RenderPageBitmap_Start)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_RenderPage_Close(request *requests.FPDF_RenderPage_Close) (*responses.FPDF_RenderPage_Close, error) {
+	return i.FPDF_RenderPage_CloseWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_RenderPage_CloseWithContext(ctx goctx.Context, request *requests.FPDF_RenderPage_Close) (*responses.FPDF_RenderPage_Close, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_RenderPage_Close != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_RenderPage_Close(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_RenderPage_Close", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_RenderPage_Close)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_RenderPage_Continue(request *requests.FPDF_RenderPage_Continue) (*responses.FPDF_RenderPage_Continue, error) {
+	return i.FPDF_RenderPage_ContinueWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_RenderPage_ContinueWithContext(ctx goctx.Context, request *requests.FPDF_RenderPage_Continue) (*responses.FPDF_RenderPage_Continue, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_RenderPage_Continue != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_RenderPage_Continue(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_RenderPage_Continue", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_RenderPage_Continue)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_SaveAsCopy(request *requests.FPDF_SaveAsCopy) (*responses.FPDF_SaveAsCopy, error) {
+	return i.FPDF_SaveAsCopyWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_SaveAsCopyWithContext(ctx goctx.Context, request *requests.FPDF_SaveAsCopy) (*responses.FPDF_SaveAsCopy, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_SaveAsCopy != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_SaveAsCopy(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_SaveAsCopy", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_SaveAsCopy)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_SaveWithVersion(request *requests.FPDF_SaveWithVersion) (*responses.FPDF_SaveWithVersion, error) {
+	return i.FPDF_SaveWithVersionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_SaveWithVersionWithContext(ctx goctx.Context, request *requests.FPDF_SaveWithVersion) (*responses.FPDF_SaveWithVersion, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_SaveWithVersion != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_SaveWithVersion(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_SaveWithVersion", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_SaveWithVersion)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_SetFormFieldHighlightAlpha(request *requests.FPDF_SetFormFieldHighlightAlpha) (*responses.FPDF_SetFormFieldHighlightAlpha, error) {
+	return i.FPDF_SetFormFieldHighlightAlphaWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_SetFormFieldHighlightAlphaWithContext(ctx goctx.Context, request *requests.FPDF_SetFormFieldHighlightAlpha) (*responses.FPDF_SetFormFieldHighlightAlpha, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_SetFormFieldHighlightAlpha != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_SetFormFieldHighlightAlpha(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_SetFormFieldHighlightAlpha", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_SetFormFieldHighlightAlpha)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_SetFormFieldHighlightColor(request *requests.FPDF_SetFormFieldHighlightColor) (*responses.FPDF_SetFormFieldHighlightColor, error) {
+	return i.FPDF_SetFormFieldHighlightColorWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_SetFormFieldHighlightColorWithContext(ctx goctx.Context, request *requests.FPDF_SetFormFieldHighlightColor) (*responses.FPDF_SetFormFieldHighlightColor, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_SetFormFieldHighlightColor != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_SetFormFieldHighlightColor(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_SetFormFieldHighlightColor", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_SetFormFieldHighlightColor)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_SetPrintMode(request *requests.FPDF_SetPrintMode) (*responses.FPDF_SetPrintMode, error) {
+	return i.FPDF_SetPrintModeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_SetPrintModeWithContext(ctx goctx.Context, request *requests.FPDF_SetPrintMode) (*responses.FPDF_SetPrintMode, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_SetPrintMode != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_SetPrintMode(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_SetPrintMode", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_SetPrintMode)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_SetSandBoxPolicy(request *requests.FPDF_SetSandBoxPolicy) (*responses.FPDF_SetSandBoxPolicy, error) {
+	return i.FPDF_SetSandBoxPolicyWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_SetSandBoxPolicyWithContext(ctx goctx.Context, request *requests.FPDF_SetSandBoxPolicy) (*responses.FPDF_SetSandBoxPolicy, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_SetSandBoxPolicy != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_SetSandBoxPolicy(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_SetSandBoxPolicy", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_SetSandBoxPolicy)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_Attr_CountChildren(request *requests.FPDF_StructElement_Attr_CountChildren) (*responses.FPDF_StructElement_Attr_CountChildren, error) {
+	return i.FPDF_StructElement_Attr_CountChildrenWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_Attr_CountChildrenWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_CountChildren) (*responses.FPDF_StructElement_Attr_CountChildren, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_Attr_CountChildren != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_Attr_CountChildren(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_Attr_CountChildren", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_CountChildren)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_Attr_GetBlobValue(request *requests.FPDF_StructElement_Attr_GetBlobValue) (*responses.FPDF_StructElement_Attr_GetBlobValue, error) {
+	return i.FPDF_StructElement_Attr_GetBlobValueWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_Attr_GetBlobValueWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetBlobValue) (*responses.FPDF_StructElement_Attr_GetBlobValue, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_Attr_GetBlobValue != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_Attr_GetBlobValue(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_Attr_GetBlobValue", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_GetBlobValue)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_Attr_GetBooleanValue(request *requests.FPDF_StructElement_Attr_GetBooleanValue) (*responses.FPDF_StructElement_Attr_GetBooleanValue, error) {
+	return i.FPDF_StructElement_Attr_GetBooleanValueWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_Attr_GetBooleanValueWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetBooleanValue) (*responses.FPDF_StructElement_Attr_GetBooleanValue, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_Attr_GetBooleanValue != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_Attr_GetBooleanValue(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_Attr_GetBooleanValue", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_GetBooleanValue)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_Attr_GetChildAtIndex(request *requests.FPDF_StructElement_Attr_GetChildAtIndex) (*responses.FPDF_StructElement_Attr_GetChildAtIndex, error) {
+	return i.FPDF_StructElement_Attr_GetChildAtIndexWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_Attr_GetChildAtIndexWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetChildAtIndex) (*responses.FPDF_StructElement_Attr_GetChildAtIndex, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_Attr_GetChildAtIndex != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_Attr_GetChildAtIndex(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_Attr_GetChildAtIndex", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_GetChildAtIndex)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_Attr_GetCount(request *requests.FPDF_StructElement_Attr_GetCount) (*responses.FPDF_StructElement_Attr_GetCount, error) {
+	return i.FPDF_StructElement_Attr_GetCountWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_Attr_GetCountWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetCount) (*responses.FPDF_StructElement_Attr_GetCount, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_Attr_GetCount != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_Attr_GetCount(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_Attr_GetCount", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_GetCount)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_Attr_GetName(request *requests.FPDF_StructElement_Attr_GetName) (*responses.FPDF_StructElement_Attr_GetName, error) {
+	return i.FPDF_StructElement_Attr_GetNameWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_Attr_GetNameWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetName) (*responses.FPDF_StructElement_Attr_GetName, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_Attr_GetName != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_Attr_GetName(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_Attr_GetName", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_GetName)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_Attr_GetNumberValue(request *requests.FPDF_StructElement_Attr_GetNumberValue) (*responses.FPDF_StructElement_Attr_GetNumberValue, error) {
+	return i.FPDF_StructElement_Attr_GetNumberValueWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_Attr_GetNumberValueWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetNumberValue) (*responses.FPDF_StructElement_Attr_GetNumberValue, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_Attr_GetNumberValue != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_Attr_GetNumberValue(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_Attr_GetNumberValue", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_GetNumberValue)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_Attr_GetStringValue(request *requests.FPDF_StructElement_Attr_GetStringValue) (*responses.FPDF_StructElement_Attr_GetStringValue, error) {
+	return i.FPDF_StructElement_Attr_GetStringValueWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_Attr_GetStringValueWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetStringValue) (*responses.FPDF_StructElement_Attr_GetStringValue, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_Attr_GetStringValue != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_Attr_GetStringValue(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_Attr_GetStringValue", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_GetStringValue)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_Attr_GetType(request *requests.FPDF_StructElement_Attr_GetType) (*responses.FPDF_StructElement_Attr_GetType, error) {
+	return i.FPDF_StructElement_Attr_GetTypeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_Attr_GetTypeWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetType) (*responses.FPDF_StructElement_Attr_GetType, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_Attr_GetType != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_Attr_GetType(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_Attr_GetType", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_GetType)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_Attr_GetValue(request *requests.FPDF_StructElement_Attr_GetValue) (*responses.FPDF_StructElement_Attr_GetValue, error) {
+	return i.FPDF_StructElement_Attr_GetValueWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_Attr_GetValueWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetValue) (*responses.FPDF_StructElement_Attr_GetValue, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_Attr_GetValue != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_Attr_GetValue(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_Attr_GetValue", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_GetValue)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_CountChildren(request *requests.FPDF_StructElement_CountChildren) (*responses.FPDF_StructElement_CountChildren, error) {
+	return i.FPDF_StructElement_CountChildrenWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_CountChildrenWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_CountChildren) (*responses.FPDF_StructElement_CountChildren, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_CountChildren != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_CountChildren(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_CountChildren", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_CountChildren)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_GetActualText(request *requests.FPDF_StructElement_GetActualText) (*responses.FPDF_StructElement_GetActualText, error) {
+	return i.FPDF_StructElement_GetActualTextWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_GetActualTextWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetActualText) (*responses.FPDF_StructElement_GetActualText, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_GetActualText != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_GetActualText(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_GetActualText", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetActualText)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_GetAltText(request *requests.FPDF_StructElement_GetAltText) (*responses.FPDF_StructElement_GetAltText, error) {
+	return i.FPDF_StructElement_GetAltTextWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_GetAltTextWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetAltText) (*responses.FPDF_StructElement_GetAltText, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_GetAltText != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_GetAltText(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_GetAltText", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetAltText)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_GetAttributeAtIndex(request *requests.FPDF_StructElement_GetAttributeAtIndex) (*responses.FPDF_StructElement_GetAttributeAtIndex, error) {
+	return i.FPDF_StructElement_GetAttributeAtIndexWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_GetAttributeAtIndexWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetAttributeAtIndex) (*responses.FPDF_StructElement_GetAttributeAtIndex, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_GetAttributeAtIndex != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_GetAttributeAtIndex(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_GetAttributeAtIndex", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetAttributeAtIndex)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_GetAttributeCount(request *requests.FPDF_StructElement_GetAttributeCount) (*responses.FPDF_StructElement_GetAttributeCount, error) {
+	return i.FPDF_StructElement_GetAttributeCountWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_GetAttributeCountWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetAttributeCount) (*responses.FPDF_StructElement_GetAttributeCount, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_GetAttributeCount != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_GetAttributeCount(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_GetAttributeCount", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetAttributeCount)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_GetChildAtIndex(request *requests.FPDF_StructElement_GetChildAtIndex) (*responses.FPDF_StructElement_GetChildAtIndex, error) {
+	return i.FPDF_StructElement_GetChildAtIndexWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_GetChildAtIndexWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetChildAtIndex) (*responses.FPDF_StructElement_GetChildAtIndex, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_GetChildAtIndex != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_GetChildAtIndex(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_GetChildAtIndex", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetChildAtIndex)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_GetChildMarkedContentID(request *requests.FPDF_StructElement_GetChildMarkedContentID) (*responses.FPDF_StructElement_GetChildMarkedContentID, error) {
+	return i.FPDF_StructElement_GetChildMarkedContentIDWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_GetChildMarkedContentIDWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetChildMarkedContentID) (*responses.FPDF_StructElement_GetChildMarkedContentID, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_GetChildMarkedContentID != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_GetChildMarkedContentID(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_GetChildMarkedContentID", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetChildMarkedContentID)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_GetExpansion(request *requests.FPDF_StructElement_GetExpansion) (*responses.FPDF_StructElement_GetExpansion, error) {
+	return i.FPDF_StructElement_GetExpansionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_GetExpansionWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetExpansion) (*responses.FPDF_StructElement_GetExpansion, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_GetExpansion != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_GetExpansion(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_GetExpansion", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetExpansion)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_GetID(request *requests.FPDF_StructElement_GetID) (*responses.FPDF_StructElement_GetID, error) {
+	return i.FPDF_StructElement_GetIDWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_GetIDWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetID) (*responses.FPDF_StructElement_GetID, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_GetID != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_GetID(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_GetID", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetID)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_GetLang(request *requests.FPDF_StructElement_GetLang) (*responses.FPDF_StructElement_GetLang, error) {
+	return i.FPDF_StructElement_GetLangWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_GetLangWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetLang) (*responses.FPDF_StructElement_GetLang, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_GetLang != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_GetLang(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_GetLang", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetLang)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_GetMarkedContentID(request *requests.FPDF_StructElement_GetMarkedContentID) (*responses.FPDF_StructElement_GetMarkedContentID, error) {
+	return i.FPDF_StructElement_GetMarkedContentIDWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_GetMarkedContentIDWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetMarkedContentID) (*responses.FPDF_StructElement_GetMarkedContentID, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_GetMarkedContentID != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_GetMarkedContentID(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_GetMarkedContentID", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetMarkedContentID)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_GetMarkedContentIdAtIndex(request *requests.FPDF_StructElement_GetMarkedContentIdAtIndex) (*responses.FPDF_StructElement_GetMarkedContentIdAtIndex, error) {
+	return i.FPDF_StructElement_GetMarkedContentIdAtIndexWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_GetMarkedContentIdAtIndexWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetMarkedContentIdAtIndex) (*responses.FPDF_StructElement_GetMarkedContentIdAtIndex, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_GetMarkedContentIdAtIndex != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_GetMarkedContentIdAtIndex(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_GetMarkedContentIdAtIndex", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetMarkedContentIdAtIndex)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_GetMarkedContentIdCount(request *requests.FPDF_StructElement_GetMarkedContentIdCount) (*responses.FPDF_StructElement_GetMarkedContentIdCount, error) {
+	return i.FPDF_StructElement_GetMarkedContentIdCountWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_GetMarkedContentIdCountWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetMarkedContentIdCount) (*responses.FPDF_StructElement_GetMarkedContentIdCount, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_GetMarkedContentIdCount != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_GetMarkedContentIdCount(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_GetMarkedContentIdCount", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetMarkedContentIdCount)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_GetObjType(request *requests.FPDF_StructElement_GetObjType) (*responses.FPDF_StructElement_GetObjType, error) {
+	return i.FPDF_StructElement_GetObjTypeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_GetObjTypeWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetObjType) (*responses.FPDF_StructElement_GetObjType, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_GetObjType != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_GetObjType(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_GetObjType", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetObjType)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_GetParent(request *requests.FPDF_StructElement_GetParent) (*responses.FPDF_StructElement_GetParent, error) {
+	return i.FPDF_StructElement_GetParentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_GetParentWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetParent) (*responses.FPDF_StructElement_GetParent, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_GetParent != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_GetParent(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_GetParent", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetParent)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_GetStringAttribute(request *requests.FPDF_StructElement_GetStringAttribute) (*responses.FPDF_StructElement_GetStringAttribute, error) {
+	return i.FPDF_StructElement_GetStringAttributeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_GetStringAttributeWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetStringAttribute) (*responses.FPDF_StructElement_GetStringAttribute, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_GetStringAttribute != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_GetStringAttribute(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_GetStringAttribute", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetStringAttribute)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_GetTitle(request *requests.FPDF_StructElement_GetTitle) (*responses.FPDF_StructElement_GetTitle, error) {
+	return i.FPDF_StructElement_GetTitleWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_GetTitleWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetTitle) (*responses.FPDF_StructElement_GetTitle, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_GetTitle != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_GetTitle(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_GetTitle", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetTitle)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructElement_GetType(request *requests.FPDF_StructElement_GetType) (*responses.FPDF_StructElement_GetType, error) {
+	return i.FPDF_StructElement_GetTypeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructElement_GetTypeWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetType) (*responses.FPDF_StructElement_GetType, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructElement_GetType != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructElement_GetType(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructElement_GetType", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetType)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructTree_Close(request *requests.FPDF_StructTree_Close) (*responses.FPDF_StructTree_Close, error) {
+	return i.FPDF_StructTree_CloseWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructTree_CloseWithContext(ctx goctx.Context, request *requests.FPDF_StructTree_Close) (*responses.FPDF_StructTree_Close, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructTree_Close != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructTree_Close(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructTree_Close", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructTree_Close)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructTree_CountChildren(request *requests.FPDF_StructTree_CountChildren) (*responses.FPDF_StructTree_CountChildren, error) {
+	return i.FPDF_StructTree_CountChildrenWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructTree_CountChildrenWithContext(ctx goctx.Context, request *requests.FPDF_StructTree_CountChildren) (*responses.FPDF_StructTree_CountChildren, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructTree_CountChildren != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructTree_CountChildren(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructTree_CountChildren", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructTree_CountChildren)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructTree_GetChildAtIndex(request *requests.FPDF_StructTree_GetChildAtIndex) (*responses.FPDF_StructTree_GetChildAtIndex, error) {
+	return i.FPDF_StructTree_GetChildAtIndexWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructTree_GetChildAtIndexWithContext(ctx goctx.Context, request *requests.FPDF_StructTree_GetChildAtIndex) (*responses.FPDF_StructTree_GetChildAtIndex, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructTree_GetChildAtIndex != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructTree_GetChildAtIndex(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructTree_GetChildAtIndex", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructTree_GetChildAtIndex)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_StructTree_GetForPage(request *requests.FPDF_StructTree_GetForPage) (*responses.FPDF_StructTree_GetForPage, error) {
+	return i.FPDF_StructTree_GetForPageWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_StructTree_GetForPageWithContext(ctx goctx.Context, request *requests.FPDF_StructTree_GetForPage) (*responses.FPDF_StructTree_GetForPage, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_StructTree_GetForPage != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_StructTree_GetForPage(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_StructTree_GetForPage", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_StructTree_GetForPage)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_VIEWERREF_GetDuplex(request *requests.FPDF_VIEWERREF_GetDuplex) (*responses.FPDF_VIEWERREF_GetDuplex, error) {
+	return i.FPDF_VIEWERREF_GetDuplexWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_VIEWERREF_GetDuplexWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetDuplex) (*responses.FPDF_VIEWERREF_GetDuplex, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_VIEWERREF_GetDuplex != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_VIEWERREF_GetDuplex(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_VIEWERREF_GetDuplex", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_VIEWERREF_GetDuplex)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_VIEWERREF_GetName(request *requests.FPDF_VIEWERREF_GetName) (*responses.FPDF_VIEWERREF_GetName, error) {
+	return i.FPDF_VIEWERREF_GetNameWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_VIEWERREF_GetNameWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetName) (*responses.FPDF_VIEWERREF_GetName, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_VIEWERREF_GetName != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_VIEWERREF_GetName(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_VIEWERREF_GetName", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_VIEWERREF_GetName)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_VIEWERREF_GetNumCopies(request *requests.FPDF_VIEWERREF_GetNumCopies) (*responses.FPDF_VIEWERREF_GetNumCopies, error) {
+	return i.FPDF_VIEWERREF_GetNumCopiesWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_VIEWERREF_GetNumCopiesWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetNumCopies) (*responses.FPDF_VIEWERREF_GetNumCopies, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_VIEWERREF_GetNumCopies != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_VIEWERREF_GetNumCopies(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_VIEWERREF_GetNumCopies", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_VIEWERREF_GetNumCopies)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_VIEWERREF_GetPrintPageRange(request *requests.FPDF_VIEWERREF_GetPrintPageRange) (*responses.FPDF_VIEWERREF_GetPrintPageRange, error) {
+	return i.FPDF_VIEWERREF_GetPrintPageRangeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_VIEWERREF_GetPrintPageRangeWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetPrintPageRange) (*responses.FPDF_VIEWERREF_GetPrintPageRange, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_VIEWERREF_GetPrintPageRange != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_VIEWERREF_GetPrintPageRange(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_VIEWERREF_GetPrintPageRange", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_VIEWERREF_GetPrintPageRange)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_VIEWERREF_GetPrintPageRangeCount(request *requests.FPDF_VIEWERREF_GetPrintPageRangeCount) (*responses.FPDF_VIEWERREF_GetPrintPageRangeCount, error) {
+	return i.FPDF_VIEWERREF_GetPrintPageRangeCountWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_VIEWERREF_GetPrintPageRangeCountWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetPrintPageRangeCount) (*responses.FPDF_VIEWERREF_GetPrintPageRangeCount, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_VIEWERREF_GetPrintPageRangeCount != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_VIEWERREF_GetPrintPageRangeCount(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_VIEWERREF_GetPrintPageRangeCount", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_VIEWERREF_GetPrintPageRangeCount)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_VIEWERREF_GetPrintPageRangeElement(request *requests.FPDF_VIEWERREF_GetPrintPageRangeElement) (*responses.FPDF_VIEWERREF_GetPrintPageRangeElement, error) {
+	return i.FPDF_VIEWERREF_GetPrintPageRangeElementWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_VIEWERREF_GetPrintPageRangeElementWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetPrintPageRangeElement) (*responses.FPDF_VIEWERREF_GetPrintPageRangeElement, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_VIEWERREF_GetPrintPageRangeElement != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_VIEWERREF_GetPrintPageRangeElement(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_VIEWERREF_GetPrintPageRangeElement", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_VIEWERREF_GetPrintPageRangeElement)
+	return typedResp, err
+}
+
+func (i *Instance) FPDF_VIEWERREF_GetPrintScaling(request *requests.FPDF_VIEWERREF_GetPrintScaling) (*responses.FPDF_VIEWERREF_GetPrintScaling, error) {
+	return i.FPDF_VIEWERREF_GetPrintScalingWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FPDF_VIEWERREF_GetPrintScalingWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetPrintScaling) (*responses.FPDF_VIEWERREF_GetPrintScaling, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FPDF_VIEWERREF_GetPrintScaling != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FPDF_VIEWERREF_GetPrintScaling(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FPDF_VIEWERREF_GetPrintScaling", request, stub)
+	typedResp, _ := resp.(*responses.FPDF_VIEWERREF_GetPrintScaling)
+	return typedResp, err
+}
+
+func (i *Instance) FSDK_SetLocaltimeFunction(request *requests.FSDK_SetLocaltimeFunction) (*responses.FSDK_SetLocaltimeFunction, error) {
+	return i.FSDK_SetLocaltimeFunctionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FSDK_SetLocaltimeFunctionWithContext(ctx goctx.Context, request *requests.FSDK_SetLocaltimeFunction) (*responses.FSDK_SetLocaltimeFunction, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FSDK_SetLocaltimeFunction != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FSDK_SetLocaltimeFunction(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FSDK_SetLocaltimeFunction", request, stub)
+	typedResp, _ := resp.(*responses.FSDK_SetLocaltimeFunction)
+	return typedResp, err
+}
+
+func (i *Instance) FSDK_SetTimeFunction(request *requests.FSDK_SetTimeFunction) (*responses.FSDK_SetTimeFunction, error) {
+	return i.FSDK_SetTimeFunctionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FSDK_SetTimeFunctionWithContext(ctx goctx.Context, request *requests.FSDK_SetTimeFunction) (*responses.FSDK_SetTimeFunction, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FSDK_SetTimeFunction != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FSDK_SetTimeFunction(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FSDK_SetTimeFunction", request, stub)
+	typedResp, _ := resp.(*responses.FSDK_SetTimeFunction)
+	return typedResp, err
+}
+
+func (i *Instance) FSDK_SetUnSpObjProcessHandler(request *requests.FSDK_SetUnSpObjProcessHandler) (*responses.FSDK_SetUnSpObjProcessHandler, error) {
+	return i.FSDK_SetUnSpObjProcessHandlerWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) FSDK_SetUnSpObjProcessHandlerWithContext(ctx goctx.Context, request *requests.FSDK_SetUnSpObjProcessHandler) (*responses.FSDK_SetUnSpObjProcessHandler, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.FSDK_SetUnSpObjProcessHandler != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.FSDK_SetUnSpObjProcessHandler(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "FSDK_SetUnSpObjProcessHandler", request, stub)
+	typedResp, _ := resp.(*responses.FSDK_SetUnSpObjProcessHandler)
+	return typedResp, err
+}
+
+func (i *Instance) GetActionInfo(request *requests.GetActionInfo) (*responses.GetActionInfo, error) {
+	return i.GetActionInfoWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) GetActionInfoWithContext(ctx goctx.Context, request *requests.GetActionInfo) (*responses.GetActionInfo, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.GetActionInfo != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.GetActionInfo(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "GetActionInfo", request, stub)
+	typedResp, _ := resp.(*responses.GetActionInfo)
+	return typedResp, err
+}
+
+func (i *Instance) GetAttachments(request *requests.GetAttachments) (*responses.GetAttachments, error) {
+	return i.GetAttachmentsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) GetAttachmentsWithContext(ctx goctx.Context, request *requests.GetAttachments) (*responses.GetAttachments, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.GetAttachments != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.GetAttachments(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "GetAttachments", request, stub)
+	typedResp, _ := resp.(*responses.GetAttachments)
+	return typedResp, err
+}
+
+func (i *Instance) GetBookmarks(request *requests.GetBookmarks) (*responses.GetBookmarks, error) {
+	return i.GetBookmarksWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) GetBookmarksWithContext(ctx goctx.Context, request *requests.GetBookmarks) (*responses.GetBookmarks, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.GetBookmarks != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.GetBookmarks(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "GetBookmarks", request, stub)
+	typedResp, _ := resp.(*responses.GetBookmarks)
+	return typedResp, err
+}
+
+func (i *Instance) GetDestInfo(request *requests.GetDestInfo) (*responses.GetDestInfo, error) {
+	return i.GetDestInfoWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) GetDestInfoWithContext(ctx goctx.Context, request *requests.GetDestInfo) (*responses.GetDestInfo, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.GetDestInfo != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.GetDestInfo(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "GetDestInfo", request, stub)
+	typedResp, _ := resp.(*responses.GetDestInfo)
+	return typedResp, err
+}
+
+func (i *Instance) GetForm(request *requests.GetForm) (*responses.GetForm, error) {
+	return i.GetFormWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) GetFormWithContext(ctx goctx.Context, request *requests.GetForm) (*responses.GetForm, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.GetForm != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.GetForm(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "GetForm", request, stub)
+	typedResp, _ := resp.(*responses.GetForm)
+	return typedResp, err
+}
+
+func (i *Instance) GetJavaScriptActions(request *requests.GetJavaScriptActions) (*responses.GetJavaScriptActions, error) {
+	return i.GetJavaScriptActionsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) GetJavaScriptActionsWithContext(ctx goctx.Context, request *requests.GetJavaScriptActions) (*responses.GetJavaScriptActions, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.GetJavaScriptActions != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.GetJavaScriptActions(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "GetJavaScriptActions", request, stub)
+	typedResp, _ := resp.(*responses.GetJavaScriptActions)
+	return typedResp, err
+}
+
+func (i *Instance) GetMetaData(request *requests.GetMetaData) (*responses.GetMetaData, error) {
+	return i.GetMetaDataWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) GetMetaDataWithContext(ctx goctx.Context, request *requests.GetMetaData) (*responses.GetMetaData, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.GetMetaData != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.GetMetaData(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "GetMetaData", request, stub)
+	typedResp, _ := resp.(*responses.GetMetaData)
+	return typedResp, err
+}
+
+func (i *Instance) GetPageSize(request *requests.GetPageSize) (*responses.GetPageSize, error) {
+	return i.GetPageSizeWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) GetPageSizeWithContext(ctx goctx.Context, request *requests.GetPageSize) (*responses.GetPageSize, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.GetPageSize != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.GetPageSize(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "GetPageSize", request, stub)
+	typedResp, _ := resp.(*responses.GetPageSize)
+	return typedResp, err
+}
+
+func (i *Instance) GetPageSizeInPixels(request *requests.GetPageSizeInPixels) (*responses.GetPageSizeInPixels, error) {
+	return i.GetPageSizeInPixelsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) GetPageSizeInPixelsWithContext(ctx goctx.Context, request *requests.GetPageSizeInPixels) (*responses.GetPageSizeInPixels, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.GetPageSizeInPixels != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.GetPageSizeInPixels(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "GetPageSizeInPixels", request, stub)
+	typedResp, _ := resp.(*responses.GetPageSizeInPixels)
+	return typedResp, err
+}
+
+func (i *Instance) GetPageText(request *requests.GetPageText) (*responses.GetPageText, error) {
+	return i.GetPageTextWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) GetPageTextWithContext(ctx goctx.Context, request *requests.GetPageText) (*responses.GetPageText, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.GetPageText != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.GetPageText(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "GetPageText", request, stub)
+	typedResp, _ := resp.(*responses.GetPageText)
+	return typedResp, err
+}
+
+func (i *Instance) GetPageTextStructured(request *requests.GetPageTextStructured) (*responses.GetPageTextStructured, error) {
+	return i.GetPageTextStructuredWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) GetPageTextStructuredWithContext(ctx goctx.Context, request *requests.GetPageTextStructured) (*responses.GetPageTextStructured, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.GetPageTextStructured != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.GetPageTextStructured(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "GetPageTextStructured", request, stub)
+	typedResp, _ := resp.(*responses.GetPageTextStructured)
+	return typedResp, err
+}
+
+func (i *Instance) ListOpenHandles(request *requests.ListOpenHandles) (*responses.ListOpenHandles, error) {
+	return i.ListOpenHandlesWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) ListOpenHandlesWithContext(ctx goctx.Context, request *requests.ListOpenHandles) (*responses.ListOpenHandles, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.ListOpenHandles != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.ListOpenHandles(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "ListOpenHandles", request, stub)
+	typedResp, _ := resp.(*responses.ListOpenHandles)
+	return typedResp, err
+}
+
+func (i *Instance) OpenDocument(request *requests.OpenDocument) (*responses.OpenDocument, error) {
+	return i.OpenDocumentWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) OpenDocumentWithContext(ctx goctx.Context, request *requests.OpenDocument) (*responses.OpenDocument, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.OpenDocument != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.OpenDocument(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "OpenDocument", request, stub)
+	typedResp, _ := resp.(*responses.OpenDocument)
+	return typedResp, err
+}
+
+func (i *Instance) RenderPageInDPI(request *requests.RenderPageInDPI) (*responses.RenderPageInDPI, error) {
+	return i.RenderPageInDPIWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) RenderPageInDPIWithContext(ctx goctx.Context, request *requests.RenderPageInDPI) (*responses.RenderPageInDPI, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.RenderPageInDPI != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.RenderPageInDPI(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "RenderPageInDPI", request, stub)
+	typedResp, _ := resp.(*responses.RenderPageInDPI)
+	return typedResp, err
+}
+
+func (i *Instance) RenderPageInPixels(request *requests.RenderPageInPixels) (*responses.RenderPageInPixels, error) {
+	return i.RenderPageInPixelsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) RenderPageInPixelsWithContext(ctx goctx.Context, request *requests.RenderPageInPixels) (*responses.RenderPageInPixels, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.RenderPageInPixels != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.RenderPageInPixels(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "RenderPageInPixels", request, stub)
+	typedResp, _ := resp.(*responses.RenderPageInPixels)
+	return typedResp, err
+}
+
+func (i *Instance) RenderPageRegion(request *requests.RenderPageRegion) (*responses.RenderPageRegion, error) {
+	return i.RenderPageRegionWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) RenderPageRegionWithContext(ctx goctx.Context, request *requests.RenderPageRegion) (*responses.RenderPageRegion, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.RenderPageRegion != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.RenderPageRegion(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "RenderPageRegion", request, stub)
+	typedResp, _ := resp.(*responses.RenderPageRegion)
+	return typedResp, err
+}
+
+func (i *Instance) RenderPagesInDPI(request *requests.RenderPagesInDPI) (*responses.RenderPagesInDPI, error) {
+	return i.RenderPagesInDPIWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) RenderPagesInDPIWithContext(ctx goctx.Context, request *requests.RenderPagesInDPI) (*responses.RenderPagesInDPI, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.RenderPagesInDPI != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.RenderPagesInDPI(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "RenderPagesInDPI", request, stub)
+	typedResp, _ := resp.(*responses.RenderPagesInDPI)
+	return typedResp, err
+}
+
+func (i *Instance) RenderPagesInPixels(request *requests.RenderPagesInPixels) (*responses.RenderPagesInPixels, error) {
+	return i.RenderPagesInPixelsWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) RenderPagesInPixelsWithContext(ctx goctx.Context, request *requests.RenderPagesInPixels) (*responses.RenderPagesInPixels, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.RenderPagesInPixels != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.RenderPagesInPixels(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "RenderPagesInPixels", request, stub)
+	typedResp, _ := resp.(*responses.RenderPagesInPixels)
+	return typedResp, err
+}
+
+func (i *Instance) RenderToFile(request *requests.RenderToFile) (*responses.RenderToFile, error) {
+	return i.RenderToFileWithContext(goctx.Background(), request)
+}
+
+func (i *Instance) RenderToFileWithContext(ctx goctx.Context, request *requests.RenderToFile) (*responses.RenderToFile, error) {
+	var stub func() (interface{}, error)
+	if i.Stubs.RenderToFile != nil {
+		stub = func() (interface{}, error) {
+			return i.Stubs.RenderToFile(request)
+		}
+	}
+
+	resp, err := i.call(ctx, "RenderToFile", request, stub)
+	typedResp, _ := resp.(*responses.RenderToFile)
+	return typedResp, err
+}
@@ -0,0 +1,358 @@
+// Package pdfiumtest provides fakes of pdfium.Pdfium and pdfium.Pool, to unit
+// test code that uses go-pdfium without PDFium or the WebAssembly module.
+//
+// The behaviour of every method can be programmed with a stub in Stubs, a
+// method without a stub returns ErrNotStubbed. All calls are recorded and can
+// be checked with the assertion helpers:
+//
+//	instance := pdfiumtest.NewInstance()
+//	instance.Stubs.FPDF_GetPageCount = func(request *requests.FPDF_GetPageCount) (*responses.FPDF_GetPageCount, error) {
+//		return &responses.FPDF_GetPageCount{PageCount: 2}, nil
+//	}
+//
+//	countPages(instance)
+//
+//	instance.AssertNumberOfCalls(t, "FPDF_GetPageCount", 1)
+package pdfiumtest
+
+import (
+	goctx "context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/klippa-app/go-pdfium"
+	"github.com/klippa-app/go-pdfium/internal/stats"
+)
+
+// ErrNotStubbed is returned by the methods that don't have a stub.
+var ErrNotStubbed = errors.New("method is not stubbed")
+
+// Call is a recorded call to a method of an instance.
+type Call struct {
+	Method   string
+	Request  interface{} // The request of the call, like *requests.FPDF_GetPageCount.
+	Response interface{} // The response of the call, like *responses.FPDF_GetPageCount.
+	Err      error
+}
+
+// Instance is a fake pdfium.Pdfium. The stubs must be set before the instance
+// is used, the instance is safe for concurrent use after that.
+type Instance struct {
+	Stubs Stubs
+
+	lock   *sync.Mutex
+	calls  []Call
+	closed bool
+	killed bool
+	stats  *stats.Recorder
+}
+
+var _ pdfium.PdfiumWithContext = &Instance{}
+
+// NewInstance creates a fake instance without stubs.
+func NewInstance() *Instance {
+	return &Instance{
+		lock: &sync.Mutex{},
+	}
+}
+
+// call records a call to the given method, the stub is nil when the method
+// isn't stubbed.
+func (i *Instance) call(ctx goctx.Context, method string, request interface{}, stub func() (interface{}, error)) (resp interface{}, err error) {
+	i.lock.Lock()
+	closed := i.closed
+	recorder := i.stats
+	i.lock.Unlock()
+
+	if recorder != nil {
+		observeCall := recorder.StartCall(method)
+		defer func() {
+			observeCall(err)
+		}()
+	}
+
+	if closed {
+		err = errors.New("instance is closed")
+	} else if ctxErr := ctx.Err(); ctxErr != nil {
+		err = ctxErr
+	} else if stub == nil {
+		err = fmt.Errorf("%w: %s", ErrNotStubbed, method)
+	} else {
+		resp, err = stub()
+	}
+
+	i.lock.Lock()
+	i.calls = append(i.calls, Call{
+		Method:   method,
+		Request:  request,
+		Response: resp,
+		Err:      err,
+	})
+	i.lock.Unlock()
+
+	return resp, err
+}
+
+// Close closes the instance, calls after this return an error.
+func (i *Instance) Close() error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if i.closed {
+		return errors.New("instance is already closed")
+	}
+
+	i.closed = true
+	return nil
+}
+
+// Kill closes the instance like Close, and marks it as killed.
+func (i *Instance) Kill() error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if i.closed {
+		return errors.New("instance is already closed")
+	}
+
+	i.closed = true
+	i.killed = true
+	return nil
+}
+
+// GetImplementation returns the instance itself.
+func (i *Instance) GetImplementation() interface{} {
+	return i
+}
+
+// IsClosed returns whether the instance has been closed or killed.
+func (i *Instance) IsClosed() bool {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	return i.closed
+}
+
+// IsKilled returns whether the instance has been killed.
+func (i *Instance) IsKilled() bool {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	return i.killed
+}
+
+// Calls returns the recorded calls, in the order in which they were made.
+func (i *Instance) Calls() []Call {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	return append([]Call{}, i.calls...)
+}
+
+// CallsTo returns the recorded calls to the given method.
+func (i *Instance) CallsTo(method string) []Call {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	calls := []Call{}
+	for _, call := range i.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// ResetCalls removes the recorded calls.
+func (i *Instance) ResetCalls() {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	i.calls = nil
+}
+
+// AssertCalled checks that the given method has been called.
+func (i *Instance) AssertCalled(t testing.TB, method string) bool {
+	t.Helper()
+
+	if len(i.CallsTo(method)) == 0 {
+		t.Errorf("expected %s to be called, but it was not called", method)
+		return false
+	}
+
+	return true
+}
+
+// AssertNotCalled checks that the given method has not been called.
+func (i *Instance) AssertNotCalled(t testing.TB, method string) bool {
+	t.Helper()
+
+	if calls := len(i.CallsTo(method)); calls > 0 {
+		t.Errorf("expected %s not to be called, but it was called %d time(s)", method, calls)
+		return false
+	}
+
+	return true
+}
+
+// AssertNumberOfCalls checks that the given method has been called the given
+// number of times.
+func (i *Instance) AssertNumberOfCalls(t testing.TB, method string, expected int) bool {
+	t.Helper()
+
+	if calls := len(i.CallsTo(method)); calls != expected {
+		t.Errorf("expected %s to be called %d time(s), but it was called %d time(s)", method, expected, calls)
+		return false
+	}
+
+	return true
+}
+
+// AssertCalledWith checks that the given method has been called with a
+// request that is deeply equal to the given request.
+func (i *Instance) AssertCalledWith(t testing.TB, method string, request interface{}) bool {
+	t.Helper()
+
+	calls := i.CallsTo(method)
+	for _, call := range calls {
+		if reflect.DeepEqual(call.Request, request) {
+			return true
+		}
+	}
+
+	if len(calls) == 0 {
+		t.Errorf("expected %s to be called with %+v, but it was not called", method, request)
+		return false
+	}
+
+	requests := make([]interface{}, len(calls))
+	for j := range calls {
+		requests[j] = calls[j].Request
+	}
+
+	t.Errorf("expected %s to be called with %+v, but it was called with %+v", method, request, requests)
+	return false
+}
+
+// Pool is a fake pdfium.Pool that hands out fake instances.
+type Pool struct {
+	// NewInstance creates the instances of the pool, NewInstance of this
+	// package is used when nil. Use it to set the stubs of the instances.
+	NewInstance func() *Instance
+
+	lock      *sync.Mutex
+	instances []*Instance
+	closed    bool
+	stats     *stats.Recorder
+}
+
+var _ pdfium.Pool = &Pool{}
+
+// NewPool creates a fake pool, the given function creates its instances and
+// may be nil.
+func NewPool(newInstance func() *Instance) *Pool {
+	return &Pool{
+		NewInstance: newInstance,
+		lock:        &sync.Mutex{},
+		stats:       stats.New(nil),
+	}
+}
+
+func (p *Pool) GetInstance(timeout time.Duration) (pdfium.Pdfium, error) {
+	return p.GetInstanceWithContext(goctx.Background())
+}
+
+func (p *Pool) GetInstanceWithContext(ctx goctx.Context) (pdfium.Pdfium, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.closed {
+		err := errors.New("pool is closed")
+		p.stats.ObserveGetInstance(0, err)
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		p.stats.ObserveGetInstance(0, err)
+		return nil, err
+	}
+
+	newInstance := NewInstance
+	if p.NewInstance != nil {
+		newInstance = p.NewInstance
+	}
+
+	instance := newInstance()
+	instance.lock.Lock()
+	instance.stats = p.stats
+	instance.lock.Unlock()
+
+	p.instances = append(p.instances, instance)
+	p.stats.ObserveGetInstance(0, nil)
+
+	return instance, nil
+}
+
+// Stats returns the statistics of the calls to the instances of the pool.
+func (p *Pool) Stats() pdfium.PoolStats {
+	stats := p.stats.Stats()
+	for _, instance := range p.Instances() {
+		if !instance.IsClosed() {
+			stats.InstancesInUse++
+		}
+	}
+
+	return stats
+}
+
+// Close closes the pool and all instances that are still open.
+func (p *Pool) Close() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.closed {
+		return errors.New("pool is already closed")
+	}
+
+	p.closed = true
+	for _, instance := range p.instances {
+		if !instance.IsClosed() {
+			instance.Close()
+		}
+	}
+
+	return nil
+}
+
+// Instances returns the instances that have been handed out by the pool.
+func (p *Pool) Instances() []*Instance {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return append([]*Instance{}, p.instances...)
+}
+
+// AssertAllInstancesClosed checks that all instances that have been handed
+// out by the pool have been closed.
+func (p *Pool) AssertAllInstancesClosed(t testing.TB) bool {
+	t.Helper()
+
+	open := 0
+	for _, instance := range p.Instances() {
+		if !instance.IsClosed() {
+			open++
+		}
+	}
+
+	if open > 0 {
+		t.Errorf("expected all instances to be closed, but %d instance(s) are still open", open)
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,122 @@
+package pdfiumtest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/klippa-app/go-pdfium/pdfiumtest"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstanceStubs(t *testing.T) {
+	instance := pdfiumtest.NewInstance()
+	instance.Stubs.FPDF_GetPageCount = func(request *requests.FPDF_GetPageCount) (*responses.FPDF_GetPageCount, error) {
+		if request.Document == "" {
+			return nil, errors.New("document not given")
+		}
+
+		return &responses.FPDF_GetPageCount{PageCount: 2}, nil
+	}
+
+	pageCount, err := instance.FPDF_GetPageCount(&requests.FPDF_GetPageCount{Document: "document"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, pageCount.PageCount)
+
+	pageCount, err = instance.FPDF_GetPageCountWithContext(context.Background(), &requests.FPDF_GetPageCount{})
+	assert.EqualError(t, err, "document not given")
+	assert.Nil(t, pageCount)
+
+	page, err := instance.FPDF_LoadPage(&requests.FPDF_LoadPage{Document: "document"})
+	assert.ErrorIs(t, err, pdfiumtest.ErrNotStubbed)
+	assert.Nil(t, page)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = instance.FPDF_GetPageCountWithContext(ctx, &requests.FPDF_GetPageCount{Document: "document"})
+	assert.ErrorIs(t, err, context.Canceled)
+
+	require.NoError(t, instance.Close())
+	_, err = instance.FPDF_GetPageCount(&requests.FPDF_GetPageCount{Document: "document"})
+	assert.EqualError(t, err, "instance is closed")
+	assert.Error(t, instance.Close())
+}
+
+func TestInstanceCalls(t *testing.T) {
+	instance := pdfiumtest.NewInstance()
+	instance.Stubs.FPDF_GetPageCount = func(request *requests.FPDF_GetPageCount) (*responses.FPDF_GetPageCount, error) {
+		return &responses.FPDF_GetPageCount{PageCount: 1}, nil
+	}
+
+	instance.FPDF_GetPageCount(&requests.FPDF_GetPageCount{Document: "first"})
+	instance.FPDF_LoadPage(&requests.FPDF_LoadPage{Document: "first"})
+	instance.FPDF_GetPageCount(&requests.FPDF_GetPageCount{Document: "second"})
+
+	calls := instance.Calls()
+	require.Len(t, calls, 3)
+	assert.Equal(t, "FPDF_GetPageCount", calls[0].Method)
+	assert.Equal(t, &requests.FPDF_GetPageCount{Document: "first"}, calls[0].Request)
+	assert.Equal(t, &responses.FPDF_GetPageCount{PageCount: 1}, calls[0].Response)
+	assert.ErrorIs(t, calls[1].Err, pdfiumtest.ErrNotStubbed)
+	assert.Len(t, instance.CallsTo("FPDF_GetPageCount"), 2)
+
+	assert.True(t, instance.AssertCalled(t, "FPDF_GetPageCount"))
+	assert.True(t, instance.AssertNotCalled(t, "FPDF_ClosePage"))
+	assert.True(t, instance.AssertNumberOfCalls(t, "FPDF_GetPageCount", 2))
+	assert.True(t, instance.AssertCalledWith(t, "FPDF_GetPageCount", &requests.FPDF_GetPageCount{Document: "second"}))
+
+	// The assertions report failures to the given test.
+	failing := &testing.T{}
+	assert.False(t, instance.AssertCalled(failing, "FPDF_ClosePage"))
+	assert.False(t, instance.AssertNotCalled(failing, "FPDF_GetPageCount"))
+	assert.False(t, instance.AssertNumberOfCalls(failing, "FPDF_GetPageCount", 1))
+	assert.False(t, instance.AssertCalledWith(failing, "FPDF_GetPageCount", &requests.FPDF_GetPageCount{Document: "third"}))
+	assert.True(t, failing.Failed())
+
+	instance.ResetCalls()
+	assert.Empty(t, instance.Calls())
+}
+
+func TestPool(t *testing.T) {
+	pool := pdfiumtest.NewPool(func() *pdfiumtest.Instance {
+		instance := pdfiumtest.NewInstance()
+		instance.Stubs.FPDF_GetPageCount = func(request *requests.FPDF_GetPageCount) (*responses.FPDF_GetPageCount, error) {
+			return &responses.FPDF_GetPageCount{PageCount: 3}, nil
+		}
+		return instance
+	})
+
+	instance, err := pool.GetInstance(time.Second)
+	require.NoError(t, err)
+
+	pageCount, err := instance.FPDF_GetPageCount(&requests.FPDF_GetPageCount{Document: "document"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, pageCount.PageCount)
+
+	_, err = instance.FPDF_LoadPage(&requests.FPDF_LoadPage{Document: "document"})
+	assert.Error(t, err)
+
+	stats := pool.Stats()
+	assert.Equal(t, 1, stats.InstancesInUse)
+	assert.Equal(t, uint64(1), stats.GetInstance.Calls)
+	assert.Equal(t, uint64(1), stats.Methods["FPDF_GetPageCount"].Calls)
+	assert.Equal(t, uint64(1), stats.Methods["FPDF_LoadPage"].Errors)
+
+	require.Len(t, pool.Instances(), 1)
+	pool.Instances()[0].AssertNumberOfCalls(t, "FPDF_GetPageCount", 1)
+
+	failing := &testing.T{}
+	assert.False(t, pool.AssertAllInstancesClosed(failing))
+
+	require.NoError(t, instance.Close())
+	assert.True(t, pool.AssertAllInstancesClosed(t))
+
+	require.NoError(t, pool.Close())
+	_, err = pool.GetInstance(time.Second)
+	assert.EqualError(t, err, "pool is closed")
+}
@@ -0,0 +1,331 @@
+// Package recorder records the calls to PDFium instances to a JSONL trace, so
+// that the exact sequence of calls that led to a crash can be replayed later
+// against any implementation with Replay.
+//
+// Every line of the trace is an Entry. A call entry is written before the
+// call is made, so that the call that crashed a worker or the process is
+// always in the trace, and a result entry is written when the call returns.
+// Large byte payloads, readers and writers are not written to the trace, they
+// are referenced by the SHA-256 hash of their data, and the data is written to
+// the payload directory when one is configured.
+package recorder
+
+import (
+	goctx "context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/klippa-app/go-pdfium"
+)
+
+// DefaultMaxInlineSize is the size from which byte payloads are referenced by
+// hash instead of written to the trace.
+const DefaultMaxInlineSize = 1024
+
+// EntryType is the type of an entry in the trace.
+type EntryType string
+
+const (
+	EntryTypeCall   EntryType = "call"   // Written before the call is made.
+	EntryTypeResult EntryType = "result" // Written when the call returns.
+)
+
+// Entry is a line of the trace.
+type Entry struct {
+	Seq        uint64             `json:"seq"`                  // The sequence number of the call, the result has the same sequence number as its call.
+	Type       EntryType          `json:"type"`                 // The type of the entry.
+	Time       time.Time          `json:"time"`                 // The time the entry was written.
+	Method     string             `json:"method"`               // The method of the Pdfium interface.
+	Request    json.RawMessage    `json:"request,omitempty"`    // The request without the payloads. Only for calls.
+	Payloads   map[string]Payload `json:"payloads,omitempty"`   // The payloads that were left out of the request, by the path of the field. Only for calls.
+	Duration   time.Duration      `json:"duration,omitempty"`   // The duration of the call. Only for results.
+	Error      string             `json:"error,omitempty"`      // The error of the call. Only for results.
+	References map[string]string  `json:"references,omitempty"` // The references in the response by the path of the field, to map them to the references of the replay. Only for results.
+}
+
+// PayloadKind is the kind of value that was left out of a request.
+type PayloadKind string
+
+const (
+	PayloadKindBytes    PayloadKind = "bytes"    // A byte slice, restored on replay from the payload directory.
+	PayloadKindReader   PayloadKind = "reader"   // An io.ReadSeeker, restored on replay as a bytes.Reader from the payload directory.
+	PayloadKindWriter   PayloadKind = "writer"   // An io.Writer, replaced by io.Discard on replay.
+	PayloadKindCallback PayloadKind = "callback" // A callback function, left out on replay.
+	PayloadKindOmitted  PayloadKind = "omitted"  // A value that can't be recorded, like a pointer or a device context, left out on replay.
+)
+
+// Payload describes a value that was left out of a request.
+type Payload struct {
+	Kind   PayloadKind `json:"kind"`
+	SHA256 string      `json:"sha256,omitempty"` // The hash of the data, for bytes and readers.
+	Size   int64       `json:"size,omitempty"`   // The size of the data, for bytes and readers.
+}
+
+// Config configures a Recorder.
+type Config struct {
+	// PayloadDir is the directory to write the large payloads to, named by
+	// their hash. When empty, the payloads are only referenced by hash and
+	// have to be provided on replay in another way.
+	PayloadDir string
+
+	// MaxInlineSize is the size from which byte payloads are referenced by
+	// hash, DefaultMaxInlineSize is used when 0.
+	MaxInlineSize int
+}
+
+// Recorder writes the calls that go through its interceptor to a trace. It's
+// safe for concurrent use, the calls of all instances of a pool are written
+// to the same trace in the order in which they are made.
+type Recorder struct {
+	writer io.Writer
+	config Config
+
+	lock *sync.Mutex
+	seq  uint64
+	err  error
+}
+
+// New creates a recorder that writes the trace to the given writer. Every
+// entry is written with a single write, so that the trace is complete up to
+// the last call when the process crashes.
+func New(writer io.Writer, config Config) (*Recorder, error) {
+	if writer == nil {
+		return nil, errors.New("writer must be given")
+	}
+
+	if config.MaxInlineSize == 0 {
+		config.MaxInlineSize = DefaultMaxInlineSize
+	}
+
+	if config.PayloadDir != "" {
+		if err := os.MkdirAll(config.PayloadDir, 0o755); err != nil {
+			return nil, fmt.Errorf("could not create payload directory: %w", err)
+		}
+	}
+
+	return &Recorder{
+		writer: writer,
+		config: config,
+		lock:   &sync.Mutex{},
+	}, nil
+}
+
+// Interceptor returns the interceptor that records the calls, add it to the
+// Interceptors in the config of a pool. Errors while recording don't fail the
+// calls, they are available through Err.
+func (r *Recorder) Interceptor() pdfium.Interceptor {
+	return func(ctx goctx.Context, method string, request interface{}, next func() (interface{}, error)) (interface{}, error) {
+		seq := r.recordCall(method, request)
+
+		start := time.Now()
+		resp, err := next()
+		r.recordResult(seq, method, time.Since(start), resp, err)
+
+		return resp, err
+	}
+}
+
+// Err returns the first error that occurred while recording.
+func (r *Recorder) Err() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.err
+}
+
+func (r *Recorder) recordCall(method string, request interface{}) uint64 {
+	r.lock.Lock()
+	r.seq++
+	seq := r.seq
+	r.lock.Unlock()
+
+	entry := &Entry{
+		Seq:    seq,
+		Type:   EntryTypeCall,
+		Method: method,
+	}
+
+	payloads := map[string]Payload{}
+	sanitized := copyValue(reflect.ValueOf(request), "", func(value reflect.Value, path string) (reflect.Value, bool) {
+		payload, ok, err := r.payload(value)
+		if err != nil {
+			r.setErr(fmt.Errorf("could not record payload %s of call %d: %w", path, seq, err))
+		}
+		if !ok {
+			return reflect.Value{}, false
+		}
+
+		payloads[path] = payload
+		return reflect.Zero(value.Type()), true
+	})
+
+	requestJSON, err := json.Marshal(sanitized.Interface())
+	if err != nil {
+		r.setErr(fmt.Errorf("could not record request of call %d: %w", seq, err))
+	}
+
+	entry.Request = requestJSON
+	if len(payloads) > 0 {
+		entry.Payloads = payloads
+	}
+
+	r.write(entry)
+	return seq
+}
+
+func (r *Recorder) recordResult(seq uint64, method string, duration time.Duration, resp interface{}, err error) {
+	entry := &Entry{
+		Seq:      seq,
+		Type:     EntryTypeResult,
+		Method:   method,
+		Duration: duration,
+	}
+
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	if resp != nil {
+		references := collectReferences(reflect.ValueOf(resp))
+		if len(references) > 0 {
+			entry.References = references
+		}
+	}
+
+	r.write(entry)
+}
+
+// payload returns the payload of the value if it has to be left out of the
+// request.
+func (r *Recorder) payload(value reflect.Value) (Payload, bool, error) {
+	switch value.Kind() {
+	case reflect.Slice:
+		if value.Type().Elem().Kind() != reflect.Uint8 || value.Len() < r.config.MaxInlineSize {
+			return Payload{}, false, nil
+		}
+
+		payload, err := r.storePayload(PayloadKindBytes, value.Bytes())
+		return payload, true, err
+	case reflect.Func:
+		if value.IsNil() {
+			return Payload{}, false, nil
+		}
+
+		return Payload{Kind: PayloadKindCallback}, true, nil
+	case reflect.Interface:
+		if value.IsNil() {
+			return Payload{}, false, nil
+		}
+
+		switch typedValue := value.Interface().(type) {
+		case io.ReadSeeker:
+			data, err := readAll(typedValue)
+			if err != nil {
+				return Payload{Kind: PayloadKindReader}, true, err
+			}
+
+			payload, err := r.storePayload(PayloadKindReader, data)
+			return payload, true, err
+		case io.Writer:
+			return Payload{Kind: PayloadKindWriter}, true, nil
+		}
+
+		return Payload{Kind: PayloadKindOmitted}, true, nil
+	case reflect.Chan, reflect.UnsafePointer:
+		if value.IsNil() {
+			return Payload{}, false, nil
+		}
+
+		return Payload{Kind: PayloadKindOmitted}, true, nil
+	}
+
+	return Payload{}, false, nil
+}
+
+func (r *Recorder) storePayload(kind PayloadKind, data []byte) (Payload, error) {
+	hash := sha256.Sum256(data)
+	payload := Payload{
+		Kind:   kind,
+		SHA256: hex.EncodeToString(hash[:]),
+		Size:   int64(len(data)),
+	}
+
+	if r.config.PayloadDir == "" {
+		return payload, nil
+	}
+
+	// The payloads are named by their hash, so a payload that exists already
+	// has the same data.
+	payloadPath := filepath.Join(r.config.PayloadDir, payload.SHA256)
+	if _, err := os.Stat(payloadPath); err == nil {
+		return payload, nil
+	}
+
+	// Write to a temporary file first, so that a crash doesn't leave a
+	// partial payload behind.
+	tempPath := payloadPath + ".tmp"
+	if err := os.WriteFile(tempPath, data, 0o644); err != nil {
+		return payload, err
+	}
+
+	return payload, os.Rename(tempPath, payloadPath)
+}
+
+func (r *Recorder) write(entry *Entry) {
+	entry.Time = time.Now()
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		r.setErr(fmt.Errorf("could not encode entry of call %d: %w", entry.Seq, err))
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, err := r.writer.Write(append(line, '\n')); err != nil && r.err == nil {
+		r.err = fmt.Errorf("could not write entry of call %d: %w", entry.Seq, err)
+	}
+}
+
+func (r *Recorder) setErr(err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+// readAll reads all the data of the reader and seeks back to the position
+// the reader was at, so that the call can still read it.
+func readAll(reader io.ReadSeeker) ([]byte, error) {
+	position, err := reader.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := reader.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := reader.Seek(position, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,205 @@
+package recorder_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klippa-app/go-pdfium"
+	"github.com/klippa-app/go-pdfium/recorder"
+	"github.com/klippa-app/go-pdfium/references"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeInstance is an instance that hands out a new document reference for
+// every document it opens, like the implementations do.
+type fakeInstance struct {
+	pdfium.Pdfium
+	prefix    string
+	documents map[references.FPDF_DOCUMENT][]byte
+	saved     [][]byte
+}
+
+func newFakeInstance(prefix string) *fakeInstance {
+	return &fakeInstance{
+		prefix:    prefix,
+		documents: map[references.FPDF_DOCUMENT][]byte{},
+	}
+}
+
+func (f *fakeInstance) FPDF_LoadMemDocument(request *requests.FPDF_LoadMemDocument) (*responses.FPDF_LoadMemDocument, error) {
+	document := references.FPDF_DOCUMENT(fmt.Sprintf("%s-%d", f.prefix, len(f.documents)))
+	f.documents[document] = *request.Data
+	return &responses.FPDF_LoadMemDocument{Document: document}, nil
+}
+
+func (f *fakeInstance) OpenDocument(request *requests.OpenDocument) (*responses.OpenDocument, error) {
+	data, err := io.ReadAll(request.FileReader)
+	if err != nil {
+		return nil, err
+	}
+
+	document := references.FPDF_DOCUMENT(fmt.Sprintf("%s-%d", f.prefix, len(f.documents)))
+	f.documents[document] = data
+	return &responses.OpenDocument{Document: document}, nil
+}
+
+func (f *fakeInstance) FPDF_GetPageCount(request *requests.FPDF_GetPageCount) (*responses.FPDF_GetPageCount, error) {
+	data, ok := f.documents[request.Document]
+	if !ok {
+		return nil, errors.New("could not find document handle")
+	}
+
+	return &responses.FPDF_GetPageCount{PageCount: len(data)}, nil
+}
+
+func (f *fakeInstance) FPDF_SaveAsCopy(request *requests.FPDF_SaveAsCopy) (*responses.FPDF_SaveAsCopy, error) {
+	data, ok := f.documents[request.Document]
+	if !ok {
+		return nil, errors.New("could not find document handle")
+	}
+
+	f.saved = append(f.saved, data)
+	_, err := request.FileWriter.Write(data)
+	return &responses.FPDF_SaveAsCopy{}, err
+}
+
+// call calls the method on the instance through the interceptor, like the
+// implementations do.
+func call[Request any, Response any](interceptor pdfium.Interceptor, method string, request *Request, next func(*Request) (*Response, error)) (*Response, error) {
+	resp, err := interceptor(context.Background(), method, request, func() (interface{}, error) {
+		return next(request)
+	})
+	typedResp, _ := resp.(*Response)
+	return typedResp, err
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	payloadDir := t.TempDir()
+	trace := &bytes.Buffer{}
+	rec, err := recorder.New(trace, recorder.Config{PayloadDir: payloadDir})
+	require.NoError(t, err)
+
+	interceptor := rec.Interceptor()
+	instance := newFakeInstance("recorded")
+
+	largeFile := bytes.Repeat([]byte("%PDF"), 1000)
+	doc, err := call(interceptor, "FPDF_LoadMemDocument", &requests.FPDF_LoadMemDocument{Data: &largeFile}, instance.FPDF_LoadMemDocument)
+	require.NoError(t, err)
+
+	smallFile := []byte("%PDF")
+	readerDoc, err := call(interceptor, "OpenDocument", &requests.OpenDocument{FileReader: bytes.NewReader(smallFile), FileReaderSize: int64(len(smallFile))}, instance.OpenDocument)
+	require.NoError(t, err)
+
+	_, err = call(interceptor, "FPDF_GetPageCount", &requests.FPDF_GetPageCount{Document: doc.Document}, instance.FPDF_GetPageCount)
+	require.NoError(t, err)
+
+	_, err = call(interceptor, "FPDF_GetPageCount", &requests.FPDF_GetPageCount{Document: "unknown"}, instance.FPDF_GetPageCount)
+	require.Error(t, err)
+
+	_, err = call(interceptor, "FPDF_SaveAsCopy", &requests.FPDF_SaveAsCopy{Document: readerDoc.Document, FileWriter: &bytes.Buffer{}}, instance.FPDF_SaveAsCopy)
+	require.NoError(t, err)
+	require.NoError(t, rec.Err())
+
+	entries, err := recorder.ReadTrace(bytes.NewReader(trace.Bytes()))
+	require.NoError(t, err)
+	require.Len(t, entries, 10)
+
+	// The large file is referenced by hash and written to the payload
+	// directory.
+	assert.Equal(t, recorder.EntryTypeCall, entries[0].Type)
+	assert.Equal(t, "FPDF_LoadMemDocument", entries[0].Method)
+	assert.JSONEq(t, `{"Data":null,"Password":null}`, string(entries[0].Request))
+	require.Contains(t, entries[0].Payloads, "Data")
+	assert.Equal(t, recorder.PayloadKindBytes, entries[0].Payloads["Data"].Kind)
+	assert.Equal(t, int64(len(largeFile)), entries[0].Payloads["Data"].Size)
+	payload, err := os.ReadFile(filepath.Join(payloadDir, entries[0].Payloads["Data"].SHA256))
+	require.NoError(t, err)
+	assert.Equal(t, largeFile, payload)
+
+	assert.Equal(t, recorder.EntryTypeResult, entries[1].Type)
+	assert.Equal(t, entries[0].Seq, entries[1].Seq)
+	assert.Equal(t, map[string]string{"Document": "recorded-0"}, entries[1].References)
+
+	// Readers are always referenced by hash.
+	assert.Equal(t, recorder.PayloadKindReader, entries[2].Payloads["FileReader"].Kind)
+	assert.Equal(t, "could not find document handle", entries[7].Error)
+	assert.Equal(t, recorder.PayloadKindWriter, entries[8].Payloads["FileWriter"].Kind)
+
+	replayInstance := newFakeInstance("replayed")
+	var results []*recorder.ReplayResult
+	err = recorder.Replay(context.Background(), replayInstance, bytes.NewReader(trace.Bytes()), recorder.ReplayConfig{
+		PayloadDir: payloadDir,
+		OnCall: func(result *recorder.ReplayResult) {
+			results = append(results, result)
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+
+	for _, result := range results {
+		assert.True(t, result.Recorded)
+		if result.RecordedError == "" {
+			assert.NoError(t, result.Error)
+		} else {
+			assert.EqualError(t, result.Error, result.RecordedError)
+		}
+	}
+
+	assert.Equal(t, largeFile, replayInstance.documents["replayed-0"])
+	assert.Equal(t, smallFile, replayInstance.documents["replayed-1"])
+	assert.Equal(t, [][]byte{smallFile}, replayInstance.saved)
+}
+
+func TestRecordCrashedCall(t *testing.T) {
+	trace := &bytes.Buffer{}
+	rec, err := recorder.New(trace, recorder.Config{})
+	require.NoError(t, err)
+
+	// The call entry is written before the call is made, so that it's in the
+	// trace when the process crashes during the call.
+	assert.Panics(t, func() {
+		rec.Interceptor()(context.Background(), "FPDF_GetPageCount", &requests.FPDF_GetPageCount{Document: "document"}, func() (interface{}, error) {
+			panic("crash")
+		})
+	})
+
+	entries, err := recorder.ReadTrace(bytes.NewReader(trace.Bytes()))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, recorder.EntryTypeCall, entries[0].Type)
+	assert.JSONEq(t, `{"Document":"document"}`, string(entries[0].Request))
+
+	results := 0
+	err = recorder.Replay(context.Background(), newFakeInstance("replayed"), bytes.NewReader(trace.Bytes()), recorder.ReplayConfig{
+		OnCall: func(result *recorder.ReplayResult) {
+			results++
+			assert.False(t, result.Recorded)
+			assert.EqualError(t, result.Error, "could not find document handle")
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, results)
+}
+
+func TestReplayMissingPayload(t *testing.T) {
+	trace := &bytes.Buffer{}
+	rec, err := recorder.New(trace, recorder.Config{})
+	require.NoError(t, err)
+
+	largeFile := bytes.Repeat([]byte("%PDF"), 1000)
+	_, err = call(rec.Interceptor(), "FPDF_LoadMemDocument", &requests.FPDF_LoadMemDocument{Data: &largeFile}, newFakeInstance("recorded").FPDF_LoadMemDocument)
+	require.NoError(t, err)
+
+	err = recorder.Replay(context.Background(), newFakeInstance("replayed"), trace, recorder.ReplayConfig{})
+	assert.ErrorContains(t, err, "payload directory must be given")
+}
@@ -0,0 +1,254 @@
+package recorder
+
+import (
+	"bufio"
+	"bytes"
+	goctx "context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	"github.com/klippa-app/go-pdfium"
+)
+
+// ReplayConfig configures Replay.
+type ReplayConfig struct {
+	// PayloadDir is the directory to read the payloads from, this is the
+	// PayloadDir of the recorder.
+	PayloadDir string
+
+	// OnCall is called after every call with the result, may be nil.
+	OnCall func(result *ReplayResult)
+}
+
+// ReplayResult is the result of a call of the trace.
+type ReplayResult struct {
+	Seq           uint64
+	Method        string
+	Duration      time.Duration
+	Error         error  // The error of the call in the replay.
+	Recorded      bool   // Whether the trace contains the result of the call. When the recording process crashed during the call, it doesn't.
+	RecordedError string // The error of the call in the trace.
+}
+
+// ReadTrace reads the entries of a trace.
+func ReadTrace(trace io.Reader) ([]Entry, error) {
+	entries := []Entry{}
+
+	scanner := bufio.NewScanner(trace)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		entry := Entry{}
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("could not read line %d of trace: %w", line, err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read trace: %w", err)
+	}
+
+	return entries, nil
+}
+
+// Replay executes the calls of the trace on the given instance, in the order
+// in which they were made. The references in the requests are mapped to the
+// references that the instance returned for the same calls. A call that
+// returns an error doesn't stop the replay, because the call might have
+// returned an error in the trace too, compare the results in OnCall. Replay
+// only returns an error when the trace can't be replayed.
+func Replay(ctx goctx.Context, instance pdfium.Pdfium, trace io.Reader, config ReplayConfig) error {
+	entries, err := ReadTrace(trace)
+	if err != nil {
+		return err
+	}
+
+	results := map[uint64]*Entry{}
+	for i := range entries {
+		if entries[i].Type == EntryTypeResult {
+			results[entries[i].Seq] = &entries[i]
+		}
+	}
+
+	references := map[string]string{}
+	for i := range entries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		call := &entries[i]
+		if call.Type != EntryTypeCall {
+			continue
+		}
+
+		method, requestType, err := replayMethod(instance, call.Method)
+		if err != nil {
+			return fmt.Errorf("could not replay call %d: %w", call.Seq, err)
+		}
+
+		request, err := replayRequest(call, requestType, references, config.PayloadDir)
+		if err != nil {
+			return fmt.Errorf("could not replay call %d: %w", call.Seq, err)
+		}
+
+		args := []reflect.Value{request}
+		if method.Type().NumIn() == 2 {
+			args = []reflect.Value{reflect.ValueOf(ctx), request}
+		}
+
+		start := time.Now()
+		out := method.Call(args)
+		result := &ReplayResult{
+			Seq:      call.Seq,
+			Method:   call.Method,
+			Duration: time.Since(start),
+		}
+		result.Error, _ = out[len(out)-1].Interface().(error)
+
+		if recorded, ok := results[call.Seq]; ok {
+			result.Recorded = true
+			result.RecordedError = recorded.Error
+
+			if len(out) == 2 && !out[0].IsNil() {
+				replayed := collectReferences(out[0])
+				for path, reference := range recorded.References {
+					if replayedReference, ok := replayed[path]; ok {
+						references[reference] = replayedReference
+					}
+				}
+			}
+		}
+
+		if config.OnCall != nil {
+			config.OnCall(result)
+		}
+	}
+
+	return nil
+}
+
+// replayMethod returns the method of the instance and the type of its
+// request, the context-aware variant is preferred.
+func replayMethod(instance pdfium.Pdfium, name string) (reflect.Value, reflect.Type, error) {
+	instanceValue := reflect.ValueOf(instance)
+	contextType := reflect.TypeOf((*goctx.Context)(nil)).Elem()
+
+	if method := instanceValue.MethodByName(name + "WithContext"); method.IsValid() {
+		methodType := method.Type()
+		if methodType.NumIn() == 2 && methodType.In(0) == contextType && methodType.In(1).Kind() == reflect.Ptr {
+			return method, methodType.In(1).Elem(), nil
+		}
+	}
+
+	if method := instanceValue.MethodByName(name); method.IsValid() {
+		methodType := method.Type()
+		if methodType.NumIn() == 1 && methodType.In(0).Kind() == reflect.Ptr {
+			return method, methodType.In(0).Elem(), nil
+		}
+	}
+
+	return reflect.Value{}, nil, fmt.Errorf("instance doesn't support method %s", name)
+}
+
+// replayRequest decodes the request of the call and restores its payloads
+// and references.
+func replayRequest(call *Entry, requestType reflect.Type, references map[string]string, payloadDir string) (reflect.Value, error) {
+	request := reflect.New(requestType)
+	if len(call.Request) > 0 {
+		if err := json.Unmarshal(call.Request, request.Interface()); err != nil {
+			return reflect.Value{}, fmt.Errorf("could not decode request: %w", err)
+		}
+	}
+
+	var payloadErr error
+	restored := copyValue(request, "", func(value reflect.Value, path string) (reflect.Value, bool) {
+		if isReference(value.Type()) {
+			if reference, ok := references[value.String()]; ok {
+				return reflect.ValueOf(reference).Convert(value.Type()), true
+			}
+			return reflect.Value{}, false
+		}
+
+		payload, ok := call.Payloads[path]
+		if !ok {
+			return reflect.Value{}, false
+		}
+
+		replacement, err := restorePayload(value.Type(), payload, payloadDir)
+		if err != nil {
+			payloadErr = fmt.Errorf("could not restore payload %s: %w", path, err)
+			return reflect.Value{}, false
+		}
+
+		return replacement, replacement.IsValid()
+	})
+	if payloadErr != nil {
+		return reflect.Value{}, payloadErr
+	}
+
+	return restored, nil
+}
+
+// restorePayload returns the value of the given type for the payload. It
+// returns an invalid value when the value should be restored by the fields
+// below it.
+func restorePayload(valueType reflect.Type, payload Payload, payloadDir string) (reflect.Value, error) {
+	switch payload.Kind {
+	case PayloadKindBytes, PayloadKindReader:
+		// The path of a pointer to a byte slice is the same as the path of
+		// the byte slice.
+		switch valueType.Kind() {
+		case reflect.Slice, reflect.Interface:
+		case reflect.Ptr:
+			if valueType.Elem().Kind() != reflect.Slice {
+				return reflect.Value{}, nil
+			}
+		default:
+			return reflect.Value{}, nil
+		}
+
+		data, err := readPayload(payload, payloadDir)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		switch valueType.Kind() {
+		case reflect.Ptr:
+			value := reflect.New(valueType.Elem())
+			value.Elem().Set(reflect.ValueOf(data).Convert(valueType.Elem()))
+			return value, nil
+		case reflect.Slice:
+			return reflect.ValueOf(data).Convert(valueType), nil
+		}
+
+		return reflect.ValueOf(bytes.NewReader(data)), nil
+	case PayloadKindWriter:
+		return reflect.ValueOf(io.Discard), nil
+	}
+
+	return reflect.Zero(valueType), nil
+}
+
+func readPayload(payload Payload, payloadDir string) ([]byte, error) {
+	if payloadDir == "" {
+		return nil, errors.New("payload directory must be given")
+	}
+
+	data, err := os.ReadFile(filepath.Join(payloadDir, payload.SHA256))
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,111 @@
+package recorder
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// referencesPackage is the package of the reference types, the values of these
+// types are mapped to the references of the replay.
+const referencesPackage = "github.com/klippa-app/go-pdfium/references"
+
+// copyValue returns a deep copy of the value, in which the values for which
+// replace returns true are replaced. The path is the path of the field in the
+// request, like Pages.0.Page.ByIndex.Document.
+func copyValue(value reflect.Value, path string, replace func(value reflect.Value, path string) (reflect.Value, bool)) reflect.Value {
+	if replacement, ok := replace(value, path); ok {
+		return replacement
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return value
+		}
+
+		copied := reflect.New(value.Type().Elem())
+		copied.Elem().Set(copyValue(value.Elem(), path, replace))
+		return copied
+	case reflect.Struct:
+		copied := reflect.New(value.Type()).Elem()
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			copied.Field(i).Set(copyValue(value.Field(i), joinPath(path, field.Name), replace))
+		}
+		return copied
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+
+		copied := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for i := 0; i < value.Len(); i++ {
+			copied.Index(i).Set(copyValue(value.Index(i), joinPath(path, strconv.Itoa(i)), replace))
+		}
+		return copied
+	case reflect.Array:
+		copied := reflect.New(value.Type()).Elem()
+		for i := 0; i < value.Len(); i++ {
+			copied.Index(i).Set(copyValue(value.Index(i), joinPath(path, strconv.Itoa(i)), replace))
+		}
+		return copied
+	}
+
+	return value
+}
+
+// collectReferences returns the references in the value by their path.
+func collectReferences(value reflect.Value) map[string]string {
+	references := map[string]string{}
+	walkReferences(value, "", references)
+	return references
+}
+
+func walkReferences(value reflect.Value, path string, references map[string]string) {
+	if isReference(value.Type()) {
+		if value.String() != "" {
+			references[path] = value.String()
+		}
+		return
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr:
+		if !value.IsNil() {
+			walkReferences(value.Elem(), path, references)
+		}
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if field.IsExported() {
+				walkReferences(value.Field(i), joinPath(path, field.Name), references)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		// Don't walk through the pixels of images and other data that can't
+		// contain references.
+		if value.Type().Elem().Kind() <= reflect.Complex128 {
+			return
+		}
+
+		for i := 0; i < value.Len(); i++ {
+			walkReferences(value.Index(i), joinPath(path, strconv.Itoa(i)), references)
+		}
+	}
+}
+
+func isReference(valueType reflect.Type) bool {
+	return valueType.Kind() == reflect.String && valueType.PkgPath() == referencesPackage
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
@@ -3,6 +3,7 @@ package requests
 import (
 	"github.com/klippa-app/go-pdfium/enums"
 	"github.com/klippa-app/go-pdfium/references"
+	"github.com/klippa-app/go-pdfium/structs"
 )
 
 type RenderImageFormat string // The pixel format of the rendered image.
@@ -13,12 +14,14 @@ const (
 )
 
 type RenderPageInDPI struct {
-	Page        Page
-	DPI         int                       // The DPI to render the page in.
-	RenderFlags enums.FPDF_RENDER_FLAG    // FPDF_RENDER_FLAG_REVERSE_BYTE_ORDER will always be set to render to Go image, except when ImageFormat is RenderImageFormatGrayscale.
-	RenderForm  bool                      // Whether to render form elements.
-	Document    *references.FPDF_DOCUMENT // The document to render if not passed through the page by index, required when RenderForm is true.
-	ImageFormat RenderImageFormat         // The pixel format to render in, an empty value means RenderImageFormatRGBA. When rendering multiple pages into one image, all pages must have the same ImageFormat.
+	Page            Page
+	DPI             int                       // The DPI to render the page in.
+	RenderFlags     enums.FPDF_RENDER_FLAG    // FPDF_RENDER_FLAG_REVERSE_BYTE_ORDER will always be set to render to Go image, except when ImageFormat is RenderImageFormatGrayscale.
+	RenderForm      bool                      // Whether to render form elements.
+	Document        *references.FPDF_DOCUMENT // The document to render if not passed through the page by index, required when RenderForm is true.
+	ImageFormat     RenderImageFormat         // The pixel format to render in, an empty value means RenderImageFormatRGBA. When rendering multiple pages into one image, all pages must have the same ImageFormat.
+	ColorScheme     *structs.FPDF_COLORSCHEME // The colors to render the page content with, for example for a dark mode or high contrast rendering. Experimental API on the cgo backend, it requires the pdfium_experimental build tag there.
+	BackgroundColor *structs.FPDF_COLOR       // The color to fill the page with before rendering. When not set, the page is filled with white, or with transparent black when the page has transparency and ImageFormat is RenderImageFormatRGBA.
 }
 
 type RenderPagesInDPI struct {
@@ -27,24 +30,64 @@ type RenderPagesInDPI struct {
 }
 
 type RenderPageInPixels struct {
-	Page        Page
-	Width       int                       // The maximum width of the image.
-	Height      int                       // The maximum height of the image.
-	RenderFlags enums.FPDF_RENDER_FLAG    // FPDF_RENDER_FLAG_REVERSE_BYTE_ORDER will always be set to render to Go image, except when ImageFormat is RenderImageFormatGrayscale.
-	RenderForm  bool                      // Whether to render form elements.
-	Document    *references.FPDF_DOCUMENT // The document to render if not passed through the page by index, required when RenderForm is true.
-	ImageFormat RenderImageFormat         // The pixel format to render in, an empty value means RenderImageFormatRGBA. When rendering multiple pages into one image, all pages must have the same ImageFormat.
+	Page            Page
+	Width           int                       // The maximum width of the image.
+	Height          int                       // The maximum height of the image.
+	RenderFlags     enums.FPDF_RENDER_FLAG    // FPDF_RENDER_FLAG_REVERSE_BYTE_ORDER will always be set to render to Go image, except when ImageFormat is RenderImageFormatGrayscale.
+	RenderForm      bool                      // Whether to render form elements.
+	Document        *references.FPDF_DOCUMENT // The document to render if not passed through the page by index, required when RenderForm is true.
+	ImageFormat     RenderImageFormat         // The pixel format to render in, an empty value means RenderImageFormatRGBA. When rendering multiple pages into one image, all pages must have the same ImageFormat.
+	ColorScheme     *structs.FPDF_COLORSCHEME // The colors to render the page content with, for example for a dark mode or high contrast rendering. Experimental API on the cgo backend, it requires the pdfium_experimental build tag there.
+	BackgroundColor *structs.FPDF_COLOR       // The color to fill the page with before rendering. When not set, the page is filled with white, or with transparent black when the page has transparency and ImageFormat is RenderImageFormatRGBA.
 }
 
 type RenderPagesInPixels struct {
 	Pages   []RenderPageInPixels // The pages
 	Padding int                  // The amount of padding (in pixels) between the images
 }
+
+// RenderRegion is a rectangle on a page in points, relative to the top-left
+// corner of the page as it's displayed, so with the rotation of the page
+// applied, like in the images of RenderPageInDPI.
+type RenderRegion struct {
+	X      float64 // The distance from the left side of the page to the left side of the region.
+	Y      float64 // The distance from the top of the page to the top of the region.
+	Width  float64 // The width of the region.
+	Height float64 // The height of the region.
+}
+
+type RenderPageRegion struct {
+	Page            Page
+	Region          RenderRegion              // The region of the page to render.
+	DPI             int                       // The DPI to render the region in. When not given, the region is rendered in the pixel size of Width and Height.
+	Width           int                       // The maximum width of the image, only used when DPI is not given.
+	Height          int                       // The maximum height of the image, only used when DPI is not given.
+	RenderFlags     enums.FPDF_RENDER_FLAG    // FPDF_RENDER_FLAG_REVERSE_BYTE_ORDER will always be set to render to Go image, except when ImageFormat is RenderImageFormatGrayscale.
+	RenderForm      bool                      // Whether to render form elements.
+	Document        *references.FPDF_DOCUMENT // The document to render if not passed through the page by index, required when RenderForm is true.
+	ImageFormat     RenderImageFormat         // The pixel format to render in, an empty value means RenderImageFormatRGBA.
+	ColorScheme     *structs.FPDF_COLORSCHEME // The colors to render the page content with, for example for a dark mode or high contrast rendering. Experimental API on the cgo backend, it requires the pdfium_experimental build tag there.
+	BackgroundColor *structs.FPDF_COLOR       // The color to fill the page with before rendering. When not set, the page is filled with white, or with transparent black when the page has transparency and ImageFormat is RenderImageFormatRGBA.
+}
 type RenderToFileOutputFormat string // The file format to render output as.
 
 const (
 	RenderToFileOutputFormatJPG RenderToFileOutputFormat = "jpg" // Render the file as a JPEG file.
 	RenderToFileOutputFormatPNG RenderToFileOutputFormat = "png" // Render the file as a PNG file.
+
+	// RenderToFileOutputFormatTIFF renders the file as a TIFF file. Every page
+	// is written as its own image in a multi-page TIFF file, the pages of
+	// RenderPagesInDPI and RenderPagesInPixels are not stitched together.
+	RenderToFileOutputFormatTIFF RenderToFileOutputFormat = "tiff"
+)
+
+type RenderToFileTIFFCompression string // The compression of a page in a TIFF file.
+
+const (
+	RenderToFileTIFFCompressionDeflate RenderToFileTIFFCompression = "deflate"  // Lossless Deflate compression, the default.
+	RenderToFileTIFFCompressionLZW     RenderToFileTIFFCompression = "lzw"      // Lossless LZW compression.
+	RenderToFileTIFFCompressionNone    RenderToFileTIFFCompression = "none"     // No compression.
+	RenderToFileTIFFCompressionCCITTG4 RenderToFileTIFFCompression = "ccitt_g4" // CCITT Group 4 compression, the page is converted to 1-bit black and white. Meant for text pages and fax.
 )
 
 type RenderToFileOutputTarget string // The file target output.
@@ -55,14 +98,17 @@ const (
 )
 
 type RenderToFile struct {
-	RenderPageInDPI     *RenderPageInDPI         // To execute the RenderPageInDPI request
-	RenderPagesInDPI    *RenderPagesInDPI        // To execute the RenderPagesInDPI request
-	RenderPageInPixels  *RenderPageInPixels      // To execute the RenderPageInPixels request
-	RenderPagesInPixels *RenderPagesInPixels     // To execute the RenderPagesInPixels request
-	OutputFormat        RenderToFileOutputFormat // The format to output the image as
-	OutputTarget        RenderToFileOutputTarget // Where to output the image
-	OutputQuality       int                      // Only used when OutputFormat RenderToFileOutputFormatJPG. Ranges from 1 to 100 inclusive, higher is better. The default is 95.
-	Progressive         bool                     // Only used when OutputFormat RenderToFileOutputFormatJPG. Will render a progressive jpeg. Requires build tag pdfium_use_turbojpeg on the cgo backend; supported natively on the webassembly backend.
-	MaxFileSize         int64                    // The maximum file size, when OutputFormat RenderToFileOutputFormatJPG, it will try to lower the quality it until it fits.
-	TargetFilePath      string                   // When OutputTarget is file, the path to write it to, if not given, a temp file is created
+	RenderPageInDPI     *RenderPageInDPI              // To execute the RenderPageInDPI request
+	RenderPagesInDPI    *RenderPagesInDPI             // To execute the RenderPagesInDPI request
+	RenderPageInPixels  *RenderPageInPixels           // To execute the RenderPageInPixels request
+	RenderPagesInPixels *RenderPagesInPixels          // To execute the RenderPagesInPixels request
+	RenderPageRegion    *RenderPageRegion             // To execute the RenderPageRegion request
+	OutputFormat        RenderToFileOutputFormat      // The format to output the image as
+	OutputTarget        RenderToFileOutputTarget      // Where to output the image
+	OutputQuality       int                           // Only used when OutputFormat RenderToFileOutputFormatJPG. Ranges from 1 to 100 inclusive, higher is better. The default is 95.
+	Progressive         bool                          // Only used when OutputFormat RenderToFileOutputFormatJPG. Will render a progressive jpeg. Requires build tag pdfium_use_turbojpeg on the cgo backend; supported natively on the webassembly backend.
+	MaxFileSize         int64                         // The maximum file size, when OutputFormat RenderToFileOutputFormatJPG, it will try to lower the quality it until it fits.
+	TargetFilePath      string                        // When OutputTarget is file, the path to write it to, if not given, a temp file is created
+	TIFFCompression     RenderToFileTIFFCompression   // Only used when OutputFormat RenderToFileOutputFormatTIFF. The compression of the pages, RenderToFileTIFFCompressionDeflate when not set.
+	TIFFPageCompression []RenderToFileTIFFCompression // Only used when OutputFormat RenderToFileOutputFormatTIFF. The compression per page, by the index of the page in the render request, overwrites TIFFCompression for the pages that it's set for. For example to use CCITT G4 for text pages and Deflate for colour pages.
 }
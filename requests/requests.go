@@ -14,6 +14,10 @@ type OpenDocument struct {
 	Password       *string // The password of the document.
 }
 
+type ListOpenHandles struct {
+	Document references.FPDF_DOCUMENT // A reference to a document, optional. When not given, the open handles of all documents of the instance are returned.
+}
+
 type PageByIndex struct {
 	Document references.FPDF_DOCUMENT // A reference to a document.
 	Index    int                      // The page number (0-index based).
@@ -118,11 +118,12 @@ func (r *RenderPages) GobDecode(data []byte) error {
 
 type RenderPageInPixels struct {
 	Result      RenderPage
-	CleanupFunc func() // In WebAssembly you MUST call Cleanup() when you are done with the image object to release resources.
+	CleanupFunc func() // In WebAssembly and with shared memory in multi-threaded usage you MUST call Cleanup() when you are done with the image object to release resources.
 }
 
-// Cleanup should be called when using the WebAssembly runtime and when you're
-// done with the Image object to release resources.
+// Cleanup should be called when using the WebAssembly runtime or shared memory
+// in multi-threaded usage and when you're done with the Image object to release
+// resources.
 func (r *RenderPageInPixels) Cleanup() {
 	if r.CleanupFunc != nil {
 		r.CleanupFunc()
@@ -131,11 +132,12 @@ func (r *RenderPageInPixels) Cleanup() {
 
 type RenderPagesInPixels struct {
 	Result      RenderPages
-	CleanupFunc func() // In WebAssembly you MUST call Cleanup() when you are done with the image object to release resources.
+	CleanupFunc func() // In WebAssembly and with shared memory in multi-threaded usage you MUST call Cleanup() when you are done with the image object to release resources.
 }
 
-// Cleanup should be called when using the WebAssembly runtime and when you're
-// done with the Image object to release resources.
+// Cleanup should be called when using the WebAssembly runtime or shared memory
+// in multi-threaded usage and when you're done with the Image object to release
+// resources.
 func (r *RenderPagesInPixels) Cleanup() {
 	if r.CleanupFunc != nil {
 		r.CleanupFunc()
@@ -144,11 +146,12 @@ func (r *RenderPagesInPixels) Cleanup() {
 
 type RenderPageInDPI struct {
 	Result      RenderPage
-	CleanupFunc func() // In WebAssembly you MUST call Cleanup() when you are done with the image object to release resources.
+	CleanupFunc func() // In WebAssembly and with shared memory in multi-threaded usage you MUST call Cleanup() when you are done with the image object to release resources.
 }
 
-// Cleanup should be called when using the WebAssembly runtime and when you're
-// done with the Image object to release resources.
+// Cleanup should be called when using the WebAssembly runtime or shared memory
+// in multi-threaded usage and when you're done with the Image object to release
+// resources.
 func (r *RenderPageInDPI) Cleanup() {
 	if r.CleanupFunc != nil {
 		r.CleanupFunc()
@@ -157,17 +160,32 @@ func (r *RenderPageInDPI) Cleanup() {
 
 type RenderPagesInDPI struct {
 	Result      RenderPages
-	CleanupFunc func() // In WebAssembly you MUST call Cleanup() when you are done with the image object to release resources.
+	CleanupFunc func() // In WebAssembly and with shared memory in multi-threaded usage you MUST call Cleanup() when you are done with the image object to release resources.
 }
 
-// Cleanup should be called when using the WebAssembly runtime and when you're
-// done with the Image object to release resources.
+// Cleanup should be called when using the WebAssembly runtime or shared memory
+// in multi-threaded usage and when you're done with the Image object to release
+// resources.
 func (r *RenderPagesInDPI) Cleanup() {
 	if r.CleanupFunc != nil {
 		r.CleanupFunc()
 	}
 }
 
+type RenderPageRegion struct {
+	Result      RenderPage // The rendered region, the Width and Height are the size of the image.
+	CleanupFunc func()     // In WebAssembly and with shared memory in multi-threaded usage you MUST call Cleanup() when you are done with the image object to release resources.
+}
+
+// Cleanup should be called when using the WebAssembly runtime or shared memory
+// in multi-threaded usage and when you're done with the Image object to release
+// resources.
+func (r *RenderPageRegion) Cleanup() {
+	if r.CleanupFunc != nil {
+		r.CleanupFunc()
+	}
+}
+
 type RenderToFile struct {
 	Pages             []RenderPagesPage // Information about the rendered pages inside this image.
 	ImageBytes        *[]byte           // The byte array of the rendered file when OutputTarget is RenderToFileOutputTargetBytes.
@@ -8,6 +8,26 @@ type OpenDocument struct {
 	Document references.FPDF_DOCUMENT
 }
 
+type OpenHandle struct {
+	Type      string // The type of the handle, like FPDF_PAGE.
+	Reference string // The reference of the handle.
+}
+
+type OpenHandleCount struct {
+	Type  string // The type of the handle, like FPDF_PAGE.
+	Count int    // The amount of open handles of this type.
+}
+
+type DocumentOpenHandles struct {
+	Document references.FPDF_DOCUMENT // The document of the handles, empty for the handles that don't belong to a document, like bitmaps.
+	Counts   []OpenHandleCount        // The amount of open handles by type, sorted by type.
+	Handles  []OpenHandle             // The open handles, sorted by type and reference.
+}
+
+type ListOpenHandles struct {
+	Documents []DocumentOpenHandles // The open documents with their open handles, sorted by document. The handles that don't belong to a document come first, when there are any.
+}
+
 type NewPage struct {
 	Page references.FPDF_PAGE
 }
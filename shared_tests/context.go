@@ -0,0 +1,77 @@
+package shared_tests
+
+import (
+	"context"
+	"io/ioutil"
+
+	"github.com/klippa-app/go-pdfium"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("context", func() {
+	BeforeEach(func() {
+		Locker.Lock()
+	})
+
+	AfterEach(func() {
+		Locker.Unlock()
+	})
+
+	It("implements the context-aware interface", func() {
+		_, ok := PdfiumInstance.(pdfium.PdfiumWithContext)
+		Expect(ok).To(BeTrue())
+	})
+
+	Context("a normal PDF file", func() {
+		It("calls PDFium with an active context", func() {
+			instance := PdfiumInstance.(pdfium.PdfiumWithContext)
+
+			pdfData, err := ioutil.ReadFile(TestDataPath + "/testdata/test.pdf")
+			Expect(err).To(BeNil())
+
+			doc, err := instance.FPDF_LoadMemDocumentWithContext(context.Background(), &requests.FPDF_LoadMemDocument{
+				Data: &pdfData,
+			})
+			Expect(err).To(BeNil())
+
+			pageCount, err := instance.FPDF_GetPageCountWithContext(context.Background(), &requests.FPDF_GetPageCount{
+				Document: doc.Document,
+			})
+			Expect(err).To(BeNil())
+			Expect(pageCount).To(Equal(&responses.FPDF_GetPageCount{
+				PageCount: 1,
+			}))
+
+			FPDF_CloseDocument, err := instance.FPDF_CloseDocumentWithContext(context.Background(), &requests.FPDF_CloseDocument{
+				Document: doc.Document,
+			})
+			Expect(err).To(BeNil())
+			Expect(FPDF_CloseDocument).To(Not(BeNil()))
+		})
+
+		It("does not call PDFium with a cancelled context", func() {
+			instance := PdfiumInstance.(pdfium.PdfiumWithContext)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			pdfData, err := ioutil.ReadFile(TestDataPath + "/testdata/test.pdf")
+			Expect(err).To(BeNil())
+
+			doc, err := instance.FPDF_LoadMemDocumentWithContext(ctx, &requests.FPDF_LoadMemDocument{
+				Data: &pdfData,
+			})
+			Expect(err).To(MatchError(context.Canceled))
+			Expect(doc).To(BeNil())
+
+			// The instance can still be used after that.
+			pageCount, err := instance.FPDF_GetPageCount(&requests.FPDF_GetPageCount{})
+			Expect(err).To(MatchError("document not given"))
+			Expect(pageCount).To(BeNil())
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package shared_tests
 
 import (
+	stderrors "errors"
 	"io/ioutil"
 	"os"
 
@@ -161,6 +162,19 @@ var _ = Describe("document", func() {
 					Expect(err).To(MatchError(errors.ErrPassword.Error()))
 					Expect(doc).To(BeNil())
 				})
+
+				It("returns a typed password error", func() {
+					_, err := PdfiumInstance.FPDF_LoadMemDocument(&requests.FPDF_LoadMemDocument{
+						Data: &pdfData,
+					})
+					Expect(stderrors.Is(err, errors.ErrPassword)).To(BeTrue())
+					Expect(errors.GetCategory(err)).To(Equal(errors.CategoryPassword))
+
+					var pdfiumError *errors.Error
+					Expect(stderrors.As(err, &pdfiumError)).To(BeTrue())
+					Expect(pdfiumError.Code).To(Equal(errors.CodePassword))
+					Expect(pdfiumError.Method).To(Equal("FPDF_LoadMemDocument"))
+				})
 			})
 
 			When("is opened with the wrong password", func() {
@@ -111,10 +111,6 @@ var _ = Describe("file readers", func() {
 
 		When("is opened with a reader that can't deliver the whole requested range", func() {
 			It("returns an error instead of handing pdfium a partial buffer", func() {
-				if TestType == "multi" {
-					Skip("Multi-threaded usage reads the file reader into memory, so it never issues partial reads")
-				}
-
 				// Only the first half of the file is available, while pdfium
 				// is told the file has its full size. Every read past the
 				// halfway point can't be filled completely, which has to be
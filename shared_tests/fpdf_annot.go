@@ -1640,10 +1640,6 @@ var _ = Describe("fpdf_annot", func() {
 		var formHandle references.FPDF_FORMHANDLE
 
 		BeforeEach(func() {
-			if TestType == "multi" {
-				Skip("Form filling is not supported on multi-threaded usage")
-			}
-
 			pdfData, err := ioutil.ReadFile(TestDataPath + "/testdata/click_form.pdf")
 			Expect(err).To(BeNil())
 
@@ -1683,10 +1679,6 @@ var _ = Describe("fpdf_annot", func() {
 		})
 
 		AfterEach(func() {
-			if TestType == "multi" {
-				Skip("Form filling is not supported on multi-threaded usage")
-			}
-
 			FPDF_CloseDocument, err := PdfiumInstance.FPDF_CloseDocument(&requests.FPDF_CloseDocument{
 				Document: doc,
 			})
@@ -1747,10 +1739,6 @@ var _ = Describe("fpdf_annot", func() {
 		var formHandle references.FPDF_FORMHANDLE
 
 		BeforeEach(func() {
-			if TestType == "multi" {
-				Skip("Form filling is not supported on multi-threaded usage")
-			}
-
 			pdfData, err := ioutil.ReadFile(TestDataPath + "/testdata/annot_javascript.pdf")
 			Expect(err).To(BeNil())
 
@@ -1790,10 +1778,6 @@ var _ = Describe("fpdf_annot", func() {
 		})
 
 		AfterEach(func() {
-			if TestType == "multi" {
-				Skip("Form filling is not supported on multi-threaded usage")
-			}
-
 			FPDF_CloseDocument, err := PdfiumInstance.FPDF_CloseDocument(&requests.FPDF_CloseDocument{
 				Document: doc,
 			})
@@ -70,16 +70,10 @@ func (f *FakeReadSeeker) AddSegmentCallback(offset, size uint64) {
 
 var _ = Describe("fpdf_dataavail", func() {
 	BeforeEach(func() {
-		if TestType == "multi" {
-			Skip("Multi-threaded usage does not support setting callbacks")
-		}
 		Locker.Lock()
 	})
 
 	AfterEach(func() {
-		if TestType == "multi" {
-			Skip("Multi-threaded usage does not support setting callbacks")
-		}
 		Locker.Unlock()
 	})
 
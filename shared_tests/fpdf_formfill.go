@@ -22,16 +22,10 @@ import (
 
 var _ = Describe("fpdf_formfill", func() {
 	BeforeEach(func() {
-		if TestType == "multi" {
-			Skip("Form filling is not supported on multi-threaded usage")
-		}
 		Locker.Lock()
 	})
 
 	AfterEach(func() {
-		if TestType == "multi" {
-			Skip("Form filling is not supported on multi-threaded usage")
-		}
 		Locker.Unlock()
 	})
 
@@ -467,6 +461,20 @@ var _ = Describe("fpdf_formfill", func() {
 
 			renderCount = 0
 			img = image.NewRGBA(image.Rect(0, 0, 300, 300))
+
+			// External memory can't be shared with the worker.
+			if TestType == "multi" {
+				FPDFBitmap_Create, err := PdfiumInstance.FPDFBitmap_Create(&requests.FPDFBitmap_Create{
+					Width:  300,
+					Height: 300,
+					Alpha:  1,
+				})
+				Expect(err).To(BeNil())
+				Expect(FPDFBitmap_Create).To(Not(BeNil()))
+				bitmap = FPDFBitmap_Create.Bitmap
+				return
+			}
+
 			FPDFBitmap_CreateEx, err := PdfiumInstance.FPDFBitmap_CreateEx(&requests.FPDFBitmap_CreateEx{
 				Width:   300,
 				Height:  300,
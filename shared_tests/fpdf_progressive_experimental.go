@@ -16,16 +16,10 @@ import (
 
 var _ = Describe("fpdf_progressive_experimental", func() {
 	BeforeEach(func() {
-		if TestType == "multi" {
-			Skip("Multi-threaded usage does not support setting callbacks")
-		}
 		Locker.Lock()
 	})
 
 	AfterEach(func() {
-		if TestType == "multi" {
-			Skip("Multi-threaded usage does not support setting callbacks")
-		}
 		Locker.Unlock()
 	})
 
@@ -111,12 +111,6 @@ var _ = Describe("fpdf_save", func() {
 			})
 
 			Context("and saved to a io.Writer", func() {
-				BeforeEach(func() {
-					if TestType == "multi" {
-						Skip("Multi-threaded usage does not support io.Writer")
-					}
-				})
-
 				It("it returns the correct bytes", func() {
 					buffer := bytes.Buffer{}
 					FPDF_SaveAsCopy, err := PdfiumInstance.FPDF_SaveAsCopy(&requests.FPDF_SaveAsCopy{
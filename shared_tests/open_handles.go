@@ -0,0 +1,150 @@
+package shared_tests
+
+import (
+	"io/ioutil"
+
+	"github.com/klippa-app/go-pdfium/references"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/responses"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ListOpenHandles", func() {
+	BeforeEach(func() {
+		Locker.Lock()
+	})
+
+	AfterEach(func() {
+		Locker.Unlock()
+	})
+
+	Context("no document", func() {
+		It("returns an error when the document doesn't exist", func() {
+			openHandles, err := PdfiumInstance.ListOpenHandles(&requests.ListOpenHandles{
+				Document: "unknown",
+			})
+			Expect(err).To(MatchError("could not find document handle, perhaps the doc was already closed or you tried to share documents between instances"))
+			Expect(openHandles).To(BeNil())
+		})
+	})
+
+	Context("a normal PDF file", func() {
+		var doc references.FPDF_DOCUMENT
+
+		BeforeEach(func() {
+			pdfData, err := ioutil.ReadFile(TestDataPath + "/testdata/test.pdf")
+			Expect(err).To(BeNil())
+
+			newDoc, err := PdfiumInstance.FPDF_LoadMemDocument(&requests.FPDF_LoadMemDocument{
+				Data: &pdfData,
+			})
+			Expect(err).To(BeNil())
+
+			doc = newDoc.Document
+		})
+
+		AfterEach(func() {
+			FPDF_CloseDocument, err := PdfiumInstance.FPDF_CloseDocument(&requests.FPDF_CloseDocument{
+				Document: doc,
+			})
+			Expect(err).To(BeNil())
+			Expect(FPDF_CloseDocument).To(Not(BeNil()))
+		})
+
+		It("returns no handles for a document without open handles", func() {
+			openHandles, err := PdfiumInstance.ListOpenHandles(&requests.ListOpenHandles{
+				Document: doc,
+			})
+			Expect(err).To(BeNil())
+			Expect(openHandles.Documents).To(HaveLen(1))
+			Expect(openHandles.Documents[0].Document).To(Equal(doc))
+			Expect(openHandles.Documents[0].Counts).To(BeEmpty())
+			Expect(openHandles.Documents[0].Handles).To(BeEmpty())
+		})
+
+		It("lists the document in the open handles of the instance", func() {
+			openHandles, err := PdfiumInstance.ListOpenHandles(&requests.ListOpenHandles{})
+			Expect(err).To(BeNil())
+
+			documents := []references.FPDF_DOCUMENT{}
+			for _, documentHandles := range openHandles.Documents {
+				documents = append(documents, documentHandles.Document)
+			}
+			Expect(documents).To(ContainElement(doc))
+		})
+
+		It("doesn't list the page that is cached for page requests by index", func() {
+			_, err := PdfiumInstance.GetPageSize(&requests.GetPageSize{
+				Page: requests.Page{
+					ByIndex: &requests.PageByIndex{
+						Document: doc,
+						Index:    0,
+					},
+				},
+			})
+			Expect(err).To(BeNil())
+
+			openHandles, err := PdfiumInstance.ListOpenHandles(&requests.ListOpenHandles{
+				Document: doc,
+			})
+			Expect(err).To(BeNil())
+			Expect(openHandles.Documents).To(HaveLen(1))
+			Expect(openHandles.Documents[0].Handles).To(BeEmpty())
+		})
+
+		It("returns the counts and handles of the open handles", func() {
+			page, err := PdfiumInstance.FPDF_LoadPage(&requests.FPDF_LoadPage{
+				Document: doc,
+				Index:    0,
+			})
+			Expect(err).To(BeNil())
+
+			textPage, err := PdfiumInstance.FPDFText_LoadPage(&requests.FPDFText_LoadPage{
+				Page: requests.Page{
+					ByReference: &page.Page,
+				},
+			})
+			Expect(err).To(BeNil())
+
+			openHandles, err := PdfiumInstance.ListOpenHandles(&requests.ListOpenHandles{
+				Document: doc,
+			})
+			Expect(err).To(BeNil())
+			Expect(openHandles).To(Equal(&responses.ListOpenHandles{
+				Documents: []responses.DocumentOpenHandles{
+					{
+						Document: doc,
+						Counts: []responses.OpenHandleCount{
+							{Type: "FPDF_PAGE", Count: 1},
+							{Type: "FPDF_TEXTPAGE", Count: 1},
+						},
+						Handles: []responses.OpenHandle{
+							{Type: "FPDF_PAGE", Reference: string(page.Page)},
+							{Type: "FPDF_TEXTPAGE", Reference: string(textPage.TextPage)},
+						},
+					},
+				},
+			}))
+
+			_, err = PdfiumInstance.FPDFText_ClosePage(&requests.FPDFText_ClosePage{
+				TextPage: textPage.TextPage,
+			})
+			Expect(err).To(BeNil())
+
+			_, err = PdfiumInstance.FPDF_ClosePage(&requests.FPDF_ClosePage{
+				Page: page.Page,
+			})
+			Expect(err).To(BeNil())
+
+			openHandles, err = PdfiumInstance.ListOpenHandles(&requests.ListOpenHandles{
+				Document: doc,
+			})
+			Expect(err).To(BeNil())
+			Expect(openHandles.Documents).To(HaveLen(1))
+			Expect(openHandles.Documents[0].Counts).To(BeEmpty())
+			Expect(openHandles.Documents[0].Handles).To(BeEmpty())
+		})
+	})
+})
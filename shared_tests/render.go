@@ -3,18 +3,22 @@ package shared_tests
 import (
 	"bytes"
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/gob"
 	"fmt"
 	"image"
+	"image/color"
 	"image/png"
 	"io/ioutil"
 	"os"
 	"strings"
 
+	"github.com/klippa-app/go-pdfium/deepzoom"
 	"github.com/klippa-app/go-pdfium/errors"
 	"github.com/klippa-app/go-pdfium/references"
 	"github.com/klippa-app/go-pdfium/requests"
 	"github.com/klippa-app/go-pdfium/responses"
+	"github.com/klippa-app/go-pdfium/structs"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -686,6 +690,314 @@ var _ = Describe("Render", func() {
 				})
 			})
 
+			Context("a region of the page is rendered", func() {
+				Context("with no region given", func() {
+					It("returns an error", func() {
+						renderedPage, err := PdfiumInstance.RenderPageRegion(&requests.RenderPageRegion{
+							Page: requests.Page{
+								ByIndex: &requests.PageByIndex{
+									Document: doc,
+									Index:    0,
+								},
+							},
+							DPI: 100,
+						})
+						Expect(err).To(MatchError("no region width or height given"))
+						Expect(renderedPage).To(BeNil())
+					})
+				})
+
+				Context("with no DPI, width or height given", func() {
+					It("returns an error", func() {
+						renderedPage, err := PdfiumInstance.RenderPageRegion(&requests.RenderPageRegion{
+							Page: requests.Page{
+								ByIndex: &requests.PageByIndex{
+									Document: doc,
+									Index:    0,
+								},
+							},
+							Region: requests.RenderRegion{
+								X:      100,
+								Y:      100,
+								Width:  200,
+								Height: 100,
+							},
+						})
+						Expect(err).To(MatchError("no DPI, width or height given"))
+						Expect(renderedPage).To(BeNil())
+					})
+				})
+
+				Context("with an invalid image format", func() {
+					It("returns an error", func() {
+						renderedPage, err := PdfiumInstance.RenderPageRegion(&requests.RenderPageRegion{
+							Page: requests.Page{
+								ByIndex: &requests.PageByIndex{
+									Document: doc,
+									Index:    0,
+								},
+							},
+							Region: requests.RenderRegion{
+								X:      100,
+								Y:      100,
+								Width:  200,
+								Height: 100,
+							},
+							DPI:         100,
+							ImageFormat: requests.RenderImageFormat("invalid"),
+						})
+						Expect(err).To(MatchError("invalid ImageFormat given"))
+						Expect(renderedPage).To(BeNil())
+					})
+				})
+
+				Context("with DPI 144", func() {
+					It("returns the right image, point to pixel ratio and resolution", func() {
+						renderedPage, err := PdfiumInstance.RenderPageRegion(&requests.RenderPageRegion{
+							Page: requests.Page{
+								ByIndex: &requests.PageByIndex{
+									Document: doc,
+									Index:    0,
+								},
+							},
+							Region: requests.RenderRegion{
+								X:      100,
+								Y:      100,
+								Width:  200,
+								Height: 100,
+							},
+							DPI: 144,
+						})
+						Expect(err).To(BeNil())
+						Expect(renderedPage).To(Not(BeNil()))
+						Expect(renderedPage.Result.Page).To(Equal(0))
+						Expect(renderedPage.Result.PointToPixelRatio).To(Equal(float64(2)))
+						Expect(renderedPage.Result.Width).To(Equal(400))
+						Expect(renderedPage.Result.Height).To(Equal(200))
+						Expect(renderedPage.Result.Image.Bounds().Size().X).To(Equal(400))
+						Expect(renderedPage.Result.Image.Bounds().Size().Y).To(Equal(200))
+						renderedPage.Cleanup()
+					})
+				})
+
+				Context("with only the width given in pixels", func() {
+					It("returns the right image, point to pixel ratio and resolution", func() {
+						renderedPage, err := PdfiumInstance.RenderPageRegion(&requests.RenderPageRegion{
+							Page: requests.Page{
+								ByIndex: &requests.PageByIndex{
+									Document: doc,
+									Index:    0,
+								},
+							},
+							Region: requests.RenderRegion{
+								X:      100,
+								Y:      100,
+								Width:  200,
+								Height: 100,
+							},
+							Width: 1000,
+						})
+						Expect(err).To(BeNil())
+						Expect(renderedPage).To(Not(BeNil()))
+						Expect(renderedPage.Result.PointToPixelRatio).To(Equal(float64(5)))
+						Expect(renderedPage.Result.Width).To(Equal(1000))
+						Expect(renderedPage.Result.Height).To(Equal(500))
+						renderedPage.Cleanup()
+					})
+				})
+
+				Context("with both the width and height given in pixels", func() {
+					It("fits the region within the width and height", func() {
+						renderedPage, err := PdfiumInstance.RenderPageRegion(&requests.RenderPageRegion{
+							Page: requests.Page{
+								ByIndex: &requests.PageByIndex{
+									Document: doc,
+									Index:    0,
+								},
+							},
+							Region: requests.RenderRegion{
+								X:      100,
+								Y:      100,
+								Width:  200,
+								Height: 100,
+							},
+							Width:  1000,
+							Height: 100,
+						})
+						Expect(err).To(BeNil())
+						Expect(renderedPage).To(Not(BeNil()))
+						Expect(renderedPage.Result.PointToPixelRatio).To(Equal(float64(1)))
+						Expect(renderedPage.Result.Width).To(Equal(200))
+						Expect(renderedPage.Result.Height).To(Equal(100))
+						renderedPage.Cleanup()
+					})
+				})
+
+				Context("in grayscale", func() {
+					It("returns a grayscale image", func() {
+						renderedPage, err := PdfiumInstance.RenderPageRegion(&requests.RenderPageRegion{
+							Page: requests.Page{
+								ByIndex: &requests.PageByIndex{
+									Document: doc,
+									Index:    0,
+								},
+							},
+							Region: requests.RenderRegion{
+								X:      100,
+								Y:      100,
+								Width:  200,
+								Height: 100,
+							},
+							DPI:         72,
+							ImageFormat: requests.RenderImageFormatGrayscale,
+						})
+						Expect(err).To(BeNil())
+						Expect(renderedPage).To(Not(BeNil()))
+						imageGray, isGray := renderedPage.Result.RenderedImage.(*image.Gray)
+						Expect(isGray).To(BeTrue())
+						Expect(imageGray.Bounds().Size().X).To(Equal(200))
+						Expect(imageGray.Bounds().Size().Y).To(Equal(100))
+						renderedPage.Cleanup()
+					})
+				})
+
+				Context("with a background color", func() {
+					It("fills the empty part of the page with the background color", func() {
+						renderedPage, err := PdfiumInstance.RenderPageRegion(&requests.RenderPageRegion{
+							Page: requests.Page{
+								ByIndex: &requests.PageByIndex{
+									Document: doc,
+									Index:    0,
+								},
+							},
+							Region: requests.RenderRegion{
+								X:      0,
+								Y:      0,
+								Width:  10,
+								Height: 10,
+							},
+							DPI: 72,
+							BackgroundColor: &structs.FPDF_COLOR{
+								R: 30,
+								G: 60,
+								B: 90,
+								A: 255,
+							},
+						})
+						Expect(err).To(BeNil())
+						Expect(renderedPage).To(Not(BeNil()))
+						imageRGBA, isRGBA := renderedPage.Result.RenderedImage.(*image.RGBA)
+						Expect(isRGBA).To(BeTrue())
+						Expect(imageRGBA.RGBAAt(0, 0)).To(Equal(color.RGBA{R: 30, G: 60, B: 90, A: 255}))
+						renderedPage.Cleanup()
+					})
+
+					It("fills the empty part of the page with the gray level of the background color in grayscale", func() {
+						renderedPage, err := PdfiumInstance.RenderPageRegion(&requests.RenderPageRegion{
+							Page: requests.Page{
+								ByIndex: &requests.PageByIndex{
+									Document: doc,
+									Index:    0,
+								},
+							},
+							Region: requests.RenderRegion{
+								X:      0,
+								Y:      0,
+								Width:  10,
+								Height: 10,
+							},
+							DPI:         72,
+							ImageFormat: requests.RenderImageFormatGrayscale,
+							BackgroundColor: &structs.FPDF_COLOR{
+								R: 0,
+								G: 0,
+								B: 0,
+								A: 255,
+							},
+						})
+						Expect(err).To(BeNil())
+						Expect(renderedPage).To(Not(BeNil()))
+						imageGray, isGray := renderedPage.Result.RenderedImage.(*image.Gray)
+						Expect(isGray).To(BeTrue())
+						Expect(imageGray.GrayAt(0, 0)).To(Equal(color.Gray{Y: 0}))
+						renderedPage.Cleanup()
+					})
+				})
+
+				Context("and directly rendered to a file", func() {
+					It("returns the right image", func() {
+						renderedPage, err := PdfiumInstance.RenderToFile(&requests.RenderToFile{
+							OutputFormat: requests.RenderToFileOutputFormatPNG,
+							OutputTarget: requests.RenderToFileOutputTargetBytes,
+							RenderPageRegion: &requests.RenderPageRegion{
+								Page: requests.Page{
+									ByIndex: &requests.PageByIndex{
+										Document: doc,
+										Index:    0,
+									},
+								},
+								Region: requests.RenderRegion{
+									X:      100,
+									Y:      100,
+									Width:  200,
+									Height: 100,
+								},
+								DPI: 144,
+							},
+						})
+						Expect(err).To(BeNil())
+						Expect(renderedPage).To(Not(BeNil()))
+						Expect(renderedPage.Width).To(Equal(400))
+						Expect(renderedPage.Height).To(Equal(200))
+						Expect(renderedPage.ImageBytes).To(Not(BeNil()))
+
+						decodedImage, err := png.Decode(bytes.NewReader(*renderedPage.ImageBytes))
+						Expect(err).To(BeNil())
+						Expect(decodedImage.Bounds().Size().X).To(Equal(400))
+						Expect(decodedImage.Bounds().Size().Y).To(Equal(200))
+					})
+				})
+
+				Context("as the tiles of a deep zoom pyramid", func() {
+					It("renders every tile in the exact size of the tile", func() {
+						pyramid, err := deepzoom.New(PdfiumInstance, deepzoom.Config{
+							Page: requests.Page{
+								ByIndex: &requests.PageByIndex{
+									Document: doc,
+									Index:    0,
+								},
+							},
+							DPI:      100,
+							TileSize: 254,
+							Overlap:  1,
+							Format:   requests.RenderToFileOutputFormatPNG,
+						})
+						Expect(err).To(BeNil())
+
+						levels := pyramid.Levels()
+						Expect(levels[len(levels)-1].Width).To(Equal(827))
+						Expect(levels[len(levels)-1].Height).To(Equal(1170))
+
+						for _, level := range levels[len(levels)-2:] {
+							for row := 0; row < level.Rows; row++ {
+								for column := 0; column < level.Columns; column++ {
+									tile, err := pyramid.Tile(level.Level, column, row)
+									Expect(err).To(BeNil())
+
+									tileData, err := pyramid.RenderTile(PdfiumInstance, level.Level, column, row)
+									Expect(err).To(BeNil())
+
+									decodedImage, err := png.Decode(bytes.NewReader(tileData))
+									Expect(err).To(BeNil())
+									Expect(decodedImage.Bounds().Size().X).To(Equal(tile.Width))
+									Expect(decodedImage.Bounds().Size().Y).To(Equal(tile.Height))
+								}
+							}
+						}
+					})
+				})
+			})
+
 			Context("the pages are rendered", func() {
 				Context("in points", func() {
 					Context("with no pages given", func() {
@@ -1739,6 +2051,80 @@ var _ = Describe("Render", func() {
 				})
 
 				Context("and directly rendered to a file", func() {
+					Context("as a TIFF file", func() {
+						It("writes every page as its own page with its own compression", func() {
+							renderedFile, err := PdfiumInstance.RenderToFile(&requests.RenderToFile{
+								OutputFormat:        requests.RenderToFileOutputFormatTIFF,
+								OutputTarget:        requests.RenderToFileOutputTargetBytes,
+								TIFFCompression:     requests.RenderToFileTIFFCompressionLZW,
+								TIFFPageCompression: []requests.RenderToFileTIFFCompression{requests.RenderToFileTIFFCompressionCCITTG4},
+								RenderPagesInDPI: &requests.RenderPagesInDPI{
+									Pages: []requests.RenderPageInDPI{
+										{
+											Page: requests.Page{
+												ByIndex: &requests.PageByIndex{
+													Document: doc,
+													Index:    0,
+												},
+											},
+											DPI: 100,
+										},
+										{
+											Page: requests.Page{
+												ByIndex: &requests.PageByIndex{
+													Document: doc,
+													Index:    0,
+												},
+											},
+											DPI: 50,
+										},
+									},
+									Padding: 50,
+								},
+							})
+							Expect(err).To(BeNil())
+							Expect(renderedFile).To(Not(BeNil()))
+							Expect(renderedFile.ImageBytes).To(Not(BeNil()))
+
+							tiffPages := readTIFFPages(*renderedFile.ImageBytes)
+							Expect(tiffPages).To(HaveLen(2))
+
+							// ImageWidth, ImageLength, BitsPerSample and Compression.
+							Expect(tiffPages[0][256]).To(Equal(uint32(827)))
+							Expect(tiffPages[0][257]).To(Equal(uint32(1170)))
+							Expect(tiffPages[0][258]).To(Equal(uint32(1)))
+							Expect(tiffPages[0][259]).To(Equal(uint32(4)))
+							Expect(tiffPages[1][256]).To(Equal(uint32(414)))
+							Expect(tiffPages[1][257]).To(Equal(uint32(585)))
+							Expect(tiffPages[1][259]).To(Equal(uint32(5)))
+						})
+					})
+
+					Context("as a TIFF file with an invalid compression", func() {
+						It("returns an error", func() {
+							renderedFile, err := PdfiumInstance.RenderToFile(&requests.RenderToFile{
+								OutputFormat:    requests.RenderToFileOutputFormatTIFF,
+								OutputTarget:    requests.RenderToFileOutputTargetBytes,
+								TIFFCompression: requests.RenderToFileTIFFCompression("jpeg"),
+								RenderPagesInDPI: &requests.RenderPagesInDPI{
+									Pages: []requests.RenderPageInDPI{
+										{
+											Page: requests.Page{
+												ByIndex: &requests.PageByIndex{
+													Document: doc,
+													Index:    0,
+												},
+											},
+											DPI: 50,
+										},
+									},
+								},
+							})
+							Expect(err).To(MatchError("invalid TIFF compression given"))
+							Expect(renderedFile).To(BeNil())
+						})
+					})
+
 					Context("with no output target given", func() {
 						It("returns an error", func() {
 							renderedPage, err := PdfiumInstance.RenderToFile(&requests.RenderToFile{
@@ -3296,3 +3682,29 @@ func writePrerenderedFile(request *requests.RenderToFile, renderedFile *response
 
 	return nil
 }
+
+// readTIFFPages returns the tags of every page of a little endian TIFF file,
+// with the values that are written in the entry itself.
+func readTIFFPages(data []byte) []map[uint16]uint32 {
+	Expect(data[:4]).To(Equal([]byte{'I', 'I', 42, 0}))
+
+	pages := []map[uint16]uint32{}
+	offset := binary.LittleEndian.Uint32(data[4:])
+	for offset != 0 {
+		count := int(binary.LittleEndian.Uint16(data[offset:]))
+		tags := map[uint16]uint32{}
+		for i := 0; i < count; i++ {
+			entry := data[int(offset)+2+i*12:]
+			tag := binary.LittleEndian.Uint16(entry)
+			if binary.LittleEndian.Uint16(entry[2:]) == 3 { // SHORT
+				tags[tag] = uint32(binary.LittleEndian.Uint16(entry[8:]))
+			} else {
+				tags[tag] = binary.LittleEndian.Uint32(entry[8:])
+			}
+		}
+		pages = append(pages, tags)
+		offset = binary.LittleEndian.Uint32(data[int(offset)+2+count*12:])
+	}
+
+	return pages
+}
@@ -0,0 +1,161 @@
+//go:build pdfium_experimental
+// +build pdfium_experimental
+
+package shared_tests
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"io/ioutil"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/klippa-app/go-pdfium/references"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/structs"
+)
+
+var _ = Describe("Render", func() {
+	BeforeEach(func() {
+		Locker.Lock()
+	})
+
+	AfterEach(func() {
+		Locker.Unlock()
+	})
+
+	Context("a normal PDF file", func() {
+		var doc references.FPDF_DOCUMENT
+
+		BeforeEach(func() {
+			pdfData, err := ioutil.ReadFile(TestDataPath + "/testdata/test.pdf")
+			Expect(err).To(BeNil())
+
+			newDoc, err := PdfiumInstance.FPDF_LoadMemDocument(&requests.FPDF_LoadMemDocument{
+				Data: &pdfData,
+			})
+			Expect(err).To(BeNil())
+
+			doc = newDoc.Document
+		})
+
+		AfterEach(func() {
+			FPDF_CloseDocument, err := PdfiumInstance.FPDF_CloseDocument(&requests.FPDF_CloseDocument{
+				Document: doc,
+			})
+			Expect(err).To(BeNil())
+			Expect(FPDF_CloseDocument).To(Not(BeNil()))
+		})
+
+		When("is opened", func() {
+			Context("a page is rendered with a color scheme", func() {
+				darkColorScheme := &structs.FPDF_COLORSCHEME{
+					PathFillColor:   0xFFFFFFFF,
+					PathStrokeColor: 0xFFFFFFFF,
+					TextFillColor:   0xFFFFFFFF,
+					TextStrokeColor: 0xFFFFFFFF,
+				}
+				darkBackgroundColor := &structs.FPDF_COLOR{
+					R: 0,
+					G: 0,
+					B: 0,
+					A: 255,
+				}
+
+				It("renders the page in DPI with the background color", func() {
+					renderedPage, err := PdfiumInstance.RenderPageInDPI(&requests.RenderPageInDPI{
+						Page: requests.Page{
+							ByIndex: &requests.PageByIndex{
+								Document: doc,
+								Index:    0,
+							},
+						},
+						DPI:             72,
+						ColorScheme:     darkColorScheme,
+						BackgroundColor: darkBackgroundColor,
+					})
+					Expect(err).To(BeNil())
+					Expect(renderedPage).To(Not(BeNil()))
+					Expect(renderedPage.Result.Width).To(Equal(596))
+					Expect(renderedPage.Result.Height).To(Equal(842))
+					imageRGBA, isRGBA := renderedPage.Result.RenderedImage.(*image.RGBA)
+					Expect(isRGBA).To(BeTrue())
+					Expect(imageRGBA.RGBAAt(0, 0)).To(Equal(color.RGBA{R: 0, G: 0, B: 0, A: 255}))
+					renderedPage.Cleanup()
+				})
+
+				It("renders the page in pixels", func() {
+					renderedPage, err := PdfiumInstance.RenderPageInPixels(&requests.RenderPageInPixels{
+						Page: requests.Page{
+							ByIndex: &requests.PageByIndex{
+								Document: doc,
+								Index:    0,
+							},
+						},
+						Width:           1000,
+						ColorScheme:     darkColorScheme,
+						BackgroundColor: darkBackgroundColor,
+					})
+					Expect(err).To(BeNil())
+					Expect(renderedPage).To(Not(BeNil()))
+					Expect(renderedPage.Result.Width).To(Equal(1000))
+					renderedPage.Cleanup()
+				})
+
+				It("renders a region of the page", func() {
+					renderedPage, err := PdfiumInstance.RenderPageRegion(&requests.RenderPageRegion{
+						Page: requests.Page{
+							ByIndex: &requests.PageByIndex{
+								Document: doc,
+								Index:    0,
+							},
+						},
+						Region: requests.RenderRegion{
+							X:      0,
+							Y:      0,
+							Width:  200,
+							Height: 100,
+						},
+						DPI:             144,
+						ColorScheme:     darkColorScheme,
+						BackgroundColor: darkBackgroundColor,
+					})
+					Expect(err).To(BeNil())
+					Expect(renderedPage).To(Not(BeNil()))
+					Expect(renderedPage.Result.Image.Bounds().Size().X).To(Equal(400))
+					Expect(renderedPage.Result.Image.Bounds().Size().Y).To(Equal(200))
+					renderedPage.Cleanup()
+				})
+
+				It("renders the page directly to a file", func() {
+					renderedPage, err := PdfiumInstance.RenderToFile(&requests.RenderToFile{
+						OutputFormat: requests.RenderToFileOutputFormatPNG,
+						OutputTarget: requests.RenderToFileOutputTargetBytes,
+						RenderPageInDPI: &requests.RenderPageInDPI{
+							Page: requests.Page{
+								ByIndex: &requests.PageByIndex{
+									Document: doc,
+									Index:    0,
+								},
+							},
+							DPI:             72,
+							ColorScheme:     darkColorScheme,
+							BackgroundColor: darkBackgroundColor,
+						},
+					})
+					Expect(err).To(BeNil())
+					Expect(renderedPage).To(Not(BeNil()))
+					Expect(renderedPage.ImageBytes).To(Not(BeNil()))
+
+					decodedImage, err := png.Decode(bytes.NewReader(*renderedPage.ImageBytes))
+					Expect(err).To(BeNil())
+					r, g, b, _ := decodedImage.At(0, 0).RGBA()
+					Expect([]uint32{r, g, b}).To(Equal([]uint32{0, 0, 0}))
+				})
+			})
+		})
+	})
+})
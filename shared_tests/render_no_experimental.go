@@ -0,0 +1,79 @@
+//go:build !pdfium_experimental
+// +build !pdfium_experimental
+
+package shared_tests
+
+import (
+	"io/ioutil"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/klippa-app/go-pdfium/errors"
+	"github.com/klippa-app/go-pdfium/references"
+	"github.com/klippa-app/go-pdfium/requests"
+	"github.com/klippa-app/go-pdfium/structs"
+)
+
+var _ = Describe("Render", func() {
+	BeforeEach(func() {
+		Locker.Lock()
+
+		if TestType == "webassembly" {
+			// Webassembly has all the experimental things implemented.
+			Skip("This test is skipped on Webassembly")
+		}
+	})
+
+	AfterEach(func() {
+		Locker.Unlock()
+	})
+
+	Context("a normal PDF file", func() {
+		var doc references.FPDF_DOCUMENT
+
+		BeforeEach(func() {
+			pdfData, err := ioutil.ReadFile(TestDataPath + "/testdata/test.pdf")
+			Expect(err).To(BeNil())
+
+			newDoc, err := PdfiumInstance.FPDF_LoadMemDocument(&requests.FPDF_LoadMemDocument{
+				Data: &pdfData,
+			})
+			Expect(err).To(BeNil())
+
+			doc = newDoc.Document
+		})
+
+		AfterEach(func() {
+			FPDF_CloseDocument, err := PdfiumInstance.FPDF_CloseDocument(&requests.FPDF_CloseDocument{
+				Document: doc,
+			})
+			Expect(err).To(BeNil())
+			Expect(FPDF_CloseDocument).To(Not(BeNil()))
+		})
+
+		When("is opened", func() {
+			Context("a page is rendered with a color scheme", func() {
+				It("returns an error", func() {
+					renderedPage, err := PdfiumInstance.RenderPageInDPI(&requests.RenderPageInDPI{
+						Page: requests.Page{
+							ByIndex: &requests.PageByIndex{
+								Document: doc,
+								Index:    0,
+							},
+						},
+						DPI: 72,
+						ColorScheme: &structs.FPDF_COLORSCHEME{
+							PathFillColor:   0xFFFFFFFF,
+							PathStrokeColor: 0xFFFFFFFF,
+							TextFillColor:   0xFFFFFFFF,
+							TextStrokeColor: 0xFFFFFFFF,
+						},
+					})
+					Expect(err).To(MatchError(errors.ErrExperimentalUnsupported.Error()))
+					Expect(renderedPage).To(BeNil())
+				})
+			})
+		})
+	})
+})
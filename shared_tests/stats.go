@@ -0,0 +1,53 @@
+package shared_tests
+
+import (
+	"io/ioutil"
+
+	"github.com/klippa-app/go-pdfium/requests"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("stats", func() {
+	BeforeEach(func() {
+		Locker.Lock()
+	})
+
+	AfterEach(func() {
+		Locker.Unlock()
+	})
+
+	It("counts the calls and errors of methods", func() {
+		before := PdfiumPool.Stats()
+		Expect(before.InstancesInUse).To(BeNumerically(">=", 1))
+
+		pdfData, err := ioutil.ReadFile(TestDataPath + "/testdata/test.pdf")
+		Expect(err).To(BeNil())
+
+		doc, err := PdfiumInstance.FPDF_LoadMemDocument(&requests.FPDF_LoadMemDocument{
+			Data: &pdfData,
+		})
+		Expect(err).To(BeNil())
+
+		_, err = PdfiumInstance.FPDF_GetPageCount(&requests.FPDF_GetPageCount{
+			Document: doc.Document,
+		})
+		Expect(err).To(BeNil())
+
+		_, err = PdfiumInstance.FPDF_GetPageCount(&requests.FPDF_GetPageCount{})
+		Expect(err).To(Not(BeNil()))
+
+		_, err = PdfiumInstance.FPDF_CloseDocument(&requests.FPDF_CloseDocument{
+			Document: doc.Document,
+		})
+		Expect(err).To(BeNil())
+
+		after := PdfiumPool.Stats()
+		pageCount := after.Methods["FPDF_GetPageCount"]
+		Expect(pageCount.Calls - before.Methods["FPDF_GetPageCount"].Calls).To(Equal(uint64(2)))
+		Expect(pageCount.Errors - before.Methods["FPDF_GetPageCount"].Errors).To(Equal(uint64(1)))
+		Expect(pageCount.Latency.Counts).To(HaveLen(len(pageCount.Latency.Buckets) + 1))
+		Expect(after.GetInstance.Calls).To(BeNumerically(">=", 1))
+	})
+})
@@ -10,15 +10,35 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/klippa-app/go-pdfium"
+	"github.com/klippa-app/go-pdfium/internal/errorcontext"
+	"github.com/klippa-app/go-pdfium/internal/handledebug"
 	"github.com/klippa-app/go-pdfium/internal/implementation_cgo"
+	"github.com/klippa-app/go-pdfium/internal/stats"
 )
 
 var singleThreadedMutex = &sync.Mutex{}
 
+// callLock makes sure that only one call is done on PDFium at a time, across
+// pools and instances. The implementation has a mutex for this too, but
+// waiting for this channel can be cancelled.
+var callLock = make(chan struct{}, 1)
+
 var poolRefs = map[string]*pdfiumPool{}
 
 type Config struct {
 	LibraryConfig *pdfium.LibraryConfig
+
+	// StatsExporter receives the statistics of the pool as they happen, see
+	// Stats() on the pool.
+	StatsExporter pdfium.StatsExporter
+
+	// Interceptors are called around every method call of the instances of
+	// the pool, the first interceptor is the outermost one.
+	Interceptors []pdfium.Interceptor
+
+	// HandleDebug enables the handle debug mode, which reports the handles
+	// that are not closed. See pdfium.HandleDebugConfig.
+	HandleDebug *pdfium.HandleDebugConfig
 }
 
 // Init will return a single-threaded pool.
@@ -40,6 +60,9 @@ func Init(config Config) pdfium.Pool {
 		poolRef:      poolRef.String(),
 		instanceRefs: map[string]*pdfiumInstance{},
 		lock:         &sync.Mutex{},
+		stats:        stats.New(config.StatsExporter),
+		interceptor:  pdfium.ChainInterceptors(config.Interceptors...),
+		handleDebug:  config.HandleDebug,
 	}
 
 	poolRefs[pool.poolRef] = pool
@@ -52,6 +75,9 @@ type pdfiumPool struct {
 	poolRef      string
 	closed       bool
 	lock         *sync.Mutex
+	stats        *stats.Recorder
+	interceptor  pdfium.Interceptor
+	handleDebug  *pdfium.HandleDebugConfig
 }
 
 // GetInstance will return a unique PDFium instance that keeps track of its
@@ -62,25 +88,47 @@ func (p *pdfiumPool) GetInstance(timeout time.Duration) (pdfium.Pdfium, error) {
 }
 
 func (p *pdfiumPool) GetInstanceWithContext(ctx goctx.Context) (pdfium.Pdfium, error) {
+	start := time.Now()
 	p.lock.Lock()
 	defer p.lock.Unlock()
 	if p.closed {
-		return nil, errors.New("pool is closed")
+		err := errors.New("pool is closed")
+		p.stats.ObserveGetInstance(time.Since(start), err)
+		return nil, err
 	}
 
 	newInstance := &pdfiumInstance{
-		pdfium: implementation_cgo.Pdfium.GetInstance(),
-		lock:   &sync.Mutex{},
-		pool:   p,
+		pdfium:      implementation_cgo.Pdfium.GetInstance(),
+		lock:        &sync.Mutex{},
+		pool:        p,
+		interceptor: p.interceptor,
+	}
+
+	if p.handleDebug != nil {
+		newInstance.handleDebug = handledebug.New(p.handleDebug, newInstance.ListOpenHandlesWithContext)
+		newInstance.interceptor = pdfium.ChainInterceptors(p.interceptor, newInstance.handleDebug.Interceptor())
 	}
 
 	instanceRef := uuid.New()
 	newInstance.instanceRef = instanceRef.String()
 	p.instanceRefs[newInstance.instanceRef] = newInstance
+	p.stats.ObserveGetInstance(time.Since(start), nil)
 
 	return newInstance, nil
 }
 
+// Stats returns the statistics of the pool. Single-threaded usage has no
+// workers, so IdleWorkers and WorkerRestarts are always 0.
+func (p *pdfiumPool) Stats() pdfium.PoolStats {
+	stats := p.stats.Stats()
+
+	p.lock.Lock()
+	stats.InstancesInUse = len(p.instanceRefs)
+	p.lock.Unlock()
+
+	return stats
+}
+
 // Close will close the pool and all instances in it.
 func (p *pdfiumPool) Close() (err error) {
 	p.lock.Lock()
@@ -125,6 +173,42 @@ type pdfiumInstance struct {
 	closed      bool
 	pool        *pdfiumPool
 	lock        *sync.Mutex
+
+	// interceptor is called around every method call, it's copied from the
+	// pool because the pool is unset when the instance is closed.
+	interceptor pdfium.Interceptor
+
+	// handleDebug tracks the handles of the instance when the handle debug
+	// mode is enabled.
+	handleDebug *handledebug.Tracker
+}
+
+var _ pdfium.PdfiumWithContext = &pdfiumInstance{}
+
+// startCall waits until PDFium is available. The returned function must be
+// deferred with the error of the call, it adds the context of the call to the
+// error and records the call in the statistics of the pool.
+func (i *pdfiumInstance) startCall(ctx goctx.Context, method string, request interface{}) (func(err *error), error) {
+	// A select picks a random case when both are ready, so a context that
+	// is already done is checked first.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	select {
+	case callLock <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	observeCall := i.pool.stats.StartCall(method)
+
+	return func(err *error) {
+		errorcontext.Set(*err, method, request)
+
+		observeCall(*err)
+		<-callLock
+	}, nil
 }
 
 // Close will close the instance and will clean up the underlying PDFium resources
@@ -137,6 +221,10 @@ func (i *pdfiumInstance) Close() (err error) {
 		return errors.New("instance is already closed")
 	}
 
+	if i.handleDebug != nil {
+		i.handleDebug.Close(goctx.Background())
+	}
+
 	defer func() {
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "NewDocumentFromReader", panicError)
@@ -2,6 +2,7 @@ package single_threaded_test
 
 import (
 	"context"
+	"errors"
 	"os"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	. "github.com/onsi/gomega/gleak"
 
 	"github.com/klippa-app/go-pdfium"
+	"github.com/klippa-app/go-pdfium/requests"
 	"github.com/klippa-app/go-pdfium/shared_tests"
 	"github.com/klippa-app/go-pdfium/single_threaded"
 )
@@ -73,6 +75,53 @@ var _ = Describe("Single Threaded", func() {
 				Expect(err).To(BeNil())
 			}, NodeTimeout(time.Second))
 		})
+
+		When("a pool is opened with interceptors", func() {
+			var TestPool pdfium.Pool
+			var methods []string
+
+			BeforeEach(func() {
+				methods = nil
+				pool := single_threaded.Init(single_threaded.Config{
+					Interceptors: []pdfium.Interceptor{
+						func(ctx context.Context, method string, request interface{}, next func() (interface{}, error)) (interface{}, error) {
+							methods = append(methods, method)
+							return next()
+						},
+						func(ctx context.Context, method string, request interface{}, next func() (interface{}, error)) (interface{}, error) {
+							if method == "FPDF_GetPageCount" {
+								return nil, errors.New("method not allowed")
+							}
+							return next()
+						},
+					},
+				})
+				TestPool = pool
+			})
+
+			AfterEach(func(ctx context.Context) {
+				err := TestPool.Close()
+				Expect(err).To(BeNil())
+			}, NodeTimeout(time.Second))
+
+			It("calls the interceptors around every method call", func() {
+				instance, err := TestPool.GetInstance(time.Second * 30)
+				Expect(err).To(BeNil())
+
+				lastError, err := instance.FPDF_GetLastError(&requests.FPDF_GetLastError{})
+				Expect(err).To(BeNil())
+				Expect(lastError).To(Not(BeNil()))
+
+				pageCount, err := instance.FPDF_GetPageCount(&requests.FPDF_GetPageCount{})
+				Expect(err).To(MatchError("method not allowed"))
+				Expect(pageCount).To(BeNil())
+
+				Expect(methods).To(Equal([]string{"FPDF_GetLastError", "FPDF_GetPageCount"}))
+
+				err = instance.Close()
+				Expect(err).To(BeNil())
+			})
+		})
 	})
 })
 
@@ -0,0 +1,62 @@
+package pdfium
+
+import (
+	"time"
+)
+
+// DefaultLatencyBuckets are the upper bounds of the buckets of the latency
+// histograms in PoolStats.
+var DefaultLatencyBuckets = []time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// PoolStats are the statistics of a pool since it was created.
+type PoolStats struct {
+	InstancesInUse int                  // The number of instances that have been retrieved and are not closed yet.
+	IdleWorkers    int                  // The number of workers that are waiting for an instance to be retrieved. Always 0 on single-threaded usage.
+	WorkerRestarts int                  // The number of workers that were replaced because they were broken, killed, timed out or recycled. Always 0 on single-threaded usage.
+	GetInstance    CallStats            // The calls to GetInstance and GetInstanceWithContext, the latency is the time waited for an instance.
+	Methods        map[string]CallStats // The calls per method of the instances.
+}
+
+// CallStats are the statistics of the calls to a method.
+type CallStats struct {
+	Calls   uint64           // The number of calls.
+	Errors  uint64           // The number of calls that returned an error.
+	Latency LatencyHistogram // The duration of the calls.
+}
+
+// LatencyHistogram is a histogram of the duration of calls.
+type LatencyHistogram struct {
+	Buckets []time.Duration // The upper bounds of the buckets.
+	Counts  []uint64        // The number of calls per bucket, not cumulative. The last count is for the calls that took longer than the last bucket.
+	Sum     time.Duration   // The total duration of all calls.
+}
+
+// StatsExporter receives every observation of a pool as it happens, so that
+// it can be fed into a metrics system like Prometheus or OpenTelemetry. The
+// methods are called from the goroutine that makes the call, so they should
+// be fast and safe for concurrent use.
+type StatsExporter interface {
+	// ObserveGetInstance is called when GetInstance or
+	// GetInstanceWithContext returns.
+	ObserveGetInstance(wait time.Duration, err error)
+
+	// ObserveCall is called when a method of an instance returns.
+	ObserveCall(method string, duration time.Duration, err error)
+
+	// ObserveWorkerRestart is called when a worker is replaced because it
+	// was broken, killed, timed out or recycled.
+	ObserveWorkerRestart()
+}
@@ -4,6 +4,7 @@
 package webassembly
 
 import (
+	goctx "context"
 	"errors"
 	"fmt"
 
@@ -11,6582 +12,13218 @@ import (
 	"github.com/klippa-app/go-pdfium/responses"
 )
 
-func (i *pdfiumInstance) FORM_CanRedo(request *requests.FORM_CanRedo) (resp *responses.FORM_CanRedo, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FORM_CanRedo(request *requests.FORM_CanRedo) (*responses.FORM_CanRedo, error) {
+	return i.FORM_CanRedoWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_CanRedoWithContext(ctx goctx.Context, request *requests.FORM_CanRedo) (*responses.FORM_CanRedo, error) {
+	resp, err := i.interceptor(ctx, "FORM_CanRedo", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_CanRedo", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_CanRedo", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_CanRedo(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_CanRedo)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_CanUndo(request *requests.FORM_CanUndo) (*responses.FORM_CanUndo, error) {
+	return i.FORM_CanUndoWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_CanUndoWithContext(ctx goctx.Context, request *requests.FORM_CanUndo) (*responses.FORM_CanUndo, error) {
+	resp, err := i.interceptor(ctx, "FORM_CanUndo", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_CanUndo", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_CanUndo", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_CanUndo(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_CanUndo)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_DoDocumentAAction(request *requests.FORM_DoDocumentAAction) (*responses.FORM_DoDocumentAAction, error) {
+	return i.FORM_DoDocumentAActionWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_DoDocumentAActionWithContext(ctx goctx.Context, request *requests.FORM_DoDocumentAAction) (*responses.FORM_DoDocumentAAction, error) {
+	resp, err := i.interceptor(ctx, "FORM_DoDocumentAAction", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_DoDocumentAAction", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_DoDocumentAAction", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_DoDocumentAAction(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_DoDocumentAAction)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_DoDocumentJSAction(request *requests.FORM_DoDocumentJSAction) (*responses.FORM_DoDocumentJSAction, error) {
+	return i.FORM_DoDocumentJSActionWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_DoDocumentJSActionWithContext(ctx goctx.Context, request *requests.FORM_DoDocumentJSAction) (*responses.FORM_DoDocumentJSAction, error) {
+	resp, err := i.interceptor(ctx, "FORM_DoDocumentJSAction", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_DoDocumentJSAction", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_DoDocumentJSAction", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_DoDocumentJSAction(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_DoDocumentJSAction)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_DoDocumentOpenAction(request *requests.FORM_DoDocumentOpenAction) (*responses.FORM_DoDocumentOpenAction, error) {
+	return i.FORM_DoDocumentOpenActionWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_DoDocumentOpenActionWithContext(ctx goctx.Context, request *requests.FORM_DoDocumentOpenAction) (*responses.FORM_DoDocumentOpenAction, error) {
+	resp, err := i.interceptor(ctx, "FORM_DoDocumentOpenAction", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_DoDocumentOpenAction", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_DoDocumentOpenAction", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_DoDocumentOpenAction(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_DoDocumentOpenAction)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_DoPageAAction(request *requests.FORM_DoPageAAction) (*responses.FORM_DoPageAAction, error) {
+	return i.FORM_DoPageAActionWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_DoPageAActionWithContext(ctx goctx.Context, request *requests.FORM_DoPageAAction) (*responses.FORM_DoPageAAction, error) {
+	resp, err := i.interceptor(ctx, "FORM_DoPageAAction", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_DoPageAAction", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_DoPageAAction", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_DoPageAAction(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_DoPageAAction)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_ForceToKillFocus(request *requests.FORM_ForceToKillFocus) (*responses.FORM_ForceToKillFocus, error) {
+	return i.FORM_ForceToKillFocusWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_ForceToKillFocusWithContext(ctx goctx.Context, request *requests.FORM_ForceToKillFocus) (*responses.FORM_ForceToKillFocus, error) {
+	resp, err := i.interceptor(ctx, "FORM_ForceToKillFocus", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_ForceToKillFocus", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_ForceToKillFocus", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_ForceToKillFocus(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_ForceToKillFocus)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_GetFocusedAnnot(request *requests.FORM_GetFocusedAnnot) (*responses.FORM_GetFocusedAnnot, error) {
+	return i.FORM_GetFocusedAnnotWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_GetFocusedAnnotWithContext(ctx goctx.Context, request *requests.FORM_GetFocusedAnnot) (*responses.FORM_GetFocusedAnnot, error) {
+	resp, err := i.interceptor(ctx, "FORM_GetFocusedAnnot", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_GetFocusedAnnot", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_GetFocusedAnnot", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_GetFocusedAnnot(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_GetFocusedAnnot)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_GetFocusedText(request *requests.FORM_GetFocusedText) (*responses.FORM_GetFocusedText, error) {
+	return i.FORM_GetFocusedTextWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_GetFocusedTextWithContext(ctx goctx.Context, request *requests.FORM_GetFocusedText) (*responses.FORM_GetFocusedText, error) {
+	resp, err := i.interceptor(ctx, "FORM_GetFocusedText", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_GetFocusedText", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_GetFocusedText", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_GetFocusedText(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_GetFocusedText)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_GetSelectedText(request *requests.FORM_GetSelectedText) (*responses.FORM_GetSelectedText, error) {
+	return i.FORM_GetSelectedTextWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_GetSelectedTextWithContext(ctx goctx.Context, request *requests.FORM_GetSelectedText) (*responses.FORM_GetSelectedText, error) {
+	resp, err := i.interceptor(ctx, "FORM_GetSelectedText", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_GetSelectedText", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_GetSelectedText", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_GetSelectedText(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_GetSelectedText)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_IsIndexSelected(request *requests.FORM_IsIndexSelected) (*responses.FORM_IsIndexSelected, error) {
+	return i.FORM_IsIndexSelectedWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_IsIndexSelectedWithContext(ctx goctx.Context, request *requests.FORM_IsIndexSelected) (*responses.FORM_IsIndexSelected, error) {
+	resp, err := i.interceptor(ctx, "FORM_IsIndexSelected", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_IsIndexSelected", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_IsIndexSelected", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_IsIndexSelected(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_IsIndexSelected)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_OnAfterLoadPage(request *requests.FORM_OnAfterLoadPage) (*responses.FORM_OnAfterLoadPage, error) {
+	return i.FORM_OnAfterLoadPageWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_OnAfterLoadPageWithContext(ctx goctx.Context, request *requests.FORM_OnAfterLoadPage) (*responses.FORM_OnAfterLoadPage, error) {
+	resp, err := i.interceptor(ctx, "FORM_OnAfterLoadPage", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_OnAfterLoadPage", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnAfterLoadPage", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_OnAfterLoadPage(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_OnAfterLoadPage)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_OnBeforeClosePage(request *requests.FORM_OnBeforeClosePage) (*responses.FORM_OnBeforeClosePage, error) {
+	return i.FORM_OnBeforeClosePageWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_OnBeforeClosePageWithContext(ctx goctx.Context, request *requests.FORM_OnBeforeClosePage) (*responses.FORM_OnBeforeClosePage, error) {
+	resp, err := i.interceptor(ctx, "FORM_OnBeforeClosePage", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_OnBeforeClosePage", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnBeforeClosePage", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_OnBeforeClosePage(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_OnBeforeClosePage)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_OnChar(request *requests.FORM_OnChar) (*responses.FORM_OnChar, error) {
+	return i.FORM_OnCharWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_OnCharWithContext(ctx goctx.Context, request *requests.FORM_OnChar) (*responses.FORM_OnChar, error) {
+	resp, err := i.interceptor(ctx, "FORM_OnChar", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_OnChar", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnChar", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_OnChar(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_OnChar)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_OnFocus(request *requests.FORM_OnFocus) (*responses.FORM_OnFocus, error) {
+	return i.FORM_OnFocusWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_OnFocusWithContext(ctx goctx.Context, request *requests.FORM_OnFocus) (*responses.FORM_OnFocus, error) {
+	resp, err := i.interceptor(ctx, "FORM_OnFocus", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_OnFocus", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnFocus", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_OnFocus(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_OnFocus)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_OnKeyDown(request *requests.FORM_OnKeyDown) (*responses.FORM_OnKeyDown, error) {
+	return i.FORM_OnKeyDownWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_OnKeyDownWithContext(ctx goctx.Context, request *requests.FORM_OnKeyDown) (*responses.FORM_OnKeyDown, error) {
+	resp, err := i.interceptor(ctx, "FORM_OnKeyDown", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_OnKeyDown", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnKeyDown", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_OnKeyDown(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_OnKeyDown)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_OnKeyUp(request *requests.FORM_OnKeyUp) (*responses.FORM_OnKeyUp, error) {
+	return i.FORM_OnKeyUpWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_OnKeyUpWithContext(ctx goctx.Context, request *requests.FORM_OnKeyUp) (*responses.FORM_OnKeyUp, error) {
+	resp, err := i.interceptor(ctx, "FORM_OnKeyUp", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_OnKeyUp", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnKeyUp", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_OnKeyUp(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_OnKeyUp)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_OnLButtonDoubleClick(request *requests.FORM_OnLButtonDoubleClick) (*responses.FORM_OnLButtonDoubleClick, error) {
+	return i.FORM_OnLButtonDoubleClickWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_OnLButtonDoubleClickWithContext(ctx goctx.Context, request *requests.FORM_OnLButtonDoubleClick) (*responses.FORM_OnLButtonDoubleClick, error) {
+	resp, err := i.interceptor(ctx, "FORM_OnLButtonDoubleClick", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_OnLButtonDoubleClick", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnLButtonDoubleClick", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_OnLButtonDoubleClick(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_OnLButtonDoubleClick)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_OnLButtonDown(request *requests.FORM_OnLButtonDown) (*responses.FORM_OnLButtonDown, error) {
+	return i.FORM_OnLButtonDownWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_OnLButtonDownWithContext(ctx goctx.Context, request *requests.FORM_OnLButtonDown) (*responses.FORM_OnLButtonDown, error) {
+	resp, err := i.interceptor(ctx, "FORM_OnLButtonDown", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_OnLButtonDown", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnLButtonDown", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_OnLButtonDown(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_OnLButtonDown)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_OnLButtonUp(request *requests.FORM_OnLButtonUp) (*responses.FORM_OnLButtonUp, error) {
+	return i.FORM_OnLButtonUpWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_OnLButtonUpWithContext(ctx goctx.Context, request *requests.FORM_OnLButtonUp) (*responses.FORM_OnLButtonUp, error) {
+	resp, err := i.interceptor(ctx, "FORM_OnLButtonUp", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_OnLButtonUp", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnLButtonUp", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_OnLButtonUp(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_OnLButtonUp)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_OnMouseMove(request *requests.FORM_OnMouseMove) (*responses.FORM_OnMouseMove, error) {
+	return i.FORM_OnMouseMoveWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_OnMouseMoveWithContext(ctx goctx.Context, request *requests.FORM_OnMouseMove) (*responses.FORM_OnMouseMove, error) {
+	resp, err := i.interceptor(ctx, "FORM_OnMouseMove", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_OnMouseMove", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnMouseMove", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_OnMouseMove(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_OnMouseMove)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_OnMouseWheel(request *requests.FORM_OnMouseWheel) (*responses.FORM_OnMouseWheel, error) {
+	return i.FORM_OnMouseWheelWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_OnMouseWheelWithContext(ctx goctx.Context, request *requests.FORM_OnMouseWheel) (*responses.FORM_OnMouseWheel, error) {
+	resp, err := i.interceptor(ctx, "FORM_OnMouseWheel", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_OnMouseWheel", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnMouseWheel", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_OnMouseWheel(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_OnMouseWheel)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_OnRButtonDown(request *requests.FORM_OnRButtonDown) (*responses.FORM_OnRButtonDown, error) {
+	return i.FORM_OnRButtonDownWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_OnRButtonDownWithContext(ctx goctx.Context, request *requests.FORM_OnRButtonDown) (*responses.FORM_OnRButtonDown, error) {
+	resp, err := i.interceptor(ctx, "FORM_OnRButtonDown", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_OnRButtonDown", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnRButtonDown", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_OnRButtonDown(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_OnRButtonDown)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_OnRButtonUp(request *requests.FORM_OnRButtonUp) (*responses.FORM_OnRButtonUp, error) {
+	return i.FORM_OnRButtonUpWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_OnRButtonUpWithContext(ctx goctx.Context, request *requests.FORM_OnRButtonUp) (*responses.FORM_OnRButtonUp, error) {
+	resp, err := i.interceptor(ctx, "FORM_OnRButtonUp", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_OnRButtonUp", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnRButtonUp", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_OnRButtonUp(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_OnRButtonUp)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_Redo(request *requests.FORM_Redo) (*responses.FORM_Redo, error) {
+	return i.FORM_RedoWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_RedoWithContext(ctx goctx.Context, request *requests.FORM_Redo) (*responses.FORM_Redo, error) {
+	resp, err := i.interceptor(ctx, "FORM_Redo", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_Redo", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_Redo", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_Redo(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_Redo)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_ReplaceAndKeepSelection(request *requests.FORM_ReplaceAndKeepSelection) (*responses.FORM_ReplaceAndKeepSelection, error) {
+	return i.FORM_ReplaceAndKeepSelectionWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_ReplaceAndKeepSelectionWithContext(ctx goctx.Context, request *requests.FORM_ReplaceAndKeepSelection) (*responses.FORM_ReplaceAndKeepSelection, error) {
+	resp, err := i.interceptor(ctx, "FORM_ReplaceAndKeepSelection", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_ReplaceAndKeepSelection", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_ReplaceAndKeepSelection", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_ReplaceAndKeepSelection(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_ReplaceAndKeepSelection)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_ReplaceSelection(request *requests.FORM_ReplaceSelection) (*responses.FORM_ReplaceSelection, error) {
+	return i.FORM_ReplaceSelectionWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_ReplaceSelectionWithContext(ctx goctx.Context, request *requests.FORM_ReplaceSelection) (*responses.FORM_ReplaceSelection, error) {
+	resp, err := i.interceptor(ctx, "FORM_ReplaceSelection", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_ReplaceSelection", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_ReplaceSelection", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_ReplaceSelection(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_ReplaceSelection)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_SelectAllText(request *requests.FORM_SelectAllText) (*responses.FORM_SelectAllText, error) {
+	return i.FORM_SelectAllTextWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_SelectAllTextWithContext(ctx goctx.Context, request *requests.FORM_SelectAllText) (*responses.FORM_SelectAllText, error) {
+	resp, err := i.interceptor(ctx, "FORM_SelectAllText", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_SelectAllText", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_SelectAllText", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_SelectAllText(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_SelectAllText)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_SetFocusedAnnot(request *requests.FORM_SetFocusedAnnot) (*responses.FORM_SetFocusedAnnot, error) {
+	return i.FORM_SetFocusedAnnotWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_SetFocusedAnnotWithContext(ctx goctx.Context, request *requests.FORM_SetFocusedAnnot) (*responses.FORM_SetFocusedAnnot, error) {
+	resp, err := i.interceptor(ctx, "FORM_SetFocusedAnnot", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_SetFocusedAnnot", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_SetFocusedAnnot", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_SetFocusedAnnot(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_SetFocusedAnnot)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_SetIndexSelected(request *requests.FORM_SetIndexSelected) (*responses.FORM_SetIndexSelected, error) {
+	return i.FORM_SetIndexSelectedWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_SetIndexSelectedWithContext(ctx goctx.Context, request *requests.FORM_SetIndexSelected) (*responses.FORM_SetIndexSelected, error) {
+	resp, err := i.interceptor(ctx, "FORM_SetIndexSelected", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_SetIndexSelected", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_SetIndexSelected", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_SetIndexSelected(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_SetIndexSelected)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FORM_Undo(request *requests.FORM_Undo) (*responses.FORM_Undo, error) {
+	return i.FORM_UndoWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FORM_UndoWithContext(ctx goctx.Context, request *requests.FORM_Undo) (*responses.FORM_Undo, error) {
+	resp, err := i.interceptor(ctx, "FORM_Undo", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FORM_Undo", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FORM_Undo", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FORM_Undo(request)
+	})
+	typedResp, _ := resp.(*responses.FORM_Undo)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAction_GetDest(request *requests.FPDFAction_GetDest) (*responses.FPDFAction_GetDest, error) {
+	return i.FPDFAction_GetDestWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAction_GetDestWithContext(ctx goctx.Context, request *requests.FPDFAction_GetDest) (*responses.FPDFAction_GetDest, error) {
+	resp, err := i.interceptor(ctx, "FPDFAction_GetDest", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAction_GetDest", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAction_GetDest", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAction_GetDest(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAction_GetDest)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAction_GetFilePath(request *requests.FPDFAction_GetFilePath) (*responses.FPDFAction_GetFilePath, error) {
+	return i.FPDFAction_GetFilePathWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAction_GetFilePathWithContext(ctx goctx.Context, request *requests.FPDFAction_GetFilePath) (*responses.FPDFAction_GetFilePath, error) {
+	resp, err := i.interceptor(ctx, "FPDFAction_GetFilePath", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAction_GetFilePath", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAction_GetFilePath", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAction_GetFilePath(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAction_GetFilePath)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAction_GetType(request *requests.FPDFAction_GetType) (*responses.FPDFAction_GetType, error) {
+	return i.FPDFAction_GetTypeWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAction_GetTypeWithContext(ctx goctx.Context, request *requests.FPDFAction_GetType) (*responses.FPDFAction_GetType, error) {
+	resp, err := i.interceptor(ctx, "FPDFAction_GetType", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAction_GetType", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAction_GetType", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAction_GetType(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAction_GetType)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAction_GetURIPath(request *requests.FPDFAction_GetURIPath) (*responses.FPDFAction_GetURIPath, error) {
+	return i.FPDFAction_GetURIPathWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAction_GetURIPathWithContext(ctx goctx.Context, request *requests.FPDFAction_GetURIPath) (*responses.FPDFAction_GetURIPath, error) {
+	resp, err := i.interceptor(ctx, "FPDFAction_GetURIPath", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAction_GetURIPath", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAction_GetURIPath", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAction_GetURIPath(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAction_GetURIPath)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_AddFileAttachment(request *requests.FPDFAnnot_AddFileAttachment) (*responses.FPDFAnnot_AddFileAttachment, error) {
+	return i.FPDFAnnot_AddFileAttachmentWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_AddFileAttachmentWithContext(ctx goctx.Context, request *requests.FPDFAnnot_AddFileAttachment) (*responses.FPDFAnnot_AddFileAttachment, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_AddFileAttachment", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_AddFileAttachment", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_AddFileAttachment", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_AddFileAttachment(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_AddFileAttachment)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_AddInkStroke(request *requests.FPDFAnnot_AddInkStroke) (*responses.FPDFAnnot_AddInkStroke, error) {
+	return i.FPDFAnnot_AddInkStrokeWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_AddInkStrokeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_AddInkStroke) (*responses.FPDFAnnot_AddInkStroke, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_AddInkStroke", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_AddInkStroke", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_AddInkStroke", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_AddInkStroke(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_AddInkStroke)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_AppendAttachmentPoints(request *requests.FPDFAnnot_AppendAttachmentPoints) (*responses.FPDFAnnot_AppendAttachmentPoints, error) {
+	return i.FPDFAnnot_AppendAttachmentPointsWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_AppendAttachmentPointsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_AppendAttachmentPoints) (*responses.FPDFAnnot_AppendAttachmentPoints, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_AppendAttachmentPoints", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_AppendAttachmentPoints", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_AppendAttachmentPoints", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_AppendAttachmentPoints(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_AppendAttachmentPoints)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_AppendObject(request *requests.FPDFAnnot_AppendObject) (*responses.FPDFAnnot_AppendObject, error) {
+	return i.FPDFAnnot_AppendObjectWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_AppendObjectWithContext(ctx goctx.Context, request *requests.FPDFAnnot_AppendObject) (*responses.FPDFAnnot_AppendObject, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_AppendObject", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_AppendObject", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_AppendObject", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_AppendObject(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_AppendObject)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_CountAttachmentPoints(request *requests.FPDFAnnot_CountAttachmentPoints) (*responses.FPDFAnnot_CountAttachmentPoints, error) {
+	return i.FPDFAnnot_CountAttachmentPointsWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_CountAttachmentPointsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_CountAttachmentPoints) (*responses.FPDFAnnot_CountAttachmentPoints, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_CountAttachmentPoints", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_CountAttachmentPoints", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_CountAttachmentPoints", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_CountAttachmentPoints(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_CountAttachmentPoints)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetAP(request *requests.FPDFAnnot_GetAP) (*responses.FPDFAnnot_GetAP, error) {
+	return i.FPDFAnnot_GetAPWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetAPWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetAP) (*responses.FPDFAnnot_GetAP, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetAP", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetAP", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetAP", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetAP(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetAP)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetAttachmentPoints(request *requests.FPDFAnnot_GetAttachmentPoints) (*responses.FPDFAnnot_GetAttachmentPoints, error) {
+	return i.FPDFAnnot_GetAttachmentPointsWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetAttachmentPointsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetAttachmentPoints) (*responses.FPDFAnnot_GetAttachmentPoints, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetAttachmentPoints", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetAttachmentPoints", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetAttachmentPoints", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetAttachmentPoints(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetAttachmentPoints)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetBorder(request *requests.FPDFAnnot_GetBorder) (*responses.FPDFAnnot_GetBorder, error) {
+	return i.FPDFAnnot_GetBorderWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetBorderWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetBorder) (*responses.FPDFAnnot_GetBorder, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetBorder", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetBorder", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetBorder", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetBorder(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetBorder)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetColor(request *requests.FPDFAnnot_GetColor) (*responses.FPDFAnnot_GetColor, error) {
+	return i.FPDFAnnot_GetColorWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetColorWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetColor) (*responses.FPDFAnnot_GetColor, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetColor", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetColor", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetColor", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetColor(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetColor)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFileAttachment(request *requests.FPDFAnnot_GetFileAttachment) (*responses.FPDFAnnot_GetFileAttachment, error) {
+	return i.FPDFAnnot_GetFileAttachmentWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFileAttachmentWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFileAttachment) (*responses.FPDFAnnot_GetFileAttachment, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetFileAttachment", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetFileAttachment", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFileAttachment", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetFileAttachment(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFileAttachment)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFlags(request *requests.FPDFAnnot_GetFlags) (*responses.FPDFAnnot_GetFlags, error) {
+	return i.FPDFAnnot_GetFlagsWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFlagsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFlags) (*responses.FPDFAnnot_GetFlags, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetFlags", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetFlags", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFlags", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetFlags(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFlags)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFocusableSubtypes(request *requests.FPDFAnnot_GetFocusableSubtypes) (*responses.FPDFAnnot_GetFocusableSubtypes, error) {
+	return i.FPDFAnnot_GetFocusableSubtypesWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFocusableSubtypesWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFocusableSubtypes) (*responses.FPDFAnnot_GetFocusableSubtypes, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetFocusableSubtypes", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetFocusableSubtypes", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFocusableSubtypes", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetFocusableSubtypes(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFocusableSubtypes)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFocusableSubtypesCount(request *requests.FPDFAnnot_GetFocusableSubtypesCount) (*responses.FPDFAnnot_GetFocusableSubtypesCount, error) {
+	return i.FPDFAnnot_GetFocusableSubtypesCountWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFocusableSubtypesCountWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFocusableSubtypesCount) (*responses.FPDFAnnot_GetFocusableSubtypesCount, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetFocusableSubtypesCount", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetFocusableSubtypesCount", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFocusableSubtypesCount", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetFocusableSubtypesCount(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFocusableSubtypesCount)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFontColor(request *requests.FPDFAnnot_GetFontColor) (*responses.FPDFAnnot_GetFontColor, error) {
+	return i.FPDFAnnot_GetFontColorWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFontColorWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFontColor) (*responses.FPDFAnnot_GetFontColor, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetFontColor", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetFontColor", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFontColor", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetFontColor(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFontColor)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFontSize(request *requests.FPDFAnnot_GetFontSize) (*responses.FPDFAnnot_GetFontSize, error) {
+	return i.FPDFAnnot_GetFontSizeWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFontSizeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFontSize) (*responses.FPDFAnnot_GetFontSize, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetFontSize", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetFontSize", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFontSize", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetFontSize(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFontSize)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormAdditionalActionJavaScript(request *requests.FPDFAnnot_GetFormAdditionalActionJavaScript) (*responses.FPDFAnnot_GetFormAdditionalActionJavaScript, error) {
+	return i.FPDFAnnot_GetFormAdditionalActionJavaScriptWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormAdditionalActionJavaScriptWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormAdditionalActionJavaScript) (*responses.FPDFAnnot_GetFormAdditionalActionJavaScript, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetFormAdditionalActionJavaScript", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetFormAdditionalActionJavaScript", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormAdditionalActionJavaScript", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetFormAdditionalActionJavaScript(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormAdditionalActionJavaScript)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormControlCount(request *requests.FPDFAnnot_GetFormControlCount) (*responses.FPDFAnnot_GetFormControlCount, error) {
+	return i.FPDFAnnot_GetFormControlCountWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormControlCountWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormControlCount) (*responses.FPDFAnnot_GetFormControlCount, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetFormControlCount", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetFormControlCount", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormControlCount", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetFormControlCount(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormControlCount)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormControlIndex(request *requests.FPDFAnnot_GetFormControlIndex) (*responses.FPDFAnnot_GetFormControlIndex, error) {
+	return i.FPDFAnnot_GetFormControlIndexWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormControlIndexWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormControlIndex) (*responses.FPDFAnnot_GetFormControlIndex, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetFormControlIndex", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetFormControlIndex", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormControlIndex", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetFormControlIndex(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormControlIndex)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormFieldAlternateName(request *requests.FPDFAnnot_GetFormFieldAlternateName) (*responses.FPDFAnnot_GetFormFieldAlternateName, error) {
+	return i.FPDFAnnot_GetFormFieldAlternateNameWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormFieldAlternateNameWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldAlternateName) (*responses.FPDFAnnot_GetFormFieldAlternateName, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetFormFieldAlternateName", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetFormFieldAlternateName", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldAlternateName", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetFormFieldAlternateName(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormFieldAlternateName)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormFieldAtPoint(request *requests.FPDFAnnot_GetFormFieldAtPoint) (*responses.FPDFAnnot_GetFormFieldAtPoint, error) {
+	return i.FPDFAnnot_GetFormFieldAtPointWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormFieldAtPointWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldAtPoint) (*responses.FPDFAnnot_GetFormFieldAtPoint, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetFormFieldAtPoint", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetFormFieldAtPoint", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldAtPoint", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetFormFieldAtPoint(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormFieldAtPoint)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormFieldExportValue(request *requests.FPDFAnnot_GetFormFieldExportValue) (*responses.FPDFAnnot_GetFormFieldExportValue, error) {
+	return i.FPDFAnnot_GetFormFieldExportValueWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormFieldExportValueWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldExportValue) (*responses.FPDFAnnot_GetFormFieldExportValue, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetFormFieldExportValue", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetFormFieldExportValue", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldExportValue", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetFormFieldExportValue(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormFieldExportValue)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormFieldFlags(request *requests.FPDFAnnot_GetFormFieldFlags) (*responses.FPDFAnnot_GetFormFieldFlags, error) {
+	return i.FPDFAnnot_GetFormFieldFlagsWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormFieldFlagsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldFlags) (*responses.FPDFAnnot_GetFormFieldFlags, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetFormFieldFlags", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetFormFieldFlags", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldFlags", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetFormFieldFlags(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormFieldFlags)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormFieldName(request *requests.FPDFAnnot_GetFormFieldName) (*responses.FPDFAnnot_GetFormFieldName, error) {
+	return i.FPDFAnnot_GetFormFieldNameWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormFieldNameWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldName) (*responses.FPDFAnnot_GetFormFieldName, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetFormFieldName", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetFormFieldName", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldName", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetFormFieldName(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormFieldName)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormFieldType(request *requests.FPDFAnnot_GetFormFieldType) (*responses.FPDFAnnot_GetFormFieldType, error) {
+	return i.FPDFAnnot_GetFormFieldTypeWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormFieldTypeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldType) (*responses.FPDFAnnot_GetFormFieldType, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetFormFieldType", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetFormFieldType", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldType", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetFormFieldType(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormFieldType)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormFieldValue(request *requests.FPDFAnnot_GetFormFieldValue) (*responses.FPDFAnnot_GetFormFieldValue, error) {
+	return i.FPDFAnnot_GetFormFieldValueWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetFormFieldValueWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetFormFieldValue) (*responses.FPDFAnnot_GetFormFieldValue, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetFormFieldValue", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetFormFieldValue", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldValue", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetFormFieldValue(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetFormFieldValue)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetInkListCount(request *requests.FPDFAnnot_GetInkListCount) (*responses.FPDFAnnot_GetInkListCount, error) {
+	return i.FPDFAnnot_GetInkListCountWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetInkListCountWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetInkListCount) (*responses.FPDFAnnot_GetInkListCount, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetInkListCount", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetInkListCount", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetInkListCount", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetInkListCount(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetInkListCount)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetInkListPath(request *requests.FPDFAnnot_GetInkListPath) (*responses.FPDFAnnot_GetInkListPath, error) {
+	return i.FPDFAnnot_GetInkListPathWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetInkListPathWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetInkListPath) (*responses.FPDFAnnot_GetInkListPath, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetInkListPath", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetInkListPath", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetInkListPath", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetInkListPath(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetInkListPath)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetLine(request *requests.FPDFAnnot_GetLine) (*responses.FPDFAnnot_GetLine, error) {
+	return i.FPDFAnnot_GetLineWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetLineWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetLine) (*responses.FPDFAnnot_GetLine, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetLine", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetLine", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetLine", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetLine(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetLine)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetLink(request *requests.FPDFAnnot_GetLink) (*responses.FPDFAnnot_GetLink, error) {
+	return i.FPDFAnnot_GetLinkWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetLinkWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetLink) (*responses.FPDFAnnot_GetLink, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetLink", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetLink", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetLink", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetLink(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetLink)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetLinkedAnnot(request *requests.FPDFAnnot_GetLinkedAnnot) (*responses.FPDFAnnot_GetLinkedAnnot, error) {
+	return i.FPDFAnnot_GetLinkedAnnotWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetLinkedAnnotWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetLinkedAnnot) (*responses.FPDFAnnot_GetLinkedAnnot, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetLinkedAnnot", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetLinkedAnnot", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetLinkedAnnot", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetLinkedAnnot(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetLinkedAnnot)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetNumberValue(request *requests.FPDFAnnot_GetNumberValue) (*responses.FPDFAnnot_GetNumberValue, error) {
+	return i.FPDFAnnot_GetNumberValueWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetNumberValueWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetNumberValue) (*responses.FPDFAnnot_GetNumberValue, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetNumberValue", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetNumberValue", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetNumberValue", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetNumberValue(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetNumberValue)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetObject(request *requests.FPDFAnnot_GetObject) (*responses.FPDFAnnot_GetObject, error) {
+	return i.FPDFAnnot_GetObjectWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetObjectWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetObject) (*responses.FPDFAnnot_GetObject, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetObject", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetObject", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetObject", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetObject(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetObject)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetObjectCount(request *requests.FPDFAnnot_GetObjectCount) (*responses.FPDFAnnot_GetObjectCount, error) {
+	return i.FPDFAnnot_GetObjectCountWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetObjectCountWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetObjectCount) (*responses.FPDFAnnot_GetObjectCount, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetObjectCount", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetObjectCount", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetObjectCount", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetObjectCount(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetObjectCount)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetOptionCount(request *requests.FPDFAnnot_GetOptionCount) (*responses.FPDFAnnot_GetOptionCount, error) {
+	return i.FPDFAnnot_GetOptionCountWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetOptionCountWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetOptionCount) (*responses.FPDFAnnot_GetOptionCount, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetOptionCount", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetOptionCount", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetOptionCount", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetOptionCount(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetOptionCount)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetOptionLabel(request *requests.FPDFAnnot_GetOptionLabel) (*responses.FPDFAnnot_GetOptionLabel, error) {
+	return i.FPDFAnnot_GetOptionLabelWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetOptionLabelWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetOptionLabel) (*responses.FPDFAnnot_GetOptionLabel, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetOptionLabel", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetOptionLabel", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetOptionLabel", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetOptionLabel(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetOptionLabel)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetRect(request *requests.FPDFAnnot_GetRect) (*responses.FPDFAnnot_GetRect, error) {
+	return i.FPDFAnnot_GetRectWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetRectWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetRect) (*responses.FPDFAnnot_GetRect, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetRect", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetRect", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetRect", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetRect(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetRect)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetStringValue(request *requests.FPDFAnnot_GetStringValue) (*responses.FPDFAnnot_GetStringValue, error) {
+	return i.FPDFAnnot_GetStringValueWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetStringValueWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetStringValue) (*responses.FPDFAnnot_GetStringValue, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetStringValue", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetStringValue", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetStringValue", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetStringValue(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetStringValue)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetSubtype(request *requests.FPDFAnnot_GetSubtype) (*responses.FPDFAnnot_GetSubtype, error) {
+	return i.FPDFAnnot_GetSubtypeWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetSubtypeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetSubtype) (*responses.FPDFAnnot_GetSubtype, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetSubtype", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetSubtype", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetSubtype", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetSubtype(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetSubtype)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetValueType(request *requests.FPDFAnnot_GetValueType) (*responses.FPDFAnnot_GetValueType, error) {
+	return i.FPDFAnnot_GetValueTypeWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetValueTypeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetValueType) (*responses.FPDFAnnot_GetValueType, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetValueType", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetValueType", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetValueType", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetValueType(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetValueType)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetVertices(request *requests.FPDFAnnot_GetVertices) (*responses.FPDFAnnot_GetVertices, error) {
+	return i.FPDFAnnot_GetVerticesWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_GetVerticesWithContext(ctx goctx.Context, request *requests.FPDFAnnot_GetVertices) (*responses.FPDFAnnot_GetVertices, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_GetVertices", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_GetVertices", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetVertices", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_GetVertices(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_GetVertices)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_HasAttachmentPoints(request *requests.FPDFAnnot_HasAttachmentPoints) (*responses.FPDFAnnot_HasAttachmentPoints, error) {
+	return i.FPDFAnnot_HasAttachmentPointsWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_HasAttachmentPointsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_HasAttachmentPoints) (*responses.FPDFAnnot_HasAttachmentPoints, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_HasAttachmentPoints", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_HasAttachmentPoints", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_HasAttachmentPoints", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_HasAttachmentPoints(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_HasAttachmentPoints)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_HasKey(request *requests.FPDFAnnot_HasKey) (*responses.FPDFAnnot_HasKey, error) {
+	return i.FPDFAnnot_HasKeyWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_HasKeyWithContext(ctx goctx.Context, request *requests.FPDFAnnot_HasKey) (*responses.FPDFAnnot_HasKey, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_HasKey", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_HasKey", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_HasKey", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_HasKey(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_HasKey)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_IsChecked(request *requests.FPDFAnnot_IsChecked) (*responses.FPDFAnnot_IsChecked, error) {
+	return i.FPDFAnnot_IsCheckedWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_IsCheckedWithContext(ctx goctx.Context, request *requests.FPDFAnnot_IsChecked) (*responses.FPDFAnnot_IsChecked, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_IsChecked", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_IsChecked", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_IsChecked", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_IsChecked(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_IsChecked)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_IsObjectSupportedSubtype(request *requests.FPDFAnnot_IsObjectSupportedSubtype) (*responses.FPDFAnnot_IsObjectSupportedSubtype, error) {
+	return i.FPDFAnnot_IsObjectSupportedSubtypeWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_IsObjectSupportedSubtypeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_IsObjectSupportedSubtype) (*responses.FPDFAnnot_IsObjectSupportedSubtype, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_IsObjectSupportedSubtype", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_IsObjectSupportedSubtype", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_IsObjectSupportedSubtype", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_IsObjectSupportedSubtype(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_IsObjectSupportedSubtype)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_IsOptionSelected(request *requests.FPDFAnnot_IsOptionSelected) (*responses.FPDFAnnot_IsOptionSelected, error) {
+	return i.FPDFAnnot_IsOptionSelectedWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_IsOptionSelectedWithContext(ctx goctx.Context, request *requests.FPDFAnnot_IsOptionSelected) (*responses.FPDFAnnot_IsOptionSelected, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_IsOptionSelected", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_IsOptionSelected", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_IsOptionSelected", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_IsOptionSelected(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_IsOptionSelected)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_IsSupportedSubtype(request *requests.FPDFAnnot_IsSupportedSubtype) (*responses.FPDFAnnot_IsSupportedSubtype, error) {
+	return i.FPDFAnnot_IsSupportedSubtypeWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_IsSupportedSubtypeWithContext(ctx goctx.Context, request *requests.FPDFAnnot_IsSupportedSubtype) (*responses.FPDFAnnot_IsSupportedSubtype, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_IsSupportedSubtype", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_IsSupportedSubtype", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_IsSupportedSubtype", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_IsSupportedSubtype(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_IsSupportedSubtype)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_RemoveInkList(request *requests.FPDFAnnot_RemoveInkList) (*responses.FPDFAnnot_RemoveInkList, error) {
+	return i.FPDFAnnot_RemoveInkListWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_RemoveInkListWithContext(ctx goctx.Context, request *requests.FPDFAnnot_RemoveInkList) (*responses.FPDFAnnot_RemoveInkList, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_RemoveInkList", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_RemoveInkList", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_RemoveInkList", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_RemoveInkList(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_RemoveInkList)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_RemoveObject(request *requests.FPDFAnnot_RemoveObject) (*responses.FPDFAnnot_RemoveObject, error) {
+	return i.FPDFAnnot_RemoveObjectWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_RemoveObjectWithContext(ctx goctx.Context, request *requests.FPDFAnnot_RemoveObject) (*responses.FPDFAnnot_RemoveObject, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_RemoveObject", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_RemoveObject", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_RemoveObject", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_RemoveObject(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_RemoveObject)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetAP(request *requests.FPDFAnnot_SetAP) (*responses.FPDFAnnot_SetAP, error) {
+	return i.FPDFAnnot_SetAPWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetAPWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetAP) (*responses.FPDFAnnot_SetAP, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_SetAP", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_SetAP", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetAP", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_SetAP(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetAP)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetAttachmentPoints(request *requests.FPDFAnnot_SetAttachmentPoints) (*responses.FPDFAnnot_SetAttachmentPoints, error) {
+	return i.FPDFAnnot_SetAttachmentPointsWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetAttachmentPointsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetAttachmentPoints) (*responses.FPDFAnnot_SetAttachmentPoints, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_SetAttachmentPoints", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_SetAttachmentPoints", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetAttachmentPoints", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_SetAttachmentPoints(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetAttachmentPoints)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetBorder(request *requests.FPDFAnnot_SetBorder) (*responses.FPDFAnnot_SetBorder, error) {
+	return i.FPDFAnnot_SetBorderWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetBorderWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetBorder) (*responses.FPDFAnnot_SetBorder, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_SetBorder", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_SetBorder", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetBorder", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_SetBorder(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetBorder)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetColor(request *requests.FPDFAnnot_SetColor) (*responses.FPDFAnnot_SetColor, error) {
+	return i.FPDFAnnot_SetColorWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetColorWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetColor) (*responses.FPDFAnnot_SetColor, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_SetColor", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_SetColor", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetColor", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_SetColor(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetColor)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetFlags(request *requests.FPDFAnnot_SetFlags) (*responses.FPDFAnnot_SetFlags, error) {
+	return i.FPDFAnnot_SetFlagsWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetFlagsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetFlags) (*responses.FPDFAnnot_SetFlags, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_SetFlags", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_SetFlags", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetFlags", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_SetFlags(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetFlags)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetFocusableSubtypes(request *requests.FPDFAnnot_SetFocusableSubtypes) (*responses.FPDFAnnot_SetFocusableSubtypes, error) {
+	return i.FPDFAnnot_SetFocusableSubtypesWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetFocusableSubtypesWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetFocusableSubtypes) (*responses.FPDFAnnot_SetFocusableSubtypes, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_SetFocusableSubtypes", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_SetFocusableSubtypes", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetFocusableSubtypes", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_SetFocusableSubtypes(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetFocusableSubtypes)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetFontColor(request *requests.FPDFAnnot_SetFontColor) (*responses.FPDFAnnot_SetFontColor, error) {
+	return i.FPDFAnnot_SetFontColorWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetFontColorWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetFontColor) (*responses.FPDFAnnot_SetFontColor, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_SetFontColor", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_SetFontColor", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetFontColor", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_SetFontColor(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetFontColor)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetFormFieldFlags(request *requests.FPDFAnnot_SetFormFieldFlags) (*responses.FPDFAnnot_SetFormFieldFlags, error) {
+	return i.FPDFAnnot_SetFormFieldFlagsWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetFormFieldFlagsWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetFormFieldFlags) (*responses.FPDFAnnot_SetFormFieldFlags, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_SetFormFieldFlags", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_SetFormFieldFlags", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetFormFieldFlags", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_SetFormFieldFlags(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetFormFieldFlags)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetRect(request *requests.FPDFAnnot_SetRect) (*responses.FPDFAnnot_SetRect, error) {
+	return i.FPDFAnnot_SetRectWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetRectWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetRect) (*responses.FPDFAnnot_SetRect, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_SetRect", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_SetRect", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetRect", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_SetRect(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetRect)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetStringValue(request *requests.FPDFAnnot_SetStringValue) (*responses.FPDFAnnot_SetStringValue, error) {
+	return i.FPDFAnnot_SetStringValueWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetStringValueWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetStringValue) (*responses.FPDFAnnot_SetStringValue, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_SetStringValue", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_SetStringValue", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetStringValue", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_SetStringValue(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetStringValue)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetURI(request *requests.FPDFAnnot_SetURI) (*responses.FPDFAnnot_SetURI, error) {
+	return i.FPDFAnnot_SetURIWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_SetURIWithContext(ctx goctx.Context, request *requests.FPDFAnnot_SetURI) (*responses.FPDFAnnot_SetURI, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_SetURI", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_SetURI", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetURI", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_SetURI(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_SetURI)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAnnot_UpdateObject(request *requests.FPDFAnnot_UpdateObject) (*responses.FPDFAnnot_UpdateObject, error) {
+	return i.FPDFAnnot_UpdateObjectWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAnnot_UpdateObjectWithContext(ctx goctx.Context, request *requests.FPDFAnnot_UpdateObject) (*responses.FPDFAnnot_UpdateObject, error) {
+	resp, err := i.interceptor(ctx, "FPDFAnnot_UpdateObject", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAnnot_UpdateObject", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_UpdateObject", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAnnot_UpdateObject(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAnnot_UpdateObject)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAttachment_GetDescription(request *requests.FPDFAttachment_GetDescription) (*responses.FPDFAttachment_GetDescription, error) {
+	return i.FPDFAttachment_GetDescriptionWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAttachment_GetDescriptionWithContext(ctx goctx.Context, request *requests.FPDFAttachment_GetDescription) (*responses.FPDFAttachment_GetDescription, error) {
+	resp, err := i.interceptor(ctx, "FPDFAttachment_GetDescription", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAttachment_GetDescription", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_GetDescription", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAttachment_GetDescription(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAttachment_GetDescription)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAttachment_GetFile(request *requests.FPDFAttachment_GetFile) (*responses.FPDFAttachment_GetFile, error) {
+	return i.FPDFAttachment_GetFileWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAttachment_GetFileWithContext(ctx goctx.Context, request *requests.FPDFAttachment_GetFile) (*responses.FPDFAttachment_GetFile, error) {
+	resp, err := i.interceptor(ctx, "FPDFAttachment_GetFile", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAttachment_GetFile", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_GetFile", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAttachment_GetFile(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAttachment_GetFile)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAttachment_GetName(request *requests.FPDFAttachment_GetName) (*responses.FPDFAttachment_GetName, error) {
+	return i.FPDFAttachment_GetNameWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAttachment_GetNameWithContext(ctx goctx.Context, request *requests.FPDFAttachment_GetName) (*responses.FPDFAttachment_GetName, error) {
+	resp, err := i.interceptor(ctx, "FPDFAttachment_GetName", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAttachment_GetName", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_GetName", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAttachment_GetName(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAttachment_GetName)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAttachment_GetStringValue(request *requests.FPDFAttachment_GetStringValue) (*responses.FPDFAttachment_GetStringValue, error) {
+	return i.FPDFAttachment_GetStringValueWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAttachment_GetStringValueWithContext(ctx goctx.Context, request *requests.FPDFAttachment_GetStringValue) (*responses.FPDFAttachment_GetStringValue, error) {
+	resp, err := i.interceptor(ctx, "FPDFAttachment_GetStringValue", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAttachment_GetStringValue", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_GetStringValue", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAttachment_GetStringValue(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAttachment_GetStringValue)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAttachment_GetSubtype(request *requests.FPDFAttachment_GetSubtype) (*responses.FPDFAttachment_GetSubtype, error) {
+	return i.FPDFAttachment_GetSubtypeWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAttachment_GetSubtypeWithContext(ctx goctx.Context, request *requests.FPDFAttachment_GetSubtype) (*responses.FPDFAttachment_GetSubtype, error) {
+	resp, err := i.interceptor(ctx, "FPDFAttachment_GetSubtype", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAttachment_GetSubtype", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_GetSubtype", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAttachment_GetSubtype(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAttachment_GetSubtype)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAttachment_GetValueType(request *requests.FPDFAttachment_GetValueType) (*responses.FPDFAttachment_GetValueType, error) {
+	return i.FPDFAttachment_GetValueTypeWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAttachment_GetValueTypeWithContext(ctx goctx.Context, request *requests.FPDFAttachment_GetValueType) (*responses.FPDFAttachment_GetValueType, error) {
+	resp, err := i.interceptor(ctx, "FPDFAttachment_GetValueType", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAttachment_GetValueType", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_GetValueType", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAttachment_GetValueType(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAttachment_GetValueType)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAttachment_HasKey(request *requests.FPDFAttachment_HasKey) (*responses.FPDFAttachment_HasKey, error) {
+	return i.FPDFAttachment_HasKeyWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAttachment_HasKeyWithContext(ctx goctx.Context, request *requests.FPDFAttachment_HasKey) (*responses.FPDFAttachment_HasKey, error) {
+	resp, err := i.interceptor(ctx, "FPDFAttachment_HasKey", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAttachment_HasKey", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_HasKey", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAttachment_HasKey(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAttachment_HasKey)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAttachment_SetDescription(request *requests.FPDFAttachment_SetDescription) (*responses.FPDFAttachment_SetDescription, error) {
+	return i.FPDFAttachment_SetDescriptionWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAttachment_SetDescriptionWithContext(ctx goctx.Context, request *requests.FPDFAttachment_SetDescription) (*responses.FPDFAttachment_SetDescription, error) {
+	resp, err := i.interceptor(ctx, "FPDFAttachment_SetDescription", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAttachment_SetDescription", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_SetDescription", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAttachment_SetDescription(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAttachment_SetDescription)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAttachment_SetFile(request *requests.FPDFAttachment_SetFile) (*responses.FPDFAttachment_SetFile, error) {
+	return i.FPDFAttachment_SetFileWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAttachment_SetFileWithContext(ctx goctx.Context, request *requests.FPDFAttachment_SetFile) (*responses.FPDFAttachment_SetFile, error) {
+	resp, err := i.interceptor(ctx, "FPDFAttachment_SetFile", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAttachment_SetFile", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_SetFile", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAttachment_SetFile(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAttachment_SetFile)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAttachment_SetStringValue(request *requests.FPDFAttachment_SetStringValue) (*responses.FPDFAttachment_SetStringValue, error) {
+	return i.FPDFAttachment_SetStringValueWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAttachment_SetStringValueWithContext(ctx goctx.Context, request *requests.FPDFAttachment_SetStringValue) (*responses.FPDFAttachment_SetStringValue, error) {
+	resp, err := i.interceptor(ctx, "FPDFAttachment_SetStringValue", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAttachment_SetStringValue", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_SetStringValue", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAttachment_SetStringValue(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAttachment_SetStringValue)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAvail_Create(request *requests.FPDFAvail_Create) (*responses.FPDFAvail_Create, error) {
+	return i.FPDFAvail_CreateWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAvail_CreateWithContext(ctx goctx.Context, request *requests.FPDFAvail_Create) (*responses.FPDFAvail_Create, error) {
+	resp, err := i.interceptor(ctx, "FPDFAvail_Create", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAvail_Create", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_Create", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAvail_Create(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAvail_Create)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAvail_Destroy(request *requests.FPDFAvail_Destroy) (*responses.FPDFAvail_Destroy, error) {
+	return i.FPDFAvail_DestroyWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAvail_DestroyWithContext(ctx goctx.Context, request *requests.FPDFAvail_Destroy) (*responses.FPDFAvail_Destroy, error) {
+	resp, err := i.interceptor(ctx, "FPDFAvail_Destroy", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAvail_Destroy", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_Destroy", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAvail_Destroy(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAvail_Destroy)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAvail_GetDocument(request *requests.FPDFAvail_GetDocument) (*responses.FPDFAvail_GetDocument, error) {
+	return i.FPDFAvail_GetDocumentWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAvail_GetDocumentWithContext(ctx goctx.Context, request *requests.FPDFAvail_GetDocument) (*responses.FPDFAvail_GetDocument, error) {
+	resp, err := i.interceptor(ctx, "FPDFAvail_GetDocument", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAvail_GetDocument", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_GetDocument", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAvail_GetDocument(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAvail_GetDocument)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAvail_GetFirstPageNum(request *requests.FPDFAvail_GetFirstPageNum) (*responses.FPDFAvail_GetFirstPageNum, error) {
+	return i.FPDFAvail_GetFirstPageNumWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAvail_GetFirstPageNumWithContext(ctx goctx.Context, request *requests.FPDFAvail_GetFirstPageNum) (*responses.FPDFAvail_GetFirstPageNum, error) {
+	resp, err := i.interceptor(ctx, "FPDFAvail_GetFirstPageNum", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAvail_GetFirstPageNum", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_GetFirstPageNum", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAvail_GetFirstPageNum(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAvail_GetFirstPageNum)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAvail_IsDocAvail(request *requests.FPDFAvail_IsDocAvail) (*responses.FPDFAvail_IsDocAvail, error) {
+	return i.FPDFAvail_IsDocAvailWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAvail_IsDocAvailWithContext(ctx goctx.Context, request *requests.FPDFAvail_IsDocAvail) (*responses.FPDFAvail_IsDocAvail, error) {
+	resp, err := i.interceptor(ctx, "FPDFAvail_IsDocAvail", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAvail_IsDocAvail", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_IsDocAvail", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAvail_IsDocAvail(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAvail_IsDocAvail)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAvail_IsFormAvail(request *requests.FPDFAvail_IsFormAvail) (*responses.FPDFAvail_IsFormAvail, error) {
+	return i.FPDFAvail_IsFormAvailWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAvail_IsFormAvailWithContext(ctx goctx.Context, request *requests.FPDFAvail_IsFormAvail) (*responses.FPDFAvail_IsFormAvail, error) {
+	resp, err := i.interceptor(ctx, "FPDFAvail_IsFormAvail", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAvail_IsFormAvail", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_IsFormAvail", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAvail_IsFormAvail(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAvail_IsFormAvail)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAvail_IsLinearized(request *requests.FPDFAvail_IsLinearized) (*responses.FPDFAvail_IsLinearized, error) {
+	return i.FPDFAvail_IsLinearizedWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAvail_IsLinearizedWithContext(ctx goctx.Context, request *requests.FPDFAvail_IsLinearized) (*responses.FPDFAvail_IsLinearized, error) {
+	resp, err := i.interceptor(ctx, "FPDFAvail_IsLinearized", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAvail_IsLinearized", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_IsLinearized", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAvail_IsLinearized(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAvail_IsLinearized)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFAvail_IsPageAvail(request *requests.FPDFAvail_IsPageAvail) (*responses.FPDFAvail_IsPageAvail, error) {
+	return i.FPDFAvail_IsPageAvailWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFAvail_IsPageAvailWithContext(ctx goctx.Context, request *requests.FPDFAvail_IsPageAvail) (*responses.FPDFAvail_IsPageAvail, error) {
+	resp, err := i.interceptor(ctx, "FPDFAvail_IsPageAvail", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFAvail_IsPageAvail", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_IsPageAvail", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFAvail_IsPageAvail(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFAvail_IsPageAvail)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFBitmap_Create(request *requests.FPDFBitmap_Create) (*responses.FPDFBitmap_Create, error) {
+	return i.FPDFBitmap_CreateWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFBitmap_CreateWithContext(ctx goctx.Context, request *requests.FPDFBitmap_Create) (*responses.FPDFBitmap_Create, error) {
+	resp, err := i.interceptor(ctx, "FPDFBitmap_Create", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFBitmap_Create", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_Create", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFBitmap_Create(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFBitmap_Create)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFBitmap_CreateEx(request *requests.FPDFBitmap_CreateEx) (*responses.FPDFBitmap_CreateEx, error) {
+	return i.FPDFBitmap_CreateExWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFBitmap_CreateExWithContext(ctx goctx.Context, request *requests.FPDFBitmap_CreateEx) (*responses.FPDFBitmap_CreateEx, error) {
+	resp, err := i.interceptor(ctx, "FPDFBitmap_CreateEx", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFBitmap_CreateEx", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_CreateEx", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFBitmap_CreateEx(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFBitmap_CreateEx)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFBitmap_Destroy(request *requests.FPDFBitmap_Destroy) (*responses.FPDFBitmap_Destroy, error) {
+	return i.FPDFBitmap_DestroyWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFBitmap_DestroyWithContext(ctx goctx.Context, request *requests.FPDFBitmap_Destroy) (*responses.FPDFBitmap_Destroy, error) {
+	resp, err := i.interceptor(ctx, "FPDFBitmap_Destroy", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFBitmap_Destroy", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_Destroy", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFBitmap_Destroy(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFBitmap_Destroy)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFBitmap_FillRect(request *requests.FPDFBitmap_FillRect) (*responses.FPDFBitmap_FillRect, error) {
+	return i.FPDFBitmap_FillRectWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFBitmap_FillRectWithContext(ctx goctx.Context, request *requests.FPDFBitmap_FillRect) (*responses.FPDFBitmap_FillRect, error) {
+	resp, err := i.interceptor(ctx, "FPDFBitmap_FillRect", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFBitmap_FillRect", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_FillRect", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFBitmap_FillRect(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFBitmap_FillRect)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFBitmap_GetBuffer(request *requests.FPDFBitmap_GetBuffer) (*responses.FPDFBitmap_GetBuffer, error) {
+	return i.FPDFBitmap_GetBufferWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFBitmap_GetBufferWithContext(ctx goctx.Context, request *requests.FPDFBitmap_GetBuffer) (*responses.FPDFBitmap_GetBuffer, error) {
+	resp, err := i.interceptor(ctx, "FPDFBitmap_GetBuffer", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFBitmap_GetBuffer", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_GetBuffer", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFBitmap_GetBuffer(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFBitmap_GetBuffer)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFBitmap_GetFormat(request *requests.FPDFBitmap_GetFormat) (*responses.FPDFBitmap_GetFormat, error) {
+	return i.FPDFBitmap_GetFormatWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFBitmap_GetFormatWithContext(ctx goctx.Context, request *requests.FPDFBitmap_GetFormat) (*responses.FPDFBitmap_GetFormat, error) {
+	resp, err := i.interceptor(ctx, "FPDFBitmap_GetFormat", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFBitmap_GetFormat", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_GetFormat", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFBitmap_GetFormat(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFBitmap_GetFormat)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFBitmap_GetHeight(request *requests.FPDFBitmap_GetHeight) (*responses.FPDFBitmap_GetHeight, error) {
+	return i.FPDFBitmap_GetHeightWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFBitmap_GetHeightWithContext(ctx goctx.Context, request *requests.FPDFBitmap_GetHeight) (*responses.FPDFBitmap_GetHeight, error) {
+	resp, err := i.interceptor(ctx, "FPDFBitmap_GetHeight", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFBitmap_GetHeight", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_GetHeight", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFBitmap_GetHeight(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFBitmap_GetHeight)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFBitmap_GetStride(request *requests.FPDFBitmap_GetStride) (*responses.FPDFBitmap_GetStride, error) {
+	return i.FPDFBitmap_GetStrideWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFBitmap_GetStrideWithContext(ctx goctx.Context, request *requests.FPDFBitmap_GetStride) (*responses.FPDFBitmap_GetStride, error) {
+	resp, err := i.interceptor(ctx, "FPDFBitmap_GetStride", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFBitmap_GetStride", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_GetStride", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFBitmap_GetStride(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFBitmap_GetStride)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFBitmap_GetWidth(request *requests.FPDFBitmap_GetWidth) (*responses.FPDFBitmap_GetWidth, error) {
+	return i.FPDFBitmap_GetWidthWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFBitmap_GetWidthWithContext(ctx goctx.Context, request *requests.FPDFBitmap_GetWidth) (*responses.FPDFBitmap_GetWidth, error) {
+	resp, err := i.interceptor(ctx, "FPDFBitmap_GetWidth", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFBitmap_GetWidth", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_GetWidth", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFBitmap_GetWidth(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFBitmap_GetWidth)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFBookmark_Find(request *requests.FPDFBookmark_Find) (*responses.FPDFBookmark_Find, error) {
+	return i.FPDFBookmark_FindWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFBookmark_FindWithContext(ctx goctx.Context, request *requests.FPDFBookmark_Find) (*responses.FPDFBookmark_Find, error) {
+	resp, err := i.interceptor(ctx, "FPDFBookmark_Find", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFBookmark_Find", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_Find", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFBookmark_Find(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFBookmark_Find)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFBookmark_GetAction(request *requests.FPDFBookmark_GetAction) (*responses.FPDFBookmark_GetAction, error) {
+	return i.FPDFBookmark_GetActionWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFBookmark_GetActionWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetAction) (*responses.FPDFBookmark_GetAction, error) {
+	resp, err := i.interceptor(ctx, "FPDFBookmark_GetAction", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFBookmark_GetAction", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetAction", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFBookmark_GetAction(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFBookmark_GetAction)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFBookmark_GetColor(request *requests.FPDFBookmark_GetColor) (*responses.FPDFBookmark_GetColor, error) {
+	return i.FPDFBookmark_GetColorWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFBookmark_GetColorWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetColor) (*responses.FPDFBookmark_GetColor, error) {
+	resp, err := i.interceptor(ctx, "FPDFBookmark_GetColor", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFBookmark_GetColor", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetColor", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFBookmark_GetColor(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFBookmark_GetColor)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFBookmark_GetCount(request *requests.FPDFBookmark_GetCount) (*responses.FPDFBookmark_GetCount, error) {
+	return i.FPDFBookmark_GetCountWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFBookmark_GetCountWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetCount) (*responses.FPDFBookmark_GetCount, error) {
+	resp, err := i.interceptor(ctx, "FPDFBookmark_GetCount", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFBookmark_GetCount", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetCount", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFBookmark_GetCount(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFBookmark_GetCount)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFBookmark_GetDest(request *requests.FPDFBookmark_GetDest) (*responses.FPDFBookmark_GetDest, error) {
+	return i.FPDFBookmark_GetDestWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFBookmark_GetDestWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetDest) (*responses.FPDFBookmark_GetDest, error) {
+	resp, err := i.interceptor(ctx, "FPDFBookmark_GetDest", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFBookmark_GetDest", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetDest", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFBookmark_GetDest(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFBookmark_GetDest)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFBookmark_GetFirstChild(request *requests.FPDFBookmark_GetFirstChild) (*responses.FPDFBookmark_GetFirstChild, error) {
+	return i.FPDFBookmark_GetFirstChildWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFBookmark_GetFirstChildWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetFirstChild) (*responses.FPDFBookmark_GetFirstChild, error) {
+	resp, err := i.interceptor(ctx, "FPDFBookmark_GetFirstChild", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFBookmark_GetFirstChild", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetFirstChild", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFBookmark_GetFirstChild(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFBookmark_GetFirstChild)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFBookmark_GetNextSibling(request *requests.FPDFBookmark_GetNextSibling) (*responses.FPDFBookmark_GetNextSibling, error) {
+	return i.FPDFBookmark_GetNextSiblingWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFBookmark_GetNextSiblingWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetNextSibling) (*responses.FPDFBookmark_GetNextSibling, error) {
+	resp, err := i.interceptor(ctx, "FPDFBookmark_GetNextSibling", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFBookmark_GetNextSibling", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetNextSibling", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFBookmark_GetNextSibling(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFBookmark_GetNextSibling)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFBookmark_GetTitle(request *requests.FPDFBookmark_GetTitle) (*responses.FPDFBookmark_GetTitle, error) {
+	return i.FPDFBookmark_GetTitleWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFBookmark_GetTitleWithContext(ctx goctx.Context, request *requests.FPDFBookmark_GetTitle) (*responses.FPDFBookmark_GetTitle, error) {
+	resp, err := i.interceptor(ctx, "FPDFBookmark_GetTitle", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFBookmark_GetTitle", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetTitle", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFBookmark_GetTitle(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFBookmark_GetTitle)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFCatalog_GetLanguage(request *requests.FPDFCatalog_GetLanguage) (*responses.FPDFCatalog_GetLanguage, error) {
+	return i.FPDFCatalog_GetLanguageWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFCatalog_GetLanguageWithContext(ctx goctx.Context, request *requests.FPDFCatalog_GetLanguage) (*responses.FPDFCatalog_GetLanguage, error) {
+	resp, err := i.interceptor(ctx, "FPDFCatalog_GetLanguage", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFCatalog_GetLanguage", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFCatalog_GetLanguage", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFCatalog_GetLanguage(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFCatalog_GetLanguage)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFCatalog_IsTagged(request *requests.FPDFCatalog_IsTagged) (*responses.FPDFCatalog_IsTagged, error) {
+	return i.FPDFCatalog_IsTaggedWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFCatalog_IsTaggedWithContext(ctx goctx.Context, request *requests.FPDFCatalog_IsTagged) (*responses.FPDFCatalog_IsTagged, error) {
+	resp, err := i.interceptor(ctx, "FPDFCatalog_IsTagged", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFCatalog_IsTagged", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFCatalog_IsTagged", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFCatalog_IsTagged(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFCatalog_IsTagged)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFCatalog_SetLanguage(request *requests.FPDFCatalog_SetLanguage) (*responses.FPDFCatalog_SetLanguage, error) {
+	return i.FPDFCatalog_SetLanguageWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFCatalog_SetLanguageWithContext(ctx goctx.Context, request *requests.FPDFCatalog_SetLanguage) (*responses.FPDFCatalog_SetLanguage, error) {
+	resp, err := i.interceptor(ctx, "FPDFCatalog_SetLanguage", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFCatalog_SetLanguage", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFCatalog_SetLanguage", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFCatalog_SetLanguage(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFCatalog_SetLanguage)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFClipPath_CountPathSegments(request *requests.FPDFClipPath_CountPathSegments) (*responses.FPDFClipPath_CountPathSegments, error) {
+	return i.FPDFClipPath_CountPathSegmentsWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_CanRedo", panicError)
+func (i *pdfiumInstance) FPDFClipPath_CountPathSegmentsWithContext(ctx goctx.Context, request *requests.FPDFClipPath_CountPathSegments) (*responses.FPDFClipPath_CountPathSegments, error) {
+	resp, err := i.interceptor(ctx, "FPDFClipPath_CountPathSegments", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FORM_CanRedo(request)
+		done, err := i.startCall(ctx, "FPDFClipPath_CountPathSegments", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFClipPath_CountPathSegments", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFClipPath_CountPathSegments(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFClipPath_CountPathSegments)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFClipPath_CountPaths(request *requests.FPDFClipPath_CountPaths) (*responses.FPDFClipPath_CountPaths, error) {
+	return i.FPDFClipPath_CountPathsWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFClipPath_CountPathsWithContext(ctx goctx.Context, request *requests.FPDFClipPath_CountPaths) (*responses.FPDFClipPath_CountPaths, error) {
+	resp, err := i.interceptor(ctx, "FPDFClipPath_CountPaths", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFClipPath_CountPaths", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFClipPath_CountPaths", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFClipPath_CountPaths(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFClipPath_CountPaths)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFClipPath_GetPathSegment(request *requests.FPDFClipPath_GetPathSegment) (*responses.FPDFClipPath_GetPathSegment, error) {
+	return i.FPDFClipPath_GetPathSegmentWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFClipPath_GetPathSegmentWithContext(ctx goctx.Context, request *requests.FPDFClipPath_GetPathSegment) (*responses.FPDFClipPath_GetPathSegment, error) {
+	resp, err := i.interceptor(ctx, "FPDFClipPath_GetPathSegment", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFClipPath_GetPathSegment", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFClipPath_GetPathSegment", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFClipPath_GetPathSegment(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFClipPath_GetPathSegment)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFDOC_ExitFormFillEnvironment(request *requests.FPDFDOC_ExitFormFillEnvironment) (*responses.FPDFDOC_ExitFormFillEnvironment, error) {
+	return i.FPDFDOC_ExitFormFillEnvironmentWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFDOC_ExitFormFillEnvironmentWithContext(ctx goctx.Context, request *requests.FPDFDOC_ExitFormFillEnvironment) (*responses.FPDFDOC_ExitFormFillEnvironment, error) {
+	resp, err := i.interceptor(ctx, "FPDFDOC_ExitFormFillEnvironment", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFDOC_ExitFormFillEnvironment", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFDOC_ExitFormFillEnvironment", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFDOC_ExitFormFillEnvironment(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFDOC_ExitFormFillEnvironment)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFDOC_InitFormFillEnvironment(request *requests.FPDFDOC_InitFormFillEnvironment) (*responses.FPDFDOC_InitFormFillEnvironment, error) {
+	return i.FPDFDOC_InitFormFillEnvironmentWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFDOC_InitFormFillEnvironmentWithContext(ctx goctx.Context, request *requests.FPDFDOC_InitFormFillEnvironment) (*responses.FPDFDOC_InitFormFillEnvironment, error) {
+	resp, err := i.interceptor(ctx, "FPDFDOC_InitFormFillEnvironment", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFDOC_InitFormFillEnvironment", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFDOC_InitFormFillEnvironment", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFDOC_InitFormFillEnvironment(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFDOC_InitFormFillEnvironment)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFDest_GetDestPageIndex(request *requests.FPDFDest_GetDestPageIndex) (*responses.FPDFDest_GetDestPageIndex, error) {
+	return i.FPDFDest_GetDestPageIndexWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFDest_GetDestPageIndexWithContext(ctx goctx.Context, request *requests.FPDFDest_GetDestPageIndex) (*responses.FPDFDest_GetDestPageIndex, error) {
+	resp, err := i.interceptor(ctx, "FPDFDest_GetDestPageIndex", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFDest_GetDestPageIndex", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFDest_GetDestPageIndex", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFDest_GetDestPageIndex(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFDest_GetDestPageIndex)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFDest_GetLocationInPage(request *requests.FPDFDest_GetLocationInPage) (*responses.FPDFDest_GetLocationInPage, error) {
+	return i.FPDFDest_GetLocationInPageWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFDest_GetLocationInPageWithContext(ctx goctx.Context, request *requests.FPDFDest_GetLocationInPage) (*responses.FPDFDest_GetLocationInPage, error) {
+	resp, err := i.interceptor(ctx, "FPDFDest_GetLocationInPage", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFDest_GetLocationInPage", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFDest_GetLocationInPage", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFDest_GetLocationInPage(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFDest_GetLocationInPage)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFDest_GetView(request *requests.FPDFDest_GetView) (*responses.FPDFDest_GetView, error) {
+	return i.FPDFDest_GetViewWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFDest_GetViewWithContext(ctx goctx.Context, request *requests.FPDFDest_GetView) (*responses.FPDFDest_GetView, error) {
+	resp, err := i.interceptor(ctx, "FPDFDest_GetView", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFDest_GetView", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFDest_GetView", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFDest_GetView(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFDest_GetView)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFDoc_AddAttachment(request *requests.FPDFDoc_AddAttachment) (*responses.FPDFDoc_AddAttachment, error) {
+	return i.FPDFDoc_AddAttachmentWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFDoc_AddAttachmentWithContext(ctx goctx.Context, request *requests.FPDFDoc_AddAttachment) (*responses.FPDFDoc_AddAttachment, error) {
+	resp, err := i.interceptor(ctx, "FPDFDoc_AddAttachment", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFDoc_AddAttachment", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_AddAttachment", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFDoc_AddAttachment(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFDoc_AddAttachment)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFDoc_CloseJavaScriptAction(request *requests.FPDFDoc_CloseJavaScriptAction) (*responses.FPDFDoc_CloseJavaScriptAction, error) {
+	return i.FPDFDoc_CloseJavaScriptActionWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFDoc_CloseJavaScriptActionWithContext(ctx goctx.Context, request *requests.FPDFDoc_CloseJavaScriptAction) (*responses.FPDFDoc_CloseJavaScriptAction, error) {
+	resp, err := i.interceptor(ctx, "FPDFDoc_CloseJavaScriptAction", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFDoc_CloseJavaScriptAction", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_CloseJavaScriptAction", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFDoc_CloseJavaScriptAction(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFDoc_CloseJavaScriptAction)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFDoc_DeleteAttachment(request *requests.FPDFDoc_DeleteAttachment) (*responses.FPDFDoc_DeleteAttachment, error) {
+	return i.FPDFDoc_DeleteAttachmentWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFDoc_DeleteAttachmentWithContext(ctx goctx.Context, request *requests.FPDFDoc_DeleteAttachment) (*responses.FPDFDoc_DeleteAttachment, error) {
+	resp, err := i.interceptor(ctx, "FPDFDoc_DeleteAttachment", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFDoc_DeleteAttachment", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_DeleteAttachment", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFDoc_DeleteAttachment(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFDoc_DeleteAttachment)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFDoc_GetAttachment(request *requests.FPDFDoc_GetAttachment) (*responses.FPDFDoc_GetAttachment, error) {
+	return i.FPDFDoc_GetAttachmentWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFDoc_GetAttachmentWithContext(ctx goctx.Context, request *requests.FPDFDoc_GetAttachment) (*responses.FPDFDoc_GetAttachment, error) {
+	resp, err := i.interceptor(ctx, "FPDFDoc_GetAttachment", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFDoc_GetAttachment", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_GetAttachment", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFDoc_GetAttachment(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFDoc_GetAttachment)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFDoc_GetAttachmentCount(request *requests.FPDFDoc_GetAttachmentCount) (*responses.FPDFDoc_GetAttachmentCount, error) {
+	return i.FPDFDoc_GetAttachmentCountWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFDoc_GetAttachmentCountWithContext(ctx goctx.Context, request *requests.FPDFDoc_GetAttachmentCount) (*responses.FPDFDoc_GetAttachmentCount, error) {
+	resp, err := i.interceptor(ctx, "FPDFDoc_GetAttachmentCount", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFDoc_GetAttachmentCount", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_GetAttachmentCount", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFDoc_GetAttachmentCount(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFDoc_GetAttachmentCount)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFDoc_GetJavaScriptAction(request *requests.FPDFDoc_GetJavaScriptAction) (*responses.FPDFDoc_GetJavaScriptAction, error) {
+	return i.FPDFDoc_GetJavaScriptActionWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFDoc_GetJavaScriptActionWithContext(ctx goctx.Context, request *requests.FPDFDoc_GetJavaScriptAction) (*responses.FPDFDoc_GetJavaScriptAction, error) {
+	resp, err := i.interceptor(ctx, "FPDFDoc_GetJavaScriptAction", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFDoc_GetJavaScriptAction", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_GetJavaScriptAction", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFDoc_GetJavaScriptAction(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFDoc_GetJavaScriptAction)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFDoc_GetJavaScriptActionCount(request *requests.FPDFDoc_GetJavaScriptActionCount) (*responses.FPDFDoc_GetJavaScriptActionCount, error) {
+	return i.FPDFDoc_GetJavaScriptActionCountWithContext(goctx.Background(), request)
+}
+
+func (i *pdfiumInstance) FPDFDoc_GetJavaScriptActionCountWithContext(ctx goctx.Context, request *requests.FPDFDoc_GetJavaScriptActionCount) (*responses.FPDFDoc_GetJavaScriptActionCount, error) {
+	resp, err := i.interceptor(ctx, "FPDFDoc_GetJavaScriptActionCount", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
+
+		done, err := i.startCall(ctx, "FPDFDoc_GetJavaScriptActionCount", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_GetJavaScriptActionCount", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFDoc_GetJavaScriptActionCount(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFDoc_GetJavaScriptActionCount)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFDoc_GetPageMode(request *requests.FPDFDoc_GetPageMode) (*responses.FPDFDoc_GetPageMode, error) {
+	return i.FPDFDoc_GetPageModeWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_CanUndo(request *requests.FORM_CanUndo) (resp *responses.FORM_CanUndo, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFDoc_GetPageModeWithContext(ctx goctx.Context, request *requests.FPDFDoc_GetPageMode) (*responses.FPDFDoc_GetPageMode, error) {
+	resp, err := i.interceptor(ctx, "FPDFDoc_GetPageMode", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_CanUndo", panicError)
+		done, err := i.startCall(ctx, "FPDFDoc_GetPageMode", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_CanUndo(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_GetPageMode", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFDoc_GetPageMode(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFDoc_GetPageMode)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFFont_Close(request *requests.FPDFFont_Close) (*responses.FPDFFont_Close, error) {
+	return i.FPDFFont_CloseWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_DoDocumentAAction(request *requests.FORM_DoDocumentAAction) (resp *responses.FORM_DoDocumentAAction, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFFont_CloseWithContext(ctx goctx.Context, request *requests.FPDFFont_Close) (*responses.FPDFFont_Close, error) {
+	resp, err := i.interceptor(ctx, "FPDFFont_Close", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_DoDocumentAAction", panicError)
+		done, err := i.startCall(ctx, "FPDFFont_Close", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_DoDocumentAAction(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_Close", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFFont_Close(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFFont_Close)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFFont_GetAscent(request *requests.FPDFFont_GetAscent) (*responses.FPDFFont_GetAscent, error) {
+	return i.FPDFFont_GetAscentWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_DoDocumentJSAction(request *requests.FORM_DoDocumentJSAction) (resp *responses.FORM_DoDocumentJSAction, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFFont_GetAscentWithContext(ctx goctx.Context, request *requests.FPDFFont_GetAscent) (*responses.FPDFFont_GetAscent, error) {
+	resp, err := i.interceptor(ctx, "FPDFFont_GetAscent", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_DoDocumentJSAction", panicError)
+		done, err := i.startCall(ctx, "FPDFFont_GetAscent", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_DoDocumentJSAction(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetAscent", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFFont_GetAscent(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFFont_GetAscent)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFFont_GetBaseFontName(request *requests.FPDFFont_GetBaseFontName) (*responses.FPDFFont_GetBaseFontName, error) {
+	return i.FPDFFont_GetBaseFontNameWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_DoDocumentOpenAction(request *requests.FORM_DoDocumentOpenAction) (resp *responses.FORM_DoDocumentOpenAction, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFFont_GetBaseFontNameWithContext(ctx goctx.Context, request *requests.FPDFFont_GetBaseFontName) (*responses.FPDFFont_GetBaseFontName, error) {
+	resp, err := i.interceptor(ctx, "FPDFFont_GetBaseFontName", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_DoDocumentOpenAction", panicError)
+		done, err := i.startCall(ctx, "FPDFFont_GetBaseFontName", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_DoDocumentOpenAction(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetBaseFontName", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFFont_GetBaseFontName(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFFont_GetBaseFontName)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFFont_GetDescent(request *requests.FPDFFont_GetDescent) (*responses.FPDFFont_GetDescent, error) {
+	return i.FPDFFont_GetDescentWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_DoPageAAction(request *requests.FORM_DoPageAAction) (resp *responses.FORM_DoPageAAction, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFFont_GetDescentWithContext(ctx goctx.Context, request *requests.FPDFFont_GetDescent) (*responses.FPDFFont_GetDescent, error) {
+	resp, err := i.interceptor(ctx, "FPDFFont_GetDescent", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_DoPageAAction", panicError)
+		done, err := i.startCall(ctx, "FPDFFont_GetDescent", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_DoPageAAction(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetDescent", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFFont_GetDescent(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFFont_GetDescent)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFFont_GetFamilyName(request *requests.FPDFFont_GetFamilyName) (*responses.FPDFFont_GetFamilyName, error) {
+	return i.FPDFFont_GetFamilyNameWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_ForceToKillFocus(request *requests.FORM_ForceToKillFocus) (resp *responses.FORM_ForceToKillFocus, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFFont_GetFamilyNameWithContext(ctx goctx.Context, request *requests.FPDFFont_GetFamilyName) (*responses.FPDFFont_GetFamilyName, error) {
+	resp, err := i.interceptor(ctx, "FPDFFont_GetFamilyName", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_ForceToKillFocus", panicError)
+		done, err := i.startCall(ctx, "FPDFFont_GetFamilyName", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_ForceToKillFocus(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetFamilyName", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFFont_GetFamilyName(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFFont_GetFamilyName)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFFont_GetFlags(request *requests.FPDFFont_GetFlags) (*responses.FPDFFont_GetFlags, error) {
+	return i.FPDFFont_GetFlagsWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_GetFocusedAnnot(request *requests.FORM_GetFocusedAnnot) (resp *responses.FORM_GetFocusedAnnot, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFFont_GetFlagsWithContext(ctx goctx.Context, request *requests.FPDFFont_GetFlags) (*responses.FPDFFont_GetFlags, error) {
+	resp, err := i.interceptor(ctx, "FPDFFont_GetFlags", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_GetFocusedAnnot", panicError)
+		done, err := i.startCall(ctx, "FPDFFont_GetFlags", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_GetFocusedAnnot(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetFlags", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFFont_GetFlags(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFFont_GetFlags)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFFont_GetFontData(request *requests.FPDFFont_GetFontData) (*responses.FPDFFont_GetFontData, error) {
+	return i.FPDFFont_GetFontDataWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_GetFocusedText(request *requests.FORM_GetFocusedText) (resp *responses.FORM_GetFocusedText, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFFont_GetFontDataWithContext(ctx goctx.Context, request *requests.FPDFFont_GetFontData) (*responses.FPDFFont_GetFontData, error) {
+	resp, err := i.interceptor(ctx, "FPDFFont_GetFontData", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_GetFocusedText", panicError)
+		done, err := i.startCall(ctx, "FPDFFont_GetFontData", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_GetFocusedText(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetFontData", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFFont_GetFontData(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFFont_GetFontData)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFFont_GetGlyphPath(request *requests.FPDFFont_GetGlyphPath) (*responses.FPDFFont_GetGlyphPath, error) {
+	return i.FPDFFont_GetGlyphPathWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_GetSelectedText(request *requests.FORM_GetSelectedText) (resp *responses.FORM_GetSelectedText, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFFont_GetGlyphPathWithContext(ctx goctx.Context, request *requests.FPDFFont_GetGlyphPath) (*responses.FPDFFont_GetGlyphPath, error) {
+	resp, err := i.interceptor(ctx, "FPDFFont_GetGlyphPath", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_GetSelectedText", panicError)
+		done, err := i.startCall(ctx, "FPDFFont_GetGlyphPath", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_GetSelectedText(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetGlyphPath", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFFont_GetGlyphPath(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFFont_GetGlyphPath)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFFont_GetGlyphWidth(request *requests.FPDFFont_GetGlyphWidth) (*responses.FPDFFont_GetGlyphWidth, error) {
+	return i.FPDFFont_GetGlyphWidthWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_IsIndexSelected(request *requests.FORM_IsIndexSelected) (resp *responses.FORM_IsIndexSelected, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFFont_GetGlyphWidthWithContext(ctx goctx.Context, request *requests.FPDFFont_GetGlyphWidth) (*responses.FPDFFont_GetGlyphWidth, error) {
+	resp, err := i.interceptor(ctx, "FPDFFont_GetGlyphWidth", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_IsIndexSelected", panicError)
+		done, err := i.startCall(ctx, "FPDFFont_GetGlyphWidth", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_IsIndexSelected(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetGlyphWidth", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFFont_GetGlyphWidth(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFFont_GetGlyphWidth)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFFont_GetIsEmbedded(request *requests.FPDFFont_GetIsEmbedded) (*responses.FPDFFont_GetIsEmbedded, error) {
+	return i.FPDFFont_GetIsEmbeddedWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_OnAfterLoadPage(request *requests.FORM_OnAfterLoadPage) (resp *responses.FORM_OnAfterLoadPage, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFFont_GetIsEmbeddedWithContext(ctx goctx.Context, request *requests.FPDFFont_GetIsEmbedded) (*responses.FPDFFont_GetIsEmbedded, error) {
+	resp, err := i.interceptor(ctx, "FPDFFont_GetIsEmbedded", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnAfterLoadPage", panicError)
+		done, err := i.startCall(ctx, "FPDFFont_GetIsEmbedded", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_OnAfterLoadPage(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetIsEmbedded", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFFont_GetIsEmbedded(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFFont_GetIsEmbedded)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFFont_GetItalicAngle(request *requests.FPDFFont_GetItalicAngle) (*responses.FPDFFont_GetItalicAngle, error) {
+	return i.FPDFFont_GetItalicAngleWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_OnBeforeClosePage(request *requests.FORM_OnBeforeClosePage) (resp *responses.FORM_OnBeforeClosePage, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFFont_GetItalicAngleWithContext(ctx goctx.Context, request *requests.FPDFFont_GetItalicAngle) (*responses.FPDFFont_GetItalicAngle, error) {
+	resp, err := i.interceptor(ctx, "FPDFFont_GetItalicAngle", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnBeforeClosePage", panicError)
+		done, err := i.startCall(ctx, "FPDFFont_GetItalicAngle", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_OnBeforeClosePage(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetItalicAngle", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFFont_GetItalicAngle(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFFont_GetItalicAngle)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFFont_GetWeight(request *requests.FPDFFont_GetWeight) (*responses.FPDFFont_GetWeight, error) {
+	return i.FPDFFont_GetWeightWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_OnChar(request *requests.FORM_OnChar) (resp *responses.FORM_OnChar, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFFont_GetWeightWithContext(ctx goctx.Context, request *requests.FPDFFont_GetWeight) (*responses.FPDFFont_GetWeight, error) {
+	resp, err := i.interceptor(ctx, "FPDFFont_GetWeight", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnChar", panicError)
+		done, err := i.startCall(ctx, "FPDFFont_GetWeight", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_OnChar(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetWeight", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFFont_GetWeight(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFFont_GetWeight)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFFormObj_CountObjects(request *requests.FPDFFormObj_CountObjects) (*responses.FPDFFormObj_CountObjects, error) {
+	return i.FPDFFormObj_CountObjectsWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_OnFocus(request *requests.FORM_OnFocus) (resp *responses.FORM_OnFocus, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFFormObj_CountObjectsWithContext(ctx goctx.Context, request *requests.FPDFFormObj_CountObjects) (*responses.FPDFFormObj_CountObjects, error) {
+	resp, err := i.interceptor(ctx, "FPDFFormObj_CountObjects", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnFocus", panicError)
+		done, err := i.startCall(ctx, "FPDFFormObj_CountObjects", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_OnFocus(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFFormObj_CountObjects", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFFormObj_CountObjects(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFFormObj_CountObjects)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFFormObj_GetObject(request *requests.FPDFFormObj_GetObject) (*responses.FPDFFormObj_GetObject, error) {
+	return i.FPDFFormObj_GetObjectWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_OnKeyDown(request *requests.FORM_OnKeyDown) (resp *responses.FORM_OnKeyDown, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFFormObj_GetObjectWithContext(ctx goctx.Context, request *requests.FPDFFormObj_GetObject) (*responses.FPDFFormObj_GetObject, error) {
+	resp, err := i.interceptor(ctx, "FPDFFormObj_GetObject", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnKeyDown", panicError)
+		done, err := i.startCall(ctx, "FPDFFormObj_GetObject", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_OnKeyDown(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFFormObj_GetObject", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFFormObj_GetObject(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFFormObj_GetObject)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFFormObj_RemoveObject(request *requests.FPDFFormObj_RemoveObject) (*responses.FPDFFormObj_RemoveObject, error) {
+	return i.FPDFFormObj_RemoveObjectWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_OnKeyUp(request *requests.FORM_OnKeyUp) (resp *responses.FORM_OnKeyUp, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFFormObj_RemoveObjectWithContext(ctx goctx.Context, request *requests.FPDFFormObj_RemoveObject) (*responses.FPDFFormObj_RemoveObject, error) {
+	resp, err := i.interceptor(ctx, "FPDFFormObj_RemoveObject", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnKeyUp", panicError)
+		done, err := i.startCall(ctx, "FPDFFormObj_RemoveObject", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_OnKeyUp(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFFormObj_RemoveObject", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFFormObj_RemoveObject(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFFormObj_RemoveObject)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFGlyphPath_CountGlyphSegments(request *requests.FPDFGlyphPath_CountGlyphSegments) (*responses.FPDFGlyphPath_CountGlyphSegments, error) {
+	return i.FPDFGlyphPath_CountGlyphSegmentsWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_OnLButtonDoubleClick(request *requests.FORM_OnLButtonDoubleClick) (resp *responses.FORM_OnLButtonDoubleClick, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFGlyphPath_CountGlyphSegmentsWithContext(ctx goctx.Context, request *requests.FPDFGlyphPath_CountGlyphSegments) (*responses.FPDFGlyphPath_CountGlyphSegments, error) {
+	resp, err := i.interceptor(ctx, "FPDFGlyphPath_CountGlyphSegments", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnLButtonDoubleClick", panicError)
+		done, err := i.startCall(ctx, "FPDFGlyphPath_CountGlyphSegments", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_OnLButtonDoubleClick(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFGlyphPath_CountGlyphSegments", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFGlyphPath_CountGlyphSegments(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFGlyphPath_CountGlyphSegments)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFGlyphPath_GetGlyphPathSegment(request *requests.FPDFGlyphPath_GetGlyphPathSegment) (*responses.FPDFGlyphPath_GetGlyphPathSegment, error) {
+	return i.FPDFGlyphPath_GetGlyphPathSegmentWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_OnLButtonDown(request *requests.FORM_OnLButtonDown) (resp *responses.FORM_OnLButtonDown, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFGlyphPath_GetGlyphPathSegmentWithContext(ctx goctx.Context, request *requests.FPDFGlyphPath_GetGlyphPathSegment) (*responses.FPDFGlyphPath_GetGlyphPathSegment, error) {
+	resp, err := i.interceptor(ctx, "FPDFGlyphPath_GetGlyphPathSegment", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnLButtonDown", panicError)
+		done, err := i.startCall(ctx, "FPDFGlyphPath_GetGlyphPathSegment", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_OnLButtonDown(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFGlyphPath_GetGlyphPathSegment", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFGlyphPath_GetGlyphPathSegment(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFGlyphPath_GetGlyphPathSegment)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFImageObj_GetBitmap(request *requests.FPDFImageObj_GetBitmap) (*responses.FPDFImageObj_GetBitmap, error) {
+	return i.FPDFImageObj_GetBitmapWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_OnLButtonUp(request *requests.FORM_OnLButtonUp) (resp *responses.FORM_OnLButtonUp, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFImageObj_GetBitmapWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetBitmap) (*responses.FPDFImageObj_GetBitmap, error) {
+	resp, err := i.interceptor(ctx, "FPDFImageObj_GetBitmap", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnLButtonUp", panicError)
+		done, err := i.startCall(ctx, "FPDFImageObj_GetBitmap", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_OnLButtonUp(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetBitmap", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFImageObj_GetBitmap(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFImageObj_GetBitmap)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFImageObj_GetIccProfileDataDecoded(request *requests.FPDFImageObj_GetIccProfileDataDecoded) (*responses.FPDFImageObj_GetIccProfileDataDecoded, error) {
+	return i.FPDFImageObj_GetIccProfileDataDecodedWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_OnMouseMove(request *requests.FORM_OnMouseMove) (resp *responses.FORM_OnMouseMove, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFImageObj_GetIccProfileDataDecodedWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetIccProfileDataDecoded) (*responses.FPDFImageObj_GetIccProfileDataDecoded, error) {
+	resp, err := i.interceptor(ctx, "FPDFImageObj_GetIccProfileDataDecoded", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnMouseMove", panicError)
+		done, err := i.startCall(ctx, "FPDFImageObj_GetIccProfileDataDecoded", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_OnMouseMove(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetIccProfileDataDecoded", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFImageObj_GetIccProfileDataDecoded(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFImageObj_GetIccProfileDataDecoded)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFImageObj_GetImageDataDecoded(request *requests.FPDFImageObj_GetImageDataDecoded) (*responses.FPDFImageObj_GetImageDataDecoded, error) {
+	return i.FPDFImageObj_GetImageDataDecodedWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_OnMouseWheel(request *requests.FORM_OnMouseWheel) (resp *responses.FORM_OnMouseWheel, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFImageObj_GetImageDataDecodedWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetImageDataDecoded) (*responses.FPDFImageObj_GetImageDataDecoded, error) {
+	resp, err := i.interceptor(ctx, "FPDFImageObj_GetImageDataDecoded", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnMouseWheel", panicError)
+		done, err := i.startCall(ctx, "FPDFImageObj_GetImageDataDecoded", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_OnMouseWheel(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetImageDataDecoded", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFImageObj_GetImageDataDecoded(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFImageObj_GetImageDataDecoded)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFImageObj_GetImageDataRaw(request *requests.FPDFImageObj_GetImageDataRaw) (*responses.FPDFImageObj_GetImageDataRaw, error) {
+	return i.FPDFImageObj_GetImageDataRawWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_OnRButtonDown(request *requests.FORM_OnRButtonDown) (resp *responses.FORM_OnRButtonDown, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFImageObj_GetImageDataRawWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetImageDataRaw) (*responses.FPDFImageObj_GetImageDataRaw, error) {
+	resp, err := i.interceptor(ctx, "FPDFImageObj_GetImageDataRaw", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnRButtonDown", panicError)
+		done, err := i.startCall(ctx, "FPDFImageObj_GetImageDataRaw", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_OnRButtonDown(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetImageDataRaw", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFImageObj_GetImageDataRaw(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFImageObj_GetImageDataRaw)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFImageObj_GetImageFilter(request *requests.FPDFImageObj_GetImageFilter) (*responses.FPDFImageObj_GetImageFilter, error) {
+	return i.FPDFImageObj_GetImageFilterWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_OnRButtonUp(request *requests.FORM_OnRButtonUp) (resp *responses.FORM_OnRButtonUp, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFImageObj_GetImageFilterWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetImageFilter) (*responses.FPDFImageObj_GetImageFilter, error) {
+	resp, err := i.interceptor(ctx, "FPDFImageObj_GetImageFilter", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_OnRButtonUp", panicError)
+		done, err := i.startCall(ctx, "FPDFImageObj_GetImageFilter", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_OnRButtonUp(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetImageFilter", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFImageObj_GetImageFilter(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFImageObj_GetImageFilter)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFImageObj_GetImageFilterCount(request *requests.FPDFImageObj_GetImageFilterCount) (*responses.FPDFImageObj_GetImageFilterCount, error) {
+	return i.FPDFImageObj_GetImageFilterCountWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_Redo(request *requests.FORM_Redo) (resp *responses.FORM_Redo, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFImageObj_GetImageFilterCountWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetImageFilterCount) (*responses.FPDFImageObj_GetImageFilterCount, error) {
+	resp, err := i.interceptor(ctx, "FPDFImageObj_GetImageFilterCount", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_Redo", panicError)
+		done, err := i.startCall(ctx, "FPDFImageObj_GetImageFilterCount", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_Redo(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetImageFilterCount", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFImageObj_GetImageFilterCount(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFImageObj_GetImageFilterCount)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFImageObj_GetImageMetadata(request *requests.FPDFImageObj_GetImageMetadata) (*responses.FPDFImageObj_GetImageMetadata, error) {
+	return i.FPDFImageObj_GetImageMetadataWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_ReplaceAndKeepSelection(request *requests.FORM_ReplaceAndKeepSelection) (resp *responses.FORM_ReplaceAndKeepSelection, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFImageObj_GetImageMetadataWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetImageMetadata) (*responses.FPDFImageObj_GetImageMetadata, error) {
+	resp, err := i.interceptor(ctx, "FPDFImageObj_GetImageMetadata", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_ReplaceAndKeepSelection", panicError)
+		done, err := i.startCall(ctx, "FPDFImageObj_GetImageMetadata", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_ReplaceAndKeepSelection(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetImageMetadata", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFImageObj_GetImageMetadata(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFImageObj_GetImageMetadata)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFImageObj_GetImagePixelSize(request *requests.FPDFImageObj_GetImagePixelSize) (*responses.FPDFImageObj_GetImagePixelSize, error) {
+	return i.FPDFImageObj_GetImagePixelSizeWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_ReplaceSelection(request *requests.FORM_ReplaceSelection) (resp *responses.FORM_ReplaceSelection, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFImageObj_GetImagePixelSizeWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetImagePixelSize) (*responses.FPDFImageObj_GetImagePixelSize, error) {
+	resp, err := i.interceptor(ctx, "FPDFImageObj_GetImagePixelSize", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_ReplaceSelection", panicError)
+		done, err := i.startCall(ctx, "FPDFImageObj_GetImagePixelSize", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_ReplaceSelection(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetImagePixelSize", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFImageObj_GetImagePixelSize(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFImageObj_GetImagePixelSize)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFImageObj_GetRenderedBitmap(request *requests.FPDFImageObj_GetRenderedBitmap) (*responses.FPDFImageObj_GetRenderedBitmap, error) {
+	return i.FPDFImageObj_GetRenderedBitmapWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_SelectAllText(request *requests.FORM_SelectAllText) (resp *responses.FORM_SelectAllText, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFImageObj_GetRenderedBitmapWithContext(ctx goctx.Context, request *requests.FPDFImageObj_GetRenderedBitmap) (*responses.FPDFImageObj_GetRenderedBitmap, error) {
+	resp, err := i.interceptor(ctx, "FPDFImageObj_GetRenderedBitmap", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_SelectAllText", panicError)
+		done, err := i.startCall(ctx, "FPDFImageObj_GetRenderedBitmap", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_SelectAllText(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetRenderedBitmap", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFImageObj_GetRenderedBitmap(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFImageObj_GetRenderedBitmap)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFImageObj_LoadJpegFile(request *requests.FPDFImageObj_LoadJpegFile) (*responses.FPDFImageObj_LoadJpegFile, error) {
+	return i.FPDFImageObj_LoadJpegFileWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_SetFocusedAnnot(request *requests.FORM_SetFocusedAnnot) (resp *responses.FORM_SetFocusedAnnot, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFImageObj_LoadJpegFileWithContext(ctx goctx.Context, request *requests.FPDFImageObj_LoadJpegFile) (*responses.FPDFImageObj_LoadJpegFile, error) {
+	resp, err := i.interceptor(ctx, "FPDFImageObj_LoadJpegFile", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_SetFocusedAnnot", panicError)
+		done, err := i.startCall(ctx, "FPDFImageObj_LoadJpegFile", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_SetFocusedAnnot(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_LoadJpegFile", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFImageObj_LoadJpegFile(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFImageObj_LoadJpegFile)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFImageObj_LoadJpegFileInline(request *requests.FPDFImageObj_LoadJpegFileInline) (*responses.FPDFImageObj_LoadJpegFileInline, error) {
+	return i.FPDFImageObj_LoadJpegFileInlineWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_SetIndexSelected(request *requests.FORM_SetIndexSelected) (resp *responses.FORM_SetIndexSelected, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFImageObj_LoadJpegFileInlineWithContext(ctx goctx.Context, request *requests.FPDFImageObj_LoadJpegFileInline) (*responses.FPDFImageObj_LoadJpegFileInline, error) {
+	resp, err := i.interceptor(ctx, "FPDFImageObj_LoadJpegFileInline", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_SetIndexSelected", panicError)
+		done, err := i.startCall(ctx, "FPDFImageObj_LoadJpegFileInline", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_SetIndexSelected(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_LoadJpegFileInline", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFImageObj_LoadJpegFileInline(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFImageObj_LoadJpegFileInline)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFImageObj_SetBitmap(request *requests.FPDFImageObj_SetBitmap) (*responses.FPDFImageObj_SetBitmap, error) {
+	return i.FPDFImageObj_SetBitmapWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FORM_Undo(request *requests.FORM_Undo) (resp *responses.FORM_Undo, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFImageObj_SetBitmapWithContext(ctx goctx.Context, request *requests.FPDFImageObj_SetBitmap) (*responses.FPDFImageObj_SetBitmap, error) {
+	resp, err := i.interceptor(ctx, "FPDFImageObj_SetBitmap", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FORM_Undo", panicError)
+		done, err := i.startCall(ctx, "FPDFImageObj_SetBitmap", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FORM_Undo(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_SetBitmap", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFImageObj_SetBitmap(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFImageObj_SetBitmap)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFImageObj_SetMatrix(request *requests.FPDFImageObj_SetMatrix) (*responses.FPDFImageObj_SetMatrix, error) {
+	return i.FPDFImageObj_SetMatrixWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAction_GetDest(request *requests.FPDFAction_GetDest) (resp *responses.FPDFAction_GetDest, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFImageObj_SetMatrixWithContext(ctx goctx.Context, request *requests.FPDFImageObj_SetMatrix) (*responses.FPDFImageObj_SetMatrix, error) {
+	resp, err := i.interceptor(ctx, "FPDFImageObj_SetMatrix", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAction_GetDest", panicError)
+		done, err := i.startCall(ctx, "FPDFImageObj_SetMatrix", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFAction_GetDest(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_SetMatrix", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFImageObj_SetMatrix(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFImageObj_SetMatrix)
+	return typedResp, err
+}
+
+func (i *pdfiumInstance) FPDFJavaScriptAction_GetName(request *requests.FPDFJavaScriptAction_GetName) (*responses.FPDFJavaScriptAction_GetName, error) {
+	return i.FPDFJavaScriptAction_GetNameWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAction_GetFilePath(request *requests.FPDFAction_GetFilePath) (resp *responses.FPDFAction_GetFilePath, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFJavaScriptAction_GetNameWithContext(ctx goctx.Context, request *requests.FPDFJavaScriptAction_GetName) (*responses.FPDFJavaScriptAction_GetName, error) {
+	resp, err := i.interceptor(ctx, "FPDFJavaScriptAction_GetName", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAction_GetFilePath", panicError)
+		done, err := i.startCall(ctx, "FPDFJavaScriptAction_GetName", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFAction_GetFilePath(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFJavaScriptAction_GetName", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFJavaScriptAction_GetName(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFJavaScriptAction_GetName)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAction_GetType(request *requests.FPDFAction_GetType) (resp *responses.FPDFAction_GetType, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFJavaScriptAction_GetScript(request *requests.FPDFJavaScriptAction_GetScript) (*responses.FPDFJavaScriptAction_GetScript, error) {
+	return i.FPDFJavaScriptAction_GetScriptWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAction_GetType", panicError)
+func (i *pdfiumInstance) FPDFJavaScriptAction_GetScriptWithContext(ctx goctx.Context, request *requests.FPDFJavaScriptAction_GetScript) (*responses.FPDFJavaScriptAction_GetScript, error) {
+	resp, err := i.interceptor(ctx, "FPDFJavaScriptAction_GetScript", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAction_GetType(request)
-}
+		done, err := i.startCall(ctx, "FPDFJavaScriptAction_GetScript", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAction_GetURIPath(request *requests.FPDFAction_GetURIPath) (resp *responses.FPDFAction_GetURIPath, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFJavaScriptAction_GetScript", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAction_GetURIPath", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFJavaScriptAction_GetScript(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFJavaScriptAction_GetScript)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAction_GetURIPath(request)
+func (i *pdfiumInstance) FPDFLink_CloseWebLinks(request *requests.FPDFLink_CloseWebLinks) (*responses.FPDFLink_CloseWebLinks, error) {
+	return i.FPDFLink_CloseWebLinksWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_AddFileAttachment(request *requests.FPDFAnnot_AddFileAttachment) (resp *responses.FPDFAnnot_AddFileAttachment, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFLink_CloseWebLinksWithContext(ctx goctx.Context, request *requests.FPDFLink_CloseWebLinks) (*responses.FPDFLink_CloseWebLinks, error) {
+	resp, err := i.interceptor(ctx, "FPDFLink_CloseWebLinks", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_AddFileAttachment", panicError)
+		done, err := i.startCall(ctx, "FPDFLink_CloseWebLinks", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_CloseWebLinks", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFAnnot_AddFileAttachment(request)
+		return i.worker.Instance.FPDFLink_CloseWebLinks(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFLink_CloseWebLinks)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_AddInkStroke(request *requests.FPDFAnnot_AddInkStroke) (resp *responses.FPDFAnnot_AddInkStroke, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFLink_CountQuadPoints(request *requests.FPDFLink_CountQuadPoints) (*responses.FPDFLink_CountQuadPoints, error) {
+	return i.FPDFLink_CountQuadPointsWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_AddInkStroke", panicError)
+func (i *pdfiumInstance) FPDFLink_CountQuadPointsWithContext(ctx goctx.Context, request *requests.FPDFLink_CountQuadPoints) (*responses.FPDFLink_CountQuadPoints, error) {
+	resp, err := i.interceptor(ctx, "FPDFLink_CountQuadPoints", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_AddInkStroke(request)
-}
+		done, err := i.startCall(ctx, "FPDFLink_CountQuadPoints", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAnnot_AppendAttachmentPoints(request *requests.FPDFAnnot_AppendAttachmentPoints) (resp *responses.FPDFAnnot_AppendAttachmentPoints, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_CountQuadPoints", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_AppendAttachmentPoints", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFLink_CountQuadPoints(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFLink_CountQuadPoints)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_AppendAttachmentPoints(request)
+func (i *pdfiumInstance) FPDFLink_CountRects(request *requests.FPDFLink_CountRects) (*responses.FPDFLink_CountRects, error) {
+	return i.FPDFLink_CountRectsWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_AppendObject(request *requests.FPDFAnnot_AppendObject) (resp *responses.FPDFAnnot_AppendObject, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFLink_CountRectsWithContext(ctx goctx.Context, request *requests.FPDFLink_CountRects) (*responses.FPDFLink_CountRects, error) {
+	resp, err := i.interceptor(ctx, "FPDFLink_CountRects", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_AppendObject", panicError)
+		done, err := i.startCall(ctx, "FPDFLink_CountRects", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFAnnot_AppendObject(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_CountRects", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFLink_CountRects(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFLink_CountRects)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_CountAttachmentPoints(request *requests.FPDFAnnot_CountAttachmentPoints) (resp *responses.FPDFAnnot_CountAttachmentPoints, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFLink_CountWebLinks(request *requests.FPDFLink_CountWebLinks) (*responses.FPDFLink_CountWebLinks, error) {
+	return i.FPDFLink_CountWebLinksWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_CountAttachmentPoints", panicError)
+func (i *pdfiumInstance) FPDFLink_CountWebLinksWithContext(ctx goctx.Context, request *requests.FPDFLink_CountWebLinks) (*responses.FPDFLink_CountWebLinks, error) {
+	resp, err := i.interceptor(ctx, "FPDFLink_CountWebLinks", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_CountAttachmentPoints(request)
-}
+		done, err := i.startCall(ctx, "FPDFLink_CountWebLinks", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAnnot_GetAP(request *requests.FPDFAnnot_GetAP) (resp *responses.FPDFAnnot_GetAP, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_CountWebLinks", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetAP", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFLink_CountWebLinks(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFLink_CountWebLinks)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_GetAP(request)
+func (i *pdfiumInstance) FPDFLink_Enumerate(request *requests.FPDFLink_Enumerate) (*responses.FPDFLink_Enumerate, error) {
+	return i.FPDFLink_EnumerateWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetAttachmentPoints(request *requests.FPDFAnnot_GetAttachmentPoints) (resp *responses.FPDFAnnot_GetAttachmentPoints, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFLink_EnumerateWithContext(ctx goctx.Context, request *requests.FPDFLink_Enumerate) (*responses.FPDFLink_Enumerate, error) {
+	resp, err := i.interceptor(ctx, "FPDFLink_Enumerate", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetAttachmentPoints", panicError)
+		done, err := i.startCall(ctx, "FPDFLink_Enumerate", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_Enumerate", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFAnnot_GetAttachmentPoints(request)
+		return i.worker.Instance.FPDFLink_Enumerate(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFLink_Enumerate)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetBorder(request *requests.FPDFAnnot_GetBorder) (resp *responses.FPDFAnnot_GetBorder, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFLink_GetAction(request *requests.FPDFLink_GetAction) (*responses.FPDFLink_GetAction, error) {
+	return i.FPDFLink_GetActionWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetBorder", panicError)
+func (i *pdfiumInstance) FPDFLink_GetActionWithContext(ctx goctx.Context, request *requests.FPDFLink_GetAction) (*responses.FPDFLink_GetAction, error) {
+	resp, err := i.interceptor(ctx, "FPDFLink_GetAction", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_GetBorder(request)
-}
+		done, err := i.startCall(ctx, "FPDFLink_GetAction", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAnnot_GetColor(request *requests.FPDFAnnot_GetColor) (resp *responses.FPDFAnnot_GetColor, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetAction", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetColor", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFLink_GetAction(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFLink_GetAction)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_GetColor(request)
+func (i *pdfiumInstance) FPDFLink_GetAnnot(request *requests.FPDFLink_GetAnnot) (*responses.FPDFLink_GetAnnot, error) {
+	return i.FPDFLink_GetAnnotWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetFileAttachment(request *requests.FPDFAnnot_GetFileAttachment) (resp *responses.FPDFAnnot_GetFileAttachment, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFLink_GetAnnotWithContext(ctx goctx.Context, request *requests.FPDFLink_GetAnnot) (*responses.FPDFLink_GetAnnot, error) {
+	resp, err := i.interceptor(ctx, "FPDFLink_GetAnnot", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFileAttachment", panicError)
+		done, err := i.startCall(ctx, "FPDFLink_GetAnnot", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFAnnot_GetFileAttachment(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetAnnot", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFLink_GetAnnot(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFLink_GetAnnot)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetFlags(request *requests.FPDFAnnot_GetFlags) (resp *responses.FPDFAnnot_GetFlags, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFLink_GetAnnotRect(request *requests.FPDFLink_GetAnnotRect) (*responses.FPDFLink_GetAnnotRect, error) {
+	return i.FPDFLink_GetAnnotRectWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFlags", panicError)
+func (i *pdfiumInstance) FPDFLink_GetAnnotRectWithContext(ctx goctx.Context, request *requests.FPDFLink_GetAnnotRect) (*responses.FPDFLink_GetAnnotRect, error) {
+	resp, err := i.interceptor(ctx, "FPDFLink_GetAnnotRect", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_GetFlags(request)
-}
+		done, err := i.startCall(ctx, "FPDFLink_GetAnnotRect", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAnnot_GetFocusableSubtypes(request *requests.FPDFAnnot_GetFocusableSubtypes) (resp *responses.FPDFAnnot_GetFocusableSubtypes, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetAnnotRect", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFocusableSubtypes", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFLink_GetAnnotRect(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFLink_GetAnnotRect)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_GetFocusableSubtypes(request)
+func (i *pdfiumInstance) FPDFLink_GetDest(request *requests.FPDFLink_GetDest) (*responses.FPDFLink_GetDest, error) {
+	return i.FPDFLink_GetDestWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetFocusableSubtypesCount(request *requests.FPDFAnnot_GetFocusableSubtypesCount) (resp *responses.FPDFAnnot_GetFocusableSubtypesCount, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFLink_GetDestWithContext(ctx goctx.Context, request *requests.FPDFLink_GetDest) (*responses.FPDFLink_GetDest, error) {
+	resp, err := i.interceptor(ctx, "FPDFLink_GetDest", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFocusableSubtypesCount", panicError)
+		done, err := i.startCall(ctx, "FPDFLink_GetDest", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetDest", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFAnnot_GetFocusableSubtypesCount(request)
+		return i.worker.Instance.FPDFLink_GetDest(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFLink_GetDest)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetFontColor(request *requests.FPDFAnnot_GetFontColor) (resp *responses.FPDFAnnot_GetFontColor, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFLink_GetLinkAtPoint(request *requests.FPDFLink_GetLinkAtPoint) (*responses.FPDFLink_GetLinkAtPoint, error) {
+	return i.FPDFLink_GetLinkAtPointWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFontColor", panicError)
+func (i *pdfiumInstance) FPDFLink_GetLinkAtPointWithContext(ctx goctx.Context, request *requests.FPDFLink_GetLinkAtPoint) (*responses.FPDFLink_GetLinkAtPoint, error) {
+	resp, err := i.interceptor(ctx, "FPDFLink_GetLinkAtPoint", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_GetFontColor(request)
-}
+		done, err := i.startCall(ctx, "FPDFLink_GetLinkAtPoint", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAnnot_GetFontSize(request *requests.FPDFAnnot_GetFontSize) (resp *responses.FPDFAnnot_GetFontSize, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetLinkAtPoint", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFontSize", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFLink_GetLinkAtPoint(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFLink_GetLinkAtPoint)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_GetFontSize(request)
+func (i *pdfiumInstance) FPDFLink_GetLinkZOrderAtPoint(request *requests.FPDFLink_GetLinkZOrderAtPoint) (*responses.FPDFLink_GetLinkZOrderAtPoint, error) {
+	return i.FPDFLink_GetLinkZOrderAtPointWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetFormAdditionalActionJavaScript(request *requests.FPDFAnnot_GetFormAdditionalActionJavaScript) (resp *responses.FPDFAnnot_GetFormAdditionalActionJavaScript, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFLink_GetLinkZOrderAtPointWithContext(ctx goctx.Context, request *requests.FPDFLink_GetLinkZOrderAtPoint) (*responses.FPDFLink_GetLinkZOrderAtPoint, error) {
+	resp, err := i.interceptor(ctx, "FPDFLink_GetLinkZOrderAtPoint", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormAdditionalActionJavaScript", panicError)
+		done, err := i.startCall(ctx, "FPDFLink_GetLinkZOrderAtPoint", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFAnnot_GetFormAdditionalActionJavaScript(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetLinkZOrderAtPoint", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFLink_GetLinkZOrderAtPoint(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFLink_GetLinkZOrderAtPoint)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetFormControlCount(request *requests.FPDFAnnot_GetFormControlCount) (resp *responses.FPDFAnnot_GetFormControlCount, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFLink_GetQuadPoints(request *requests.FPDFLink_GetQuadPoints) (*responses.FPDFLink_GetQuadPoints, error) {
+	return i.FPDFLink_GetQuadPointsWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormControlCount", panicError)
+func (i *pdfiumInstance) FPDFLink_GetQuadPointsWithContext(ctx goctx.Context, request *requests.FPDFLink_GetQuadPoints) (*responses.FPDFLink_GetQuadPoints, error) {
+	resp, err := i.interceptor(ctx, "FPDFLink_GetQuadPoints", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_GetFormControlCount(request)
-}
+		done, err := i.startCall(ctx, "FPDFLink_GetQuadPoints", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAnnot_GetFormControlIndex(request *requests.FPDFAnnot_GetFormControlIndex) (resp *responses.FPDFAnnot_GetFormControlIndex, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetQuadPoints", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormControlIndex", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFLink_GetQuadPoints(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFLink_GetQuadPoints)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_GetFormControlIndex(request)
+func (i *pdfiumInstance) FPDFLink_GetRect(request *requests.FPDFLink_GetRect) (*responses.FPDFLink_GetRect, error) {
+	return i.FPDFLink_GetRectWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetFormFieldAlternateName(request *requests.FPDFAnnot_GetFormFieldAlternateName) (resp *responses.FPDFAnnot_GetFormFieldAlternateName, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFLink_GetRectWithContext(ctx goctx.Context, request *requests.FPDFLink_GetRect) (*responses.FPDFLink_GetRect, error) {
+	resp, err := i.interceptor(ctx, "FPDFLink_GetRect", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldAlternateName", panicError)
+		done, err := i.startCall(ctx, "FPDFLink_GetRect", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetRect", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFAnnot_GetFormFieldAlternateName(request)
+		return i.worker.Instance.FPDFLink_GetRect(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFLink_GetRect)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetFormFieldAtPoint(request *requests.FPDFAnnot_GetFormFieldAtPoint) (resp *responses.FPDFAnnot_GetFormFieldAtPoint, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFLink_GetTextRange(request *requests.FPDFLink_GetTextRange) (*responses.FPDFLink_GetTextRange, error) {
+	return i.FPDFLink_GetTextRangeWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldAtPoint", panicError)
+func (i *pdfiumInstance) FPDFLink_GetTextRangeWithContext(ctx goctx.Context, request *requests.FPDFLink_GetTextRange) (*responses.FPDFLink_GetTextRange, error) {
+	resp, err := i.interceptor(ctx, "FPDFLink_GetTextRange", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_GetFormFieldAtPoint(request)
-}
+		done, err := i.startCall(ctx, "FPDFLink_GetTextRange", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAnnot_GetFormFieldExportValue(request *requests.FPDFAnnot_GetFormFieldExportValue) (resp *responses.FPDFAnnot_GetFormFieldExportValue, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetTextRange", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldExportValue", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFLink_GetTextRange(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFLink_GetTextRange)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_GetFormFieldExportValue(request)
+func (i *pdfiumInstance) FPDFLink_GetURL(request *requests.FPDFLink_GetURL) (*responses.FPDFLink_GetURL, error) {
+	return i.FPDFLink_GetURLWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetFormFieldFlags(request *requests.FPDFAnnot_GetFormFieldFlags) (resp *responses.FPDFAnnot_GetFormFieldFlags, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFLink_GetURLWithContext(ctx goctx.Context, request *requests.FPDFLink_GetURL) (*responses.FPDFLink_GetURL, error) {
+	resp, err := i.interceptor(ctx, "FPDFLink_GetURL", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldFlags", panicError)
+		done, err := i.startCall(ctx, "FPDFLink_GetURL", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFAnnot_GetFormFieldFlags(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetURL", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFLink_GetURL(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFLink_GetURL)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetFormFieldName(request *requests.FPDFAnnot_GetFormFieldName) (resp *responses.FPDFAnnot_GetFormFieldName, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFLink_LoadWebLinks(request *requests.FPDFLink_LoadWebLinks) (*responses.FPDFLink_LoadWebLinks, error) {
+	return i.FPDFLink_LoadWebLinksWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldName", panicError)
+func (i *pdfiumInstance) FPDFLink_LoadWebLinksWithContext(ctx goctx.Context, request *requests.FPDFLink_LoadWebLinks) (*responses.FPDFLink_LoadWebLinks, error) {
+	resp, err := i.interceptor(ctx, "FPDFLink_LoadWebLinks", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_GetFormFieldName(request)
-}
+		done, err := i.startCall(ctx, "FPDFLink_LoadWebLinks", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAnnot_GetFormFieldType(request *requests.FPDFAnnot_GetFormFieldType) (resp *responses.FPDFAnnot_GetFormFieldType, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_LoadWebLinks", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldType", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFLink_LoadWebLinks(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFLink_LoadWebLinks)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_GetFormFieldType(request)
+func (i *pdfiumInstance) FPDFPageObjMark_CountParams(request *requests.FPDFPageObjMark_CountParams) (*responses.FPDFPageObjMark_CountParams, error) {
+	return i.FPDFPageObjMark_CountParamsWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetFormFieldValue(request *requests.FPDFAnnot_GetFormFieldValue) (resp *responses.FPDFAnnot_GetFormFieldValue, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObjMark_CountParamsWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_CountParams) (*responses.FPDFPageObjMark_CountParams, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObjMark_CountParams", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetFormFieldValue", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObjMark_CountParams", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_CountParams", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFAnnot_GetFormFieldValue(request)
+		return i.worker.Instance.FPDFPageObjMark_CountParams(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_CountParams)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetInkListCount(request *requests.FPDFAnnot_GetInkListCount) (resp *responses.FPDFAnnot_GetInkListCount, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObjMark_GetName(request *requests.FPDFPageObjMark_GetName) (*responses.FPDFPageObjMark_GetName, error) {
+	return i.FPDFPageObjMark_GetNameWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetInkListCount", panicError)
+func (i *pdfiumInstance) FPDFPageObjMark_GetNameWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetName) (*responses.FPDFPageObjMark_GetName, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObjMark_GetName", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_GetInkListCount(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObjMark_GetName", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAnnot_GetInkListPath(request *requests.FPDFAnnot_GetInkListPath) (resp *responses.FPDFAnnot_GetInkListPath, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetName", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetInkListPath", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObjMark_GetName(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_GetName)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_GetInkListPath(request)
+func (i *pdfiumInstance) FPDFPageObjMark_GetParamBlobValue(request *requests.FPDFPageObjMark_GetParamBlobValue) (*responses.FPDFPageObjMark_GetParamBlobValue, error) {
+	return i.FPDFPageObjMark_GetParamBlobValueWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetLine(request *requests.FPDFAnnot_GetLine) (resp *responses.FPDFAnnot_GetLine, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObjMark_GetParamBlobValueWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetParamBlobValue) (*responses.FPDFPageObjMark_GetParamBlobValue, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObjMark_GetParamBlobValue", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetLine", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObjMark_GetParamBlobValue", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFAnnot_GetLine(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetParamBlobValue", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPageObjMark_GetParamBlobValue(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_GetParamBlobValue)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetLink(request *requests.FPDFAnnot_GetLink) (resp *responses.FPDFAnnot_GetLink, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObjMark_GetParamFloatValue(request *requests.FPDFPageObjMark_GetParamFloatValue) (*responses.FPDFPageObjMark_GetParamFloatValue, error) {
+	return i.FPDFPageObjMark_GetParamFloatValueWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetLink", panicError)
+func (i *pdfiumInstance) FPDFPageObjMark_GetParamFloatValueWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetParamFloatValue) (*responses.FPDFPageObjMark_GetParamFloatValue, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObjMark_GetParamFloatValue", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_GetLink(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObjMark_GetParamFloatValue", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAnnot_GetLinkedAnnot(request *requests.FPDFAnnot_GetLinkedAnnot) (resp *responses.FPDFAnnot_GetLinkedAnnot, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetParamFloatValue", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetLinkedAnnot", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObjMark_GetParamFloatValue(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_GetParamFloatValue)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_GetLinkedAnnot(request)
+func (i *pdfiumInstance) FPDFPageObjMark_GetParamIntValue(request *requests.FPDFPageObjMark_GetParamIntValue) (*responses.FPDFPageObjMark_GetParamIntValue, error) {
+	return i.FPDFPageObjMark_GetParamIntValueWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetNumberValue(request *requests.FPDFAnnot_GetNumberValue) (resp *responses.FPDFAnnot_GetNumberValue, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObjMark_GetParamIntValueWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetParamIntValue) (*responses.FPDFPageObjMark_GetParamIntValue, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObjMark_GetParamIntValue", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetNumberValue", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObjMark_GetParamIntValue", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetParamIntValue", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFAnnot_GetNumberValue(request)
+		return i.worker.Instance.FPDFPageObjMark_GetParamIntValue(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_GetParamIntValue)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetObject(request *requests.FPDFAnnot_GetObject) (resp *responses.FPDFAnnot_GetObject, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObjMark_GetParamKey(request *requests.FPDFPageObjMark_GetParamKey) (*responses.FPDFPageObjMark_GetParamKey, error) {
+	return i.FPDFPageObjMark_GetParamKeyWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetObject", panicError)
+func (i *pdfiumInstance) FPDFPageObjMark_GetParamKeyWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetParamKey) (*responses.FPDFPageObjMark_GetParamKey, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObjMark_GetParamKey", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_GetObject(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObjMark_GetParamKey", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAnnot_GetObjectCount(request *requests.FPDFAnnot_GetObjectCount) (resp *responses.FPDFAnnot_GetObjectCount, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetParamKey", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetObjectCount", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObjMark_GetParamKey(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_GetParamKey)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_GetObjectCount(request)
+func (i *pdfiumInstance) FPDFPageObjMark_GetParamStringValue(request *requests.FPDFPageObjMark_GetParamStringValue) (*responses.FPDFPageObjMark_GetParamStringValue, error) {
+	return i.FPDFPageObjMark_GetParamStringValueWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetOptionCount(request *requests.FPDFAnnot_GetOptionCount) (resp *responses.FPDFAnnot_GetOptionCount, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObjMark_GetParamStringValueWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetParamStringValue) (*responses.FPDFPageObjMark_GetParamStringValue, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObjMark_GetParamStringValue", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetOptionCount", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObjMark_GetParamStringValue", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFAnnot_GetOptionCount(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetParamStringValue", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPageObjMark_GetParamStringValue(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_GetParamStringValue)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetOptionLabel(request *requests.FPDFAnnot_GetOptionLabel) (resp *responses.FPDFAnnot_GetOptionLabel, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObjMark_GetParamValueType(request *requests.FPDFPageObjMark_GetParamValueType) (*responses.FPDFPageObjMark_GetParamValueType, error) {
+	return i.FPDFPageObjMark_GetParamValueTypeWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetOptionLabel", panicError)
+func (i *pdfiumInstance) FPDFPageObjMark_GetParamValueTypeWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_GetParamValueType) (*responses.FPDFPageObjMark_GetParamValueType, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObjMark_GetParamValueType", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_GetOptionLabel(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObjMark_GetParamValueType", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAnnot_GetRect(request *requests.FPDFAnnot_GetRect) (resp *responses.FPDFAnnot_GetRect, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetParamValueType", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetRect", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObjMark_GetParamValueType(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_GetParamValueType)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_GetRect(request)
+func (i *pdfiumInstance) FPDFPageObjMark_RemoveParam(request *requests.FPDFPageObjMark_RemoveParam) (*responses.FPDFPageObjMark_RemoveParam, error) {
+	return i.FPDFPageObjMark_RemoveParamWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetStringValue(request *requests.FPDFAnnot_GetStringValue) (resp *responses.FPDFAnnot_GetStringValue, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObjMark_RemoveParamWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_RemoveParam) (*responses.FPDFPageObjMark_RemoveParam, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObjMark_RemoveParam", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetStringValue", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObjMark_RemoveParam", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_RemoveParam", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFAnnot_GetStringValue(request)
+		return i.worker.Instance.FPDFPageObjMark_RemoveParam(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_RemoveParam)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetSubtype(request *requests.FPDFAnnot_GetSubtype) (resp *responses.FPDFAnnot_GetSubtype, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObjMark_SetBlobParam(request *requests.FPDFPageObjMark_SetBlobParam) (*responses.FPDFPageObjMark_SetBlobParam, error) {
+	return i.FPDFPageObjMark_SetBlobParamWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetSubtype", panicError)
+func (i *pdfiumInstance) FPDFPageObjMark_SetBlobParamWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_SetBlobParam) (*responses.FPDFPageObjMark_SetBlobParam, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObjMark_SetBlobParam", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_GetSubtype(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObjMark_SetBlobParam", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAnnot_GetValueType(request *requests.FPDFAnnot_GetValueType) (resp *responses.FPDFAnnot_GetValueType, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_SetBlobParam", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetValueType", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObjMark_SetBlobParam(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_SetBlobParam)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_GetValueType(request)
+func (i *pdfiumInstance) FPDFPageObjMark_SetFloatParam(request *requests.FPDFPageObjMark_SetFloatParam) (*responses.FPDFPageObjMark_SetFloatParam, error) {
+	return i.FPDFPageObjMark_SetFloatParamWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_GetVertices(request *requests.FPDFAnnot_GetVertices) (resp *responses.FPDFAnnot_GetVertices, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObjMark_SetFloatParamWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_SetFloatParam) (*responses.FPDFPageObjMark_SetFloatParam, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObjMark_SetFloatParam", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_GetVertices", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObjMark_SetFloatParam", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFAnnot_GetVertices(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_SetFloatParam", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPageObjMark_SetFloatParam(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_SetFloatParam)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_HasAttachmentPoints(request *requests.FPDFAnnot_HasAttachmentPoints) (resp *responses.FPDFAnnot_HasAttachmentPoints, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObjMark_SetIntParam(request *requests.FPDFPageObjMark_SetIntParam) (*responses.FPDFPageObjMark_SetIntParam, error) {
+	return i.FPDFPageObjMark_SetIntParamWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_HasAttachmentPoints", panicError)
+func (i *pdfiumInstance) FPDFPageObjMark_SetIntParamWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_SetIntParam) (*responses.FPDFPageObjMark_SetIntParam, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObjMark_SetIntParam", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_HasAttachmentPoints(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObjMark_SetIntParam", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAnnot_HasKey(request *requests.FPDFAnnot_HasKey) (resp *responses.FPDFAnnot_HasKey, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_SetIntParam", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_HasKey", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObjMark_SetIntParam(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_SetIntParam)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_HasKey(request)
+func (i *pdfiumInstance) FPDFPageObjMark_SetStringParam(request *requests.FPDFPageObjMark_SetStringParam) (*responses.FPDFPageObjMark_SetStringParam, error) {
+	return i.FPDFPageObjMark_SetStringParamWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_IsChecked(request *requests.FPDFAnnot_IsChecked) (resp *responses.FPDFAnnot_IsChecked, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObjMark_SetStringParamWithContext(ctx goctx.Context, request *requests.FPDFPageObjMark_SetStringParam) (*responses.FPDFPageObjMark_SetStringParam, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObjMark_SetStringParam", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_IsChecked", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObjMark_SetStringParam", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_SetStringParam", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFAnnot_IsChecked(request)
+		return i.worker.Instance.FPDFPageObjMark_SetStringParam(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObjMark_SetStringParam)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_IsObjectSupportedSubtype(request *requests.FPDFAnnot_IsObjectSupportedSubtype) (resp *responses.FPDFAnnot_IsObjectSupportedSubtype, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_AddExistingMark(request *requests.FPDFPageObj_AddExistingMark) (*responses.FPDFPageObj_AddExistingMark, error) {
+	return i.FPDFPageObj_AddExistingMarkWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_IsObjectSupportedSubtype", panicError)
+func (i *pdfiumInstance) FPDFPageObj_AddExistingMarkWithContext(ctx goctx.Context, request *requests.FPDFPageObj_AddExistingMark) (*responses.FPDFPageObj_AddExistingMark, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_AddExistingMark", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_IsObjectSupportedSubtype(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_AddExistingMark", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAnnot_IsOptionSelected(request *requests.FPDFAnnot_IsOptionSelected) (resp *responses.FPDFAnnot_IsOptionSelected, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_AddExistingMark", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_IsOptionSelected", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_AddExistingMark(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_AddExistingMark)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_IsOptionSelected(request)
+func (i *pdfiumInstance) FPDFPageObj_AddMark(request *requests.FPDFPageObj_AddMark) (*responses.FPDFPageObj_AddMark, error) {
+	return i.FPDFPageObj_AddMarkWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_IsSupportedSubtype(request *requests.FPDFAnnot_IsSupportedSubtype) (resp *responses.FPDFAnnot_IsSupportedSubtype, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_AddMarkWithContext(ctx goctx.Context, request *requests.FPDFPageObj_AddMark) (*responses.FPDFPageObj_AddMark, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_AddMark", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_IsSupportedSubtype", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_AddMark", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFAnnot_IsSupportedSubtype(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_AddMark", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPageObj_AddMark(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_AddMark)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_RemoveInkList(request *requests.FPDFAnnot_RemoveInkList) (resp *responses.FPDFAnnot_RemoveInkList, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_CountMarks(request *requests.FPDFPageObj_CountMarks) (*responses.FPDFPageObj_CountMarks, error) {
+	return i.FPDFPageObj_CountMarksWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_RemoveInkList", panicError)
+func (i *pdfiumInstance) FPDFPageObj_CountMarksWithContext(ctx goctx.Context, request *requests.FPDFPageObj_CountMarks) (*responses.FPDFPageObj_CountMarks, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_CountMarks", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_RemoveInkList(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_CountMarks", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAnnot_RemoveObject(request *requests.FPDFAnnot_RemoveObject) (resp *responses.FPDFAnnot_RemoveObject, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_CountMarks", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_RemoveObject", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_CountMarks(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_CountMarks)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_RemoveObject(request)
+func (i *pdfiumInstance) FPDFPageObj_CreateNewPath(request *requests.FPDFPageObj_CreateNewPath) (*responses.FPDFPageObj_CreateNewPath, error) {
+	return i.FPDFPageObj_CreateNewPathWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_SetAP(request *requests.FPDFAnnot_SetAP) (resp *responses.FPDFAnnot_SetAP, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_CreateNewPathWithContext(ctx goctx.Context, request *requests.FPDFPageObj_CreateNewPath) (*responses.FPDFPageObj_CreateNewPath, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_CreateNewPath", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetAP", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_CreateNewPath", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_CreateNewPath", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFAnnot_SetAP(request)
+		return i.worker.Instance.FPDFPageObj_CreateNewPath(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_CreateNewPath)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_SetAttachmentPoints(request *requests.FPDFAnnot_SetAttachmentPoints) (resp *responses.FPDFAnnot_SetAttachmentPoints, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_CreateNewRect(request *requests.FPDFPageObj_CreateNewRect) (*responses.FPDFPageObj_CreateNewRect, error) {
+	return i.FPDFPageObj_CreateNewRectWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetAttachmentPoints", panicError)
+func (i *pdfiumInstance) FPDFPageObj_CreateNewRectWithContext(ctx goctx.Context, request *requests.FPDFPageObj_CreateNewRect) (*responses.FPDFPageObj_CreateNewRect, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_CreateNewRect", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_SetAttachmentPoints(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_CreateNewRect", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAnnot_SetBorder(request *requests.FPDFAnnot_SetBorder) (resp *responses.FPDFAnnot_SetBorder, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_CreateNewRect", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetBorder", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_CreateNewRect(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_CreateNewRect)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_SetBorder(request)
+func (i *pdfiumInstance) FPDFPageObj_CreateTextObj(request *requests.FPDFPageObj_CreateTextObj) (*responses.FPDFPageObj_CreateTextObj, error) {
+	return i.FPDFPageObj_CreateTextObjWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_SetColor(request *requests.FPDFAnnot_SetColor) (resp *responses.FPDFAnnot_SetColor, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_CreateTextObjWithContext(ctx goctx.Context, request *requests.FPDFPageObj_CreateTextObj) (*responses.FPDFPageObj_CreateTextObj, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_CreateTextObj", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetColor", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_CreateTextObj", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_CreateTextObj", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPageObj_CreateTextObj(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_CreateTextObj)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_SetColor(request)
+func (i *pdfiumInstance) FPDFPageObj_Destroy(request *requests.FPDFPageObj_Destroy) (*responses.FPDFPageObj_Destroy, error) {
+	return i.FPDFPageObj_DestroyWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_SetFlags(request *requests.FPDFAnnot_SetFlags) (resp *responses.FPDFAnnot_SetFlags, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_DestroyWithContext(ctx goctx.Context, request *requests.FPDFPageObj_Destroy) (*responses.FPDFPageObj_Destroy, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_Destroy", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetFlags", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_Destroy", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFAnnot_SetFlags(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_Destroy", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPageObj_Destroy(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_Destroy)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_SetFocusableSubtypes(request *requests.FPDFAnnot_SetFocusableSubtypes) (resp *responses.FPDFAnnot_SetFocusableSubtypes, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_GetBounds(request *requests.FPDFPageObj_GetBounds) (*responses.FPDFPageObj_GetBounds, error) {
+	return i.FPDFPageObj_GetBoundsWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetFocusableSubtypes", panicError)
+func (i *pdfiumInstance) FPDFPageObj_GetBoundsWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetBounds) (*responses.FPDFPageObj_GetBounds, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_GetBounds", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_SetFocusableSubtypes(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_GetBounds", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAnnot_SetFontColor(request *requests.FPDFAnnot_SetFontColor) (resp *responses.FPDFAnnot_SetFontColor, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetBounds", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetFontColor", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_GetBounds(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetBounds)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_SetFontColor(request)
+func (i *pdfiumInstance) FPDFPageObj_GetClipPath(request *requests.FPDFPageObj_GetClipPath) (*responses.FPDFPageObj_GetClipPath, error) {
+	return i.FPDFPageObj_GetClipPathWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_SetFormFieldFlags(request *requests.FPDFAnnot_SetFormFieldFlags) (resp *responses.FPDFAnnot_SetFormFieldFlags, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_GetClipPathWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetClipPath) (*responses.FPDFPageObj_GetClipPath, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_GetClipPath", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetFormFieldFlags", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_GetClipPath", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetClipPath", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFAnnot_SetFormFieldFlags(request)
+		return i.worker.Instance.FPDFPageObj_GetClipPath(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetClipPath)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_SetRect(request *requests.FPDFAnnot_SetRect) (resp *responses.FPDFAnnot_SetRect, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_GetDashArray(request *requests.FPDFPageObj_GetDashArray) (*responses.FPDFPageObj_GetDashArray, error) {
+	return i.FPDFPageObj_GetDashArrayWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetRect", panicError)
+func (i *pdfiumInstance) FPDFPageObj_GetDashArrayWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetDashArray) (*responses.FPDFPageObj_GetDashArray, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_GetDashArray", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_SetRect(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_GetDashArray", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAnnot_SetStringValue(request *requests.FPDFAnnot_SetStringValue) (resp *responses.FPDFAnnot_SetStringValue, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetDashArray", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetStringValue", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_GetDashArray(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetDashArray)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAnnot_SetStringValue(request)
+func (i *pdfiumInstance) FPDFPageObj_GetDashCount(request *requests.FPDFPageObj_GetDashCount) (*responses.FPDFPageObj_GetDashCount, error) {
+	return i.FPDFPageObj_GetDashCountWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAnnot_SetURI(request *requests.FPDFAnnot_SetURI) (resp *responses.FPDFAnnot_SetURI, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_GetDashCountWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetDashCount) (*responses.FPDFPageObj_GetDashCount, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_GetDashCount", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_SetURI", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_GetDashCount", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFAnnot_SetURI(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetDashCount", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPageObj_GetDashCount(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetDashCount)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAnnot_UpdateObject(request *requests.FPDFAnnot_UpdateObject) (resp *responses.FPDFAnnot_UpdateObject, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_GetDashPhase(request *requests.FPDFPageObj_GetDashPhase) (*responses.FPDFPageObj_GetDashPhase, error) {
+	return i.FPDFPageObj_GetDashPhaseWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAnnot_UpdateObject", panicError)
+func (i *pdfiumInstance) FPDFPageObj_GetDashPhaseWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetDashPhase) (*responses.FPDFPageObj_GetDashPhase, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_GetDashPhase", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAnnot_UpdateObject(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_GetDashPhase", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAttachment_GetDescription(request *requests.FPDFAttachment_GetDescription) (resp *responses.FPDFAttachment_GetDescription, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetDashPhase", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_GetDescription", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_GetDashPhase(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetDashPhase)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAttachment_GetDescription(request)
+func (i *pdfiumInstance) FPDFPageObj_GetFillColor(request *requests.FPDFPageObj_GetFillColor) (*responses.FPDFPageObj_GetFillColor, error) {
+	return i.FPDFPageObj_GetFillColorWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAttachment_GetFile(request *requests.FPDFAttachment_GetFile) (resp *responses.FPDFAttachment_GetFile, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_GetFillColorWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetFillColor) (*responses.FPDFPageObj_GetFillColor, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_GetFillColor", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_GetFile", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_GetFillColor", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetFillColor", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFAttachment_GetFile(request)
+		return i.worker.Instance.FPDFPageObj_GetFillColor(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetFillColor)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAttachment_GetName(request *requests.FPDFAttachment_GetName) (resp *responses.FPDFAttachment_GetName, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_GetIsActive(request *requests.FPDFPageObj_GetIsActive) (*responses.FPDFPageObj_GetIsActive, error) {
+	return i.FPDFPageObj_GetIsActiveWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_GetName", panicError)
+func (i *pdfiumInstance) FPDFPageObj_GetIsActiveWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetIsActive) (*responses.FPDFPageObj_GetIsActive, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_GetIsActive", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAttachment_GetName(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_GetIsActive", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAttachment_GetStringValue(request *requests.FPDFAttachment_GetStringValue) (resp *responses.FPDFAttachment_GetStringValue, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetIsActive", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_GetStringValue", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_GetIsActive(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetIsActive)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAttachment_GetStringValue(request)
+func (i *pdfiumInstance) FPDFPageObj_GetLineCap(request *requests.FPDFPageObj_GetLineCap) (*responses.FPDFPageObj_GetLineCap, error) {
+	return i.FPDFPageObj_GetLineCapWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAttachment_GetSubtype(request *requests.FPDFAttachment_GetSubtype) (resp *responses.FPDFAttachment_GetSubtype, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_GetLineCapWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetLineCap) (*responses.FPDFPageObj_GetLineCap, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_GetLineCap", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_GetSubtype", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_GetLineCap", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFAttachment_GetSubtype(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetLineCap", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPageObj_GetLineCap(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetLineCap)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAttachment_GetValueType(request *requests.FPDFAttachment_GetValueType) (resp *responses.FPDFAttachment_GetValueType, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_GetLineJoin(request *requests.FPDFPageObj_GetLineJoin) (*responses.FPDFPageObj_GetLineJoin, error) {
+	return i.FPDFPageObj_GetLineJoinWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_GetValueType", panicError)
+func (i *pdfiumInstance) FPDFPageObj_GetLineJoinWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetLineJoin) (*responses.FPDFPageObj_GetLineJoin, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_GetLineJoin", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAttachment_GetValueType(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_GetLineJoin", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAttachment_HasKey(request *requests.FPDFAttachment_HasKey) (resp *responses.FPDFAttachment_HasKey, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetLineJoin", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_HasKey", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_GetLineJoin(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetLineJoin)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAttachment_HasKey(request)
+func (i *pdfiumInstance) FPDFPageObj_GetMark(request *requests.FPDFPageObj_GetMark) (*responses.FPDFPageObj_GetMark, error) {
+	return i.FPDFPageObj_GetMarkWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAttachment_SetDescription(request *requests.FPDFAttachment_SetDescription) (resp *responses.FPDFAttachment_SetDescription, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_GetMarkWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetMark) (*responses.FPDFPageObj_GetMark, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_GetMark", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_SetDescription", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_GetMark", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetMark", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFAttachment_SetDescription(request)
+		return i.worker.Instance.FPDFPageObj_GetMark(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetMark)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAttachment_SetFile(request *requests.FPDFAttachment_SetFile) (resp *responses.FPDFAttachment_SetFile, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_GetMarkedContentID(request *requests.FPDFPageObj_GetMarkedContentID) (*responses.FPDFPageObj_GetMarkedContentID, error) {
+	return i.FPDFPageObj_GetMarkedContentIDWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_SetFile", panicError)
+func (i *pdfiumInstance) FPDFPageObj_GetMarkedContentIDWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetMarkedContentID) (*responses.FPDFPageObj_GetMarkedContentID, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_GetMarkedContentID", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAttachment_SetFile(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_GetMarkedContentID", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAttachment_SetStringValue(request *requests.FPDFAttachment_SetStringValue) (resp *responses.FPDFAttachment_SetStringValue, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetMarkedContentID", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAttachment_SetStringValue", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_GetMarkedContentID(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetMarkedContentID)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAttachment_SetStringValue(request)
+func (i *pdfiumInstance) FPDFPageObj_GetMatrix(request *requests.FPDFPageObj_GetMatrix) (*responses.FPDFPageObj_GetMatrix, error) {
+	return i.FPDFPageObj_GetMatrixWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAvail_Create(request *requests.FPDFAvail_Create) (resp *responses.FPDFAvail_Create, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_GetMatrixWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetMatrix) (*responses.FPDFPageObj_GetMatrix, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_GetMatrix", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_Create", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_GetMatrix", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFAvail_Create(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetMatrix", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPageObj_GetMatrix(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetMatrix)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAvail_Destroy(request *requests.FPDFAvail_Destroy) (resp *responses.FPDFAvail_Destroy, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_GetRotatedBounds(request *requests.FPDFPageObj_GetRotatedBounds) (*responses.FPDFPageObj_GetRotatedBounds, error) {
+	return i.FPDFPageObj_GetRotatedBoundsWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_Destroy", panicError)
+func (i *pdfiumInstance) FPDFPageObj_GetRotatedBoundsWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetRotatedBounds) (*responses.FPDFPageObj_GetRotatedBounds, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_GetRotatedBounds", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAvail_Destroy(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_GetRotatedBounds", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAvail_GetDocument(request *requests.FPDFAvail_GetDocument) (resp *responses.FPDFAvail_GetDocument, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetRotatedBounds", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_GetDocument", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_GetRotatedBounds(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetRotatedBounds)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAvail_GetDocument(request)
+func (i *pdfiumInstance) FPDFPageObj_GetStrokeColor(request *requests.FPDFPageObj_GetStrokeColor) (*responses.FPDFPageObj_GetStrokeColor, error) {
+	return i.FPDFPageObj_GetStrokeColorWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAvail_GetFirstPageNum(request *requests.FPDFAvail_GetFirstPageNum) (resp *responses.FPDFAvail_GetFirstPageNum, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_GetStrokeColorWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetStrokeColor) (*responses.FPDFPageObj_GetStrokeColor, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_GetStrokeColor", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_GetFirstPageNum", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_GetStrokeColor", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetStrokeColor", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFAvail_GetFirstPageNum(request)
+		return i.worker.Instance.FPDFPageObj_GetStrokeColor(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetStrokeColor)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAvail_IsDocAvail(request *requests.FPDFAvail_IsDocAvail) (resp *responses.FPDFAvail_IsDocAvail, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_GetStrokeWidth(request *requests.FPDFPageObj_GetStrokeWidth) (*responses.FPDFPageObj_GetStrokeWidth, error) {
+	return i.FPDFPageObj_GetStrokeWidthWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_IsDocAvail", panicError)
+func (i *pdfiumInstance) FPDFPageObj_GetStrokeWidthWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetStrokeWidth) (*responses.FPDFPageObj_GetStrokeWidth, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_GetStrokeWidth", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAvail_IsDocAvail(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_GetStrokeWidth", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFAvail_IsFormAvail(request *requests.FPDFAvail_IsFormAvail) (resp *responses.FPDFAvail_IsFormAvail, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetStrokeWidth", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_IsFormAvail", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_GetStrokeWidth(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetStrokeWidth)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFAvail_IsFormAvail(request)
+func (i *pdfiumInstance) FPDFPageObj_GetType(request *requests.FPDFPageObj_GetType) (*responses.FPDFPageObj_GetType, error) {
+	return i.FPDFPageObj_GetTypeWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFAvail_IsLinearized(request *requests.FPDFAvail_IsLinearized) (resp *responses.FPDFAvail_IsLinearized, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_GetTypeWithContext(ctx goctx.Context, request *requests.FPDFPageObj_GetType) (*responses.FPDFPageObj_GetType, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_GetType", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_IsLinearized", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_GetType", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFAvail_IsLinearized(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetType", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPageObj_GetType(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_GetType)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFAvail_IsPageAvail(request *requests.FPDFAvail_IsPageAvail) (resp *responses.FPDFAvail_IsPageAvail, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_HasTransparency(request *requests.FPDFPageObj_HasTransparency) (*responses.FPDFPageObj_HasTransparency, error) {
+	return i.FPDFPageObj_HasTransparencyWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFAvail_IsPageAvail", panicError)
+func (i *pdfiumInstance) FPDFPageObj_HasTransparencyWithContext(ctx goctx.Context, request *requests.FPDFPageObj_HasTransparency) (*responses.FPDFPageObj_HasTransparency, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_HasTransparency", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFAvail_IsPageAvail(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_HasTransparency", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFBitmap_Create(request *requests.FPDFBitmap_Create) (resp *responses.FPDFBitmap_Create, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_HasTransparency", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_Create", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_HasTransparency(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_HasTransparency)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFBitmap_Create(request)
+func (i *pdfiumInstance) FPDFPageObj_NewImageObj(request *requests.FPDFPageObj_NewImageObj) (*responses.FPDFPageObj_NewImageObj, error) {
+	return i.FPDFPageObj_NewImageObjWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFBitmap_CreateEx(request *requests.FPDFBitmap_CreateEx) (resp *responses.FPDFBitmap_CreateEx, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_NewImageObjWithContext(ctx goctx.Context, request *requests.FPDFPageObj_NewImageObj) (*responses.FPDFPageObj_NewImageObj, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_NewImageObj", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_CreateEx", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_NewImageObj", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_NewImageObj", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFBitmap_CreateEx(request)
+		return i.worker.Instance.FPDFPageObj_NewImageObj(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_NewImageObj)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFBitmap_Destroy(request *requests.FPDFBitmap_Destroy) (resp *responses.FPDFBitmap_Destroy, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_NewTextObj(request *requests.FPDFPageObj_NewTextObj) (*responses.FPDFPageObj_NewTextObj, error) {
+	return i.FPDFPageObj_NewTextObjWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_Destroy", panicError)
+func (i *pdfiumInstance) FPDFPageObj_NewTextObjWithContext(ctx goctx.Context, request *requests.FPDFPageObj_NewTextObj) (*responses.FPDFPageObj_NewTextObj, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_NewTextObj", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFBitmap_Destroy(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_NewTextObj", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFBitmap_FillRect(request *requests.FPDFBitmap_FillRect) (resp *responses.FPDFBitmap_FillRect, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_NewTextObj", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_FillRect", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_NewTextObj(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_NewTextObj)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFBitmap_FillRect(request)
+func (i *pdfiumInstance) FPDFPageObj_RemoveMark(request *requests.FPDFPageObj_RemoveMark) (*responses.FPDFPageObj_RemoveMark, error) {
+	return i.FPDFPageObj_RemoveMarkWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFBitmap_GetBuffer(request *requests.FPDFBitmap_GetBuffer) (resp *responses.FPDFBitmap_GetBuffer, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_RemoveMarkWithContext(ctx goctx.Context, request *requests.FPDFPageObj_RemoveMark) (*responses.FPDFPageObj_RemoveMark, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_RemoveMark", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_GetBuffer", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_RemoveMark", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFBitmap_GetBuffer(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_RemoveMark", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPageObj_RemoveMark(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_RemoveMark)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFBitmap_GetFormat(request *requests.FPDFBitmap_GetFormat) (resp *responses.FPDFBitmap_GetFormat, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_SetBlendMode(request *requests.FPDFPageObj_SetBlendMode) (*responses.FPDFPageObj_SetBlendMode, error) {
+	return i.FPDFPageObj_SetBlendModeWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_GetFormat", panicError)
+func (i *pdfiumInstance) FPDFPageObj_SetBlendModeWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetBlendMode) (*responses.FPDFPageObj_SetBlendMode, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_SetBlendMode", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFBitmap_GetFormat(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_SetBlendMode", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFBitmap_GetHeight(request *requests.FPDFBitmap_GetHeight) (resp *responses.FPDFBitmap_GetHeight, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetBlendMode", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_GetHeight", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_SetBlendMode(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetBlendMode)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFBitmap_GetHeight(request)
+func (i *pdfiumInstance) FPDFPageObj_SetDashArray(request *requests.FPDFPageObj_SetDashArray) (*responses.FPDFPageObj_SetDashArray, error) {
+	return i.FPDFPageObj_SetDashArrayWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFBitmap_GetStride(request *requests.FPDFBitmap_GetStride) (resp *responses.FPDFBitmap_GetStride, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_SetDashArrayWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetDashArray) (*responses.FPDFPageObj_SetDashArray, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_SetDashArray", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_GetStride", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_SetDashArray", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetDashArray", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFBitmap_GetStride(request)
+		return i.worker.Instance.FPDFPageObj_SetDashArray(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetDashArray)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFBitmap_GetWidth(request *requests.FPDFBitmap_GetWidth) (resp *responses.FPDFBitmap_GetWidth, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_SetDashPhase(request *requests.FPDFPageObj_SetDashPhase) (*responses.FPDFPageObj_SetDashPhase, error) {
+	return i.FPDFPageObj_SetDashPhaseWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBitmap_GetWidth", panicError)
+func (i *pdfiumInstance) FPDFPageObj_SetDashPhaseWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetDashPhase) (*responses.FPDFPageObj_SetDashPhase, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_SetDashPhase", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFBitmap_GetWidth(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_SetDashPhase", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFBookmark_Find(request *requests.FPDFBookmark_Find) (resp *responses.FPDFBookmark_Find, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetDashPhase", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_Find", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_SetDashPhase(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetDashPhase)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFBookmark_Find(request)
+func (i *pdfiumInstance) FPDFPageObj_SetFillColor(request *requests.FPDFPageObj_SetFillColor) (*responses.FPDFPageObj_SetFillColor, error) {
+	return i.FPDFPageObj_SetFillColorWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFBookmark_GetAction(request *requests.FPDFBookmark_GetAction) (resp *responses.FPDFBookmark_GetAction, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_SetFillColorWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetFillColor) (*responses.FPDFPageObj_SetFillColor, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_SetFillColor", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetAction", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_SetFillColor", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFBookmark_GetAction(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetFillColor", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPageObj_SetFillColor(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetFillColor)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFBookmark_GetColor(request *requests.FPDFBookmark_GetColor) (resp *responses.FPDFBookmark_GetColor, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_SetIsActive(request *requests.FPDFPageObj_SetIsActive) (*responses.FPDFPageObj_SetIsActive, error) {
+	return i.FPDFPageObj_SetIsActiveWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetColor", panicError)
+func (i *pdfiumInstance) FPDFPageObj_SetIsActiveWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetIsActive) (*responses.FPDFPageObj_SetIsActive, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_SetIsActive", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFBookmark_GetColor(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_SetIsActive", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFBookmark_GetCount(request *requests.FPDFBookmark_GetCount) (resp *responses.FPDFBookmark_GetCount, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetIsActive", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetCount", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_SetIsActive(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetIsActive)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFBookmark_GetCount(request)
+func (i *pdfiumInstance) FPDFPageObj_SetLineCap(request *requests.FPDFPageObj_SetLineCap) (*responses.FPDFPageObj_SetLineCap, error) {
+	return i.FPDFPageObj_SetLineCapWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFBookmark_GetDest(request *requests.FPDFBookmark_GetDest) (resp *responses.FPDFBookmark_GetDest, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_SetLineCapWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetLineCap) (*responses.FPDFPageObj_SetLineCap, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_SetLineCap", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetDest", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_SetLineCap", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetLineCap", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFBookmark_GetDest(request)
+		return i.worker.Instance.FPDFPageObj_SetLineCap(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetLineCap)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFBookmark_GetFirstChild(request *requests.FPDFBookmark_GetFirstChild) (resp *responses.FPDFBookmark_GetFirstChild, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_SetLineJoin(request *requests.FPDFPageObj_SetLineJoin) (*responses.FPDFPageObj_SetLineJoin, error) {
+	return i.FPDFPageObj_SetLineJoinWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetFirstChild", panicError)
+func (i *pdfiumInstance) FPDFPageObj_SetLineJoinWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetLineJoin) (*responses.FPDFPageObj_SetLineJoin, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_SetLineJoin", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFBookmark_GetFirstChild(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_SetLineJoin", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFBookmark_GetNextSibling(request *requests.FPDFBookmark_GetNextSibling) (resp *responses.FPDFBookmark_GetNextSibling, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetLineJoin", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetNextSibling", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_SetLineJoin(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetLineJoin)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFBookmark_GetNextSibling(request)
+func (i *pdfiumInstance) FPDFPageObj_SetMatrix(request *requests.FPDFPageObj_SetMatrix) (*responses.FPDFPageObj_SetMatrix, error) {
+	return i.FPDFPageObj_SetMatrixWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFBookmark_GetTitle(request *requests.FPDFBookmark_GetTitle) (resp *responses.FPDFBookmark_GetTitle, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_SetMatrixWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetMatrix) (*responses.FPDFPageObj_SetMatrix, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_SetMatrix", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFBookmark_GetTitle", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_SetMatrix", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFBookmark_GetTitle(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetMatrix", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPageObj_SetMatrix(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetMatrix)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFCatalog_GetLanguage(request *requests.FPDFCatalog_GetLanguage) (resp *responses.FPDFCatalog_GetLanguage, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_SetStrokeColor(request *requests.FPDFPageObj_SetStrokeColor) (*responses.FPDFPageObj_SetStrokeColor, error) {
+	return i.FPDFPageObj_SetStrokeColorWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFCatalog_GetLanguage", panicError)
+func (i *pdfiumInstance) FPDFPageObj_SetStrokeColorWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetStrokeColor) (*responses.FPDFPageObj_SetStrokeColor, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_SetStrokeColor", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFCatalog_GetLanguage(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_SetStrokeColor", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFCatalog_IsTagged(request *requests.FPDFCatalog_IsTagged) (resp *responses.FPDFCatalog_IsTagged, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetStrokeColor", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFCatalog_IsTagged", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_SetStrokeColor(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetStrokeColor)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFCatalog_IsTagged(request)
+func (i *pdfiumInstance) FPDFPageObj_SetStrokeWidth(request *requests.FPDFPageObj_SetStrokeWidth) (*responses.FPDFPageObj_SetStrokeWidth, error) {
+	return i.FPDFPageObj_SetStrokeWidthWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFCatalog_SetLanguage(request *requests.FPDFCatalog_SetLanguage) (resp *responses.FPDFCatalog_SetLanguage, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_SetStrokeWidthWithContext(ctx goctx.Context, request *requests.FPDFPageObj_SetStrokeWidth) (*responses.FPDFPageObj_SetStrokeWidth, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_SetStrokeWidth", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFCatalog_SetLanguage", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_SetStrokeWidth", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetStrokeWidth", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFCatalog_SetLanguage(request)
+		return i.worker.Instance.FPDFPageObj_SetStrokeWidth(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_SetStrokeWidth)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFClipPath_CountPathSegments(request *requests.FPDFClipPath_CountPathSegments) (resp *responses.FPDFClipPath_CountPathSegments, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_Transform(request *requests.FPDFPageObj_Transform) (*responses.FPDFPageObj_Transform, error) {
+	return i.FPDFPageObj_TransformWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFClipPath_CountPathSegments", panicError)
+func (i *pdfiumInstance) FPDFPageObj_TransformWithContext(ctx goctx.Context, request *requests.FPDFPageObj_Transform) (*responses.FPDFPageObj_Transform, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_Transform", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFClipPath_CountPathSegments(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_Transform", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFClipPath_CountPaths(request *requests.FPDFClipPath_CountPaths) (resp *responses.FPDFClipPath_CountPaths, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_Transform", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFClipPath_CountPaths", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_Transform(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_Transform)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFClipPath_CountPaths(request)
+func (i *pdfiumInstance) FPDFPageObj_TransformClipPath(request *requests.FPDFPageObj_TransformClipPath) (*responses.FPDFPageObj_TransformClipPath, error) {
+	return i.FPDFPageObj_TransformClipPathWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFClipPath_GetPathSegment(request *requests.FPDFClipPath_GetPathSegment) (resp *responses.FPDFClipPath_GetPathSegment, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_TransformClipPathWithContext(ctx goctx.Context, request *requests.FPDFPageObj_TransformClipPath) (*responses.FPDFPageObj_TransformClipPath, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_TransformClipPath", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFClipPath_GetPathSegment", panicError)
+		done, err := i.startCall(ctx, "FPDFPageObj_TransformClipPath", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFClipPath_GetPathSegment(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_TransformClipPath", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPageObj_TransformClipPath(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_TransformClipPath)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFDOC_ExitFormFillEnvironment(request *requests.FPDFDOC_ExitFormFillEnvironment) (resp *responses.FPDFDOC_ExitFormFillEnvironment, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPageObj_TransformF(request *requests.FPDFPageObj_TransformF) (*responses.FPDFPageObj_TransformF, error) {
+	return i.FPDFPageObj_TransformFWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDOC_ExitFormFillEnvironment", panicError)
+func (i *pdfiumInstance) FPDFPageObj_TransformFWithContext(ctx goctx.Context, request *requests.FPDFPageObj_TransformF) (*responses.FPDFPageObj_TransformF, error) {
+	resp, err := i.interceptor(ctx, "FPDFPageObj_TransformF", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFDOC_ExitFormFillEnvironment(request)
-}
+		done, err := i.startCall(ctx, "FPDFPageObj_TransformF", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFDOC_InitFormFillEnvironment(request *requests.FPDFDOC_InitFormFillEnvironment) (resp *responses.FPDFDOC_InitFormFillEnvironment, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_TransformF", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDOC_InitFormFillEnvironment", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPageObj_TransformF(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPageObj_TransformF)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFDOC_InitFormFillEnvironment(request)
+func (i *pdfiumInstance) FPDFPage_CloseAnnot(request *requests.FPDFPage_CloseAnnot) (*responses.FPDFPage_CloseAnnot, error) {
+	return i.FPDFPage_CloseAnnotWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFDest_GetDestPageIndex(request *requests.FPDFDest_GetDestPageIndex) (resp *responses.FPDFDest_GetDestPageIndex, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_CloseAnnotWithContext(ctx goctx.Context, request *requests.FPDFPage_CloseAnnot) (*responses.FPDFPage_CloseAnnot, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_CloseAnnot", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDest_GetDestPageIndex", panicError)
+		done, err := i.startCall(ctx, "FPDFPage_CloseAnnot", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_CloseAnnot", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFDest_GetDestPageIndex(request)
+		return i.worker.Instance.FPDFPage_CloseAnnot(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_CloseAnnot)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFDest_GetLocationInPage(request *requests.FPDFDest_GetLocationInPage) (resp *responses.FPDFDest_GetLocationInPage, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_CountObjects(request *requests.FPDFPage_CountObjects) (*responses.FPDFPage_CountObjects, error) {
+	return i.FPDFPage_CountObjectsWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDest_GetLocationInPage", panicError)
+func (i *pdfiumInstance) FPDFPage_CountObjectsWithContext(ctx goctx.Context, request *requests.FPDFPage_CountObjects) (*responses.FPDFPage_CountObjects, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_CountObjects", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFDest_GetLocationInPage(request)
-}
+		done, err := i.startCall(ctx, "FPDFPage_CountObjects", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFDest_GetView(request *requests.FPDFDest_GetView) (resp *responses.FPDFDest_GetView, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_CountObjects", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDest_GetView", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPage_CountObjects(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_CountObjects)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFDest_GetView(request)
+func (i *pdfiumInstance) FPDFPage_CreateAnnot(request *requests.FPDFPage_CreateAnnot) (*responses.FPDFPage_CreateAnnot, error) {
+	return i.FPDFPage_CreateAnnotWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFDoc_AddAttachment(request *requests.FPDFDoc_AddAttachment) (resp *responses.FPDFDoc_AddAttachment, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_CreateAnnotWithContext(ctx goctx.Context, request *requests.FPDFPage_CreateAnnot) (*responses.FPDFPage_CreateAnnot, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_CreateAnnot", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_AddAttachment", panicError)
+		done, err := i.startCall(ctx, "FPDFPage_CreateAnnot", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFDoc_AddAttachment(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_CreateAnnot", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPage_CreateAnnot(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_CreateAnnot)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFDoc_CloseJavaScriptAction(request *requests.FPDFDoc_CloseJavaScriptAction) (resp *responses.FPDFDoc_CloseJavaScriptAction, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_Delete(request *requests.FPDFPage_Delete) (*responses.FPDFPage_Delete, error) {
+	return i.FPDFPage_DeleteWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_CloseJavaScriptAction", panicError)
+func (i *pdfiumInstance) FPDFPage_DeleteWithContext(ctx goctx.Context, request *requests.FPDFPage_Delete) (*responses.FPDFPage_Delete, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_Delete", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFDoc_CloseJavaScriptAction(request)
-}
+		done, err := i.startCall(ctx, "FPDFPage_Delete", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFDoc_DeleteAttachment(request *requests.FPDFDoc_DeleteAttachment) (resp *responses.FPDFDoc_DeleteAttachment, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_Delete", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_DeleteAttachment", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPage_Delete(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_Delete)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFDoc_DeleteAttachment(request)
+func (i *pdfiumInstance) FPDFPage_Flatten(request *requests.FPDFPage_Flatten) (*responses.FPDFPage_Flatten, error) {
+	return i.FPDFPage_FlattenWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFDoc_GetAttachment(request *requests.FPDFDoc_GetAttachment) (resp *responses.FPDFDoc_GetAttachment, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_FlattenWithContext(ctx goctx.Context, request *requests.FPDFPage_Flatten) (*responses.FPDFPage_Flatten, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_Flatten", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_GetAttachment", panicError)
+		done, err := i.startCall(ctx, "FPDFPage_Flatten", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_Flatten", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFDoc_GetAttachment(request)
+		return i.worker.Instance.FPDFPage_Flatten(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_Flatten)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFDoc_GetAttachmentCount(request *requests.FPDFDoc_GetAttachmentCount) (resp *responses.FPDFDoc_GetAttachmentCount, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_FormFieldZOrderAtPoint(request *requests.FPDFPage_FormFieldZOrderAtPoint) (*responses.FPDFPage_FormFieldZOrderAtPoint, error) {
+	return i.FPDFPage_FormFieldZOrderAtPointWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_GetAttachmentCount", panicError)
+func (i *pdfiumInstance) FPDFPage_FormFieldZOrderAtPointWithContext(ctx goctx.Context, request *requests.FPDFPage_FormFieldZOrderAtPoint) (*responses.FPDFPage_FormFieldZOrderAtPoint, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_FormFieldZOrderAtPoint", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFDoc_GetAttachmentCount(request)
-}
+		done, err := i.startCall(ctx, "FPDFPage_FormFieldZOrderAtPoint", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFDoc_GetJavaScriptAction(request *requests.FPDFDoc_GetJavaScriptAction) (resp *responses.FPDFDoc_GetJavaScriptAction, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_FormFieldZOrderAtPoint", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_GetJavaScriptAction", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPage_FormFieldZOrderAtPoint(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_FormFieldZOrderAtPoint)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFDoc_GetJavaScriptAction(request)
+func (i *pdfiumInstance) FPDFPage_GenerateContent(request *requests.FPDFPage_GenerateContent) (*responses.FPDFPage_GenerateContent, error) {
+	return i.FPDFPage_GenerateContentWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFDoc_GetJavaScriptActionCount(request *requests.FPDFDoc_GetJavaScriptActionCount) (resp *responses.FPDFDoc_GetJavaScriptActionCount, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_GenerateContentWithContext(ctx goctx.Context, request *requests.FPDFPage_GenerateContent) (*responses.FPDFPage_GenerateContent, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_GenerateContent", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_GetJavaScriptActionCount", panicError)
+		done, err := i.startCall(ctx, "FPDFPage_GenerateContent", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFDoc_GetJavaScriptActionCount(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GenerateContent", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPage_GenerateContent(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_GenerateContent)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFDoc_GetPageMode(request *requests.FPDFDoc_GetPageMode) (resp *responses.FPDFDoc_GetPageMode, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_GetAnnot(request *requests.FPDFPage_GetAnnot) (*responses.FPDFPage_GetAnnot, error) {
+	return i.FPDFPage_GetAnnotWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFDoc_GetPageMode", panicError)
+func (i *pdfiumInstance) FPDFPage_GetAnnotWithContext(ctx goctx.Context, request *requests.FPDFPage_GetAnnot) (*responses.FPDFPage_GetAnnot, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_GetAnnot", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFDoc_GetPageMode(request)
-}
+		done, err := i.startCall(ctx, "FPDFPage_GetAnnot", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFFont_Close(request *requests.FPDFFont_Close) (resp *responses.FPDFFont_Close, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetAnnot", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_Close", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPage_GetAnnot(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_GetAnnot)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFFont_Close(request)
+func (i *pdfiumInstance) FPDFPage_GetAnnotCount(request *requests.FPDFPage_GetAnnotCount) (*responses.FPDFPage_GetAnnotCount, error) {
+	return i.FPDFPage_GetAnnotCountWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFFont_GetAscent(request *requests.FPDFFont_GetAscent) (resp *responses.FPDFFont_GetAscent, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_GetAnnotCountWithContext(ctx goctx.Context, request *requests.FPDFPage_GetAnnotCount) (*responses.FPDFPage_GetAnnotCount, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_GetAnnotCount", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetAscent", panicError)
+		done, err := i.startCall(ctx, "FPDFPage_GetAnnotCount", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetAnnotCount", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFFont_GetAscent(request)
+		return i.worker.Instance.FPDFPage_GetAnnotCount(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_GetAnnotCount)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFFont_GetBaseFontName(request *requests.FPDFFont_GetBaseFontName) (resp *responses.FPDFFont_GetBaseFontName, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_GetAnnotIndex(request *requests.FPDFPage_GetAnnotIndex) (*responses.FPDFPage_GetAnnotIndex, error) {
+	return i.FPDFPage_GetAnnotIndexWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetBaseFontName", panicError)
+func (i *pdfiumInstance) FPDFPage_GetAnnotIndexWithContext(ctx goctx.Context, request *requests.FPDFPage_GetAnnotIndex) (*responses.FPDFPage_GetAnnotIndex, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_GetAnnotIndex", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFFont_GetBaseFontName(request)
-}
+		done, err := i.startCall(ctx, "FPDFPage_GetAnnotIndex", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFFont_GetDescent(request *requests.FPDFFont_GetDescent) (resp *responses.FPDFFont_GetDescent, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetAnnotIndex", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetDescent", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPage_GetAnnotIndex(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_GetAnnotIndex)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFFont_GetDescent(request)
+func (i *pdfiumInstance) FPDFPage_GetArtBox(request *requests.FPDFPage_GetArtBox) (*responses.FPDFPage_GetArtBox, error) {
+	return i.FPDFPage_GetArtBoxWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFFont_GetFamilyName(request *requests.FPDFFont_GetFamilyName) (resp *responses.FPDFFont_GetFamilyName, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_GetArtBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_GetArtBox) (*responses.FPDFPage_GetArtBox, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_GetArtBox", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetFamilyName", panicError)
+		done, err := i.startCall(ctx, "FPDFPage_GetArtBox", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFFont_GetFamilyName(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetArtBox", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPage_GetArtBox(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_GetArtBox)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFFont_GetFlags(request *requests.FPDFFont_GetFlags) (resp *responses.FPDFFont_GetFlags, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_GetBleedBox(request *requests.FPDFPage_GetBleedBox) (*responses.FPDFPage_GetBleedBox, error) {
+	return i.FPDFPage_GetBleedBoxWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetFlags", panicError)
+func (i *pdfiumInstance) FPDFPage_GetBleedBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_GetBleedBox) (*responses.FPDFPage_GetBleedBox, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_GetBleedBox", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFFont_GetFlags(request)
-}
+		done, err := i.startCall(ctx, "FPDFPage_GetBleedBox", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFFont_GetFontData(request *requests.FPDFFont_GetFontData) (resp *responses.FPDFFont_GetFontData, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetBleedBox", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetFontData", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPage_GetBleedBox(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_GetBleedBox)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFFont_GetFontData(request)
+func (i *pdfiumInstance) FPDFPage_GetCropBox(request *requests.FPDFPage_GetCropBox) (*responses.FPDFPage_GetCropBox, error) {
+	return i.FPDFPage_GetCropBoxWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFFont_GetGlyphPath(request *requests.FPDFFont_GetGlyphPath) (resp *responses.FPDFFont_GetGlyphPath, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_GetCropBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_GetCropBox) (*responses.FPDFPage_GetCropBox, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_GetCropBox", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetGlyphPath", panicError)
+		done, err := i.startCall(ctx, "FPDFPage_GetCropBox", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetCropBox", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFFont_GetGlyphPath(request)
+		return i.worker.Instance.FPDFPage_GetCropBox(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_GetCropBox)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFFont_GetGlyphWidth(request *requests.FPDFFont_GetGlyphWidth) (resp *responses.FPDFFont_GetGlyphWidth, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_GetDecodedThumbnailData(request *requests.FPDFPage_GetDecodedThumbnailData) (*responses.FPDFPage_GetDecodedThumbnailData, error) {
+	return i.FPDFPage_GetDecodedThumbnailDataWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetGlyphWidth", panicError)
+func (i *pdfiumInstance) FPDFPage_GetDecodedThumbnailDataWithContext(ctx goctx.Context, request *requests.FPDFPage_GetDecodedThumbnailData) (*responses.FPDFPage_GetDecodedThumbnailData, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_GetDecodedThumbnailData", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFFont_GetGlyphWidth(request)
-}
+		done, err := i.startCall(ctx, "FPDFPage_GetDecodedThumbnailData", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFFont_GetIsEmbedded(request *requests.FPDFFont_GetIsEmbedded) (resp *responses.FPDFFont_GetIsEmbedded, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetDecodedThumbnailData", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetIsEmbedded", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPage_GetDecodedThumbnailData(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_GetDecodedThumbnailData)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFFont_GetIsEmbedded(request)
+func (i *pdfiumInstance) FPDFPage_GetMediaBox(request *requests.FPDFPage_GetMediaBox) (*responses.FPDFPage_GetMediaBox, error) {
+	return i.FPDFPage_GetMediaBoxWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFFont_GetItalicAngle(request *requests.FPDFFont_GetItalicAngle) (resp *responses.FPDFFont_GetItalicAngle, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_GetMediaBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_GetMediaBox) (*responses.FPDFPage_GetMediaBox, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_GetMediaBox", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetItalicAngle", panicError)
+		done, err := i.startCall(ctx, "FPDFPage_GetMediaBox", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFFont_GetItalicAngle(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetMediaBox", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPage_GetMediaBox(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_GetMediaBox)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFFont_GetWeight(request *requests.FPDFFont_GetWeight) (resp *responses.FPDFFont_GetWeight, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_GetObject(request *requests.FPDFPage_GetObject) (*responses.FPDFPage_GetObject, error) {
+	return i.FPDFPage_GetObjectWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFont_GetWeight", panicError)
+func (i *pdfiumInstance) FPDFPage_GetObjectWithContext(ctx goctx.Context, request *requests.FPDFPage_GetObject) (*responses.FPDFPage_GetObject, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_GetObject", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFFont_GetWeight(request)
-}
+		done, err := i.startCall(ctx, "FPDFPage_GetObject", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFFormObj_CountObjects(request *requests.FPDFFormObj_CountObjects) (resp *responses.FPDFFormObj_CountObjects, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetObject", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFormObj_CountObjects", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPage_GetObject(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_GetObject)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFFormObj_CountObjects(request)
+func (i *pdfiumInstance) FPDFPage_GetRawThumbnailData(request *requests.FPDFPage_GetRawThumbnailData) (*responses.FPDFPage_GetRawThumbnailData, error) {
+	return i.FPDFPage_GetRawThumbnailDataWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFFormObj_GetObject(request *requests.FPDFFormObj_GetObject) (resp *responses.FPDFFormObj_GetObject, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_GetRawThumbnailDataWithContext(ctx goctx.Context, request *requests.FPDFPage_GetRawThumbnailData) (*responses.FPDFPage_GetRawThumbnailData, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_GetRawThumbnailData", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFormObj_GetObject", panicError)
+		done, err := i.startCall(ctx, "FPDFPage_GetRawThumbnailData", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetRawThumbnailData", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFFormObj_GetObject(request)
+		return i.worker.Instance.FPDFPage_GetRawThumbnailData(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_GetRawThumbnailData)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFFormObj_RemoveObject(request *requests.FPDFFormObj_RemoveObject) (resp *responses.FPDFFormObj_RemoveObject, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_GetRotation(request *requests.FPDFPage_GetRotation) (*responses.FPDFPage_GetRotation, error) {
+	return i.FPDFPage_GetRotationWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFFormObj_RemoveObject", panicError)
+func (i *pdfiumInstance) FPDFPage_GetRotationWithContext(ctx goctx.Context, request *requests.FPDFPage_GetRotation) (*responses.FPDFPage_GetRotation, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_GetRotation", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFFormObj_RemoveObject(request)
-}
+		done, err := i.startCall(ctx, "FPDFPage_GetRotation", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFGlyphPath_CountGlyphSegments(request *requests.FPDFGlyphPath_CountGlyphSegments) (resp *responses.FPDFGlyphPath_CountGlyphSegments, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetRotation", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFGlyphPath_CountGlyphSegments", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPage_GetRotation(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_GetRotation)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFGlyphPath_CountGlyphSegments(request)
+func (i *pdfiumInstance) FPDFPage_GetThumbnailAsBitmap(request *requests.FPDFPage_GetThumbnailAsBitmap) (*responses.FPDFPage_GetThumbnailAsBitmap, error) {
+	return i.FPDFPage_GetThumbnailAsBitmapWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFGlyphPath_GetGlyphPathSegment(request *requests.FPDFGlyphPath_GetGlyphPathSegment) (resp *responses.FPDFGlyphPath_GetGlyphPathSegment, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_GetThumbnailAsBitmapWithContext(ctx goctx.Context, request *requests.FPDFPage_GetThumbnailAsBitmap) (*responses.FPDFPage_GetThumbnailAsBitmap, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_GetThumbnailAsBitmap", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFGlyphPath_GetGlyphPathSegment", panicError)
+		done, err := i.startCall(ctx, "FPDFPage_GetThumbnailAsBitmap", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFGlyphPath_GetGlyphPathSegment(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetThumbnailAsBitmap", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPage_GetThumbnailAsBitmap(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_GetThumbnailAsBitmap)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFImageObj_GetBitmap(request *requests.FPDFImageObj_GetBitmap) (resp *responses.FPDFImageObj_GetBitmap, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_GetTrimBox(request *requests.FPDFPage_GetTrimBox) (*responses.FPDFPage_GetTrimBox, error) {
+	return i.FPDFPage_GetTrimBoxWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetBitmap", panicError)
+func (i *pdfiumInstance) FPDFPage_GetTrimBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_GetTrimBox) (*responses.FPDFPage_GetTrimBox, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_GetTrimBox", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFImageObj_GetBitmap(request)
-}
+		done, err := i.startCall(ctx, "FPDFPage_GetTrimBox", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFImageObj_GetIccProfileDataDecoded(request *requests.FPDFImageObj_GetIccProfileDataDecoded) (resp *responses.FPDFImageObj_GetIccProfileDataDecoded, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetTrimBox", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetIccProfileDataDecoded", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPage_GetTrimBox(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_GetTrimBox)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFImageObj_GetIccProfileDataDecoded(request)
+func (i *pdfiumInstance) FPDFPage_HasFormFieldAtPoint(request *requests.FPDFPage_HasFormFieldAtPoint) (*responses.FPDFPage_HasFormFieldAtPoint, error) {
+	return i.FPDFPage_HasFormFieldAtPointWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFImageObj_GetImageDataDecoded(request *requests.FPDFImageObj_GetImageDataDecoded) (resp *responses.FPDFImageObj_GetImageDataDecoded, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_HasFormFieldAtPointWithContext(ctx goctx.Context, request *requests.FPDFPage_HasFormFieldAtPoint) (*responses.FPDFPage_HasFormFieldAtPoint, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_HasFormFieldAtPoint", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetImageDataDecoded", panicError)
+		done, err := i.startCall(ctx, "FPDFPage_HasFormFieldAtPoint", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_HasFormFieldAtPoint", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFImageObj_GetImageDataDecoded(request)
+		return i.worker.Instance.FPDFPage_HasFormFieldAtPoint(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_HasFormFieldAtPoint)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFImageObj_GetImageDataRaw(request *requests.FPDFImageObj_GetImageDataRaw) (resp *responses.FPDFImageObj_GetImageDataRaw, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_HasTransparency(request *requests.FPDFPage_HasTransparency) (*responses.FPDFPage_HasTransparency, error) {
+	return i.FPDFPage_HasTransparencyWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetImageDataRaw", panicError)
+func (i *pdfiumInstance) FPDFPage_HasTransparencyWithContext(ctx goctx.Context, request *requests.FPDFPage_HasTransparency) (*responses.FPDFPage_HasTransparency, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_HasTransparency", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFImageObj_GetImageDataRaw(request)
-}
+		done, err := i.startCall(ctx, "FPDFPage_HasTransparency", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFImageObj_GetImageFilter(request *requests.FPDFImageObj_GetImageFilter) (resp *responses.FPDFImageObj_GetImageFilter, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_HasTransparency", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetImageFilter", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPage_HasTransparency(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_HasTransparency)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFImageObj_GetImageFilter(request)
+func (i *pdfiumInstance) FPDFPage_InsertClipPath(request *requests.FPDFPage_InsertClipPath) (*responses.FPDFPage_InsertClipPath, error) {
+	return i.FPDFPage_InsertClipPathWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFImageObj_GetImageFilterCount(request *requests.FPDFImageObj_GetImageFilterCount) (resp *responses.FPDFImageObj_GetImageFilterCount, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_InsertClipPathWithContext(ctx goctx.Context, request *requests.FPDFPage_InsertClipPath) (*responses.FPDFPage_InsertClipPath, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_InsertClipPath", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetImageFilterCount", panicError)
+		done, err := i.startCall(ctx, "FPDFPage_InsertClipPath", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFImageObj_GetImageFilterCount(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_InsertClipPath", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPage_InsertClipPath(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_InsertClipPath)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFImageObj_GetImageMetadata(request *requests.FPDFImageObj_GetImageMetadata) (resp *responses.FPDFImageObj_GetImageMetadata, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_InsertObject(request *requests.FPDFPage_InsertObject) (*responses.FPDFPage_InsertObject, error) {
+	return i.FPDFPage_InsertObjectWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetImageMetadata", panicError)
+func (i *pdfiumInstance) FPDFPage_InsertObjectWithContext(ctx goctx.Context, request *requests.FPDFPage_InsertObject) (*responses.FPDFPage_InsertObject, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_InsertObject", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFImageObj_GetImageMetadata(request)
-}
+		done, err := i.startCall(ctx, "FPDFPage_InsertObject", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFImageObj_GetImagePixelSize(request *requests.FPDFImageObj_GetImagePixelSize) (resp *responses.FPDFImageObj_GetImagePixelSize, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_InsertObject", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetImagePixelSize", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPage_InsertObject(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_InsertObject)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFImageObj_GetImagePixelSize(request)
+func (i *pdfiumInstance) FPDFPage_InsertObjectAtIndex(request *requests.FPDFPage_InsertObjectAtIndex) (*responses.FPDFPage_InsertObjectAtIndex, error) {
+	return i.FPDFPage_InsertObjectAtIndexWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFImageObj_GetRenderedBitmap(request *requests.FPDFImageObj_GetRenderedBitmap) (resp *responses.FPDFImageObj_GetRenderedBitmap, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_InsertObjectAtIndexWithContext(ctx goctx.Context, request *requests.FPDFPage_InsertObjectAtIndex) (*responses.FPDFPage_InsertObjectAtIndex, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_InsertObjectAtIndex", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_GetRenderedBitmap", panicError)
+		done, err := i.startCall(ctx, "FPDFPage_InsertObjectAtIndex", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_InsertObjectAtIndex", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFImageObj_GetRenderedBitmap(request)
+		return i.worker.Instance.FPDFPage_InsertObjectAtIndex(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_InsertObjectAtIndex)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFImageObj_LoadJpegFile(request *requests.FPDFImageObj_LoadJpegFile) (resp *responses.FPDFImageObj_LoadJpegFile, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_New(request *requests.FPDFPage_New) (*responses.FPDFPage_New, error) {
+	return i.FPDFPage_NewWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_LoadJpegFile", panicError)
+func (i *pdfiumInstance) FPDFPage_NewWithContext(ctx goctx.Context, request *requests.FPDFPage_New) (*responses.FPDFPage_New, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_New", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFImageObj_LoadJpegFile(request)
-}
+		done, err := i.startCall(ctx, "FPDFPage_New", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFImageObj_LoadJpegFileInline(request *requests.FPDFImageObj_LoadJpegFileInline) (resp *responses.FPDFImageObj_LoadJpegFileInline, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_New", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_LoadJpegFileInline", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPage_New(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_New)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFImageObj_LoadJpegFileInline(request)
+func (i *pdfiumInstance) FPDFPage_RemoveAnnot(request *requests.FPDFPage_RemoveAnnot) (*responses.FPDFPage_RemoveAnnot, error) {
+	return i.FPDFPage_RemoveAnnotWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFImageObj_SetBitmap(request *requests.FPDFImageObj_SetBitmap) (resp *responses.FPDFImageObj_SetBitmap, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_RemoveAnnotWithContext(ctx goctx.Context, request *requests.FPDFPage_RemoveAnnot) (*responses.FPDFPage_RemoveAnnot, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_RemoveAnnot", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_SetBitmap", panicError)
+		done, err := i.startCall(ctx, "FPDFPage_RemoveAnnot", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFImageObj_SetBitmap(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_RemoveAnnot", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPage_RemoveAnnot(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_RemoveAnnot)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFImageObj_SetMatrix(request *requests.FPDFImageObj_SetMatrix) (resp *responses.FPDFImageObj_SetMatrix, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_RemoveObject(request *requests.FPDFPage_RemoveObject) (*responses.FPDFPage_RemoveObject, error) {
+	return i.FPDFPage_RemoveObjectWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFImageObj_SetMatrix", panicError)
+func (i *pdfiumInstance) FPDFPage_RemoveObjectWithContext(ctx goctx.Context, request *requests.FPDFPage_RemoveObject) (*responses.FPDFPage_RemoveObject, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_RemoveObject", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFImageObj_SetMatrix(request)
-}
+		done, err := i.startCall(ctx, "FPDFPage_RemoveObject", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFJavaScriptAction_GetName(request *requests.FPDFJavaScriptAction_GetName) (resp *responses.FPDFJavaScriptAction_GetName, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_RemoveObject", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFJavaScriptAction_GetName", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPage_RemoveObject(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_RemoveObject)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFJavaScriptAction_GetName(request)
+func (i *pdfiumInstance) FPDFPage_SetArtBox(request *requests.FPDFPage_SetArtBox) (*responses.FPDFPage_SetArtBox, error) {
+	return i.FPDFPage_SetArtBoxWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFJavaScriptAction_GetScript(request *requests.FPDFJavaScriptAction_GetScript) (resp *responses.FPDFJavaScriptAction_GetScript, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_SetArtBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_SetArtBox) (*responses.FPDFPage_SetArtBox, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_SetArtBox", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFJavaScriptAction_GetScript", panicError)
+		done, err := i.startCall(ctx, "FPDFPage_SetArtBox", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_SetArtBox", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFJavaScriptAction_GetScript(request)
+		return i.worker.Instance.FPDFPage_SetArtBox(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_SetArtBox)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFLink_CloseWebLinks(request *requests.FPDFLink_CloseWebLinks) (resp *responses.FPDFLink_CloseWebLinks, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_SetBleedBox(request *requests.FPDFPage_SetBleedBox) (*responses.FPDFPage_SetBleedBox, error) {
+	return i.FPDFPage_SetBleedBoxWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_CloseWebLinks", panicError)
+func (i *pdfiumInstance) FPDFPage_SetBleedBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_SetBleedBox) (*responses.FPDFPage_SetBleedBox, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_SetBleedBox", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFLink_CloseWebLinks(request)
-}
+		done, err := i.startCall(ctx, "FPDFPage_SetBleedBox", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFLink_CountQuadPoints(request *requests.FPDFLink_CountQuadPoints) (resp *responses.FPDFLink_CountQuadPoints, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_SetBleedBox", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_CountQuadPoints", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPage_SetBleedBox(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_SetBleedBox)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFLink_CountQuadPoints(request)
+func (i *pdfiumInstance) FPDFPage_SetCropBox(request *requests.FPDFPage_SetCropBox) (*responses.FPDFPage_SetCropBox, error) {
+	return i.FPDFPage_SetCropBoxWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFLink_CountRects(request *requests.FPDFLink_CountRects) (resp *responses.FPDFLink_CountRects, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_SetCropBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_SetCropBox) (*responses.FPDFPage_SetCropBox, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_SetCropBox", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_CountRects", panicError)
+		done, err := i.startCall(ctx, "FPDFPage_SetCropBox", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFLink_CountRects(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_SetCropBox", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPage_SetCropBox(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_SetCropBox)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFLink_CountWebLinks(request *requests.FPDFLink_CountWebLinks) (resp *responses.FPDFLink_CountWebLinks, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_SetMediaBox(request *requests.FPDFPage_SetMediaBox) (*responses.FPDFPage_SetMediaBox, error) {
+	return i.FPDFPage_SetMediaBoxWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_CountWebLinks", panicError)
+func (i *pdfiumInstance) FPDFPage_SetMediaBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_SetMediaBox) (*responses.FPDFPage_SetMediaBox, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_SetMediaBox", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFLink_CountWebLinks(request)
-}
+		done, err := i.startCall(ctx, "FPDFPage_SetMediaBox", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFLink_Enumerate(request *requests.FPDFLink_Enumerate) (resp *responses.FPDFLink_Enumerate, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_SetMediaBox", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_Enumerate", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPage_SetMediaBox(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_SetMediaBox)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFLink_Enumerate(request)
+func (i *pdfiumInstance) FPDFPage_SetRotation(request *requests.FPDFPage_SetRotation) (*responses.FPDFPage_SetRotation, error) {
+	return i.FPDFPage_SetRotationWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFLink_GetAction(request *requests.FPDFLink_GetAction) (resp *responses.FPDFLink_GetAction, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_SetRotationWithContext(ctx goctx.Context, request *requests.FPDFPage_SetRotation) (*responses.FPDFPage_SetRotation, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_SetRotation", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetAction", panicError)
+		done, err := i.startCall(ctx, "FPDFPage_SetRotation", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_SetRotation", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFLink_GetAction(request)
+		return i.worker.Instance.FPDFPage_SetRotation(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_SetRotation)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFLink_GetAnnot(request *requests.FPDFLink_GetAnnot) (resp *responses.FPDFLink_GetAnnot, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_SetTrimBox(request *requests.FPDFPage_SetTrimBox) (*responses.FPDFPage_SetTrimBox, error) {
+	return i.FPDFPage_SetTrimBoxWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetAnnot", panicError)
+func (i *pdfiumInstance) FPDFPage_SetTrimBoxWithContext(ctx goctx.Context, request *requests.FPDFPage_SetTrimBox) (*responses.FPDFPage_SetTrimBox, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_SetTrimBox", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFLink_GetAnnot(request)
-}
+		done, err := i.startCall(ctx, "FPDFPage_SetTrimBox", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFLink_GetAnnotRect(request *requests.FPDFLink_GetAnnotRect) (resp *responses.FPDFLink_GetAnnotRect, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_SetTrimBox", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetAnnotRect", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPage_SetTrimBox(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_SetTrimBox)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFLink_GetAnnotRect(request)
+func (i *pdfiumInstance) FPDFPage_TransFormWithClip(request *requests.FPDFPage_TransFormWithClip) (*responses.FPDFPage_TransFormWithClip, error) {
+	return i.FPDFPage_TransFormWithClipWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFLink_GetDest(request *requests.FPDFLink_GetDest) (resp *responses.FPDFLink_GetDest, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_TransFormWithClipWithContext(ctx goctx.Context, request *requests.FPDFPage_TransFormWithClip) (*responses.FPDFPage_TransFormWithClip, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_TransFormWithClip", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetDest", panicError)
+		done, err := i.startCall(ctx, "FPDFPage_TransFormWithClip", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFLink_GetDest(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_TransFormWithClip", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPage_TransFormWithClip(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_TransFormWithClip)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFLink_GetLinkAtPoint(request *requests.FPDFLink_GetLinkAtPoint) (resp *responses.FPDFLink_GetLinkAtPoint, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPage_TransformAnnots(request *requests.FPDFPage_TransformAnnots) (*responses.FPDFPage_TransformAnnots, error) {
+	return i.FPDFPage_TransformAnnotsWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetLinkAtPoint", panicError)
+func (i *pdfiumInstance) FPDFPage_TransformAnnotsWithContext(ctx goctx.Context, request *requests.FPDFPage_TransformAnnots) (*responses.FPDFPage_TransformAnnots, error) {
+	resp, err := i.interceptor(ctx, "FPDFPage_TransformAnnots", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFLink_GetLinkAtPoint(request)
-}
+		done, err := i.startCall(ctx, "FPDFPage_TransformAnnots", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFLink_GetLinkZOrderAtPoint(request *requests.FPDFLink_GetLinkZOrderAtPoint) (resp *responses.FPDFLink_GetLinkZOrderAtPoint, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_TransformAnnots", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetLinkZOrderAtPoint", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPage_TransformAnnots(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPage_TransformAnnots)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFLink_GetLinkZOrderAtPoint(request)
+func (i *pdfiumInstance) FPDFPathSegment_GetClose(request *requests.FPDFPathSegment_GetClose) (*responses.FPDFPathSegment_GetClose, error) {
+	return i.FPDFPathSegment_GetCloseWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFLink_GetQuadPoints(request *requests.FPDFLink_GetQuadPoints) (resp *responses.FPDFLink_GetQuadPoints, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPathSegment_GetCloseWithContext(ctx goctx.Context, request *requests.FPDFPathSegment_GetClose) (*responses.FPDFPathSegment_GetClose, error) {
+	resp, err := i.interceptor(ctx, "FPDFPathSegment_GetClose", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetQuadPoints", panicError)
+		done, err := i.startCall(ctx, "FPDFPathSegment_GetClose", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPathSegment_GetClose", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFLink_GetQuadPoints(request)
+		return i.worker.Instance.FPDFPathSegment_GetClose(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPathSegment_GetClose)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFLink_GetRect(request *requests.FPDFLink_GetRect) (resp *responses.FPDFLink_GetRect, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPathSegment_GetPoint(request *requests.FPDFPathSegment_GetPoint) (*responses.FPDFPathSegment_GetPoint, error) {
+	return i.FPDFPathSegment_GetPointWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetRect", panicError)
+func (i *pdfiumInstance) FPDFPathSegment_GetPointWithContext(ctx goctx.Context, request *requests.FPDFPathSegment_GetPoint) (*responses.FPDFPathSegment_GetPoint, error) {
+	resp, err := i.interceptor(ctx, "FPDFPathSegment_GetPoint", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFLink_GetRect(request)
-}
+		done, err := i.startCall(ctx, "FPDFPathSegment_GetPoint", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFLink_GetTextRange(request *requests.FPDFLink_GetTextRange) (resp *responses.FPDFLink_GetTextRange, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPathSegment_GetPoint", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetTextRange", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPathSegment_GetPoint(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPathSegment_GetPoint)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFLink_GetTextRange(request)
+func (i *pdfiumInstance) FPDFPathSegment_GetType(request *requests.FPDFPathSegment_GetType) (*responses.FPDFPathSegment_GetType, error) {
+	return i.FPDFPathSegment_GetTypeWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFLink_GetURL(request *requests.FPDFLink_GetURL) (resp *responses.FPDFLink_GetURL, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPathSegment_GetTypeWithContext(ctx goctx.Context, request *requests.FPDFPathSegment_GetType) (*responses.FPDFPathSegment_GetType, error) {
+	resp, err := i.interceptor(ctx, "FPDFPathSegment_GetType", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_GetURL", panicError)
+		done, err := i.startCall(ctx, "FPDFPathSegment_GetType", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPathSegment_GetType", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPathSegment_GetType(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPathSegment_GetType)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFLink_GetURL(request)
+func (i *pdfiumInstance) FPDFPath_BezierTo(request *requests.FPDFPath_BezierTo) (*responses.FPDFPath_BezierTo, error) {
+	return i.FPDFPath_BezierToWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFLink_LoadWebLinks(request *requests.FPDFLink_LoadWebLinks) (resp *responses.FPDFLink_LoadWebLinks, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPath_BezierToWithContext(ctx goctx.Context, request *requests.FPDFPath_BezierTo) (*responses.FPDFPath_BezierTo, error) {
+	resp, err := i.interceptor(ctx, "FPDFPath_BezierTo", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFLink_LoadWebLinks", panicError)
+		done, err := i.startCall(ctx, "FPDFPath_BezierTo", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFLink_LoadWebLinks(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_BezierTo", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPath_BezierTo(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPath_BezierTo)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPageObjMark_CountParams(request *requests.FPDFPageObjMark_CountParams) (resp *responses.FPDFPageObjMark_CountParams, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPath_Close(request *requests.FPDFPath_Close) (*responses.FPDFPath_Close, error) {
+	return i.FPDFPath_CloseWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_CountParams", panicError)
+func (i *pdfiumInstance) FPDFPath_CloseWithContext(ctx goctx.Context, request *requests.FPDFPath_Close) (*responses.FPDFPath_Close, error) {
+	resp, err := i.interceptor(ctx, "FPDFPath_Close", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPageObjMark_CountParams(request)
-}
+		done, err := i.startCall(ctx, "FPDFPath_Close", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPageObjMark_GetName(request *requests.FPDFPageObjMark_GetName) (resp *responses.FPDFPageObjMark_GetName, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_Close", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetName", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPath_Close(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPath_Close)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPageObjMark_GetName(request)
+func (i *pdfiumInstance) FPDFPath_CountSegments(request *requests.FPDFPath_CountSegments) (*responses.FPDFPath_CountSegments, error) {
+	return i.FPDFPath_CountSegmentsWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPageObjMark_GetParamBlobValue(request *requests.FPDFPageObjMark_GetParamBlobValue) (resp *responses.FPDFPageObjMark_GetParamBlobValue, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPath_CountSegmentsWithContext(ctx goctx.Context, request *requests.FPDFPath_CountSegments) (*responses.FPDFPath_CountSegments, error) {
+	resp, err := i.interceptor(ctx, "FPDFPath_CountSegments", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetParamBlobValue", panicError)
+		done, err := i.startCall(ctx, "FPDFPath_CountSegments", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_CountSegments", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFPageObjMark_GetParamBlobValue(request)
+		return i.worker.Instance.FPDFPath_CountSegments(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPath_CountSegments)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPageObjMark_GetParamFloatValue(request *requests.FPDFPageObjMark_GetParamFloatValue) (resp *responses.FPDFPageObjMark_GetParamFloatValue, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPath_GetDrawMode(request *requests.FPDFPath_GetDrawMode) (*responses.FPDFPath_GetDrawMode, error) {
+	return i.FPDFPath_GetDrawModeWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetParamFloatValue", panicError)
+func (i *pdfiumInstance) FPDFPath_GetDrawModeWithContext(ctx goctx.Context, request *requests.FPDFPath_GetDrawMode) (*responses.FPDFPath_GetDrawMode, error) {
+	resp, err := i.interceptor(ctx, "FPDFPath_GetDrawMode", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPageObjMark_GetParamFloatValue(request)
-}
+		done, err := i.startCall(ctx, "FPDFPath_GetDrawMode", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPageObjMark_GetParamIntValue(request *requests.FPDFPageObjMark_GetParamIntValue) (resp *responses.FPDFPageObjMark_GetParamIntValue, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_GetDrawMode", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetParamIntValue", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPath_GetDrawMode(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPath_GetDrawMode)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPageObjMark_GetParamIntValue(request)
+func (i *pdfiumInstance) FPDFPath_GetPathSegment(request *requests.FPDFPath_GetPathSegment) (*responses.FPDFPath_GetPathSegment, error) {
+	return i.FPDFPath_GetPathSegmentWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPageObjMark_GetParamKey(request *requests.FPDFPageObjMark_GetParamKey) (resp *responses.FPDFPageObjMark_GetParamKey, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPath_GetPathSegmentWithContext(ctx goctx.Context, request *requests.FPDFPath_GetPathSegment) (*responses.FPDFPath_GetPathSegment, error) {
+	resp, err := i.interceptor(ctx, "FPDFPath_GetPathSegment", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetParamKey", panicError)
+		done, err := i.startCall(ctx, "FPDFPath_GetPathSegment", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFPageObjMark_GetParamKey(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_GetPathSegment", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFPath_GetPathSegment(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPath_GetPathSegment)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPageObjMark_GetParamStringValue(request *requests.FPDFPageObjMark_GetParamStringValue) (resp *responses.FPDFPageObjMark_GetParamStringValue, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPath_LineTo(request *requests.FPDFPath_LineTo) (*responses.FPDFPath_LineTo, error) {
+	return i.FPDFPath_LineToWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetParamStringValue", panicError)
+func (i *pdfiumInstance) FPDFPath_LineToWithContext(ctx goctx.Context, request *requests.FPDFPath_LineTo) (*responses.FPDFPath_LineTo, error) {
+	resp, err := i.interceptor(ctx, "FPDFPath_LineTo", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPageObjMark_GetParamStringValue(request)
-}
+		done, err := i.startCall(ctx, "FPDFPath_LineTo", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPageObjMark_GetParamValueType(request *requests.FPDFPageObjMark_GetParamValueType) (resp *responses.FPDFPageObjMark_GetParamValueType, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_LineTo", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_GetParamValueType", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPath_LineTo(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPath_LineTo)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPageObjMark_GetParamValueType(request)
+func (i *pdfiumInstance) FPDFPath_MoveTo(request *requests.FPDFPath_MoveTo) (*responses.FPDFPath_MoveTo, error) {
+	return i.FPDFPath_MoveToWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPageObjMark_RemoveParam(request *requests.FPDFPageObjMark_RemoveParam) (resp *responses.FPDFPageObjMark_RemoveParam, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPath_MoveToWithContext(ctx goctx.Context, request *requests.FPDFPath_MoveTo) (*responses.FPDFPath_MoveTo, error) {
+	resp, err := i.interceptor(ctx, "FPDFPath_MoveTo", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_RemoveParam", panicError)
+		done, err := i.startCall(ctx, "FPDFPath_MoveTo", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_MoveTo", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFPageObjMark_RemoveParam(request)
+		return i.worker.Instance.FPDFPath_MoveTo(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPath_MoveTo)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPageObjMark_SetBlobParam(request *requests.FPDFPageObjMark_SetBlobParam) (resp *responses.FPDFPageObjMark_SetBlobParam, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFPath_SetDrawMode(request *requests.FPDFPath_SetDrawMode) (*responses.FPDFPath_SetDrawMode, error) {
+	return i.FPDFPath_SetDrawModeWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_SetBlobParam", panicError)
+func (i *pdfiumInstance) FPDFPath_SetDrawModeWithContext(ctx goctx.Context, request *requests.FPDFPath_SetDrawMode) (*responses.FPDFPath_SetDrawMode, error) {
+	resp, err := i.interceptor(ctx, "FPDFPath_SetDrawMode", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPageObjMark_SetBlobParam(request)
-}
+		done, err := i.startCall(ctx, "FPDFPath_SetDrawMode", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPageObjMark_SetFloatParam(request *requests.FPDFPageObjMark_SetFloatParam) (resp *responses.FPDFPageObjMark_SetFloatParam, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_SetDrawMode", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_SetFloatParam", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFPath_SetDrawMode(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFPath_SetDrawMode)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPageObjMark_SetFloatParam(request)
+func (i *pdfiumInstance) FPDFSignatureObj_GetByteRange(request *requests.FPDFSignatureObj_GetByteRange) (*responses.FPDFSignatureObj_GetByteRange, error) {
+	return i.FPDFSignatureObj_GetByteRangeWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPageObjMark_SetIntParam(request *requests.FPDFPageObjMark_SetIntParam) (resp *responses.FPDFPageObjMark_SetIntParam, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFSignatureObj_GetByteRangeWithContext(ctx goctx.Context, request *requests.FPDFSignatureObj_GetByteRange) (*responses.FPDFSignatureObj_GetByteRange, error) {
+	resp, err := i.interceptor(ctx, "FPDFSignatureObj_GetByteRange", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_SetIntParam", panicError)
+		done, err := i.startCall(ctx, "FPDFSignatureObj_GetByteRange", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFPageObjMark_SetIntParam(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFSignatureObj_GetByteRange", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFSignatureObj_GetByteRange(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFSignatureObj_GetByteRange)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPageObjMark_SetStringParam(request *requests.FPDFPageObjMark_SetStringParam) (resp *responses.FPDFPageObjMark_SetStringParam, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFSignatureObj_GetContents(request *requests.FPDFSignatureObj_GetContents) (*responses.FPDFSignatureObj_GetContents, error) {
+	return i.FPDFSignatureObj_GetContentsWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObjMark_SetStringParam", panicError)
+func (i *pdfiumInstance) FPDFSignatureObj_GetContentsWithContext(ctx goctx.Context, request *requests.FPDFSignatureObj_GetContents) (*responses.FPDFSignatureObj_GetContents, error) {
+	resp, err := i.interceptor(ctx, "FPDFSignatureObj_GetContents", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPageObjMark_SetStringParam(request)
-}
+		done, err := i.startCall(ctx, "FPDFSignatureObj_GetContents", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPageObj_AddExistingMark(request *requests.FPDFPageObj_AddExistingMark) (resp *responses.FPDFPageObj_AddExistingMark, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFSignatureObj_GetContents", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_AddExistingMark", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFSignatureObj_GetContents(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFSignatureObj_GetContents)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPageObj_AddExistingMark(request)
+func (i *pdfiumInstance) FPDFSignatureObj_GetDocMDPPermission(request *requests.FPDFSignatureObj_GetDocMDPPermission) (*responses.FPDFSignatureObj_GetDocMDPPermission, error) {
+	return i.FPDFSignatureObj_GetDocMDPPermissionWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPageObj_AddMark(request *requests.FPDFPageObj_AddMark) (resp *responses.FPDFPageObj_AddMark, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFSignatureObj_GetDocMDPPermissionWithContext(ctx goctx.Context, request *requests.FPDFSignatureObj_GetDocMDPPermission) (*responses.FPDFSignatureObj_GetDocMDPPermission, error) {
+	resp, err := i.interceptor(ctx, "FPDFSignatureObj_GetDocMDPPermission", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_AddMark", panicError)
+		done, err := i.startCall(ctx, "FPDFSignatureObj_GetDocMDPPermission", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFSignatureObj_GetDocMDPPermission", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFPageObj_AddMark(request)
+		return i.worker.Instance.FPDFSignatureObj_GetDocMDPPermission(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFSignatureObj_GetDocMDPPermission)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPageObj_CountMarks(request *requests.FPDFPageObj_CountMarks) (resp *responses.FPDFPageObj_CountMarks, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFSignatureObj_GetReason(request *requests.FPDFSignatureObj_GetReason) (*responses.FPDFSignatureObj_GetReason, error) {
+	return i.FPDFSignatureObj_GetReasonWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_CountMarks", panicError)
+func (i *pdfiumInstance) FPDFSignatureObj_GetReasonWithContext(ctx goctx.Context, request *requests.FPDFSignatureObj_GetReason) (*responses.FPDFSignatureObj_GetReason, error) {
+	resp, err := i.interceptor(ctx, "FPDFSignatureObj_GetReason", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPageObj_CountMarks(request)
-}
+		done, err := i.startCall(ctx, "FPDFSignatureObj_GetReason", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPageObj_CreateNewPath(request *requests.FPDFPageObj_CreateNewPath) (resp *responses.FPDFPageObj_CreateNewPath, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFSignatureObj_GetReason", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_CreateNewPath", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFSignatureObj_GetReason(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFSignatureObj_GetReason)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPageObj_CreateNewPath(request)
+func (i *pdfiumInstance) FPDFSignatureObj_GetSubFilter(request *requests.FPDFSignatureObj_GetSubFilter) (*responses.FPDFSignatureObj_GetSubFilter, error) {
+	return i.FPDFSignatureObj_GetSubFilterWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPageObj_CreateNewRect(request *requests.FPDFPageObj_CreateNewRect) (resp *responses.FPDFPageObj_CreateNewRect, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFSignatureObj_GetSubFilterWithContext(ctx goctx.Context, request *requests.FPDFSignatureObj_GetSubFilter) (*responses.FPDFSignatureObj_GetSubFilter, error) {
+	resp, err := i.interceptor(ctx, "FPDFSignatureObj_GetSubFilter", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_CreateNewRect", panicError)
+		done, err := i.startCall(ctx, "FPDFSignatureObj_GetSubFilter", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFPageObj_CreateNewRect(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFSignatureObj_GetSubFilter", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFSignatureObj_GetSubFilter(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFSignatureObj_GetSubFilter)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPageObj_CreateTextObj(request *requests.FPDFPageObj_CreateTextObj) (resp *responses.FPDFPageObj_CreateTextObj, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFSignatureObj_GetTime(request *requests.FPDFSignatureObj_GetTime) (*responses.FPDFSignatureObj_GetTime, error) {
+	return i.FPDFSignatureObj_GetTimeWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_CreateTextObj", panicError)
+func (i *pdfiumInstance) FPDFSignatureObj_GetTimeWithContext(ctx goctx.Context, request *requests.FPDFSignatureObj_GetTime) (*responses.FPDFSignatureObj_GetTime, error) {
+	resp, err := i.interceptor(ctx, "FPDFSignatureObj_GetTime", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPageObj_CreateTextObj(request)
-}
+		done, err := i.startCall(ctx, "FPDFSignatureObj_GetTime", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPageObj_Destroy(request *requests.FPDFPageObj_Destroy) (resp *responses.FPDFPageObj_Destroy, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFSignatureObj_GetTime", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_Destroy", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFSignatureObj_GetTime(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFSignatureObj_GetTime)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPageObj_Destroy(request)
+func (i *pdfiumInstance) FPDFTextObj_GetFont(request *requests.FPDFTextObj_GetFont) (*responses.FPDFTextObj_GetFont, error) {
+	return i.FPDFTextObj_GetFontWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPageObj_GetBounds(request *requests.FPDFPageObj_GetBounds) (resp *responses.FPDFPageObj_GetBounds, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFTextObj_GetFontWithContext(ctx goctx.Context, request *requests.FPDFTextObj_GetFont) (*responses.FPDFTextObj_GetFont, error) {
+	resp, err := i.interceptor(ctx, "FPDFTextObj_GetFont", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetBounds", panicError)
+		done, err := i.startCall(ctx, "FPDFTextObj_GetFont", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_GetFont", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFPageObj_GetBounds(request)
+		return i.worker.Instance.FPDFTextObj_GetFont(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFTextObj_GetFont)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPageObj_GetClipPath(request *requests.FPDFPageObj_GetClipPath) (resp *responses.FPDFPageObj_GetClipPath, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFTextObj_GetFontSize(request *requests.FPDFTextObj_GetFontSize) (*responses.FPDFTextObj_GetFontSize, error) {
+	return i.FPDFTextObj_GetFontSizeWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetClipPath", panicError)
+func (i *pdfiumInstance) FPDFTextObj_GetFontSizeWithContext(ctx goctx.Context, request *requests.FPDFTextObj_GetFontSize) (*responses.FPDFTextObj_GetFontSize, error) {
+	resp, err := i.interceptor(ctx, "FPDFTextObj_GetFontSize", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPageObj_GetClipPath(request)
-}
+		done, err := i.startCall(ctx, "FPDFTextObj_GetFontSize", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPageObj_GetDashArray(request *requests.FPDFPageObj_GetDashArray) (resp *responses.FPDFPageObj_GetDashArray, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_GetFontSize", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetDashArray", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFTextObj_GetFontSize(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFTextObj_GetFontSize)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPageObj_GetDashArray(request)
+func (i *pdfiumInstance) FPDFTextObj_GetRenderedBitmap(request *requests.FPDFTextObj_GetRenderedBitmap) (*responses.FPDFTextObj_GetRenderedBitmap, error) {
+	return i.FPDFTextObj_GetRenderedBitmapWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPageObj_GetDashCount(request *requests.FPDFPageObj_GetDashCount) (resp *responses.FPDFPageObj_GetDashCount, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFTextObj_GetRenderedBitmapWithContext(ctx goctx.Context, request *requests.FPDFTextObj_GetRenderedBitmap) (*responses.FPDFTextObj_GetRenderedBitmap, error) {
+	resp, err := i.interceptor(ctx, "FPDFTextObj_GetRenderedBitmap", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetDashCount", panicError)
+		done, err := i.startCall(ctx, "FPDFTextObj_GetRenderedBitmap", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFPageObj_GetDashCount(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_GetRenderedBitmap", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFTextObj_GetRenderedBitmap(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFTextObj_GetRenderedBitmap)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPageObj_GetDashPhase(request *requests.FPDFPageObj_GetDashPhase) (resp *responses.FPDFPageObj_GetDashPhase, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFTextObj_GetText(request *requests.FPDFTextObj_GetText) (*responses.FPDFTextObj_GetText, error) {
+	return i.FPDFTextObj_GetTextWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetDashPhase", panicError)
+func (i *pdfiumInstance) FPDFTextObj_GetTextWithContext(ctx goctx.Context, request *requests.FPDFTextObj_GetText) (*responses.FPDFTextObj_GetText, error) {
+	resp, err := i.interceptor(ctx, "FPDFTextObj_GetText", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPageObj_GetDashPhase(request)
-}
+		done, err := i.startCall(ctx, "FPDFTextObj_GetText", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPageObj_GetFillColor(request *requests.FPDFPageObj_GetFillColor) (resp *responses.FPDFPageObj_GetFillColor, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_GetText", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetFillColor", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFTextObj_GetText(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFTextObj_GetText)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPageObj_GetFillColor(request)
+func (i *pdfiumInstance) FPDFTextObj_GetTextRenderMode(request *requests.FPDFTextObj_GetTextRenderMode) (*responses.FPDFTextObj_GetTextRenderMode, error) {
+	return i.FPDFTextObj_GetTextRenderModeWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPageObj_GetIsActive(request *requests.FPDFPageObj_GetIsActive) (resp *responses.FPDFPageObj_GetIsActive, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFTextObj_GetTextRenderModeWithContext(ctx goctx.Context, request *requests.FPDFTextObj_GetTextRenderMode) (*responses.FPDFTextObj_GetTextRenderMode, error) {
+	resp, err := i.interceptor(ctx, "FPDFTextObj_GetTextRenderMode", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetIsActive", panicError)
+		done, err := i.startCall(ctx, "FPDFTextObj_GetTextRenderMode", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_GetTextRenderMode", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFPageObj_GetIsActive(request)
+		return i.worker.Instance.FPDFTextObj_GetTextRenderMode(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFTextObj_GetTextRenderMode)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPageObj_GetLineCap(request *requests.FPDFPageObj_GetLineCap) (resp *responses.FPDFPageObj_GetLineCap, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFTextObj_SetFontSize(request *requests.FPDFTextObj_SetFontSize) (*responses.FPDFTextObj_SetFontSize, error) {
+	return i.FPDFTextObj_SetFontSizeWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetLineCap", panicError)
+func (i *pdfiumInstance) FPDFTextObj_SetFontSizeWithContext(ctx goctx.Context, request *requests.FPDFTextObj_SetFontSize) (*responses.FPDFTextObj_SetFontSize, error) {
+	resp, err := i.interceptor(ctx, "FPDFTextObj_SetFontSize", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPageObj_GetLineCap(request)
-}
+		done, err := i.startCall(ctx, "FPDFTextObj_SetFontSize", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPageObj_GetLineJoin(request *requests.FPDFPageObj_GetLineJoin) (resp *responses.FPDFPageObj_GetLineJoin, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_SetFontSize", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetLineJoin", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFTextObj_SetFontSize(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFTextObj_SetFontSize)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPageObj_GetLineJoin(request)
+func (i *pdfiumInstance) FPDFTextObj_SetTextRenderMode(request *requests.FPDFTextObj_SetTextRenderMode) (*responses.FPDFTextObj_SetTextRenderMode, error) {
+	return i.FPDFTextObj_SetTextRenderModeWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPageObj_GetMark(request *requests.FPDFPageObj_GetMark) (resp *responses.FPDFPageObj_GetMark, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFTextObj_SetTextRenderModeWithContext(ctx goctx.Context, request *requests.FPDFTextObj_SetTextRenderMode) (*responses.FPDFTextObj_SetTextRenderMode, error) {
+	resp, err := i.interceptor(ctx, "FPDFTextObj_SetTextRenderMode", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetMark", panicError)
+		done, err := i.startCall(ctx, "FPDFTextObj_SetTextRenderMode", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFPageObj_GetMark(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_SetTextRenderMode", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFTextObj_SetTextRenderMode(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFTextObj_SetTextRenderMode)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPageObj_GetMarkedContentID(request *requests.FPDFPageObj_GetMarkedContentID) (resp *responses.FPDFPageObj_GetMarkedContentID, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_ClosePage(request *requests.FPDFText_ClosePage) (*responses.FPDFText_ClosePage, error) {
+	return i.FPDFText_ClosePageWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetMarkedContentID", panicError)
+func (i *pdfiumInstance) FPDFText_ClosePageWithContext(ctx goctx.Context, request *requests.FPDFText_ClosePage) (*responses.FPDFText_ClosePage, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_ClosePage", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPageObj_GetMarkedContentID(request)
-}
+		done, err := i.startCall(ctx, "FPDFText_ClosePage", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPageObj_GetMatrix(request *requests.FPDFPageObj_GetMatrix) (resp *responses.FPDFPageObj_GetMatrix, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_ClosePage", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetMatrix", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFText_ClosePage(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_ClosePage)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPageObj_GetMatrix(request)
+func (i *pdfiumInstance) FPDFText_CountChars(request *requests.FPDFText_CountChars) (*responses.FPDFText_CountChars, error) {
+	return i.FPDFText_CountCharsWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPageObj_GetRotatedBounds(request *requests.FPDFPageObj_GetRotatedBounds) (resp *responses.FPDFPageObj_GetRotatedBounds, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_CountCharsWithContext(ctx goctx.Context, request *requests.FPDFText_CountChars) (*responses.FPDFText_CountChars, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_CountChars", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetRotatedBounds", panicError)
+		done, err := i.startCall(ctx, "FPDFText_CountChars", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_CountChars", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFPageObj_GetRotatedBounds(request)
+		return i.worker.Instance.FPDFText_CountChars(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_CountChars)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPageObj_GetStrokeColor(request *requests.FPDFPageObj_GetStrokeColor) (resp *responses.FPDFPageObj_GetStrokeColor, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_CountRects(request *requests.FPDFText_CountRects) (*responses.FPDFText_CountRects, error) {
+	return i.FPDFText_CountRectsWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetStrokeColor", panicError)
+func (i *pdfiumInstance) FPDFText_CountRectsWithContext(ctx goctx.Context, request *requests.FPDFText_CountRects) (*responses.FPDFText_CountRects, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_CountRects", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPageObj_GetStrokeColor(request)
-}
+		done, err := i.startCall(ctx, "FPDFText_CountRects", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPageObj_GetStrokeWidth(request *requests.FPDFPageObj_GetStrokeWidth) (resp *responses.FPDFPageObj_GetStrokeWidth, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_CountRects", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetStrokeWidth", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFText_CountRects(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_CountRects)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPageObj_GetStrokeWidth(request)
+func (i *pdfiumInstance) FPDFText_FindClose(request *requests.FPDFText_FindClose) (*responses.FPDFText_FindClose, error) {
+	return i.FPDFText_FindCloseWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPageObj_GetType(request *requests.FPDFPageObj_GetType) (resp *responses.FPDFPageObj_GetType, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_FindCloseWithContext(ctx goctx.Context, request *requests.FPDFText_FindClose) (*responses.FPDFText_FindClose, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_FindClose", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_GetType", panicError)
+		done, err := i.startCall(ctx, "FPDFText_FindClose", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFPageObj_GetType(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_FindClose", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFText_FindClose(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_FindClose)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPageObj_HasTransparency(request *requests.FPDFPageObj_HasTransparency) (resp *responses.FPDFPageObj_HasTransparency, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_FindNext(request *requests.FPDFText_FindNext) (*responses.FPDFText_FindNext, error) {
+	return i.FPDFText_FindNextWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_HasTransparency", panicError)
+func (i *pdfiumInstance) FPDFText_FindNextWithContext(ctx goctx.Context, request *requests.FPDFText_FindNext) (*responses.FPDFText_FindNext, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_FindNext", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPageObj_HasTransparency(request)
-}
+		done, err := i.startCall(ctx, "FPDFText_FindNext", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPageObj_NewImageObj(request *requests.FPDFPageObj_NewImageObj) (resp *responses.FPDFPageObj_NewImageObj, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_FindNext", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_NewImageObj", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFText_FindNext(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_FindNext)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPageObj_NewImageObj(request)
+func (i *pdfiumInstance) FPDFText_FindPrev(request *requests.FPDFText_FindPrev) (*responses.FPDFText_FindPrev, error) {
+	return i.FPDFText_FindPrevWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPageObj_NewTextObj(request *requests.FPDFPageObj_NewTextObj) (resp *responses.FPDFPageObj_NewTextObj, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_FindPrevWithContext(ctx goctx.Context, request *requests.FPDFText_FindPrev) (*responses.FPDFText_FindPrev, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_FindPrev", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_NewTextObj", panicError)
+		done, err := i.startCall(ctx, "FPDFText_FindPrev", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_FindPrev", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFPageObj_NewTextObj(request)
+		return i.worker.Instance.FPDFText_FindPrev(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_FindPrev)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPageObj_RemoveMark(request *requests.FPDFPageObj_RemoveMark) (resp *responses.FPDFPageObj_RemoveMark, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_FindStart(request *requests.FPDFText_FindStart) (*responses.FPDFText_FindStart, error) {
+	return i.FPDFText_FindStartWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_RemoveMark", panicError)
+func (i *pdfiumInstance) FPDFText_FindStartWithContext(ctx goctx.Context, request *requests.FPDFText_FindStart) (*responses.FPDFText_FindStart, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_FindStart", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPageObj_RemoveMark(request)
-}
+		done, err := i.startCall(ctx, "FPDFText_FindStart", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPageObj_SetBlendMode(request *requests.FPDFPageObj_SetBlendMode) (resp *responses.FPDFPageObj_SetBlendMode, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_FindStart", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetBlendMode", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFText_FindStart(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_FindStart)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPageObj_SetBlendMode(request)
+func (i *pdfiumInstance) FPDFText_GetBoundedText(request *requests.FPDFText_GetBoundedText) (*responses.FPDFText_GetBoundedText, error) {
+	return i.FPDFText_GetBoundedTextWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPageObj_SetDashArray(request *requests.FPDFPageObj_SetDashArray) (resp *responses.FPDFPageObj_SetDashArray, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetBoundedTextWithContext(ctx goctx.Context, request *requests.FPDFText_GetBoundedText) (*responses.FPDFText_GetBoundedText, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetBoundedText", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetDashArray", panicError)
+		done, err := i.startCall(ctx, "FPDFText_GetBoundedText", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFPageObj_SetDashArray(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetBoundedText", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFText_GetBoundedText(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetBoundedText)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPageObj_SetDashPhase(request *requests.FPDFPageObj_SetDashPhase) (resp *responses.FPDFPageObj_SetDashPhase, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetCharAngle(request *requests.FPDFText_GetCharAngle) (*responses.FPDFText_GetCharAngle, error) {
+	return i.FPDFText_GetCharAngleWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetDashPhase", panicError)
+func (i *pdfiumInstance) FPDFText_GetCharAngleWithContext(ctx goctx.Context, request *requests.FPDFText_GetCharAngle) (*responses.FPDFText_GetCharAngle, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetCharAngle", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPageObj_SetDashPhase(request)
-}
+		done, err := i.startCall(ctx, "FPDFText_GetCharAngle", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPageObj_SetFillColor(request *requests.FPDFPageObj_SetFillColor) (resp *responses.FPDFPageObj_SetFillColor, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetCharAngle", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetFillColor", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFText_GetCharAngle(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetCharAngle)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPageObj_SetFillColor(request)
+func (i *pdfiumInstance) FPDFText_GetCharBox(request *requests.FPDFText_GetCharBox) (*responses.FPDFText_GetCharBox, error) {
+	return i.FPDFText_GetCharBoxWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPageObj_SetIsActive(request *requests.FPDFPageObj_SetIsActive) (resp *responses.FPDFPageObj_SetIsActive, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetCharBoxWithContext(ctx goctx.Context, request *requests.FPDFText_GetCharBox) (*responses.FPDFText_GetCharBox, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetCharBox", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetIsActive", panicError)
+		done, err := i.startCall(ctx, "FPDFText_GetCharBox", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetCharBox", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFPageObj_SetIsActive(request)
+		return i.worker.Instance.FPDFText_GetCharBox(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetCharBox)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPageObj_SetLineCap(request *requests.FPDFPageObj_SetLineCap) (resp *responses.FPDFPageObj_SetLineCap, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetCharIndexAtPos(request *requests.FPDFText_GetCharIndexAtPos) (*responses.FPDFText_GetCharIndexAtPos, error) {
+	return i.FPDFText_GetCharIndexAtPosWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetLineCap", panicError)
+func (i *pdfiumInstance) FPDFText_GetCharIndexAtPosWithContext(ctx goctx.Context, request *requests.FPDFText_GetCharIndexAtPos) (*responses.FPDFText_GetCharIndexAtPos, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetCharIndexAtPos", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPageObj_SetLineCap(request)
-}
+		done, err := i.startCall(ctx, "FPDFText_GetCharIndexAtPos", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPageObj_SetLineJoin(request *requests.FPDFPageObj_SetLineJoin) (resp *responses.FPDFPageObj_SetLineJoin, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetCharIndexAtPos", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetLineJoin", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFText_GetCharIndexAtPos(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetCharIndexAtPos)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPageObj_SetLineJoin(request)
+func (i *pdfiumInstance) FPDFText_GetCharIndexFromTextIndex(request *requests.FPDFText_GetCharIndexFromTextIndex) (*responses.FPDFText_GetCharIndexFromTextIndex, error) {
+	return i.FPDFText_GetCharIndexFromTextIndexWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPageObj_SetMatrix(request *requests.FPDFPageObj_SetMatrix) (resp *responses.FPDFPageObj_SetMatrix, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetCharIndexFromTextIndexWithContext(ctx goctx.Context, request *requests.FPDFText_GetCharIndexFromTextIndex) (*responses.FPDFText_GetCharIndexFromTextIndex, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetCharIndexFromTextIndex", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetMatrix", panicError)
+		done, err := i.startCall(ctx, "FPDFText_GetCharIndexFromTextIndex", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFPageObj_SetMatrix(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetCharIndexFromTextIndex", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFText_GetCharIndexFromTextIndex(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetCharIndexFromTextIndex)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPageObj_SetStrokeColor(request *requests.FPDFPageObj_SetStrokeColor) (resp *responses.FPDFPageObj_SetStrokeColor, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetCharOrigin(request *requests.FPDFText_GetCharOrigin) (*responses.FPDFText_GetCharOrigin, error) {
+	return i.FPDFText_GetCharOriginWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetStrokeColor", panicError)
+func (i *pdfiumInstance) FPDFText_GetCharOriginWithContext(ctx goctx.Context, request *requests.FPDFText_GetCharOrigin) (*responses.FPDFText_GetCharOrigin, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetCharOrigin", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPageObj_SetStrokeColor(request)
-}
+		done, err := i.startCall(ctx, "FPDFText_GetCharOrigin", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPageObj_SetStrokeWidth(request *requests.FPDFPageObj_SetStrokeWidth) (resp *responses.FPDFPageObj_SetStrokeWidth, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetCharOrigin", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_SetStrokeWidth", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFText_GetCharOrigin(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetCharOrigin)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPageObj_SetStrokeWidth(request)
+func (i *pdfiumInstance) FPDFText_GetFillColor(request *requests.FPDFText_GetFillColor) (*responses.FPDFText_GetFillColor, error) {
+	return i.FPDFText_GetFillColorWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPageObj_Transform(request *requests.FPDFPageObj_Transform) (resp *responses.FPDFPageObj_Transform, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetFillColorWithContext(ctx goctx.Context, request *requests.FPDFText_GetFillColor) (*responses.FPDFText_GetFillColor, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetFillColor", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_Transform", panicError)
+		done, err := i.startCall(ctx, "FPDFText_GetFillColor", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetFillColor", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFPageObj_Transform(request)
+		return i.worker.Instance.FPDFText_GetFillColor(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetFillColor)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPageObj_TransformClipPath(request *requests.FPDFPageObj_TransformClipPath) (resp *responses.FPDFPageObj_TransformClipPath, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetFontInfo(request *requests.FPDFText_GetFontInfo) (*responses.FPDFText_GetFontInfo, error) {
+	return i.FPDFText_GetFontInfoWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_TransformClipPath", panicError)
+func (i *pdfiumInstance) FPDFText_GetFontInfoWithContext(ctx goctx.Context, request *requests.FPDFText_GetFontInfo) (*responses.FPDFText_GetFontInfo, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetFontInfo", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPageObj_TransformClipPath(request)
-}
+		done, err := i.startCall(ctx, "FPDFText_GetFontInfo", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPageObj_TransformF(request *requests.FPDFPageObj_TransformF) (resp *responses.FPDFPageObj_TransformF, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetFontInfo", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPageObj_TransformF", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFText_GetFontInfo(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetFontInfo)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPageObj_TransformF(request)
+func (i *pdfiumInstance) FPDFText_GetFontSize(request *requests.FPDFText_GetFontSize) (*responses.FPDFText_GetFontSize, error) {
+	return i.FPDFText_GetFontSizeWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPage_CloseAnnot(request *requests.FPDFPage_CloseAnnot) (resp *responses.FPDFPage_CloseAnnot, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetFontSizeWithContext(ctx goctx.Context, request *requests.FPDFText_GetFontSize) (*responses.FPDFText_GetFontSize, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetFontSize", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_CloseAnnot", panicError)
+		done, err := i.startCall(ctx, "FPDFText_GetFontSize", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFPage_CloseAnnot(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetFontSize", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFText_GetFontSize(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetFontSize)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPage_CountObjects(request *requests.FPDFPage_CountObjects) (resp *responses.FPDFPage_CountObjects, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetFontWeight(request *requests.FPDFText_GetFontWeight) (*responses.FPDFText_GetFontWeight, error) {
+	return i.FPDFText_GetFontWeightWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_CountObjects", panicError)
+func (i *pdfiumInstance) FPDFText_GetFontWeightWithContext(ctx goctx.Context, request *requests.FPDFText_GetFontWeight) (*responses.FPDFText_GetFontWeight, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetFontWeight", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPage_CountObjects(request)
-}
+		done, err := i.startCall(ctx, "FPDFText_GetFontWeight", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPage_CreateAnnot(request *requests.FPDFPage_CreateAnnot) (resp *responses.FPDFPage_CreateAnnot, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetFontWeight", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_CreateAnnot", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFText_GetFontWeight(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetFontWeight)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPage_CreateAnnot(request)
+func (i *pdfiumInstance) FPDFText_GetLooseCharBox(request *requests.FPDFText_GetLooseCharBox) (*responses.FPDFText_GetLooseCharBox, error) {
+	return i.FPDFText_GetLooseCharBoxWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPage_Delete(request *requests.FPDFPage_Delete) (resp *responses.FPDFPage_Delete, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetLooseCharBoxWithContext(ctx goctx.Context, request *requests.FPDFText_GetLooseCharBox) (*responses.FPDFText_GetLooseCharBox, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetLooseCharBox", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_Delete", panicError)
+		done, err := i.startCall(ctx, "FPDFText_GetLooseCharBox", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetLooseCharBox", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFPage_Delete(request)
+		return i.worker.Instance.FPDFText_GetLooseCharBox(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetLooseCharBox)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPage_Flatten(request *requests.FPDFPage_Flatten) (resp *responses.FPDFPage_Flatten, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetMatrix(request *requests.FPDFText_GetMatrix) (*responses.FPDFText_GetMatrix, error) {
+	return i.FPDFText_GetMatrixWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_Flatten", panicError)
+func (i *pdfiumInstance) FPDFText_GetMatrixWithContext(ctx goctx.Context, request *requests.FPDFText_GetMatrix) (*responses.FPDFText_GetMatrix, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetMatrix", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPage_Flatten(request)
-}
+		done, err := i.startCall(ctx, "FPDFText_GetMatrix", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPage_FormFieldZOrderAtPoint(request *requests.FPDFPage_FormFieldZOrderAtPoint) (resp *responses.FPDFPage_FormFieldZOrderAtPoint, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetMatrix", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_FormFieldZOrderAtPoint", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFText_GetMatrix(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetMatrix)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPage_FormFieldZOrderAtPoint(request)
+func (i *pdfiumInstance) FPDFText_GetRect(request *requests.FPDFText_GetRect) (*responses.FPDFText_GetRect, error) {
+	return i.FPDFText_GetRectWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPage_GenerateContent(request *requests.FPDFPage_GenerateContent) (resp *responses.FPDFPage_GenerateContent, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetRectWithContext(ctx goctx.Context, request *requests.FPDFText_GetRect) (*responses.FPDFText_GetRect, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetRect", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GenerateContent", panicError)
+		done, err := i.startCall(ctx, "FPDFText_GetRect", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFPage_GenerateContent(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetRect", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFText_GetRect(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetRect)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPage_GetAnnot(request *requests.FPDFPage_GetAnnot) (resp *responses.FPDFPage_GetAnnot, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetSchCount(request *requests.FPDFText_GetSchCount) (*responses.FPDFText_GetSchCount, error) {
+	return i.FPDFText_GetSchCountWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetAnnot", panicError)
+func (i *pdfiumInstance) FPDFText_GetSchCountWithContext(ctx goctx.Context, request *requests.FPDFText_GetSchCount) (*responses.FPDFText_GetSchCount, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetSchCount", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPage_GetAnnot(request)
-}
+		done, err := i.startCall(ctx, "FPDFText_GetSchCount", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPage_GetAnnotCount(request *requests.FPDFPage_GetAnnotCount) (resp *responses.FPDFPage_GetAnnotCount, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetSchCount", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetAnnotCount", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFText_GetSchCount(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetSchCount)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPage_GetAnnotCount(request)
+func (i *pdfiumInstance) FPDFText_GetSchResultIndex(request *requests.FPDFText_GetSchResultIndex) (*responses.FPDFText_GetSchResultIndex, error) {
+	return i.FPDFText_GetSchResultIndexWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPage_GetAnnotIndex(request *requests.FPDFPage_GetAnnotIndex) (resp *responses.FPDFPage_GetAnnotIndex, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetSchResultIndexWithContext(ctx goctx.Context, request *requests.FPDFText_GetSchResultIndex) (*responses.FPDFText_GetSchResultIndex, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetSchResultIndex", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetAnnotIndex", panicError)
+		done, err := i.startCall(ctx, "FPDFText_GetSchResultIndex", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetSchResultIndex", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFPage_GetAnnotIndex(request)
+		return i.worker.Instance.FPDFText_GetSchResultIndex(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetSchResultIndex)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPage_GetArtBox(request *requests.FPDFPage_GetArtBox) (resp *responses.FPDFPage_GetArtBox, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetStrokeColor(request *requests.FPDFText_GetStrokeColor) (*responses.FPDFText_GetStrokeColor, error) {
+	return i.FPDFText_GetStrokeColorWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetArtBox", panicError)
+func (i *pdfiumInstance) FPDFText_GetStrokeColorWithContext(ctx goctx.Context, request *requests.FPDFText_GetStrokeColor) (*responses.FPDFText_GetStrokeColor, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetStrokeColor", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPage_GetArtBox(request)
-}
+		done, err := i.startCall(ctx, "FPDFText_GetStrokeColor", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPage_GetBleedBox(request *requests.FPDFPage_GetBleedBox) (resp *responses.FPDFPage_GetBleedBox, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetStrokeColor", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetBleedBox", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFText_GetStrokeColor(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetStrokeColor)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPage_GetBleedBox(request)
+func (i *pdfiumInstance) FPDFText_GetText(request *requests.FPDFText_GetText) (*responses.FPDFText_GetText, error) {
+	return i.FPDFText_GetTextWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPage_GetCropBox(request *requests.FPDFPage_GetCropBox) (resp *responses.FPDFPage_GetCropBox, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetTextWithContext(ctx goctx.Context, request *requests.FPDFText_GetText) (*responses.FPDFText_GetText, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetText", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetCropBox", panicError)
+		done, err := i.startCall(ctx, "FPDFText_GetText", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFPage_GetCropBox(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetText", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFText_GetText(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetText)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPage_GetDecodedThumbnailData(request *requests.FPDFPage_GetDecodedThumbnailData) (resp *responses.FPDFPage_GetDecodedThumbnailData, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetTextIndexFromCharIndex(request *requests.FPDFText_GetTextIndexFromCharIndex) (*responses.FPDFText_GetTextIndexFromCharIndex, error) {
+	return i.FPDFText_GetTextIndexFromCharIndexWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetDecodedThumbnailData", panicError)
+func (i *pdfiumInstance) FPDFText_GetTextIndexFromCharIndexWithContext(ctx goctx.Context, request *requests.FPDFText_GetTextIndexFromCharIndex) (*responses.FPDFText_GetTextIndexFromCharIndex, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetTextIndexFromCharIndex", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPage_GetDecodedThumbnailData(request)
-}
+		done, err := i.startCall(ctx, "FPDFText_GetTextIndexFromCharIndex", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPage_GetMediaBox(request *requests.FPDFPage_GetMediaBox) (resp *responses.FPDFPage_GetMediaBox, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetTextIndexFromCharIndex", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetMediaBox", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFText_GetTextIndexFromCharIndex(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetTextIndexFromCharIndex)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPage_GetMediaBox(request)
+func (i *pdfiumInstance) FPDFText_GetTextObject(request *requests.FPDFText_GetTextObject) (*responses.FPDFText_GetTextObject, error) {
+	return i.FPDFText_GetTextObjectWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPage_GetObject(request *requests.FPDFPage_GetObject) (resp *responses.FPDFPage_GetObject, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetTextObjectWithContext(ctx goctx.Context, request *requests.FPDFText_GetTextObject) (*responses.FPDFText_GetTextObject, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetTextObject", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetObject", panicError)
+		done, err := i.startCall(ctx, "FPDFText_GetTextObject", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetTextObject", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFPage_GetObject(request)
+		return i.worker.Instance.FPDFText_GetTextObject(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetTextObject)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPage_GetRawThumbnailData(request *requests.FPDFPage_GetRawThumbnailData) (resp *responses.FPDFPage_GetRawThumbnailData, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_GetUnicode(request *requests.FPDFText_GetUnicode) (*responses.FPDFText_GetUnicode, error) {
+	return i.FPDFText_GetUnicodeWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetRawThumbnailData", panicError)
+func (i *pdfiumInstance) FPDFText_GetUnicodeWithContext(ctx goctx.Context, request *requests.FPDFText_GetUnicode) (*responses.FPDFText_GetUnicode, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_GetUnicode", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPage_GetRawThumbnailData(request)
-}
+		done, err := i.startCall(ctx, "FPDFText_GetUnicode", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPage_GetRotation(request *requests.FPDFPage_GetRotation) (resp *responses.FPDFPage_GetRotation, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetUnicode", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetRotation", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFText_GetUnicode(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_GetUnicode)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPage_GetRotation(request)
+func (i *pdfiumInstance) FPDFText_HasUnicodeMapError(request *requests.FPDFText_HasUnicodeMapError) (*responses.FPDFText_HasUnicodeMapError, error) {
+	return i.FPDFText_HasUnicodeMapErrorWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPage_GetThumbnailAsBitmap(request *requests.FPDFPage_GetThumbnailAsBitmap) (resp *responses.FPDFPage_GetThumbnailAsBitmap, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_HasUnicodeMapErrorWithContext(ctx goctx.Context, request *requests.FPDFText_HasUnicodeMapError) (*responses.FPDFText_HasUnicodeMapError, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_HasUnicodeMapError", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetThumbnailAsBitmap", panicError)
+		done, err := i.startCall(ctx, "FPDFText_HasUnicodeMapError", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFPage_GetThumbnailAsBitmap(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_HasUnicodeMapError", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFText_HasUnicodeMapError(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_HasUnicodeMapError)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPage_GetTrimBox(request *requests.FPDFPage_GetTrimBox) (resp *responses.FPDFPage_GetTrimBox, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_IsGenerated(request *requests.FPDFText_IsGenerated) (*responses.FPDFText_IsGenerated, error) {
+	return i.FPDFText_IsGeneratedWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_GetTrimBox", panicError)
+func (i *pdfiumInstance) FPDFText_IsGeneratedWithContext(ctx goctx.Context, request *requests.FPDFText_IsGenerated) (*responses.FPDFText_IsGenerated, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_IsGenerated", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPage_GetTrimBox(request)
-}
+		done, err := i.startCall(ctx, "FPDFText_IsGenerated", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPage_HasFormFieldAtPoint(request *requests.FPDFPage_HasFormFieldAtPoint) (resp *responses.FPDFPage_HasFormFieldAtPoint, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_IsGenerated", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_HasFormFieldAtPoint", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFText_IsGenerated(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_IsGenerated)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPage_HasFormFieldAtPoint(request)
+func (i *pdfiumInstance) FPDFText_IsHyphen(request *requests.FPDFText_IsHyphen) (*responses.FPDFText_IsHyphen, error) {
+	return i.FPDFText_IsHyphenWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPage_HasTransparency(request *requests.FPDFPage_HasTransparency) (resp *responses.FPDFPage_HasTransparency, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_IsHyphenWithContext(ctx goctx.Context, request *requests.FPDFText_IsHyphen) (*responses.FPDFText_IsHyphen, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_IsHyphen", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_HasTransparency", panicError)
+		done, err := i.startCall(ctx, "FPDFText_IsHyphen", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_IsHyphen", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFPage_HasTransparency(request)
+		return i.worker.Instance.FPDFText_IsHyphen(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_IsHyphen)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPage_InsertClipPath(request *requests.FPDFPage_InsertClipPath) (resp *responses.FPDFPage_InsertClipPath, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_LoadCidType2Font(request *requests.FPDFText_LoadCidType2Font) (*responses.FPDFText_LoadCidType2Font, error) {
+	return i.FPDFText_LoadCidType2FontWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_InsertClipPath", panicError)
+func (i *pdfiumInstance) FPDFText_LoadCidType2FontWithContext(ctx goctx.Context, request *requests.FPDFText_LoadCidType2Font) (*responses.FPDFText_LoadCidType2Font, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_LoadCidType2Font", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPage_InsertClipPath(request)
-}
+		done, err := i.startCall(ctx, "FPDFText_LoadCidType2Font", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPage_InsertObject(request *requests.FPDFPage_InsertObject) (resp *responses.FPDFPage_InsertObject, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_LoadCidType2Font", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_InsertObject", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFText_LoadCidType2Font(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_LoadCidType2Font)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPage_InsertObject(request)
+func (i *pdfiumInstance) FPDFText_LoadFont(request *requests.FPDFText_LoadFont) (*responses.FPDFText_LoadFont, error) {
+	return i.FPDFText_LoadFontWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPage_InsertObjectAtIndex(request *requests.FPDFPage_InsertObjectAtIndex) (resp *responses.FPDFPage_InsertObjectAtIndex, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_LoadFontWithContext(ctx goctx.Context, request *requests.FPDFText_LoadFont) (*responses.FPDFText_LoadFont, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_LoadFont", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_InsertObjectAtIndex", panicError)
+		done, err := i.startCall(ctx, "FPDFText_LoadFont", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFPage_InsertObjectAtIndex(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_LoadFont", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFText_LoadFont(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_LoadFont)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPage_New(request *requests.FPDFPage_New) (resp *responses.FPDFPage_New, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_LoadPage(request *requests.FPDFText_LoadPage) (*responses.FPDFText_LoadPage, error) {
+	return i.FPDFText_LoadPageWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_New", panicError)
+func (i *pdfiumInstance) FPDFText_LoadPageWithContext(ctx goctx.Context, request *requests.FPDFText_LoadPage) (*responses.FPDFText_LoadPage, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_LoadPage", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPage_New(request)
-}
+		done, err := i.startCall(ctx, "FPDFText_LoadPage", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPage_RemoveAnnot(request *requests.FPDFPage_RemoveAnnot) (resp *responses.FPDFPage_RemoveAnnot, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_LoadPage", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_RemoveAnnot", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFText_LoadPage(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_LoadPage)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPage_RemoveAnnot(request)
+func (i *pdfiumInstance) FPDFText_LoadStandardFont(request *requests.FPDFText_LoadStandardFont) (*responses.FPDFText_LoadStandardFont, error) {
+	return i.FPDFText_LoadStandardFontWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPage_RemoveObject(request *requests.FPDFPage_RemoveObject) (resp *responses.FPDFPage_RemoveObject, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_LoadStandardFontWithContext(ctx goctx.Context, request *requests.FPDFText_LoadStandardFont) (*responses.FPDFText_LoadStandardFont, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_LoadStandardFont", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_RemoveObject", panicError)
+		done, err := i.startCall(ctx, "FPDFText_LoadStandardFont", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_LoadStandardFont", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFPage_RemoveObject(request)
+		return i.worker.Instance.FPDFText_LoadStandardFont(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_LoadStandardFont)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPage_SetArtBox(request *requests.FPDFPage_SetArtBox) (resp *responses.FPDFPage_SetArtBox, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_SetCharcodes(request *requests.FPDFText_SetCharcodes) (*responses.FPDFText_SetCharcodes, error) {
+	return i.FPDFText_SetCharcodesWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_SetArtBox", panicError)
+func (i *pdfiumInstance) FPDFText_SetCharcodesWithContext(ctx goctx.Context, request *requests.FPDFText_SetCharcodes) (*responses.FPDFText_SetCharcodes, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_SetCharcodes", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPage_SetArtBox(request)
-}
+		done, err := i.startCall(ctx, "FPDFText_SetCharcodes", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPage_SetBleedBox(request *requests.FPDFPage_SetBleedBox) (resp *responses.FPDFPage_SetBleedBox, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_SetCharcodes", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_SetBleedBox", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFText_SetCharcodes(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_SetCharcodes)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPage_SetBleedBox(request)
+func (i *pdfiumInstance) FPDFText_SetPositions(request *requests.FPDFText_SetPositions) (*responses.FPDFText_SetPositions, error) {
+	return i.FPDFText_SetPositionsWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPage_SetCropBox(request *requests.FPDFPage_SetCropBox) (resp *responses.FPDFPage_SetCropBox, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_SetPositionsWithContext(ctx goctx.Context, request *requests.FPDFText_SetPositions) (*responses.FPDFText_SetPositions, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_SetPositions", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_SetCropBox", panicError)
+		done, err := i.startCall(ctx, "FPDFText_SetPositions", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFPage_SetCropBox(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_SetPositions", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDFText_SetPositions(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_SetPositions)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPage_SetMediaBox(request *requests.FPDFPage_SetMediaBox) (resp *responses.FPDFPage_SetMediaBox, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDFText_SetText(request *requests.FPDFText_SetText) (*responses.FPDFText_SetText, error) {
+	return i.FPDFText_SetTextWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_SetMediaBox", panicError)
+func (i *pdfiumInstance) FPDFText_SetTextWithContext(ctx goctx.Context, request *requests.FPDFText_SetText) (*responses.FPDFText_SetText, error) {
+	resp, err := i.interceptor(ctx, "FPDFText_SetText", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPage_SetMediaBox(request)
-}
+		done, err := i.startCall(ctx, "FPDFText_SetText", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPage_SetRotation(request *requests.FPDFPage_SetRotation) (resp *responses.FPDFPage_SetRotation, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_SetText", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_SetRotation", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDFText_SetText(request)
+	})
+	typedResp, _ := resp.(*responses.FPDFText_SetText)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPage_SetRotation(request)
+func (i *pdfiumInstance) FPDF_CloseDocument(request *requests.FPDF_CloseDocument) (*responses.FPDF_CloseDocument, error) {
+	return i.FPDF_CloseDocumentWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPage_SetTrimBox(request *requests.FPDFPage_SetTrimBox) (resp *responses.FPDFPage_SetTrimBox, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_CloseDocumentWithContext(ctx goctx.Context, request *requests.FPDF_CloseDocument) (*responses.FPDF_CloseDocument, error) {
+	resp, err := i.interceptor(ctx, "FPDF_CloseDocument", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_SetTrimBox", panicError)
+		done, err := i.startCall(ctx, "FPDF_CloseDocument", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_CloseDocument", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFPage_SetTrimBox(request)
+		return i.worker.Instance.FPDF_CloseDocument(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_CloseDocument)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPage_TransFormWithClip(request *requests.FPDFPage_TransFormWithClip) (resp *responses.FPDFPage_TransFormWithClip, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_ClosePage(request *requests.FPDF_ClosePage) (*responses.FPDF_ClosePage, error) {
+	return i.FPDF_ClosePageWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_TransFormWithClip", panicError)
+func (i *pdfiumInstance) FPDF_ClosePageWithContext(ctx goctx.Context, request *requests.FPDF_ClosePage) (*responses.FPDF_ClosePage, error) {
+	resp, err := i.interceptor(ctx, "FPDF_ClosePage", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPage_TransFormWithClip(request)
-}
+		done, err := i.startCall(ctx, "FPDF_ClosePage", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPage_TransformAnnots(request *requests.FPDFPage_TransformAnnots) (resp *responses.FPDFPage_TransformAnnots, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_ClosePage", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPage_TransformAnnots", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_ClosePage(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_ClosePage)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPage_TransformAnnots(request)
+func (i *pdfiumInstance) FPDF_CloseXObject(request *requests.FPDF_CloseXObject) (*responses.FPDF_CloseXObject, error) {
+	return i.FPDF_CloseXObjectWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPathSegment_GetClose(request *requests.FPDFPathSegment_GetClose) (resp *responses.FPDFPathSegment_GetClose, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_CloseXObjectWithContext(ctx goctx.Context, request *requests.FPDF_CloseXObject) (*responses.FPDF_CloseXObject, error) {
+	resp, err := i.interceptor(ctx, "FPDF_CloseXObject", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPathSegment_GetClose", panicError)
+		done, err := i.startCall(ctx, "FPDF_CloseXObject", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFPathSegment_GetClose(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_CloseXObject", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_CloseXObject(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_CloseXObject)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPathSegment_GetPoint(request *requests.FPDFPathSegment_GetPoint) (resp *responses.FPDFPathSegment_GetPoint, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_CopyViewerPreferences(request *requests.FPDF_CopyViewerPreferences) (*responses.FPDF_CopyViewerPreferences, error) {
+	return i.FPDF_CopyViewerPreferencesWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPathSegment_GetPoint", panicError)
+func (i *pdfiumInstance) FPDF_CopyViewerPreferencesWithContext(ctx goctx.Context, request *requests.FPDF_CopyViewerPreferences) (*responses.FPDF_CopyViewerPreferences, error) {
+	resp, err := i.interceptor(ctx, "FPDF_CopyViewerPreferences", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPathSegment_GetPoint(request)
-}
+		done, err := i.startCall(ctx, "FPDF_CopyViewerPreferences", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPathSegment_GetType(request *requests.FPDFPathSegment_GetType) (resp *responses.FPDFPathSegment_GetType, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_CopyViewerPreferences", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPathSegment_GetType", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_CopyViewerPreferences(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_CopyViewerPreferences)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPathSegment_GetType(request)
+func (i *pdfiumInstance) FPDF_CountNamedDests(request *requests.FPDF_CountNamedDests) (*responses.FPDF_CountNamedDests, error) {
+	return i.FPDF_CountNamedDestsWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPath_BezierTo(request *requests.FPDFPath_BezierTo) (resp *responses.FPDFPath_BezierTo, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_CountNamedDestsWithContext(ctx goctx.Context, request *requests.FPDF_CountNamedDests) (*responses.FPDF_CountNamedDests, error) {
+	resp, err := i.interceptor(ctx, "FPDF_CountNamedDests", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_BezierTo", panicError)
+		done, err := i.startCall(ctx, "FPDF_CountNamedDests", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_CountNamedDests", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFPath_BezierTo(request)
+		return i.worker.Instance.FPDF_CountNamedDests(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_CountNamedDests)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPath_Close(request *requests.FPDFPath_Close) (resp *responses.FPDFPath_Close, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_CreateClipPath(request *requests.FPDF_CreateClipPath) (*responses.FPDF_CreateClipPath, error) {
+	return i.FPDF_CreateClipPathWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_Close", panicError)
+func (i *pdfiumInstance) FPDF_CreateClipPathWithContext(ctx goctx.Context, request *requests.FPDF_CreateClipPath) (*responses.FPDF_CreateClipPath, error) {
+	resp, err := i.interceptor(ctx, "FPDF_CreateClipPath", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPath_Close(request)
-}
+		done, err := i.startCall(ctx, "FPDF_CreateClipPath", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPath_CountSegments(request *requests.FPDFPath_CountSegments) (resp *responses.FPDFPath_CountSegments, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_CreateClipPath", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_CountSegments", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_CreateClipPath(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_CreateClipPath)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPath_CountSegments(request)
+func (i *pdfiumInstance) FPDF_CreateNewDocument(request *requests.FPDF_CreateNewDocument) (*responses.FPDF_CreateNewDocument, error) {
+	return i.FPDF_CreateNewDocumentWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPath_GetDrawMode(request *requests.FPDFPath_GetDrawMode) (resp *responses.FPDFPath_GetDrawMode, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_CreateNewDocumentWithContext(ctx goctx.Context, request *requests.FPDF_CreateNewDocument) (*responses.FPDF_CreateNewDocument, error) {
+	resp, err := i.interceptor(ctx, "FPDF_CreateNewDocument", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_GetDrawMode", panicError)
+		done, err := i.startCall(ctx, "FPDF_CreateNewDocument", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFPath_GetDrawMode(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_CreateNewDocument", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_CreateNewDocument(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_CreateNewDocument)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPath_GetPathSegment(request *requests.FPDFPath_GetPathSegment) (resp *responses.FPDFPath_GetPathSegment, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_DestroyClipPath(request *requests.FPDF_DestroyClipPath) (*responses.FPDF_DestroyClipPath, error) {
+	return i.FPDF_DestroyClipPathWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_GetPathSegment", panicError)
+func (i *pdfiumInstance) FPDF_DestroyClipPathWithContext(ctx goctx.Context, request *requests.FPDF_DestroyClipPath) (*responses.FPDF_DestroyClipPath, error) {
+	resp, err := i.interceptor(ctx, "FPDF_DestroyClipPath", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPath_GetPathSegment(request)
-}
+		done, err := i.startCall(ctx, "FPDF_DestroyClipPath", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFPath_LineTo(request *requests.FPDFPath_LineTo) (resp *responses.FPDFPath_LineTo, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_DestroyClipPath", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_LineTo", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_DestroyClipPath(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_DestroyClipPath)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFPath_LineTo(request)
+func (i *pdfiumInstance) FPDF_DeviceToPage(request *requests.FPDF_DeviceToPage) (*responses.FPDF_DeviceToPage, error) {
+	return i.FPDF_DeviceToPageWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFPath_MoveTo(request *requests.FPDFPath_MoveTo) (resp *responses.FPDFPath_MoveTo, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_DeviceToPageWithContext(ctx goctx.Context, request *requests.FPDF_DeviceToPage) (*responses.FPDF_DeviceToPage, error) {
+	resp, err := i.interceptor(ctx, "FPDF_DeviceToPage", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_MoveTo", panicError)
+		done, err := i.startCall(ctx, "FPDF_DeviceToPage", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_DeviceToPage", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFPath_MoveTo(request)
+		return i.worker.Instance.FPDF_DeviceToPage(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_DeviceToPage)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFPath_SetDrawMode(request *requests.FPDFPath_SetDrawMode) (resp *responses.FPDFPath_SetDrawMode, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_DocumentHasValidCrossReferenceTable(request *requests.FPDF_DocumentHasValidCrossReferenceTable) (*responses.FPDF_DocumentHasValidCrossReferenceTable, error) {
+	return i.FPDF_DocumentHasValidCrossReferenceTableWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFPath_SetDrawMode", panicError)
+func (i *pdfiumInstance) FPDF_DocumentHasValidCrossReferenceTableWithContext(ctx goctx.Context, request *requests.FPDF_DocumentHasValidCrossReferenceTable) (*responses.FPDF_DocumentHasValidCrossReferenceTable, error) {
+	resp, err := i.interceptor(ctx, "FPDF_DocumentHasValidCrossReferenceTable", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFPath_SetDrawMode(request)
-}
+		done, err := i.startCall(ctx, "FPDF_DocumentHasValidCrossReferenceTable", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFSignatureObj_GetByteRange(request *requests.FPDFSignatureObj_GetByteRange) (resp *responses.FPDFSignatureObj_GetByteRange, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_DocumentHasValidCrossReferenceTable", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFSignatureObj_GetByteRange", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_DocumentHasValidCrossReferenceTable(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_DocumentHasValidCrossReferenceTable)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFSignatureObj_GetByteRange(request)
+func (i *pdfiumInstance) FPDF_FFLDraw(request *requests.FPDF_FFLDraw) (*responses.FPDF_FFLDraw, error) {
+	return i.FPDF_FFLDrawWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFSignatureObj_GetContents(request *requests.FPDFSignatureObj_GetContents) (resp *responses.FPDFSignatureObj_GetContents, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_FFLDrawWithContext(ctx goctx.Context, request *requests.FPDF_FFLDraw) (*responses.FPDF_FFLDraw, error) {
+	resp, err := i.interceptor(ctx, "FPDF_FFLDraw", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFSignatureObj_GetContents", panicError)
+		done, err := i.startCall(ctx, "FPDF_FFLDraw", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFSignatureObj_GetContents(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_FFLDraw", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_FFLDraw(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_FFLDraw)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFSignatureObj_GetDocMDPPermission(request *requests.FPDFSignatureObj_GetDocMDPPermission) (resp *responses.FPDFSignatureObj_GetDocMDPPermission, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetDocPermissions(request *requests.FPDF_GetDocPermissions) (*responses.FPDF_GetDocPermissions, error) {
+	return i.FPDF_GetDocPermissionsWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFSignatureObj_GetDocMDPPermission", panicError)
+func (i *pdfiumInstance) FPDF_GetDocPermissionsWithContext(ctx goctx.Context, request *requests.FPDF_GetDocPermissions) (*responses.FPDF_GetDocPermissions, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetDocPermissions", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFSignatureObj_GetDocMDPPermission(request)
-}
+		done, err := i.startCall(ctx, "FPDF_GetDocPermissions", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFSignatureObj_GetReason(request *requests.FPDFSignatureObj_GetReason) (resp *responses.FPDFSignatureObj_GetReason, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetDocPermissions", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFSignatureObj_GetReason", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_GetDocPermissions(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetDocPermissions)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFSignatureObj_GetReason(request)
+func (i *pdfiumInstance) FPDF_GetDocUserPermissions(request *requests.FPDF_GetDocUserPermissions) (*responses.FPDF_GetDocUserPermissions, error) {
+	return i.FPDF_GetDocUserPermissionsWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFSignatureObj_GetSubFilter(request *requests.FPDFSignatureObj_GetSubFilter) (resp *responses.FPDFSignatureObj_GetSubFilter, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetDocUserPermissionsWithContext(ctx goctx.Context, request *requests.FPDF_GetDocUserPermissions) (*responses.FPDF_GetDocUserPermissions, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetDocUserPermissions", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFSignatureObj_GetSubFilter", panicError)
+		done, err := i.startCall(ctx, "FPDF_GetDocUserPermissions", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetDocUserPermissions", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFSignatureObj_GetSubFilter(request)
+		return i.worker.Instance.FPDF_GetDocUserPermissions(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetDocUserPermissions)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFSignatureObj_GetTime(request *requests.FPDFSignatureObj_GetTime) (resp *responses.FPDFSignatureObj_GetTime, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetFileIdentifier(request *requests.FPDF_GetFileIdentifier) (*responses.FPDF_GetFileIdentifier, error) {
+	return i.FPDF_GetFileIdentifierWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFSignatureObj_GetTime", panicError)
+func (i *pdfiumInstance) FPDF_GetFileIdentifierWithContext(ctx goctx.Context, request *requests.FPDF_GetFileIdentifier) (*responses.FPDF_GetFileIdentifier, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetFileIdentifier", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFSignatureObj_GetTime(request)
-}
+		done, err := i.startCall(ctx, "FPDF_GetFileIdentifier", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFTextObj_GetFont(request *requests.FPDFTextObj_GetFont) (resp *responses.FPDFTextObj_GetFont, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetFileIdentifier", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_GetFont", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_GetFileIdentifier(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetFileIdentifier)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFTextObj_GetFont(request)
+func (i *pdfiumInstance) FPDF_GetFileVersion(request *requests.FPDF_GetFileVersion) (*responses.FPDF_GetFileVersion, error) {
+	return i.FPDF_GetFileVersionWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFTextObj_GetFontSize(request *requests.FPDFTextObj_GetFontSize) (resp *responses.FPDFTextObj_GetFontSize, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetFileVersionWithContext(ctx goctx.Context, request *requests.FPDF_GetFileVersion) (*responses.FPDF_GetFileVersion, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetFileVersion", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_GetFontSize", panicError)
+		done, err := i.startCall(ctx, "FPDF_GetFileVersion", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFTextObj_GetFontSize(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetFileVersion", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_GetFileVersion(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetFileVersion)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFTextObj_GetRenderedBitmap(request *requests.FPDFTextObj_GetRenderedBitmap) (resp *responses.FPDFTextObj_GetRenderedBitmap, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetFormType(request *requests.FPDF_GetFormType) (*responses.FPDF_GetFormType, error) {
+	return i.FPDF_GetFormTypeWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_GetRenderedBitmap", panicError)
+func (i *pdfiumInstance) FPDF_GetFormTypeWithContext(ctx goctx.Context, request *requests.FPDF_GetFormType) (*responses.FPDF_GetFormType, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetFormType", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFTextObj_GetRenderedBitmap(request)
-}
+		done, err := i.startCall(ctx, "FPDF_GetFormType", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFTextObj_GetText(request *requests.FPDFTextObj_GetText) (resp *responses.FPDFTextObj_GetText, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetFormType", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_GetText", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_GetFormType(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetFormType)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFTextObj_GetText(request)
+func (i *pdfiumInstance) FPDF_GetLastError(request *requests.FPDF_GetLastError) (*responses.FPDF_GetLastError, error) {
+	return i.FPDF_GetLastErrorWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFTextObj_GetTextRenderMode(request *requests.FPDFTextObj_GetTextRenderMode) (resp *responses.FPDFTextObj_GetTextRenderMode, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetLastErrorWithContext(ctx goctx.Context, request *requests.FPDF_GetLastError) (*responses.FPDF_GetLastError, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetLastError", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_GetTextRenderMode", panicError)
+		done, err := i.startCall(ctx, "FPDF_GetLastError", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetLastError", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFTextObj_GetTextRenderMode(request)
+		return i.worker.Instance.FPDF_GetLastError(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetLastError)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFTextObj_SetFontSize(request *requests.FPDFTextObj_SetFontSize) (resp *responses.FPDFTextObj_SetFontSize, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetMetaText(request *requests.FPDF_GetMetaText) (*responses.FPDF_GetMetaText, error) {
+	return i.FPDF_GetMetaTextWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_SetFontSize", panicError)
+func (i *pdfiumInstance) FPDF_GetMetaTextWithContext(ctx goctx.Context, request *requests.FPDF_GetMetaText) (*responses.FPDF_GetMetaText, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetMetaText", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFTextObj_SetFontSize(request)
-}
+		done, err := i.startCall(ctx, "FPDF_GetMetaText", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFTextObj_SetTextRenderMode(request *requests.FPDFTextObj_SetTextRenderMode) (resp *responses.FPDFTextObj_SetTextRenderMode, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetMetaText", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFTextObj_SetTextRenderMode", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_GetMetaText(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetMetaText)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFTextObj_SetTextRenderMode(request)
+func (i *pdfiumInstance) FPDF_GetNamedDest(request *requests.FPDF_GetNamedDest) (*responses.FPDF_GetNamedDest, error) {
+	return i.FPDF_GetNamedDestWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFText_ClosePage(request *requests.FPDFText_ClosePage) (resp *responses.FPDFText_ClosePage, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetNamedDestWithContext(ctx goctx.Context, request *requests.FPDF_GetNamedDest) (*responses.FPDF_GetNamedDest, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetNamedDest", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_ClosePage", panicError)
+		done, err := i.startCall(ctx, "FPDF_GetNamedDest", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFText_ClosePage(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetNamedDest", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_GetNamedDest(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetNamedDest)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFText_CountChars(request *requests.FPDFText_CountChars) (resp *responses.FPDFText_CountChars, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetNamedDestByName(request *requests.FPDF_GetNamedDestByName) (*responses.FPDF_GetNamedDestByName, error) {
+	return i.FPDF_GetNamedDestByNameWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_CountChars", panicError)
+func (i *pdfiumInstance) FPDF_GetNamedDestByNameWithContext(ctx goctx.Context, request *requests.FPDF_GetNamedDestByName) (*responses.FPDF_GetNamedDestByName, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetNamedDestByName", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFText_CountChars(request)
-}
+		done, err := i.startCall(ctx, "FPDF_GetNamedDestByName", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFText_CountRects(request *requests.FPDFText_CountRects) (resp *responses.FPDFText_CountRects, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetNamedDestByName", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_CountRects", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_GetNamedDestByName(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetNamedDestByName)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFText_CountRects(request)
+func (i *pdfiumInstance) FPDF_GetPageAAction(request *requests.FPDF_GetPageAAction) (*responses.FPDF_GetPageAAction, error) {
+	return i.FPDF_GetPageAActionWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFText_FindClose(request *requests.FPDFText_FindClose) (resp *responses.FPDFText_FindClose, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetPageAActionWithContext(ctx goctx.Context, request *requests.FPDF_GetPageAAction) (*responses.FPDF_GetPageAAction, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetPageAAction", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_FindClose", panicError)
+		done, err := i.startCall(ctx, "FPDF_GetPageAAction", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageAAction", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFText_FindClose(request)
+		return i.worker.Instance.FPDF_GetPageAAction(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetPageAAction)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFText_FindNext(request *requests.FPDFText_FindNext) (resp *responses.FPDFText_FindNext, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetPageBoundingBox(request *requests.FPDF_GetPageBoundingBox) (*responses.FPDF_GetPageBoundingBox, error) {
+	return i.FPDF_GetPageBoundingBoxWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_FindNext", panicError)
+func (i *pdfiumInstance) FPDF_GetPageBoundingBoxWithContext(ctx goctx.Context, request *requests.FPDF_GetPageBoundingBox) (*responses.FPDF_GetPageBoundingBox, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetPageBoundingBox", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFText_FindNext(request)
-}
+		done, err := i.startCall(ctx, "FPDF_GetPageBoundingBox", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFText_FindPrev(request *requests.FPDFText_FindPrev) (resp *responses.FPDFText_FindPrev, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageBoundingBox", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_FindPrev", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_GetPageBoundingBox(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetPageBoundingBox)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFText_FindPrev(request)
+func (i *pdfiumInstance) FPDF_GetPageCount(request *requests.FPDF_GetPageCount) (*responses.FPDF_GetPageCount, error) {
+	return i.FPDF_GetPageCountWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFText_FindStart(request *requests.FPDFText_FindStart) (resp *responses.FPDFText_FindStart, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetPageCountWithContext(ctx goctx.Context, request *requests.FPDF_GetPageCount) (*responses.FPDF_GetPageCount, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetPageCount", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_FindStart", panicError)
+		done, err := i.startCall(ctx, "FPDF_GetPageCount", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFText_FindStart(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageCount", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_GetPageCount(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetPageCount)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFText_GetBoundedText(request *requests.FPDFText_GetBoundedText) (resp *responses.FPDFText_GetBoundedText, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetPageHeight(request *requests.FPDF_GetPageHeight) (*responses.FPDF_GetPageHeight, error) {
+	return i.FPDF_GetPageHeightWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetBoundedText", panicError)
+func (i *pdfiumInstance) FPDF_GetPageHeightWithContext(ctx goctx.Context, request *requests.FPDF_GetPageHeight) (*responses.FPDF_GetPageHeight, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetPageHeight", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFText_GetBoundedText(request)
-}
+		done, err := i.startCall(ctx, "FPDF_GetPageHeight", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFText_GetCharAngle(request *requests.FPDFText_GetCharAngle) (resp *responses.FPDFText_GetCharAngle, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageHeight", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetCharAngle", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_GetPageHeight(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetPageHeight)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFText_GetCharAngle(request)
+func (i *pdfiumInstance) FPDF_GetPageHeightF(request *requests.FPDF_GetPageHeightF) (*responses.FPDF_GetPageHeightF, error) {
+	return i.FPDF_GetPageHeightFWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFText_GetCharBox(request *requests.FPDFText_GetCharBox) (resp *responses.FPDFText_GetCharBox, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetPageHeightFWithContext(ctx goctx.Context, request *requests.FPDF_GetPageHeightF) (*responses.FPDF_GetPageHeightF, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetPageHeightF", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetCharBox", panicError)
+		done, err := i.startCall(ctx, "FPDF_GetPageHeightF", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageHeightF", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFText_GetCharBox(request)
+		return i.worker.Instance.FPDF_GetPageHeightF(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetPageHeightF)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFText_GetCharIndexAtPos(request *requests.FPDFText_GetCharIndexAtPos) (resp *responses.FPDFText_GetCharIndexAtPos, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetPageLabel(request *requests.FPDF_GetPageLabel) (*responses.FPDF_GetPageLabel, error) {
+	return i.FPDF_GetPageLabelWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetCharIndexAtPos", panicError)
+func (i *pdfiumInstance) FPDF_GetPageLabelWithContext(ctx goctx.Context, request *requests.FPDF_GetPageLabel) (*responses.FPDF_GetPageLabel, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetPageLabel", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFText_GetCharIndexAtPos(request)
-}
+		done, err := i.startCall(ctx, "FPDF_GetPageLabel", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFText_GetCharIndexFromTextIndex(request *requests.FPDFText_GetCharIndexFromTextIndex) (resp *responses.FPDFText_GetCharIndexFromTextIndex, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageLabel", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetCharIndexFromTextIndex", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_GetPageLabel(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetPageLabel)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFText_GetCharIndexFromTextIndex(request)
+func (i *pdfiumInstance) FPDF_GetPageSizeByIndex(request *requests.FPDF_GetPageSizeByIndex) (*responses.FPDF_GetPageSizeByIndex, error) {
+	return i.FPDF_GetPageSizeByIndexWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFText_GetCharOrigin(request *requests.FPDFText_GetCharOrigin) (resp *responses.FPDFText_GetCharOrigin, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetPageSizeByIndexWithContext(ctx goctx.Context, request *requests.FPDF_GetPageSizeByIndex) (*responses.FPDF_GetPageSizeByIndex, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetPageSizeByIndex", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetCharOrigin", panicError)
+		done, err := i.startCall(ctx, "FPDF_GetPageSizeByIndex", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFText_GetCharOrigin(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageSizeByIndex", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_GetPageSizeByIndex(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetPageSizeByIndex)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFText_GetFillColor(request *requests.FPDFText_GetFillColor) (resp *responses.FPDFText_GetFillColor, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetPageSizeByIndexF(request *requests.FPDF_GetPageSizeByIndexF) (*responses.FPDF_GetPageSizeByIndexF, error) {
+	return i.FPDF_GetPageSizeByIndexFWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetFillColor", panicError)
+func (i *pdfiumInstance) FPDF_GetPageSizeByIndexFWithContext(ctx goctx.Context, request *requests.FPDF_GetPageSizeByIndexF) (*responses.FPDF_GetPageSizeByIndexF, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetPageSizeByIndexF", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFText_GetFillColor(request)
-}
+		done, err := i.startCall(ctx, "FPDF_GetPageSizeByIndexF", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFText_GetFontInfo(request *requests.FPDFText_GetFontInfo) (resp *responses.FPDFText_GetFontInfo, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageSizeByIndexF", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetFontInfo", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_GetPageSizeByIndexF(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetPageSizeByIndexF)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFText_GetFontInfo(request)
+func (i *pdfiumInstance) FPDF_GetPageWidth(request *requests.FPDF_GetPageWidth) (*responses.FPDF_GetPageWidth, error) {
+	return i.FPDF_GetPageWidthWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFText_GetFontSize(request *requests.FPDFText_GetFontSize) (resp *responses.FPDFText_GetFontSize, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetPageWidthWithContext(ctx goctx.Context, request *requests.FPDF_GetPageWidth) (*responses.FPDF_GetPageWidth, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetPageWidth", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetFontSize", panicError)
+		done, err := i.startCall(ctx, "FPDF_GetPageWidth", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageWidth", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFText_GetFontSize(request)
+		return i.worker.Instance.FPDF_GetPageWidth(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetPageWidth)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFText_GetFontWeight(request *requests.FPDFText_GetFontWeight) (resp *responses.FPDFText_GetFontWeight, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetPageWidthF(request *requests.FPDF_GetPageWidthF) (*responses.FPDF_GetPageWidthF, error) {
+	return i.FPDF_GetPageWidthFWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetFontWeight", panicError)
+func (i *pdfiumInstance) FPDF_GetPageWidthFWithContext(ctx goctx.Context, request *requests.FPDF_GetPageWidthF) (*responses.FPDF_GetPageWidthF, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetPageWidthF", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFText_GetFontWeight(request)
-}
+		done, err := i.startCall(ctx, "FPDF_GetPageWidthF", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFText_GetLooseCharBox(request *requests.FPDFText_GetLooseCharBox) (resp *responses.FPDFText_GetLooseCharBox, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageWidthF", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetLooseCharBox", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_GetPageWidthF(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetPageWidthF)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFText_GetLooseCharBox(request)
+func (i *pdfiumInstance) FPDF_GetSecurityHandlerRevision(request *requests.FPDF_GetSecurityHandlerRevision) (*responses.FPDF_GetSecurityHandlerRevision, error) {
+	return i.FPDF_GetSecurityHandlerRevisionWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFText_GetMatrix(request *requests.FPDFText_GetMatrix) (resp *responses.FPDFText_GetMatrix, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetSecurityHandlerRevisionWithContext(ctx goctx.Context, request *requests.FPDF_GetSecurityHandlerRevision) (*responses.FPDF_GetSecurityHandlerRevision, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetSecurityHandlerRevision", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetMatrix", panicError)
+		done, err := i.startCall(ctx, "FPDF_GetSecurityHandlerRevision", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFText_GetMatrix(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetSecurityHandlerRevision", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_GetSecurityHandlerRevision(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetSecurityHandlerRevision)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFText_GetRect(request *requests.FPDFText_GetRect) (resp *responses.FPDFText_GetRect, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetSignatureCount(request *requests.FPDF_GetSignatureCount) (*responses.FPDF_GetSignatureCount, error) {
+	return i.FPDF_GetSignatureCountWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetRect", panicError)
+func (i *pdfiumInstance) FPDF_GetSignatureCountWithContext(ctx goctx.Context, request *requests.FPDF_GetSignatureCount) (*responses.FPDF_GetSignatureCount, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetSignatureCount", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFText_GetRect(request)
-}
+		done, err := i.startCall(ctx, "FPDF_GetSignatureCount", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFText_GetSchCount(request *requests.FPDFText_GetSchCount) (resp *responses.FPDFText_GetSchCount, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetSignatureCount", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetSchCount", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_GetSignatureCount(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetSignatureCount)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFText_GetSchCount(request)
+func (i *pdfiumInstance) FPDF_GetSignatureObject(request *requests.FPDF_GetSignatureObject) (*responses.FPDF_GetSignatureObject, error) {
+	return i.FPDF_GetSignatureObjectWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFText_GetSchResultIndex(request *requests.FPDFText_GetSchResultIndex) (resp *responses.FPDFText_GetSchResultIndex, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetSignatureObjectWithContext(ctx goctx.Context, request *requests.FPDF_GetSignatureObject) (*responses.FPDF_GetSignatureObject, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetSignatureObject", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetSchResultIndex", panicError)
+		done, err := i.startCall(ctx, "FPDF_GetSignatureObject", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetSignatureObject", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFText_GetSchResultIndex(request)
+		return i.worker.Instance.FPDF_GetSignatureObject(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetSignatureObject)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFText_GetStrokeColor(request *requests.FPDFText_GetStrokeColor) (resp *responses.FPDFText_GetStrokeColor, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetTrailerEnds(request *requests.FPDF_GetTrailerEnds) (*responses.FPDF_GetTrailerEnds, error) {
+	return i.FPDF_GetTrailerEndsWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetStrokeColor", panicError)
+func (i *pdfiumInstance) FPDF_GetTrailerEndsWithContext(ctx goctx.Context, request *requests.FPDF_GetTrailerEnds) (*responses.FPDF_GetTrailerEnds, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetTrailerEnds", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFText_GetStrokeColor(request)
-}
+		done, err := i.startCall(ctx, "FPDF_GetTrailerEnds", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFText_GetText(request *requests.FPDFText_GetText) (resp *responses.FPDFText_GetText, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetTrailerEnds", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetText", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_GetTrailerEnds(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetTrailerEnds)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFText_GetText(request)
+func (i *pdfiumInstance) FPDF_GetXFAPacketContent(request *requests.FPDF_GetXFAPacketContent) (*responses.FPDF_GetXFAPacketContent, error) {
+	return i.FPDF_GetXFAPacketContentWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFText_GetTextIndexFromCharIndex(request *requests.FPDFText_GetTextIndexFromCharIndex) (resp *responses.FPDFText_GetTextIndexFromCharIndex, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetXFAPacketContentWithContext(ctx goctx.Context, request *requests.FPDF_GetXFAPacketContent) (*responses.FPDF_GetXFAPacketContent, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetXFAPacketContent", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetTextIndexFromCharIndex", panicError)
+		done, err := i.startCall(ctx, "FPDF_GetXFAPacketContent", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFText_GetTextIndexFromCharIndex(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetXFAPacketContent", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_GetXFAPacketContent(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetXFAPacketContent)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFText_GetTextObject(request *requests.FPDFText_GetTextObject) (resp *responses.FPDFText_GetTextObject, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetXFAPacketCount(request *requests.FPDF_GetXFAPacketCount) (*responses.FPDF_GetXFAPacketCount, error) {
+	return i.FPDF_GetXFAPacketCountWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetTextObject", panicError)
+func (i *pdfiumInstance) FPDF_GetXFAPacketCountWithContext(ctx goctx.Context, request *requests.FPDF_GetXFAPacketCount) (*responses.FPDF_GetXFAPacketCount, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetXFAPacketCount", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFText_GetTextObject(request)
-}
+		done, err := i.startCall(ctx, "FPDF_GetXFAPacketCount", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFText_GetUnicode(request *requests.FPDFText_GetUnicode) (resp *responses.FPDFText_GetUnicode, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetXFAPacketCount", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_GetUnicode", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_GetXFAPacketCount(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetXFAPacketCount)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFText_GetUnicode(request)
+func (i *pdfiumInstance) FPDF_GetXFAPacketName(request *requests.FPDF_GetXFAPacketName) (*responses.FPDF_GetXFAPacketName, error) {
+	return i.FPDF_GetXFAPacketNameWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFText_HasUnicodeMapError(request *requests.FPDFText_HasUnicodeMapError) (resp *responses.FPDFText_HasUnicodeMapError, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_GetXFAPacketNameWithContext(ctx goctx.Context, request *requests.FPDF_GetXFAPacketName) (*responses.FPDF_GetXFAPacketName, error) {
+	resp, err := i.interceptor(ctx, "FPDF_GetXFAPacketName", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_HasUnicodeMapError", panicError)
+		done, err := i.startCall(ctx, "FPDF_GetXFAPacketName", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetXFAPacketName", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFText_HasUnicodeMapError(request)
+		return i.worker.Instance.FPDF_GetXFAPacketName(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_GetXFAPacketName)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFText_IsGenerated(request *requests.FPDFText_IsGenerated) (resp *responses.FPDFText_IsGenerated, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_ImportNPagesToOne(request *requests.FPDF_ImportNPagesToOne) (*responses.FPDF_ImportNPagesToOne, error) {
+	return i.FPDF_ImportNPagesToOneWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_IsGenerated", panicError)
+func (i *pdfiumInstance) FPDF_ImportNPagesToOneWithContext(ctx goctx.Context, request *requests.FPDF_ImportNPagesToOne) (*responses.FPDF_ImportNPagesToOne, error) {
+	resp, err := i.interceptor(ctx, "FPDF_ImportNPagesToOne", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFText_IsGenerated(request)
-}
+		done, err := i.startCall(ctx, "FPDF_ImportNPagesToOne", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFText_IsHyphen(request *requests.FPDFText_IsHyphen) (resp *responses.FPDFText_IsHyphen, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_ImportNPagesToOne", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_IsHyphen", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_ImportNPagesToOne(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_ImportNPagesToOne)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFText_IsHyphen(request)
+func (i *pdfiumInstance) FPDF_ImportPages(request *requests.FPDF_ImportPages) (*responses.FPDF_ImportPages, error) {
+	return i.FPDF_ImportPagesWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFText_LoadCidType2Font(request *requests.FPDFText_LoadCidType2Font) (resp *responses.FPDFText_LoadCidType2Font, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_ImportPagesWithContext(ctx goctx.Context, request *requests.FPDF_ImportPages) (*responses.FPDF_ImportPages, error) {
+	resp, err := i.interceptor(ctx, "FPDF_ImportPages", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_LoadCidType2Font", panicError)
+		done, err := i.startCall(ctx, "FPDF_ImportPages", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFText_LoadCidType2Font(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_ImportPages", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_ImportPages(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_ImportPages)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFText_LoadFont(request *requests.FPDFText_LoadFont) (resp *responses.FPDFText_LoadFont, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_ImportPagesByIndex(request *requests.FPDF_ImportPagesByIndex) (*responses.FPDF_ImportPagesByIndex, error) {
+	return i.FPDF_ImportPagesByIndexWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_LoadFont", panicError)
+func (i *pdfiumInstance) FPDF_ImportPagesByIndexWithContext(ctx goctx.Context, request *requests.FPDF_ImportPagesByIndex) (*responses.FPDF_ImportPagesByIndex, error) {
+	resp, err := i.interceptor(ctx, "FPDF_ImportPagesByIndex", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFText_LoadFont(request)
-}
+		done, err := i.startCall(ctx, "FPDF_ImportPagesByIndex", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFText_LoadPage(request *requests.FPDFText_LoadPage) (resp *responses.FPDFText_LoadPage, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_ImportPagesByIndex", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_LoadPage", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_ImportPagesByIndex(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_ImportPagesByIndex)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFText_LoadPage(request)
+func (i *pdfiumInstance) FPDF_LoadCustomDocument(request *requests.FPDF_LoadCustomDocument) (*responses.FPDF_LoadCustomDocument, error) {
+	return i.FPDF_LoadCustomDocumentWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFText_LoadStandardFont(request *requests.FPDFText_LoadStandardFont) (resp *responses.FPDFText_LoadStandardFont, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_LoadCustomDocumentWithContext(ctx goctx.Context, request *requests.FPDF_LoadCustomDocument) (*responses.FPDF_LoadCustomDocument, error) {
+	resp, err := i.interceptor(ctx, "FPDF_LoadCustomDocument", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_LoadStandardFont", panicError)
+		done, err := i.startCall(ctx, "FPDF_LoadCustomDocument", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_LoadCustomDocument", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDFText_LoadStandardFont(request)
+		return i.worker.Instance.FPDF_LoadCustomDocument(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_LoadCustomDocument)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDFText_SetCharcodes(request *requests.FPDFText_SetCharcodes) (resp *responses.FPDFText_SetCharcodes, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_LoadDocument(request *requests.FPDF_LoadDocument) (*responses.FPDF_LoadDocument, error) {
+	return i.FPDF_LoadDocumentWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_SetCharcodes", panicError)
+func (i *pdfiumInstance) FPDF_LoadDocumentWithContext(ctx goctx.Context, request *requests.FPDF_LoadDocument) (*responses.FPDF_LoadDocument, error) {
+	resp, err := i.interceptor(ctx, "FPDF_LoadDocument", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDFText_SetCharcodes(request)
-}
+		done, err := i.startCall(ctx, "FPDF_LoadDocument", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDFText_SetPositions(request *requests.FPDFText_SetPositions) (resp *responses.FPDFText_SetPositions, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_LoadDocument", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_SetPositions", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_LoadDocument(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_LoadDocument)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDFText_SetPositions(request)
+func (i *pdfiumInstance) FPDF_LoadMemDocument(request *requests.FPDF_LoadMemDocument) (*responses.FPDF_LoadMemDocument, error) {
+	return i.FPDF_LoadMemDocumentWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDFText_SetText(request *requests.FPDFText_SetText) (resp *responses.FPDFText_SetText, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_LoadMemDocumentWithContext(ctx goctx.Context, request *requests.FPDF_LoadMemDocument) (*responses.FPDF_LoadMemDocument, error) {
+	resp, err := i.interceptor(ctx, "FPDF_LoadMemDocument", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDFText_SetText", panicError)
+		done, err := i.startCall(ctx, "FPDF_LoadMemDocument", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDFText_SetText(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_LoadMemDocument", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_LoadMemDocument(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_LoadMemDocument)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_CloseDocument(request *requests.FPDF_CloseDocument) (resp *responses.FPDF_CloseDocument, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_LoadMemDocument64(request *requests.FPDF_LoadMemDocument64) (*responses.FPDF_LoadMemDocument64, error) {
+	return i.FPDF_LoadMemDocument64WithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_CloseDocument", panicError)
+func (i *pdfiumInstance) FPDF_LoadMemDocument64WithContext(ctx goctx.Context, request *requests.FPDF_LoadMemDocument64) (*responses.FPDF_LoadMemDocument64, error) {
+	resp, err := i.interceptor(ctx, "FPDF_LoadMemDocument64", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_CloseDocument(request)
-}
+		done, err := i.startCall(ctx, "FPDF_LoadMemDocument64", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_ClosePage(request *requests.FPDF_ClosePage) (resp *responses.FPDF_ClosePage, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_LoadMemDocument64", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_ClosePage", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_LoadMemDocument64(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_LoadMemDocument64)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_ClosePage(request)
+func (i *pdfiumInstance) FPDF_LoadPage(request *requests.FPDF_LoadPage) (*responses.FPDF_LoadPage, error) {
+	return i.FPDF_LoadPageWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_CloseXObject(request *requests.FPDF_CloseXObject) (resp *responses.FPDF_CloseXObject, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_LoadPageWithContext(ctx goctx.Context, request *requests.FPDF_LoadPage) (*responses.FPDF_LoadPage, error) {
+	resp, err := i.interceptor(ctx, "FPDF_LoadPage", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_CloseXObject", panicError)
+		done, err := i.startCall(ctx, "FPDF_LoadPage", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_LoadPage", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDF_CloseXObject(request)
+		return i.worker.Instance.FPDF_LoadPage(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_LoadPage)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_CopyViewerPreferences(request *requests.FPDF_CopyViewerPreferences) (resp *responses.FPDF_CopyViewerPreferences, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_LoadXFA(request *requests.FPDF_LoadXFA) (*responses.FPDF_LoadXFA, error) {
+	return i.FPDF_LoadXFAWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_CopyViewerPreferences", panicError)
+func (i *pdfiumInstance) FPDF_LoadXFAWithContext(ctx goctx.Context, request *requests.FPDF_LoadXFA) (*responses.FPDF_LoadXFA, error) {
+	resp, err := i.interceptor(ctx, "FPDF_LoadXFA", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_CopyViewerPreferences(request)
-}
+		done, err := i.startCall(ctx, "FPDF_LoadXFA", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_CountNamedDests(request *requests.FPDF_CountNamedDests) (resp *responses.FPDF_CountNamedDests, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_LoadXFA", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_CountNamedDests", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_LoadXFA(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_LoadXFA)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_CountNamedDests(request)
+func (i *pdfiumInstance) FPDF_MovePages(request *requests.FPDF_MovePages) (*responses.FPDF_MovePages, error) {
+	return i.FPDF_MovePagesWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_CreateClipPath(request *requests.FPDF_CreateClipPath) (resp *responses.FPDF_CreateClipPath, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_MovePagesWithContext(ctx goctx.Context, request *requests.FPDF_MovePages) (*responses.FPDF_MovePages, error) {
+	resp, err := i.interceptor(ctx, "FPDF_MovePages", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_CreateClipPath", panicError)
+		done, err := i.startCall(ctx, "FPDF_MovePages", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDF_CreateClipPath(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_MovePages", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_MovePages(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_MovePages)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_CreateNewDocument(request *requests.FPDF_CreateNewDocument) (resp *responses.FPDF_CreateNewDocument, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_NewFormObjectFromXObject(request *requests.FPDF_NewFormObjectFromXObject) (*responses.FPDF_NewFormObjectFromXObject, error) {
+	return i.FPDF_NewFormObjectFromXObjectWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_CreateNewDocument", panicError)
+func (i *pdfiumInstance) FPDF_NewFormObjectFromXObjectWithContext(ctx goctx.Context, request *requests.FPDF_NewFormObjectFromXObject) (*responses.FPDF_NewFormObjectFromXObject, error) {
+	resp, err := i.interceptor(ctx, "FPDF_NewFormObjectFromXObject", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_CreateNewDocument(request)
-}
+		done, err := i.startCall(ctx, "FPDF_NewFormObjectFromXObject", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_DestroyClipPath(request *requests.FPDF_DestroyClipPath) (resp *responses.FPDF_DestroyClipPath, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_NewFormObjectFromXObject", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_DestroyClipPath", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_NewFormObjectFromXObject(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_NewFormObjectFromXObject)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_DestroyClipPath(request)
+func (i *pdfiumInstance) FPDF_NewXObjectFromPage(request *requests.FPDF_NewXObjectFromPage) (*responses.FPDF_NewXObjectFromPage, error) {
+	return i.FPDF_NewXObjectFromPageWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_DeviceToPage(request *requests.FPDF_DeviceToPage) (resp *responses.FPDF_DeviceToPage, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_NewXObjectFromPageWithContext(ctx goctx.Context, request *requests.FPDF_NewXObjectFromPage) (*responses.FPDF_NewXObjectFromPage, error) {
+	resp, err := i.interceptor(ctx, "FPDF_NewXObjectFromPage", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_DeviceToPage", panicError)
+		done, err := i.startCall(ctx, "FPDF_NewXObjectFromPage", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_NewXObjectFromPage", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDF_DeviceToPage(request)
+		return i.worker.Instance.FPDF_NewXObjectFromPage(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_NewXObjectFromPage)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_DocumentHasValidCrossReferenceTable(request *requests.FPDF_DocumentHasValidCrossReferenceTable) (resp *responses.FPDF_DocumentHasValidCrossReferenceTable, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_PageToDevice(request *requests.FPDF_PageToDevice) (*responses.FPDF_PageToDevice, error) {
+	return i.FPDF_PageToDeviceWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_DocumentHasValidCrossReferenceTable", panicError)
+func (i *pdfiumInstance) FPDF_PageToDeviceWithContext(ctx goctx.Context, request *requests.FPDF_PageToDevice) (*responses.FPDF_PageToDevice, error) {
+	resp, err := i.interceptor(ctx, "FPDF_PageToDevice", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_DocumentHasValidCrossReferenceTable(request)
-}
+		done, err := i.startCall(ctx, "FPDF_PageToDevice", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_FFLDraw(request *requests.FPDF_FFLDraw) (resp *responses.FPDF_FFLDraw, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_PageToDevice", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_FFLDraw", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_PageToDevice(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_PageToDevice)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_FFLDraw(request)
+func (i *pdfiumInstance) FPDF_RemoveFormFieldHighlight(request *requests.FPDF_RemoveFormFieldHighlight) (*responses.FPDF_RemoveFormFieldHighlight, error) {
+	return i.FPDF_RemoveFormFieldHighlightWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_GetDocPermissions(request *requests.FPDF_GetDocPermissions) (resp *responses.FPDF_GetDocPermissions, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_RemoveFormFieldHighlightWithContext(ctx goctx.Context, request *requests.FPDF_RemoveFormFieldHighlight) (*responses.FPDF_RemoveFormFieldHighlight, error) {
+	resp, err := i.interceptor(ctx, "FPDF_RemoveFormFieldHighlight", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetDocPermissions", panicError)
+		done, err := i.startCall(ctx, "FPDF_RemoveFormFieldHighlight", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDF_GetDocPermissions(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RemoveFormFieldHighlight", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_RemoveFormFieldHighlight(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_RemoveFormFieldHighlight)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_GetDocUserPermissions(request *requests.FPDF_GetDocUserPermissions) (resp *responses.FPDF_GetDocUserPermissions, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_RenderPage(request *requests.FPDF_RenderPage) (*responses.FPDF_RenderPage, error) {
+	return i.FPDF_RenderPageWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetDocUserPermissions", panicError)
+func (i *pdfiumInstance) FPDF_RenderPageWithContext(ctx goctx.Context, request *requests.FPDF_RenderPage) (*responses.FPDF_RenderPage, error) {
+	resp, err := i.interceptor(ctx, "FPDF_RenderPage", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_GetDocUserPermissions(request)
-}
+		done, err := i.startCall(ctx, "FPDF_RenderPage", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_GetFileIdentifier(request *requests.FPDF_GetFileIdentifier) (resp *responses.FPDF_GetFileIdentifier, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPage", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetFileIdentifier", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_RenderPage(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_RenderPage)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_GetFileIdentifier(request)
+func (i *pdfiumInstance) FPDF_RenderPageBitmap(request *requests.FPDF_RenderPageBitmap) (*responses.FPDF_RenderPageBitmap, error) {
+	return i.FPDF_RenderPageBitmapWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_GetFileVersion(request *requests.FPDF_GetFileVersion) (resp *responses.FPDF_GetFileVersion, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_RenderPageBitmapWithContext(ctx goctx.Context, request *requests.FPDF_RenderPageBitmap) (*responses.FPDF_RenderPageBitmap, error) {
+	resp, err := i.interceptor(ctx, "FPDF_RenderPageBitmap", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetFileVersion", panicError)
+		done, err := i.startCall(ctx, "FPDF_RenderPageBitmap", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPageBitmap", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDF_GetFileVersion(request)
+		return i.worker.Instance.FPDF_RenderPageBitmap(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_RenderPageBitmap)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_GetFormType(request *requests.FPDF_GetFormType) (resp *responses.FPDF_GetFormType, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_RenderPageBitmapWithColorScheme_Start(request *requests.FPDF_RenderPageBitmapWithColorScheme_Start) (*responses.FPDF_RenderPageBitmapWithColorScheme_Start, error) {
+	return i.FPDF_RenderPageBitmapWithColorScheme_StartWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetFormType", panicError)
+func (i *pdfiumInstance) FPDF_RenderPageBitmapWithColorScheme_StartWithContext(ctx goctx.Context, request *requests.FPDF_RenderPageBitmapWithColorScheme_Start) (*responses.FPDF_RenderPageBitmapWithColorScheme_Start, error) {
+	resp, err := i.interceptor(ctx, "FPDF_RenderPageBitmapWithColorScheme_Start", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_GetFormType(request)
-}
+		done, err := i.startCall(ctx, "FPDF_RenderPageBitmapWithColorScheme_Start", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_GetLastError(request *requests.FPDF_GetLastError) (resp *responses.FPDF_GetLastError, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPageBitmapWithColorScheme_Start", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetLastError", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_RenderPageBitmapWithColorScheme_Start(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_RenderPageBitmapWithColorScheme_Start)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_GetLastError(request)
+func (i *pdfiumInstance) FPDF_RenderPageBitmapWithMatrix(request *requests.FPDF_RenderPageBitmapWithMatrix) (*responses.FPDF_RenderPageBitmapWithMatrix, error) {
+	return i.FPDF_RenderPageBitmapWithMatrixWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_GetMetaText(request *requests.FPDF_GetMetaText) (resp *responses.FPDF_GetMetaText, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_RenderPageBitmapWithMatrixWithContext(ctx goctx.Context, request *requests.FPDF_RenderPageBitmapWithMatrix) (*responses.FPDF_RenderPageBitmapWithMatrix, error) {
+	resp, err := i.interceptor(ctx, "FPDF_RenderPageBitmapWithMatrix", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetMetaText", panicError)
+		done, err := i.startCall(ctx, "FPDF_RenderPageBitmapWithMatrix", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDF_GetMetaText(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPageBitmapWithMatrix", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_RenderPageBitmapWithMatrix(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_RenderPageBitmapWithMatrix)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_GetNamedDest(request *requests.FPDF_GetNamedDest) (resp *responses.FPDF_GetNamedDest, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_RenderPageBitmap_Start(request *requests.FPDF_RenderPageBitmap_Start) (*responses.FPDF_RenderPageBitmap_Start, error) {
+	return i.FPDF_RenderPageBitmap_StartWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetNamedDest", panicError)
+func (i *pdfiumInstance) FPDF_RenderPageBitmap_StartWithContext(ctx goctx.Context, request *requests.FPDF_RenderPageBitmap_Start) (*responses.FPDF_RenderPageBitmap_Start, error) {
+	resp, err := i.interceptor(ctx, "FPDF_RenderPageBitmap_Start", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_GetNamedDest(request)
-}
+		done, err := i.startCall(ctx, "FPDF_RenderPageBitmap_Start", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_GetNamedDestByName(request *requests.FPDF_GetNamedDestByName) (resp *responses.FPDF_GetNamedDestByName, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPageBitmap_Start", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetNamedDestByName", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_RenderPageBitmap_Start(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_RenderPageBitmap_Start)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_GetNamedDestByName(request)
+func (i *pdfiumInstance) FPDF_RenderPage_Close(request *requests.FPDF_RenderPage_Close) (*responses.FPDF_RenderPage_Close, error) {
+	return i.FPDF_RenderPage_CloseWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_GetPageAAction(request *requests.FPDF_GetPageAAction) (resp *responses.FPDF_GetPageAAction, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_RenderPage_CloseWithContext(ctx goctx.Context, request *requests.FPDF_RenderPage_Close) (*responses.FPDF_RenderPage_Close, error) {
+	resp, err := i.interceptor(ctx, "FPDF_RenderPage_Close", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageAAction", panicError)
+		done, err := i.startCall(ctx, "FPDF_RenderPage_Close", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPage_Close", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDF_GetPageAAction(request)
+		return i.worker.Instance.FPDF_RenderPage_Close(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_RenderPage_Close)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_GetPageBoundingBox(request *requests.FPDF_GetPageBoundingBox) (resp *responses.FPDF_GetPageBoundingBox, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_RenderPage_Continue(request *requests.FPDF_RenderPage_Continue) (*responses.FPDF_RenderPage_Continue, error) {
+	return i.FPDF_RenderPage_ContinueWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageBoundingBox", panicError)
+func (i *pdfiumInstance) FPDF_RenderPage_ContinueWithContext(ctx goctx.Context, request *requests.FPDF_RenderPage_Continue) (*responses.FPDF_RenderPage_Continue, error) {
+	resp, err := i.interceptor(ctx, "FPDF_RenderPage_Continue", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_GetPageBoundingBox(request)
-}
+		done, err := i.startCall(ctx, "FPDF_RenderPage_Continue", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_GetPageCount(request *requests.FPDF_GetPageCount) (resp *responses.FPDF_GetPageCount, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPage_Continue", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageCount", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_RenderPage_Continue(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_RenderPage_Continue)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_GetPageCount(request)
+func (i *pdfiumInstance) FPDF_SaveAsCopy(request *requests.FPDF_SaveAsCopy) (*responses.FPDF_SaveAsCopy, error) {
+	return i.FPDF_SaveAsCopyWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_GetPageHeight(request *requests.FPDF_GetPageHeight) (resp *responses.FPDF_GetPageHeight, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_SaveAsCopyWithContext(ctx goctx.Context, request *requests.FPDF_SaveAsCopy) (*responses.FPDF_SaveAsCopy, error) {
+	resp, err := i.interceptor(ctx, "FPDF_SaveAsCopy", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageHeight", panicError)
+		done, err := i.startCall(ctx, "FPDF_SaveAsCopy", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDF_GetPageHeight(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SaveAsCopy", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_SaveAsCopy(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_SaveAsCopy)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_GetPageHeightF(request *requests.FPDF_GetPageHeightF) (resp *responses.FPDF_GetPageHeightF, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_SaveWithVersion(request *requests.FPDF_SaveWithVersion) (*responses.FPDF_SaveWithVersion, error) {
+	return i.FPDF_SaveWithVersionWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageHeightF", panicError)
+func (i *pdfiumInstance) FPDF_SaveWithVersionWithContext(ctx goctx.Context, request *requests.FPDF_SaveWithVersion) (*responses.FPDF_SaveWithVersion, error) {
+	resp, err := i.interceptor(ctx, "FPDF_SaveWithVersion", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_GetPageHeightF(request)
-}
+		done, err := i.startCall(ctx, "FPDF_SaveWithVersion", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_GetPageLabel(request *requests.FPDF_GetPageLabel) (resp *responses.FPDF_GetPageLabel, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SaveWithVersion", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageLabel", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_SaveWithVersion(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_SaveWithVersion)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_GetPageLabel(request)
+func (i *pdfiumInstance) FPDF_SetFormFieldHighlightAlpha(request *requests.FPDF_SetFormFieldHighlightAlpha) (*responses.FPDF_SetFormFieldHighlightAlpha, error) {
+	return i.FPDF_SetFormFieldHighlightAlphaWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_GetPageSizeByIndex(request *requests.FPDF_GetPageSizeByIndex) (resp *responses.FPDF_GetPageSizeByIndex, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_SetFormFieldHighlightAlphaWithContext(ctx goctx.Context, request *requests.FPDF_SetFormFieldHighlightAlpha) (*responses.FPDF_SetFormFieldHighlightAlpha, error) {
+	resp, err := i.interceptor(ctx, "FPDF_SetFormFieldHighlightAlpha", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageSizeByIndex", panicError)
+		done, err := i.startCall(ctx, "FPDF_SetFormFieldHighlightAlpha", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SetFormFieldHighlightAlpha", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDF_GetPageSizeByIndex(request)
+		return i.worker.Instance.FPDF_SetFormFieldHighlightAlpha(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_SetFormFieldHighlightAlpha)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_GetPageSizeByIndexF(request *requests.FPDF_GetPageSizeByIndexF) (resp *responses.FPDF_GetPageSizeByIndexF, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_SetFormFieldHighlightColor(request *requests.FPDF_SetFormFieldHighlightColor) (*responses.FPDF_SetFormFieldHighlightColor, error) {
+	return i.FPDF_SetFormFieldHighlightColorWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageSizeByIndexF", panicError)
+func (i *pdfiumInstance) FPDF_SetFormFieldHighlightColorWithContext(ctx goctx.Context, request *requests.FPDF_SetFormFieldHighlightColor) (*responses.FPDF_SetFormFieldHighlightColor, error) {
+	resp, err := i.interceptor(ctx, "FPDF_SetFormFieldHighlightColor", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_GetPageSizeByIndexF(request)
-}
+		done, err := i.startCall(ctx, "FPDF_SetFormFieldHighlightColor", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_GetPageWidth(request *requests.FPDF_GetPageWidth) (resp *responses.FPDF_GetPageWidth, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SetFormFieldHighlightColor", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageWidth", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_SetFormFieldHighlightColor(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_SetFormFieldHighlightColor)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_GetPageWidth(request)
+func (i *pdfiumInstance) FPDF_SetPrintMode(request *requests.FPDF_SetPrintMode) (*responses.FPDF_SetPrintMode, error) {
+	return i.FPDF_SetPrintModeWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_GetPageWidthF(request *requests.FPDF_GetPageWidthF) (resp *responses.FPDF_GetPageWidthF, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_SetPrintModeWithContext(ctx goctx.Context, request *requests.FPDF_SetPrintMode) (*responses.FPDF_SetPrintMode, error) {
+	resp, err := i.interceptor(ctx, "FPDF_SetPrintMode", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetPageWidthF", panicError)
+		done, err := i.startCall(ctx, "FPDF_SetPrintMode", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDF_GetPageWidthF(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SetPrintMode", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_SetPrintMode(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_SetPrintMode)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_GetSecurityHandlerRevision(request *requests.FPDF_GetSecurityHandlerRevision) (resp *responses.FPDF_GetSecurityHandlerRevision, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_SetSandBoxPolicy(request *requests.FPDF_SetSandBoxPolicy) (*responses.FPDF_SetSandBoxPolicy, error) {
+	return i.FPDF_SetSandBoxPolicyWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetSecurityHandlerRevision", panicError)
+func (i *pdfiumInstance) FPDF_SetSandBoxPolicyWithContext(ctx goctx.Context, request *requests.FPDF_SetSandBoxPolicy) (*responses.FPDF_SetSandBoxPolicy, error) {
+	resp, err := i.interceptor(ctx, "FPDF_SetSandBoxPolicy", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_GetSecurityHandlerRevision(request)
-}
+		done, err := i.startCall(ctx, "FPDF_SetSandBoxPolicy", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_GetSignatureCount(request *requests.FPDF_GetSignatureCount) (resp *responses.FPDF_GetSignatureCount, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SetSandBoxPolicy", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetSignatureCount", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_SetSandBoxPolicy(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_SetSandBoxPolicy)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_GetSignatureCount(request)
+func (i *pdfiumInstance) FPDF_StructElement_Attr_CountChildren(request *requests.FPDF_StructElement_Attr_CountChildren) (*responses.FPDF_StructElement_Attr_CountChildren, error) {
+	return i.FPDF_StructElement_Attr_CountChildrenWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_GetSignatureObject(request *requests.FPDF_GetSignatureObject) (resp *responses.FPDF_GetSignatureObject, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_Attr_CountChildrenWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_CountChildren) (*responses.FPDF_StructElement_Attr_CountChildren, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_Attr_CountChildren", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetSignatureObject", panicError)
+		done, err := i.startCall(ctx, "FPDF_StructElement_Attr_CountChildren", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_CountChildren", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDF_GetSignatureObject(request)
+		return i.worker.Instance.FPDF_StructElement_Attr_CountChildren(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_CountChildren)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_GetTrailerEnds(request *requests.FPDF_GetTrailerEnds) (resp *responses.FPDF_GetTrailerEnds, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_Attr_GetBlobValue(request *requests.FPDF_StructElement_Attr_GetBlobValue) (*responses.FPDF_StructElement_Attr_GetBlobValue, error) {
+	return i.FPDF_StructElement_Attr_GetBlobValueWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetTrailerEnds", panicError)
+func (i *pdfiumInstance) FPDF_StructElement_Attr_GetBlobValueWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetBlobValue) (*responses.FPDF_StructElement_Attr_GetBlobValue, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_Attr_GetBlobValue", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_GetTrailerEnds(request)
-}
+		done, err := i.startCall(ctx, "FPDF_StructElement_Attr_GetBlobValue", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_GetXFAPacketContent(request *requests.FPDF_GetXFAPacketContent) (resp *responses.FPDF_GetXFAPacketContent, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetBlobValue", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetXFAPacketContent", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_StructElement_Attr_GetBlobValue(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_GetBlobValue)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_GetXFAPacketContent(request)
+func (i *pdfiumInstance) FPDF_StructElement_Attr_GetBooleanValue(request *requests.FPDF_StructElement_Attr_GetBooleanValue) (*responses.FPDF_StructElement_Attr_GetBooleanValue, error) {
+	return i.FPDF_StructElement_Attr_GetBooleanValueWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_GetXFAPacketCount(request *requests.FPDF_GetXFAPacketCount) (resp *responses.FPDF_GetXFAPacketCount, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_Attr_GetBooleanValueWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetBooleanValue) (*responses.FPDF_StructElement_Attr_GetBooleanValue, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_Attr_GetBooleanValue", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetXFAPacketCount", panicError)
+		done, err := i.startCall(ctx, "FPDF_StructElement_Attr_GetBooleanValue", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDF_GetXFAPacketCount(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetBooleanValue", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_StructElement_Attr_GetBooleanValue(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_GetBooleanValue)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_GetXFAPacketName(request *requests.FPDF_GetXFAPacketName) (resp *responses.FPDF_GetXFAPacketName, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_Attr_GetChildAtIndex(request *requests.FPDF_StructElement_Attr_GetChildAtIndex) (*responses.FPDF_StructElement_Attr_GetChildAtIndex, error) {
+	return i.FPDF_StructElement_Attr_GetChildAtIndexWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_GetXFAPacketName", panicError)
+func (i *pdfiumInstance) FPDF_StructElement_Attr_GetChildAtIndexWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetChildAtIndex) (*responses.FPDF_StructElement_Attr_GetChildAtIndex, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_Attr_GetChildAtIndex", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_GetXFAPacketName(request)
-}
+		done, err := i.startCall(ctx, "FPDF_StructElement_Attr_GetChildAtIndex", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_ImportNPagesToOne(request *requests.FPDF_ImportNPagesToOne) (resp *responses.FPDF_ImportNPagesToOne, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetChildAtIndex", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_ImportNPagesToOne", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_StructElement_Attr_GetChildAtIndex(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_GetChildAtIndex)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_ImportNPagesToOne(request)
+func (i *pdfiumInstance) FPDF_StructElement_Attr_GetCount(request *requests.FPDF_StructElement_Attr_GetCount) (*responses.FPDF_StructElement_Attr_GetCount, error) {
+	return i.FPDF_StructElement_Attr_GetCountWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_ImportPages(request *requests.FPDF_ImportPages) (resp *responses.FPDF_ImportPages, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_Attr_GetCountWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetCount) (*responses.FPDF_StructElement_Attr_GetCount, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_Attr_GetCount", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_ImportPages", panicError)
+		done, err := i.startCall(ctx, "FPDF_StructElement_Attr_GetCount", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetCount", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDF_ImportPages(request)
+		return i.worker.Instance.FPDF_StructElement_Attr_GetCount(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_GetCount)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_ImportPagesByIndex(request *requests.FPDF_ImportPagesByIndex) (resp *responses.FPDF_ImportPagesByIndex, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_Attr_GetName(request *requests.FPDF_StructElement_Attr_GetName) (*responses.FPDF_StructElement_Attr_GetName, error) {
+	return i.FPDF_StructElement_Attr_GetNameWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_ImportPagesByIndex", panicError)
+func (i *pdfiumInstance) FPDF_StructElement_Attr_GetNameWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetName) (*responses.FPDF_StructElement_Attr_GetName, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_Attr_GetName", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_ImportPagesByIndex(request)
-}
+		done, err := i.startCall(ctx, "FPDF_StructElement_Attr_GetName", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_LoadCustomDocument(request *requests.FPDF_LoadCustomDocument) (resp *responses.FPDF_LoadCustomDocument, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetName", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_LoadCustomDocument", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_StructElement_Attr_GetName(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_GetName)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_LoadCustomDocument(request)
+func (i *pdfiumInstance) FPDF_StructElement_Attr_GetNumberValue(request *requests.FPDF_StructElement_Attr_GetNumberValue) (*responses.FPDF_StructElement_Attr_GetNumberValue, error) {
+	return i.FPDF_StructElement_Attr_GetNumberValueWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_LoadDocument(request *requests.FPDF_LoadDocument) (resp *responses.FPDF_LoadDocument, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_Attr_GetNumberValueWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetNumberValue) (*responses.FPDF_StructElement_Attr_GetNumberValue, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_Attr_GetNumberValue", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_LoadDocument", panicError)
+		done, err := i.startCall(ctx, "FPDF_StructElement_Attr_GetNumberValue", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDF_LoadDocument(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetNumberValue", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_StructElement_Attr_GetNumberValue(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_GetNumberValue)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_LoadMemDocument(request *requests.FPDF_LoadMemDocument) (resp *responses.FPDF_LoadMemDocument, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_Attr_GetStringValue(request *requests.FPDF_StructElement_Attr_GetStringValue) (*responses.FPDF_StructElement_Attr_GetStringValue, error) {
+	return i.FPDF_StructElement_Attr_GetStringValueWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_LoadMemDocument", panicError)
+func (i *pdfiumInstance) FPDF_StructElement_Attr_GetStringValueWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetStringValue) (*responses.FPDF_StructElement_Attr_GetStringValue, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_Attr_GetStringValue", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_LoadMemDocument(request)
-}
+		done, err := i.startCall(ctx, "FPDF_StructElement_Attr_GetStringValue", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_LoadMemDocument64(request *requests.FPDF_LoadMemDocument64) (resp *responses.FPDF_LoadMemDocument64, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetStringValue", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_LoadMemDocument64", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_StructElement_Attr_GetStringValue(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_GetStringValue)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_LoadMemDocument64(request)
+func (i *pdfiumInstance) FPDF_StructElement_Attr_GetType(request *requests.FPDF_StructElement_Attr_GetType) (*responses.FPDF_StructElement_Attr_GetType, error) {
+	return i.FPDF_StructElement_Attr_GetTypeWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_LoadPage(request *requests.FPDF_LoadPage) (resp *responses.FPDF_LoadPage, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_Attr_GetTypeWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetType) (*responses.FPDF_StructElement_Attr_GetType, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_Attr_GetType", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_LoadPage", panicError)
+		done, err := i.startCall(ctx, "FPDF_StructElement_Attr_GetType", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetType", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDF_LoadPage(request)
+		return i.worker.Instance.FPDF_StructElement_Attr_GetType(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_GetType)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_LoadXFA(request *requests.FPDF_LoadXFA) (resp *responses.FPDF_LoadXFA, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_Attr_GetValue(request *requests.FPDF_StructElement_Attr_GetValue) (*responses.FPDF_StructElement_Attr_GetValue, error) {
+	return i.FPDF_StructElement_Attr_GetValueWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_LoadXFA", panicError)
+func (i *pdfiumInstance) FPDF_StructElement_Attr_GetValueWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_Attr_GetValue) (*responses.FPDF_StructElement_Attr_GetValue, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_Attr_GetValue", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_LoadXFA(request)
-}
+		done, err := i.startCall(ctx, "FPDF_StructElement_Attr_GetValue", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_MovePages(request *requests.FPDF_MovePages) (resp *responses.FPDF_MovePages, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetValue", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_MovePages", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_StructElement_Attr_GetValue(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_Attr_GetValue)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_MovePages(request)
+func (i *pdfiumInstance) FPDF_StructElement_CountChildren(request *requests.FPDF_StructElement_CountChildren) (*responses.FPDF_StructElement_CountChildren, error) {
+	return i.FPDF_StructElement_CountChildrenWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_NewFormObjectFromXObject(request *requests.FPDF_NewFormObjectFromXObject) (resp *responses.FPDF_NewFormObjectFromXObject, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_CountChildrenWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_CountChildren) (*responses.FPDF_StructElement_CountChildren, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_CountChildren", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_NewFormObjectFromXObject", panicError)
+		done, err := i.startCall(ctx, "FPDF_StructElement_CountChildren", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDF_NewFormObjectFromXObject(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_CountChildren", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_StructElement_CountChildren(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_CountChildren)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_NewXObjectFromPage(request *requests.FPDF_NewXObjectFromPage) (resp *responses.FPDF_NewXObjectFromPage, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_GetActualText(request *requests.FPDF_StructElement_GetActualText) (*responses.FPDF_StructElement_GetActualText, error) {
+	return i.FPDF_StructElement_GetActualTextWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_NewXObjectFromPage", panicError)
+func (i *pdfiumInstance) FPDF_StructElement_GetActualTextWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetActualText) (*responses.FPDF_StructElement_GetActualText, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_GetActualText", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_NewXObjectFromPage(request)
-}
+		done, err := i.startCall(ctx, "FPDF_StructElement_GetActualText", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_PageToDevice(request *requests.FPDF_PageToDevice) (resp *responses.FPDF_PageToDevice, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetActualText", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_PageToDevice", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_StructElement_GetActualText(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetActualText)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_PageToDevice(request)
+func (i *pdfiumInstance) FPDF_StructElement_GetAltText(request *requests.FPDF_StructElement_GetAltText) (*responses.FPDF_StructElement_GetAltText, error) {
+	return i.FPDF_StructElement_GetAltTextWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_RemoveFormFieldHighlight(request *requests.FPDF_RemoveFormFieldHighlight) (resp *responses.FPDF_RemoveFormFieldHighlight, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_GetAltTextWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetAltText) (*responses.FPDF_StructElement_GetAltText, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_GetAltText", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RemoveFormFieldHighlight", panicError)
+		done, err := i.startCall(ctx, "FPDF_StructElement_GetAltText", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetAltText", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDF_RemoveFormFieldHighlight(request)
+		return i.worker.Instance.FPDF_StructElement_GetAltText(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetAltText)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_RenderPage(request *requests.FPDF_RenderPage) (resp *responses.FPDF_RenderPage, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_GetAttributeAtIndex(request *requests.FPDF_StructElement_GetAttributeAtIndex) (*responses.FPDF_StructElement_GetAttributeAtIndex, error) {
+	return i.FPDF_StructElement_GetAttributeAtIndexWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPage", panicError)
+func (i *pdfiumInstance) FPDF_StructElement_GetAttributeAtIndexWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetAttributeAtIndex) (*responses.FPDF_StructElement_GetAttributeAtIndex, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_GetAttributeAtIndex", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_RenderPage(request)
-}
+		done, err := i.startCall(ctx, "FPDF_StructElement_GetAttributeAtIndex", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_RenderPageBitmap(request *requests.FPDF_RenderPageBitmap) (resp *responses.FPDF_RenderPageBitmap, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetAttributeAtIndex", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPageBitmap", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_StructElement_GetAttributeAtIndex(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetAttributeAtIndex)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_RenderPageBitmap(request)
+func (i *pdfiumInstance) FPDF_StructElement_GetAttributeCount(request *requests.FPDF_StructElement_GetAttributeCount) (*responses.FPDF_StructElement_GetAttributeCount, error) {
+	return i.FPDF_StructElement_GetAttributeCountWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_RenderPageBitmapWithColorScheme_Start(request *requests.FPDF_RenderPageBitmapWithColorScheme_Start) (resp *responses.FPDF_RenderPageBitmapWithColorScheme_Start, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_GetAttributeCountWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetAttributeCount) (*responses.FPDF_StructElement_GetAttributeCount, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_GetAttributeCount", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPageBitmapWithColorScheme_Start", panicError)
+		done, err := i.startCall(ctx, "FPDF_StructElement_GetAttributeCount", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDF_RenderPageBitmapWithColorScheme_Start(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetAttributeCount", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_StructElement_GetAttributeCount(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetAttributeCount)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_RenderPageBitmapWithMatrix(request *requests.FPDF_RenderPageBitmapWithMatrix) (resp *responses.FPDF_RenderPageBitmapWithMatrix, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_GetChildAtIndex(request *requests.FPDF_StructElement_GetChildAtIndex) (*responses.FPDF_StructElement_GetChildAtIndex, error) {
+	return i.FPDF_StructElement_GetChildAtIndexWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPageBitmapWithMatrix", panicError)
+func (i *pdfiumInstance) FPDF_StructElement_GetChildAtIndexWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetChildAtIndex) (*responses.FPDF_StructElement_GetChildAtIndex, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_GetChildAtIndex", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_RenderPageBitmapWithMatrix(request)
-}
+		done, err := i.startCall(ctx, "FPDF_StructElement_GetChildAtIndex", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_RenderPageBitmap_Start(request *requests.FPDF_RenderPageBitmap_Start) (resp *responses.FPDF_RenderPageBitmap_Start, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetChildAtIndex", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPageBitmap_Start", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_StructElement_GetChildAtIndex(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetChildAtIndex)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_RenderPageBitmap_Start(request)
+func (i *pdfiumInstance) FPDF_StructElement_GetChildMarkedContentID(request *requests.FPDF_StructElement_GetChildMarkedContentID) (*responses.FPDF_StructElement_GetChildMarkedContentID, error) {
+	return i.FPDF_StructElement_GetChildMarkedContentIDWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_RenderPage_Close(request *requests.FPDF_RenderPage_Close) (resp *responses.FPDF_RenderPage_Close, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_GetChildMarkedContentIDWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetChildMarkedContentID) (*responses.FPDF_StructElement_GetChildMarkedContentID, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_GetChildMarkedContentID", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPage_Close", panicError)
+		done, err := i.startCall(ctx, "FPDF_StructElement_GetChildMarkedContentID", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetChildMarkedContentID", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDF_RenderPage_Close(request)
+		return i.worker.Instance.FPDF_StructElement_GetChildMarkedContentID(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetChildMarkedContentID)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_RenderPage_Continue(request *requests.FPDF_RenderPage_Continue) (resp *responses.FPDF_RenderPage_Continue, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_GetExpansion(request *requests.FPDF_StructElement_GetExpansion) (*responses.FPDF_StructElement_GetExpansion, error) {
+	return i.FPDF_StructElement_GetExpansionWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_RenderPage_Continue", panicError)
+func (i *pdfiumInstance) FPDF_StructElement_GetExpansionWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetExpansion) (*responses.FPDF_StructElement_GetExpansion, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_GetExpansion", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_RenderPage_Continue(request)
-}
+		done, err := i.startCall(ctx, "FPDF_StructElement_GetExpansion", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_SaveAsCopy(request *requests.FPDF_SaveAsCopy) (resp *responses.FPDF_SaveAsCopy, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetExpansion", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SaveAsCopy", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_StructElement_GetExpansion(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetExpansion)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_SaveAsCopy(request)
+func (i *pdfiumInstance) FPDF_StructElement_GetID(request *requests.FPDF_StructElement_GetID) (*responses.FPDF_StructElement_GetID, error) {
+	return i.FPDF_StructElement_GetIDWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_SaveWithVersion(request *requests.FPDF_SaveWithVersion) (resp *responses.FPDF_SaveWithVersion, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_GetIDWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetID) (*responses.FPDF_StructElement_GetID, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_GetID", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SaveWithVersion", panicError)
+		done, err := i.startCall(ctx, "FPDF_StructElement_GetID", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDF_SaveWithVersion(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetID", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_StructElement_GetID(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetID)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_SetFormFieldHighlightAlpha(request *requests.FPDF_SetFormFieldHighlightAlpha) (resp *responses.FPDF_SetFormFieldHighlightAlpha, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_GetLang(request *requests.FPDF_StructElement_GetLang) (*responses.FPDF_StructElement_GetLang, error) {
+	return i.FPDF_StructElement_GetLangWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SetFormFieldHighlightAlpha", panicError)
+func (i *pdfiumInstance) FPDF_StructElement_GetLangWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetLang) (*responses.FPDF_StructElement_GetLang, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_GetLang", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_SetFormFieldHighlightAlpha(request)
-}
+		done, err := i.startCall(ctx, "FPDF_StructElement_GetLang", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_SetFormFieldHighlightColor(request *requests.FPDF_SetFormFieldHighlightColor) (resp *responses.FPDF_SetFormFieldHighlightColor, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetLang", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SetFormFieldHighlightColor", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_StructElement_GetLang(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetLang)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_SetFormFieldHighlightColor(request)
+func (i *pdfiumInstance) FPDF_StructElement_GetMarkedContentID(request *requests.FPDF_StructElement_GetMarkedContentID) (*responses.FPDF_StructElement_GetMarkedContentID, error) {
+	return i.FPDF_StructElement_GetMarkedContentIDWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_SetPrintMode(request *requests.FPDF_SetPrintMode) (resp *responses.FPDF_SetPrintMode, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_GetMarkedContentIDWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetMarkedContentID) (*responses.FPDF_StructElement_GetMarkedContentID, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_GetMarkedContentID", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SetPrintMode", panicError)
+		done, err := i.startCall(ctx, "FPDF_StructElement_GetMarkedContentID", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetMarkedContentID", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDF_SetPrintMode(request)
+		return i.worker.Instance.FPDF_StructElement_GetMarkedContentID(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetMarkedContentID)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_SetSandBoxPolicy(request *requests.FPDF_SetSandBoxPolicy) (resp *responses.FPDF_SetSandBoxPolicy, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_GetMarkedContentIdAtIndex(request *requests.FPDF_StructElement_GetMarkedContentIdAtIndex) (*responses.FPDF_StructElement_GetMarkedContentIdAtIndex, error) {
+	return i.FPDF_StructElement_GetMarkedContentIdAtIndexWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_SetSandBoxPolicy", panicError)
+func (i *pdfiumInstance) FPDF_StructElement_GetMarkedContentIdAtIndexWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetMarkedContentIdAtIndex) (*responses.FPDF_StructElement_GetMarkedContentIdAtIndex, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_GetMarkedContentIdAtIndex", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_SetSandBoxPolicy(request)
-}
+		done, err := i.startCall(ctx, "FPDF_StructElement_GetMarkedContentIdAtIndex", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_StructElement_Attr_CountChildren(request *requests.FPDF_StructElement_Attr_CountChildren) (resp *responses.FPDF_StructElement_Attr_CountChildren, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetMarkedContentIdAtIndex", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_CountChildren", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_StructElement_GetMarkedContentIdAtIndex(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetMarkedContentIdAtIndex)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_StructElement_Attr_CountChildren(request)
+func (i *pdfiumInstance) FPDF_StructElement_GetMarkedContentIdCount(request *requests.FPDF_StructElement_GetMarkedContentIdCount) (*responses.FPDF_StructElement_GetMarkedContentIdCount, error) {
+	return i.FPDF_StructElement_GetMarkedContentIdCountWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_StructElement_Attr_GetBlobValue(request *requests.FPDF_StructElement_Attr_GetBlobValue) (resp *responses.FPDF_StructElement_Attr_GetBlobValue, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_GetMarkedContentIdCountWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetMarkedContentIdCount) (*responses.FPDF_StructElement_GetMarkedContentIdCount, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_GetMarkedContentIdCount", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetBlobValue", panicError)
+		done, err := i.startCall(ctx, "FPDF_StructElement_GetMarkedContentIdCount", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDF_StructElement_Attr_GetBlobValue(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetMarkedContentIdCount", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_StructElement_GetMarkedContentIdCount(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetMarkedContentIdCount)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_StructElement_Attr_GetBooleanValue(request *requests.FPDF_StructElement_Attr_GetBooleanValue) (resp *responses.FPDF_StructElement_Attr_GetBooleanValue, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_GetObjType(request *requests.FPDF_StructElement_GetObjType) (*responses.FPDF_StructElement_GetObjType, error) {
+	return i.FPDF_StructElement_GetObjTypeWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetBooleanValue", panicError)
+func (i *pdfiumInstance) FPDF_StructElement_GetObjTypeWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetObjType) (*responses.FPDF_StructElement_GetObjType, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_GetObjType", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_StructElement_Attr_GetBooleanValue(request)
-}
+		done, err := i.startCall(ctx, "FPDF_StructElement_GetObjType", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_StructElement_Attr_GetChildAtIndex(request *requests.FPDF_StructElement_Attr_GetChildAtIndex) (resp *responses.FPDF_StructElement_Attr_GetChildAtIndex, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetObjType", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetChildAtIndex", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_StructElement_GetObjType(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetObjType)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_StructElement_Attr_GetChildAtIndex(request)
+func (i *pdfiumInstance) FPDF_StructElement_GetParent(request *requests.FPDF_StructElement_GetParent) (*responses.FPDF_StructElement_GetParent, error) {
+	return i.FPDF_StructElement_GetParentWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_StructElement_Attr_GetCount(request *requests.FPDF_StructElement_Attr_GetCount) (resp *responses.FPDF_StructElement_Attr_GetCount, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_GetParentWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetParent) (*responses.FPDF_StructElement_GetParent, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_GetParent", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetCount", panicError)
+		done, err := i.startCall(ctx, "FPDF_StructElement_GetParent", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetParent", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDF_StructElement_Attr_GetCount(request)
+		return i.worker.Instance.FPDF_StructElement_GetParent(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetParent)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_StructElement_Attr_GetName(request *requests.FPDF_StructElement_Attr_GetName) (resp *responses.FPDF_StructElement_Attr_GetName, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_GetStringAttribute(request *requests.FPDF_StructElement_GetStringAttribute) (*responses.FPDF_StructElement_GetStringAttribute, error) {
+	return i.FPDF_StructElement_GetStringAttributeWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetName", panicError)
+func (i *pdfiumInstance) FPDF_StructElement_GetStringAttributeWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetStringAttribute) (*responses.FPDF_StructElement_GetStringAttribute, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_GetStringAttribute", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_StructElement_Attr_GetName(request)
-}
+		done, err := i.startCall(ctx, "FPDF_StructElement_GetStringAttribute", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_StructElement_Attr_GetNumberValue(request *requests.FPDF_StructElement_Attr_GetNumberValue) (resp *responses.FPDF_StructElement_Attr_GetNumberValue, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetStringAttribute", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetNumberValue", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_StructElement_GetStringAttribute(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetStringAttribute)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_StructElement_Attr_GetNumberValue(request)
+func (i *pdfiumInstance) FPDF_StructElement_GetTitle(request *requests.FPDF_StructElement_GetTitle) (*responses.FPDF_StructElement_GetTitle, error) {
+	return i.FPDF_StructElement_GetTitleWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_StructElement_Attr_GetStringValue(request *requests.FPDF_StructElement_Attr_GetStringValue) (resp *responses.FPDF_StructElement_Attr_GetStringValue, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_GetTitleWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetTitle) (*responses.FPDF_StructElement_GetTitle, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_GetTitle", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetStringValue", panicError)
+		done, err := i.startCall(ctx, "FPDF_StructElement_GetTitle", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDF_StructElement_Attr_GetStringValue(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetTitle", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_StructElement_GetTitle(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetTitle)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_StructElement_Attr_GetType(request *requests.FPDF_StructElement_Attr_GetType) (resp *responses.FPDF_StructElement_Attr_GetType, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructElement_GetType(request *requests.FPDF_StructElement_GetType) (*responses.FPDF_StructElement_GetType, error) {
+	return i.FPDF_StructElement_GetTypeWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetType", panicError)
+func (i *pdfiumInstance) FPDF_StructElement_GetTypeWithContext(ctx goctx.Context, request *requests.FPDF_StructElement_GetType) (*responses.FPDF_StructElement_GetType, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructElement_GetType", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_StructElement_Attr_GetType(request)
-}
+		done, err := i.startCall(ctx, "FPDF_StructElement_GetType", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_StructElement_Attr_GetValue(request *requests.FPDF_StructElement_Attr_GetValue) (resp *responses.FPDF_StructElement_Attr_GetValue, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetType", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_Attr_GetValue", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_StructElement_GetType(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructElement_GetType)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_StructElement_Attr_GetValue(request)
+func (i *pdfiumInstance) FPDF_StructTree_Close(request *requests.FPDF_StructTree_Close) (*responses.FPDF_StructTree_Close, error) {
+	return i.FPDF_StructTree_CloseWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_StructElement_CountChildren(request *requests.FPDF_StructElement_CountChildren) (resp *responses.FPDF_StructElement_CountChildren, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructTree_CloseWithContext(ctx goctx.Context, request *requests.FPDF_StructTree_Close) (*responses.FPDF_StructTree_Close, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructTree_Close", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_CountChildren", panicError)
+		done, err := i.startCall(ctx, "FPDF_StructTree_Close", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructTree_Close", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDF_StructElement_CountChildren(request)
+		return i.worker.Instance.FPDF_StructTree_Close(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructTree_Close)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_StructElement_GetActualText(request *requests.FPDF_StructElement_GetActualText) (resp *responses.FPDF_StructElement_GetActualText, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructTree_CountChildren(request *requests.FPDF_StructTree_CountChildren) (*responses.FPDF_StructTree_CountChildren, error) {
+	return i.FPDF_StructTree_CountChildrenWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetActualText", panicError)
+func (i *pdfiumInstance) FPDF_StructTree_CountChildrenWithContext(ctx goctx.Context, request *requests.FPDF_StructTree_CountChildren) (*responses.FPDF_StructTree_CountChildren, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructTree_CountChildren", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_StructElement_GetActualText(request)
-}
+		done, err := i.startCall(ctx, "FPDF_StructTree_CountChildren", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_StructElement_GetAltText(request *requests.FPDF_StructElement_GetAltText) (resp *responses.FPDF_StructElement_GetAltText, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructTree_CountChildren", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetAltText", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_StructTree_CountChildren(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructTree_CountChildren)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_StructElement_GetAltText(request)
+func (i *pdfiumInstance) FPDF_StructTree_GetChildAtIndex(request *requests.FPDF_StructTree_GetChildAtIndex) (*responses.FPDF_StructTree_GetChildAtIndex, error) {
+	return i.FPDF_StructTree_GetChildAtIndexWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_StructElement_GetAttributeAtIndex(request *requests.FPDF_StructElement_GetAttributeAtIndex) (resp *responses.FPDF_StructElement_GetAttributeAtIndex, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructTree_GetChildAtIndexWithContext(ctx goctx.Context, request *requests.FPDF_StructTree_GetChildAtIndex) (*responses.FPDF_StructTree_GetChildAtIndex, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructTree_GetChildAtIndex", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetAttributeAtIndex", panicError)
+		done, err := i.startCall(ctx, "FPDF_StructTree_GetChildAtIndex", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDF_StructElement_GetAttributeAtIndex(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructTree_GetChildAtIndex", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_StructTree_GetChildAtIndex(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructTree_GetChildAtIndex)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_StructElement_GetAttributeCount(request *requests.FPDF_StructElement_GetAttributeCount) (resp *responses.FPDF_StructElement_GetAttributeCount, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_StructTree_GetForPage(request *requests.FPDF_StructTree_GetForPage) (*responses.FPDF_StructTree_GetForPage, error) {
+	return i.FPDF_StructTree_GetForPageWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetAttributeCount", panicError)
+func (i *pdfiumInstance) FPDF_StructTree_GetForPageWithContext(ctx goctx.Context, request *requests.FPDF_StructTree_GetForPage) (*responses.FPDF_StructTree_GetForPage, error) {
+	resp, err := i.interceptor(ctx, "FPDF_StructTree_GetForPage", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_StructElement_GetAttributeCount(request)
-}
+		done, err := i.startCall(ctx, "FPDF_StructTree_GetForPage", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_StructElement_GetChildAtIndex(request *requests.FPDF_StructElement_GetChildAtIndex) (resp *responses.FPDF_StructElement_GetChildAtIndex, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructTree_GetForPage", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetChildAtIndex", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_StructTree_GetForPage(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_StructTree_GetForPage)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_StructElement_GetChildAtIndex(request)
+func (i *pdfiumInstance) FPDF_VIEWERREF_GetDuplex(request *requests.FPDF_VIEWERREF_GetDuplex) (*responses.FPDF_VIEWERREF_GetDuplex, error) {
+	return i.FPDF_VIEWERREF_GetDuplexWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_StructElement_GetChildMarkedContentID(request *requests.FPDF_StructElement_GetChildMarkedContentID) (resp *responses.FPDF_StructElement_GetChildMarkedContentID, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_VIEWERREF_GetDuplexWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetDuplex) (*responses.FPDF_VIEWERREF_GetDuplex, error) {
+	resp, err := i.interceptor(ctx, "FPDF_VIEWERREF_GetDuplex", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetChildMarkedContentID", panicError)
+		done, err := i.startCall(ctx, "FPDF_VIEWERREF_GetDuplex", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetDuplex", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDF_StructElement_GetChildMarkedContentID(request)
+		return i.worker.Instance.FPDF_VIEWERREF_GetDuplex(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_VIEWERREF_GetDuplex)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_StructElement_GetExpansion(request *requests.FPDF_StructElement_GetExpansion) (resp *responses.FPDF_StructElement_GetExpansion, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_VIEWERREF_GetName(request *requests.FPDF_VIEWERREF_GetName) (*responses.FPDF_VIEWERREF_GetName, error) {
+	return i.FPDF_VIEWERREF_GetNameWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetExpansion", panicError)
+func (i *pdfiumInstance) FPDF_VIEWERREF_GetNameWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetName) (*responses.FPDF_VIEWERREF_GetName, error) {
+	resp, err := i.interceptor(ctx, "FPDF_VIEWERREF_GetName", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_StructElement_GetExpansion(request)
-}
+		done, err := i.startCall(ctx, "FPDF_VIEWERREF_GetName", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_StructElement_GetID(request *requests.FPDF_StructElement_GetID) (resp *responses.FPDF_StructElement_GetID, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetName", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetID", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_VIEWERREF_GetName(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_VIEWERREF_GetName)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_StructElement_GetID(request)
+func (i *pdfiumInstance) FPDF_VIEWERREF_GetNumCopies(request *requests.FPDF_VIEWERREF_GetNumCopies) (*responses.FPDF_VIEWERREF_GetNumCopies, error) {
+	return i.FPDF_VIEWERREF_GetNumCopiesWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_StructElement_GetLang(request *requests.FPDF_StructElement_GetLang) (resp *responses.FPDF_StructElement_GetLang, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_VIEWERREF_GetNumCopiesWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetNumCopies) (*responses.FPDF_VIEWERREF_GetNumCopies, error) {
+	resp, err := i.interceptor(ctx, "FPDF_VIEWERREF_GetNumCopies", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetLang", panicError)
+		done, err := i.startCall(ctx, "FPDF_VIEWERREF_GetNumCopies", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDF_StructElement_GetLang(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetNumCopies", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_VIEWERREF_GetNumCopies(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_VIEWERREF_GetNumCopies)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_StructElement_GetMarkedContentID(request *requests.FPDF_StructElement_GetMarkedContentID) (resp *responses.FPDF_StructElement_GetMarkedContentID, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_VIEWERREF_GetPrintPageRange(request *requests.FPDF_VIEWERREF_GetPrintPageRange) (*responses.FPDF_VIEWERREF_GetPrintPageRange, error) {
+	return i.FPDF_VIEWERREF_GetPrintPageRangeWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetMarkedContentID", panicError)
+func (i *pdfiumInstance) FPDF_VIEWERREF_GetPrintPageRangeWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetPrintPageRange) (*responses.FPDF_VIEWERREF_GetPrintPageRange, error) {
+	resp, err := i.interceptor(ctx, "FPDF_VIEWERREF_GetPrintPageRange", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_StructElement_GetMarkedContentID(request)
-}
+		done, err := i.startCall(ctx, "FPDF_VIEWERREF_GetPrintPageRange", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_StructElement_GetMarkedContentIdAtIndex(request *requests.FPDF_StructElement_GetMarkedContentIdAtIndex) (resp *responses.FPDF_StructElement_GetMarkedContentIdAtIndex, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetPrintPageRange", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetMarkedContentIdAtIndex", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_VIEWERREF_GetPrintPageRange(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_VIEWERREF_GetPrintPageRange)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_StructElement_GetMarkedContentIdAtIndex(request)
+func (i *pdfiumInstance) FPDF_VIEWERREF_GetPrintPageRangeCount(request *requests.FPDF_VIEWERREF_GetPrintPageRangeCount) (*responses.FPDF_VIEWERREF_GetPrintPageRangeCount, error) {
+	return i.FPDF_VIEWERREF_GetPrintPageRangeCountWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_StructElement_GetMarkedContentIdCount(request *requests.FPDF_StructElement_GetMarkedContentIdCount) (resp *responses.FPDF_StructElement_GetMarkedContentIdCount, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_VIEWERREF_GetPrintPageRangeCountWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetPrintPageRangeCount) (*responses.FPDF_VIEWERREF_GetPrintPageRangeCount, error) {
+	resp, err := i.interceptor(ctx, "FPDF_VIEWERREF_GetPrintPageRangeCount", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetMarkedContentIdCount", panicError)
+		done, err := i.startCall(ctx, "FPDF_VIEWERREF_GetPrintPageRangeCount", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetPrintPageRangeCount", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDF_StructElement_GetMarkedContentIdCount(request)
+		return i.worker.Instance.FPDF_VIEWERREF_GetPrintPageRangeCount(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_VIEWERREF_GetPrintPageRangeCount)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_StructElement_GetObjType(request *requests.FPDF_StructElement_GetObjType) (resp *responses.FPDF_StructElement_GetObjType, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_VIEWERREF_GetPrintPageRangeElement(request *requests.FPDF_VIEWERREF_GetPrintPageRangeElement) (*responses.FPDF_VIEWERREF_GetPrintPageRangeElement, error) {
+	return i.FPDF_VIEWERREF_GetPrintPageRangeElementWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetObjType", panicError)
+func (i *pdfiumInstance) FPDF_VIEWERREF_GetPrintPageRangeElementWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetPrintPageRangeElement) (*responses.FPDF_VIEWERREF_GetPrintPageRangeElement, error) {
+	resp, err := i.interceptor(ctx, "FPDF_VIEWERREF_GetPrintPageRangeElement", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_StructElement_GetObjType(request)
-}
+		done, err := i.startCall(ctx, "FPDF_VIEWERREF_GetPrintPageRangeElement", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_StructElement_GetParent(request *requests.FPDF_StructElement_GetParent) (resp *responses.FPDF_StructElement_GetParent, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetPrintPageRangeElement", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetParent", panicError)
-		}
-	}()
+		return i.worker.Instance.FPDF_VIEWERREF_GetPrintPageRangeElement(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_VIEWERREF_GetPrintPageRangeElement)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_StructElement_GetParent(request)
+func (i *pdfiumInstance) FPDF_VIEWERREF_GetPrintScaling(request *requests.FPDF_VIEWERREF_GetPrintScaling) (*responses.FPDF_VIEWERREF_GetPrintScaling, error) {
+	return i.FPDF_VIEWERREF_GetPrintScalingWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_StructElement_GetStringAttribute(request *requests.FPDF_StructElement_GetStringAttribute) (resp *responses.FPDF_StructElement_GetStringAttribute, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FPDF_VIEWERREF_GetPrintScalingWithContext(ctx goctx.Context, request *requests.FPDF_VIEWERREF_GetPrintScaling) (*responses.FPDF_VIEWERREF_GetPrintScaling, error) {
+	resp, err := i.interceptor(ctx, "FPDF_VIEWERREF_GetPrintScaling", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetStringAttribute", panicError)
+		done, err := i.startCall(ctx, "FPDF_VIEWERREF_GetPrintScaling", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDF_StructElement_GetStringAttribute(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetPrintScaling", panicError)
+			}
+		}()
+
+		return i.worker.Instance.FPDF_VIEWERREF_GetPrintScaling(request)
+	})
+	typedResp, _ := resp.(*responses.FPDF_VIEWERREF_GetPrintScaling)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_StructElement_GetTitle(request *requests.FPDF_StructElement_GetTitle) (resp *responses.FPDF_StructElement_GetTitle, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FSDK_SetLocaltimeFunction(request *requests.FSDK_SetLocaltimeFunction) (*responses.FSDK_SetLocaltimeFunction, error) {
+	return i.FSDK_SetLocaltimeFunctionWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetTitle", panicError)
+func (i *pdfiumInstance) FSDK_SetLocaltimeFunctionWithContext(ctx goctx.Context, request *requests.FSDK_SetLocaltimeFunction) (*responses.FSDK_SetLocaltimeFunction, error) {
+	resp, err := i.interceptor(ctx, "FSDK_SetLocaltimeFunction", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_StructElement_GetTitle(request)
-}
+		done, err := i.startCall(ctx, "FSDK_SetLocaltimeFunction", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_StructElement_GetType(request *requests.FPDF_StructElement_GetType) (resp *responses.FPDF_StructElement_GetType, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FSDK_SetLocaltimeFunction", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructElement_GetType", panicError)
-		}
-	}()
+		return i.worker.Instance.FSDK_SetLocaltimeFunction(request)
+	})
+	typedResp, _ := resp.(*responses.FSDK_SetLocaltimeFunction)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_StructElement_GetType(request)
+func (i *pdfiumInstance) FSDK_SetTimeFunction(request *requests.FSDK_SetTimeFunction) (*responses.FSDK_SetTimeFunction, error) {
+	return i.FSDK_SetTimeFunctionWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_StructTree_Close(request *requests.FPDF_StructTree_Close) (resp *responses.FPDF_StructTree_Close, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FSDK_SetTimeFunctionWithContext(ctx goctx.Context, request *requests.FSDK_SetTimeFunction) (*responses.FSDK_SetTimeFunction, error) {
+	resp, err := i.interceptor(ctx, "FSDK_SetTimeFunction", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructTree_Close", panicError)
+		done, err := i.startCall(ctx, "FSDK_SetTimeFunction", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FSDK_SetTimeFunction", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDF_StructTree_Close(request)
+		return i.worker.Instance.FSDK_SetTimeFunction(request)
+	})
+	typedResp, _ := resp.(*responses.FSDK_SetTimeFunction)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_StructTree_CountChildren(request *requests.FPDF_StructTree_CountChildren) (resp *responses.FPDF_StructTree_CountChildren, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) FSDK_SetUnSpObjProcessHandler(request *requests.FSDK_SetUnSpObjProcessHandler) (*responses.FSDK_SetUnSpObjProcessHandler, error) {
+	return i.FSDK_SetUnSpObjProcessHandlerWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructTree_CountChildren", panicError)
+func (i *pdfiumInstance) FSDK_SetUnSpObjProcessHandlerWithContext(ctx goctx.Context, request *requests.FSDK_SetUnSpObjProcessHandler) (*responses.FSDK_SetUnSpObjProcessHandler, error) {
+	resp, err := i.interceptor(ctx, "FSDK_SetUnSpObjProcessHandler", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_StructTree_CountChildren(request)
-}
+		done, err := i.startCall(ctx, "FSDK_SetUnSpObjProcessHandler", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_StructTree_GetChildAtIndex(request *requests.FPDF_StructTree_GetChildAtIndex) (resp *responses.FPDF_StructTree_GetChildAtIndex, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "FSDK_SetUnSpObjProcessHandler", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructTree_GetChildAtIndex", panicError)
-		}
-	}()
+		return i.worker.Instance.FSDK_SetUnSpObjProcessHandler(request)
+	})
+	typedResp, _ := resp.(*responses.FSDK_SetUnSpObjProcessHandler)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_StructTree_GetChildAtIndex(request)
+func (i *pdfiumInstance) GetActionInfo(request *requests.GetActionInfo) (*responses.GetActionInfo, error) {
+	return i.GetActionInfoWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_StructTree_GetForPage(request *requests.FPDF_StructTree_GetForPage) (resp *responses.FPDF_StructTree_GetForPage, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) GetActionInfoWithContext(ctx goctx.Context, request *requests.GetActionInfo) (*responses.GetActionInfo, error) {
+	resp, err := i.interceptor(ctx, "GetActionInfo", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_StructTree_GetForPage", panicError)
+		done, err := i.startCall(ctx, "GetActionInfo", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDF_StructTree_GetForPage(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "GetActionInfo", panicError)
+			}
+		}()
+
+		return i.worker.Instance.GetActionInfo(request)
+	})
+	typedResp, _ := resp.(*responses.GetActionInfo)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_VIEWERREF_GetDuplex(request *requests.FPDF_VIEWERREF_GetDuplex) (resp *responses.FPDF_VIEWERREF_GetDuplex, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) GetAttachments(request *requests.GetAttachments) (*responses.GetAttachments, error) {
+	return i.GetAttachmentsWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetDuplex", panicError)
+func (i *pdfiumInstance) GetAttachmentsWithContext(ctx goctx.Context, request *requests.GetAttachments) (*responses.GetAttachments, error) {
+	resp, err := i.interceptor(ctx, "GetAttachments", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_VIEWERREF_GetDuplex(request)
-}
+		done, err := i.startCall(ctx, "GetAttachments", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_VIEWERREF_GetName(request *requests.FPDF_VIEWERREF_GetName) (resp *responses.FPDF_VIEWERREF_GetName, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "GetAttachments", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetName", panicError)
-		}
-	}()
+		return i.worker.Instance.GetAttachments(request)
+	})
+	typedResp, _ := resp.(*responses.GetAttachments)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_VIEWERREF_GetName(request)
+func (i *pdfiumInstance) GetBookmarks(request *requests.GetBookmarks) (*responses.GetBookmarks, error) {
+	return i.GetBookmarksWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_VIEWERREF_GetNumCopies(request *requests.FPDF_VIEWERREF_GetNumCopies) (resp *responses.FPDF_VIEWERREF_GetNumCopies, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) GetBookmarksWithContext(ctx goctx.Context, request *requests.GetBookmarks) (*responses.GetBookmarks, error) {
+	resp, err := i.interceptor(ctx, "GetBookmarks", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetNumCopies", panicError)
+		done, err := i.startCall(ctx, "GetBookmarks", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "GetBookmarks", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FPDF_VIEWERREF_GetNumCopies(request)
+		return i.worker.Instance.GetBookmarks(request)
+	})
+	typedResp, _ := resp.(*responses.GetBookmarks)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_VIEWERREF_GetPrintPageRange(request *requests.FPDF_VIEWERREF_GetPrintPageRange) (resp *responses.FPDF_VIEWERREF_GetPrintPageRange, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) GetDestInfo(request *requests.GetDestInfo) (*responses.GetDestInfo, error) {
+	return i.GetDestInfoWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetPrintPageRange", panicError)
+func (i *pdfiumInstance) GetDestInfoWithContext(ctx goctx.Context, request *requests.GetDestInfo) (*responses.GetDestInfo, error) {
+	resp, err := i.interceptor(ctx, "GetDestInfo", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_VIEWERREF_GetPrintPageRange(request)
-}
+		done, err := i.startCall(ctx, "GetDestInfo", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FPDF_VIEWERREF_GetPrintPageRangeCount(request *requests.FPDF_VIEWERREF_GetPrintPageRangeCount) (resp *responses.FPDF_VIEWERREF_GetPrintPageRangeCount, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "GetDestInfo", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetPrintPageRangeCount", panicError)
-		}
-	}()
+		return i.worker.Instance.GetDestInfo(request)
+	})
+	typedResp, _ := resp.(*responses.GetDestInfo)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FPDF_VIEWERREF_GetPrintPageRangeCount(request)
+func (i *pdfiumInstance) GetForm(request *requests.GetForm) (*responses.GetForm, error) {
+	return i.GetFormWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FPDF_VIEWERREF_GetPrintPageRangeElement(request *requests.FPDF_VIEWERREF_GetPrintPageRangeElement) (resp *responses.FPDF_VIEWERREF_GetPrintPageRangeElement, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) GetFormWithContext(ctx goctx.Context, request *requests.GetForm) (*responses.GetForm, error) {
+	resp, err := i.interceptor(ctx, "GetForm", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetPrintPageRangeElement", panicError)
+		done, err := i.startCall(ctx, "GetForm", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.FPDF_VIEWERREF_GetPrintPageRangeElement(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "GetForm", panicError)
+			}
+		}()
+
+		return i.worker.Instance.GetForm(request)
+	})
+	typedResp, _ := resp.(*responses.GetForm)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FPDF_VIEWERREF_GetPrintScaling(request *requests.FPDF_VIEWERREF_GetPrintScaling) (resp *responses.FPDF_VIEWERREF_GetPrintScaling, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) GetJavaScriptActions(request *requests.GetJavaScriptActions) (*responses.GetJavaScriptActions, error) {
+	return i.GetJavaScriptActionsWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FPDF_VIEWERREF_GetPrintScaling", panicError)
+func (i *pdfiumInstance) GetJavaScriptActionsWithContext(ctx goctx.Context, request *requests.GetJavaScriptActions) (*responses.GetJavaScriptActions, error) {
+	resp, err := i.interceptor(ctx, "GetJavaScriptActions", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FPDF_VIEWERREF_GetPrintScaling(request)
-}
+		done, err := i.startCall(ctx, "GetJavaScriptActions", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) FSDK_SetLocaltimeFunction(request *requests.FSDK_SetLocaltimeFunction) (resp *responses.FSDK_SetLocaltimeFunction, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "GetJavaScriptActions", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FSDK_SetLocaltimeFunction", panicError)
-		}
-	}()
+		return i.worker.Instance.GetJavaScriptActions(request)
+	})
+	typedResp, _ := resp.(*responses.GetJavaScriptActions)
+	return typedResp, err
+}
 
-	return i.worker.Instance.FSDK_SetLocaltimeFunction(request)
+func (i *pdfiumInstance) GetMetaData(request *requests.GetMetaData) (*responses.GetMetaData, error) {
+	return i.GetMetaDataWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) FSDK_SetTimeFunction(request *requests.FSDK_SetTimeFunction) (resp *responses.FSDK_SetTimeFunction, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) GetMetaDataWithContext(ctx goctx.Context, request *requests.GetMetaData) (*responses.GetMetaData, error) {
+	resp, err := i.interceptor(ctx, "GetMetaData", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FSDK_SetTimeFunction", panicError)
+		done, err := i.startCall(ctx, "GetMetaData", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "GetMetaData", panicError)
+			}
+		}()
 
-	return i.worker.Instance.FSDK_SetTimeFunction(request)
+		return i.worker.Instance.GetMetaData(request)
+	})
+	typedResp, _ := resp.(*responses.GetMetaData)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) FSDK_SetUnSpObjProcessHandler(request *requests.FSDK_SetUnSpObjProcessHandler) (resp *responses.FSDK_SetUnSpObjProcessHandler, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) GetPageSize(request *requests.GetPageSize) (*responses.GetPageSize, error) {
+	return i.GetPageSizeWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "FSDK_SetUnSpObjProcessHandler", panicError)
+func (i *pdfiumInstance) GetPageSizeWithContext(ctx goctx.Context, request *requests.GetPageSize) (*responses.GetPageSize, error) {
+	resp, err := i.interceptor(ctx, "GetPageSize", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.FSDK_SetUnSpObjProcessHandler(request)
-}
+		done, err := i.startCall(ctx, "GetPageSize", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) GetActionInfo(request *requests.GetActionInfo) (resp *responses.GetActionInfo, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "GetPageSize", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "GetActionInfo", panicError)
-		}
-	}()
+		return i.worker.Instance.GetPageSize(request)
+	})
+	typedResp, _ := resp.(*responses.GetPageSize)
+	return typedResp, err
+}
 
-	return i.worker.Instance.GetActionInfo(request)
+func (i *pdfiumInstance) GetPageSizeInPixels(request *requests.GetPageSizeInPixels) (*responses.GetPageSizeInPixels, error) {
+	return i.GetPageSizeInPixelsWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) GetAttachments(request *requests.GetAttachments) (resp *responses.GetAttachments, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) GetPageSizeInPixelsWithContext(ctx goctx.Context, request *requests.GetPageSizeInPixels) (*responses.GetPageSizeInPixels, error) {
+	resp, err := i.interceptor(ctx, "GetPageSizeInPixels", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "GetAttachments", panicError)
+		done, err := i.startCall(ctx, "GetPageSizeInPixels", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.GetAttachments(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "GetPageSizeInPixels", panicError)
+			}
+		}()
+
+		return i.worker.Instance.GetPageSizeInPixels(request)
+	})
+	typedResp, _ := resp.(*responses.GetPageSizeInPixels)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) GetBookmarks(request *requests.GetBookmarks) (resp *responses.GetBookmarks, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) GetPageText(request *requests.GetPageText) (*responses.GetPageText, error) {
+	return i.GetPageTextWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "GetBookmarks", panicError)
+func (i *pdfiumInstance) GetPageTextWithContext(ctx goctx.Context, request *requests.GetPageText) (*responses.GetPageText, error) {
+	resp, err := i.interceptor(ctx, "GetPageText", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.GetBookmarks(request)
-}
+		done, err := i.startCall(ctx, "GetPageText", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) GetDestInfo(request *requests.GetDestInfo) (resp *responses.GetDestInfo, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "GetPageText", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "GetDestInfo", panicError)
-		}
-	}()
+		return i.worker.Instance.GetPageText(request)
+	})
+	typedResp, _ := resp.(*responses.GetPageText)
+	return typedResp, err
+}
 
-	return i.worker.Instance.GetDestInfo(request)
+func (i *pdfiumInstance) GetPageTextStructured(request *requests.GetPageTextStructured) (*responses.GetPageTextStructured, error) {
+	return i.GetPageTextStructuredWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) GetForm(request *requests.GetForm) (resp *responses.GetForm, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) GetPageTextStructuredWithContext(ctx goctx.Context, request *requests.GetPageTextStructured) (*responses.GetPageTextStructured, error) {
+	resp, err := i.interceptor(ctx, "GetPageTextStructured", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "GetForm", panicError)
+		done, err := i.startCall(ctx, "GetPageTextStructured", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "GetPageTextStructured", panicError)
+			}
+		}()
 
-	return i.worker.Instance.GetForm(request)
+		return i.worker.Instance.GetPageTextStructured(request)
+	})
+	typedResp, _ := resp.(*responses.GetPageTextStructured)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) GetJavaScriptActions(request *requests.GetJavaScriptActions) (resp *responses.GetJavaScriptActions, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) ListOpenHandles(request *requests.ListOpenHandles) (*responses.ListOpenHandles, error) {
+	return i.ListOpenHandlesWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "GetJavaScriptActions", panicError)
+func (i *pdfiumInstance) ListOpenHandlesWithContext(ctx goctx.Context, request *requests.ListOpenHandles) (*responses.ListOpenHandles, error) {
+	resp, err := i.interceptor(ctx, "ListOpenHandles", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.GetJavaScriptActions(request)
-}
+		done, err := i.startCall(ctx, "ListOpenHandles", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) GetMetaData(request *requests.GetMetaData) (resp *responses.GetMetaData, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "ListOpenHandles", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "GetMetaData", panicError)
-		}
-	}()
+		return i.worker.Instance.ListOpenHandles(request)
+	})
+	typedResp, _ := resp.(*responses.ListOpenHandles)
+	return typedResp, err
+}
 
-	return i.worker.Instance.GetMetaData(request)
+func (i *pdfiumInstance) OpenDocument(request *requests.OpenDocument) (*responses.OpenDocument, error) {
+	return i.OpenDocumentWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) GetPageSize(request *requests.GetPageSize) (resp *responses.GetPageSize, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) OpenDocumentWithContext(ctx goctx.Context, request *requests.OpenDocument) (*responses.OpenDocument, error) {
+	resp, err := i.interceptor(ctx, "OpenDocument", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "GetPageSize", panicError)
+		done, err := i.startCall(ctx, "OpenDocument", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.GetPageSize(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "OpenDocument", panicError)
+			}
+		}()
+
+		return i.worker.Instance.OpenDocument(request)
+	})
+	typedResp, _ := resp.(*responses.OpenDocument)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) GetPageSizeInPixels(request *requests.GetPageSizeInPixels) (resp *responses.GetPageSizeInPixels, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) RenderPageInDPI(request *requests.RenderPageInDPI) (*responses.RenderPageInDPI, error) {
+	return i.RenderPageInDPIWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "GetPageSizeInPixels", panicError)
+func (i *pdfiumInstance) RenderPageInDPIWithContext(ctx goctx.Context, request *requests.RenderPageInDPI) (*responses.RenderPageInDPI, error) {
+	resp, err := i.interceptor(ctx, "RenderPageInDPI", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.GetPageSizeInPixels(request)
-}
+		done, err := i.startCall(ctx, "RenderPageInDPI", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) GetPageText(request *requests.GetPageText) (resp *responses.GetPageText, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "RenderPageInDPI", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "GetPageText", panicError)
-		}
-	}()
+		return i.worker.Instance.RenderPageInDPI(request)
+	})
+	typedResp, _ := resp.(*responses.RenderPageInDPI)
+	return typedResp, err
+}
 
-	return i.worker.Instance.GetPageText(request)
+func (i *pdfiumInstance) RenderPageInPixels(request *requests.RenderPageInPixels) (*responses.RenderPageInPixels, error) {
+	return i.RenderPageInPixelsWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) GetPageTextStructured(request *requests.GetPageTextStructured) (resp *responses.GetPageTextStructured, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) RenderPageInPixelsWithContext(ctx goctx.Context, request *requests.RenderPageInPixels) (*responses.RenderPageInPixels, error) {
+	resp, err := i.interceptor(ctx, "RenderPageInPixels", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "GetPageTextStructured", panicError)
+		done, err := i.startCall(ctx, "RenderPageInPixels", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "RenderPageInPixels", panicError)
+			}
+		}()
 
-	return i.worker.Instance.GetPageTextStructured(request)
+		return i.worker.Instance.RenderPageInPixels(request)
+	})
+	typedResp, _ := resp.(*responses.RenderPageInPixels)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) OpenDocument(request *requests.OpenDocument) (resp *responses.OpenDocument, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) RenderPageRegion(request *requests.RenderPageRegion) (*responses.RenderPageRegion, error) {
+	return i.RenderPageRegionWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "OpenDocument", panicError)
+func (i *pdfiumInstance) RenderPageRegionWithContext(ctx goctx.Context, request *requests.RenderPageRegion) (*responses.RenderPageRegion, error) {
+	resp, err := i.interceptor(ctx, "RenderPageRegion", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.OpenDocument(request)
-}
+		done, err := i.startCall(ctx, "RenderPageRegion", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) RenderPageInDPI(request *requests.RenderPageInDPI) (resp *responses.RenderPageInDPI, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "RenderPageRegion", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "RenderPageInDPI", panicError)
-		}
-	}()
+		return i.worker.Instance.RenderPageRegion(request)
+	})
+	typedResp, _ := resp.(*responses.RenderPageRegion)
+	return typedResp, err
+}
 
-	return i.worker.Instance.RenderPageInDPI(request)
+func (i *pdfiumInstance) RenderPagesInDPI(request *requests.RenderPagesInDPI) (*responses.RenderPagesInDPI, error) {
+	return i.RenderPagesInDPIWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) RenderPageInPixels(request *requests.RenderPageInPixels) (resp *responses.RenderPageInPixels, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) RenderPagesInDPIWithContext(ctx goctx.Context, request *requests.RenderPagesInDPI) (*responses.RenderPagesInDPI, error) {
+	resp, err := i.interceptor(ctx, "RenderPagesInDPI", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "RenderPageInPixels", panicError)
+		done, err := i.startCall(ctx, "RenderPagesInDPI", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
 
-	return i.worker.Instance.RenderPageInPixels(request)
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "RenderPagesInDPI", panicError)
+			}
+		}()
+
+		return i.worker.Instance.RenderPagesInDPI(request)
+	})
+	typedResp, _ := resp.(*responses.RenderPagesInDPI)
+	return typedResp, err
 }
 
-func (i *pdfiumInstance) RenderPagesInDPI(request *requests.RenderPagesInDPI) (resp *responses.RenderPagesInDPI, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) RenderPagesInPixels(request *requests.RenderPagesInPixels) (*responses.RenderPagesInPixels, error) {
+	return i.RenderPagesInPixelsWithContext(goctx.Background(), request)
+}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "RenderPagesInDPI", panicError)
+func (i *pdfiumInstance) RenderPagesInPixelsWithContext(ctx goctx.Context, request *requests.RenderPagesInPixels) (*responses.RenderPagesInPixels, error) {
+	resp, err := i.interceptor(ctx, "RenderPagesInPixels", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
 		}
-	}()
 
-	return i.worker.Instance.RenderPagesInDPI(request)
-}
+		done, err := i.startCall(ctx, "RenderPagesInPixels", request)
+		if err != nil {
+			return nil, err
+		}
+		defer done(&err)
 
-func (i *pdfiumInstance) RenderPagesInPixels(request *requests.RenderPagesInPixels) (resp *responses.RenderPagesInPixels, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "RenderPagesInPixels", panicError)
+			}
+		}()
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "RenderPagesInPixels", panicError)
-		}
-	}()
+		return i.worker.Instance.RenderPagesInPixels(request)
+	})
+	typedResp, _ := resp.(*responses.RenderPagesInPixels)
+	return typedResp, err
+}
 
-	return i.worker.Instance.RenderPagesInPixels(request)
+func (i *pdfiumInstance) RenderToFile(request *requests.RenderToFile) (*responses.RenderToFile, error) {
+	return i.RenderToFileWithContext(goctx.Background(), request)
 }
 
-func (i *pdfiumInstance) RenderToFile(request *requests.RenderToFile) (resp *responses.RenderToFile, err error) {
-	if i.closed {
-		return nil, errors.New("instance is closed")
-	}
+func (i *pdfiumInstance) RenderToFileWithContext(ctx goctx.Context, request *requests.RenderToFile) (*responses.RenderToFile, error) {
+	resp, err := i.interceptor(ctx, "RenderToFile", request, func() (resp interface{}, err error) {
+		if i.closed {
+			return nil, errors.New("instance is closed")
+		}
 
-	defer func() {
-		if panicError := recover(); panicError != nil {
-			err = fmt.Errorf("panic occurred in %s: %v", "RenderToFile", panicError)
+		done, err := i.startCall(ctx, "RenderToFile", request)
+		if err != nil {
+			return nil, err
 		}
-	}()
+		defer done(&err)
+
+		defer func() {
+			if panicError := recover(); panicError != nil {
+				err = fmt.Errorf("panic occurred in %s: %v", "RenderToFile", panicError)
+			}
+		}()
 
-	return i.worker.Instance.RenderToFile(request)
+		return i.worker.Instance.RenderToFile(request)
+	})
+	typedResp, _ := resp.(*responses.RenderToFile)
+	return typedResp, err
 }
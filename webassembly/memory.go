@@ -0,0 +1,65 @@
+package webassembly
+
+import (
+	"errors"
+
+	"github.com/klippa-app/go-pdfium"
+)
+
+// MemoryStats is the usage of the WebAssembly memory of the worker of an
+// instance, in bytes.
+type MemoryStats struct {
+	// Current is the size of the memory of the worker at the end of the last
+	// call, 0 when the instance is closed. The memory of a WebAssembly module can't shrink,
+	// so it's the peak of the worker until now, including the usage of the
+	// instances that used the worker before when ReuseWorkers is enabled.
+	Current uint64
+
+	// Peak is the largest size of the memory of the worker that was seen at
+	// the end of a call, it's kept when the instance is closed, so that it
+	// can be used to size the pool after the work is done.
+	Peak uint64
+
+	// Limit is the size to which the memory of the worker can grow, see
+	// Config.MaxMemoryPages.
+	Limit uint64
+}
+
+// GetMemoryStats returns the memory usage of an instance of a WebAssembly
+// pool.
+func GetMemoryStats(instance pdfium.Pdfium) (*MemoryStats, error) {
+	webassemblyInstance, ok := instance.(*pdfiumInstance)
+	if !ok {
+		return nil, errors.New("instance is not a webassembly instance")
+	}
+
+	return webassemblyInstance.memoryStats(), nil
+}
+
+// memoryStats returns the memory stats of the instance.
+func (i *pdfiumInstance) memoryStats() *MemoryStats {
+	i.memoryLock.Lock()
+	defer i.memoryLock.Unlock()
+
+	return &MemoryStats{
+		Current: i.memoryCurrent,
+		Peak:    i.memoryPeak,
+		Limit:   i.memoryLimit,
+	}
+}
+
+// observeMemory updates the memory stats of the instance with the memory of
+// its worker. It must be called when no call is running on the worker.
+func (i *pdfiumInstance) observeMemory() {
+	current := i.worker.Instance.MemorySize()
+	limit := i.worker.Instance.MemoryLimit()
+
+	i.memoryLock.Lock()
+	defer i.memoryLock.Unlock()
+
+	i.memoryCurrent = current
+	if current > i.memoryPeak {
+		i.memoryPeak = current
+	}
+	i.memoryLimit = limit
+}
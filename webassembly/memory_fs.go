@@ -0,0 +1,534 @@
+package webassembly
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/klippa-app/go-pdfium"
+	"github.com/klippa-app/go-pdfium/internal/implementation_webassembly"
+
+	"github.com/tetratelabs/wazero"
+)
+
+// MemoryFS is an in-memory file system for the WebAssembly runtime, set it as
+// Config.MemoryFS, a pool without a configured file system uses one too. It
+// only contains the files that were added with AddFile, which can only be
+// read, and the files that are written in its scratch directory, so the
+// module can't reach the files of the host. All paths of
+// the pool, like the Path of FPDF_LoadDocument, the FilePath of
+// FPDF_SaveAsCopy and the TargetFilePath of RenderToFile, are paths in the
+// MemoryFS. All paths are absolute, relative paths are relative to /.
+type MemoryFS struct {
+	lock       sync.Mutex
+	scratchDir string
+	files      map[string]*memoryFile
+	tempFiles  int
+}
+
+type memoryFile struct {
+	data     []byte
+	readOnly bool
+	modTime  time.Time
+}
+
+// NewMemoryFS creates an empty in-memory file system. Files can only be
+// written in the scratch directory, /tmp when it's empty. RenderToFile
+// writes its temporary files in the scratch directory too.
+func NewMemoryFS(scratchDir string) *MemoryFS {
+	if scratchDir == "" {
+		scratchDir = "/tmp"
+	}
+
+	return &MemoryFS{
+		scratchDir: cleanPath(scratchDir),
+		files:      map[string]*memoryFile{},
+	}
+}
+
+// GetMemoryFS returns the in-memory file system of a WebAssembly pool, which
+// is the MemoryFS of the config or the MemoryFS that the pool created because
+// no file system was configured. It returns nil when the pool uses FSConfig or
+// MountHostFS.
+func GetMemoryFS(pool pdfium.Pool) (*MemoryFS, error) {
+	webassemblyPool, ok := pool.(*pdfiumPool)
+	if !ok {
+		return nil, errors.New("pool is not a webassembly pool")
+	}
+
+	return webassemblyPool.memoryFS, nil
+}
+
+// fsConfig returns the FSConfig that mounts the file system as the root of
+// the module. The module only reads files, the files that are written are
+// written by the implementation through goFileSystem.
+func (m *MemoryFS) fsConfig() wazero.FSConfig {
+	return wazero.NewFSConfig().WithFSMount(moduleFS{fs: m}, "/")
+}
+
+// cleanPath returns the absolute and clean version of the path.
+func cleanPath(name string) string {
+	return path.Clean("/" + name)
+}
+
+// ScratchDir returns the directory in which files can be written.
+func (m *MemoryFS) ScratchDir() string {
+	return m.scratchDir
+}
+
+// AddFile adds a file that can only be read. The data must not be changed
+// while it's in the file system.
+func (m *MemoryFS) AddFile(name string, data []byte) error {
+	name = cleanPath(name)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.isDir(name) {
+		return &fs.PathError{Op: "add", Path: name, Err: fs.ErrExist}
+	}
+
+	m.files[name] = &memoryFile{
+		data:     data,
+		readOnly: true,
+		modTime:  time.Now(),
+	}
+
+	return nil
+}
+
+// ReadFile returns a copy of the contents of a file, like the files that
+// were written by FPDF_SaveAsCopy and RenderToFile.
+func (m *MemoryFS) ReadFile(name string) ([]byte, error) {
+	name = cleanPath(name)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	file, ok := m.files[name]
+	if !ok {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrNotExist}
+	}
+
+	return append([]byte(nil), file.data...), nil
+}
+
+// Remove removes a file from the file system.
+func (m *MemoryFS) Remove(name string) error {
+	name = cleanPath(name)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.files[name]; !ok {
+		return &fs.PathError{Op: "remove", Path: name, Err: fs.ErrNotExist}
+	}
+
+	delete(m.files, name)
+	return nil
+}
+
+// Files returns the paths of all files in the file system, sorted.
+func (m *MemoryFS) Files() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	names := make([]string, 0, len(m.files))
+	for name := range m.files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// isDir returns whether the path is a directory, which are the root, the
+// scratch directory and their parents, and the parents of the files. The
+// lock must be held.
+func (m *MemoryFS) isDir(name string) bool {
+	if name == "/" || name == m.scratchDir || isParent(name, m.scratchDir) {
+		return true
+	}
+
+	for fileName := range m.files {
+		if isParent(name, fileName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isParent returns whether dir is a parent directory of name.
+func isParent(dir string, name string) bool {
+	if dir == "/" {
+		return name != "/"
+	}
+
+	return strings.HasPrefix(name, dir+"/")
+}
+
+// canWrite returns whether a file can be created at the path.
+func (m *MemoryFS) canWrite(name string) bool {
+	return isParent(m.scratchDir, name)
+}
+
+// readDir returns the sorted names of the files and directories in a
+// directory. The lock must be held.
+func (m *MemoryFS) readDir(dir string) []string {
+	entries := map[string]bool{}
+	addEntry := func(name string) {
+		if !isParent(dir, name) {
+			return
+		}
+
+		entry := strings.TrimPrefix(strings.TrimPrefix(name, dir), "/")
+		if i := strings.Index(entry, "/"); i >= 0 {
+			entry = entry[:i]
+		}
+
+		entries[entry] = true
+	}
+
+	addEntry(m.scratchDir)
+	for name := range m.files {
+		addEntry(name)
+	}
+
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// open opens a file for the module or for the implementation.
+func (m *MemoryFS) open(name string, read bool, write bool, create bool, exclusive bool, truncate bool) (*memoryFileHandle, error) {
+	name = cleanPath(name)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	handle := &memoryFileHandle{
+		fs:    m,
+		name:  name,
+		read:  read,
+		write: write,
+	}
+
+	if m.isDir(name) {
+		if write {
+			return nil, &fs.PathError{Op: "open", Path: name, Err: errIsDir}
+		}
+
+		handle.dir = true
+		return handle, nil
+	}
+
+	file, ok := m.files[name]
+	if ok && create && exclusive {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrExist}
+	}
+
+	if !ok {
+		if !create {
+			return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+		}
+
+		if !m.canWrite(name) {
+			return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrPermission}
+		}
+
+		file = &memoryFile{modTime: time.Now()}
+		m.files[name] = file
+	}
+
+	if (write || truncate) && file.readOnly {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrPermission}
+	}
+
+	if truncate {
+		file.data = nil
+		file.modTime = time.Now()
+	}
+
+	handle.file = file
+	return handle, nil
+}
+
+var errIsDir = errors.New("is a directory")
+
+// goFileSystem is the file system for the files that the implementation opens
+// and creates itself, instead of through the module.
+type goFileSystem struct {
+	*MemoryFS
+}
+
+func (m goFileSystem) Open(name string) (implementation_webassembly.File, error) {
+	handle, err := m.open(name, true, false, false, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &memoryGoFile{handle: handle}, nil
+}
+
+func (m goFileSystem) Create(name string) (implementation_webassembly.File, error) {
+	handle, err := m.open(name, true, true, true, false, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &memoryGoFile{handle: handle}, nil
+}
+
+func (m goFileSystem) CreateTemp() (implementation_webassembly.File, error) {
+	m.lock.Lock()
+	m.tempFiles++
+	name := path.Join(m.scratchDir, fmt.Sprintf("go-pdfium-%d", m.tempFiles))
+	m.lock.Unlock()
+
+	handle, err := m.open(name, true, true, true, true, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &memoryGoFile{handle: handle}, nil
+}
+
+// moduleFS is the file system for the files that PDFium opens through the
+// module, it implements fs.FS. The MemoryFS isn't embedded, so that its
+// ReadFile isn't mistaken for fs.ReadFileFS.
+type moduleFS struct {
+	fs *MemoryFS
+}
+
+func (m moduleFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+
+	handle, err := m.fs.open(name, true, false, false, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &memoryGoFile{handle: handle}, nil
+}
+
+// memoryFileHandle is an open file or directory of a MemoryFS.
+type memoryFileHandle struct {
+	fs     *MemoryFS
+	name   string
+	file   *memoryFile // nil for directories.
+	dir    bool
+	read   bool
+	write  bool
+	offset int64
+}
+
+func (h *memoryFileHandle) readAt(p []byte, offset int64) (int, error) {
+	if h.dir {
+		return 0, &fs.PathError{Op: "read", Path: h.name, Err: errIsDir}
+	}
+
+	if !h.read {
+		return 0, &fs.PathError{Op: "read", Path: h.name, Err: fs.ErrPermission}
+	}
+
+	h.fs.lock.Lock()
+	defer h.fs.lock.Unlock()
+
+	if offset >= int64(len(h.file.data)) {
+		return 0, io.EOF
+	}
+
+	return copy(p, h.file.data[offset:]), nil
+}
+
+func (h *memoryFileHandle) writeAt(p []byte, offset int64) (int, error) {
+	if !h.write {
+		return 0, &fs.PathError{Op: "write", Path: h.name, Err: fs.ErrPermission}
+	}
+
+	h.fs.lock.Lock()
+	defer h.fs.lock.Unlock()
+
+	end := offset + int64(len(p))
+	if length := int64(len(h.file.data)); end > length {
+		// Grow the capacity like append does, PDFium writes files in small
+		// blocks, so growing to the exact size would copy the whole file
+		// on every write.
+		h.file.data = slices.Grow(h.file.data, int(end-length))[:end]
+
+		// Writing past the end leaves a gap that reads as zeros.
+		if offset > length {
+			clear(h.file.data[length:offset])
+		}
+	}
+
+	copy(h.file.data[offset:], p)
+	h.file.modTime = time.Now()
+
+	return len(p), nil
+}
+
+func (h *memoryFileHandle) seek(offset int64, whence int) (int64, error) {
+	var newOffset int64
+	switch whence {
+	case io.SeekStart:
+		newOffset = offset
+	case io.SeekCurrent:
+		newOffset = h.offset + offset
+	case io.SeekEnd:
+		newOffset = h.size() + offset
+	default:
+		return 0, &fs.PathError{Op: "seek", Path: h.name, Err: fs.ErrInvalid}
+	}
+
+	if newOffset < 0 {
+		return 0, &fs.PathError{Op: "seek", Path: h.name, Err: fs.ErrInvalid}
+	}
+
+	h.offset = newOffset
+	return newOffset, nil
+}
+
+func (h *memoryFileHandle) size() int64 {
+	if h.dir {
+		return 0
+	}
+
+	h.fs.lock.Lock()
+	defer h.fs.lock.Unlock()
+
+	return int64(len(h.file.data))
+}
+
+func (h *memoryFileHandle) info() *memoryFileInfo {
+	if h.dir {
+		return &memoryFileInfo{name: path.Base(h.name), mode: fs.ModeDir | 0o755}
+	}
+
+	h.fs.lock.Lock()
+	defer h.fs.lock.Unlock()
+
+	mode := fs.FileMode(0o644)
+	if h.file.readOnly {
+		mode = 0o444
+	}
+
+	return &memoryFileInfo{
+		name:    path.Base(h.name),
+		size:    int64(len(h.file.data)),
+		mode:    mode,
+		modTime: h.file.modTime,
+	}
+}
+
+// memoryGoFile is an open file or directory of a MemoryFS, that is used by
+// the implementation and by the module.
+type memoryGoFile struct {
+	handle *memoryFileHandle
+
+	// dirEntries are the entries of a directory that are not read yet.
+	dirEntries []fs.DirEntry
+}
+
+func (f *memoryGoFile) Read(p []byte) (int, error) {
+	n, err := f.handle.readAt(p, f.handle.offset)
+	f.handle.offset += int64(n)
+	return n, err
+}
+
+func (f *memoryGoFile) ReadAt(p []byte, offset int64) (int, error) {
+	n, err := f.handle.readAt(p, offset)
+	if err == nil && n < len(p) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (f *memoryGoFile) ReadDir(n int) ([]fs.DirEntry, error) {
+	if !f.handle.dir {
+		return nil, &fs.PathError{Op: "readdir", Path: f.handle.name, Err: fs.ErrInvalid}
+	}
+
+	if f.dirEntries == nil {
+		f.handle.fs.lock.Lock()
+		entries := f.handle.fs.readDir(f.handle.name)
+		f.handle.fs.lock.Unlock()
+
+		f.dirEntries = make([]fs.DirEntry, 0, len(entries))
+		for _, name := range entries {
+			handle, err := f.handle.fs.open(path.Join(f.handle.name, name), false, false, false, false, false)
+			if err != nil {
+				continue
+			}
+			f.dirEntries = append(f.dirEntries, fs.FileInfoToDirEntry(handle.info()))
+		}
+	}
+
+	if n <= 0 {
+		entries := f.dirEntries
+		f.dirEntries = f.dirEntries[len(f.dirEntries):]
+		return entries, nil
+	}
+
+	if len(f.dirEntries) == 0 {
+		return nil, io.EOF
+	}
+
+	if n > len(f.dirEntries) {
+		n = len(f.dirEntries)
+	}
+
+	entries := f.dirEntries[:n]
+	f.dirEntries = f.dirEntries[n:]
+	return entries, nil
+}
+
+func (f *memoryGoFile) Write(p []byte) (int, error) {
+	n, err := f.handle.writeAt(p, f.handle.offset)
+	f.handle.offset += int64(n)
+	return n, err
+}
+
+func (f *memoryGoFile) Seek(offset int64, whence int) (int64, error) {
+	return f.handle.seek(offset, whence)
+}
+
+func (f *memoryGoFile) Close() error {
+	return nil
+}
+
+func (f *memoryGoFile) Name() string {
+	return f.handle.name
+}
+
+func (f *memoryGoFile) Stat() (fs.FileInfo, error) {
+	return f.handle.info(), nil
+}
+
+type memoryFileInfo struct {
+	name    string
+	size    int64
+	mode    fs.FileMode
+	modTime time.Time
+}
+
+func (i *memoryFileInfo) Name() string       { return i.name }
+func (i *memoryFileInfo) Size() int64        { return i.size }
+func (i *memoryFileInfo) Mode() fs.FileMode  { return i.mode }
+func (i *memoryFileInfo) ModTime() time.Time { return i.modTime }
+func (i *memoryFileInfo) IsDir() bool        { return i.mode.IsDir() }
+func (i *memoryFileInfo) Sys() interface{}   { return nil }
@@ -0,0 +1,92 @@
+package webassembly
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryFS(t *testing.T) {
+	memoryFS := NewMemoryFS("/scratch")
+	require.NoError(t, memoryFS.AddFile("/documents/test.pdf", []byte("%PDF-1.7")))
+	assert.Equal(t, "/scratch", memoryFS.ScratchDir())
+
+	goFS := goFileSystem{memoryFS}
+
+	// Added files can only be read.
+	_, err := goFS.Create("/documents/test.pdf")
+	assert.True(t, errors.Is(err, fs.ErrPermission))
+
+	// Files can only be created in the scratch directory.
+	_, err = goFS.Create("/other/output.pdf")
+	assert.True(t, errors.Is(err, fs.ErrPermission))
+
+	file, err := goFS.Create("/scratch/output.pdf")
+	require.NoError(t, err)
+	_, err = file.Write([]byte("output"))
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	tempFile, err := goFS.CreateTemp()
+	require.NoError(t, err)
+	assert.Equal(t, "/scratch/go-pdfium-1", tempFile.Name())
+
+	data, err := memoryFS.ReadFile("/scratch/output.pdf")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("output"), data)
+
+	file, err = goFS.Open("/documents/test.pdf")
+	require.NoError(t, err)
+	data, err = io.ReadAll(file)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("%PDF-1.7"), data)
+
+	assert.Equal(t, []string{"/documents/test.pdf", "/scratch/go-pdfium-1", "/scratch/output.pdf"}, memoryFS.Files())
+
+	require.NoError(t, memoryFS.Remove("/scratch/go-pdfium-1"))
+	_, err = memoryFS.ReadFile("/scratch/go-pdfium-1")
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+
+	// The module sees the same files.
+	require.NoError(t, fstest.TestFS(moduleFS{fs: memoryFS}, "documents/test.pdf", "scratch/output.pdf"))
+
+	_, err = moduleFS{fs: memoryFS}.Open("etc/passwd")
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+}
+
+func TestMemoryFSWrite(t *testing.T) {
+	memoryFS := NewMemoryFS("/scratch")
+	goFS := goFileSystem{memoryFS}
+
+	file, err := goFS.Create("/scratch/output.pdf")
+	require.NoError(t, err)
+
+	// Writing in small blocks grows the file like append, instead of
+	// copying the whole file on every write.
+	block := bytes.Repeat([]byte("x"), 512)
+	allocs := testing.AllocsPerRun(1, func() {
+		for i := 0; i < 2048; i++ {
+			_, err := file.Write(block)
+			require.NoError(t, err)
+		}
+	})
+	assert.Less(t, allocs, float64(100))
+
+	// Writing past the end leaves a gap of zeros.
+	size, err := file.Seek(4, io.SeekEnd)
+	require.NoError(t, err)
+	_, err = file.Write([]byte("end"))
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	data, err := memoryFS.ReadFile("/scratch/output.pdf")
+	require.NoError(t, err)
+	assert.Len(t, data, int(size)+3)
+	assert.Equal(t, []byte("\x00\x00\x00\x00end"), data[len(data)-7:])
+}
@@ -0,0 +1,166 @@
+package webassembly
+
+import (
+	goctx "context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/klippa-app/go-pdfium/webassembly/imports"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/experimental"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+// ModuleConfig is the configuration of a compiled module.
+type ModuleConfig struct {
+	// Context is used to initialize the wazero runtime. It must remain valid
+	// for the lifetime of the module. If nil, context.Background is used.
+	Context goctx.Context
+
+	// WASM is the PDFium WebAssembly module, the embedded module is used
+	// when it's nil and the module is compiled with CompileModule.
+	WASM []byte
+
+	// RuntimeConfig is the configuration of the wazero runtime. Closing the
+	// module when the context of its worker is done is always enabled, also
+	// on a custom RuntimeConfig, so that Kill, CallTimeout and the context of
+	// the WithContext methods can interrupt a call.
+	RuntimeConfig wazero.RuntimeConfig
+
+	// CompilationCacheDir is a directory in which wazero persists the
+	// compiled module, so that the next process that compiles the same module
+	// with the same version of wazero doesn't have to compile it again. Only
+	// used by the compiler engine, the interpreter doesn't compile.
+	CompilationCacheDir string
+
+	// MaxMemoryPages is the maximum number of 64 KiB pages that the memory
+	// of each worker can grow to, 0 means the maximum of 65536 pages (4 GiB).
+	// This overwrites the memory limit of the RuntimeConfig.
+	MaxMemoryPages uint32
+}
+
+// Module is a compiled PDFium WebAssembly module. It can be shared between
+// pools by setting it as Config.Module, so that the module is only compiled
+// once. It must be closed after all pools that use it are closed.
+type Module struct {
+	runtime        wazero.Runtime
+	compiledModule wazero.CompiledModule
+	cache          wazero.CompilationCache
+	context        goctx.Context
+
+	lock   sync.Mutex
+	closed bool
+}
+
+// CompileModule compiles the module in ModuleConfig.WASM, or the embedded
+// module when it's nil.
+func CompileModule(config ModuleConfig) (*Module, error) {
+	if config.WASM == nil {
+		config.WASM = pdfiumWasm
+	}
+	return compileModuleWithConfig(config)
+}
+
+// CompileModuleWithWASM compiles the module in ModuleConfig.WASM. Unlike
+// CompileModule, it does not reference the embedded default module, see
+// InitWithWASM.
+func CompileModuleWithWASM(config ModuleConfig) (*Module, error) {
+	if config.WASM == nil {
+		return nil, errors.New("webassembly module must be provided")
+	}
+	return compileModuleWithConfig(config)
+}
+
+// compileModuleWithConfig creates a runtime with the imports of the module
+// and compiles the module.
+func compileModuleWithConfig(config ModuleConfig) (*Module, error) {
+	if config.Context == nil {
+		config.Context = goctx.Background()
+	}
+
+	if config.RuntimeConfig == nil {
+		// The bundled PDFium module lowers setjmp/longjmp to WebAssembly
+		// exception handling instructions, so the exception handling core
+		// feature is required. When passing a custom RuntimeConfig, include
+		// this feature in the same way.
+		config.RuntimeConfig = wazero.NewRuntimeConfig().WithCoreFeatures(
+			api.CoreFeaturesV2 | experimental.CoreFeaturesExceptionHandling)
+	}
+
+	// The module is closed when the context of its worker is done, so that
+	// Kill, CallTimeout and the context of the WithContext methods can
+	// interrupt a call. This is also enabled on a custom RuntimeConfig.
+	config.RuntimeConfig = config.RuntimeConfig.WithCloseOnContextDone(true)
+
+	if config.MaxMemoryPages > 0 {
+		config.RuntimeConfig = config.RuntimeConfig.WithMemoryLimitPages(config.MaxMemoryPages)
+	}
+
+	var cache wazero.CompilationCache
+	if config.CompilationCacheDir != "" {
+		var err error
+		cache, err = wazero.NewCompilationCacheWithDir(config.CompilationCacheDir)
+		if err != nil {
+			return nil, fmt.Errorf("could not create compilation cache: %w", err)
+		}
+		config.RuntimeConfig = config.RuntimeConfig.WithCompilationCache(cache)
+	}
+
+	runtime := wazero.NewRuntimeWithConfig(config.Context, config.RuntimeConfig)
+
+	closeRuntime := func() {
+		runtime.Close(config.Context)
+		if cache != nil {
+			cache.Close(config.Context)
+		}
+	}
+
+	// Import WASI features.
+	if _, err := wasi_snapshot_preview1.Instantiate(config.Context, runtime); err != nil {
+		closeRuntime()
+		return nil, fmt.Errorf("could not instantiate webassembly wasi_snapshot_preview1 module: %w", err)
+	}
+
+	compiledModule, err := runtime.CompileModule(config.Context, config.WASM)
+	if err != nil {
+		closeRuntime()
+		return nil, fmt.Errorf("could not compile webassembly module: %w", err)
+	}
+
+	// Add basic Emscripten specific methods.
+	if _, err := imports.Instantiate(config.Context, runtime, compiledModule); err != nil {
+		closeRuntime()
+		return nil, fmt.Errorf("could not instantiate webassembly emscripten/env module: %w", err)
+	}
+
+	return &Module{
+		runtime:        runtime,
+		compiledModule: compiledModule,
+		cache:          cache,
+		context:        config.Context,
+	}, nil
+}
+
+// Close closes the runtime of the module, the pools that use the module can't
+// be used anymore after that.
+func (m *Module) Close() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.closed {
+		return errors.New("module is already closed")
+	}
+	m.closed = true
+
+	err := m.runtime.Close(goctx.Background())
+	if m.cache != nil {
+		if cacheErr := m.cache.Close(goctx.Background()); err == nil {
+			err = cacheErr
+		}
+	}
+
+	return err
+}
@@ -1,9 +1,11 @@
 package webassembly_test
 
 import (
+	"errors"
 	"os"
 	"time"
 
+	pdfium_errors "github.com/klippa-app/go-pdfium/errors"
 	"github.com/klippa-app/go-pdfium/requests"
 	"github.com/klippa-app/go-pdfium/webassembly"
 
@@ -45,6 +47,7 @@ var _ = Describe("Render bitmap allocation failure", func() {
 		})
 		Expect(err).ToNot(BeNil())
 		Expect(err.Error()).To(ContainSubstring("could not create bitmap"))
+		Expect(errors.Is(err, pdfium_errors.ErrOutOfMemory)).To(BeTrue())
 		Expect(resp).To(BeNil())
 
 		// The instance must still be usable for renders that do fit.
@@ -56,4 +59,131 @@ var _ = Describe("Render bitmap allocation failure", func() {
 		Expect(smallResp).ToNot(BeNil())
 		smallResp.Cleanup()
 	})
+
+	It("limits the memory with MaxMemoryPages and reports the memory usage", func() {
+		pool, err := webassembly.Init(webassembly.Config{
+			MinIdle:        1,
+			MaxIdle:        1,
+			MaxTotal:       1,
+			RuntimeConfig:  runtimeConfig(),
+			MaxMemoryPages: 768,
+		})
+		Expect(err).To(BeNil())
+		defer pool.Close()
+
+		instance, err := pool.GetInstance(time.Second * 30)
+		Expect(err).To(BeNil())
+
+		memoryStats, err := webassembly.GetMemoryStats(instance)
+		Expect(err).To(BeNil())
+		Expect(memoryStats.Limit).To(Equal(uint64(768 * 65536)))
+		Expect(memoryStats.Current).To(BeNumerically(">", 0))
+		Expect(memoryStats.Peak).To(Equal(memoryStats.Current))
+
+		pdfData, err := os.ReadFile("../shared_tests/testdata/test.pdf")
+		Expect(err).To(BeNil())
+
+		doc, err := instance.OpenDocument(&requests.OpenDocument{File: &pdfData})
+		Expect(err).To(BeNil())
+
+		resp, err := instance.RenderPageInDPI(&requests.RenderPageInDPI{
+			DPI:  600,
+			Page: requests.Page{ByIndex: &requests.PageByIndex{Document: doc.Document, Index: 0}},
+		})
+		Expect(resp).To(BeNil())
+
+		var pdfiumError *pdfium_errors.Error
+		Expect(errors.As(err, &pdfiumError)).To(BeTrue())
+		Expect(pdfiumError.Category).To(Equal(pdfium_errors.CategoryOutOfMemory))
+		Expect(pdfiumError.Method).To(Equal("RenderPageInDPI"))
+
+		memoryStats, err = webassembly.GetMemoryStats(instance)
+		Expect(err).To(BeNil())
+		Expect(memoryStats.Current).To(BeNumerically("<=", memoryStats.Limit))
+
+		_, err = instance.FPDF_CloseDocument(&requests.FPDF_CloseDocument{Document: doc.Document})
+		Expect(err).To(BeNil())
+		Expect(instance.Close()).To(Succeed())
+
+		// The peak is kept after the instance is closed.
+		closedStats, err := webassembly.GetMemoryStats(instance)
+		Expect(err).To(BeNil())
+		Expect(closedStats.Current).To(BeZero())
+		Expect(closedStats.Peak).To(BeNumerically(">=", memoryStats.Current))
+		Expect(closedStats.Limit).To(Equal(memoryStats.Limit))
+	})
+})
+
+var _ = Describe("Document load memory exhaustion", func() {
+	// A load that fails inside PDFium because its allocations fail is
+	// reported by PDFium as a regular load error, it must be returned as an
+	// out of memory error because the memory of the worker grew to its limit.
+	It("returns an out of memory error when the document can not be loaded", func() {
+		pool, err := webassembly.Init(webassembly.Config{
+			MinIdle:        1,
+			MaxIdle:        1,
+			MaxTotal:       1,
+			RuntimeConfig:  runtimeConfig(),
+			MaxMemoryPages: 768,
+		})
+		Expect(err).To(BeNil())
+		defer pool.Close()
+
+		instance, err := pool.GetInstance(time.Second * 30)
+		Expect(err).To(BeNil())
+		defer instance.Close()
+
+		pdfData, err := os.ReadFile("../shared_tests/testdata/test.pdf")
+		Expect(err).To(BeNil())
+
+		memoryStats, err := webassembly.GetMemoryStats(instance)
+		Expect(err).To(BeNil())
+
+		// Pad the document until it fills the memory, whichever allocation
+		// fails first, the copy of the file or one of PDFium, the load must
+		// fail with an out of memory error.
+		const wasmPageSize = 65536
+		size := int(memoryStats.Limit-memoryStats.Current) - 16*wasmPageSize
+		for ; size < int(memoryStats.Limit); size += wasmPageSize / 4 {
+			paddedData := make([]byte, size)
+			copy(paddedData, pdfData)
+			for i := len(pdfData); i < len(paddedData); i++ {
+				paddedData[i] = ' '
+			}
+
+			doc, err := instance.OpenDocument(&requests.OpenDocument{File: &paddedData})
+			if err != nil {
+				Expect(errors.Is(err, pdfium_errors.ErrOutOfMemory)).To(BeTrue(), err.Error())
+
+				var pdfiumError *pdfium_errors.Error
+				Expect(errors.As(err, &pdfiumError)).To(BeTrue())
+				Expect(pdfiumError.Method).To(Equal("OpenDocument"))
+				break
+			}
+
+			_, err = instance.FPDF_CloseDocument(&requests.FPDF_CloseDocument{Document: doc.Document})
+			Expect(err).To(BeNil())
+		}
+		Expect(size).To(BeNumerically("<", memoryStats.Limit))
+
+		// The memory doesn't shrink, other errors of the worker must not be
+		// reported as out of memory errors after that.
+		passwordData, err := os.ReadFile("../shared_tests/testdata/password_test123.pdf")
+		Expect(err).To(BeNil())
+
+		wrongPassword := "test"
+		passwordDoc, err := instance.FPDF_LoadMemDocument(&requests.FPDF_LoadMemDocument{
+			Data:     &passwordData,
+			Password: &wrongPassword,
+		})
+		Expect(passwordDoc).To(BeNil())
+		Expect(errors.Is(err, pdfium_errors.ErrPassword)).To(BeTrue())
+		Expect(pdfium_errors.GetCategory(err)).To(Equal(pdfium_errors.CategoryPassword))
+
+		// The instance must still be usable for documents that do fit.
+		doc, err := instance.OpenDocument(&requests.OpenDocument{File: &pdfData})
+		Expect(err).To(BeNil())
+		_, err = instance.FPDF_CloseDocument(&requests.FPDF_CloseDocument{Document: doc.Document})
+		Expect(err).To(BeNil())
+	})
 })
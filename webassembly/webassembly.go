@@ -14,15 +14,16 @@ import (
 	"time"
 
 	"github.com/klippa-app/go-pdfium"
+	pdfium_errors "github.com/klippa-app/go-pdfium/errors"
+	"github.com/klippa-app/go-pdfium/internal/errorcontext"
+	"github.com/klippa-app/go-pdfium/internal/handledebug"
 	"github.com/klippa-app/go-pdfium/internal/implementation_webassembly"
-	"github.com/klippa-app/go-pdfium/webassembly/imports"
+	"github.com/klippa-app/go-pdfium/internal/stats"
 
 	"github.com/google/uuid"
 	pool "github.com/jolestar/go-commons-pool/v2"
 	"github.com/tetratelabs/wazero"
 	"github.com/tetratelabs/wazero/api"
-	"github.com/tetratelabs/wazero/experimental"
-	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
 	"golang.org/x/net/context"
 )
 
@@ -47,22 +48,77 @@ type Config struct {
 	MaxTotal      int
 	WASM          []byte
 	FSConfig      wazero.FSConfig
-	RuntimeConfig wazero.RuntimeConfig
+	RuntimeConfig wazero.RuntimeConfig // See ModuleConfig.RuntimeConfig.
 	Stdout        io.Writer
 	Stderr        io.Writer
 	RandomSource  io.Reader
 	ReuseWorkers  bool // WebAssembly runtime by default doesn't use workers because creating new instances is cheap.
+
+	// CallTimeout is the maximum duration of a single call, 0 means no
+	// timeout. When a call takes longer, the module of the worker is closed
+	// and the call returns a *errors.TimeoutError. The instance can't be used
+	// anymore after that and should be closed, which replaces the worker.
+	CallTimeout time.Duration
+
+	// StatsExporter receives the statistics of the pool as they happen, see
+	// Stats() on the pool.
+	StatsExporter pdfium.StatsExporter
+
+	// Interceptors are called around every method call of the instances of
+	// the pool, the first interceptor is the outermost one.
+	Interceptors []pdfium.Interceptor
+
+	// HandleDebug enables the handle debug mode, which reports the handles
+	// that are not closed. See pdfium.HandleDebugConfig.
+	HandleDebug *pdfium.HandleDebugConfig
+
+	// MemoryFS is the in-memory file system of the module, that only
+	// contains the files that you add to it and the files that are written
+	// to its scratch directory. The files that are written by go-pdfium
+	// itself, like by FPDF_SaveAsCopy and RenderToFile, are written to it
+	// too. When neither MemoryFS, FSConfig nor MountHostFS is set, the pool
+	// uses an empty MemoryFS with /tmp as scratch directory, see GetMemoryFS.
+	// Can't be combined with FSConfig. See NewMemoryFS.
+	MemoryFS *MemoryFS
+
+	// MountHostFS mounts the root of the host as the root of the module, so
+	// that the module can read and write all the files of the host that the
+	// process can. On Windows the volume of the current working directory is
+	// mounted. Can't be combined with MemoryFS and FSConfig.
+	MountHostFS bool
+
+	// CompilationCacheDir is a directory in which wazero persists the
+	// compiled module, so that the module doesn't have to be compiled again
+	// on the next start. See ModuleConfig.CompilationCacheDir.
+	CompilationCacheDir string
+
+	// MaxMemoryPages is the maximum number of 64 KiB pages that the memory
+	// of each worker can grow to, 0 means the maximum of 65536 pages (4 GiB).
+	// A call that needs more memory returns an error that matches
+	// errors.ErrOutOfMemory. See GetMemoryStats for the memory usage.
+	MaxMemoryPages uint32
+
+	// Module is a compiled module that is shared with other pools, see
+	// CompileModule. The pool doesn't close the module. Can't be combined
+	// with WASM, RuntimeConfig, CompilationCacheDir and MaxMemoryPages, those
+	// are set on the module.
+	Module *Module
 }
 
 type pdfiumPool struct {
-	runtime        wazero.Runtime
-	compiledModule wazero.CompiledModule
-	workerPool     *pool.ObjectPool
-	instanceRefs   map[string]*pdfiumInstance
-	poolRef        string
-	closed         bool
-	lock           *sync.Mutex
-	reuseWorkers   bool
+	module       *Module
+	ownsModule   bool
+	workerPool   *pool.ObjectPool
+	instanceRefs map[string]*pdfiumInstance
+	poolRef      string
+	closed       bool
+	lock         *sync.Mutex
+	reuseWorkers bool
+	callTimeout  time.Duration
+	stats        *stats.Recorder
+	interceptor  pdfium.Interceptor
+	handleDebug  *pdfium.HandleDebugConfig
+	memoryFS     *MemoryFS
 }
 
 var poolRefs = map[string]*pdfiumPool{}
@@ -73,7 +129,7 @@ var multiThreadedMutex = &sync.Mutex{}
 // allow it. If the pool has been exhausted. It will wait until a worker becomes
 // available. So it's important that you close instances when you're done with them.
 func Init(config Config) (pdfium.Pool, error) {
-	if config.WASM == nil {
+	if config.WASM == nil && config.Module == nil {
 		config.WASM = pdfiumWasm
 	}
 	return initWithConfig(config)
@@ -85,15 +141,31 @@ func Init(config Config) (pdfium.Pool, error) {
 // This causes Go not to embed the default WASM file, saving about ~5MB in the
 // resulting binary file.
 func InitWithWASM(config Config) (pdfium.Pool, error) {
-	if config.WASM == nil {
+	if config.WASM == nil && config.Module == nil {
 		return nil, errors.New("webassembly module must be provided")
 	}
 	return initWithConfig(config)
 }
 
 func initWithConfig(config Config) (pdfium.Pool, error) {
-	// Mount the full root by default.
-	if config.FSConfig == nil {
+	if config.MountHostFS && (config.MemoryFS != nil || config.FSConfig != nil) {
+		return nil, errors.New("MountHostFS can't be combined with MemoryFS and FSConfig")
+	}
+
+	// The module can't reach the files of the host by default.
+	if config.MemoryFS == nil && config.FSConfig == nil && !config.MountHostFS {
+		config.MemoryFS = NewMemoryFS("")
+	}
+
+	if config.MemoryFS != nil {
+		if config.FSConfig != nil {
+			return nil, errors.New("FSConfig can't be combined with MemoryFS")
+		}
+
+		config.FSConfig = config.MemoryFS.fsConfig()
+	}
+
+	if config.MountHostFS {
 		config.FSConfig = wazero.NewFSConfig()
 
 		// On Windows we mount the volume of the current working directory as
@@ -125,39 +197,30 @@ func initWithConfig(config Config) (pdfium.Pool, error) {
 		config.RandomSource = rand.Reader
 	}
 
-	if config.RuntimeConfig == nil {
-		// The bundled PDFium module lowers setjmp/longjmp to WebAssembly
-		// exception handling instructions, so the exception handling core
-		// feature is required. When passing a custom RuntimeConfig, include
-		// this feature in the same way.
-		config.RuntimeConfig = wazero.NewRuntimeConfig().WithCoreFeatures(
-			api.CoreFeaturesV2 | experimental.CoreFeaturesExceptionHandling)
-	}
-
 	poolContext := config.Context
 	if poolContext == nil {
 		poolContext = context.Background()
 	}
 
-	runtime := wazero.NewRuntimeWithConfig(poolContext, config.RuntimeConfig)
-
-	// Import WASI features.
-	if _, err := wasi_snapshot_preview1.Instantiate(poolContext, runtime); err != nil {
-		runtime.Close(poolContext)
-		return nil, fmt.Errorf("could not instantiate webassembly wasi_snapshot_preview1 module: %w", err)
-	}
-
-	compiledModule, err := runtime.CompileModule(poolContext, config.WASM)
-	if err != nil {
-		runtime.Close(poolContext)
-		return nil, fmt.Errorf("could not compile webassembly module: %w", err)
+	module := config.Module
+	ownsModule := module == nil
+	if ownsModule {
+		var err error
+		module, err = compileModuleWithConfig(ModuleConfig{
+			Context:             poolContext,
+			WASM:                config.WASM,
+			RuntimeConfig:       config.RuntimeConfig,
+			CompilationCacheDir: config.CompilationCacheDir,
+			MaxMemoryPages:      config.MaxMemoryPages,
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else if config.WASM != nil || config.RuntimeConfig != nil || config.CompilationCacheDir != "" || config.MaxMemoryPages != 0 {
+		return nil, errors.New("WASM, RuntimeConfig, CompilationCacheDir and MaxMemoryPages can't be combined with Module")
 	}
 
-	// Add basic Emscripten specific methods.
-	if _, err := imports.Instantiate(poolContext, runtime, compiledModule); err != nil {
-		runtime.Close(poolContext)
-		return nil, fmt.Errorf("could not instantiate webassembly emscripten/env module: %w", err)
-	}
+	statsRecorder := stats.New(config.StatsExporter)
 
 	factory := pool.NewPooledObjectFactory(
 		func(goctx.Context) (interface{}, error) {
@@ -175,7 +238,7 @@ func initWithConfig(config Config) (pdfium.Pool, error) {
 				WithFSConfig(config.FSConfig).
 				WithName("")
 
-			mod, err := runtime.InstantiateModule(newWorker.Context, compiledModule, moduleConfig)
+			mod, err := module.runtime.InstantiateModule(newWorker.Context, module.compiledModule, moduleConfig)
 			if err != nil {
 				return nil, fmt.Errorf("could not instantiate webassembly module: %w", err)
 			}
@@ -203,6 +266,9 @@ func initWithConfig(config Config) (pdfium.Pool, error) {
 			}
 
 			newWorker.Instance = implementation_webassembly.GetInstance(newWorker.Context, newWorker.Functions, newWorker.Module)
+			if config.MemoryFS != nil {
+				newWorker.Instance.FileSystem = goFileSystem{config.MemoryFS}
+			}
 
 			return newWorker, nil
 		}, func(ctx goctx.Context, object *pool.PooledObject) error {
@@ -216,16 +282,23 @@ func initWithConfig(config Config) (pdfium.Pool, error) {
 			return err
 		}, func(ctx goctx.Context, object *pool.PooledObject) bool {
 			worker := object.Object.(*worker)
-			// @todo: how do to alive check?
-			// @todo: do we need to do an alive check?
+
+			// The module of a worker is closed when its context is done by
+			// a cancelled call, a timeout or Kill.
+			if worker.Context.Err() != nil || worker.Module.IsClosed() {
+				statsRecorder.ObserveWorkerRestart()
+				return false
+			}
 
 			pong, err := worker.Instance.Ping()
 			if err != nil {
+				statsRecorder.ObserveWorkerRestart()
 				return false
 			}
 
 			if pong != "Pong" {
 				err = errors.New("Wrong ping/pong result")
+				statsRecorder.ObserveWorkerRestart()
 				return false
 			}
 
@@ -251,13 +324,18 @@ func initWithConfig(config Config) (pdfium.Pool, error) {
 
 	// Create a new PDFium pool.
 	newPool := &pdfiumPool{
-		runtime:        runtime,
-		compiledModule: compiledModule,
-		poolRef:        poolRef.String(),
-		instanceRefs:   map[string]*pdfiumInstance{},
-		lock:           &sync.Mutex{},
-		workerPool:     p,
-		reuseWorkers:   config.ReuseWorkers,
+		module:       module,
+		ownsModule:   ownsModule,
+		poolRef:      poolRef.String(),
+		instanceRefs: map[string]*pdfiumInstance{},
+		lock:         &sync.Mutex{},
+		workerPool:   p,
+		reuseWorkers: config.ReuseWorkers,
+		callTimeout:  config.CallTimeout,
+		stats:        statsRecorder,
+		interceptor:  pdfium.ChainInterceptors(config.Interceptors...),
+		handleDebug:  config.HandleDebug,
+		memoryFS:     config.MemoryFS,
 	}
 
 	poolRefs[newPool.poolRef] = newPool
@@ -282,7 +360,9 @@ func (p *pdfiumPool) GetInstanceWithContext(ctx goctx.Context) (pdfium.Pdfium, e
 
 	p.lock.Unlock()
 
+	start := time.Now()
 	workerObject, err := p.workerPool.BorrowObject(ctx)
+	p.stats.ObserveGetInstance(time.Since(start), err)
 	if err != nil {
 		return nil, err
 	}
@@ -291,10 +371,19 @@ func (p *pdfiumPool) GetInstanceWithContext(ctx goctx.Context) (pdfium.Pdfium, e
 	defer p.lock.Unlock()
 
 	newInstance := &pdfiumInstance{
-		worker: workerObject.(*worker),
-		lock:   &sync.Mutex{},
+		worker:      workerObject.(*worker),
+		lock:        &sync.Mutex{},
+		callLock:    make(chan struct{}, 1),
+		interceptor: p.interceptor,
+	}
+
+	if p.handleDebug != nil {
+		newInstance.handleDebug = handledebug.New(p.handleDebug, newInstance.ListOpenHandlesWithContext)
+		newInstance.interceptor = pdfium.ChainInterceptors(p.interceptor, newInstance.handleDebug.Interceptor())
 	}
 
+	newInstance.observeMemory()
+
 	instanceRef := uuid.New()
 	newInstance.instanceRef = instanceRef.String()
 	newInstance.pool = p
@@ -303,6 +392,18 @@ func (p *pdfiumPool) GetInstanceWithContext(ctx goctx.Context) (pdfium.Pdfium, e
 	return newInstance, nil
 }
 
+func (p *pdfiumPool) Stats() pdfium.PoolStats {
+	stats := p.stats.Stats()
+
+	p.lock.Lock()
+	stats.InstancesInUse = len(p.instanceRefs)
+	p.lock.Unlock()
+
+	stats.IdleWorkers = p.workerPool.GetNumIdle()
+
+	return stats
+}
+
 func (p *pdfiumPool) Close() (err error) {
 	p.lock.Lock()
 
@@ -334,7 +435,9 @@ func (p *pdfiumPool) Close() (err error) {
 	// Close the underlying pool and destroy workers.
 	p.workerPool.Close(goctx.Background())
 
-	p.runtime.Close(goctx.Background())
+	if p.ownsModule {
+		p.module.Close()
+	}
 
 	return nil
 }
@@ -345,8 +448,29 @@ type pdfiumInstance struct {
 	instanceRef string
 	closed      bool
 	lock        *sync.Mutex
+
+	// callLock makes sure that the calls of the instance are done one at a
+	// time, it's a channel so that waiting for it can be cancelled.
+	callLock chan struct{}
+
+	// interceptor is called around every method call, it's copied from the
+	// pool because the pool is unset when the instance is closed.
+	interceptor pdfium.Interceptor
+
+	// handleDebug tracks the handles of the instance when the handle debug
+	// mode is enabled.
+	handleDebug *handledebug.Tracker
+
+	// The memory stats of the worker, the peak and limit are kept when the
+	// worker is released. Guarded by memoryLock.
+	memoryLock    sync.Mutex
+	memoryCurrent uint64
+	memoryPeak    uint64
+	memoryLimit   uint64
 }
 
+var _ pdfium.PdfiumWithContext = &pdfiumInstance{}
+
 // Close will close the instance and will clean up the underlying PDFium resources
 // by calling i.worker.plugin.Close().
 func (i *pdfiumInstance) Close() (err error) {
@@ -357,6 +481,10 @@ func (i *pdfiumInstance) Close() (err error) {
 		return errors.New("instance is already closed")
 	}
 
+	if i.handleDebug != nil {
+		i.handleDebug.Close(goctx.Background())
+	}
+
 	defer func() {
 		if panicError := recover(); panicError != nil {
 			err = fmt.Errorf("panic occurred in %s: %v", "Close", panicError)
@@ -364,13 +492,22 @@ func (i *pdfiumInstance) Close() (err error) {
 	}()
 
 	defer func() {
-		if i.pool.reuseWorkers {
+		i.observeMemory()
+
+		// A worker of which the context is done has been closed by a
+		// cancelled call, it's returned so that the validator of the pool
+		// replaces it and counts the restart.
+		if i.pool.reuseWorkers || i.worker.Context.Err() != nil {
 			i.pool.workerPool.ReturnObject(goctx.Background(), i.worker)
 		} else {
 			i.pool.workerPool.InvalidateObject(goctx.Background(), i.worker)
 		}
 
 		i.worker = nil
+		i.memoryLock.Lock()
+		i.memoryCurrent = 0
+		i.memoryLock.Unlock()
+
 		i.pool.lock.Lock()
 		delete(i.pool.instanceRefs, i.instanceRef)
 		i.pool.lock.Unlock()
@@ -397,17 +534,17 @@ func (i *pdfiumInstance) Kill() (err error) {
 		}
 	}()
 
-	// Cancel the worker context to interrupt any in-flight WASM execution.
-	// For this to take effect, the caller must enable WithCloseOnContextDone
-	// on the RuntimeConfig passed to Init.
+	// Cancel the worker context to interrupt any in-flight WASM execution,
+	// WithCloseOnContextDone is always enabled on the RuntimeConfig.
 	i.worker.Cancel()
 
 	i.pool.lock.Lock()
 	delete(i.pool.instanceRefs, i.instanceRef)
 	i.pool.lock.Unlock()
 
-	// Invalidate will close the module.
-	err = i.pool.workerPool.InvalidateObject(goctx.Background(), i.worker)
+	// The validator of the pool fails on the closed module, so that the pool
+	// destroys the worker and counts the restart.
+	err = i.pool.workerPool.ReturnObject(goctx.Background(), i.worker)
 
 	i.pool = nil
 	i.closed = true
@@ -415,6 +552,79 @@ func (i *pdfiumInstance) Kill() (err error) {
 	return err
 }
 
+// startCall waits until the instance is available, and closes the module of
+// the worker when the context is done or when the call takes longer than the
+// call timeout. The returned function must be deferred with the error of the
+// call, it replaces the error with the error of the context or a
+// *errors.TimeoutError when the module was closed during the call, and with an
+// out of memory error when the call failed after the memory of the worker grew
+// to its limit during the call. The result of a call that completed before the module was
+// closed is kept, so that its handles don't leak.
+func (i *pdfiumInstance) startCall(ctx goctx.Context, method string, request interface{}) (func(err *error), error) {
+	// A select picks a random case when both are ready, so a context that
+	// is already done is checked first.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	select {
+	case i.callLock <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	// Cancelling the worker context closes the module, which interrupts the
+	// execution of the call.
+	stopCancel := func() bool { return true }
+	if ctx.Done() != nil {
+		stopCancel = goctx.AfterFunc(ctx, i.worker.Cancel)
+	}
+
+	observeCall := i.pool.stats.StartCall(method)
+
+	// The memory of a worker never shrinks, so only a call during which the
+	// memory grew to the limit can have failed because it ran out of memory.
+	memoryBefore := i.worker.Instance.MemorySize()
+
+	var timer *time.Timer
+	timeout := i.pool.callTimeout
+	if timeout > 0 {
+		timer = time.AfterFunc(timeout, i.worker.Cancel)
+	}
+
+	return func(err *error) {
+		defer func() {
+			i.observeMemory()
+			observeCall(*err)
+			<-i.callLock
+		}()
+
+		cancelled := !stopCancel()
+		timedOut := timer != nil && !timer.Stop()
+
+		if *err != nil && i.worker.Module.IsClosed() {
+			if cancelled {
+				*err = ctx.Err()
+				return
+			}
+
+			if timedOut {
+				*err = &pdfium_errors.TimeoutError{
+					Method:  method,
+					Timeout: timeout,
+				}
+				return
+			}
+		}
+
+		if *err != nil && !errors.Is(*err, pdfium_errors.ErrOutOfMemory) && i.worker.Instance.MemorySize() > memoryBefore && i.worker.Instance.MemoryAtLimit() {
+			*err = pdfium_errors.NewOutOfMemoryError(method, *err)
+		}
+
+		errorcontext.Set(*err, method, request)
+	}, nil
+}
+
 func (i *pdfiumInstance) GetImplementation() interface{} {
 	return i.worker.Instance
 }
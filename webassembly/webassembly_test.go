@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/klippa-app/go-pdfium"
+	pdfium_errors "github.com/klippa-app/go-pdfium/errors"
 	"github.com/klippa-app/go-pdfium/requests"
 	"github.com/klippa-app/go-pdfium/shared_tests"
 	"github.com/klippa-app/go-pdfium/webassembly"
@@ -48,6 +49,7 @@ var _ = BeforeSuite(func() {
 		MaxIdle:       1, // Makes sure that at most x workers are ever available
 		MaxTotal:      1, // The maximum number of workers in total, allows the number of workers to grow when needed, items between total max and idle max are automatically cleaned up, while idle workers are kept alive so they can be used directly.
 		RuntimeConfig: runtimeConfig(),
+		MountHostFS:   true, // The shared tests load the test data by path.
 	})
 	Expect(err).To(BeNil())
 
@@ -92,6 +94,86 @@ var _ = Describe("Webassembly", func() {
 		})
 	})
 
+	Context("Module", func() {
+		It("requires a configured module", func() {
+			module, err := webassembly.CompileModuleWithWASM(webassembly.ModuleConfig{})
+			Expect(module).To(BeNil())
+			Expect(err).To(MatchError("webassembly module must be provided"))
+		})
+
+		It("can't be combined with the configuration of the module", func() {
+			pool, err := webassembly.Init(webassembly.Config{
+				Module:              &webassembly.Module{},
+				CompilationCacheDir: GinkgoT().TempDir(),
+			})
+			Expect(pool).To(BeNil())
+			Expect(err).To(MatchError("WASM, RuntimeConfig, CompilationCacheDir and MaxMemoryPages can't be combined with Module"))
+		})
+
+		It("can be shared between pools", func() {
+			module, err := webassembly.CompileModule(webassembly.ModuleConfig{
+				RuntimeConfig: runtimeConfig(),
+			})
+			Expect(err).To(BeNil())
+			defer module.Close()
+
+			for i := 0; i < 2; i++ {
+				pool, err := webassembly.Init(webassembly.Config{
+					MinIdle:  1,
+					MaxIdle:  1,
+					MaxTotal: 1,
+					Module:   module,
+				})
+				Expect(err).To(BeNil())
+
+				instance, err := pool.GetInstance(time.Second * 30)
+				Expect(err).To(BeNil())
+
+				pdfData, err := os.ReadFile("../shared_tests/testdata/test.pdf")
+				Expect(err).To(BeNil())
+				doc, err := instance.OpenDocument(&requests.OpenDocument{
+					File: &pdfData,
+				})
+				Expect(err).To(BeNil())
+
+				pageCount, err := instance.FPDF_GetPageCount(&requests.FPDF_GetPageCount{
+					Document: doc.Document,
+				})
+				Expect(err).To(BeNil())
+				Expect(pageCount.PageCount).To(Equal(1))
+
+				Expect(instance.Close()).To(Succeed())
+
+				// Closing the pool doesn't close the shared module.
+				Expect(pool.Close()).To(Succeed())
+			}
+
+			Expect(module.Close()).To(Succeed())
+			Expect(module.Close()).To(MatchError("module is already closed"))
+		})
+
+		It("persists the compiled module in the compilation cache dir", func() {
+			if interpreterMode {
+				Skip("the interpreter doesn't compile the module")
+			}
+
+			cacheDir := GinkgoT().TempDir()
+			pool, err := webassembly.Init(webassembly.Config{
+				MinIdle:             1,
+				MaxIdle:             1,
+				MaxTotal:            1,
+				RuntimeConfig:       runtimeConfig(),
+				CompilationCacheDir: cacheDir,
+			})
+			Expect(err).To(BeNil())
+			Expect(pool.Close()).To(Succeed())
+
+			entries, err := os.ReadDir(cacheDir)
+			Expect(err).To(BeNil())
+			Expect(entries).ToNot(BeEmpty())
+		})
+	})
+
 	Context("pooling", func() {
 		It("uses the configured context for workers", func() {
 			ctx, cancel := context.WithCancel(context.Background())
@@ -158,6 +240,111 @@ var _ = Describe("Webassembly", func() {
 		})
 	})
 
+	Context("Interceptors", func() {
+		It("calls the interceptors around every method call", func() {
+			var methods []string
+			pool, err := webassembly.Init(webassembly.Config{
+				MinIdle:       0,
+				MaxIdle:       1,
+				MaxTotal:      1,
+				RuntimeConfig: runtimeConfig(),
+				Interceptors: []pdfium.Interceptor{
+					func(ctx context.Context, method string, request interface{}, next func() (interface{}, error)) (interface{}, error) {
+						methods = append(methods, method)
+						return next()
+					},
+					func(ctx context.Context, method string, request interface{}, next func() (interface{}, error)) (interface{}, error) {
+						if method == "FPDF_GetPageCount" {
+							return nil, errors.New("method not allowed")
+						}
+						return next()
+					},
+				},
+			})
+			Expect(err).To(BeNil())
+			defer pool.Close()
+
+			instance, err := pool.GetInstance(time.Second * 30)
+			Expect(err).To(BeNil())
+
+			lastError, err := instance.FPDF_GetLastError(&requests.FPDF_GetLastError{})
+			Expect(err).To(BeNil())
+			Expect(lastError).To(Not(BeNil()))
+
+			pageCount, err := instance.FPDF_GetPageCount(&requests.FPDF_GetPageCount{})
+			Expect(err).To(MatchError("method not allowed"))
+			Expect(pageCount).To(BeNil())
+
+			Expect(methods).To(Equal([]string{"FPDF_GetLastError", "FPDF_GetPageCount"}))
+
+			err = instance.Close()
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Context("HandleDebug", func() {
+		It("reports the handles that are not closed", func() {
+			var leaks []pdfium.HandleLeak
+			pool, err := webassembly.Init(webassembly.Config{
+				MinIdle:       0,
+				MaxIdle:       1,
+				MaxTotal:      1,
+				RuntimeConfig: runtimeConfig(),
+				HandleDebug: &pdfium.HandleDebugConfig{
+					OnLeak: func(leak pdfium.HandleLeak) {
+						leaks = append(leaks, leak)
+					},
+				},
+			})
+			Expect(err).To(BeNil())
+			defer pool.Close()
+
+			instance, err := pool.GetInstance(time.Second * 30)
+			Expect(err).To(BeNil())
+
+			pdfData, err := os.ReadFile("../shared_tests/testdata/test.pdf")
+			Expect(err).To(BeNil())
+
+			doc, err := instance.FPDF_LoadMemDocument(&requests.FPDF_LoadMemDocument{
+				Data: &pdfData,
+			})
+			Expect(err).To(BeNil())
+
+			page, err := instance.FPDF_LoadPage(&requests.FPDF_LoadPage{
+				Document: doc.Document,
+				Index:    0,
+			})
+			Expect(err).To(BeNil())
+
+			_, err = instance.FPDF_CloseDocument(&requests.FPDF_CloseDocument{
+				Document: doc.Document,
+			})
+			Expect(err).To(BeNil())
+
+			Expect(leaks).To(HaveLen(1))
+			Expect(leaks[0].Method).To(Equal("FPDF_CloseDocument"))
+			Expect(leaks[0].Document).To(Equal(doc.Document))
+			Expect(leaks[0].Handles).To(HaveLen(1))
+			Expect(leaks[0].Handles[0].Type).To(Equal("FPDF_PAGE"))
+			Expect(leaks[0].Handles[0].Reference).To(Equal(string(page.Page)))
+			Expect(leaks[0].Handles[0].Stack).To(ContainSubstring("FPDF_LoadPage"))
+
+			doc, err = instance.FPDF_LoadMemDocument(&requests.FPDF_LoadMemDocument{
+				Data: &pdfData,
+			})
+			Expect(err).To(BeNil())
+
+			err = instance.Close()
+			Expect(err).To(BeNil())
+
+			Expect(leaks).To(HaveLen(2))
+			Expect(leaks[1].Method).To(Equal("Close"))
+			Expect(leaks[1].Document).To(Equal(doc.Document))
+			Expect(leaks[1].Handles).To(HaveLen(1))
+			Expect(leaks[1].Handles[0].Type).To(Equal("FPDF_DOCUMENT"))
+		})
+	})
+
 	Context("Kill", func() {
 		// Kill() previously set i.pool = nil before calling
 		// i.pool.workerPool.InvalidateObject(), causing a nil pointer
@@ -178,6 +365,7 @@ var _ = Describe("Webassembly", func() {
 
 			err = instance.Kill()
 			Expect(err).To(BeNil())
+			Expect(pool.Stats().WorkerRestarts).To(BeEquivalentTo(1))
 
 			// The pool should still be usable after Kill.
 			instance2, err := pool.GetInstance(time.Second * 30)
@@ -259,6 +447,282 @@ var _ = Describe("Webassembly", func() {
 			Expect(err).To(BeNil())
 		})
 	})
+
+	Context("CallTimeout", func() {
+		It("interrupts a stuck call with a timeout error and recovers the pool", func() {
+			pool, err := webassembly.Init(webassembly.Config{
+				MinIdle:       0,
+				MaxIdle:       1,
+				MaxTotal:      1,
+				RuntimeConfig: runtimeConfig(),
+				CallTimeout:   time.Second,
+			})
+			Expect(err).To(BeNil())
+			defer pool.Close()
+
+			instance, err := pool.GetInstance(time.Second * 30)
+			Expect(err).To(BeNil())
+
+			pdfData, err := os.ReadFile("../shared_tests/testdata/bug_451265.pdf")
+			Expect(err).To(BeNil())
+
+			doc, err := instance.OpenDocument(&requests.OpenDocument{
+				File: &pdfData,
+			})
+			Expect(err).To(BeNil())
+
+			renderedFile, err := instance.RenderToFile(&requests.RenderToFile{
+				RenderPageInDPI: &requests.RenderPageInDPI{
+					Page: requests.Page{
+						ByIndex: &requests.PageByIndex{
+							Document: doc.Document,
+							Index:    0,
+						},
+					},
+					DPI: 72,
+				},
+				OutputFormat:  requests.RenderToFileOutputFormatJPG,
+				OutputTarget:  requests.RenderToFileOutputTargetBytes,
+				OutputQuality: 50,
+			})
+			Expect(renderedFile).To(BeNil())
+
+			var timeoutError *pdfium_errors.TimeoutError
+			Expect(errors.As(err, &timeoutError)).To(BeTrue())
+			Expect(timeoutError.Method).To(Equal("RenderToFile"))
+			Expect(timeoutError.Timeout).To(Equal(time.Second))
+
+			// The worker is broken, closing the instance replaces it.
+			instance.Close()
+			Expect(pool.Stats().WorkerRestarts).To(BeEquivalentTo(1))
+
+			instance2, err := pool.GetInstance(time.Second * 30)
+			Expect(err).To(BeNil())
+
+			pageCount, err := instance2.FPDF_GetPageCount(&requests.FPDF_GetPageCount{})
+			Expect(err).To(MatchError("document not given"))
+			Expect(pageCount).To(BeNil())
+
+			err = instance2.Close()
+			Expect(err).To(BeNil())
+		})
+
+		It("counts the restart of a reused worker once", func() {
+			pool, err := webassembly.Init(webassembly.Config{
+				MinIdle:       0,
+				MaxIdle:       1,
+				MaxTotal:      1,
+				RuntimeConfig: runtimeConfig(),
+				CallTimeout:   time.Second,
+				ReuseWorkers:  true,
+			})
+			Expect(err).To(BeNil())
+			defer pool.Close()
+
+			instance, err := pool.GetInstance(time.Second * 30)
+			Expect(err).To(BeNil())
+
+			pdfData, err := os.ReadFile("../shared_tests/testdata/bug_451265.pdf")
+			Expect(err).To(BeNil())
+
+			doc, err := instance.OpenDocument(&requests.OpenDocument{
+				File: &pdfData,
+			})
+			Expect(err).To(BeNil())
+
+			_, err = instance.RenderPageInDPI(&requests.RenderPageInDPI{
+				Page: requests.Page{
+					ByIndex: &requests.PageByIndex{
+						Document: doc.Document,
+						Index:    0,
+					},
+				},
+				DPI: 72,
+			})
+			var timeoutError *pdfium_errors.TimeoutError
+			Expect(errors.As(err, &timeoutError)).To(BeTrue())
+
+			// The worker is broken, closing the instance replaces it.
+			instance.Close()
+			Expect(pool.Stats().WorkerRestarts).To(BeEquivalentTo(1))
+
+			// The closed worker is not handed out again.
+			instance2, err := pool.GetInstance(time.Second * 30)
+			Expect(err).To(BeNil())
+			Expect(pool.Stats().WorkerRestarts).To(BeEquivalentTo(1))
+
+			err = instance2.Close()
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Context("MemoryFS", func() {
+		It("can't be combined with FSConfig", func() {
+			pool, err := webassembly.Init(webassembly.Config{
+				MemoryFS: webassembly.NewMemoryFS(""),
+				FSConfig: wazero.NewFSConfig(),
+			})
+			Expect(pool).To(BeNil())
+			Expect(err).To(MatchError("FSConfig can't be combined with MemoryFS"))
+		})
+
+		It("can't be combined with MountHostFS", func() {
+			pool, err := webassembly.Init(webassembly.Config{
+				MemoryFS:    webassembly.NewMemoryFS(""),
+				MountHostFS: true,
+			})
+			Expect(pool).To(BeNil())
+			Expect(err).To(MatchError("MountHostFS can't be combined with MemoryFS and FSConfig"))
+		})
+
+		It("is used when no file system is configured", func() {
+			pool, err := webassembly.Init(webassembly.Config{
+				MinIdle:       1,
+				MaxIdle:       1,
+				MaxTotal:      1,
+				RuntimeConfig: runtimeConfig(),
+			})
+			Expect(err).To(BeNil())
+			defer pool.Close()
+
+			memoryFS, err := webassembly.GetMemoryFS(pool)
+			Expect(err).To(BeNil())
+			Expect(memoryFS).ToNot(BeNil())
+			Expect(memoryFS.ScratchDir()).To(Equal("/tmp"))
+
+			instance, err := pool.GetInstance(time.Second * 30)
+			Expect(err).To(BeNil())
+			defer instance.Close()
+
+			// Files of the host can't be reached.
+			hostPath, err := filepath.Abs("../shared_tests/testdata/test.pdf")
+			Expect(err).To(BeNil())
+			doc, err := instance.FPDF_LoadDocument(&requests.FPDF_LoadDocument{
+				Path: &hostPath,
+			})
+			Expect(err).To(MatchError(pdfium_errors.ErrFile.Error()))
+			Expect(doc).To(BeNil())
+		})
+
+		It("is not used when the host is mounted", func() {
+			pool, err := webassembly.Init(webassembly.Config{
+				MinIdle:       1,
+				MaxIdle:       1,
+				MaxTotal:      1,
+				RuntimeConfig: runtimeConfig(),
+				MountHostFS:   true,
+			})
+			Expect(err).To(BeNil())
+			defer pool.Close()
+
+			memoryFS, err := webassembly.GetMemoryFS(pool)
+			Expect(err).To(BeNil())
+			Expect(memoryFS).To(BeNil())
+
+			instance, err := pool.GetInstance(time.Second * 30)
+			Expect(err).To(BeNil())
+			defer instance.Close()
+
+			hostPath, err := filepath.Abs("../shared_tests/testdata/test.pdf")
+			Expect(err).To(BeNil())
+			if runtime.GOOS == "windows" {
+				hostPath = strings.ReplaceAll(strings.TrimPrefix(hostPath, filepath.VolumeName(hostPath)), "\\", "/")
+			}
+
+			doc, err := instance.FPDF_LoadDocument(&requests.FPDF_LoadDocument{
+				Path: &hostPath,
+			})
+			Expect(err).To(BeNil())
+
+			_, err = instance.FPDF_CloseDocument(&requests.FPDF_CloseDocument{
+				Document: doc.Document,
+			})
+			Expect(err).To(BeNil())
+		})
+
+		It("only exposes the registered files and the scratch directory", func() {
+			pdfData, err := os.ReadFile("../shared_tests/testdata/test.pdf")
+			Expect(err).To(BeNil())
+
+			memoryFS := webassembly.NewMemoryFS("/output")
+			Expect(memoryFS.AddFile("/input/test.pdf", pdfData)).To(Succeed())
+
+			pool, err := webassembly.Init(webassembly.Config{
+				MinIdle:       1,
+				MaxIdle:       1,
+				MaxTotal:      1,
+				RuntimeConfig: runtimeConfig(),
+				MemoryFS:      memoryFS,
+			})
+			Expect(err).To(BeNil())
+			defer pool.Close()
+
+			instance, err := pool.GetInstance(time.Second * 30)
+			Expect(err).To(BeNil())
+			defer instance.Close()
+
+			// Files of the host can't be reached.
+			hostPath, err := filepath.Abs("../shared_tests/testdata/test.pdf")
+			Expect(err).To(BeNil())
+			doc, err := instance.FPDF_LoadDocument(&requests.FPDF_LoadDocument{
+				Path: &hostPath,
+			})
+			Expect(err).To(MatchError(pdfium_errors.ErrFile.Error()))
+			Expect(doc).To(BeNil())
+
+			inputPath := "/input/test.pdf"
+			doc, err = instance.FPDF_LoadDocument(&requests.FPDF_LoadDocument{
+				Path: &inputPath,
+			})
+			Expect(err).To(BeNil())
+
+			// Registered files can't be overwritten.
+			_, err = instance.FPDF_SaveAsCopy(&requests.FPDF_SaveAsCopy{
+				Document: doc.Document,
+				FilePath: &inputPath,
+			})
+			Expect(err).To(HaveOccurred())
+
+			outputPath := "/output/copy.pdf"
+			_, err = instance.FPDF_SaveAsCopy(&requests.FPDF_SaveAsCopy{
+				Document: doc.Document,
+				FilePath: &outputPath,
+			})
+			Expect(err).To(BeNil())
+
+			savedData, err := memoryFS.ReadFile(outputPath)
+			Expect(err).To(BeNil())
+			Expect(savedData).To(HavePrefix("%PDF-"))
+
+			renderedFile, err := instance.RenderToFile(&requests.RenderToFile{
+				RenderPageInDPI: &requests.RenderPageInDPI{
+					Page: requests.Page{
+						ByIndex: &requests.PageByIndex{
+							Document: doc.Document,
+							Index:    0,
+						},
+					},
+					DPI: 72,
+				},
+				OutputFormat: requests.RenderToFileOutputFormatPNG,
+				OutputTarget: requests.RenderToFileOutputTargetFile,
+			})
+			Expect(err).To(BeNil())
+			Expect(renderedFile.ImagePath).To(HavePrefix("/output/"))
+
+			renderedData, err := memoryFS.ReadFile(renderedFile.ImagePath)
+			Expect(err).To(BeNil())
+			Expect(renderedData).To(HavePrefix("\x89PNG"))
+
+			_, err = os.Stat(renderedFile.ImagePath)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+
+			_, err = instance.FPDF_CloseDocument(&requests.FPDF_CloseDocument{
+				Document: doc.Document,
+			})
+			Expect(err).To(BeNil())
+		})
+	})
 })
 
 var _ = AfterEach(func() {